@@ -2,39 +2,55 @@ package lnd
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"sync"
 
-	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
-	"github.com/davecgh/go-spew/spew"
 
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
+var byteOrder = binary.BigEndian
+
+// justiceConfTarget is the confirmation target used to sweep revoked outputs.
+// The sweeper raises the fee rate beyond it as the deadline of an output
+// approaches.
+const justiceConfTarget = 2
+
+// ownOutputConfTarget is the confirmation target used to sweep our own output
+// on a revoked commitment, which the remote party isn't able to spend.
+const ownOutputConfTarget = 6
+
+// maxJusticeSweepFailures is the number of consecutive failed sweeps of a
+// revoked output after which it's reported as failed. The sweep is still
+// retried every block, as the time lock of the remote party keeps running.
+const maxJusticeSweepFailures = 3
+
 var (
 	// retributionBucket stores retribution state on disk between detecting
-	// a contract breach, broadcasting a justice transaction that sweeps the
-	// channel, and finally witnessing the justice transaction confirm on
-	// the blockchain. It is critical that such state is persisted on disk,
+	// a contract breach, offering the revoked outputs to the sweeper, and
+	// finally witnessing all of them being swept on the blockchain. It is critical that such state is persisted on disk,
 	// so that if our node restarts at any point during the retribution
 	// procedure, we can recover and continue from the persisted state.
 	retributionBucket = []byte("retribution")
 
-	// justiceTxnBucket holds the finalized justice transactions for all
-	// breached contracts. Entries are added to the justice txn bucket just
-	// before broadcasting the sweep txn.
+	// justiceTxnBucket holds the finalized justice transactions of
+	// breached contracts, as stored by earlier versions that crafted the
+	// justice transaction themselves. Revoked outputs are handed to the
+	// sweeper now, so entries are only cleaned up.
 	justiceTxnBucket = []byte("justice-txn")
 
 	// errBrarShuttingDown is an error returned if the breacharbiter has
@@ -75,32 +91,21 @@ type BreachConfig struct {
 	// it should respond to channel closure.
 	DB *channeldb.DB
 
-	// Estimator is used by the breach arbiter to determine an appropriate
-	// fee level when generating, signing, and broadcasting sweep
-	// transactions.
-	Estimator lnwallet.FeeEstimator
-
-	// GenSweepScript generates the receiving scripts for swept outputs.
-	GenSweepScript func() ([]byte, error)
-
 	// Notifier provides a publish/subscribe interface for event driven
 	// notifications regarding the confirmation of txids.
 	Notifier chainntnfs.ChainNotifier
 
-	// PublishTransaction facilitates the process of broadcasting a
-	// transaction to the network.
-	PublishTransaction func(*wire.MsgTx) error
-
 	// ContractBreaches is a channel where the breachArbiter will receive
 	// notifications in the event of a contract breach being observed. A
 	// ContractBreachEvent must be ACKed by the breachArbiter, such that
 	// the sending subsystem knows that the event is properly handed off.
 	ContractBreaches <-chan *ContractBreachEvent
 
-	// Signer is used by the breach arbiter to generate sweep transactions,
-	// which move coins from previously open channels back to the user's
-	// wallet.
-	Signer input.Signer
+	// SweepInput offers a revoked output to the sweeper, which sweeps it
+	// back into the wallet. The sweeper only batches the output with other
+	// inputs of the given sweep group.
+	SweepInput func(input.Input, sweep.FeePreference,
+		uint64) (chan sweep.Result, error)
 
 	// Store is a persistent resource that maintains information regarding
 	// breached channels. This is used in conjunction with DB to recover
@@ -122,6 +127,11 @@ type breachArbiter struct {
 
 	cfg *BreachConfig
 
+	// reports tracks the progress of exacting justice for each of the
+	// breached channels being handled.
+	reports   map[wire.OutPoint]*breachReport
+	reportMtx sync.Mutex
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 	sync.Mutex
@@ -131,8 +141,9 @@ type breachArbiter struct {
 // its dependent objects.
 func newBreachArbiter(cfg *BreachConfig) *breachArbiter {
 	return &breachArbiter{
-		cfg:  cfg,
-		quit: make(chan struct{}),
+		cfg:     cfg,
+		reports: make(map[wire.OutPoint]*breachReport),
+		quit:    make(chan struct{}),
 	}
 }
 
@@ -312,184 +323,475 @@ func convertToSecondLevelRevoke(bo *breachedOutput, breachInfo *retributionInfo,
 		bo.outpoint)
 }
 
-// waitForSpendEvent waits for any of the breached outputs to get spent, and
-// mutates the breachInfo to be able to sweep it. This method should be used
-// when we fail to publish the justice tx because of a double spend, indicating
-// that the counter party has taken one of the breached outputs to the second
-// level. The spendNtfns map is a cache used to store registered spend
-// subscriptions, in case we must call this method multiple times.
-func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
-	spendNtfns map[wire.OutPoint]*chainntnfs.SpendEvent) error {
+// justiceState describes the progress of sweeping a revoked output.
+type justiceState uint8
+
+const (
+	// justicePending indicates that the revoked output is being swept.
+	justicePending justiceState = iota
 
-	inputs := breachInfo.breachedOutputs
+	// justiceSecondLevel indicates that the remote party spent a revoked
+	// HTLC output to the second level, and that the second level output
+	// is being swept instead.
+	justiceSecondLevel
 
-	// spend is used to wrap the index of the output that gets spent
-	// together with the spend details.
-	type spend struct {
-		index  int
-		detail *chainntnfs.SpendDetail
+	// justiceSwept indicates that the revoked output was swept into our
+	// wallet.
+	justiceSwept
+
+	// justiceLost indicates that the remote party managed to spend the
+	// revoked output.
+	justiceLost
+
+	// justiceFailed indicates that sweeping the revoked output failed
+	// repeatedly. The sweep is retried every block until the output has
+	// been spent, or once the breach arbiter is restarted if we're unable
+	// to wait for blocks.
+	justiceFailed
+)
+
+// String returns a human readable representation of the justice state.
+func (s justiceState) String() string {
+	switch s {
+	case justicePending:
+		return "Pending"
+	case justiceSecondLevel:
+		return "SecondLevel"
+	case justiceSwept:
+		return "Swept"
+	case justiceLost:
+		return "Lost"
+	case justiceFailed:
+		return "Failed"
+	default:
+		return "Unknown"
 	}
+}
 
-	// We create a channel the first goroutine that gets a spend event can
-	// signal. We make it buffered in case multiple spend events come in at
-	// the same time.
-	anySpend := make(chan struct{}, len(inputs))
+// justiceReport describes the progress of sweeping a single revoked output.
+type justiceReport struct {
+	// witnessType is the witness type of the output on the revoked
+	// commitment transaction.
+	witnessType input.StandardWitnessType
 
-	// The allSpends channel will be used to pass spend events from all the
-	// goroutines that detects a spend before they are signalled to exit.
-	allSpends := make(chan spend, len(inputs))
+	// outpoint is the outpoint that is being swept. It points to the
+	// second level output once the remote party went there.
+	outpoint wire.OutPoint
 
-	// exit will be used to signal the goroutines that they can exit.
-	exit := make(chan struct{})
-	var wg sync.WaitGroup
+	// amount is the value of the output being swept.
+	amount btcutil.Amount
 
-	// We'll now launch a goroutine for each of the HTLC outputs, that will
-	// signal the moment they detect a spend event.
-	for i := range inputs {
-		breachedOutput := &inputs[i]
+	// state is the progress of the sweep.
+	state justiceState
 
-		brarLog.Infof("Checking spend from %v(%v) for ChannelPoint(%v)",
-			breachedOutput.witnessType, breachedOutput.outpoint,
-			breachInfo.chanPoint)
+	// deadlineHeight is the height by which the sweep should confirm, as
+	// the remote party is able to spend the output as well from the next
+	// block on. It's zero if the remote delay of the channel is unknown.
+	deadlineHeight uint32
 
-		// If we have already registered for a notification for this
-		// output, we'll reuse it.
-		spendNtfn, ok := spendNtfns[breachedOutput.outpoint]
-		if !ok {
-			var err error
-			spendNtfn, err = b.cfg.Notifier.RegisterSpendNtfn(
-				&breachedOutput.outpoint,
-				breachedOutput.signDesc.Output.PkScript,
-				breachInfo.breachHeight,
-			)
-			if err != nil {
-				brarLog.Errorf("Unable to check for spentness "+
-					"of outpoint=%v: %v",
-					breachedOutput.outpoint, err)
-
-				// Registration may have failed if we've been
-				// instructed to shutdown. If so, return here
-				// to avoid entering an infinite loop.
-				select {
-				case <-b.quit:
-					return errBrarShuttingDown
-				default:
-					continue
-				}
+	// spendTxid is the hash of the transaction that spent the output, if
+	// any.
+	spendTxid chainhash.Hash
+}
+
+// breachReport describes the progress of exacting justice for a breached
+// channel.
+type breachReport struct {
+	// breachTxid is the hash of the revoked commitment transaction.
+	breachTxid chainhash.Hash
+
+	// confHeight is the height at which the revoked commitment
+	// transaction confirmed, or zero if it's still unconfirmed.
+	confHeight uint32
+
+	// outputs reports on each of the revoked outputs.
+	outputs []justiceReport
+}
+
+// limboBalance returns the value of the outputs that are still to be swept.
+func (r *breachReport) limboBalance() btcutil.Amount {
+	var amt btcutil.Amount
+	for _, output := range r.outputs {
+		switch output.state {
+		case justicePending, justiceSecondLevel, justiceFailed:
+			amt += output.amount
+		}
+	}
+
+	return amt
+}
+
+// recoveredBalance returns the value of the outputs that were swept into our
+// wallet.
+func (r *breachReport) recoveredBalance() btcutil.Amount {
+	var amt btcutil.Amount
+	for _, output := range r.outputs {
+		if output.state == justiceSwept {
+			amt += output.amount
+		}
+	}
+
+	return amt
+}
+
+// trackBreach starts reporting on the progress of the given retribution.
+func (b *breachArbiter) trackBreach(breachInfo *retributionInfo) {
+	report := &breachReport{
+		breachTxid: breachInfo.commitHash,
+		outputs:    make([]justiceReport, len(breachInfo.breachedOutputs)),
+	}
+	for i, bo := range breachInfo.breachedOutputs {
+		report.outputs[i] = justiceReport{
+			witnessType: bo.witnessType,
+			outpoint:    bo.outpoint,
+			amount:      bo.amt,
+		}
+	}
+
+	b.reportMtx.Lock()
+	b.reports[breachInfo.chanPoint] = report
+	b.reportMtx.Unlock()
+}
+
+// updateReport applies the given update to the report of a breached channel.
+func (b *breachArbiter) updateReport(chanPoint *wire.OutPoint,
+	update func(*breachReport)) {
+
+	b.reportMtx.Lock()
+	defer b.reportMtx.Unlock()
+
+	if report, ok := b.reports[*chanPoint]; ok {
+		update(report)
+	}
+}
+
+// breachReport returns a snapshot of the progress of exacting justice for the
+// given channel, or nil if the breach arbiter isn't handling a breach of it.
+func (b *breachArbiter) breachReport(chanPoint *wire.OutPoint) *breachReport {
+	b.reportMtx.Lock()
+	defer b.reportMtx.Unlock()
+
+	report, ok := b.reports[*chanPoint]
+	if !ok {
+		return nil
+	}
+
+	snapshot := *report
+	snapshot.outputs = append([]justiceReport(nil), report.outputs...)
+
+	return &snapshot
+}
+
+// justiceSweepGroup returns the sweep group of a revoked output of the given
+// channel. Commitment and HTLC outputs are placed in separate groups, so the
+// remote party spending an HTLC output to the second level can't invalidate
+// the sweep of the commitment outputs.
+func justiceSweepGroup(chanPoint *wire.OutPoint,
+	witnessType input.StandardWitnessType) uint64 {
+
+	var kind byte
+	switch witnessType {
+	case input.CommitmentNoDelay, input.CommitSpendNoDelayTweakless,
+		input.CommitmentRevoke:
+
+	default:
+		kind = 1
+	}
+
+	var index [4]byte
+	byteOrder.PutUint32(index[:], chanPoint.Index)
+
+	h := sha256.New()
+	h.Write(chanPoint.Hash[:])
+	h.Write(index[:])
+	h.Write([]byte{kind})
+
+	return byteOrder.Uint64(h.Sum(nil)[:8])
+}
+
+// isJusticeSpend returns whether the given transaction spends the revoked
+// output into our wallet. Besides the sweeps of the sweeper, this covers
+// justice transactions published by a watchtower or an earlier version of the
+// breach arbiter.
+func isJusticeSpend(bo *breachedOutput, tx *wire.MsgTx) bool {
+	switch bo.witnessType {
+	// Our own commitment output can only be spent by us.
+	case input.CommitmentNoDelay, input.CommitSpendNoDelayTweakless:
+		return true
+	}
+
+	for _, txIn := range tx.TxIn {
+		if txIn.PreviousOutPoint != bo.outpoint {
+			continue
+		}
+
+		// A revocation clause is spent by a signature, followed by
+		// either the revocation key or a true value selecting the
+		// clause, and the witness script.
+		witness := txIn.Witness
+		if len(witness) != 3 {
+			return false
+		}
+
+		return len(witness[1]) == 33 || bytes.Equal(witness[1], []byte{1})
+	}
+
+	return false
+}
+
+// sweepRevokedOutput offers the revoked output at the given index of the
+// retribution to the sweeper, and waits for it to be spent. If the remote
+// party spends an HTLC output to the second level first, the second level
+// output is swept instead. Once the output reached its final state, it's sent
+// on the results channel. If the output can't be swept, it's offered again
+// every block until it has been spent. Only if we're unable to wait for the
+// next block or the spend of the output, justiceFailed is sent on the results
+// channel instead.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *breachArbiter) sweepRevokedOutput(breachInfo *retributionInfo,
+	idx int, breachConfHeight uint32, results chan<- justiceState) {
+
+	defer b.wg.Done()
+
+	state, spendTxid, err := b.sweepRevokedOutputUntilFinal(
+		breachInfo, idx, breachConfHeight,
+	)
+	switch {
+	case err == errBrarShuttingDown:
+		return
+
+	case err != nil:
+		brarLog.Errorf("Unable to sweep revoked output %v for "+
+			"ChannelPoint(%v), retrying on restart: %v",
+			breachInfo.breachedOutputs[idx].outpoint,
+			breachInfo.chanPoint, err)
+	}
+
+	b.updateReport(&breachInfo.chanPoint, func(r *breachReport) {
+		r.outputs[idx].state = state
+		r.outputs[idx].spendTxid = spendTxid
+	})
+
+	select {
+	case results <- state:
+	case <-b.quit:
+	}
+}
+
+// sweepRevokedOutputUntilFinal sweeps the revoked output at the given index of
+// the retribution, and returns its final state along with the hash of the
+// transaction that spent it. If we're unable to wait for the next block or
+// the spend of the output, justiceFailed is returned along with the error.
+func (b *breachArbiter) sweepRevokedOutputUntilFinal(
+	breachInfo *retributionInfo, idx int,
+	breachConfHeight uint32) (justiceState, chainhash.Hash, error) {
+
+	chanPoint := &breachInfo.chanPoint
+
+	// We'll work on a copy of the output, as it's modified once it's
+	// spent to the second level.
+	bo := breachInfo.breachedOutputs[idx]
+	group := justiceSweepGroup(chanPoint, bo.witnessType)
+	confHeight := breachConfHeight
+
+	setState := func(state justiceState) {
+		b.updateReport(chanPoint, func(r *breachReport) {
+			r.outputs[idx].state = state
+		})
+	}
+
+	var failures int
+	for {
+		// The remote party is able to spend the output itself once its
+		// CSV delay expired, so our sweep should confirm the block
+		// before. The sweeper raises the fee rate every block to meet
+		// this deadline. Our own output can only be spent by us, so
+		// there's no need to hurry.
+		var (
+			deadline uint32
+			feePref  sweep.FeePreference
+		)
+		switch {
+		case bo.witnessType == input.CommitmentNoDelay ||
+			bo.witnessType == input.CommitSpendNoDelayTweakless:
+
+			feePref.ConfTarget = ownOutputConfTarget
+
+		// The remote party is able to spend an HTLC output on the
+		// commitment to the second level right away, so we'll sweep
+		// it with the most aggressive fee rate.
+		case bo.witnessType == input.HtlcAcceptedRevoke ||
+			bo.witnessType == input.HtlcOfferedRevoke:
+
+			deadline = confHeight + 1
+			feePref.ConfTarget = justiceConfTarget
+			feePref.DeadlineHeight = int32(deadline)
+
+		default:
+			if breachInfo.remoteDelay > 0 {
+				deadline = confHeight + breachInfo.remoteDelay - 1
 			}
-			spendNtfns[breachedOutput.outpoint] = spendNtfn
+			feePref.ConfTarget = justiceConfTarget
+			feePref.DeadlineHeight = int32(deadline)
 		}
 
-		// Launch a goroutine waiting for a spend event.
-		b.wg.Add(1)
-		wg.Add(1)
-		go func(index int, spendEv *chainntnfs.SpendEvent) {
-			defer b.wg.Done()
-			defer wg.Done()
+		b.updateReport(chanPoint, func(r *breachReport) {
+			r.outputs[idx].outpoint = bo.outpoint
+			r.outputs[idx].amount = bo.amt
+			r.outputs[idx].deadlineHeight = deadline
+		})
+
+		brarLog.Infof("Sweeping revoked %v(%v) for ChannelPoint(%v) "+
+			"with fee_preference=%v", bo.witnessType, bo.outpoint,
+			chanPoint, feePref)
 
+		// The sweeper gets its own copy of the output, so we're free
+		// to modify ours.
+		inp := bo
+		var result sweep.Result
+		resultChan, err := b.cfg.SweepInput(&inp, feePref, group)
+		if err == nil {
 			select {
-			// The output has been taken to the second level!
-			case sp, ok := <-spendEv.Spend:
-				if !ok {
-					return
-				}
-
-				brarLog.Infof("Detected spend on %s(%v) by "+
-					"txid(%v) for ChannelPoint(%v)",
-					inputs[index].witnessType,
-					inputs[index].outpoint,
-					sp.SpenderTxHash,
-					breachInfo.chanPoint)
-
-				// First we send the spend event on the
-				// allSpends channel, such that it can be
-				// handled after all go routines have exited.
-				allSpends <- spend{index, sp}
-
-				// Finally we'll signal the anySpend channel
-				// that a spend was detected, such that the
-				// other goroutines can be shut down.
-				anySpend <- struct{}{}
-			case <-exit:
-				return
+			case result = <-resultChan:
 			case <-b.quit:
-				return
+				return justiceFailed, chainhash.Hash{},
+					errBrarShuttingDown
 			}
-		}(i, spendNtfn)
-	}
+		} else {
+			result.Err = err
+		}
 
-	// We'll wait for any of the outputs to be spent, or that we are
-	// signalled to exit.
-	select {
-	// A goroutine have signalled that a spend occurred.
-	case <-anySpend:
-		// Signal for the remaining goroutines to exit.
-		close(exit)
-		wg.Wait()
-
-		// At this point all goroutines that can send on the allSpends
-		// channel have exited. We can therefore safely close the
-		// channel before ranging over its content.
-		close(allSpends)
-
-		doneOutputs := make(map[int]struct{})
-		for s := range allSpends {
-			breachedOutput := &inputs[s.index]
-			delete(spendNtfns, breachedOutput.outpoint)
-
-			switch breachedOutput.witnessType {
-			case input.HtlcAcceptedRevoke:
-				fallthrough
-			case input.HtlcOfferedRevoke:
-				brarLog.Infof("Spend on second-level"+
-					"%s(%v) for ChannelPoint(%v) "+
-					"transitions to second-level output",
-					breachedOutput.witnessType,
-					breachedOutput.outpoint,
-					breachInfo.chanPoint)
-
-				// In this case we'll morph our initial revoke
-				// spend to instead point to the second level
-				// output, and update the sign descriptor in the
-				// process.
-				convertToSecondLevelRevoke(
-					breachedOutput, breachInfo, s.detail,
-				)
-
-				continue
+		switch {
+		case result.Err == nil:
+			return justiceSwept, result.Tx.TxHash(), nil
+
+		case result.Err == sweep.ErrSweeperShuttingDown:
+			return justiceFailed, chainhash.Hash{},
+				errBrarShuttingDown
+
+		// The sweeper gave up after publishing the sweep for too many
+		// blocks, but the output might still be ours to take.
+		case result.Err == sweep.ErrTooManyAttempts:
+			brarLog.Warnf("Sweep of revoked %v for ChannelPoint(%v) "+
+				"exceeded max attempts, offering it again",
+				bo.outpoint, chanPoint)
+
+			if err := b.waitForNextBlock(); err != nil {
+				return justiceFailed, chainhash.Hash{}, err
 			}
+			continue
 
-			brarLog.Infof("Spend on %s(%v) for ChannelPoint(%v) "+
-				"transitions output to terminal state, "+
-				"removing input from justice transaction",
-				breachedOutput.witnessType,
-				breachedOutput.outpoint, breachInfo.chanPoint)
+		// We'll keep offering the output every block until it has
+		// been spent, as the remote party is able to take it once its
+		// time lock expired. After a number of failures, the output is
+		// reported as failed in the meantime.
+		case result.Err != sweep.ErrRemoteSpend:
+			failures++
 
-			doneOutputs[s.index] = struct{}{}
-		}
+			brarLog.Errorf("Unable to sweep revoked %v for "+
+				"ChannelPoint(%v), retrying next block "+
+				"(failures=%v): %v", bo.outpoint, chanPoint,
+				failures, result.Err)
+
+			if failures >= maxJusticeSweepFailures {
+				setState(justiceFailed)
+			}
+
+			if err := b.waitForNextBlock(); err != nil {
+				return justiceFailed, chainhash.Hash{}, err
+			}
+			continue
+
+		case isJusticeSpend(&bo, result.Tx):
+			return justiceSwept, result.Tx.TxHash(), nil
 
-		// Filter the inputs for which we can no longer proceed.
-		var nextIndex int
-		for i := range inputs {
-			if _, ok := doneOutputs[i]; ok {
-				continue
+		// The remote party spent an HTLC output to the second level,
+		// whose output we can claim through the revocation clause as
+		// well.
+		case bo.witnessType == input.HtlcAcceptedRevoke ||
+			bo.witnessType == input.HtlcOfferedRevoke:
+
+			spend, err := b.waitForSpend(&bo, breachInfo)
+			if err != nil {
+				return justiceFailed, chainhash.Hash{}, err
 			}
 
-			inputs[nextIndex] = inputs[i]
-			nextIndex++
+			// The output is shared with the retribution, so
+			// we'll give the second level output its own copy.
+			txOut := *bo.signDesc.Output
+			bo.signDesc.Output = &txOut
+
+			convertToSecondLevelRevoke(&bo, breachInfo, spend)
+			bo.confHeight = uint32(spend.SpendingHeight)
+			confHeight = bo.confHeight
+			failures = 0
+
+			setState(justiceSecondLevel)
+			continue
+
+		default:
+			brarLog.Warnf("Revoked %v(%v) for ChannelPoint(%v) was "+
+				"spent by the remote party in tx=%v",
+				bo.witnessType, bo.outpoint, chanPoint,
+				result.Tx.TxHash())
+
+			return justiceLost, result.Tx.TxHash(), nil
 		}
+	}
+}
 
-		// Update our remaining set of outputs before continuing with
-		// another attempt at publication.
-		breachInfo.breachedOutputs = inputs[:nextIndex]
+// waitForNextBlock blocks until the next block has been connected.
+func (b *breachArbiter) waitForNextBlock() error {
+	blockEpochs, err := b.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+	defer blockEpochs.Cancel()
 
-	case <-b.quit:
-		return errBrarShuttingDown
+	// The current best block is delivered right away, so we'll wait for
+	// the one after it.
+	for i := 0; i < 2; i++ {
+		select {
+		case _, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return errBrarShuttingDown
+			}
+
+		case <-b.quit:
+			return errBrarShuttingDown
+		}
 	}
 
 	return nil
 }
 
+// waitForSpend waits for the confirmed spend of the given breached output,
+// which has already been detected by the sweeper.
+func (b *breachArbiter) waitForSpend(bo *breachedOutput,
+	breachInfo *retributionInfo) (*chainntnfs.SpendDetail, error) {
+
+	spendNtfn, err := b.cfg.Notifier.RegisterSpendNtfn(
+		&bo.outpoint, bo.signDesc.Output.PkScript,
+		breachInfo.breachHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer spendNtfn.Cancel()
+
+	select {
+	case spend, ok := <-spendNtfn.Spend:
+		if !ok {
+			return nil, errBrarShuttingDown
+		}
+		return spend, nil
+
+	case <-b.quit:
+		return nil, errBrarShuttingDown
+	}
+}
+
 // exactRetribution is a goroutine which is executed once a contract breach has
 // been detected by a breachObserver. This function is responsible for
 // punishing a counterparty for violating the channel contract by sweeping ALL
@@ -501,7 +803,8 @@ func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
 
 	defer b.wg.Done()
 
-	// TODO(roasbeef): state needs to be checkpointed here
+	b.trackBreach(breachInfo)
+
 	var breachConfHeight uint32
 	select {
 	case breachConf, ok := <-confChan.Confirmed:
@@ -522,156 +825,82 @@ func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
 	brarLog.Debugf("Breach transaction %v has been confirmed, sweeping "+
 		"revoked funds", breachInfo.commitHash)
 
-	// We may have to wait for some of the HTLC outputs to be spent to the
-	// second level before broadcasting the justice tx. We'll store the
-	// SpendEvents between each attempt to not re-register uneccessarily.
-	spendNtfns := make(map[wire.OutPoint]*chainntnfs.SpendEvent)
-
-	finalTx, err := b.cfg.Store.GetFinalizedTxn(&breachInfo.chanPoint)
-	if err != nil {
-		brarLog.Errorf("Unable to get finalized txn for"+
-			"chanid=%v: %v", &breachInfo.chanPoint, err)
-		return
-	}
-
-	// If this retribution has not been finalized before, we will first
-	// construct a sweep transaction and write it to disk. This will allow
-	// the breach arbiter to re-register for notifications for the justice
-	// txid.
-justiceTxBroadcast:
-	if finalTx == nil {
-		// With the breach transaction confirmed, we now create the
-		// justice tx which will claim ALL the funds within the
-		// channel.
-		finalTx, err = b.createJusticeTx(breachInfo)
-		if err != nil {
-			brarLog.Errorf("Unable to create justice tx: %v", err)
-			return
-		}
+	b.updateReport(&breachInfo.chanPoint, func(r *breachReport) {
+		r.confHeight = breachConfHeight
+	})
 
-		// Persist our finalized justice transaction before making an
-		// attempt to broadcast.
-		err := b.cfg.Store.Finalize(&breachInfo.chanPoint, finalTx)
-		if err != nil {
-			brarLog.Errorf("Unable to finalize justice tx for "+
-				"chanid=%v: %v", &breachInfo.chanPoint, err)
-			return
-		}
+	// We'll hand each of the revoked outputs to the sweeper separately,
+	// so an output that is contested by the remote party doesn't hold up
+	// the others.
+	results := make(chan justiceState, len(breachInfo.breachedOutputs))
+	for i := range breachInfo.breachedOutputs {
+		b.wg.Add(1)
+		go b.sweepRevokedOutput(
+			breachInfo, i, breachConfHeight, results,
+		)
 	}
 
-	brarLog.Debugf("Broadcasting justice tx: %v", newLogClosure(func() string {
-		return spew.Sdump(finalTx)
-	}))
-
-	// We'll now attempt to broadcast the transaction which finalized the
-	// channel's retribution against the cheating counter party.
-	err = b.cfg.PublishTransaction(finalTx)
-	if err != nil {
-		brarLog.Errorf("Unable to broadcast justice tx: %v", err)
-
-		if err == lnwallet.ErrDoubleSpend {
-			// Broadcasting the transaction failed because of a
-			// conflict either in the mempool or in chain. We'll
-			// now create spend subscriptions for all HTLC outputs
-			// on the commitment transaction that could possibly
-			// have been spent, and wait for any of them to
-			// trigger.
-			brarLog.Infof("Waiting for a spend event before " +
-				"attempting to craft new justice tx.")
-			finalTx = nil
-
-			err := b.waitForSpendEvent(breachInfo, spendNtfns)
-			if err != nil {
-				if err != errBrarShuttingDown {
-					brarLog.Errorf("error waiting for "+
-						"spend event: %v", err)
-				}
-				return
-			}
-
-			if len(breachInfo.breachedOutputs) == 0 {
-				brarLog.Debugf("No more outputs to sweep for "+
-					"breach, marking ChannelPoint(%v) "+
-					"fully resolved", breachInfo.chanPoint)
-
-				err = b.cleanupBreach(&breachInfo.chanPoint)
-				if err != nil {
-					brarLog.Errorf("Failed to cleanup "+
-						"breached ChannelPoint(%v): %v",
-						breachInfo.chanPoint, err)
-				}
-				return
+	var failed bool
+	for range breachInfo.breachedOutputs {
+		select {
+		case state := <-results:
+			if state == justiceFailed {
+				failed = true
 			}
-
-			brarLog.Infof("Attempting another justice tx "+
-				"with %d inputs",
-				len(breachInfo.breachedOutputs))
-
-			goto justiceTxBroadcast
+		case <-b.quit:
+			return
 		}
 	}
 
-	// As a conclusionary step, we register for a notification to be
-	// dispatched once the justice tx is confirmed. After confirmation we
-	// notify the caller that initiated the retribution workflow that the
-	// deed has been done.
-	justiceTXID := finalTx.TxHash()
-	justiceScript := finalTx.TxOut[0].PkScript
-	confChan, err = b.cfg.Notifier.RegisterConfirmationsNtfn(
-		&justiceTXID, justiceScript, 1, breachConfHeight,
-	)
-	if err != nil {
-		brarLog.Errorf("Unable to register for conf for txid(%v): %v",
-			justiceTXID, err)
+	// If any of the outputs couldn't be swept, we'll keep the retribution
+	// around, so the sweep is retried once we're restarted.
+	if failed {
+		brarLog.Errorf("Unable to sweep all revoked outputs for "+
+			"ChannelPoint(%v), retrying on restart",
+			breachInfo.chanPoint)
 		return
 	}
 
-	select {
-	case _, ok := <-confChan.Confirmed:
-		if !ok {
-			return
+	// Compute both the total value of funds being swept and the amount of
+	// funds that were revoked from the counter party.
+	var totalFunds, revokedFunds btcutil.Amount
+	report := b.breachReport(&breachInfo.chanPoint)
+	for _, output := range report.outputs {
+		if output.state != justiceSwept {
+			continue
 		}
-
-		// Compute both the total value of funds being swept and the
-		// amount of funds that were revoked from the counter party.
-		var totalFunds, revokedFunds btcutil.Amount
-		for _, inp := range breachInfo.breachedOutputs {
-			totalFunds += inp.Amount()
-
-			// If the output being revoked is the remote commitment
-			// output or an offered HTLC output, it's amount
-			// contributes to the value of funds being revoked from
-			// the counter party.
-			switch inp.WitnessType() {
-			case input.CommitmentRevoke:
-				revokedFunds += inp.Amount()
-			case input.HtlcOfferedRevoke:
-				revokedFunds += inp.Amount()
-			default:
-			}
+		totalFunds += output.amount
+
+		// If the output being revoked is the remote commitment output
+		// or an offered HTLC output, it's amount contributes to the
+		// value of funds being revoked from the counter party.
+		switch output.witnessType {
+		case input.CommitmentRevoke:
+			revokedFunds += output.amount
+		case input.HtlcOfferedRevoke:
+			revokedFunds += output.amount
 		}
+	}
 
-		brarLog.Infof("Justice for ChannelPoint(%v) has "+
-			"been served, %v revoked funds (%v total) "+
-			"have been claimed", breachInfo.chanPoint,
-			revokedFunds, totalFunds)
+	brarLog.Infof("Justice for ChannelPoint(%v) has been served, %v "+
+		"revoked funds (%v total) have been claimed",
+		breachInfo.chanPoint, revokedFunds, totalFunds)
 
-		err = b.cleanupBreach(&breachInfo.chanPoint)
-		if err != nil {
-			brarLog.Errorf("Failed to cleanup breached "+
-				"ChannelPoint(%v): %v", breachInfo.chanPoint,
-				err)
-		}
+	err := b.cleanupBreach(&breachInfo.chanPoint)
+	if err != nil {
+		brarLog.Errorf("Failed to cleanup breached ChannelPoint(%v): %v",
+			breachInfo.chanPoint, err)
+		return
+	}
 
-		// TODO(roasbeef): add peer to blacklist?
+	b.reportMtx.Lock()
+	delete(b.reports, breachInfo.chanPoint)
+	b.reportMtx.Unlock()
 
-		// TODO(roasbeef): close other active channels with offending
-		// peer
+	// TODO(roasbeef): add peer to blacklist?
 
-		return
-	case <-b.quit:
-		return
-	}
+	// TODO(roasbeef): close other active channels with offending
+	// peer
 }
 
 // cleanupBreach marks the given channel point as fully resolved and removes the
@@ -910,15 +1139,19 @@ var _ input.Input = (*breachedOutput)(nil)
 
 // retributionInfo encapsulates all the data needed to sweep all the contested
 // funds within a channel whose contract has been breached by the prior
-// counterparty. This struct is used to create the justice transaction which
-// spends all outputs of the commitment transaction into an output controlled
-// by the wallet.
+// counterparty. Each of the breached outputs is offered to the sweeper, which
+// spends it into an output controlled by the wallet.
 type retributionInfo struct {
 	commitHash   chainhash.Hash
 	chanPoint    wire.OutPoint
 	chainHash    chainhash.Hash
 	breachHeight uint32
 
+	// remoteDelay is the CSV delay of the remote party's commitment
+	// output, after which it is able to spend it itself. It's zero for
+	// retributions stored before it was recorded.
+	remoteDelay uint32
+
 	breachedOutputs []breachedOutput
 }
 
@@ -1009,161 +1242,15 @@ func newRetributionInfo(chanPoint *wire.OutPoint,
 	}
 
 	return &retributionInfo{
-		commitHash:      breachInfo.BreachTransaction.TxHash(),
+		commitHash:      breachInfo.BreachTxHash,
 		chainHash:       breachInfo.ChainHash,
 		chanPoint:       *chanPoint,
 		breachedOutputs: breachedOutputs,
 		breachHeight:    breachInfo.BreachHeight,
+		remoteDelay:     breachInfo.RemoteDelay,
 	}
 }
 
-// createJusticeTx creates a transaction which exacts "justice" by sweeping ALL
-// the funds within the channel which we are now entitled to due to a breach of
-// the channel's contract by the counterparty. This function returns a *fully*
-// signed transaction with the witness for each input fully in place.
-func (b *breachArbiter) createJusticeTx(
-	r *retributionInfo) (*wire.MsgTx, error) {
-
-	// We will assemble the breached outputs into a slice of spendable
-	// outputs, while simultaneously computing the estimated weight of the
-	// transaction.
-	var (
-		spendableOutputs []input.Input
-		weightEstimate   input.TxWeightEstimator
-	)
-
-	// Allocate enough space to potentially hold each of the breached
-	// outputs in the retribution info.
-	spendableOutputs = make([]input.Input, 0, len(r.breachedOutputs))
-
-	// The justice transaction we construct will be a segwit transaction
-	// that pays to a p2wkh output. Components such as the version,
-	// nLockTime, and output are already included in the TxWeightEstimator.
-	weightEstimate.AddP2WKHOutput()
-
-	// Next, we iterate over the breached outputs contained in the
-	// retribution info.  For each, we switch over the witness type such
-	// that we contribute the appropriate weight for each input and witness,
-	// finally adding to our list of spendable outputs.
-	for i := range r.breachedOutputs {
-		// Grab locally scoped reference to breached output.
-		inp := &r.breachedOutputs[i]
-
-		// First, determine the appropriate estimated witness weight for
-		// the give witness type of this breached output. If the witness
-		// weight cannot be estimated, we will omit it from the
-		// transaction.
-		witnessWeight, _, err := inp.WitnessType().SizeUpperBound()
-		if err != nil {
-			brarLog.Warnf("could not determine witness weight "+
-				"for breached output in retribution info: %v",
-				err)
-			continue
-		}
-		weightEstimate.AddWitnessInput(witnessWeight)
-
-		// Finally, append this input to our list of spendable outputs.
-		spendableOutputs = append(spendableOutputs, inp)
-	}
-
-	txWeight := int64(weightEstimate.Weight())
-	return b.sweepSpendableOutputsTxn(txWeight, spendableOutputs...)
-}
-
-// sweepSpendableOutputsTxn creates a signed transaction from a sequence of
-// spendable outputs by sweeping the funds into a single p2wkh output.
-func (b *breachArbiter) sweepSpendableOutputsTxn(txWeight int64,
-	inputs ...input.Input) (*wire.MsgTx, error) {
-
-	// First, we obtain a new public key script from the wallet which we'll
-	// sweep the funds to.
-	// TODO(roasbeef): possibly create many outputs to minimize change in
-	// the future?
-	pkScript, err := b.cfg.GenSweepScript()
-	if err != nil {
-		return nil, err
-	}
-
-	// Compute the total amount contained in the inputs.
-	var totalAmt btcutil.Amount
-	for _, input := range inputs {
-		totalAmt += btcutil.Amount(input.SignDesc().Output.Value)
-	}
-
-	// We'll actually attempt to target inclusion within the next two
-	// blocks as we'd like to sweep these funds back into our wallet ASAP.
-	feePerKw, err := b.cfg.Estimator.EstimateFeePerKW(2)
-	if err != nil {
-		return nil, err
-	}
-	txFee := feePerKw.FeeForWeight(txWeight)
-
-	// TODO(roasbeef): already start to siphon their funds into fees
-	sweepAmt := int64(totalAmt - txFee)
-
-	// With the fee calculated, we can now create the transaction using the
-	// information gathered above and the provided retribution information.
-	txn := wire.NewMsgTx(2)
-
-	// We begin by adding the output to which our funds will be deposited.
-	txn.AddTxOut(&wire.TxOut{
-		PkScript: pkScript,
-		Value:    sweepAmt,
-	})
-
-	// Next, we add all of the spendable outputs as inputs to the
-	// transaction.
-	for _, input := range inputs {
-		txn.AddTxIn(&wire.TxIn{
-			PreviousOutPoint: *input.OutPoint(),
-		})
-	}
-
-	// Before signing the transaction, check to ensure that it meets some
-	// basic validity requirements.
-	btx := btcutil.NewTx(txn)
-	if err := blockchain.CheckTransactionSanity(btx); err != nil {
-		return nil, err
-	}
-
-	// Create a sighash cache to improve the performance of hashing and
-	// signing SigHashAll inputs.
-	hashCache := txscript.NewTxSigHashes(txn)
-
-	// Create a closure that encapsulates the process of initializing a
-	// particular output's witness generation function, computing the
-	// witness, and attaching it to the transaction. This function accepts
-	// an integer index representing the intended txin index, and the
-	// breached output from which it will spend.
-	addWitness := func(idx int, so input.Input) error {
-		// First, we construct a valid witness for this outpoint and
-		// transaction using the SpendableOutput's witness generation
-		// function.
-		inputScript, err := so.CraftInputScript(
-			b.cfg.Signer, txn, hashCache, idx,
-		)
-		if err != nil {
-			return err
-		}
-
-		// Then, we add the witness to the transaction at the
-		// appropriate txin index.
-		txn.TxIn[idx].Witness = inputScript.Witness
-
-		return nil
-	}
-
-	// Finally, generate a witness for each output and attach it to the
-	// transaction.
-	for i, input := range inputs {
-		if err := addWitness(i, input); err != nil {
-			return nil, err
-		}
-	}
-
-	return txn, nil
-}
-
 // RetributionStore provides an interface for managing a persistent map from
 // wire.OutPoint -> retributionInfo. Upon learning of a breach, a BreachArbiter
 // should record the retributionInfo for the breached channel, which serves a
@@ -1185,15 +1272,6 @@ type RetributionStore interface {
 	// is aware of any breaches for the provided channel point.
 	IsBreached(chanPoint *wire.OutPoint) (bool, error)
 
-	// Finalize persists the finalized justice transaction for a particular
-	// channel.
-	Finalize(chanPoint *wire.OutPoint, finalTx *wire.MsgTx) error
-
-	// GetFinalizedTxn loads the finalized justice transaction, if any, from
-	// the retribution store. The finalized transaction will be nil if
-	// Finalize has not yet been called for this channel point.
-	GetFinalizedTxn(chanPoint *wire.OutPoint) (*wire.MsgTx, error)
-
 	// Remove deletes the retributionInfo from disk, if any exists, under
 	// the given key. An error should be re raised if the removal fails.
 	Remove(key *wire.OutPoint) error
@@ -1244,66 +1322,6 @@ func (rs *retributionStore) Add(ret *retributionInfo) error {
 	})
 }
 
-// Finalize writes a signed justice transaction to the retribution store. This
-// is done before publishing the transaction, so that we can recover the txid on
-// startup and re-register for confirmation notifications.
-func (rs *retributionStore) Finalize(chanPoint *wire.OutPoint,
-	finalTx *wire.MsgTx) error {
-	return rs.db.Update(func(tx *bbolt.Tx) error {
-		justiceBkt, err := tx.CreateBucketIfNotExists(justiceTxnBucket)
-		if err != nil {
-			return err
-		}
-
-		var chanBuf bytes.Buffer
-		if err := writeOutpoint(&chanBuf, chanPoint); err != nil {
-			return err
-		}
-
-		var txBuf bytes.Buffer
-		if err := finalTx.Serialize(&txBuf); err != nil {
-			return err
-		}
-
-		return justiceBkt.Put(chanBuf.Bytes(), txBuf.Bytes())
-	})
-}
-
-// GetFinalizedTxn loads the finalized justice transaction for the provided
-// channel point. The finalized transaction will be nil if Finalize has yet to
-// be called for this channel point.
-func (rs *retributionStore) GetFinalizedTxn(
-	chanPoint *wire.OutPoint) (*wire.MsgTx, error) {
-
-	var finalTxBytes []byte
-	if err := rs.db.View(func(tx *bbolt.Tx) error {
-		justiceBkt := tx.Bucket(justiceTxnBucket)
-		if justiceBkt == nil {
-			return nil
-		}
-
-		var chanBuf bytes.Buffer
-		if err := writeOutpoint(&chanBuf, chanPoint); err != nil {
-			return err
-		}
-
-		finalTxBytes = justiceBkt.Get(chanBuf.Bytes())
-
-		return nil
-	}); err != nil {
-		return nil, err
-	}
-
-	if finalTxBytes == nil {
-		return nil, nil
-	}
-
-	finalTx := &wire.MsgTx{}
-	err := finalTx.Deserialize(bytes.NewReader(finalTxBytes))
-
-	return finalTx, err
-}
-
 // IsBreached queries the retribution store to discern if this channel was
 // previously breached. This is used when connecting to a peer to determine if
 // it is safe to add a link to the htlcswitch, as we should never add a channel
@@ -1332,8 +1350,8 @@ func (rs *retributionStore) IsBreached(chanPoint *wire.OutPoint) (bool, error) {
 	return found, err
 }
 
-// Remove removes a retribution state and finalized justice transaction by
-// channel point  from the retribution store.
+// Remove removes a retribution state, and any legacy finalized justice
+// transaction, by channel point from the retribution store.
 func (rs *retributionStore) Remove(chanPoint *wire.OutPoint) error {
 	return rs.db.Update(func(tx *bbolt.Tx) error {
 		retBucket := tx.Bucket(retributionBucket)
@@ -1354,13 +1372,13 @@ func (rs *retributionStore) Remove(chanPoint *wire.OutPoint) error {
 		}
 		chanBytes := chanBuf.Bytes()
 
-		// Remove the persisted retribution info and finalized justice
-		// transaction.
+		// Remove the persisted retribution info and any finalized
+		// justice transaction left by an earlier version.
 		if err := retBucket.Delete(chanBytes); err != nil {
 			return err
 		}
 
-		// If we have not finalized this channel breach, we can exit
+		// If no justice transaction was ever finalized, we can exit
 		// early.
 		justiceBkt := tx.Bucket(justiceTxnBucket)
 		if justiceBkt == nil {
@@ -1429,6 +1447,11 @@ func (ret *retributionInfo) Encode(w io.Writer) error {
 		}
 	}
 
+	binary.BigEndian.PutUint32(scratch[:], ret.remoteDelay)
+	if _, err := w.Write(scratch[:]); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1476,6 +1499,16 @@ func (ret *retributionInfo) Decode(r io.Reader) error {
 		}
 	}
 
+	// Retributions stored before the remote delay was recorded end here.
+	_, err = io.ReadFull(r, scratch[:4])
+	switch {
+	case err == io.EOF:
+		return nil
+	case err != nil:
+		return err
+	}
+	ret.remoteDelay = binary.BigEndian.Uint32(scratch[:4])
+
 	return nil
 }
 
@@ -1542,3 +1575,37 @@ func (bo *breachedOutput) Decode(r io.Reader) error {
 
 	return nil
 }
+
+// TODO(bvu): copied from channeldb, remove repetition
+func writeOutpoint(w io.Writer, o *wire.OutPoint) error {
+	// TODO(roasbeef): make all scratch buffers on the stack
+	scratch := make([]byte, 4)
+
+	// TODO(roasbeef): write raw 32 bytes instead of wasting the extra
+	// byte.
+	if err := wire.WriteVarBytes(w, 0, o.Hash[:]); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint32(scratch, o.Index)
+	_, err := w.Write(scratch)
+	return err
+}
+
+// TODO(bvu): copied from channeldb, remove repetition
+func readOutpoint(r io.Reader, o *wire.OutPoint) error {
+	scratch := make([]byte, 4)
+
+	txid, err := wire.ReadVarBytes(r, 0, 32, "prevout")
+	if err != nil {
+		return err
+	}
+	copy(o.Hash[:], txid)
+
+	if _, err := r.Read(scratch); err != nil {
+		return err
+	}
+	o.Index = byteOrder.Uint32(scratch)
+
+	return nil
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/shachain"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 var (
@@ -285,6 +286,7 @@ var (
 			},
 			chanPoint:    breachOutPoints[0],
 			breachHeight: 337,
+			remoteDelay:  144,
 			// Set to breachedOutputs 0 and 1 in init()
 			breachedOutputs: []breachedOutput{{}, {}},
 		},
@@ -404,24 +406,6 @@ func (frs *failingRetributionStore) IsBreached(chanPoint *wire.OutPoint) (bool,
 	return frs.rs.IsBreached(chanPoint)
 }
 
-func (frs *failingRetributionStore) Finalize(chanPoint *wire.OutPoint,
-	finalTx *wire.MsgTx) error {
-
-	frs.mu.Lock()
-	defer frs.mu.Unlock()
-
-	return frs.rs.Finalize(chanPoint, finalTx)
-}
-
-func (frs *failingRetributionStore) GetFinalizedTxn(
-	chanPoint *wire.OutPoint) (*wire.MsgTx, error) {
-
-	frs.mu.Lock()
-	defer frs.mu.Unlock()
-
-	return frs.rs.GetFinalizedTxn(chanPoint)
-}
-
 func (frs *failingRetributionStore) Remove(key *wire.OutPoint) error {
 	frs.mu.Lock()
 	defer frs.mu.Unlock()
@@ -518,6 +502,7 @@ func copyRetInfo(retInfo *retributionInfo) *retributionInfo {
 		chainHash:       retInfo.chainHash,
 		chanPoint:       retInfo.chanPoint,
 		breachHeight:    retInfo.breachHeight,
+		remoteDelay:     retInfo.remoteDelay,
 		breachedOutputs: make([]breachedOutput, nOutputs),
 	}
 
@@ -532,16 +517,14 @@ func copyRetInfo(retInfo *retributionInfo) *retributionInfo {
 // by an in-memory map. Access to the internal state is provided by a mutex.
 // TODO(cfromknecht) extend to support and test controlled failures.
 type mockRetributionStore struct {
-	mu       sync.Mutex
-	state    map[wire.OutPoint]*retributionInfo
-	finalTxs map[wire.OutPoint]*wire.MsgTx
+	mu    sync.Mutex
+	state map[wire.OutPoint]*retributionInfo
 }
 
 func newMockRetributionStore() *mockRetributionStore {
 	return &mockRetributionStore{
-		mu:       sync.Mutex{},
-		state:    make(map[wire.OutPoint]*retributionInfo),
-		finalTxs: make(map[wire.OutPoint]*wire.MsgTx),
+		mu:    sync.Mutex{},
+		state: make(map[wire.OutPoint]*retributionInfo),
 	}
 }
 
@@ -561,30 +544,9 @@ func (rs *mockRetributionStore) IsBreached(chanPoint *wire.OutPoint) (bool, erro
 	return ok, nil
 }
 
-func (rs *mockRetributionStore) Finalize(chanPoint *wire.OutPoint,
-	finalTx *wire.MsgTx) error {
-
-	rs.mu.Lock()
-	rs.finalTxs[*chanPoint] = finalTx
-	rs.mu.Unlock()
-
-	return nil
-}
-
-func (rs *mockRetributionStore) GetFinalizedTxn(
-	chanPoint *wire.OutPoint) (*wire.MsgTx, error) {
-
-	rs.mu.Lock()
-	finalTx := rs.finalTxs[*chanPoint]
-	rs.mu.Unlock()
-
-	return finalTx, nil
-}
-
 func (rs *mockRetributionStore) Remove(key *wire.OutPoint) error {
 	rs.mu.Lock()
 	delete(rs.state, *key)
-	delete(rs.finalTxs, *key)
 	rs.mu.Unlock()
 
 	return nil
@@ -1052,6 +1014,7 @@ func TestBreachHandoffSuccess(t *testing.T) {
 		ProcessACK: make(chan error, 1),
 		BreachRetribution: &lnwallet.BreachRetribution{
 			BreachTransaction: bobClose.CloseTx,
+			BreachTxHash:      bobClose.CloseTx.TxHash(),
 			LocalOutputSignDesc: &input.SignDescriptor{
 				Output: &wire.TxOut{
 					PkScript: breachKeys[0],
@@ -1084,6 +1047,7 @@ func TestBreachHandoffSuccess(t *testing.T) {
 		ProcessACK: make(chan error, 1),
 		BreachRetribution: &lnwallet.BreachRetribution{
 			BreachTransaction: bobClose.CloseTx,
+			BreachTxHash:      bobClose.CloseTx.TxHash(),
 			LocalOutputSignDesc: &input.SignDescriptor{
 				Output: &wire.TxOut{
 					PkScript: breachKeys[0],
@@ -1133,6 +1097,7 @@ func TestBreachHandoffFail(t *testing.T) {
 		ProcessACK: make(chan error, 1),
 		BreachRetribution: &lnwallet.BreachRetribution{
 			BreachTransaction: bobClose.CloseTx,
+			BreachTxHash:      bobClose.CloseTx.TxHash(),
 			LocalOutputSignDesc: &input.SignDescriptor{
 				Output: &wire.TxOut{
 					PkScript: breachKeys[0],
@@ -1173,6 +1138,7 @@ func TestBreachHandoffFail(t *testing.T) {
 		ProcessACK: make(chan error, 1),
 		BreachRetribution: &lnwallet.BreachRetribution{
 			BreachTransaction: bobClose.CloseTx,
+			BreachTxHash:      bobClose.CloseTx.TxHash(),
 			LocalOutputSignDesc: &input.SignDescriptor{
 				Output: &wire.TxOut{
 					PkScript: breachKeys[0],
@@ -1198,28 +1164,34 @@ func TestBreachHandoffFail(t *testing.T) {
 	assertArbiterBreach(t, brar, chanPoint)
 }
 
-type publAssertion func(*testing.T, map[wire.OutPoint]*wire.MsgTx,
-	chan *wire.MsgTx)
+// sweepOffer records an input offered to the mock sweeper, along with the
+// channel its result is delivered on.
+type sweepOffer struct {
+	input   input.Input
+	feePref sweep.FeePreference
+	group   uint64
+	result  chan sweep.Result
+}
 
 type breachTest struct {
 	name string
 
-	// spend2ndLevel requests that second level htlcs be spent *again*, as
-	// if by a remote party or watchtower. The outpoint of the second level
-	// htlc is in effect "readded" to the set of inputs.
+	// spend2ndLevel requests that the remote party spends the htlc output
+	// to the second level before we're able to sweep it.
 	spend2ndLevel bool
 
-	// sendFinalConf informs the test to send a confirmation for the justice
-	// transaction before asserting the arbiter is cleaned up.
-	sendFinalConf bool
+	// lose2ndLevel requests that the remote party also manages to sweep
+	// the second level htlc output.
+	lose2ndLevel bool
 
-	// whenNonZeroInputs is called after spending an input but there are
-	// further inputs to spend in the test.
-	whenNonZeroInputs publAssertion
+	// tooManyAttempts requests that the sweeper gives up on the htlc
+	// output once, after which it should be offered again.
+	tooManyAttempts bool
 
-	// whenZeroInputs is called after spending an input but there are no
-	// further inputs to spend in the test.
-	whenZeroInputs publAssertion
+	// failHtlcSweep requests that the sweep of the htlc output fails
+	// repeatedly, after which it should be reported as failed, but still
+	// be offered every block until it's swept.
+	failHtlcSweep bool
 }
 
 var (
@@ -1246,104 +1218,31 @@ var (
 
 var breachTests = []breachTest{
 	{
-		name:          "all spends",
+		name: "all swept",
+	},
+	{
+		name:          "second level sweep",
 		spend2ndLevel: true,
-		whenNonZeroInputs: func(t *testing.T,
-			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
-
-			var tx *wire.MsgTx
-			select {
-			case tx = <-publTx:
-			case <-time.After(5 * time.Second):
-				t.Fatalf("tx was not published")
-			}
-
-			// The justice transaction should have thee same number
-			// of inputs as we are tracking in the test.
-			if len(tx.TxIn) != len(inputs) {
-				t.Fatalf("expected justice txn to have %d "+
-					"inputs, found %d", len(inputs),
-					len(tx.TxIn))
-			}
-
-			// Ensure that each input exists on the justice
-			// transaction.
-			for in := range inputs {
-				findInputIndex(t, in, tx)
-			}
-
-		},
-		whenZeroInputs: func(t *testing.T,
-			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
-
-			// Sanity check to ensure the brar doesn't try to
-			// broadcast another sweep, since all outputs have been
-			// spent externally.
-			select {
-			case <-publTx:
-				t.Fatalf("tx published unexpectedly")
-			case <-time.After(50 * time.Millisecond):
-			}
-		},
 	},
 	{
-		name:          "commit spends, second level sweep",
-		spend2ndLevel: false,
-		sendFinalConf: true,
-		whenNonZeroInputs: func(t *testing.T,
-			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
-
-			select {
-			case <-publTx:
-			case <-time.After(5 * time.Second):
-				t.Fatalf("tx was not published")
-			}
-		},
-		whenZeroInputs: func(t *testing.T,
-			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
-
-			// Now a transaction attempting to spend from the second
-			// level tx should be published instead. Let this
-			// publish succeed by setting the publishing error to
-			// nil.
-			var tx *wire.MsgTx
-			select {
-			case tx = <-publTx:
-			case <-time.After(5 * time.Second):
-				t.Fatalf("tx was not published")
-			}
-
-			// The commitment outputs should be gone, and there
-			// should only be a single htlc spend.
-			if len(tx.TxIn) != 1 {
-				t.Fatalf("expect 1 htlc output, found %d "+
-					"outputs", len(tx.TxIn))
-			}
-
-			// The remaining TxIn  previously attempting to spend
-			// the HTLC outpoint should now be spending from the
-			// second level tx.
-			//
-			// NOTE: Commitment outputs and htlc sweeps are spent
-			// with a different transactions (and thus txids),
-			// ensuring we aren't mistaking this for a different
-			// output type.
-			onlyInput := tx.TxIn[0].PreviousOutPoint.Hash
-			if onlyInput != htlc2ndLevlTx.TxHash() {
-				t.Fatalf("tx not attempting to spend second "+
-					"level tx, %v", tx.TxIn[0])
-			}
-		},
+		name:          "second level lost",
+		spend2ndLevel: true,
+		lose2ndLevel:  true,
+	},
+	{
+		name:            "htlc sweep too many attempts",
+		tooManyAttempts: true,
+	},
+	{
+		name:          "htlc sweep retried after failures",
+		failHtlcSweep: true,
 	},
 }
 
 // TestBreachSpends checks the behavior of the breach arbiter in response to
-// spend events on a channels outputs by asserting that it properly removes or
-// modifies the inputs from the justice txn.
+// the results of sweeping the revoked outputs, by asserting that it follows
+// htlc outputs to the second level, and fully resolves the breach once all
+// outputs have been spent.
 func TestBreachSpends(t *testing.T) {
 	for _, test := range breachTests {
 		tc := test
@@ -1363,24 +1262,41 @@ func testBreachSpends(t *testing.T, test breachTest) {
 		height       = bobClose.ChanSnapshot.CommitHeight
 		forceCloseTx = bobClose.CloseTx
 		chanPoint    = alice.ChanPoint
-		publTx       = make(chan *wire.MsgTx)
-		publErr      error
-		publMtx      sync.Mutex
+		offers       = make(chan *sweepOffer)
 	)
 
-	// Make PublishTransaction always return ErrDoubleSpend to begin with.
-	publErr = lnwallet.ErrDoubleSpend
-	brar.cfg.PublishTransaction = func(tx *wire.MsgTx) error {
-		publTx <- tx
+	// Hand all inputs offered to the sweeper to the test, which decides
+	// on the outcome of each sweep.
+	brar.cfg.SweepInput = func(inp input.Input, feePref sweep.FeePreference,
+		group uint64) (chan sweep.Result, error) {
 
-		publMtx.Lock()
-		defer publMtx.Unlock()
-		return publErr
+		offer := &sweepOffer{
+			input:   inp,
+			feePref: feePref,
+			group:   group,
+			result:  make(chan sweep.Result, 1),
+		}
+		offers <- offer
+
+		return offer.result, nil
+	}
+
+	receiveOffer := func() *sweepOffer {
+		t.Helper()
+
+		select {
+		case offer := <-offers:
+			return offer
+		case <-time.After(5 * time.Second):
+			t.Fatalf("input was not offered to the sweeper")
+		}
+
+		return nil
 	}
 
 	// Notify the breach arbiter about the breach.
 	retribution, err := lnwallet.NewBreachRetribution(
-		alice.State(), height, 1,
+		alice.State(), height, 1, forceCloseTx,
 	)
 	if err != nil {
 		t.Fatalf("unable to create breach retribution: %v", err)
@@ -1431,106 +1347,296 @@ func testBreachSpends(t *testing.T, test breachTest) {
 
 	// Notify that the breaching transaction is confirmed, to trigger the
 	// retribution logic.
+	const breachConfHeight = 100
 	notifier := brar.cfg.Notifier.(*mockSpendNotifier)
-	notifier.confChannel <- &chainntnfs.TxConfirmation{}
+	notifier.confChannel <- &chainntnfs.TxConfirmation{
+		BlockHeight: breachConfHeight,
+	}
 
-	// The breach arbiter should attempt to sweep all outputs on the
-	// breached commitment. We'll pretend that the HTLC output has been
-	// spent by the channel counter party's second level tx already.
-	var tx *wire.MsgTx
-	select {
-	case tx = <-publTx:
-	case <-time.After(5 * time.Second):
-		t.Fatalf("tx was not published")
+	// The breach arbiter should offer each of the outputs on the breached
+	// commitment to the sweeper.
+	localOutpoint := retribution.LocalOutpoint
+	remoteOutpoint := retribution.RemoteOutpoint
+	htlcOutpoint := retribution.HtlcRetributions[0].OutPoint
+
+	offered := make(map[wire.OutPoint]*sweepOffer)
+	for i := 0; i < 3; i++ {
+		offer := receiveOffer()
+		offered[*offer.input.OutPoint()] = offer
 	}
 
-	// All outputs should initially spend from the force closed txn.
+	// The revoked outputs must be swept before the remote party is able
+	// to spend them, which is right away for the htlc output, while our
+	// own output has no deadline.
 	forceTxID := forceCloseTx.TxHash()
-	for _, txIn := range tx.TxIn {
-		if txIn.PreviousOutPoint.Hash != forceTxID {
-			t.Fatalf("og justice tx not spending commitment")
+	deadline := breachConfHeight + int32(retribution.RemoteDelay) - 1
+	for op, offer := range offered {
+		if op.Hash != forceTxID {
+			t.Fatalf("offered input %v not spending commitment", op)
+		}
+
+		expectedPref := sweep.FeePreference{
+			ConfTarget:     justiceConfTarget,
+			DeadlineHeight: deadline,
+		}
+		switch op {
+		case localOutpoint:
+			expectedPref = sweep.FeePreference{
+				ConfTarget: ownOutputConfTarget,
+			}
+
+		case htlcOutpoint:
+			expectedPref.DeadlineHeight = breachConfHeight + 1
+		}
+		if offer.feePref != expectedPref {
+			t.Fatalf("expected fee preference %v for %v, got %v",
+				expectedPref, op, offer.feePref)
 		}
 	}
 
-	localOutpoint := retribution.LocalOutpoint
-	remoteOutpoint := retribution.RemoteOutpoint
-	htlcOutpoint := retribution.HtlcRetributions[0].OutPoint
+	// The commitment outputs are swept together, but separately from the
+	// htlc output, so the remote party spending the htlc can't invalidate
+	// the sweep of the commitment outputs.
+	localOffer, ok := offered[localOutpoint]
+	if !ok {
+		t.Fatalf("local output %v not offered", localOutpoint)
+	}
+	remoteOffer, ok := offered[remoteOutpoint]
+	if !ok {
+		t.Fatalf("remote output %v not offered", remoteOutpoint)
+	}
+	htlcOffer, ok := offered[htlcOutpoint]
+	if !ok {
+		t.Fatalf("htlc output %v not offered", htlcOutpoint)
+	}
 
-	// Construct a map from outpoint on the force close to the transaction
-	// we want it to be spent by. As the test progresses, this map will be
-	// updated to contain only the set of commitment or second level
-	// outpoints that remain to be spent.
-	inputs := map[wire.OutPoint]*wire.MsgTx{
-		htlcOutpoint:   htlc2ndLevlTx,
-		localOutpoint:  commitSpendTx,
-		remoteOutpoint: commitSpendTx,
-	}
-
-	// Until no more inputs to spend remain, deliver the spend events and
-	// process the assertions prescribed by the test case.
-	for len(inputs) > 0 {
-		var (
-			op      wire.OutPoint
-			spendTx *wire.MsgTx
-		)
+	if localOffer.group != remoteOffer.group {
+		t.Fatalf("commitment outputs offered in different groups")
+	}
+	if htlcOffer.group == localOffer.group {
+		t.Fatalf("htlc output offered in commitment output group")
+	}
 
-		// Pick an outpoint at random from the set of inputs.
-		for op, spendTx = range inputs {
-			delete(inputs, op)
-			break
+	localOffer.result <- sweep.Result{Tx: commitSpendTx}
+	remoteOffer.result <- sweep.Result{Tx: commitSpendTx}
+
+	// A failed sweep of the htlc output is offered again from the next
+	// block on.
+	nextBlock := func() {
+		t.Helper()
+
+		for i := 0; i < 2; i++ {
+			select {
+			case notifier.epochChan <- &chainntnfs.BlockEpoch{}:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("block epoch not received")
+			}
 		}
+	}
+
+	switch {
+	case test.tooManyAttempts:
+		htlcOffer.result <- sweep.Result{Err: sweep.ErrTooManyAttempts}
+		nextBlock()
 
-		// Deliver the spend notification for the chosen transaction.
-		notifier.Spend(&op, 2, spendTx)
-
-		// When the second layer transfer is detected, add back the
-		// outpoint of the second layer tx so that we can spend it
-		// again. Only do so if the test requests this behavior.
-		spendTxID := spendTx.TxHash()
-		if test.spend2ndLevel && spendTxID == htlc2ndLevlTx.TxHash() {
-			// Create the second level outpoint that will be spent,
-			// the index is always zero for these 1-in-1-out txns.
-			spendOp := wire.OutPoint{Hash: spendTxID}
-			inputs[spendOp] = htlcSpendTx
+		htlcOffer = receiveOffer()
+		if *htlcOffer.input.OutPoint() != htlcOutpoint {
+			t.Fatalf("expected htlc output %v to be offered "+
+				"again, got %v", htlcOutpoint,
+				htlcOffer.input.OutPoint())
 		}
 
-		if len(inputs) > 0 {
-			test.whenNonZeroInputs(t, inputs, publTx)
-		} else {
-			// Reset the publishing error so that any publication,
-			// made by the breach arbiter, if any, will succeed.
-			publMtx.Lock()
-			publErr = nil
-			publMtx.Unlock()
-			test.whenZeroInputs(t, inputs, publTx)
+	case test.failHtlcSweep:
+		for i := 0; i < maxJusticeSweepFailures; i++ {
+			htlcOffer.result <- sweep.Result{
+				Err: fmt.Errorf("sweep failed"),
+			}
+			nextBlock()
+
+			htlcOffer = receiveOffer()
+		}
+
+		// After repeated failures, the output should be reported as
+		// failed, while it's still offered to the sweeper every block.
+		err := wait.NoError(func() error {
+			report := brar.breachReport(chanPoint)
+			if report == nil {
+				return fmt.Errorf("no breach report")
+			}
+			for _, output := range report.outputs {
+				if output.outpoint != htlcOutpoint {
+					continue
+				}
+				if output.state != justiceFailed {
+					return fmt.Errorf("expected state %v, "+
+						"got %v", justiceFailed,
+						output.state)
+				}
+			}
+
+			return nil
+		}, 5*time.Second)
+		if err != nil {
+			t.Fatalf("htlc sweep not failed: %v", err)
+		}
+		assertArbiterBreach(t, brar, chanPoint)
+
+		if *htlcOffer.input.OutPoint() != htlcOutpoint {
+			t.Fatalf("expected htlc output %v to be offered "+
+				"again, got %v", htlcOutpoint,
+				htlcOffer.input.OutPoint())
+		}
+	}
+
+	if !test.spend2ndLevel {
+		htlcOffer.result <- sweep.Result{Tx: htlcSpendTx}
+		assertBrarCleanup(t, brar, alice.ChanPoint, alice.State().Db)
+
+		return
+	}
+
+	// The remote party spends the htlc output to the second level first,
+	// after which the breach arbiter should offer the second level output
+	// instead.
+	const spendHeight = 110
+	htlcOffer.result <- sweep.Result{
+		Tx:  htlc2ndLevlTx,
+		Err: sweep.ErrRemoteSpend,
+	}
+	notifier.Spend(&htlcOutpoint, spendHeight, htlc2ndLevlTx)
+
+	secondLevelOffer := receiveOffer()
+
+	secondLevelOp := wire.OutPoint{Hash: htlc2ndLevlTx.TxHash()}
+	if *secondLevelOffer.input.OutPoint() != secondLevelOp {
+		t.Fatalf("expected second level output %v to be offered, "+
+			"got %v", secondLevelOp,
+			secondLevelOffer.input.OutPoint())
+	}
+	if secondLevelOffer.input.WitnessType() != input.HtlcSecondLevelRevoke {
+		t.Fatalf("expected second level witness type, got %v",
+			secondLevelOffer.input.WitnessType())
+	}
+	if secondLevelOffer.group != htlcOffer.group {
+		t.Fatalf("second level output offered in different group")
+	}
+
+	deadline = spendHeight + int32(retribution.RemoteDelay) - 1
+	if secondLevelOffer.feePref.DeadlineHeight != deadline {
+		t.Fatalf("expected deadline %v for second level output, "+
+			"got %v", deadline,
+			secondLevelOffer.feePref.DeadlineHeight)
+	}
+
+	// The breach report should reflect the second level output.
+	report := brar.breachReport(chanPoint)
+	if report == nil {
+		t.Fatalf("no breach report for %v", chanPoint)
+	}
+	var found bool
+	for _, output := range report.outputs {
+		if output.outpoint != secondLevelOp {
+			continue
 		}
+		found = true
+
+		if output.state != justiceSecondLevel {
+			t.Fatalf("expected state %v, got %v",
+				justiceSecondLevel, output.state)
+		}
+	}
+	if !found {
+		t.Fatalf("second level output not reported")
 	}
 
-	// Deliver confirmation of sweep if the test expects it.
-	if test.sendFinalConf {
-		notifier.confChannel <- &chainntnfs.TxConfirmation{}
+	if test.lose2ndLevel {
+		secondLevelOffer.result <- sweep.Result{
+			Tx:  htlcSpendTx,
+			Err: sweep.ErrRemoteSpend,
+		}
+	} else {
+		secondLevelOffer.result <- sweep.Result{Tx: htlcSpendTx}
 	}
 
 	// Assert that the channel is fully resolved.
 	assertBrarCleanup(t, brar, alice.ChanPoint, alice.State().Db)
 }
 
-// findInputIndex returns the index of the input that spends from the given
-// outpoint. This method fails if the outpoint is not found.
-func findInputIndex(t *testing.T, op wire.OutPoint, tx *wire.MsgTx) int {
-	t.Helper()
-
-	inputIdx := -1
-	for i, txIn := range tx.TxIn {
-		if txIn.PreviousOutPoint == op {
-			inputIdx = i
+// TestIsJusticeSpend asserts that spends of revoked outputs through the
+// revocation clause are recognized, even if the spend wasn't published by the
+// sweeper.
+func TestIsJusticeSpend(t *testing.T) {
+	op := wire.OutPoint{Index: 1}
+	spendTx := func(witness ...[]byte) *wire.MsgTx {
+		return &wire.MsgTx{
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: op,
+				Witness:          witness,
+			}},
 		}
 	}
-	if inputIdx == -1 {
-		t.Fatalf("input %v in not found", op)
+
+	sig := make([]byte, 72)
+	revocationKey := make([]byte, 33)
+	script := make([]byte, 100)
+
+	tests := []struct {
+		name        string
+		witnessType input.StandardWitnessType
+		tx          *wire.MsgTx
+		justice     bool
+	}{
+		{
+			name:        "own commitment output",
+			witnessType: input.CommitSpendNoDelayTweakless,
+			tx:          spendTx(sig, revocationKey),
+			justice:     true,
+		},
+		{
+			name:        "revoked commitment output",
+			witnessType: input.CommitmentRevoke,
+			tx:          spendTx(sig, []byte{1}, script),
+			justice:     true,
+		},
+		{
+			name:        "remote commitment output",
+			witnessType: input.CommitmentRevoke,
+			tx:          spendTx(sig, nil, script),
+			justice:     false,
+		},
+		{
+			name:        "revoked htlc output",
+			witnessType: input.HtlcOfferedRevoke,
+			tx:          spendTx(sig, revocationKey, script),
+			justice:     true,
+		},
+		{
+			name:        "second level htlc transaction",
+			witnessType: input.HtlcAcceptedRevoke,
+			tx: spendTx(
+				nil, sig, sig, make([]byte, 32), script,
+			),
+			justice: false,
+		},
+		{
+			name:        "unrelated transaction",
+			witnessType: input.HtlcSecondLevelRevoke,
+			tx:          htlcSpendTx,
+			justice:     false,
+		},
 	}
 
-	return inputIdx
+	for _, test := range tests {
+		bo := &breachedOutput{
+			outpoint:    op,
+			witnessType: test.witnessType,
+		}
+
+		if isJusticeSpend(bo, test.tx) != test.justice {
+			t.Fatalf("%v: expected justice spend %v", test.name,
+				test.justice)
+		}
+	}
 }
 
 // assertArbiterBreach checks that the breach arbiter has persisted the breach
@@ -1666,22 +1772,23 @@ func createTestArbiter(t *testing.T, contractBreaches chan *ContractBreachEvent,
 		return newRetributionStore(db)
 	})
 
-	aliceKeyPriv, _ := btcec.PrivKeyFromBytes(btcec.S256(),
-		alicesPrivKey)
-	signer := &mockSigner{key: aliceKeyPriv}
-
-	// Assemble our test arbiter.
+	// Assemble our test arbiter, whose sweeper sweeps all offered inputs
+	// right away.
 	notifier := makeMockSpendNotifier()
 	ba := newBreachArbiter(&BreachConfig{
-		CloseLink:          func(_ *wire.OutPoint, _ htlcswitch.ChannelCloseType) {},
-		DB:                 db,
-		Estimator:          lnwallet.NewStaticFeeEstimator(12500, 0),
-		GenSweepScript:     func() ([]byte, error) { return nil, nil },
-		ContractBreaches:   contractBreaches,
-		Signer:             signer,
-		Notifier:           notifier,
-		PublishTransaction: func(_ *wire.MsgTx) error { return nil },
-		Store:              store,
+		CloseLink:        func(_ *wire.OutPoint, _ htlcswitch.ChannelCloseType) {},
+		DB:               db,
+		ContractBreaches: contractBreaches,
+		Notifier:         notifier,
+		SweepInput: func(input.Input, sweep.FeePreference,
+			uint64) (chan sweep.Result, error) {
+
+			result := make(chan sweep.Result, 1)
+			result <- sweep.Result{Tx: &wire.MsgTx{}}
+
+			return result, nil
+		},
+		Store: store,
 	})
 
 	if err := ba.Start(); err != nil {
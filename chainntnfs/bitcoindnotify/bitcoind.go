@@ -67,6 +67,10 @@ type BitcoindNotifier struct {
 // time.
 var _ chainntnfs.ChainNotifier = (*BitcoindNotifier)(nil)
 
+// Ensure BitcoindNotifier implements the BlockNotifier interface at compile
+// time, as it processes the full content of each block.
+var _ chainntnfs.BlockNotifier = (*BitcoindNotifier)(nil)
+
 // New returns a new BitcoindNotifier instance. This function assumes the
 // bitcoind node detailed in the passed configuration is already running, and
 // willing to accept RPC requests and new zmq clients.
@@ -933,3 +937,14 @@ func (b *BitcoindNotifier) RegisterBlockEpochNtfn(
 		}, nil
 	}
 }
+
+// SubscribeBlocks registers an intent to be notified of each block connected
+// to and disconnected from the tip of the main chain. The events of connected
+// blocks include the transactions matching the given filter.
+//
+// NOTE: This is part of the chainntnfs.BlockNotifier interface.
+func (b *BitcoindNotifier) SubscribeBlocks(
+	filter *chainntnfs.BlockFilter) (*chainntnfs.BlockSubscription, error) {
+
+	return b.txNotifier.SubscribeBlocks(filter)
+}
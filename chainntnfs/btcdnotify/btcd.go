@@ -88,6 +88,10 @@ type BtcdNotifier struct {
 // Ensure BtcdNotifier implements the ChainNotifier interface at compile time.
 var _ chainntnfs.ChainNotifier = (*BtcdNotifier)(nil)
 
+// Ensure BtcdNotifier implements the BlockNotifier interface at compile time,
+// as it processes the full content of each block.
+var _ chainntnfs.BlockNotifier = (*BtcdNotifier)(nil)
+
 // New returns a new BtcdNotifier instance. This function assumes the btcd node
 // detailed in the passed configuration is already running, and willing to
 // accept new websockets clients.
@@ -998,3 +1002,14 @@ func (b *BtcdNotifier) RegisterBlockEpochNtfn(
 		}, nil
 	}
 }
+
+// SubscribeBlocks registers an intent to be notified of each block connected
+// to and disconnected from the tip of the main chain. The events of connected
+// blocks include the transactions matching the given filter.
+//
+// NOTE: This is part of the chainntnfs.BlockNotifier interface.
+func (b *BtcdNotifier) SubscribeBlocks(
+	filter *chainntnfs.BlockFilter) (*chainntnfs.BlockSubscription, error) {
+
+	return b.txNotifier.SubscribeBlocks(filter)
+}
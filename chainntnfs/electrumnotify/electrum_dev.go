@@ -0,0 +1,73 @@
+// +build dev
+
+package electrumnotify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/electrum"
+)
+
+// UnsafeStart starts the notifier with a specified best height and optional
+// best hash. Its bestBlock and txNotifier are initialized with bestHeight and
+// optionally bestHash. The parameter generateBlocks is necessary to ensure we
+// drain all notifications up to syncHeight, since if they are generated ahead
+// of UnsafeStart the notifier may start up with an outdated best block and
+// miss sending ntfns. Used for testing.
+func (e *ElectrumNotifier) UnsafeStart(bestHeight int32,
+	bestHash *chainhash.Hash, syncHeight int32,
+	generateBlocks func() error) error {
+
+	e.sub = e.chainConn.Subscribe()
+
+	e.txNotifier = chainntnfs.NewTxNotifier(
+		uint32(bestHeight), chainntnfs.ReorgSafetyLimit,
+		e.confirmHintCache, e.spendHintCache,
+	)
+
+	if generateBlocks != nil {
+		// Ensure no block notifications are pending when we start the
+		// notification dispatcher goroutine.
+
+		// First generate the blocks, then drain the notifications
+		// for the generated blocks.
+		if err := generateBlocks(); err != nil {
+			return err
+		}
+
+		timeout := time.After(60 * time.Second)
+	loop:
+		for {
+			select {
+			case ntfn := <-e.sub.Notifications:
+				tip, ok := ntfn.(*electrum.HeaderNotification)
+				if ok && tip.Height >= syncHeight {
+					break loop
+				}
+			case <-timeout:
+				return fmt.Errorf("unable to catch up to height %d",
+					syncHeight)
+			}
+		}
+	}
+
+	// Run notificationDispatcher after setting the notifier's best block
+	// to avoid a race condition.
+	e.bestBlock = chainntnfs.BlockEpoch{Height: bestHeight, Hash: bestHash}
+	if bestHash == nil {
+		hash, err := e.chainConn.GetBlockHash(int64(bestHeight))
+		if err != nil {
+			return err
+		}
+		e.bestBlock.Hash = hash
+	}
+	e.blocks[bestHeight] = *e.bestBlock.Hash
+
+	e.wg.Add(1)
+	go e.notificationDispatcher()
+
+	return nil
+}
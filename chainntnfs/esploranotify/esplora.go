@@ -0,0 +1,923 @@
+package esploranotify
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/esplora"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+const (
+	// notifierType uniquely identifies this concrete implementation of the
+	// ChainNotifier interface.
+	notifierType = "esplora"
+)
+
+// EsploraNotifier implements the ChainNotifier interface using the REST API of
+// an Esplora server. Multiple concurrent clients are supported. All
+// notifications are achieved via non-blocking sends on client channels.
+//
+// As the server is polled for new blocks, the notifier may learn about
+// several blocks at once, in which case all of them are fetched and
+// connected in order.
+type EsploraNotifier struct {
+	epochClientCounter uint64 // To be used atomically.
+
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	chainConn *esplora.Client
+
+	sub *esplora.Subscription
+
+	notificationCancels  chan interface{}
+	notificationRegistry chan interface{}
+
+	txNotifier *chainntnfs.TxNotifier
+
+	blockEpochClients map[uint64]*blockEpochRegistration
+
+	bestBlock chainntnfs.BlockEpoch
+
+	// blocks contains the hashes of the most recent blocks we've
+	// connected, allowing us to locate the fork point upon a reorg.
+	blocks map[int32]chainhash.Hash
+
+	// spendHintCache is a cache used to query and update the latest height
+	// hints for an outpoint. Each height hint represents the earliest
+	// height at which the outpoint could have been spent within the chain.
+	spendHintCache chainntnfs.SpendHintCache
+
+	// confirmHintCache is a cache used to query the latest height hints for
+	// a transaction. Each height hint represents the earliest height at
+	// which the transaction could have confirmed within the chain.
+	confirmHintCache chainntnfs.ConfirmHintCache
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// Ensure EsploraNotifier implements the ChainNotifier interface at compile
+// time.
+var _ chainntnfs.ChainNotifier = (*EsploraNotifier)(nil)
+
+// New returns a new EsploraNotifier instance. This function assumes the
+// passed Esplora client has already been started.
+func New(chainConn *esplora.Client, spendHintCache chainntnfs.SpendHintCache,
+	confirmHintCache chainntnfs.ConfirmHintCache) *EsploraNotifier {
+
+	return &EsploraNotifier{
+		chainConn: chainConn,
+
+		notificationCancels:  make(chan interface{}),
+		notificationRegistry: make(chan interface{}),
+
+		blockEpochClients: make(map[uint64]*blockEpochRegistration),
+
+		blocks: make(map[int32]chainhash.Hash),
+
+		spendHintCache:   spendHintCache,
+		confirmHintCache: confirmHintCache,
+
+		quit: make(chan struct{}),
+	}
+}
+
+// Start subscribes to new blocks announced by the Esplora server, and
+// launches all related helper goroutines.
+func (e *EsploraNotifier) Start() error {
+	// Already started?
+	if atomic.AddInt32(&e.started, 1) != 1 {
+		return nil
+	}
+
+	// We subscribe before querying the best block to ensure we don't miss
+	// any block found in between.
+	e.sub = e.chainConn.Subscribe()
+
+	currentHash, currentHeight, err := e.chainConn.GetBestBlock()
+	if err != nil {
+		e.sub.Cancel()
+		return err
+	}
+
+	e.txNotifier = chainntnfs.NewTxNotifier(
+		uint32(currentHeight), chainntnfs.ReorgSafetyLimit,
+		e.confirmHintCache, e.spendHintCache,
+	)
+
+	e.bestBlock = chainntnfs.BlockEpoch{
+		Height: currentHeight,
+		Hash:   currentHash,
+	}
+	e.blocks[currentHeight] = *currentHash
+
+	e.wg.Add(1)
+	go e.notificationDispatcher()
+
+	return nil
+}
+
+// Stop shuts down the EsploraNotifier. The Esplora client is left running,
+// as it may be shared with other subsystems.
+func (e *EsploraNotifier) Stop() error {
+	// Already shutting down?
+	if atomic.AddInt32(&e.stopped, 1) != 1 {
+		return nil
+	}
+
+	e.sub.Cancel()
+
+	close(e.quit)
+	e.wg.Wait()
+
+	// Notify all pending clients of our shutdown by closing the related
+	// notification channels.
+	for _, epochClient := range e.blockEpochClients {
+		close(epochClient.cancelChan)
+		epochClient.wg.Wait()
+
+		close(epochClient.epochChan)
+	}
+	e.txNotifier.TearDown()
+
+	return nil
+}
+
+// notificationDispatcher is the primary goroutine which handles client
+// notification registrations, as well as notification dispatches.
+func (e *EsploraNotifier) notificationDispatcher() {
+	defer e.wg.Done()
+
+	for {
+		select {
+		case cancelMsg := <-e.notificationCancels:
+			switch msg := cancelMsg.(type) {
+			case *epochCancel:
+				chainntnfs.Log.Infof("Cancelling epoch "+
+					"notification, epoch_id=%v", msg.epochID)
+
+				// First, we'll lookup the original
+				// registration in order to stop the active
+				// queue goroutine.
+				reg := e.blockEpochClients[msg.epochID]
+				reg.epochQueue.Stop()
+
+				// Next, close the cancel channel for this
+				// specific client, and wait for the client to
+				// exit.
+				close(reg.cancelChan)
+				reg.wg.Wait()
+
+				// Once the client has exited, we can then
+				// safely close the channel used to send epoch
+				// notifications, in order to notify any
+				// listeners that the intent has been
+				// canceled.
+				close(reg.epochChan)
+				delete(e.blockEpochClients, msg.epochID)
+			}
+
+		case registerMsg := <-e.notificationRegistry:
+			switch msg := registerMsg.(type) {
+			case *chainntnfs.HistoricalConfDispatch:
+				// Look up whether the transaction is already
+				// included in the active chain. We'll do this
+				// in a goroutine to prevent blocking
+				// potentially long lookups.
+				e.wg.Add(1)
+				go func() {
+					defer e.wg.Done()
+
+					confDetails, err := e.historicalConfDetails(
+						msg.ConfRequest,
+						msg.StartHeight, msg.EndHeight,
+					)
+					if err != nil {
+						chainntnfs.Log.Errorf("Lookup "+
+							"of the conf details "+
+							"of %v within range "+
+							"%d-%d failed: %v",
+							msg.ConfRequest,
+							msg.StartHeight,
+							msg.EndHeight, err)
+						return
+					}
+
+					// If the historical dispatch finished
+					// without error, we will invoke
+					// UpdateConfDetails even if none were
+					// found. This allows the notifier to
+					// begin safely updating the height hint
+					// cache at tip, since any pending
+					// lookups have now completed.
+					err = e.txNotifier.UpdateConfDetails(
+						msg.ConfRequest, confDetails,
+					)
+					if err != nil {
+						chainntnfs.Log.Errorf("Unable "+
+							"to update conf "+
+							"details of %v: %v",
+							msg.ConfRequest, err)
+					}
+				}()
+
+			case *chainntnfs.HistoricalSpendDispatch:
+				// In order to ensure we don't block the caller
+				// on what may be a long lookup, we'll launch a
+				// goroutine to do so in the background.
+				e.wg.Add(1)
+				go func() {
+					defer e.wg.Done()
+
+					spendDetails, err := e.historicalSpendDetails(
+						msg.SpendRequest,
+						msg.StartHeight, msg.EndHeight,
+					)
+					if err != nil {
+						chainntnfs.Log.Errorf("Lookup "+
+							"of the spend details "+
+							"of %v within range "+
+							"%d-%d failed: %v",
+							msg.SpendRequest,
+							msg.StartHeight,
+							msg.EndHeight, err)
+						return
+					}
+
+					// If the historical dispatch finished
+					// without error, we will invoke
+					// UpdateSpendDetails even if none were
+					// found. This allows the notifier to
+					// begin safely updating the height hint
+					// cache at tip, since any pending
+					// lookups have now completed.
+					err = e.txNotifier.UpdateSpendDetails(
+						msg.SpendRequest, spendDetails,
+					)
+					if err != nil {
+						chainntnfs.Log.Errorf("Unable "+
+							"to update spend "+
+							"details of %v: %v",
+							msg.SpendRequest, err)
+					}
+				}()
+
+			case *blockEpochRegistration:
+				chainntnfs.Log.Infof("New block epoch subscription")
+
+				e.blockEpochClients[msg.epochID] = msg
+
+				// If the client did not provide their best
+				// known block, then we'll immediately dispatch
+				// a notification for the current tip.
+				if msg.bestBlock == nil {
+					e.notifyBlockEpochClient(
+						msg, e.bestBlock.Height,
+						e.bestBlock.Hash,
+					)
+
+					msg.errorChan <- nil
+					continue
+				}
+
+				// Otherwise, we'll attempt to deliver the
+				// backlog of notifications from their best
+				// known block. We're only able to detect
+				// whether their best block has been reorged
+				// out if the server still knows about it, as
+				// it may only keep track of the best chain.
+				_, err := e.chainConn.GetBlockHeader(
+					msg.bestBlock.Hash,
+				)
+				knownBlock := err == nil
+
+				missedBlocks, err := chainntnfs.GetClientMissedBlocks(
+					e.chainConn, msg.bestBlock,
+					e.bestBlock.Height, knownBlock,
+				)
+				if err != nil {
+					msg.errorChan <- err
+					continue
+				}
+
+				for _, block := range missedBlocks {
+					e.notifyBlockEpochClient(
+						msg, block.Height, block.Hash,
+					)
+				}
+
+				msg.errorChan <- nil
+			}
+
+		case ntfn, ok := <-e.sub.Notifications:
+			if !ok {
+				return
+			}
+
+			tip, ok := ntfn.(*esplora.TipNotification)
+			if !ok {
+				continue
+			}
+
+			if err := e.handleNewTip(tip); err != nil {
+				chainntnfs.Log.Errorf("Unable to process new "+
+					"tip at height %d: %v", tip.Height, err)
+			}
+
+		case <-e.quit:
+			return
+		}
+	}
+}
+
+// handleNewTip brings the notifier in sync with the new tip announced by the
+// server. As the server is polled, we may learn about several blocks at
+// once, e.g. during a reorg, so we'll first locate the fork point between our view of the
+// chain and the server's, disconnect any blocks that have been reorged out,
+// and then connect all blocks up to the new tip.
+func (e *EsploraNotifier) handleNewTip(tip *esplora.TipNotification) error {
+	tipHash := tip.Header.BlockHash()
+	if tipHash == *e.bestBlock.Hash {
+		return nil
+	}
+
+	// If the server is behind us, e.g. when load balanced across several
+	// backends, we'll wait for it to catch up, unless the tip it announces has been
+	// reorged out of our chain.
+	if hash, ok := e.blocks[tip.Height]; ok && hash == tipHash {
+		return nil
+	}
+
+	// We'll walk back our chain until we find a block that's also part of
+	// the server's. If we're unable to walk back any further, as we've
+	// never seen the previous block, we'll assume it's the fork point.
+	forkHeight := e.bestBlock.Height
+	forkHash := *e.bestBlock.Hash
+	for forkHeight > 0 {
+		if forkHeight < tip.Height {
+			chainHash, err := e.chainConn.GetBlockHash(
+				int64(forkHeight),
+			)
+			if err != nil {
+				return err
+			}
+			if *chainHash == forkHash {
+				break
+			}
+		}
+
+		prevHash, ok := e.blocks[forkHeight-1]
+		if !ok {
+			header, err := e.chainConn.GetBlockHeader(&forkHash)
+			if err != nil {
+				forkHeight--
+				break
+			}
+			prevHash = header.PrevBlock
+		}
+
+		forkHeight--
+		forkHash = prevHash
+	}
+
+	if forkHeight < e.bestBlock.Height {
+		chainntnfs.Log.Infof("Chain reorganization detected, "+
+			"rewinding from height %d to %d", e.bestBlock.Height,
+			forkHeight)
+	}
+
+	for e.bestBlock.Height > forkHeight {
+		height := e.bestBlock.Height
+
+		chainntnfs.Log.Infof("Block disconnected from main chain: "+
+			"height=%v, sha=%v", height, e.bestBlock.Hash)
+
+		err := e.txNotifier.DisconnectTip(uint32(height))
+		if err != nil {
+			return fmt.Errorf("unable to disconnect tip for "+
+				"height=%d: %v", height, err)
+		}
+		delete(e.blocks, height)
+
+		prevHash, ok := e.blocks[height-1]
+		if !ok {
+			hash, err := e.chainConn.GetBlockHash(int64(height - 1))
+			if err != nil {
+				return err
+			}
+			prevHash = *hash
+		}
+		e.bestBlock = chainntnfs.BlockEpoch{
+			Height: height - 1,
+			Hash:   &prevHash,
+		}
+	}
+
+	for height := forkHeight + 1; height <= tip.Height; height++ {
+		hash := &tipHash
+		if height != tip.Height {
+			var err error
+			hash, err = e.chainConn.GetBlockHash(int64(height))
+			if err != nil {
+				return err
+			}
+		}
+
+		block := chainntnfs.BlockEpoch{
+			Height: height,
+			Hash:   hash,
+		}
+		if err := e.handleBlockConnected(block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleBlockConnected applies a chain update for a new block. Any watched
+// transactions included this block will processed to either send notifications
+// now or after numConfirmations confs.
+func (e *EsploraNotifier) handleBlockConnected(block chainntnfs.BlockEpoch) error {
+	// First, we'll fetch the raw block as we'll need to gather all the
+	// transactions to determine whether any are relevant to our registered
+	// clients.
+	rawBlock, err := e.chainConn.GetBlock(block.Hash)
+	if err != nil {
+		return fmt.Errorf("unable to get block: %v", err)
+	}
+	txns := btcutil.NewBlock(rawBlock).Transactions()
+
+	// We'll then extend the txNotifier's height with the information of
+	// this new block, which will handle all of the notification logic for
+	// us.
+	err = e.txNotifier.ConnectTip(block.Hash, uint32(block.Height), txns)
+	if err != nil {
+		return fmt.Errorf("unable to connect tip: %v", err)
+	}
+
+	chainntnfs.Log.Infof("New block: height=%v, sha=%v", block.Height,
+		block.Hash)
+
+	// Now that we've guaranteed the new block extends the txNotifier's
+	// current tip, we'll proceed to dispatch notifications to all of our
+	// registered clients whom have had notifications fulfilled. Before
+	// doing so, we'll make sure update our in memory state in order to
+	// satisfy any client requests based upon the new block.
+	e.bestBlock = block
+	e.blocks[block.Height] = *block.Hash
+	delete(e.blocks, block.Height-chainntnfs.ReorgSafetyLimit)
+
+	e.notifyBlockEpochs(block.Height, block.Hash)
+	return e.txNotifier.NotifyHeight(uint32(block.Height))
+}
+
+// confirmedTx fetches the transaction with the given hash, confirmed in the
+// block with the given hash, along with its index within the block.
+func (e *EsploraNotifier) confirmedTx(txHash,
+	blockHash *chainhash.Hash) (*wire.MsgTx, uint32, error) {
+
+	tx, err := e.chainConn.GetTransaction(txHash)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	txHashes, err := e.chainConn.GetBlockTxIDs(blockHash)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range txHashes {
+		if txHashes[i] == *txHash {
+			return tx, uint32(i), nil
+		}
+	}
+
+	return nil, 0, fmt.Errorf("transaction %v not found in block %v",
+		txHash, blockHash)
+}
+
+// scriptTxsInRange returns the transactions paying to or spending from the
+// given script, confirmed within the given height range, in the order they
+// were confirmed.
+func (e *EsploraNotifier) scriptTxsInRange(pkScript []byte, startHeight,
+	endHeight uint32) ([]esplora.ScriptTx, error) {
+
+	txs, err := e.chainConn.ScriptTxs(pkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	// The server returns the most recent transactions first, so we'll
+	// walk them backwards.
+	var inRange []esplora.ScriptTx
+	for i := len(txs) - 1; i >= 0; i-- {
+		status := txs[i].Status
+		if !status.Confirmed ||
+			uint32(status.BlockHeight) < startHeight ||
+			uint32(status.BlockHeight) > endHeight {
+
+			continue
+		}
+
+		inRange = append(inRange, txs[i])
+	}
+
+	return inRange, nil
+}
+
+// historicalConfDetails looks up whether a confirmation request (txid/output
+// script) has already been included in a block in the active chain and, if so,
+// returns details about said block.
+func (e *EsploraNotifier) historicalConfDetails(
+	confRequest chainntnfs.ConfRequest, startHeight,
+	endHeight uint32) (*chainntnfs.TxConfirmation, error) {
+
+	// If a txid was provided, we'll look up its status directly.
+	// Otherwise, we'll need to go through the transactions related to the
+	// output script.
+	var candidates []esplora.ScriptTx
+	if confRequest.TxID != chainntnfs.ZeroHash {
+		status, err := e.chainConn.GetTxStatus(&confRequest.TxID)
+		switch {
+		case err == esplora.ErrNotFound:
+			return nil, nil
+
+		case err != nil:
+			return nil, err
+		}
+
+		if status.Confirmed &&
+			uint32(status.BlockHeight) >= startHeight &&
+			uint32(status.BlockHeight) <= endHeight {
+
+			candidates = append(candidates, esplora.ScriptTx{
+				TxHash: confRequest.TxID,
+				Status: *status,
+			})
+		}
+	} else {
+		var err error
+		candidates, err = e.scriptTxsInRange(
+			confRequest.PkScript.Script(), startHeight, endHeight,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, candidate := range candidates {
+		// Ensure we haven't been requested to shut down before
+		// processing the next transaction.
+		select {
+		case <-e.quit:
+			return nil, chainntnfs.ErrChainNotifierShuttingDown
+		default:
+		}
+
+		blockHash, err := chainhash.NewHashFromStr(
+			candidate.Status.BlockHash,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		tx, txIndex, err := e.confirmedTx(&candidate.TxHash, blockHash)
+		if err != nil {
+			return nil, err
+		}
+		if !confRequest.MatchesTx(tx) {
+			continue
+		}
+
+		return &chainntnfs.TxConfirmation{
+			Tx:          tx,
+			BlockHash:   blockHash,
+			BlockHeight: uint32(candidate.Status.BlockHeight),
+			TxIndex:     txIndex,
+		}, nil
+	}
+
+	// If we reach here, then we were not able to find the transaction
+	// within a block, so we avoid returning an error.
+	return nil, nil
+}
+
+// historicalSpendDetails attempts to find a transaction within the given
+// height range that spends the given outpoint/output script. If one is found,
+// the spend details are assembled and returned to the caller. If the spend is
+// not found, a nil spend detail will be returned.
+func (e *EsploraNotifier) historicalSpendDetails(
+	spendRequest chainntnfs.SpendRequest, startHeight, endHeight uint32) (
+	*chainntnfs.SpendDetail, error) {
+
+	// If an outpoint was provided, we'll look up its spend directly.
+	// Otherwise, we'll need to go through the transactions related to the
+	// output script.
+	var candidates []esplora.ScriptTx
+	if spendRequest.OutPoint != chainntnfs.ZeroOutPoint {
+		outSpend, err := e.chainConn.GetOutSpend(&spendRequest.OutPoint)
+		switch {
+		case err == esplora.ErrNotFound:
+			return nil, nil
+
+		case err != nil:
+			return nil, err
+		}
+
+		status := outSpend.Status
+		if outSpend.Spent && status.Confirmed &&
+			uint32(status.BlockHeight) >= startHeight &&
+			uint32(status.BlockHeight) <= endHeight {
+
+			candidates = append(candidates, esplora.ScriptTx{
+				TxHash: outSpend.SpenderTxHash,
+				Status: status,
+			})
+		}
+	} else {
+		var err error
+		candidates, err = e.scriptTxsInRange(
+			spendRequest.PkScript.Script(), startHeight, endHeight,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, candidate := range candidates {
+		// Ensure we haven't been requested to shut down before
+		// processing the next transaction.
+		select {
+		case <-e.quit:
+			return nil, chainntnfs.ErrChainNotifierShuttingDown
+		default:
+		}
+
+		tx, err := e.chainConn.GetTransaction(&candidate.TxHash)
+		if err != nil {
+			return nil, err
+		}
+
+		matches, inputIdx, err := spendRequest.MatchesTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		if !matches {
+			continue
+		}
+
+		txHash := tx.TxHash()
+		return &chainntnfs.SpendDetail{
+			SpentOutPoint:     &tx.TxIn[inputIdx].PreviousOutPoint,
+			SpenderTxHash:     &txHash,
+			SpendingTx:        tx,
+			SpenderInputIndex: inputIdx,
+			SpendingHeight:    candidate.Status.BlockHeight,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// notifyBlockEpochs notifies all registered block epoch clients of the newly
+// connected block to the main chain.
+func (e *EsploraNotifier) notifyBlockEpochs(newHeight int32,
+	newSha *chainhash.Hash) {
+
+	for _, client := range e.blockEpochClients {
+		e.notifyBlockEpochClient(client, newHeight, newSha)
+	}
+}
+
+// notifyBlockEpochClient sends a registered block epoch client a notification
+// about a specific block.
+func (e *EsploraNotifier) notifyBlockEpochClient(
+	epochClient *blockEpochRegistration, height int32,
+	sha *chainhash.Hash) {
+
+	epoch := &chainntnfs.BlockEpoch{
+		Height: height,
+		Hash:   sha,
+	}
+
+	select {
+	case epochClient.epochQueue.ChanIn() <- epoch:
+	case <-epochClient.cancelChan:
+	case <-e.quit:
+	}
+}
+
+// RegisterSpendNtfn registers an intent to be notified once the target
+// outpoint/output script has been spent by a transaction on-chain. When
+// intending to be notified of the spend of an output script, a nil outpoint
+// must be used. The heightHint should represent the earliest height in the
+// chain of the transaction that spent the outpoint/output script.
+//
+// Once a spend of has been detected, the details of the spending event will be
+// sent across the 'Spend' channel.
+func (e *EsploraNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	// First, we'll construct a spend notification request and hand it off
+	// to the txNotifier.
+	ntfn, err := e.txNotifier.RegisterSpend(outpoint, pkScript, heightHint)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the txNotifier didn't return any details to perform a historical
+	// scan of the chain, then we can return early as there's nothing left
+	// for us to do.
+	if ntfn.HistoricalDispatch == nil {
+		return ntfn.Event, nil
+	}
+
+	// When dispatching spends of outpoints, we'll check whether the
+	// outpoint is still unspent first, in which case we can avoid the
+	// lookup.
+	if ntfn.HistoricalDispatch.OutPoint != chainntnfs.ZeroOutPoint {
+		outSpend, err := e.chainConn.GetOutSpend(
+			&ntfn.HistoricalDispatch.OutPoint,
+		)
+		if err != nil && err != esplora.ErrNotFound {
+			return nil, err
+		}
+
+		// We'll let the txNotifier know the outpoint is still unspent
+		// in order to begin updating its spend hint.
+		if err == nil && !outSpend.Spent {
+			err := e.txNotifier.UpdateSpendDetails(
+				ntfn.HistoricalDispatch.SpendRequest, nil,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return ntfn.Event, nil
+		}
+	}
+
+	select {
+	case e.notificationRegistry <- ntfn.HistoricalDispatch:
+	case <-e.quit:
+		return nil, chainntnfs.ErrChainNotifierShuttingDown
+	}
+
+	return ntfn.Event, nil
+}
+
+// RegisterConfirmationsNtfn registers an intent to be notified once the target
+// txid/output script has reached numConfs confirmations on-chain. When
+// intending to be notified of the confirmation of an output script, a nil txid
+// must be used. The heightHint should represent the earliest height at which
+// the txid/output script could have been included in the chain.
+//
+// Progress on the number of confirmations left can be read from the 'Updates'
+// channel. Once it has reached all of its confirmations, a notification will be
+// sent across the 'Confirmed' channel.
+func (e *EsploraNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	pkScript []byte,
+	numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	// Register the conf notification with the TxNotifier. A non-nil value
+	// for `dispatch` will be returned if we are required to perform a
+	// lookup for the confirmation. Otherwise the notifier will begin
+	// watching at tip for the transaction to confirm.
+	ntfn, err := e.txNotifier.RegisterConf(
+		txid, pkScript, numConfs, heightHint,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if ntfn.HistoricalDispatch == nil {
+		return ntfn.Event, nil
+	}
+
+	select {
+	case e.notificationRegistry <- ntfn.HistoricalDispatch:
+		return ntfn.Event, nil
+	case <-e.quit:
+		return nil, chainntnfs.ErrChainNotifierShuttingDown
+	}
+}
+
+// blockEpochRegistration represents a client's intent to receive a
+// notification with each newly connected block.
+type blockEpochRegistration struct {
+	epochID uint64
+
+	epochChan chan *chainntnfs.BlockEpoch
+
+	epochQueue *queue.ConcurrentQueue
+
+	bestBlock *chainntnfs.BlockEpoch
+
+	errorChan chan error
+
+	cancelChan chan struct{}
+
+	wg sync.WaitGroup
+}
+
+// epochCancel is a message sent to the EsploraNotifier when a client wishes
+// to cancel an outstanding epoch notification that has yet to be dispatched.
+type epochCancel struct {
+	epochID uint64
+}
+
+// RegisterBlockEpochNtfn returns a BlockEpochEvent which subscribes the
+// caller to receive notifications, of each new block connected to the main
+// chain. Clients have the option of passing in their best known block, which
+// the notifier uses to check if they are behind on blocks and catch them up. If
+// they do not provide one, then a notification will be dispatched immediately
+// for the current tip of the chain upon a successful registration.
+func (e *EsploraNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	reg := &blockEpochRegistration{
+		epochQueue: queue.NewConcurrentQueue(20),
+		epochChan:  make(chan *chainntnfs.BlockEpoch, 20),
+		cancelChan: make(chan struct{}),
+		epochID:    atomic.AddUint64(&e.epochClientCounter, 1),
+		bestBlock:  bestBlock,
+		errorChan:  make(chan error, 1),
+	}
+	reg.epochQueue.Start()
+
+	// Before we send the request to the main goroutine, we'll launch a new
+	// goroutine to proxy items added to our queue to the client itself.
+	// This ensures that all notifications are received *in order*.
+	reg.wg.Add(1)
+	go func() {
+		defer reg.wg.Done()
+
+		for {
+			select {
+			case ntfn := <-reg.epochQueue.ChanOut():
+				blockNtfn := ntfn.(*chainntnfs.BlockEpoch)
+				select {
+				case reg.epochChan <- blockNtfn:
+
+				case <-reg.cancelChan:
+					return
+
+				case <-e.quit:
+					return
+				}
+
+			case <-reg.cancelChan:
+				return
+
+			case <-e.quit:
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-e.quit:
+		// As we're exiting before the registration could be sent,
+		// we'll stop the queue now ourselves.
+		reg.epochQueue.Stop()
+
+		return nil, errors.New("chainntnfs: system interrupt while " +
+			"attempting to register for block epoch notification.")
+	case e.notificationRegistry <- reg:
+		return &chainntnfs.BlockEpochEvent{
+			Epochs: reg.epochChan,
+			Cancel: func() {
+				cancel := &epochCancel{
+					epochID: reg.epochID,
+				}
+
+				// Submit epoch cancellation to notification dispatcher.
+				select {
+				case e.notificationCancels <- cancel:
+					// Cancellation is being handled, drain
+					// the epoch channel until it is closed
+					// before yielding to caller.
+					for {
+						select {
+						case _, ok := <-reg.epochChan:
+							if !ok {
+								return
+							}
+						case <-e.quit:
+							return
+						}
+					}
+				case <-e.quit:
+				}
+			},
+		}, nil
+	}
+}
@@ -313,6 +313,97 @@ type BlockEpochEvent struct {
 	Cancel func()
 }
 
+// BlockNotifier is an optional interface implemented by ChainNotifiers that
+// process the full content of each block. Unlike block epochs, it allows
+// clients to follow the main chain including its reorganizations, along with
+// the transactions relevant to them.
+type BlockNotifier interface {
+	// SubscribeBlocks registers an intent to be notified of each block
+	// connected to and disconnected from the tip of the main chain. The
+	// events of connected blocks include the transactions matching the
+	// given filter, which may be nil if the caller isn't interested in
+	// any transactions.
+	SubscribeBlocks(filter *BlockFilter) (*BlockSubscription, error)
+}
+
+// BlockFilter describes the transactions a block subscription is interested
+// in.
+type BlockFilter struct {
+	// PkScripts is the set of output scripts to match. A transaction
+	// matches if any of its outputs pays to one of them.
+	PkScripts [][]byte
+
+	// OutPoints is the set of outpoints to match. A transaction matches if
+	// it spends any of them.
+	OutPoints []wire.OutPoint
+}
+
+// BlockEventType denotes whether a block was connected to or disconnected
+// from the tip of the main chain.
+type BlockEventType uint8
+
+const (
+	// BlockConnected denotes that a block was connected to the tip of the
+	// main chain.
+	BlockConnected BlockEventType = iota
+
+	// BlockDisconnected denotes that the tip of the main chain was
+	// disconnected due to a reorganization.
+	BlockDisconnected
+)
+
+// String returns the string representation of the BlockEventType.
+func (t BlockEventType) String() string {
+	switch t {
+	case BlockConnected:
+		return "BlockConnected"
+
+	case BlockDisconnected:
+		return "BlockDisconnected"
+
+	default:
+		return "unknown"
+	}
+}
+
+// BlockEvent describes a change of the tip of the main chain.
+type BlockEvent struct {
+	// Type denotes whether the block was connected or disconnected.
+	Type BlockEventType
+
+	// Hash is the hash of the block. It is nil for disconnected blocks
+	// which were connected before the notifier started, as their hash
+	// isn't known anymore.
+	Hash *chainhash.Hash
+
+	// Height is the height of the block.
+	Height uint32
+
+	// Txns are the transactions of a connected block matching the filter
+	// of the subscription, in the order they appear in the block.
+	Txns []*wire.MsgTx
+}
+
+// BlockSubscription encapsulates an on-going stream of block events.
+//
+// NOTE: If the caller wishes to cancel their block subscription, the Cancel
+// closure MUST be called.
+type BlockSubscription struct {
+	// Events is a receive only channel that will be sent upon each time a
+	// block is connected to or disconnected from the tip of the main
+	// chain. It is closed once the notifier shuts down.
+	Events <-chan *BlockEvent
+
+	// Height is the height of the tip of the main chain at the time of the
+	// subscription. The first event is for the block following it, or for
+	// the disconnection of the tip itself.
+	Height uint32
+
+	// Cancel is a closure that should be executed by the caller in the case
+	// that they wish to abandon their block subscription.
+	Cancel func()
+}
+
 // NotifierDriver represents a "driver" for a particular interface. A driver is
 // identified by a globally unique string identifier along with a 'New()'
 // method which is responsible for initializing a particular ChainNotifier
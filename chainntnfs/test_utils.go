@@ -18,12 +18,15 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/integration/rpctest"
+	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/btcwallet/chain"
 	"github.com/btcsuite/btcwallet/walletdb"
 	"github.com/lightninglabs/neutrino"
+	"github.com/lightningnetwork/lnd/electrum"
+	"github.com/lightningnetwork/lnd/esplora"
 )
 
 var (
@@ -302,3 +305,56 @@ func NewNeutrinoBackend(t *testing.T, minerAddr string) (*neutrino.ChainService,
 		os.RemoveAll(spvDir)
 	}
 }
+
+// NewElectrumBackend spawns a new Electrum test server backed by the btcd node
+// reachable with the given RPC config, and returns a client connected to it.
+func NewElectrumBackend(t *testing.T,
+	rpcConfig rpcclient.ConnConfig) (*electrum.Client, func()) {
+
+	t.Helper()
+
+	server, err := electrum.NewTestServer(&rpcConfig)
+	if err != nil {
+		t.Fatalf("unable to create electrum test server: %v", err)
+	}
+
+	client := electrum.NewClient(&electrum.ClientConfig{
+		Server: server.Addr(),
+	})
+	if err := client.Start(); err != nil {
+		server.Stop()
+		t.Fatalf("unable to start electrum client: %v", err)
+	}
+
+	return client, func() {
+		client.Stop()
+		server.Stop()
+	}
+}
+
+// NewEsploraBackend spawns a new Esplora test server backed by the btcd node
+// reachable with the given RPC config, and returns a client of it.
+func NewEsploraBackend(t *testing.T,
+	rpcConfig rpcclient.ConnConfig) (*esplora.Client, func()) {
+
+	t.Helper()
+
+	server, err := esplora.NewTestServer(&rpcConfig)
+	if err != nil {
+		t.Fatalf("unable to create esplora test server: %v", err)
+	}
+
+	client := esplora.NewClient(&esplora.ClientConfig{
+		URL:          server.URL(),
+		PollInterval: 100 * time.Millisecond,
+	})
+	if err := client.Start(); err != nil {
+		server.Stop()
+		t.Fatalf("unable to start esplora client: %v", err)
+	}
+
+	return client, func() {
+		client.Stop()
+		server.Stop()
+	}
+}
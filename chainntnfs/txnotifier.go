@@ -12,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/queue"
 )
 
 const (
@@ -446,6 +447,50 @@ type SpendRegistration struct {
 	Height uint32
 }
 
+// blockSubscription represents a client's intent to be notified of the blocks
+// connected to and disconnected from the tip of the chain.
+type blockSubscription struct {
+	// id is the unique identifier of the subscription.
+	id uint64
+
+	// pkScripts and outPoints are the output scripts and outpoints a
+	// transaction must pay to or spend in order to be included in the
+	// events of connected blocks.
+	pkScripts map[string]struct{}
+	outPoints map[wire.OutPoint]struct{}
+
+	// queue buffers the events of the subscription so that the TxNotifier
+	// is never blocked by a slow client.
+	queue *queue.ConcurrentQueue
+
+	// events is the channel through which events are delivered to the
+	// client in order.
+	events chan *BlockEvent
+
+	// cancel is closed once the client cancels the subscription.
+	cancel chan struct{}
+
+	wg sync.WaitGroup
+}
+
+// matchesTx determines whether the transaction pays to or spends any of the
+// output scripts or outpoints of the subscription.
+func (s *blockSubscription) matchesTx(tx *wire.MsgTx) bool {
+	for _, txIn := range tx.TxIn {
+		if _, ok := s.outPoints[txIn.PreviousOutPoint]; ok {
+			return true
+		}
+	}
+
+	for _, txOut := range tx.TxOut {
+		if _, ok := s.pkScripts[string(txOut.PkScript)]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // TxNotifier is a struct responsible for delivering transaction notifications
 // to subscribers. These notifications can be of two different types:
 // transaction/output script confirmations and/or outpoint/output script spends.
@@ -454,6 +499,7 @@ type SpendRegistration struct {
 type TxNotifier struct {
 	confClientCounter  uint64 // To be used atomically.
 	spendClientCounter uint64 // To be used atomically.
+	blockClientCounter uint64 // To be used atomically.
 
 	// currentHeight is the height of the tracked blockchain. It is used to
 	// determine the number of confirmations a tx has and ensure blocks are
@@ -509,6 +555,16 @@ type TxNotifier struct {
 	// earliest height at which they could have been spent within the chain.
 	spendHintCache SpendHintCache
 
+	// blockHashes is an index of the hashes of the blocks that are still
+	// under the risk of being reorged out of the chain by their height.
+	// This is tracked so that block subscriptions can be notified of the
+	// hash of a disconnected block.
+	blockHashes map[uint32]chainhash.Hash
+
+	// blockSubscriptions is the set of active block subscriptions by their
+	// ID.
+	blockSubscriptions map[uint64]*blockSubscription
+
 	// quit is closed in order to signal that the notifier is gracefully
 	// exiting.
 	quit chan struct{}
@@ -534,6 +590,8 @@ func NewTxNotifier(startHeight uint32, reorgSafetyLimit uint32,
 		spendsByHeight:       make(map[uint32]map[SpendRequest]struct{}),
 		confirmHintCache:     confirmHintCache,
 		spendHintCache:       spendHintCache,
+		blockHashes:          make(map[uint32]chainhash.Hash),
+		blockSubscriptions:   make(map[uint64]*blockSubscription),
 		quit:                 make(chan struct{}),
 	}
 }
@@ -1353,6 +1411,18 @@ func (n *TxNotifier) ConnectTip(blockHash *chainhash.Hash, blockHeight uint32,
 	// caches, along with all of our unconfirmed and unspent requests.
 	n.updateHints(blockHeight)
 
+	// We'll also notify our block subscriptions of the new block, and
+	// remember its hash in case it is disconnected later on.
+	if blockHash != nil {
+		n.blockHashes[blockHeight] = *blockHash
+	}
+	err := n.notifyBlockSubscriptions(
+		BlockConnected, blockHash, blockHeight, txns,
+	)
+	if err != nil {
+		return err
+	}
+
 	// Finally, we'll clear the entries from our set of notifications for
 	// requests that are no longer under the risk of being reorged out of
 	// the chain.
@@ -1385,6 +1455,7 @@ func (n *TxNotifier) ConnectTip(blockHash *chainhash.Hash, blockHeight uint32,
 			delete(n.spendNotifications, spendRequest)
 		}
 		delete(n.spendsByHeight, matureBlockHeight)
+		delete(n.blockHashes, matureBlockHeight)
 	}
 
 	return nil
@@ -1744,7 +1815,18 @@ func (n *TxNotifier) DisconnectTip(blockHeight uint32) error {
 	delete(n.confsByInitialHeight, blockHeight)
 	delete(n.spendsByHeight, blockHeight)
 
-	return nil
+	// Finally, we'll notify our block subscriptions of the block being
+	// disconnected. Its hash is only known if it was connected while we
+	// were running.
+	var blockHash *chainhash.Hash
+	if hash, ok := n.blockHashes[blockHeight]; ok {
+		blockHash = &hash
+	}
+	delete(n.blockHashes, blockHeight)
+
+	return n.notifyBlockSubscriptions(
+		BlockDisconnected, blockHash, blockHeight, nil,
+	)
 }
 
 // updateHints attempts to update the confirm and spend hints for all relevant
@@ -1906,6 +1988,139 @@ func (n *TxNotifier) dispatchSpendReorg(ntfn *SpendNtfn) error {
 	return nil
 }
 
+// SubscribeBlocks registers an intent to be notified of each block connected
+// to and disconnected from the tip of the chain. The events of connected blocks
+// include the transactions matching the given filter, if any.
+//
+// NOTE: Only the transactions handed to ConnectTip are matched, so the owner of
+// the TxNotifier must provide the full content of each block for the filter to
+// be reliable.
+func (n *TxNotifier) SubscribeBlocks(
+	filter *BlockFilter) (*BlockSubscription, error) {
+
+	select {
+	case <-n.quit:
+		return nil, ErrTxNotifierExiting
+	default:
+	}
+
+	sub := &blockSubscription{
+		id:        atomic.AddUint64(&n.blockClientCounter, 1),
+		pkScripts: make(map[string]struct{}),
+		outPoints: make(map[wire.OutPoint]struct{}),
+		queue:     queue.NewConcurrentQueue(20),
+		events:    make(chan *BlockEvent, 20),
+		cancel:    make(chan struct{}),
+	}
+	if filter != nil {
+		for _, pkScript := range filter.PkScripts {
+			sub.pkScripts[string(pkScript)] = struct{}{}
+		}
+		for _, outPoint := range filter.OutPoints {
+			sub.outPoints[outPoint] = struct{}{}
+		}
+	}
+
+	sub.queue.Start()
+
+	// We'll launch a goroutine to proxy the events added to the queue to
+	// the client itself, which ensures they are received in order.
+	sub.wg.Add(1)
+	go n.forwardBlockEvents(sub)
+
+	n.Lock()
+	n.blockSubscriptions[sub.id] = sub
+	height := n.currentHeight
+	n.Unlock()
+
+	Log.Infof("New block subscription (id=%d) at height %d", sub.id,
+		height)
+
+	var cancelOnce sync.Once
+	return &BlockSubscription{
+		Events: sub.events,
+		Height: height,
+		Cancel: func() {
+			cancelOnce.Do(func() {
+				n.cancelBlockSubscription(sub)
+			})
+		},
+	}, nil
+}
+
+// cancelBlockSubscription removes the block subscription, after which no
+// further events will be delivered to it.
+func (n *TxNotifier) cancelBlockSubscription(sub *blockSubscription) {
+	Log.Infof("Canceling block subscription (id=%d)", sub.id)
+
+	n.Lock()
+	delete(n.blockSubscriptions, sub.id)
+	n.Unlock()
+
+	close(sub.cancel)
+	sub.wg.Wait()
+	sub.queue.Stop()
+}
+
+// forwardBlockEvents delivers the events queued for the block subscription to
+// the client until it cancels the subscription or the TxNotifier exits.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *TxNotifier) forwardBlockEvents(sub *blockSubscription) {
+	defer sub.wg.Done()
+	defer close(sub.events)
+
+	for {
+		select {
+		case item := <-sub.queue.ChanOut():
+			select {
+			case sub.events <- item.(*BlockEvent):
+			case <-sub.cancel:
+				return
+			case <-n.quit:
+				return
+			}
+
+		case <-sub.cancel:
+			return
+
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// notifyBlockSubscriptions queues an event for the block being connected or
+// disconnected for all block subscriptions, along with the transactions
+// matching their filter.
+//
+// NOTE: This must be called with the TxNotifier's lock held.
+func (n *TxNotifier) notifyBlockSubscriptions(eventType BlockEventType,
+	blockHash *chainhash.Hash, blockHeight uint32,
+	txns []*btcutil.Tx) error {
+
+	for _, sub := range n.blockSubscriptions {
+		event := &BlockEvent{
+			Type:   eventType,
+			Hash:   blockHash,
+			Height: blockHeight,
+		}
+		for _, tx := range txns {
+			if sub.matchesTx(tx.MsgTx()) {
+				event.Txns = append(event.Txns, tx.MsgTx())
+			}
+		}
+
+		select {
+		case sub.queue.ChanIn() <- event:
+		case <-n.quit:
+			return ErrTxNotifierExiting
+		}
+	}
+
+	return nil
+}
+
 // TearDown is to be called when the owner of the TxNotifier is exiting. This
 // closes the event channels of all registered notifications that have not been
 // dispatched yet.
@@ -1931,4 +2146,11 @@ func (n *TxNotifier) TearDown() {
 			close(ntfn.Event.Done)
 		}
 	}
+
+	// The event channels of block subscriptions are closed by their
+	// forwarding goroutines, so we only need to stop their queues.
+	for id, sub := range n.blockSubscriptions {
+		sub.queue.Stop()
+		delete(n.blockSubscriptions, id)
+	}
 }
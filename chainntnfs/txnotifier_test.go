@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -2189,6 +2190,126 @@ func TestTxNotifierTearDown(t *testing.T) {
 	}
 }
 
+// TestTxNotifierBlockSubscription ensures that block subscriptions are notified
+// of connected and disconnected blocks, and only receive the transactions of a
+// connected block that match their filter.
+func TestTxNotifierBlockSubscription(t *testing.T) {
+	t.Parallel()
+
+	hintCache := newMockHintCache()
+	n := chainntnfs.NewTxNotifier(
+		10, chainntnfs.ReorgSafetyLimit, hintCache, hintCache,
+	)
+
+	// We'll create a subscription matching transactions that spend a
+	// specific outpoint or pay to our test script, along with one that
+	// isn't interested in any transactions.
+	watchedOutPoint := wire.OutPoint{Index: 1}
+	filtered, err := n.SubscribeBlocks(&chainntnfs.BlockFilter{
+		PkScripts: [][]byte{testRawScript},
+		OutPoints: []wire.OutPoint{watchedOutPoint},
+	})
+	if err != nil {
+		t.Fatalf("unable to subscribe to blocks: %v", err)
+	}
+	defer filtered.Cancel()
+
+	unfiltered, err := n.SubscribeBlocks(nil)
+	if err != nil {
+		t.Fatalf("unable to subscribe to blocks: %v", err)
+	}
+
+	if filtered.Height != 10 {
+		t.Fatalf("expected subscription at height 10, got %d",
+			filtered.Height)
+	}
+
+	spendTx := wire.NewMsgTx(1)
+	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: watchedOutPoint})
+	payTx := wire.NewMsgTx(2)
+	payTx.AddTxOut(&wire.TxOut{PkScript: testRawScript})
+	otherTx := wire.NewMsgTx(3)
+	otherTx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 2}})
+	otherTx.AddTxOut(&wire.TxOut{PkScript: testSigScript})
+
+	block := btcutil.NewBlock(&wire.MsgBlock{
+		Transactions: []*wire.MsgTx{otherTx, spendTx, payTx},
+	})
+	blockHash := block.Hash()
+	err = n.ConnectTip(blockHash, 11, block.Transactions())
+	if err != nil {
+		t.Fatalf("unable to connect block: %v", err)
+	}
+
+	assertEvent := func(sub *chainntnfs.BlockSubscription,
+		eventType chainntnfs.BlockEventType, height uint32,
+		txns ...*wire.MsgTx) {
+
+		t.Helper()
+
+		var event *chainntnfs.BlockEvent
+		select {
+		case event = <-sub.Events:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %v event", eventType)
+		}
+
+		if event.Type != eventType {
+			t.Fatalf("expected %v event, got %v", eventType,
+				event.Type)
+		}
+		if event.Height != height {
+			t.Fatalf("expected height %d, got %d", height,
+				event.Height)
+		}
+		if event.Hash == nil || !event.Hash.IsEqual(blockHash) {
+			t.Fatalf("expected hash %v, got %v", blockHash,
+				event.Hash)
+		}
+		if len(event.Txns) != len(txns) {
+			t.Fatalf("expected %d transactions, got %d", len(txns),
+				len(event.Txns))
+		}
+		for i, tx := range txns {
+			if event.Txns[i].TxHash() != tx.TxHash() {
+				t.Fatalf("expected transaction %v, got %v",
+					tx.TxHash(), event.Txns[i].TxHash())
+			}
+		}
+	}
+
+	// Only the transactions spending the watched outpoint and paying to
+	// the watched script should be included, in block order.
+	assertEvent(filtered, chainntnfs.BlockConnected, 11, spendTx, payTx)
+	assertEvent(unfiltered, chainntnfs.BlockConnected, 11)
+
+	// Once the subscription without a filter is canceled, its event channel
+	// should be closed.
+	unfiltered.Cancel()
+	if _, ok := <-unfiltered.Events; ok {
+		t.Fatal("expected closed event channel")
+	}
+
+	// Disconnecting the block should notify the remaining subscription
+	// along with the hash of the disconnected block.
+	if err := n.DisconnectTip(11); err != nil {
+		t.Fatalf("unable to disconnect block: %v", err)
+	}
+	assertEvent(filtered, chainntnfs.BlockDisconnected, 11)
+
+	// Finally, tearing down the notifier should close the event channel of
+	// the remaining subscription.
+	n.TearDown()
+	select {
+	case _, ok := <-filtered.Events:
+		if ok {
+			t.Fatal("expected closed event channel")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected closed event channel")
+	}
+}
+
 func assertConfDetails(t *testing.T, result, expected *chainntnfs.TxConfirmation) {
 	t.Helper()
 
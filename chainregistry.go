@@ -1,10 +1,13 @@
 package lnd
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -23,8 +26,12 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/chainntnfs/bitcoindnotify"
 	"github.com/lightningnetwork/lnd/chainntnfs/btcdnotify"
+	"github.com/lightningnetwork/lnd/chainntnfs/electrumnotify"
+	"github.com/lightningnetwork/lnd/chainntnfs/esploranotify"
 	"github.com/lightningnetwork/lnd/chainntnfs/neutrinonotify"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/electrum"
+	"github.com/lightningnetwork/lnd/esplora"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -236,6 +243,84 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			activeNetParams.Params, neutrinoCS,
 		)
 
+	case "electrum":
+		// We'll connect to the Electrum server, which will be shared
+		// by the ChainNotifier, FilteredChainView, fee estimator and
+		// the wallet's ChainSource.
+		electrumClient, err := newElectrumClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if err := electrumClient.Start(); err != nil {
+			return nil, fmt.Errorf("unable to connect to electrum "+
+				"server: %v", err)
+		}
+
+		cc.chainNotifier = electrumnotify.New(
+			electrumClient, hintCache, hintCache,
+		)
+		cc.chainView = chainview.NewElectrumFilteredChainView(
+			electrumClient,
+		)
+		walletConfig.ChainSource = electrum.NewChainClient(
+			electrumClient, activeNetParams.Params,
+		)
+
+		// If we're not in regtest mode, then we'll use the fee
+		// estimates of the server rather than a statically coded
+		// value.
+		if !cfg.Bitcoin.RegTest {
+			ltndLog.Infof("Initializing electrum backed fee " +
+				"estimator")
+
+			fallBackFeeRate := lnwallet.SatPerKVByte(25 * 1000)
+			cc.feeEstimator = lnwallet.NewElectrumFeeEstimator(
+				electrumClient, fallBackFeeRate.FeePerKWeight(),
+			)
+			if err := cc.feeEstimator.Start(); err != nil {
+				return nil, err
+			}
+		}
+
+	case "esplora":
+		// As with Electrum, a single client talking to the Esplora
+		// server is shared by all of the chain dependent subsystems.
+		esploraClient := esplora.NewClient(&esplora.ClientConfig{
+			URL:          cfg.EsploraMode.URL,
+			Dial:         cfg.net.Dial,
+			PollInterval: cfg.EsploraMode.PollInterval,
+		})
+		if err := esploraClient.Start(); err != nil {
+			return nil, fmt.Errorf("unable to connect to esplora "+
+				"server: %v", err)
+		}
+
+		cc.chainNotifier = esploranotify.New(
+			esploraClient, hintCache, hintCache,
+		)
+		cc.chainView = chainview.NewEsploraFilteredChainView(
+			esploraClient,
+		)
+		walletConfig.ChainSource = esplora.NewChainClient(
+			esploraClient, activeNetParams.Params,
+		)
+
+		// If we're not in regtest mode, then we'll use the fee
+		// estimates of the server rather than a statically coded
+		// value.
+		if !cfg.Bitcoin.RegTest {
+			ltndLog.Infof("Initializing esplora backed fee " +
+				"estimator")
+
+			fallBackFeeRate := lnwallet.SatPerKVByte(25 * 1000)
+			cc.feeEstimator = lnwallet.NewEsploraFeeEstimator(
+				esploraClient, fallBackFeeRate.FeePerKWeight(),
+			)
+			if err := cc.feeEstimator.Start(); err != nil {
+				return nil, err
+			}
+		}
+
 	case "bitcoind", "litecoind":
 		var bitcoindMode *bitcoindConfig
 		switch {
@@ -461,6 +546,18 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			homeChainConfig.Node)
 	}
 
+	// If the composite fee estimator has been configured, we'll combine
+	// the estimates of the chain backend with those of the configured web
+	// APIs, bounded by the configured fee rates.
+	if cfg.FeeEstimator.Active() {
+		cc.feeEstimator, err = newCompositeFeeEstimator(
+			cfg, homeChainConfig.Node, cc.feeEstimator,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	wc, err := btcwallet.New(*walletConfig)
 	if err != nil {
 		fmt.Printf("unable to create wallet controller: %v\n", err)
@@ -513,6 +610,113 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 	return cc, nil
 }
 
+// newCompositeFeeEstimator creates a composite fee estimator querying the
+// given fee estimator of the chain backend, which must already be started,
+// along with the web APIs specified within the config.
+func newCompositeFeeEstimator(cfg *config, backend string,
+	backendEstimator lnwallet.FeeEstimator) (lnwallet.FeeEstimator, error) {
+
+	feeCfg := cfg.FeeEstimator
+
+	sources := []lnwallet.FeeSource{{
+		Name:      backend,
+		Estimator: backendEstimator,
+	}}
+	for _, feeURL := range feeCfg.FeeURLs {
+		// The URL may contain credentials of the API, so we'll only
+		// expose its host as the name of the source.
+		name := feeURL
+		if u, err := url.Parse(feeURL); err == nil && u.Host != "" {
+			name = u.Host
+		}
+
+		estimator := lnwallet.NewWebAPIFeeEstimator(
+			lnwallet.SparseConfFeeSource{
+				URL: feeURL,
+			},
+			defaultBitcoinStaticFeePerKW,
+		)
+
+		// As the backend's estimator has already been started, we'll
+		// only start the web APIs rather than the composite estimator
+		// as a whole.
+		if err := estimator.Start(); err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, lnwallet.FeeSource{
+			Name:      name,
+			Estimator: estimator,
+		})
+	}
+
+	ltndLog.Infof("Using composite fee estimator with %d sources",
+		len(sources))
+
+	var maxFeePerKW lnwallet.SatPerKWeight
+	if feeCfg.MaxFeeRate != 0 {
+		maxFeePerKW = lnwallet.SatPerKVByte(
+			feeCfg.MaxFeeRate * 1000,
+		).FeePerKWeight()
+	}
+
+	return lnwallet.NewCompositeFeeEstimator(
+		lnwallet.CompositeFeeEstimatorConfig{
+			Sources:      sources,
+			Percentile:   feeCfg.Percentile,
+			MaxDeviation: feeCfg.MaxDeviation,
+			MinSources:   feeCfg.MinSources,
+			MinFeePerKW: lnwallet.SatPerKVByte(
+				feeCfg.MinFeeRate * 1000,
+			).FeePerKWeight(),
+			MaxFeePerKW: maxFeePerKW,
+		},
+	), nil
+}
+
+// newElectrumClient creates a new Electrum client connecting to the server
+// specified within the config. Unless disabled, the connection is secured
+// using TLS, verifying the server's certificate against the configured
+// certificate or the system's root certificates.
+func newElectrumClient(cfg *config) (*electrum.Client, error) {
+	electrumMode := cfg.ElectrumMode
+
+	var tlsConfig *tls.Config
+	if !electrumMode.NoTLS {
+		host, _, err := net.SplitHostPort(electrumMode.Server)
+		if err != nil {
+			return nil, fmt.Errorf("invalid electrum server "+
+				"address: %v", err)
+		}
+
+		tlsConfig = &tls.Config{
+			ServerName:         host,
+			InsecureSkipVerify: electrumMode.TLSSkipVerify,
+		}
+
+		if electrumMode.TLSCertPath != "" {
+			cert, err := ioutil.ReadFile(electrumMode.TLSCertPath)
+			if err != nil {
+				return nil, err
+			}
+
+			certPool := x509.NewCertPool()
+			if !certPool.AppendCertsFromPEM(cert) {
+				return nil, fmt.Errorf("unable to parse "+
+					"electrum server certificate %v",
+					electrumMode.TLSCertPath)
+			}
+			tlsConfig.RootCAs = certPool
+		}
+	}
+
+	return electrum.NewClient(&electrum.ClientConfig{
+		Server:    electrumMode.Server,
+		TLSConfig: tlsConfig,
+		Dial:      cfg.net.Dial,
+	}), nil
+}
+
 var (
 	// bitcoinTestnetGenesis is the genesis hash of Bitcoin's testnet
 	// chain.
@@ -8,6 +8,7 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -75,8 +76,9 @@ type chanCloseCfg struct {
 	// further HTLC's should be routed through the channel.
 	unregisterChannel func(lnwire.ChannelID)
 
-	// broadcastTx broadcasts the passed transaction to the network.
-	broadcastTx func(*wire.MsgTx) error
+	// broadcastTx broadcasts the passed transaction to the network,
+	// storing the given label for it.
+	broadcastTx func(*wire.MsgTx, string) error
 
 	// disableChannel disables a channel, resulting in it not being able to
 	// forward payments.
@@ -449,7 +451,11 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 			newLogClosure(func() string {
 				return spew.Sdump(closeTx)
 			}))
-		if err := c.cfg.broadcastTx(closeTx); err != nil {
+		shortChanID := c.cfg.channel.ShortChanID()
+		label := labels.MakeLabel(
+			labels.LabelTypeChannelClose, &shortChanID,
+		)
+		if err := c.cfg.broadcastTx(closeTx, label); err != nil {
 			return nil, false, err
 		}
 
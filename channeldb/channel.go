@@ -1795,10 +1795,9 @@ func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg) error {
 		}
 
 		// With the commitment pointer swapped, we can now add the
-		// revoked (prior) state to the revocation log.
-		//
-		// TODO(roasbeef): store less
-		err = appendChannelLogEntry(logBucket, &c.RemoteCommitment)
+		// compact form of the revoked (prior) state to the revocation
+		// log.
+		err = putRevocationLog(logBucket, &c.RemoteCommitment)
 		if err != nil {
 			return err
 		}
@@ -1922,7 +1921,7 @@ func (c *OpenChannel) RemoveFwdPkg(height uint64) error {
 // commitment chain. The ChannelDelta returned by this method will always lag
 // one state behind the most current (unrevoked) state of the remote node's
 // commitment chain.
-func (c *OpenChannel) RevocationLogTail() (*ChannelCommitment, error) {
+func (c *OpenChannel) RevocationLogTail() (*RevocationLog, error) {
 	c.RLock()
 	defer c.RUnlock()
 
@@ -1932,7 +1931,7 @@ func (c *OpenChannel) RevocationLogTail() (*ChannelCommitment, error) {
 		return nil, nil
 	}
 
-	var commit ChannelCommitment
+	var tail *RevocationLog
 	if err := c.Db.View(func(tx *bbolt.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
@@ -1951,13 +1950,18 @@ func (c *OpenChannel) RevocationLogTail() (*ChannelCommitment, error) {
 		// store the update number on disk in a big-endian format,
 		// this will retrieve the latest entry.
 		cursor := logBucket.Cursor()
-		_, tailLogEntry := cursor.Last()
+		tailLogKey, tailLogEntry := cursor.Last()
+		if tailLogKey == nil {
+			return ErrNoPastDeltas
+		}
 		logEntryReader := bytes.NewReader(tailLogEntry)
 
-		// Once we have the entry, we'll decode it into the channel
-		// delta pointer we created above.
+		// Once we have the entry, we'll decode it into the revocation
+		// log pointer we created above.
 		var dbErr error
-		commit, dbErr = deserializeChanCommit(logEntryReader)
+		tail, dbErr = deserializeRevocationLog(
+			logEntryReader, byteOrder.Uint64(tailLogKey),
+		)
 		if dbErr != nil {
 			return dbErr
 		}
@@ -1967,7 +1971,7 @@ func (c *OpenChannel) RevocationLogTail() (*ChannelCommitment, error) {
 		return nil, err
 	}
 
-	return &commit, nil
+	return tail, nil
 }
 
 // CommitmentHeight returns the current commitment height. The commitment
@@ -2010,11 +2014,11 @@ func (c *OpenChannel) CommitmentHeight() (uint64, error) {
 // intended to be used for obtaining the relevant data needed to claim all
 // funds rightfully spendable in the case of an on-chain broadcast of the
 // commitment transaction.
-func (c *OpenChannel) FindPreviousState(updateNum uint64) (*ChannelCommitment, error) {
+func (c *OpenChannel) FindPreviousState(updateNum uint64) (*RevocationLog, error) {
 	c.RLock()
 	defer c.RUnlock()
 
-	var commit ChannelCommitment
+	var rl *RevocationLog
 	err := c.Db.View(func(tx *bbolt.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
@@ -2028,19 +2032,14 @@ func (c *OpenChannel) FindPreviousState(updateNum uint64) (*ChannelCommitment, e
 			return ErrNoPastDeltas
 		}
 
-		c, err := fetchChannelLogEntry(logBucket, updateNum)
-		if err != nil {
-			return err
-		}
-
-		commit = c
-		return nil
+		rl, err = fetchRevocationLog(logBucket, updateNum)
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &commit, nil
+	return rl, nil
 }
 
 // ClosureType is an enum like structure that details exactly _how_ a channel
@@ -2790,28 +2789,3 @@ func makeLogKey(updateNum uint64) [8]byte {
 	byteOrder.PutUint64(key[:], updateNum)
 	return key
 }
-
-func appendChannelLogEntry(log *bbolt.Bucket,
-	commit *ChannelCommitment) error {
-
-	var b bytes.Buffer
-	if err := serializeChanCommit(&b, commit); err != nil {
-		return err
-	}
-
-	logEntrykey := makeLogKey(commit.CommitHeight)
-	return log.Put(logEntrykey[:], b.Bytes())
-}
-
-func fetchChannelLogEntry(log *bbolt.Bucket,
-	updateNum uint64) (ChannelCommitment, error) {
-
-	logEntrykey := makeLogKey(updateNum)
-	commitBytes := log.Get(logEntrykey[:])
-	if commitBytes == nil {
-		return ChannelCommitment{}, fmt.Errorf("log entry not found")
-	}
-
-	commitReader := bytes.NewReader(commitBytes)
-	return deserializeChanCommit(commitReader)
-}
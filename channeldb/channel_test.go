@@ -353,6 +353,19 @@ func assertCommitmentEqual(t *testing.T, a, b *ChannelCommitment) {
 	}
 }
 
+// assertRevocationLogEqual asserts that the revocation log entry matches the
+// compact form of the passed revoked commitment.
+func assertRevocationLogEqual(t *testing.T, commit *ChannelCommitment,
+	rl *RevocationLog) {
+
+	expected := newRevocationLog(commit)
+	if !reflect.DeepEqual(expected, rl) {
+		_, _, line, _ := runtime.Caller(1)
+		t.Fatalf("line %v: revocation logs don't match: %v vs %v",
+			line, spew.Sdump(expected), spew.Sdump(rl))
+	}
+}
+
 func TestChannelStateTransition(t *testing.T) {
 	t.Parallel()
 
@@ -551,9 +564,9 @@ func TestChannelStateTransition(t *testing.T) {
 		t.Fatalf("unable to fetch past delta: %v", err)
 	}
 
-	// The two deltas (the original vs the on-disk version) should
-	// identical, and all HTLC data should properly be retained.
-	assertCommitmentEqual(t, &oldRemoteCommit, diskPrevCommit)
+	// The on-disk version should be the compact form of the original
+	// commitment, retaining all non-dust HTLCs.
+	assertRevocationLogEqual(t, &oldRemoteCommit, diskPrevCommit)
 
 	// The state number recovered from the tail of the revocation log
 	// should be identical to this current state.
@@ -589,7 +602,7 @@ func TestChannelStateTransition(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to fetch past delta: %v", err)
 	}
-	assertCommitmentEqual(t, &oldRemoteCommit, prevCommit)
+	assertRevocationLogEqual(t, &oldRemoteCommit, prevCommit)
 
 	// Once again, state number recovered from the tail of the revocation
 	// log should be identical to this current state.
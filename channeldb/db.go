@@ -115,6 +115,12 @@ var (
 			number:    11,
 			migration: migrateInvoices,
 		},
+		{
+			// Store revoked states of the remote party in the
+			// compact revocation log format.
+			number:    12,
+			migration: migrateRevocationLog,
+		},
 	}
 
 	// Big endian is the preferred byte order, due to cursor scans over
@@ -872,6 +878,115 @@ func (d *DB) MarkChanFullyClosed(chanPoint *wire.OutPoint) error {
 	})
 }
 
+// ClosedChannelStateSize returns the number of bytes of state that is still
+// stored for a fully closed channel beyond its close summary, and that
+// PruneClosedChannel would remove.
+func (d *DB) ClosedChannelStateSize(summary *ChannelCloseSummary) (uint64,
+	error) {
+
+	if summary.IsPending {
+		return 0, ErrChanNotFullyClosed
+	}
+
+	var size uint64
+	err := d.View(func(tx *bbolt.Tx) error {
+		size = closedChannelStateSize(tx, summary)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// PruneClosedChannel removes the forwarding packages of a fully closed
+// channel, which are the only state that channeldb still stores for it beyond
+// its close summary. The close summary is kept. The state of the channel
+// itself, including its revocation log, isn't touched by this, as it's
+// already removed once the channel is closed. The number of bytes removed is
+// returned.
+func (d *DB) PruneClosedChannel(summary *ChannelCloseSummary) (uint64,
+	error) {
+
+	var size uint64
+	err := d.Update(func(tx *bbolt.Tx) error {
+		var err error
+		size, err = PruneClosedChannel(tx, summary)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// PruneClosedChannel removes the forwarding packages of a fully closed channel
+// within the given transaction, so other state of the channel can be pruned
+// atomically along with them. The number of bytes removed is returned.
+func PruneClosedChannel(tx *bbolt.Tx, summary *ChannelCloseSummary) (uint64,
+	error) {
+
+	if summary.IsPending {
+		return 0, ErrChanNotFullyClosed
+	}
+
+	size := closedChannelStateSize(tx, summary)
+	if size == 0 {
+		return 0, nil
+	}
+
+	packager := NewChannelPackager(summary.ShortChanID)
+	if err := packager.Wipe(tx); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// closedChannelStateSize returns the number of bytes taken up by the
+// forwarding packages of the given closed channel.
+func closedChannelStateSize(tx *bbolt.Tx, summary *ChannelCloseSummary) uint64 {
+	// A channel that closed before it was confirmed never forwarded any
+	// HTLCs, and doesn't have a short channel ID we could look them up by.
+	if summary.ShortChanID.ToUint64() == 0 {
+		return 0
+	}
+
+	fwdPkgBkt := tx.Bucket(fwdPackagesKey)
+	if fwdPkgBkt == nil {
+		return 0
+	}
+
+	source := makeLogKey(summary.ShortChanID.ToUint64())
+	sourceBkt := fwdPkgBkt.Bucket(source[:])
+	if sourceBkt == nil {
+		return 0
+	}
+
+	return uint64(len(source)) + BucketSize(sourceBkt)
+}
+
+// BucketSize returns the total number of bytes taken up by the keys and
+// values stored within the given bucket, including those of its nested
+// buckets.
+func BucketSize(b *bbolt.Bucket) uint64 {
+	var size uint64
+	b.ForEach(func(k, v []byte) error {
+		size += uint64(len(k) + len(v))
+
+		// A nil value indicates a nested bucket.
+		if v == nil {
+			size += BucketSize(b.Bucket(k))
+		}
+
+		return nil
+	})
+
+	return size
+}
+
 // pruneLinkNode determines whether we should garbage collect a link node from
 // the database due to no longer having any open channels with it. If there are
 // any left, then this acts as a no-op.
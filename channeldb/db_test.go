@@ -14,6 +14,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -163,6 +164,133 @@ func TestFetchClosedChannelForID(t *testing.T) {
 	}
 }
 
+// TestPruneClosedChannel tests that the forwarding packages of a fully closed
+// channel are pruned, while its close summary and the forwarding packages of
+// other channels are kept.
+func TestPruneClosedChannel(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := state.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// We'll add forwarding packages for both the channel we're about to
+	// close, and another channel.
+	otherChanID := lnwire.NewShortChanIDFromInt(
+		state.ShortChanID().ToUint64() + 1,
+	)
+	addFwdPkg := func(source lnwire.ShortChannelID, height uint64) {
+		fwdPkg := NewFwdPkg(source, height, nil, nil)
+		packager := NewChannelPackager(source)
+		err := cdb.Update(func(tx *bbolt.Tx) error {
+			return packager.AddFwdPkg(tx, fwdPkg)
+		})
+		if err != nil {
+			t.Fatalf("unable to add fwd pkg: %v", err)
+		}
+	}
+	addFwdPkg(state.ShortChanID(), 1)
+	addFwdPkg(state.ShortChanID(), 2)
+	addFwdPkg(otherChanID, 1)
+
+	closeSummary := &ChannelCloseSummary{
+		ChanPoint:   state.FundingOutpoint,
+		ShortChanID: state.ShortChanID(),
+		RemotePub:   state.IdentityPub,
+		IsPending:   true,
+	}
+	if err := state.CloseChannel(closeSummary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	// As long as the channel isn't fully closed, its state can't be
+	// pruned.
+	_, err = cdb.PruneClosedChannel(closeSummary)
+	if err != ErrChanNotFullyClosed {
+		t.Fatalf("expected ErrChanNotFullyClosed, got: %v", err)
+	}
+
+	err = cdb.MarkChanFullyClosed(&closeSummary.ChanPoint)
+	if err != nil {
+		t.Fatalf("unable to mark channel fully closed: %v", err)
+	}
+	closeSummary, err = cdb.FetchClosedChannel(&state.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch close summary: %v", err)
+	}
+
+	size, err := cdb.ClosedChannelStateSize(closeSummary)
+	if err != nil {
+		t.Fatalf("unable to fetch state size: %v", err)
+	}
+	if size == 0 {
+		t.Fatalf("expected non-zero state size")
+	}
+
+	pruned, err := cdb.PruneClosedChannel(closeSummary)
+	if err != nil {
+		t.Fatalf("unable to prune channel: %v", err)
+	}
+	if pruned != size {
+		t.Fatalf("expected %v bytes pruned, got %v", size, pruned)
+	}
+
+	// Nothing should be left to prune, and only the forwarding packages of
+	// the other channel should remain.
+	size, err = cdb.ClosedChannelStateSize(closeSummary)
+	if err != nil {
+		t.Fatalf("unable to fetch state size: %v", err)
+	}
+	if size != 0 {
+		t.Fatalf("expected no state left, got %v bytes", size)
+	}
+
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		fwdPkgs, err := loadChannelFwdPkgs(tx, state.ShortChanID())
+		if err != nil {
+			return err
+		}
+		if len(fwdPkgs) != 0 {
+			t.Fatalf("expected no fwd pkgs, found %v",
+				len(fwdPkgs))
+		}
+
+		fwdPkgs, err = loadChannelFwdPkgs(tx, otherChanID)
+		if err != nil {
+			return err
+		}
+		if len(fwdPkgs) != 1 {
+			t.Fatalf("expected 1 fwd pkg, found %v", len(fwdPkgs))
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to load fwd pkgs: %v", err)
+	}
+
+	// The close summary should be kept.
+	_, err = cdb.FetchClosedChannel(&state.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch close summary: %v", err)
+	}
+}
+
 // TestAddrsForNode tests the we're able to properly obtain all the addresses
 // for a target node.
 func TestAddrsForNode(t *testing.T) {
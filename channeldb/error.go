@@ -101,6 +101,10 @@ var (
 	// channels it has closed, but it hasn't yet closed any channels.
 	ErrNoClosedChannels = fmt.Errorf("no channel have been closed yet")
 
+	// ErrChanNotFullyClosed is returned when the state of a closed channel
+	// is to be pruned before the channel is fully resolved.
+	ErrChanNotFullyClosed = fmt.Errorf("channel is not fully closed")
+
 	// ErrNoForwardingEvents is returned in the case that a query fails due
 	// to the log not having any recorded events.
 	ErrNoForwardingEvents = fmt.Errorf("no recorded forwarding events")
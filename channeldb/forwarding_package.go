@@ -401,6 +401,9 @@ type FwdPackager interface {
 	// RemovePkg deletes a forwarding package owned by this channel at
 	// the provided remote `height`.
 	RemovePkg(tx *bbolt.Tx, height uint64) error
+
+	// Wipe deletes all the forwarding packages owned by this channel.
+	Wipe(tx *bbolt.Tx) error
 }
 
 // ChannelPackager is used by a channel to manage the lifecycle of its forwarding
@@ -912,6 +915,22 @@ func (p *ChannelPackager) RemovePkg(tx *bbolt.Tx, height uint64) error {
 	return sourceBkt.DeleteBucket(heightKey[:])
 }
 
+// Wipe deletes all the forwarding packages owned by the packager's source.
+func (p *ChannelPackager) Wipe(tx *bbolt.Tx) error {
+	fwdPkgBkt := tx.Bucket(fwdPackagesKey)
+	if fwdPkgBkt == nil {
+		return nil
+	}
+
+	sourceBytes := makeLogKey(p.source.ToUint64())
+	err := fwdPkgBkt.DeleteBucket(sourceBytes[:])
+	if err != nil && err != bbolt.ErrBucketNotFound {
+		return err
+	}
+
+	return nil
+}
+
 // uint16Key writes the provided 16-bit unsigned integer to a 2-byte slice.
 func uint16Key(i uint16) []byte {
 	key := make([]byte, 2)
@@ -2840,6 +2840,14 @@ func (c *ChannelEdgePolicy) ComputeFee(
 	return c.FeeBaseMSat + (amt*c.FeeProportionalMillionths)/feeRateParts
 }
 
+// InboundFee returns the fee that the advertising node charges for HTLCs that
+// arrive over this channel, as carried in the policy's extra opaque data. A
+// zero fee is returned if the policy doesn't specify an inbound fee.
+func (c *ChannelEdgePolicy) InboundFee() (lnwire.Fee, error) {
+	fee, _, err := lnwire.ParseInboundFee(c.ExtraOpaqueData)
+	return fee, err
+}
+
 // divideCeil divides dividend by factor and rounds the result up.
 func divideCeil(dividend, factor lnwire.MilliSatoshi) lnwire.MilliSatoshi {
 	return (dividend + factor - 1) / factor
@@ -0,0 +1,104 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/coreos/bbolt"
+)
+
+// migrateRevocationLog converts the entries of the revocation log of every
+// open channel from full ChannelCommitments to the compact RevocationLog
+// format, which only retains what's needed to act on a breach.
+func migrateRevocationLog(tx *bbolt.Tx) error {
+	openChanBucket := tx.Bucket(openChannelBucket)
+	if openChanBucket == nil {
+		return nil
+	}
+
+	log.Infof("Migrating revocation logs to compact format")
+
+	// The open channel bucket is nested as node key -> chain hash ->
+	// channel point, so we'll first gather all channel buckets that
+	// carry a revocation log.
+	var logBuckets []*bbolt.Bucket
+	err := forEachNestedBucket(openChanBucket, func(nodeBucket *bbolt.Bucket) error {
+		return forEachNestedBucket(nodeBucket, func(chainBucket *bbolt.Bucket) error {
+			return forEachNestedBucket(chainBucket, func(chanBucket *bbolt.Bucket) error {
+				logBucket := chanBucket.Bucket(revocationLogBucket)
+				if logBucket != nil {
+					logBuckets = append(logBuckets, logBucket)
+				}
+
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var numEntries int
+	for _, logBucket := range logBuckets {
+		// We store the converted keys and values and put them back
+		// into the database after the loop, since modifying the bucket
+		// within the ForEach loop is not safe.
+		var (
+			logKeys   [][]byte
+			logValues [][]byte
+		)
+		err := logBucket.ForEach(func(k, v []byte) error {
+			// Read the full commitment the log used to store.
+			commit, err := deserializeChanCommit(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			var b bytes.Buffer
+			err = serializeRevocationLog(&b, newRevocationLog(&commit))
+			if err != nil {
+				return err
+			}
+
+			logKeys = append(logKeys, k)
+			logValues = append(logValues, b.Bytes())
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("unable to convert revocation log: %v",
+				err)
+		}
+
+		for i := range logKeys {
+			if err := logBucket.Put(logKeys[i], logValues[i]); err != nil {
+				return err
+			}
+		}
+
+		numEntries += len(logKeys)
+	}
+
+	log.Infof("Migration of %d revocation log entries of %d channels "+
+		"completed!", numEntries, len(logBuckets))
+
+	return nil
+}
+
+// forEachNestedBucket calls the passed closure for every nested bucket of the
+// given bucket, skipping plain key/value pairs.
+func forEachNestedBucket(b *bbolt.Bucket, cb func(*bbolt.Bucket) error) error {
+	return b.ForEach(func(k, v []byte) error {
+		// If there's a value, it's not a bucket so ignore it.
+		if v != nil {
+			return nil
+		}
+
+		nested := b.Bucket(k)
+		if nested == nil {
+			return nil
+		}
+
+		return cb(nested)
+	})
+}
@@ -0,0 +1,88 @@
+package channeldb
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/coreos/bbolt"
+)
+
+// TestMigrateRevocationLog checks that the full commitments stored in the
+// revocation log are converted to the compact format.
+func TestMigrateRevocationLog(t *testing.T) {
+	t.Parallel()
+
+	var channel *OpenChannel
+	commit := createTestRevokedCommitment()
+
+	// Store the revoked commitment in the legacy format within the
+	// revocation log of a freshly created channel.
+	beforeMigrationFunc := func(d *DB) {
+		var err error
+		channel, err = createTestChannelState(d)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+
+		addr := &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 18556,
+		}
+		if err := channel.SyncPending(addr, 101); err != nil {
+			t.Fatalf("unable to save channel state: %v", err)
+		}
+
+		err = d.Update(func(tx *bbolt.Tx) error {
+			chanBucket, err := fetchChanBucket(
+				tx, channel.IdentityPub,
+				&channel.FundingOutpoint, channel.ChainHash,
+			)
+			if err != nil {
+				return err
+			}
+
+			logBucket, err := chanBucket.CreateBucketIfNotExists(
+				revocationLogBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			var b bytes.Buffer
+			if err := serializeChanCommit(&b, commit); err != nil {
+				return err
+			}
+
+			logKey := makeLogKey(commit.CommitHeight)
+			return logBucket.Put(logKey[:], b.Bytes())
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// After the migration, the entry should be in the compact format.
+	afterMigrationFunc := func(d *DB) {
+		meta, err := d.FetchMeta(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if meta.DbVersionNumber != 1 {
+			t.Fatal("migration 'revocation log' wasn't applied")
+		}
+
+		rl, err := channel.FindPreviousState(commit.CommitHeight)
+		if err != nil {
+			t.Fatalf("unable to fetch revocation log: %v", err)
+		}
+		assertRevocationLogEqual(t, commit, rl)
+	}
+
+	applyMigration(t,
+		beforeMigrationFunc,
+		afterMigrationFunc,
+		migrateRevocationLog,
+		false)
+}
@@ -0,0 +1,210 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+)
+
+// OutputIndexEmpty is used as the output index of a commitment output that
+// isn't present within the commitment transaction, as its value was below the
+// dust limit.
+const OutputIndexEmpty = math.MaxUint16
+
+// HTLCEntry is the compact on-disk representation of an HTLC output within a
+// revoked commitment transaction of the remote party. It only retains the
+// fields needed to reconstruct the HTLC scripts and to sweep the output in
+// case the revoked state is broadcast.
+type HTLCEntry struct {
+	// RHash is the payment hash of the HTLC.
+	RHash [32]byte
+
+	// RefundTimeout is the absolute timeout on the HTLC that the sender
+	// must wait before reclaiming the funds in limbo.
+	RefundTimeout uint32
+
+	// OutputIndex is the output index of this HTLC within the revoked
+	// commitment transaction.
+	OutputIndex uint16
+
+	// Incoming denotes whether we're the receiver or the sender of this
+	// HTLC.
+	Incoming bool
+
+	// Amt is the value of the HTLC output.
+	Amt btcutil.Amount
+}
+
+// RevocationLog is the compact on-disk representation of a revoked commitment
+// state of the remote party. In contrast to a full ChannelCommitment, it
+// doesn't contain the commitment transaction itself, the signatures or any
+// dust HTLCs, which keeps the size of the revocation log of busy channels in
+// check.
+type RevocationLog struct {
+	// CommitHeight is the update number of the revoked state. It isn't
+	// serialized, as it's the key of the entry within the log.
+	CommitHeight uint64
+
+	// CommitTxHash is the txid of the revoked commitment transaction.
+	CommitTxHash chainhash.Hash
+
+	// OurOutputIndex is the index of the output paying to us within the
+	// revoked commitment transaction, or OutputIndexEmpty if it's dust.
+	OurOutputIndex uint16
+
+	// OurBalance is our balance within the revoked state.
+	OurBalance btcutil.Amount
+
+	// TheirOutputIndex is the index of the delayed output paying to the
+	// remote party within the revoked commitment transaction, or
+	// OutputIndexEmpty if it's dust.
+	TheirOutputIndex uint16
+
+	// TheirBalance is the balance of the remote party within the revoked
+	// state.
+	TheirBalance btcutil.Amount
+
+	// HTLCEntries is the set of non-dust HTLCs that were present within
+	// the revoked commitment transaction.
+	HTLCEntries []*HTLCEntry
+}
+
+// newRevocationLog creates the compact revocation log entry of the passed
+// commitment of the remote party.
+//
+// The indexes of the commitment outputs aren't part of the commitment, so
+// they're recovered from the commitment transaction: the output paying to us
+// is the only P2WPKH output, while the delayed output of the remote party is
+// the only P2WSH output that doesn't belong to an HTLC.
+func newRevocationLog(commit *ChannelCommitment) *RevocationLog {
+	rl := &RevocationLog{
+		CommitHeight:     commit.CommitHeight,
+		CommitTxHash:     commit.CommitTx.TxHash(),
+		OurOutputIndex:   OutputIndexEmpty,
+		OurBalance:       commit.LocalBalance.ToSatoshis(),
+		TheirOutputIndex: OutputIndexEmpty,
+		TheirBalance:     commit.RemoteBalance.ToSatoshis(),
+	}
+
+	htlcOutputs := make(map[int32]struct{}, len(commit.Htlcs))
+	for _, htlc := range commit.Htlcs {
+		// Dust HTLCs don't have an output on the commitment
+		// transaction, so there's nothing to sweep for them.
+		if htlc.OutputIndex < 0 {
+			continue
+		}
+		htlcOutputs[htlc.OutputIndex] = struct{}{}
+
+		rl.HTLCEntries = append(rl.HTLCEntries, &HTLCEntry{
+			RHash:         htlc.RHash,
+			RefundTimeout: htlc.RefundTimeout,
+			OutputIndex:   uint16(htlc.OutputIndex),
+			Incoming:      htlc.Incoming,
+			Amt:           htlc.Amt.ToSatoshis(),
+		})
+	}
+
+	for i, txOut := range commit.CommitTx.TxOut {
+		if _, ok := htlcOutputs[int32(i)]; ok {
+			continue
+		}
+
+		switch {
+		case txscript.IsPayToWitnessPubKeyHash(txOut.PkScript):
+			rl.OurOutputIndex = uint16(i)
+
+		case txscript.IsPayToWitnessScriptHash(txOut.PkScript):
+			rl.TheirOutputIndex = uint16(i)
+		}
+	}
+
+	return rl
+}
+
+// putRevocationLog appends the compact form of the passed revoked commitment
+// of the remote party to the revocation log.
+func putRevocationLog(log *bbolt.Bucket, commit *ChannelCommitment) error {
+	var b bytes.Buffer
+	if err := serializeRevocationLog(&b, newRevocationLog(commit)); err != nil {
+		return err
+	}
+
+	logEntrykey := makeLogKey(commit.CommitHeight)
+	return log.Put(logEntrykey[:], b.Bytes())
+}
+
+// fetchRevocationLog retrieves the revocation log entry of the given update
+// number.
+func fetchRevocationLog(log *bbolt.Bucket,
+	updateNum uint64) (*RevocationLog, error) {
+
+	logEntrykey := makeLogKey(updateNum)
+	logBytes := log.Get(logEntrykey[:])
+	if logBytes == nil {
+		return nil, fmt.Errorf("log entry not found")
+	}
+
+	return deserializeRevocationLog(bytes.NewReader(logBytes), updateNum)
+}
+
+func serializeRevocationLog(w io.Writer, rl *RevocationLog) error {
+	if err := WriteElements(w,
+		rl.CommitTxHash, rl.OurOutputIndex, rl.OurBalance,
+		rl.TheirOutputIndex, rl.TheirBalance,
+		uint16(len(rl.HTLCEntries)),
+	); err != nil {
+		return err
+	}
+
+	for _, htlc := range rl.HTLCEntries {
+		if err := WriteElements(w,
+			htlc.RHash, htlc.RefundTimeout, htlc.OutputIndex,
+			htlc.Incoming, htlc.Amt,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializeRevocationLog(r io.Reader,
+	updateNum uint64) (*RevocationLog, error) {
+
+	rl := &RevocationLog{
+		CommitHeight: updateNum,
+	}
+
+	var numHtlcs uint16
+	if err := ReadElements(r,
+		&rl.CommitTxHash, &rl.OurOutputIndex, &rl.OurBalance,
+		&rl.TheirOutputIndex, &rl.TheirBalance, &numHtlcs,
+	); err != nil {
+		return nil, err
+	}
+
+	if numHtlcs == 0 {
+		return rl, nil
+	}
+
+	rl.HTLCEntries = make([]*HTLCEntry, numHtlcs)
+	for i := range rl.HTLCEntries {
+		htlc := &HTLCEntry{}
+		if err := ReadElements(r,
+			&htlc.RHash, &htlc.RefundTimeout, &htlc.OutputIndex,
+			&htlc.Incoming, &htlc.Amt,
+		); err != nil {
+			return nil, err
+		}
+
+		rl.HTLCEntries[i] = htlc
+	}
+
+	return rl, nil
+}
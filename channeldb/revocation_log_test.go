@@ -0,0 +1,128 @@
+package channeldb
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// createTestRevokedCommitment creates a revoked commitment of the remote party
+// whose transaction carries their delayed output at index 0, an HTLC output at
+// index 1 and our output at index 2, along with a dust HTLC.
+func createTestRevokedCommitment() *ChannelCommitment {
+	p2wsh := func(b byte) []byte {
+		return append(
+			[]byte{txscript.OP_0, txscript.OP_DATA_32},
+			bytes.Repeat([]byte{b}, 32)...,
+		)
+	}
+	p2wpkh := append(
+		[]byte{txscript.OP_0, txscript.OP_DATA_20},
+		bytes.Repeat([]byte{3}, 20)...,
+	)
+
+	commitTx := wire.NewMsgTx(2)
+	commitTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: key, Index: 1},
+	})
+	commitTx.AddTxOut(&wire.TxOut{Value: 7000, PkScript: p2wsh(1)})
+	commitTx.AddTxOut(&wire.TxOut{Value: 2000, PkScript: p2wsh(2)})
+	commitTx.AddTxOut(&wire.TxOut{Value: 5000, PkScript: p2wpkh})
+
+	return &ChannelCommitment{
+		CommitHeight:  42,
+		LocalBalance:  lnwire.NewMSatFromSatoshis(5000),
+		RemoteBalance: lnwire.NewMSatFromSatoshis(7000),
+		CommitFee:     1000,
+		FeePerKw:      2500,
+		CommitTx:      commitTx,
+		CommitSig:     bytes.Repeat([]byte{1}, 71),
+		Htlcs: []HTLC{
+			{
+				Signature:     bytes.Repeat([]byte{2}, 71),
+				RHash:         rev,
+				Amt:           lnwire.NewMSatFromSatoshis(2000),
+				RefundTimeout: 500,
+				OutputIndex:   1,
+				Incoming:      true,
+				OnionBlob:     bytes.Repeat([]byte{4}, 10),
+				HtlcIndex:     3,
+				LogIndex:      5,
+			},
+			{
+				Signature:     bytes.Repeat([]byte{2}, 71),
+				RHash:         key,
+				Amt:           lnwire.NewMSatFromSatoshis(10),
+				RefundTimeout: 600,
+				OutputIndex:   -1,
+				OnionBlob:     bytes.Repeat([]byte{5}, 10),
+				HtlcIndex:     4,
+				LogIndex:      6,
+			},
+		},
+	}
+}
+
+// TestNewRevocationLog asserts that the compact form of a revoked commitment
+// locates the commitment outputs, drops dust HTLCs and survives a round trip
+// through its serialization.
+func TestNewRevocationLog(t *testing.T) {
+	t.Parallel()
+
+	commit := createTestRevokedCommitment()
+
+	expected := &RevocationLog{
+		CommitHeight:     42,
+		CommitTxHash:     commit.CommitTx.TxHash(),
+		OurOutputIndex:   2,
+		OurBalance:       5000,
+		TheirOutputIndex: 0,
+		TheirBalance:     7000,
+		HTLCEntries: []*HTLCEntry{
+			{
+				RHash:         rev,
+				RefundTimeout: 500,
+				OutputIndex:   1,
+				Incoming:      true,
+				Amt:           2000,
+			},
+		},
+	}
+
+	rl := newRevocationLog(commit)
+	if !reflect.DeepEqual(expected, rl) {
+		t.Fatalf("revocation logs don't match: expected %v, got %v",
+			spew.Sdump(expected), spew.Sdump(rl))
+	}
+
+	var b bytes.Buffer
+	if err := serializeRevocationLog(&b, rl); err != nil {
+		t.Fatalf("unable to serialize revocation log: %v", err)
+	}
+	rl, err := deserializeRevocationLog(&b, commit.CommitHeight)
+	if err != nil {
+		t.Fatalf("unable to deserialize revocation log: %v", err)
+	}
+	if !reflect.DeepEqual(expected, rl) {
+		t.Fatalf("revocation logs don't match: expected %v, got %v",
+			spew.Sdump(expected), spew.Sdump(rl))
+	}
+
+	// If our output was trimmed as dust, it shouldn't be located within
+	// the commitment transaction.
+	commit.CommitTx.TxOut = commit.CommitTx.TxOut[:2]
+	rl = newRevocationLog(commit)
+	if rl.OurOutputIndex != OutputIndexEmpty {
+		t.Fatalf("expected no output index for our output, got %v",
+			rl.OurOutputIndex)
+	}
+	if rl.TheirOutputIndex != 0 {
+		t.Fatalf("expected output index 0 for their output, got %v",
+			rl.TheirOutputIndex)
+	}
+}
@@ -0,0 +1,114 @@
+// +build routerrpc
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var updateChanStatusCommand = cli.Command{
+	Name:     "updatechanstatus",
+	Category: "Channels",
+	Usage:    "Set the status of an existing channel on the network.",
+	Description: `
+	Set the status of an existing channel on the network. The actions can
+	be "enable", "disable", or "auto". If the action changes the status, a
+	message will be broadcast over the network.
+
+	Note that enabling / disabling a channel using this command ONLY affects
+	what is advertised in the channel graph. Disabling a channel using this
+	command does not close the channel immediately.
+
+	A manual "disable" request will cause the channel to stay disabled until
+	a subsequent manual request of either "enable" or "auto", even across
+	reconnects and restarts. A manual "enable" request requires the channel
+	to be active. An "auto" request hands control over the channel's status
+	back to lnd.
+
+	The format for a chan_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "chan_point action",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel whose status should be updated. " +
+				"Takes the form of: txid:output_index",
+		},
+		cli.StringFlag{
+			Name: "action",
+			Usage: `the action to take: must be one of "enable", ` +
+				`"disable", or "auto"`,
+		},
+	},
+	Action: actionDecorator(updateChanStatus),
+}
+
+func updateChanStatus(ctx *cli.Context) error {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	args := ctx.Args()
+
+	// Show command help if no arguments provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "updatechanstatus")
+		return nil
+	}
+
+	var chanPointStr string
+	switch {
+	case ctx.IsSet("chan_point"):
+		chanPointStr = ctx.String("chan_point")
+	case args.Present():
+		chanPointStr = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("chan_point argument missing")
+	}
+
+	chanPoint, err := parseChanPoint(chanPointStr)
+	if err != nil {
+		return err
+	}
+
+	var actionStr string
+	switch {
+	case ctx.IsSet("action"):
+		actionStr = ctx.String("action")
+	case args.Present():
+		actionStr = args.First()
+	default:
+		return fmt.Errorf("action argument missing")
+	}
+
+	var action routerrpc.ChanStatusAction
+	switch actionStr {
+	case "enable":
+		action = routerrpc.ChanStatusAction_ENABLE
+	case "disable":
+		action = routerrpc.ChanStatusAction_DISABLE
+	case "auto":
+		action = routerrpc.ChanStatusAction_AUTO
+	default:
+		return fmt.Errorf("unrecognized action: %v", actionStr)
+	}
+
+	req := &routerrpc.UpdateChanStatusRequest{
+		ChanPoint: chanPoint,
+		Action:    action,
+	}
+
+	resp, err := client.UpdateChanStatus(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -113,6 +113,13 @@ var newAddressCommand = cli.Command{
 	Generate a wallet new address. Address-types has to be one of:
 	    - p2wkh:  Pay to witness key hash
 	    - np2wkh: Pay to nested witness key hash`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "account",
+			Usage: "(optional) the name of the account to " +
+				"generate a new address for",
+		},
+	},
 	Action: actionDecorator(newAddress),
 }
 
@@ -137,7 +144,8 @@ func newAddress(ctx *cli.Context) error {
 
 	ctxb := context.Background()
 	addr, err := client.NewAddress(ctxb, &lnrpc.NewAddressRequest{
-		Type: addrType,
+		Type:    addrType,
+		Account: ctx.String("account"),
 	})
 	if err != nil {
 		return err
@@ -234,6 +242,18 @@ var sendCoinsCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) a utxo specified as outpoint(tx:idx) " +
+				"that should be spent; can be repeated to " +
+				"spend multiple utxos instead of performing " +
+				"automatic coin selection",
+		},
+		cli.StringFlag{
+			Name: "label",
+			Usage: "(optional) a label for the transaction, " +
+				"limited to 500 characters",
+		},
 	},
 	Action: actionDecorator(sendCoins),
 }
@@ -283,6 +303,11 @@ func sendCoins(ctx *cli.Context) error {
 			"sweep all coins out of the wallet")
 	}
 
+	outpoints, err := parseUtxos(ctx.StringSlice("utxo"))
+	if err != nil {
+		return err
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
@@ -293,6 +318,8 @@ func sendCoins(ctx *cli.Context) error {
 		TargetConf: int32(ctx.Int64("conf_target")),
 		SatPerByte: ctx.Int64("sat_per_byte"),
 		SendAll:    ctx.Bool("sweepall"),
+		Outpoints:  outpoints,
+		Label:      ctx.String("label"),
 	}
 	txid, err := client.SendCoins(ctxb, req)
 	if err != nil {
@@ -303,6 +330,21 @@ func sendCoins(ctx *cli.Context) error {
 	return nil
 }
 
+// parseUtxos parses a list of outpoints, each of the form txid:index, into
+// their lnrpc type.
+func parseUtxos(utxos []string) ([]*lnrpc.OutPoint, error) {
+	outpoints := make([]*lnrpc.OutPoint, 0, len(utxos))
+	for _, utxo := range utxos {
+		outpoint, err := NewProtoOutPoint(utxo)
+		if err != nil {
+			return nil, err
+		}
+		outpoints = append(outpoints, outpoint)
+	}
+
+	return outpoints, nil
+}
+
 var listUnspentCommand = cli.Command{
 	Name:      "listunspent",
 	Category:  "On-chain",
@@ -337,6 +379,11 @@ var listUnspentCommand = cli.Command{
 				"true and both min_confs and max_confs are " +
 				"non-zero. (default: false)",
 		},
+		cli.StringFlag{
+			Name: "account",
+			Usage: "(optional) only list utxos belonging to " +
+				"this account",
+		},
 	},
 	Action: actionDecorator(listUnspent),
 }
@@ -400,6 +447,7 @@ func listUnspent(ctx *cli.Context) error {
 	req := &lnrpc.ListUnspentRequest{
 		MinConfs: int32(minConfirms),
 		MaxConfs: int32(maxConfirms),
+		Account:  ctx.String("account"),
 	}
 	resp, err := client.ListUnspent(ctxb, req)
 	if err != nil {
@@ -448,6 +496,18 @@ var sendManyCommand = cli.Command{
 			Usage: "(optional) a manual fee expressed in sat/byte that should be " +
 				"used when crafting the transaction",
 		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) a utxo specified as outpoint(tx:idx) " +
+				"that should be spent; can be repeated to " +
+				"spend multiple utxos instead of performing " +
+				"automatic coin selection",
+		},
+		cli.StringFlag{
+			Name: "label",
+			Usage: "(optional) a label for the transaction, " +
+				"limited to 500 characters",
+		},
 	},
 	Action: actionDecorator(sendMany),
 }
@@ -465,6 +525,11 @@ func sendMany(ctx *cli.Context) error {
 			"set, but not both")
 	}
 
+	outpoints, err := parseUtxos(ctx.StringSlice("utxo"))
+	if err != nil {
+		return err
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
@@ -473,6 +538,8 @@ func sendMany(ctx *cli.Context) error {
 		AddrToAmount: amountToAddr,
 		TargetConf:   int32(ctx.Int64("conf_target")),
 		SatPerByte:   ctx.Int64("sat_per_byte"),
+		Outpoints:    outpoints,
+		Label:        ctx.String("label"),
 	})
 	if err != nil {
 		return err
@@ -1795,7 +1862,14 @@ var walletBalanceCommand = cli.Command{
 	Name:     "walletbalance",
 	Category: "Wallet",
 	Usage:    "Compute and display the wallet's current balance.",
-	Action:   actionDecorator(walletBalance),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "account",
+			Usage: "(optional) the account for which the balance " +
+				"is shown",
+		},
+	},
+	Action: actionDecorator(walletBalance),
 }
 
 func walletBalance(ctx *cli.Context) error {
@@ -1803,7 +1877,9 @@ func walletBalance(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &lnrpc.WalletBalanceRequest{}
+	req := &lnrpc.WalletBalanceRequest{
+		Account: ctx.String("account"),
+	}
 	resp, err := client.WalletBalance(ctxb, req)
 	if err != nil {
 		return err
@@ -2040,6 +2116,41 @@ func closedChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var prunableChannelsCommand = cli.Command{
+	Name:     "prunablechannels",
+	Category: "Channels",
+	Usage: "List resolved closed channels of which state can be " +
+		"pruned.",
+	Description: `
+	Lists the fully resolved closed channels of which state beyond their
+	close summary, such as their forwarding packages, is still stored in
+	the database, along with the number of bytes pruning it reclaims.
+
+	Pruning is disabled unless --resolved-chan-prune-window is set. The
+	state of a channel is then pruned once the chain has reached its prune
+	height, which is checked at startup and roughly once a day. Pruning
+	wipes the forwarding packages of the channel and any arbitrator state
+	left behind. Revocation state is already removed when the channel is
+	closed.`,
+	Action: actionDecorator(prunableChannels),
+}
+
+func prunableChannels(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.PrunableChannelsRequest{}
+	resp, err := client.PrunableChannels(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var cltvLimitFlag = cli.UintFlag{
 	Name: "cltv_limit",
 	Usage: "the maximum time lock that may be used for " +
@@ -3175,7 +3286,14 @@ var listChainTxnsCommand = cli.Command{
 	Category:    "On-chain",
 	Usage:       "List transactions from the wallet.",
 	Description: "List all transactions an address of the wallet was involved in.",
-	Action:      actionDecorator(listChainTxns),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "account",
+			Usage: "(optional) only list transactions relevant " +
+				"to this account",
+		},
+	},
+	Action: actionDecorator(listChainTxns),
 }
 
 func listChainTxns(ctx *cli.Context) error {
@@ -3183,7 +3301,10 @@ func listChainTxns(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.GetTransactions(ctxb, &lnrpc.GetTransactionsRequest{})
+	req := &lnrpc.GetTransactionsRequest{
+		Account: ctx.String("account"),
+	}
+	resp, err := client.GetTransactions(ctxb, req)
 
 	if err != nil {
 		return err
@@ -3215,6 +3336,50 @@ func stopDaemon(ctx *cli.Context) error {
 	return nil
 }
 
+var drainCommand = cli.Command{
+	Name:  "drain",
+	Usage: "Drain the node and shutdown the daemon.",
+	Description: `
+	Put the daemon into drain mode before stopping it. All channels are
+	disabled on the network, new forwards and incoming channels are
+	rejected, and the daemon waits for in-flight HTLCs to be resolved. Once
+	all HTLCs are resolved, or the timeout expires, the daemon is stopped.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "timeout",
+			Usage: "the maximum number of seconds to wait for " +
+				"in-flight HTLCs to be resolved (default: 600)",
+		},
+	},
+	Action: actionDecorator(drainDaemon),
+}
+
+func drainDaemon(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.DrainRequest{
+		TimeoutSeconds: uint32(ctx.Uint64("timeout")),
+	}
+
+	stream, err := client.DrainAndStop(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+	}
+}
+
 var signMessageCommand = cli.Command{
 	Name:      "signmessage",
 	Category:  "Wallet",
@@ -3355,12 +3520,18 @@ var updateChannelPolicyCommand = cli.Command{
 	Usage: "Update the channel policy for all channels, or a single " +
 		"channel.",
 	ArgsUsage: "base_fee_msat fee_rate time_lock_delta " +
-		"[--max_htlc_msat=N] [channel_point]",
+		"[--max_htlc_msat=N] [--inbound_base_fee_msat=N " +
+		"--inbound_fee_per_mil=N] [channel_point]",
 	Description: `
 	Updates the channel policy for all channels, or just a particular channel
 	identified by its channel point. The update will be committed, and
 	broadcast to the rest of the network within the next batch.
-	Channel points are encoded as: funding_txid:output_index`,
+	Channel points are encoded as: funding_txid:output_index
+
+	The inbound fee is charged on top of the regular fee for HTLCs that
+	arrive over the channel. It may be negative to offer a discount, but
+	the total fee charged for a forward will never be negative. If neither
+	inbound fee flag is set, the inbound fee is left unchanged.`,
 	Flags: []cli.Flag{
 		cli.Int64Flag{
 			Name: "base_fee_msat",
@@ -3386,6 +3557,18 @@ var updateChannelPolicyCommand = cli.Command{
 				"to all forwarded HTLCs. If unset, the max HTLC " +
 				"is left unchanged.",
 		},
+		cli.Int64Flag{
+			Name: "inbound_base_fee_msat",
+			Usage: "the base fee in milli-satoshis that will be " +
+				"charged for each HTLC arriving over the " +
+				"channel, negative values are a discount",
+		},
+		cli.Int64Flag{
+			Name: "inbound_fee_per_mil",
+			Usage: "the fee rate in millionths that will be " +
+				"charged for each HTLC arriving over the " +
+				"channel, negative values are a discount",
+		},
 		cli.StringFlag{
 			Name: "chan_point",
 			Usage: "The channel whose fee policy should be " +
@@ -3502,6 +3685,15 @@ func updateChannelPolicy(ctx *cli.Context) error {
 		MaxHtlcMsat:   ctx.Uint64("max_htlc_msat"),
 	}
 
+	if ctx.IsSet("inbound_base_fee_msat") ||
+		ctx.IsSet("inbound_fee_per_mil") {
+
+		req.InboundFee = &lnrpc.InboundFee{
+			BaseFeeMsat: int32(ctx.Int64("inbound_base_fee_msat")),
+			FeePerMil:   int32(ctx.Int64("inbound_fee_per_mil")),
+		}
+	}
+
 	if chanPoint != nil {
 		req.Scope = &lnrpc.PolicyUpdateRequest_ChanPoint{
 			ChanPoint: chanPoint,
@@ -3521,6 +3713,257 @@ func updateChannelPolicy(ctx *cli.Context) error {
 	return nil
 }
 
+var setFeeStrategyCommand = cli.Command{
+	Name:     "setfeestrategy",
+	Category: "Channels",
+	Usage: "Set the dynamic fee strategy for all channels, or a single " +
+		"channel.",
+	ArgsUsage: "[--chan_point=txid:output_index] [--clear]",
+	Description: `
+	Sets the strategy the dynamic fee engine uses to price a particular
+	channel identified by its channel point. If no channel point is given,
+	the default strategy used for all channels without a strategy of their
+	own is set instead. If --clear is set, the existing strategy is
+	removed.
+
+	The proportional curve scales the fee rate linearly from
+	--max_fee_per_mil for a channel without local balance down to
+	--min_fee_per_mil for a channel whose entire capacity is local. The
+	step curve uses the fee rate of the first --step whose threshold is at
+	or above the channel's local balance percentage. Steps are given as
+	max_local_percent:fee_per_mil, e.g. --step=20:500 --step=100:100.
+
+	Channels are only re-priced if the fee engine is activated with
+	--feepolicy.active.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel whose strategy should be set, if " +
+				"nil the default strategy is set. Takes the " +
+				"form of: txid:output_index",
+		},
+		cli.BoolFlag{
+			Name:  "clear",
+			Usage: "remove the existing strategy",
+		},
+		cli.StringFlag{
+			Name:  "curve",
+			Usage: "the fee curve to use: proportional or step",
+			Value: "proportional",
+		},
+		cli.Int64Flag{
+			Name: "base_fee_msat",
+			Usage: "the base fee in milli-satoshis that will be " +
+				"set on each channel priced by this strategy",
+		},
+		cli.Uint64Flag{
+			Name: "min_fee_per_mil",
+			Usage: "the lowest fee rate, in millionths, the " +
+				"strategy will set",
+		},
+		cli.Uint64Flag{
+			Name: "max_fee_per_mil",
+			Usage: "the highest fee rate, in millionths, the " +
+				"strategy will set",
+		},
+		cli.StringSliceFlag{
+			Name: "step",
+			Usage: "a step of the step curve, in the form of " +
+				"max_local_percent:fee_per_mil. Can be " +
+				"specified multiple times",
+		},
+		cli.Uint64Flag{
+			Name: "volume_target_msat",
+			Usage: "the amount we'd like to forward out of the " +
+				"channel during the volume window, if zero " +
+				"forwarding volume is ignored",
+		},
+		cli.Uint64Flag{
+			Name: "volume_adjust_percent",
+			Usage: "the percentage by which the fee rate is " +
+				"raised if the volume target was met, or " +
+				"lowered if it wasn't",
+		},
+	},
+	Action: actionDecorator(setFeeStrategy),
+}
+
+func setFeeStrategy(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SetFeeStrategyRequest{}
+	if ctx.IsSet("chan_point") {
+		chanPoint, err := parseChanPoint(ctx.String("chan_point"))
+		if err != nil {
+			return fmt.Errorf("unable to parse chan point: %v", err)
+		}
+		req.Scope = &lnrpc.SetFeeStrategyRequest_ChanPoint{
+			ChanPoint: chanPoint,
+		}
+	} else {
+		req.Scope = &lnrpc.SetFeeStrategyRequest_Global{
+			Global: true,
+		}
+	}
+
+	if !ctx.Bool("clear") {
+		strategy := &lnrpc.FeeStrategy{
+			BaseFeeMsat:         ctx.Int64("base_fee_msat"),
+			MinFeePerMil:        uint32(ctx.Uint64("min_fee_per_mil")),
+			MaxFeePerMil:        uint32(ctx.Uint64("max_fee_per_mil")),
+			VolumeTargetMsat:    ctx.Uint64("volume_target_msat"),
+			VolumeAdjustPercent: uint32(ctx.Uint64("volume_adjust_percent")),
+		}
+
+		switch ctx.String("curve") {
+		case "proportional":
+			strategy.Curve = lnrpc.FeeStrategy_PROPORTIONAL
+		case "step":
+			strategy.Curve = lnrpc.FeeStrategy_STEP
+		default:
+			return fmt.Errorf("unknown curve: %v",
+				ctx.String("curve"))
+		}
+
+		for _, stepStr := range ctx.StringSlice("step") {
+			split := strings.Split(stepStr, ":")
+			if len(split) != 2 {
+				return fmt.Errorf("expecting step to be in " +
+					"format of: max_local_percent:fee_per_mil")
+			}
+
+			percent, err := strconv.ParseUint(split[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("unable to decode "+
+					"max_local_percent: %v", err)
+			}
+			feeRate, err := strconv.ParseUint(split[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("unable to decode "+
+					"fee_per_mil: %v", err)
+			}
+
+			strategy.Steps = append(
+				strategy.Steps, &lnrpc.FeeStrategyStep{
+					MaxLocalPercent: uint32(percent),
+					FeePerMil:       uint32(feeRate),
+				},
+			)
+		}
+
+		req.Strategy = strategy
+	}
+
+	resp, err := client.SetFeeStrategy(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var previewFeeUpdatesCommand = cli.Command{
+	Name:     "previewfeeupdates",
+	Category: "Channels",
+	Usage: "Show the fee strategies and the policies the dynamic fee " +
+		"engine would apply.",
+	Description: `
+	Returns the configured fee strategies along with the policy the dynamic
+	fee engine would select for each channel, and whether it would be
+	applied during the next round. No channel policies are changed.`,
+	Action: actionDecorator(previewFeeUpdates),
+}
+
+func previewFeeUpdates(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.PreviewFeeUpdatesRequest{}
+	resp, err := client.PreviewFeeUpdates(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var updateDeadlinePolicyCommand = cli.Command{
+	Name:      "updatedeadlinepolicy",
+	Category:  "Channels",
+	Usage:     "Set when a channel goes to chain for its HTLCs.",
+	ArgsUsage: "--chan_point=txid:output_index",
+	Description: `
+	Sets the policy that decides when the channel identified by its
+	channel point is force closed in order to resolve its HTLCs on chain.
+
+	The broadcast deltas override the default number of blocks before an
+	incoming HTLC expires, or after an outgoing HTLC expired, at which the
+	channel goes to chain. A delta of zero keeps the default.
+
+	HTLCs below --min_chain_htlc_sat don't cause the channel to be force
+	closed. Instead, outgoing HTLCs are failed back and incoming HTLCs are
+	written off once they reach their deadline.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel whose policy should be set. Takes " +
+				"the form of: txid:output_index",
+		},
+		cli.Uint64Flag{
+			Name: "incoming_broadcast_delta",
+			Usage: "the number of blocks before an incoming HTLC " +
+				"expires at which we go to chain, if zero the " +
+				"global default is used",
+		},
+		cli.Uint64Flag{
+			Name: "outgoing_broadcast_delta",
+			Usage: "the number of blocks after an outgoing HTLC " +
+				"expired at which we go to chain, if zero the " +
+				"global default is used",
+		},
+		cli.Int64Flag{
+			Name: "min_chain_htlc_sat",
+			Usage: "the minimum value in satoshis of an HTLC " +
+				"worth going to chain for",
+		},
+	},
+	Action: actionDecorator(updateDeadlinePolicy),
+}
+
+func updateDeadlinePolicy(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if !ctx.IsSet("chan_point") {
+		return fmt.Errorf("chan_point must be set")
+	}
+	chanPoint, err := parseChanPoint(ctx.String("chan_point"))
+	if err != nil {
+		return fmt.Errorf("unable to parse chan point: %v", err)
+	}
+
+	req := &lnrpc.DeadlinePolicyRequest{
+		ChanPoint:              chanPoint,
+		IncomingBroadcastDelta: uint32(ctx.Uint64("incoming_broadcast_delta")),
+		OutgoingBroadcastDelta: uint32(ctx.Uint64("outgoing_broadcast_delta")),
+		MinChainHtlcSat:        ctx.Int64("min_chain_htlc_sat"),
+	}
+
+	resp, err := client.UpdateDeadlinePolicy(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var forwardingHistoryCommand = cli.Command{
 	Name:      "fwdinghistory",
 	Category:  "Payments",
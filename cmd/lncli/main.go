@@ -280,6 +280,7 @@ func main() {
 		listInvoicesCommand,
 		listChannelsCommand,
 		closedChannelsCommand,
+		prunableChannelsCommand,
 		listPaymentsCommand,
 		describeGraphCommand,
 		getChanInfoCommand,
@@ -290,10 +291,14 @@ func main() {
 		decodePayReqCommand,
 		listChainTxnsCommand,
 		stopCommand,
+		drainCommand,
 		signMessageCommand,
 		verifyMessageCommand,
 		feeReportCommand,
 		updateChannelPolicyCommand,
+		setFeeStrategyCommand,
+		previewFeeUpdatesCommand,
+		updateDeadlinePolicyCommand,
 		forwardingHistoryCommand,
 		exportChanBackupCommand,
 		verifyChanBackupCommand,
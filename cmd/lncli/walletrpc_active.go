@@ -4,9 +4,15 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/urfave/cli"
 )
@@ -21,8 +27,17 @@ func walletCommands() []cli.Command {
 			Usage:       "Interact with the wallet.",
 			Description: "",
 			Subcommands: []cli.Command{
+				estimateFeeRateCommand,
 				pendingSweepsCommand,
 				bumpFeeCommand,
+				listSweepsCommand,
+				leaseOutputCommand,
+				releaseOutputCommand,
+				listLeasesCommand,
+				consolidateCommand,
+				psbtCommand,
+				accountsCommand,
+				labelTxCommand,
 			},
 		},
 	}
@@ -36,6 +51,46 @@ func getWalletClient(ctx *cli.Context) (walletrpc.WalletKitClient, func()) {
 	return walletrpc.NewWalletKitClient(conn), cleanUp
 }
 
+var estimateFeeRateCommand = cli.Command{
+	Name:      "estimatefeerate",
+	Usage:     "Estimate the fee rate for a confirmation target.",
+	ArgsUsage: "conf_target",
+	Description: `
+	Estimate the fee rate in sat/kw to attach to a transaction in order for
+	it to confirm within the given number of blocks.
+
+	If lnd is configured to combine the estimates of several fee sources,
+	the estimates of the individual sources are returned as well, along
+	with whether they have been ignored.`,
+	Action: actionDecorator(estimateFeeRate),
+}
+
+func estimateFeeRate(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "estimatefeerate")
+	}
+
+	confTarget, err := strconv.ParseInt(ctx.Args().First(), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid conf target: %v", err)
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.EstimateFeeRequest{
+		ConfTarget: int32(confTarget),
+	}
+	resp, err := client.EstimateFee(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var pendingSweepsCommand = cli.Command{
 	Name:      "pendingsweeps",
 	Usage:     "List all outputs that are pending to be swept within lnd.",
@@ -107,9 +162,19 @@ var bumpFeeCommand = cli.Command{
 	parent's fee. This can be done by specifying an outpoint within the low
 	fee transaction that is under the control of the wallet.
 
+	Unconfirmed transactions funded by the wallet, such as the ones created
+	through sendcoins, can also be replaced by a higher fee transaction
+	paying to the same outputs through the --replace flag. The higher fee
+	is deducted from the change output of the transaction the outpoint
+	belongs to.
+
 	A fee preference must be provided, either through the conf_target or
 	sat_per_byte parameters.
 
+	For time-sensitive sweeps, a deadline height can be provided along with
+	a fee budget. The fee rate of the fee preference is then raised every
+	block until it reaches the rate allowed by the budget at the deadline.
+
 	Note that this command currently doesn't perform any validation checks
 	on the fee preference being provided. For now, the responsibility of
 	ensuring that the new fee preference is sufficient is delegated to the
@@ -125,6 +190,23 @@ var bumpFeeCommand = cli.Command{
 			Usage: "a manual fee expressed in sat/byte that " +
 				"should be used when sweeping the output",
 		},
+		cli.BoolFlag{
+			Name: "replace",
+			Usage: "replace the transaction the outpoint belongs " +
+				"to (RBF) instead of spending the outpoint " +
+				"(CPFP)",
+		},
+		cli.Int64Flag{
+			Name: "deadline_height",
+			Usage: "the height by which the output should be " +
+				"swept, raising the fee rate every block " +
+				"until then",
+		},
+		cli.Int64Flag{
+			Name: "budget",
+			Usage: "the maximum fee in satoshis to pay for " +
+				"sweeping the output before its deadline",
+		},
 	},
 	Action: actionDecorator(bumpFee),
 }
@@ -132,7 +214,7 @@ var bumpFeeCommand = cli.Command{
 func bumpFee(ctx *cli.Context) error {
 	// Display the command's help message if we do not have the expected
 	// number of arguments/flags.
-	if ctx.NArg() != 1 || ctx.NumFlags() != 1 {
+	if ctx.NArg() != 1 {
 		return cli.ShowCommandHelp(ctx, "bumpfee")
 	}
 
@@ -151,16 +233,702 @@ func bumpFee(ctx *cli.Context) error {
 		confTarget = uint32(ctx.Uint64("conf_target"))
 	case ctx.IsSet("sat_per_byte"):
 		satPerByte = uint32(ctx.Uint64("sat_per_byte"))
+	default:
+		return fmt.Errorf("either conf_target or sat_per_byte must " +
+			"be set")
 	}
 
 	client, cleanUp := getWalletClient(ctx)
 	defer cleanUp()
 
 	resp, err := client.BumpFee(context.Background(), &walletrpc.BumpFeeRequest{
-		Outpoint:   protoOutPoint,
-		TargetConf: confTarget,
-		SatPerByte: satPerByte,
+		Outpoint:       protoOutPoint,
+		TargetConf:     confTarget,
+		SatPerByte:     satPerByte,
+		Replace:        ctx.Bool("replace"),
+		DeadlineHeight: int32(ctx.Int64("deadline_height")),
+		BudgetSat:      ctx.Int64("budget"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listSweepsCommand = cli.Command{
+	Name:      "listsweeps",
+	Usage:     "List all outputs that were offered to be swept within lnd.",
+	ArgsUsage: "",
+	Description: `
+	List all on-chain outputs that were offered to lnd's central batching
+	engine, including the ones that are still pending, along with the
+	outcome of their sweep and the fee paid if they were swept
+	successfully.
+	`,
+	Flags:  []cli.Flag{},
+	Action: actionDecorator(listSweeps),
+}
+
+func listSweeps(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ListSweepsRequest{}
+	resp, err := client.ListSweeps(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	var listSweepsResp = struct {
+		Sweeps []*SweepRecord `json:"sweeps"`
+	}{
+		Sweeps: make([]*SweepRecord, 0, len(resp.Sweeps)),
+	}
+
+	for _, protoSweep := range resp.Sweeps {
+		sweep := NewSweepRecordFromProto(protoSweep)
+		listSweepsResp.Sweeps = append(listSweepsResp.Sweeps, sweep)
+	}
+
+	printJSON(listSweepsResp)
+
+	return nil
+}
+
+var leaseOutputCommand = cli.Command{
+	Name:      "leaseoutput",
+	Usage:     "Lease an output, excluding it from coin selection.",
+	ArgsUsage: "lease_id outpoint",
+	Description: `
+	Lock an output of the wallet to the given lease ID, preventing it from
+	being used by any future coin selection attempts, including those of
+	sendcoins, sendmany and channel funding. The lease persists across
+	restarts until it either expires or is released through releaseoutput.
+	Leasing an output again with the same ID extends the lease.
+
+	The lease ID must be 32 bytes, encoded as hex.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "expiry",
+			Usage: "the duration of the lease in seconds; if not " +
+				"set, a default of 10 minutes is used",
+		},
+	},
+	Action: actionDecorator(leaseOutput),
+}
+
+func leaseOutput(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "leaseoutput")
+	}
+
+	lockID, err := hex.DecodeString(ctx.Args().Get(0))
+	if err != nil {
+		return fmt.Errorf("unable to decode lease id: %v", err)
+	}
+
+	protoOutPoint, err := NewProtoOutPoint(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.LeaseOutputRequest{
+		Id:                lockID,
+		Outpoint:          protoOutPoint,
+		ExpirationSeconds: ctx.Uint64("expiry"),
+	}
+	resp, err := client.LeaseOutput(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var releaseOutputCommand = cli.Command{
+	Name:      "releaseoutput",
+	Usage:     "Release a previously leased output.",
+	ArgsUsage: "lease_id outpoint",
+	Description: `
+	Release the lease on an output of the wallet, making it available for
+	coin selection once again. The lease ID must match the one the output
+	was leased with.`,
+	Action: actionDecorator(releaseOutput),
+}
+
+func releaseOutput(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "releaseoutput")
+	}
+
+	lockID, err := hex.DecodeString(ctx.Args().Get(0))
+	if err != nil {
+		return fmt.Errorf("unable to decode lease id: %v", err)
+	}
+
+	protoOutPoint, err := NewProtoOutPoint(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ReleaseOutputRequest{
+		Id:       lockID,
+		Outpoint: protoOutPoint,
+	}
+	resp, err := client.ReleaseOutput(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listLeasesCommand = cli.Command{
+	Name:   "listleases",
+	Usage:  "List all currently leased outputs.",
+	Action: actionDecorator(listLeases),
+}
+
+func listLeases(ctx *cli.Context) error {
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ListLeasesRequest{}
+	resp, err := client.ListLeases(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	// Sort them by expiration for display purposes.
+	sort.Slice(resp.LockedUtxos, func(i, j int) bool {
+		return resp.LockedUtxos[i].Expiration <
+			resp.LockedUtxos[j].Expiration
+	})
+
+	var listLeasesResp = struct {
+		LockedUtxos []*UtxoLease `json:"locked_utxos"`
+	}{
+		LockedUtxos: make([]*UtxoLease, 0, len(resp.LockedUtxos)),
+	}
+
+	for _, protoLease := range resp.LockedUtxos {
+		listLeasesResp.LockedUtxos = append(
+			listLeasesResp.LockedUtxos,
+			NewUtxoLeaseFromProto(protoLease),
+		)
+	}
+
+	printJSON(listLeasesResp)
+
+	return nil
+}
+
+var consolidateCommand = cli.Command{
+	Name:  "consolidate",
+	Usage: "Merge the wallet's small utxos into a single output.",
+	Description: `
+	Merge the smallest confirmed utxos of the wallet that are worth
+	spending into a single output, by handing them off to lnd's central
+	batching engine.
+
+	Unless forced, the consolidation only takes place if the estimated fee
+	rate is below the configured maximum and enough utxos are worth
+	merging. A dry run shows the consolidation that would take place,
+	along with the reason it would be skipped, without spending anything.
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "dry_run",
+			Usage: "only show the consolidation that would take " +
+				"place",
+		},
+		cli.BoolFlag{
+			Name: "force",
+			Usage: "consolidate regardless of the fee rate and " +
+				"minimum number of utxos",
+		},
+	},
+	Action: actionDecorator(consolidate),
+}
+
+func consolidate(ctx *cli.Context) error {
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ConsolidateUtxosRequest{
+		DryRun: ctx.Bool("dry_run"),
+		Force:  ctx.Bool("force"),
+	}
+	resp, err := client.ConsolidateUtxos(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var psbtCommand = cli.Command{
+	Name:  "psbt",
+	Usage: "Interact with partially signed bitcoin transactions (PSBTs).",
+	Subcommands: []cli.Command{
+		fundPsbtCommand,
+		signPsbtCommand,
+		finalizePsbtCommand,
+	},
+}
+
+var fundPsbtCommand = cli.Command{
+	Name:  "fund",
+	Usage: "Fund a Partially Signed Bitcoin Transaction (PSBT).",
+	ArgsUsage: "[--template_psbt=T | [--outputs=O [--inputs=I]]] " +
+		"[--conf_target=C | --sat_per_vbyte=S]",
+	Description: `
+	The fund command creates a fully populated PSBT that contains enough
+	inputs to fund the outputs specified in either the PSBT or the
+	--outputs flag.
+
+	If there are no inputs specified in the template, coin selection is
+	performed automatically. If inputs are specified, the wallet assumes
+	that full coin selection happened externally and it will not add any
+	additional inputs to the PSBT. If the specified inputs aren't enough to
+	fund the outputs with the given fee rate, an error is returned.
+
+	After either selecting or verifying the inputs, all input UTXOs are
+	leased for 10 minutes to prevent them from being used in other coin
+	selection attempts. If a change output is needed, it is appended as the
+	last output of the PSBT.
+
+	The --outputs flag expects a JSON encoded map of addresses to amounts in
+	satoshis, for example:
+	    --outputs='{"ExampleAddr": 100000, "AnotherAddr": 200000}'`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "template_psbt",
+			Usage: "the outputs to fund and optional inputs to " +
+				"spend provided in the base64 PSBT format",
+		},
+		cli.StringFlag{
+			Name: "outputs",
+			Usage: "a JSON compatible map of destination " +
+				"addresses to amounts to send, must not " +
+				"include a change address",
+		},
+		cli.StringSliceFlag{
+			Name: "inputs",
+			Usage: "an outpoint in the format txid:output_index " +
+				"to spend; can be specified multiple times",
+		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks that the transaction " +
+				"should be confirmed on-chain within",
+			Value: 6,
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "a manual fee expressed in sat/vbyte that " +
+				"should be used when creating the transaction",
+		},
+	},
+	Action: actionDecorator(fundPsbt),
+}
+
+func fundPsbt(ctx *cli.Context) error {
+	if ctx.NArg() > 0 || ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "fund")
+	}
+
+	req := &walletrpc.FundPsbtRequest{}
+
+	switch {
+	case ctx.IsSet("template_psbt") && ctx.IsSet("outputs"):
+		return fmt.Errorf("cannot set both template_psbt and outputs")
+
+	case ctx.IsSet("template_psbt"):
+		packet, err := base64.StdEncoding.DecodeString(
+			ctx.String("template_psbt"),
+		)
+		if err != nil {
+			return fmt.Errorf("error parsing PSBT: %v", err)
+		}
+		req.Template = &walletrpc.FundPsbtRequest_Psbt{
+			Psbt: packet,
+		}
+
+	case ctx.IsSet("outputs"):
+		var amountToAddr map[string]uint64
+		err := json.Unmarshal(
+			[]byte(ctx.String("outputs")), &amountToAddr,
+		)
+		if err != nil {
+			return fmt.Errorf("error parsing outputs JSON: %v", err)
+		}
+
+		tpl := &walletrpc.TxTemplate{
+			Outputs: amountToAddr,
+		}
+		for _, input := range ctx.StringSlice("inputs") {
+			op, err := NewProtoOutPoint(input)
+			if err != nil {
+				return fmt.Errorf("error parsing input %s: %v",
+					input, err)
+			}
+			tpl.Inputs = append(tpl.Inputs, op)
+		}
+		req.Template = &walletrpc.FundPsbtRequest_Raw{
+			Raw: tpl,
+		}
+
+	default:
+		return fmt.Errorf("must specify either template_psbt or " +
+			"outputs flag")
+	}
+
+	switch {
+	case ctx.IsSet("conf_target") && ctx.IsSet("sat_per_vbyte"):
+		return fmt.Errorf("cannot set conf_target and sat_per_vbyte " +
+			"at the same time")
+
+	case ctx.IsSet("sat_per_vbyte"):
+		req.Fees = &walletrpc.FundPsbtRequest_SatPerVbyte{
+			SatPerVbyte: ctx.Uint64("sat_per_vbyte"),
+		}
+
+	default:
+		req.Fees = &walletrpc.FundPsbtRequest_TargetConf{
+			TargetConf: uint32(ctx.Uint64("conf_target")),
+		}
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.FundPsbt(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	var fundPsbtResp = struct {
+		FundedPsbt        string       `json:"psbt"`
+		ChangeOutputIndex int32        `json:"change_output_index"`
+		LockedUtxos       []*UtxoLease `json:"locks"`
+	}{
+		FundedPsbt: base64.StdEncoding.EncodeToString(
+			resp.FundedPsbt,
+		),
+		ChangeOutputIndex: resp.ChangeOutputIndex,
+		LockedUtxos:       make([]*UtxoLease, 0, len(resp.LockedUtxos)),
+	}
+	for _, protoLease := range resp.LockedUtxos {
+		fundPsbtResp.LockedUtxos = append(
+			fundPsbtResp.LockedUtxos,
+			NewUtxoLeaseFromProto(protoLease),
+		)
+	}
+
+	printJSON(fundPsbtResp)
+
+	return nil
+}
+
+var signPsbtCommand = cli.Command{
+	Name:      "sign",
+	Usage:     "Sign the wallet's inputs of a PSBT.",
+	ArgsUsage: "funded_psbt",
+	Description: `
+	The sign command adds a signature to every input of the given base64
+	encoded PSBT that belongs to the wallet and hasn't been signed yet.
+	Inputs of other wallets are left untouched, so the resulting PSBT can be
+	passed on to other signers.`,
+	Action: actionDecorator(signPsbt),
+}
+
+func signPsbt(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "sign")
+	}
+
+	packet, err := base64.StdEncoding.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("error parsing PSBT: %v", err)
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.SignPsbtRequest{
+		FundedPsbt: packet,
+	}
+	resp, err := client.SignPsbt(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		SignedPsbt   string   `json:"psbt"`
+		SignedInputs []uint32 `json:"signed_inputs"`
+	}{
+		SignedPsbt:   base64.StdEncoding.EncodeToString(resp.SignedPsbt),
+		SignedInputs: resp.SignedInputs,
 	})
+
+	return nil
+}
+
+var finalizePsbtCommand = cli.Command{
+	Name:      "finalize",
+	Usage:     "Finalize a PSBT and extract its final transaction.",
+	ArgsUsage: "funded_psbt",
+	Description: `
+	The finalize command signs all inputs of the given base64 encoded PSBT
+	that belong to the wallet, and then finalizes all inputs of the PSBT.
+	The signatures of all other inputs must already be present. The final
+	transaction is extracted and printed in its raw hex format. If the
+	--publish flag is set, the transaction is also published to the
+	network.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "publish",
+			Usage: "publish the final transaction after it has " +
+				"been extracted",
+		},
+		cli.StringFlag{
+			Name: "label",
+			Usage: "(optional) a label for the final transaction " +
+				"if it is published",
+		},
+	},
+	Action: actionDecorator(finalizePsbt),
+}
+
+func finalizePsbt(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "finalize")
+	}
+
+	packet, err := base64.StdEncoding.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("error parsing PSBT: %v", err)
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.FinalizePsbtRequest{
+		FundedPsbt: packet,
+		Publish:    ctx.Bool("publish"),
+		Label:      ctx.String("label"),
+	}
+	resp, err := client.FinalizePsbt(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		SignedPsbt string `json:"psbt"`
+		RawFinalTx string `json:"final_tx"`
+	}{
+		SignedPsbt: base64.StdEncoding.EncodeToString(resp.SignedPsbt),
+		RawFinalTx: hex.EncodeToString(resp.RawFinalTx),
+	})
+
+	return nil
+}
+
+var accountsCommand = cli.Command{
+	Name:  "accounts",
+	Usage: "Interact with the wallet's on-chain accounts.",
+	Subcommands: []cli.Command{
+		listAccountsCommand,
+		createAccountCommand,
+		importAccountCommand,
+	},
+}
+
+// parseAddrType parses the address type of an account from its command line
+// representation.
+func parseAddrType(addrType string) (walletrpc.AddressType, error) {
+	switch strings.ToLower(addrType) {
+	case "":
+		return walletrpc.AddressType_UNKNOWN, nil
+
+	case "p2wkh":
+		return walletrpc.AddressType_WITNESS_PUBKEY_HASH, nil
+
+	case "np2wkh":
+		return walletrpc.AddressType_NESTED_WITNESS_PUBKEY_HASH, nil
+
+	case "p2pkh":
+		return walletrpc.AddressType_PUBKEY_HASH, nil
+
+	default:
+		return 0, fmt.Errorf("invalid address type %v, supported "+
+			"address types are: p2wkh, np2wkh and p2pkh", addrType)
+	}
+}
+
+var listAccountsCommand = cli.Command{
+	Name:      "list",
+	Usage:     "List all on-chain accounts of the wallet.",
+	ArgsUsage: "[--name=N]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "(optional) only list the account with this name",
+		},
+	},
+	Action: actionDecorator(listAccounts),
+}
+
+func listAccounts(ctx *cli.Context) error {
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ListAccountsRequest{
+		Name: ctx.String("name"),
+	}
+	resp, err := client.ListAccounts(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var createAccountCommand = cli.Command{
+	Name:      "create",
+	Usage:     "Derive a new named account from the wallet's seed.",
+	ArgsUsage: "name [--address_type=T]",
+	Description: `
+	The create command derives a new account from the wallet's seed. The
+	address type determines the BIP44 (p2pkh), BIP49 (np2wkh) or BIP84
+	(p2wkh) purpose the account is derived under. Funds of the account are
+	never used to fund channels or for coin selection of the default
+	account.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "address_type",
+			Usage: "the type of addresses the account derives, " +
+				"one of p2wkh, np2wkh or p2pkh",
+			Value: "p2wkh",
+		},
+	},
+	Action: actionDecorator(createAccount),
+}
+
+func createAccount(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "create")
+	}
+
+	addrType, err := parseAddrType(ctx.String("address_type"))
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.CreateAccountRequest{
+		Name:        ctx.Args().First(),
+		AddressType: addrType,
+	}
+	resp, err := client.CreateAccount(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var importAccountCommand = cli.Command{
+	Name:  "import",
+	Usage: "Import a watch-only account from its extended public key.",
+	ArgsUsage: "extended_public_key name [--address_type=T] " +
+		"[--master_key_fingerprint=F] [--birthday_height=H]",
+	Description: `
+	The import command imports a watch-only account from its extended
+	public key at the account level of its derivation path, which is
+	m/purpose'/coin_type'/account'. The wallet tracks funds received to
+	addresses of the account, but is unable to sign for them.
+
+	SLIP-132 encoded keys (ypub, zpub, upub and vpub) imply the address
+	type of the account. For all other keys, the --address_type flag is
+	required.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "address_type",
+			Usage: "the type of addresses the account derives, " +
+				"one of p2wkh, np2wkh or p2pkh",
+		},
+		cli.StringFlag{
+			Name: "master_key_fingerprint",
+			Usage: "(optional) the hex encoded fingerprint of " +
+				"the root key the account key was derived " +
+				"from",
+		},
+		cli.Uint64Flag{
+			Name: "birthday_height",
+			Usage: "(optional) the height to start scanning for " +
+				"funds of the account from, defaults to the " +
+				"wallet's birthday",
+		},
+	},
+	Action: actionDecorator(importAccount),
+}
+
+func importAccount(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "import")
+	}
+
+	addrType, err := parseAddrType(ctx.String("address_type"))
+	if err != nil {
+		return err
+	}
+
+	var fingerprint []byte
+	if ctx.IsSet("master_key_fingerprint") {
+		fingerprint, err = hex.DecodeString(
+			ctx.String("master_key_fingerprint"),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid master key fingerprint: %v",
+				err)
+		}
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ImportAccountRequest{
+		Name:                 ctx.Args().Get(1),
+		ExtendedPublicKey:    ctx.Args().First(),
+		MasterKeyFingerprint: fingerprint,
+		AddressType:          addrType,
+		BirthdayHeight:       uint32(ctx.Uint64("birthday_height")),
+	}
+	resp, err := client.ImportAccount(context.Background(), req)
 	if err != nil {
 		return err
 	}
@@ -169,3 +937,55 @@ func bumpFee(ctx *cli.Context) error {
 
 	return nil
 }
+
+var labelTxCommand = cli.Command{
+	Name:      "labeltx",
+	Usage:     "Adds a label to a transaction.",
+	ArgsUsage: "txid label",
+	Description: `
+	Add a label to a transaction. If the transaction already has a label,
+	this call will fail unless the overwrite flag is set. The label is
+	limited to 500 characters. Note that multi word labels must be contained
+	in quotation marks ("").
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "overwrite",
+			Usage: "set to overwrite existing labels",
+		},
+	},
+	Action: actionDecorator(labelTransaction),
+}
+
+func labelTransaction(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "labeltx")
+	}
+
+	// Get the transaction id and check that it is a valid hash.
+	txid := ctx.Args().Get(0)
+	hash, err := chainhash.NewHashFromStr(txid)
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.LabelTransactionRequest{
+		Txid:      hash[:],
+		Label:     ctx.Args().Get(1),
+		Overwrite: ctx.Bool("overwrite"),
+	}
+	_, err = client.LabelTransaction(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Transaction: %v labelled with: %v\n", txid,
+		ctx.Args().Get(1))
+
+	return nil
+}
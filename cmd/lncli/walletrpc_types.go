@@ -1,6 +1,10 @@
 package main
 
-import "github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+import (
+	"encoding/hex"
+
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+)
 
 // PendingSweep is a CLI-friendly type of the walletrpc.PendingSweep proto. We
 // use this to show more useful string versions of byte slices and enums.
@@ -11,6 +15,10 @@ type PendingSweep struct {
 	SatPerByte          uint32   `json:"sat_per_byte"`
 	BroadcastAttempts   uint32   `json:"broadcast_attempts"`
 	NextBroadcastHeight uint32   `json:"next_broadcast_height"`
+	DeadlineHeight      int32    `json:"deadline_height"`
+	BudgetSat           int64    `json:"budget_sat"`
+	CurrentSatPerByte   uint32   `json:"current_sat_per_byte"`
+	NextSatPerByte      uint32   `json:"next_sat_per_byte"`
 }
 
 // NewPendingSweepFromProto converts the walletrpc.PendingSweep proto type into
@@ -23,5 +31,59 @@ func NewPendingSweepFromProto(pendingSweep *walletrpc.PendingSweep) *PendingSwee
 		SatPerByte:          pendingSweep.SatPerByte,
 		BroadcastAttempts:   pendingSweep.BroadcastAttempts,
 		NextBroadcastHeight: pendingSweep.NextBroadcastHeight,
+		DeadlineHeight:      pendingSweep.DeadlineHeight,
+		BudgetSat:           pendingSweep.BudgetSat,
+		CurrentSatPerByte:   pendingSweep.CurrentSatPerByte,
+		NextSatPerByte:      pendingSweep.NextSatPerByte,
+	}
+}
+
+// SweepRecord is a CLI-friendly type of the walletrpc.SweepRecord proto. We
+// use this to show more useful string versions of byte slices and enums.
+type SweepRecord struct {
+	OutPoint          OutPoint `json:"outpoint"`
+	WitnessType       string   `json:"witness_type"`
+	AmountSat         uint32   `json:"amount_sat"`
+	Outcome           string   `json:"outcome"`
+	SweepTxid         string   `json:"sweep_txid"`
+	SpendTxid         string   `json:"spend_txid"`
+	FeeSat            int64    `json:"fee_sat"`
+	SatPerByte        uint32   `json:"sat_per_byte"`
+	BroadcastAttempts uint32   `json:"broadcast_attempts"`
+	DeadlineHeight    int32    `json:"deadline_height"`
+}
+
+// NewSweepRecordFromProto converts the walletrpc.SweepRecord proto type into
+// its corresponding CLI-friendly type.
+func NewSweepRecordFromProto(record *walletrpc.SweepRecord) *SweepRecord {
+	return &SweepRecord{
+		OutPoint:          NewOutPointFromProto(record.Outpoint),
+		WitnessType:       record.WitnessType.String(),
+		AmountSat:         record.AmountSat,
+		Outcome:           record.Outcome.String(),
+		SweepTxid:         record.SweepTxid,
+		SpendTxid:         record.SpendTxid,
+		FeeSat:            record.FeeSat,
+		SatPerByte:        record.SatPerByte,
+		BroadcastAttempts: record.BroadcastAttempts,
+		DeadlineHeight:    record.DeadlineHeight,
+	}
+}
+
+// UtxoLease is a CLI-friendly type of the walletrpc.UtxoLease proto. We use
+// this to show a hex encoded version of the lease ID and outpoint.
+type UtxoLease struct {
+	ID         string   `json:"id"`
+	OutPoint   OutPoint `json:"outpoint"`
+	Expiration uint64   `json:"expiration"`
+}
+
+// NewUtxoLeaseFromProto converts the walletrpc.UtxoLease proto type into its
+// corresponding CLI-friendly type.
+func NewUtxoLeaseFromProto(lease *walletrpc.UtxoLease) *UtxoLease {
+	return &UtxoLease{
+		ID:         hex.EncodeToString(lease.Id),
+		OutPoint:   NewOutPointFromProto(lease.Outpoint),
+		Expiration: lease.Expiration,
 	}
 }
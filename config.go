@@ -30,8 +30,11 @@ import (
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/feepolicy"
+	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/tor"
 )
 
@@ -157,7 +160,7 @@ type chainConfig struct {
 	Active   bool   `long:"active" description:"If the chain should be active or not."`
 	ChainDir string `long:"chaindir" description:"The directory to store the chain's data within."`
 
-	Node string `long:"node" description:"The blockchain interface to use." choice:"btcd" choice:"bitcoind" choice:"neutrino" choice:"ltcd" choice:"litecoind"`
+	Node string `long:"node" description:"The blockchain interface to use." choice:"btcd" choice:"bitcoind" choice:"neutrino" choice:"electrum" choice:"esplora" choice:"ltcd" choice:"litecoind"`
 
 	MainNet  bool `long:"mainnet" description:"Use the main network"`
 	TestNet3 bool `long:"testnet" description:"Use the test network"`
@@ -182,6 +185,18 @@ type neutrinoConfig struct {
 	AssertFilterHeader string        `long:"assertfilterheader" description:"Optional filter header in height:hash format to assert the state of neutrino's filter header chain on startup. If the assertion does not hold, then the filter header chain will be re-synced from the genesis block."`
 }
 
+type electrumConfig struct {
+	Server        string `long:"server" description:"The host:port of the Electrum server to connect to"`
+	NoTLS         bool   `long:"notls" description:"Connect to the Electrum server over plain TCP instead of TLS"`
+	TLSCertPath   string `long:"tlscertpath" description:"Optional path to the Electrum server's TLS certificate. If not set, the server's certificate is verified using the system's root certificates."`
+	TLSSkipVerify bool   `long:"tlsskipverify" description:"Skip the verification of the Electrum server's TLS certificate, which is commonly self-signed. Only use this when connecting to a server you trust, e.g. over Tor."`
+}
+
+type esploraConfig struct {
+	URL          string        `long:"url" description:"The base URL of the Esplora REST API to connect to, e.g. https://blockstream.info/api"`
+	PollInterval time.Duration `long:"pollinterval" description:"How often to poll the Esplora server for a new chain tip and mempool transactions. Valid time units are {s, m, h}."`
+}
+
 type btcdConfig struct {
 	Dir        string `long:"dir" description:"The base directory that contains the node's data, logs, configuration file, etc."`
 	RPCHost    string `long:"rpchost" description:"The daemon's rpc listening address. If a port is omitted, then the default port for the selected chain parameters will be used."`
@@ -279,6 +294,8 @@ type config struct {
 	BtcdMode     *btcdConfig     `group:"btcd" namespace:"btcd"`
 	BitcoindMode *bitcoindConfig `group:"bitcoind" namespace:"bitcoind"`
 	NeutrinoMode *neutrinoConfig `group:"neutrino" namespace:"neutrino"`
+	ElectrumMode *electrumConfig `group:"electrum" namespace:"electrum"`
+	EsploraMode  *esploraConfig  `group:"esplora" namespace:"esplora"`
 
 	Litecoin      *chainConfig    `group:"Litecoin" namespace:"litecoin"`
 	LtcdMode      *btcdConfig     `group:"ltcd" namespace:"ltcd"`
@@ -320,6 +337,10 @@ type config struct {
 
 	MaxChannelFeeAllocation float64 `long:"max-channel-fee-allocation" description:"The maximum percentage of total funds that can be allocated to a channel's commitment fee. This only applies for the initiator of the channel. Valid values are within [0.1, 1]."`
 
+	MaxDustExposure uint64 `long:"max-dust-exposure" description:"The maximum total value in satoshis of trimmed HTLCs allowed on either commitment of a channel. Trimmed HTLCs are burned to miners if the channel is force closed. HTLCs that would exceed this limit are failed back, and fee updates that would exceed it aren't sent. The limit is disabled by default (0)."`
+
+	ResolvedChanPruneWindow uint32 `long:"resolved-chan-prune-window" description:"If set, the number of blocks after the close of a fully resolved channel at which the state that is still stored for it is pruned from the database. Pruning runs at startup and roughly once a day. It wipes the forwarding packages of the channel and any arbitrator state left behind, while the closed channel summary and resolution reports are kept. Revocation state is already removed when the channel is closed. Disabled by default."`
+
 	net tor.Net
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
@@ -328,6 +349,16 @@ type config struct {
 
 	Caches *lncfg.Caches `group:"caches" namespace:"caches"`
 
+	FeePolicy *lncfg.FeePolicy `group:"feepolicy" namespace:"feepolicy"`
+
+	FeeEstimator *lncfg.FeeEstimator `group:"feeestimator" namespace:"feeestimator"`
+
+	Consolidation *lncfg.Consolidation `group:"consolidation" namespace:"consolidation"`
+
+	Sweeper *lncfg.Sweeper `group:"sweeper" namespace:"sweeper"`
+
+	StuckHtlc *lncfg.StuckHtlc `group:"stuckhtlc" namespace:"stuckhtlc"`
+
 	Prometheus lncfg.Prometheus `group:"prometheus" namespace:"prometheus"`
 
 	WtClient *lncfg.WtClient `group:"wtclient" namespace:"wtclient"`
@@ -431,6 +462,34 @@ func loadConfig() (*config, error) {
 			RejectCacheSize:  channeldb.DefaultRejectCacheSize,
 			ChannelCacheSize: channeldb.DefaultChannelCacheSize,
 		},
+		FeePolicy: &lncfg.FeePolicy{
+			Interval:          feepolicy.DefaultUpdateInterval,
+			MinUpdateInterval: feepolicy.DefaultMinUpdateInterval,
+			MinFeeRateDelta:   feepolicy.DefaultMinFeeRateDelta,
+			VolumeWindow:      feepolicy.DefaultVolumeWindow,
+		},
+		FeeEstimator: &lncfg.FeeEstimator{
+			Percentile:   lnwallet.DefaultFeePercentile,
+			MaxDeviation: lnwallet.DefaultFeeMaxDeviation,
+			MinSources:   lnwallet.DefaultFeeMinSources,
+		},
+		Consolidation: &lncfg.Consolidation{
+			ConfTarget: sweep.DefaultConsolidationConfTarget,
+			MaxFeeRate: uint64(
+				sweep.DefaultConsolidationMaxFeeRate.
+					FeePerKVByte() / 1000,
+			),
+			MinInputs: sweep.DefaultConsolidationMinInputs,
+			MaxInputs: sweep.DefaultConsolidationMaxInputs,
+		},
+		Sweeper: &lncfg.Sweeper{
+			FeeFunction:        "linear",
+			DeadlineBucketSize: sweep.DefaultDeadlineBucketSize,
+		},
+		StuckHtlc: &lncfg.StuckHtlc{
+			ReconnectGrace: htlcswitch.DefaultStuckHtlcReconnectGrace,
+			Interval:       htlcswitch.DefaultStuckHtlcCheckInterval,
+		},
 		Prometheus: lncfg.DefaultPrometheus(),
 		Watchtower: &lncfg.Watchtower{
 			TowerDir: defaultTowerDir,
@@ -846,9 +905,46 @@ func loadConfig() (*config, error) {
 		case "neutrino":
 			// No need to get RPC parameters.
 
+		case "electrum":
+			if cfg.ElectrumMode.Server == "" {
+				return nil, fmt.Errorf("%s: electrum.server "+
+					"must be specified", funcName)
+			}
+			if cfg.ElectrumMode.NoTLS &&
+				(cfg.ElectrumMode.TLSCertPath != "" ||
+					cfg.ElectrumMode.TLSSkipVerify) {
+
+				return nil, fmt.Errorf("%s: electrum.notls "+
+					"can't be used with electrum.tlscertpath "+
+					"or electrum.tlsskipverify", funcName)
+			}
+
+			// Electrum servers don't serve full blocks, which
+			// the watchtower needs to scan for breaches.
+			if cfg.Watchtower.Active {
+				return nil, fmt.Errorf("%s: the watchtower "+
+					"can't be used with electrum", funcName)
+			}
+
+			cfg.ElectrumMode.TLSCertPath = cleanAndExpandPath(
+				cfg.ElectrumMode.TLSCertPath,
+			)
+
+		case "esplora":
+			if cfg.EsploraMode.URL == "" {
+				return nil, fmt.Errorf("%s: esplora.url "+
+					"must be specified", funcName)
+			}
+			if cfg.EsploraMode.PollInterval < 0 {
+				return nil, fmt.Errorf("%s: esplora."+
+					"pollinterval can't be negative",
+					funcName)
+			}
+
 		default:
-			str := "%s: only btcd, bitcoind, and neutrino mode " +
-				"supported for bitcoin at this time"
+			str := "%s: only btcd, bitcoind, neutrino, electrum " +
+				"and esplora mode supported for bitcoin at " +
+				"this time"
 			return nil, fmt.Errorf(str, funcName)
 		}
 
@@ -1093,10 +1189,17 @@ func loadConfig() (*config, error) {
 			"minbackoff")
 	}
 
-	// Validate the subconfigs for workers, caches, and the tower client.
+	// Validate the subconfigs for workers, caches, the fee engine, the fee
+	// estimator, UTXO consolidation, the sweeper, the stuck HTLC monitor
+	// and the tower client.
 	err = lncfg.Validate(
 		cfg.Workers,
 		cfg.Caches,
+		cfg.FeePolicy,
+		cfg.FeeEstimator,
+		cfg.Consolidation,
+		cfg.Sweeper,
+		cfg.StuckHtlc,
 		cfg.WtClient,
 	)
 	if err != nil {
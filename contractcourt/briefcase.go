@@ -8,9 +8,11 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
@@ -40,6 +42,186 @@ func (c *ContractResolutions) IsEmpty() bool {
 		len(c.HtlcResolutions.OutgoingHTLCs) == 0
 }
 
+// ResolverType indicates the type of output a ResolverReport was created for.
+type ResolverType uint8
+
+const (
+	// ResolverTypeCommit is the type of a report for our output on the
+	// commitment transaction.
+	ResolverTypeCommit ResolverType = 0
+
+	// ResolverTypeIncomingHtlc is the type of a report for an incoming
+	// HTLC output.
+	ResolverTypeIncomingHtlc ResolverType = 1
+
+	// ResolverTypeOutgoingHtlc is the type of a report for an outgoing
+	// HTLC output.
+	ResolverTypeOutgoingHtlc ResolverType = 2
+)
+
+// String returns a human readable string describing the ResolverType.
+func (r ResolverType) String() string {
+	switch r {
+	case ResolverTypeCommit:
+		return "Commit"
+
+	case ResolverTypeIncomingHtlc:
+		return "IncomingHtlc"
+
+	case ResolverTypeOutgoingHtlc:
+		return "OutgoingHtlc"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// ResolverOutcome indicates how the output a ResolverReport was created for
+// was resolved.
+type ResolverOutcome uint8
+
+const (
+	// ResolverOutcomeClaimed indicates that the output was claimed. Our
+	// commitment output and incoming HTLCs are claimed by us, while
+	// outgoing HTLCs are claimed by the remote party with the preimage.
+	ResolverOutcomeClaimed ResolverOutcome = 0
+
+	// ResolverOutcomeTimeout indicates that an outgoing HTLC timed out,
+	// and was swept back to us.
+	ResolverOutcomeTimeout ResolverOutcome = 1
+
+	// ResolverOutcomeAbandoned indicates that we gave up on claiming an
+	// incoming HTLC, because it expired or was canceled before we learned
+	// of the preimage. The remote party may still sweep it.
+	ResolverOutcomeAbandoned ResolverOutcome = 2
+
+	// ResolverOutcomeBreached indicates that the output was swept by the
+	// remote party through the revocation clause, as the commitment
+	// transaction it belongs to was revoked.
+	ResolverOutcomeBreached ResolverOutcome = 3
+
+	// ResolverOutcomeWrittenOff indicates that an HTLC was below the
+	// minimum value worth going to chain for of the channel, so we didn't
+	// force close the channel as it was about to expire. An outgoing HTLC
+	// is failed back, leaving the remote party free to claim it, while an
+	// incoming HTLC is given up on.
+	ResolverOutcomeWrittenOff ResolverOutcome = 4
+)
+
+// String returns a human readable string describing the ResolverOutcome.
+func (r ResolverOutcome) String() string {
+	switch r {
+	case ResolverOutcomeClaimed:
+		return "Claimed"
+
+	case ResolverOutcomeTimeout:
+		return "Timeout"
+
+	case ResolverOutcomeAbandoned:
+		return "Abandoned"
+
+	case ResolverOutcomeBreached:
+		return "Breached"
+
+	case ResolverOutcomeWrittenOff:
+		return "WrittenOff"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// ResolverReport describes how a single output of a channel's commitment
+// transaction was resolved. The reports are kept after the channel has been
+// fully resolved, so that every satoshi of a force close can be accounted for.
+type ResolverReport struct {
+	// OutPoint is the output on the commitment transaction that was
+	// resolved.
+	OutPoint wire.OutPoint
+
+	// Amount is the value of the output.
+	Amount btcutil.Amount
+
+	// ResolverType is the type of the output.
+	ResolverType ResolverType
+
+	// ResolverOutcome is how the output was resolved.
+	ResolverOutcome ResolverOutcome
+
+	// SpendTxID is the transaction that finally resolved the output. For
+	// outputs resolved through a second-level transaction, this is the
+	// transaction spending the second-level output. It is nil if the
+	// output wasn't spent by the time it was resolved.
+	SpendTxID *chainhash.Hash
+
+	// WrittenOffHtlc identifies the HTLC of a report with the WrittenOff
+	// outcome. As such an HTLC never made it to chain, OutPoint is left
+	// empty for these reports.
+	WrittenOffHtlc *WrittenOffHtlc
+}
+
+// WrittenOffHtlc identifies an HTLC that was written off while the channel was
+// still open.
+type WrittenOffHtlc struct {
+	// PaymentHash is the payment hash of the HTLC.
+	PaymentHash lntypes.Hash
+
+	// HtlcIndex is the index of the HTLC within the channel.
+	HtlcIndex uint64
+}
+
+// reportKey returns the key the report is stored under. Reports of outputs are
+// keyed by their outpoint, while written off HTLCs are keyed by their payment
+// hash and index, which never collides as the key is longer.
+func (r *ResolverReport) reportKey() []byte {
+	if r.WrittenOffHtlc == nil {
+		key := newResolverID(r.OutPoint)
+		return key[:]
+	}
+
+	var key [lntypes.HashSize + 8]byte
+	copy(key[:], r.WrittenOffHtlc.PaymentHash[:])
+	endian.PutUint64(key[lntypes.HashSize:], r.WrittenOffHtlc.HtlcIndex)
+
+	return key[:]
+}
+
+// recovered returns true if the output the report was created for was swept
+// back to our wallet.
+func (r *ResolverReport) recovered() bool {
+	switch r.ResolverType {
+	case ResolverTypeCommit, ResolverTypeIncomingHtlc:
+		return r.ResolverOutcome == ResolverOutcomeClaimed
+
+	case ResolverTypeOutgoingHtlc:
+		return r.ResolverOutcome == ResolverOutcomeTimeout
+
+	default:
+		return false
+	}
+}
+
+// newWrittenOffReport returns the report of an HTLC that was written off
+// while the channel was still open. As the HTLC never made it to chain, there
+// is no output to identify it by, so it's identified by its payment hash and
+// index instead.
+func newWrittenOffReport(htlc *channeldb.HTLC) *ResolverReport {
+	resolverType := ResolverTypeOutgoingHtlc
+	if htlc.Incoming {
+		resolverType = ResolverTypeIncomingHtlc
+	}
+
+	return &ResolverReport{
+		Amount:          htlc.Amt.ToSatoshis(),
+		ResolverType:    resolverType,
+		ResolverOutcome: ResolverOutcomeWrittenOff,
+		WrittenOffHtlc: &WrittenOffHtlc{
+			PaymentHash: htlc.RHash,
+			HtlcIndex:   htlc.HtlcIndex,
+		},
+	}
+}
+
 // ArbitratorLog is the primary source of persistent storage for the
 // ChannelArbitrator. The log stores the current state of the
 // ChannelArbitrator's internal state machine, any items that are required to
@@ -56,8 +238,10 @@ type ArbitratorLog interface {
 
 	// InsertUnresolvedContracts inserts a set of unresolved contracts into
 	// the log. The log will then persistently store each contract until
-	// they've been swapped out, or resolved.
-	InsertUnresolvedContracts(...ContractResolver) error
+	// they've been swapped out, or resolved. The given reports are stored
+	// atomically along with the contracts.
+	InsertUnresolvedContracts(reports []*ResolverReport,
+		resolvers ...ContractResolver) error
 
 	// FetchUnresolvedContracts returns all unresolved contracts that have
 	// been previously written to the log.
@@ -91,6 +275,14 @@ type ArbitratorLog interface {
 	// from the database.
 	FetchConfirmedCommitSet() (*CommitSet, error)
 
+	// FetchResolverReports returns the reports of all outputs of the
+	// contract that have been resolved so far.
+	FetchResolverReports() ([]*ResolverReport, error)
+
+	// LogResolverReports stores the given reports, for decisions that
+	// aren't tied to a contract resolver.
+	LogResolverReports(reports ...*ResolverReport) error
+
 	// FetchChainActions attempts to fetch the set of previously stored
 	// chain actions. We'll use this upon restart to properly advance our
 	// state machine forward.
@@ -102,7 +294,8 @@ type ArbitratorLog interface {
 
 	// WipeHistory is to be called ONLY once *all* contracts have been
 	// fully resolved, and the channel closure if finalized. This method
-	// will delete all on-disk state within the persistent log.
+	// will delete all on-disk state within the persistent log, except for
+	// the resolver reports.
 	WipeHistory() error
 }
 
@@ -271,6 +464,12 @@ var (
 	// store the confirmed active HTLC sets once we learn that a channel
 	// has closed out on chain.
 	commitSetKey = []byte("commit-set")
+
+	// resolverReportsBucketKey is the top-level bucket that stores the
+	// resolver reports of each channel, within a sub-bucket keyed by the
+	// logScope. It's kept separate from the logScope bucket itself, as
+	// the reports must outlive the wiping of the log.
+	resolverReportsBucketKey = []byte("resolver-reports")
 )
 
 var (
@@ -532,10 +731,13 @@ func (b *boltArbitratorLog) FetchUnresolvedContracts() ([]ContractResolver, erro
 
 // InsertUnresolvedContracts inserts a set of unresolved contracts into the
 // log. The log will then persistently store each contract until they've been
-// swapped out, or resolved.
+// swapped out, or resolved. The given reports are stored atomically along with
+// the contracts.
 //
 // NOTE: Part of the ContractResolver interface.
-func (b *boltArbitratorLog) InsertUnresolvedContracts(resolvers ...ContractResolver) error {
+func (b *boltArbitratorLog) InsertUnresolvedContracts(reports []*ResolverReport,
+	resolvers ...ContractResolver) error {
+
 	return b.db.Batch(func(tx *bbolt.Tx) error {
 		contractBucket, err := fetchContractWriteBucket(tx, b.scopeKey[:])
 		if err != nil {
@@ -549,7 +751,7 @@ func (b *boltArbitratorLog) InsertUnresolvedContracts(resolvers ...ContractResol
 			}
 		}
 
-		return nil
+		return putResolverReports(tx, b.scopeKey[:], reports)
 	})
 }
 
@@ -737,6 +939,24 @@ func (b *boltArbitratorLog) FetchContractResolutions() (*ContractResolutions, er
 	return c, err
 }
 
+// FetchResolverReports returns the reports of all outputs of the contract that
+// have been resolved so far.
+//
+// NOTE: Part of the ContractResolver interface.
+func (b *boltArbitratorLog) FetchResolverReports() ([]*ResolverReport, error) {
+	return fetchResolverReports(b.db, b.scopeKey)
+}
+
+// LogResolverReports stores the given reports, for decisions that aren't tied
+// to a contract resolver.
+//
+// NOTE: Part of the ContractResolver interface.
+func (b *boltArbitratorLog) LogResolverReports(reports ...*ResolverReport) error {
+	return b.db.Batch(func(tx *bbolt.Tx) error {
+		return putResolverReports(tx, b.scopeKey[:], reports)
+	})
+}
+
 // FetchChainActions attempts to fetch the set of previously stored chain
 // actions. We'll use this upon restart to properly advance our state machine
 // forward.
@@ -836,7 +1056,8 @@ func (b *boltArbitratorLog) FetchConfirmedCommitSet() (*CommitSet, error) {
 
 // WipeHistory is to be called ONLY once *all* contracts have been fully
 // resolved, and the channel closure if finalized. This method will delete all
-// on-disk state within the persistent log.
+// on-disk state within the persistent log, except for the resolver reports
+// which are stored outside of the log's scope.
 //
 // NOTE: Part of the ContractResolver interface.
 func (b *boltArbitratorLog) WipeHistory() error {
@@ -877,18 +1098,246 @@ func (b *boltArbitratorLog) WipeHistory() error {
 	})
 }
 
+// arbitratorLogSize returns the number of bytes taken up by the arbitrator log
+// of the channel with the given scope. Normally, this is zero once the channel
+// is resolved, as its log is wiped at that point.
+func arbitratorLogSize(db *bbolt.DB, scope logScope) (uint64, error) {
+	var size uint64
+	err := db.View(func(tx *bbolt.Tx) error {
+		scopeBucket := tx.Bucket(scope[:])
+		if scopeBucket == nil {
+			return nil
+		}
+
+		size = uint64(len(scope)) + channeldb.BucketSize(scopeBucket)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// wipeArbitratorLog removes any arbitrator log that was left behind for the
+// resolved channel with the given scope within the given transaction, and
+// returns the number of bytes removed. Unlike WipeHistory, this doesn't
+// require the channel arbitrator's config, and is a no-op if the log doesn't
+// exist.
+func wipeArbitratorLog(tx *bbolt.Tx, scope logScope) (uint64, error) {
+	scopeBucket := tx.Bucket(scope[:])
+	if scopeBucket == nil {
+		return 0, nil
+	}
+
+	size := uint64(len(scope)) + channeldb.BucketSize(scopeBucket)
+	if err := tx.DeleteBucket(scope[:]); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
 // checkpointContract is a private method that will be fed into
 // ContractResolver instances to checkpoint their state once they reach
-// milestones during contract resolution.
-func (b *boltArbitratorLog) checkpointContract(c ContractResolver) error {
+// milestones during contract resolution. Any reports are stored atomically
+// along with the state of the resolver.
+func (b *boltArbitratorLog) checkpointContract(c ContractResolver,
+	reports ...*ResolverReport) error {
+
 	return b.db.Batch(func(tx *bbolt.Tx) error {
 		contractBucket, err := fetchContractWriteBucket(tx, b.scopeKey[:])
 		if err != nil {
 			return err
 		}
 
-		return b.writeResolver(contractBucket, c)
+		if err := b.writeResolver(contractBucket, c); err != nil {
+			return err
+		}
+
+		return putResolverReports(tx, b.scopeKey[:], reports)
+	})
+}
+
+// putResolverReports stores the given resolver reports within the reports
+// bucket of the log scope, replacing any previous report of the same output.
+func putResolverReports(tx *bbolt.Tx, scopeKey []byte,
+	reports []*ResolverReport) error {
+
+	if len(reports) == 0 {
+		return nil
+	}
+
+	reportsBucket, err := tx.CreateBucketIfNotExists(
+		resolverReportsBucketKey,
+	)
+	if err != nil {
+		return err
+	}
+	scopeBucket, err := reportsBucket.CreateBucketIfNotExists(scopeKey)
+	if err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		var b bytes.Buffer
+		if err := encodeResolverReport(&b, report); err != nil {
+			return err
+		}
+
+		err := scopeBucket.Put(report.reportKey(), b.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchResolverReports returns all resolver reports stored for the given log
+// scope. As the reports outlive the log itself, this may be called for
+// channels that have already been fully resolved.
+func fetchResolverReports(db *bbolt.DB,
+	scopeKey logScope) ([]*ResolverReport, error) {
+
+	var reports []*ResolverReport
+	err := db.View(func(tx *bbolt.Tx) error {
+		reportsBucket := tx.Bucket(resolverReportsBucketKey)
+		if reportsBucket == nil {
+			return nil
+		}
+		scopeBucket := reportsBucket.Bucket(scopeKey[:])
+		if scopeBucket == nil {
+			return nil
+		}
+
+		return scopeBucket.ForEach(func(k, v []byte) error {
+			report := &ResolverReport{}
+			err := decodeResolverReport(bytes.NewReader(v), report)
+			if err != nil {
+				return err
+			}
+
+			reports = append(reports, report)
+			return nil
+		})
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+func encodeResolverReport(w io.Writer, r *ResolverReport) error {
+	if _, err := w.Write(r.OutPoint.Hash[:]); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, r.OutPoint.Index); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, uint64(r.Amount)); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, r.ResolverType); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, r.ResolverOutcome); err != nil {
+		return err
+	}
+
+	if r.SpendTxID == nil {
+		if err := binary.Write(w, endian, false); err != nil {
+			return err
+		}
+	} else {
+		if err := binary.Write(w, endian, true); err != nil {
+			return err
+		}
+		if _, err := w.Write(r.SpendTxID[:]); err != nil {
+			return err
+		}
+	}
+
+	if r.WrittenOffHtlc == nil {
+		return binary.Write(w, endian, false)
+	}
+	if err := binary.Write(w, endian, true); err != nil {
+		return err
+	}
+	if _, err := w.Write(r.WrittenOffHtlc.PaymentHash[:]); err != nil {
+		return err
+	}
+	return binary.Write(w, endian, r.WrittenOffHtlc.HtlcIndex)
+}
+
+func decodeResolverReport(r io.Reader, report *ResolverReport) error {
+	_, err := io.ReadFull(r, report.OutPoint.Hash[:])
+	if err != nil {
+		return err
+	}
+	err = binary.Read(r, endian, &report.OutPoint.Index)
+	if err != nil {
+		return err
+	}
+
+	var amt uint64
+	if err := binary.Read(r, endian, &amt); err != nil {
+		return err
+	}
+	report.Amount = btcutil.Amount(amt)
+
+	err = binary.Read(r, endian, &report.ResolverType)
+	if err != nil {
+		return err
+	}
+	err = binary.Read(r, endian, &report.ResolverOutcome)
+	if err != nil {
+		return err
+	}
+
+	var haveSpend bool
+	if err := binary.Read(r, endian, &haveSpend); err != nil {
+		return err
+	}
+	if haveSpend {
+		report.SpendTxID = &chainhash.Hash{}
+		_, err = io.ReadFull(r, report.SpendTxID[:])
+		if err != nil {
+			return err
+		}
+	}
+
+	// Reports stored before written off HTLCs had their own field carry
+	// the payment hash and index of the HTLC in the outpoint instead.
+	var haveHtlc bool
+	err = binary.Read(r, endian, &haveHtlc)
+	switch {
+	case err == io.EOF:
+		if report.ResolverOutcome != ResolverOutcomeWrittenOff {
+			return nil
+		}
+
+		report.WrittenOffHtlc = &WrittenOffHtlc{
+			PaymentHash: lntypes.Hash(report.OutPoint.Hash),
+			HtlcIndex:   uint64(report.OutPoint.Index),
+		}
+		report.OutPoint = wire.OutPoint{}
+		return nil
+
+	case err != nil:
+		return err
+
+	case !haveHtlc:
+		return nil
+	}
+
+	report.WrittenOffHtlc = &WrittenOffHtlc{}
+	_, err = io.ReadFull(r, report.WrittenOffHtlc.PaymentHash[:])
+	if err != nil {
+		return err
+	}
+	return binary.Read(r, endian, &report.WrittenOffHtlc.HtlcIndex)
 }
 
 func encodeIncomingResolution(w io.Writer, i *lnwallet.IncomingHtlcResolution) error {
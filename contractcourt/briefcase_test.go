@@ -1,6 +1,7 @@
 package contractcourt
 
 import (
+	"bytes"
 	"crypto/rand"
 	"io/ioutil"
 	"os"
@@ -18,6 +19,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
@@ -149,10 +151,6 @@ func assertResolversEqual(t *testing.T, originalResolver ContractResolver,
 			t.Fatalf("resolution mismatch: expected %#v, got %v#",
 				ogRes.htlcResolution, diskRes.htlcResolution)
 		}
-		if ogRes.outputIncubating != diskRes.outputIncubating {
-			t.Fatalf("expected %v, got %v",
-				ogRes.outputIncubating, diskRes.outputIncubating)
-		}
 		if ogRes.resolved != diskRes.resolved {
 			t.Fatalf("expected %v, got %v", ogRes.resolved,
 				diskRes.resolved)
@@ -172,10 +170,6 @@ func assertResolversEqual(t *testing.T, originalResolver ContractResolver,
 			t.Fatalf("resolution mismatch: expected %#v, got %v#",
 				ogRes.htlcResolution, diskRes.htlcResolution)
 		}
-		if ogRes.outputIncubating != diskRes.outputIncubating {
-			t.Fatalf("expected %v, got %v",
-				ogRes.outputIncubating, diskRes.outputIncubating)
-		}
 		if ogRes.resolved != diskRes.resolved {
 			t.Fatalf("expected %v, got %v", ogRes.resolved,
 				diskRes.resolved)
@@ -262,10 +256,9 @@ func TestContractInsertionRetrieval(t *testing.T) {
 			ClaimOutpoint:   randOutPoint(),
 			SweepSignDesc:   testSignDesc,
 		},
-		outputIncubating: true,
-		resolved:         true,
-		broadcastHeight:  102,
-		htlcIndex:        12,
+		resolved:        true,
+		broadcastHeight: 102,
+		htlcIndex:       12,
 	}
 	successResolver := htlcSuccessResolver{
 		htlcResolution: lnwallet.IncomingHtlcResolution{
@@ -275,11 +268,10 @@ func TestContractInsertionRetrieval(t *testing.T) {
 			ClaimOutpoint:   randOutPoint(),
 			SweepSignDesc:   testSignDesc,
 		},
-		outputIncubating: true,
-		resolved:         true,
-		broadcastHeight:  109,
-		payHash:          testPreimage,
-		sweepTx:          nil,
+		resolved:        true,
+		broadcastHeight: 109,
+		payHash:         testPreimage,
+		sweepTx:         nil,
 	}
 	resolvers := []ContractResolver{
 		&timeoutResolver,
@@ -299,17 +291,27 @@ func TestContractInsertionRetrieval(t *testing.T) {
 	// All resolvers require a unique ResolverKey() output. To achieve this
 	// for the composite resolvers, we'll mutate the underlying resolver
 	// with a new outpoint.
-	contestTimeout := timeoutResolver
+	contestTimeout := &htlcOutgoingContestResolver{
+		htlcTimeoutResolver: htlcTimeoutResolver{
+			htlcResolution:  timeoutResolver.htlcResolution,
+			resolved:        timeoutResolver.resolved,
+			broadcastHeight: timeoutResolver.broadcastHeight,
+			htlcIndex:       timeoutResolver.htlcIndex,
+		},
+	}
 	contestTimeout.htlcResolution.ClaimOutpoint = randOutPoint()
-	resolvers = append(resolvers, &htlcOutgoingContestResolver{
-		htlcTimeoutResolver: contestTimeout,
-	})
-	contestSuccess := successResolver
+	resolvers = append(resolvers, contestTimeout)
+	contestSuccess := &htlcIncomingContestResolver{
+		htlcExpiry: 100,
+		htlcSuccessResolver: htlcSuccessResolver{
+			htlcResolution:  successResolver.htlcResolution,
+			resolved:        successResolver.resolved,
+			broadcastHeight: successResolver.broadcastHeight,
+			payHash:         successResolver.payHash,
+		},
+	}
 	contestSuccess.htlcResolution.ClaimOutpoint = randOutPoint()
-	resolvers = append(resolvers, &htlcIncomingContestResolver{
-		htlcExpiry:          100,
-		htlcSuccessResolver: contestSuccess,
-	})
+	resolvers = append(resolvers, contestSuccess)
 
 	// For quick lookup during the test, we'll create this map which allow
 	// us to lookup a resolver according to its unique resolver key.
@@ -321,7 +323,8 @@ func TestContractInsertionRetrieval(t *testing.T) {
 	resolverMap[string(resolvers[4].ResolverKey())] = resolvers[4]
 
 	// Now, we'll insert the resolver into the log.
-	if err := testLog.InsertUnresolvedContracts(resolvers...); err != nil {
+	err = testLog.InsertUnresolvedContracts(nil, resolvers...)
+	if err != nil {
 		t.Fatalf("unable to insert resolvers: %v", err)
 	}
 
@@ -392,15 +395,14 @@ func TestContractResolution(t *testing.T) {
 			ClaimOutpoint:   randOutPoint(),
 			SweepSignDesc:   testSignDesc,
 		},
-		outputIncubating: true,
-		resolved:         true,
-		broadcastHeight:  192,
-		htlcIndex:        9912,
+		resolved:        true,
+		broadcastHeight: 192,
+		htlcIndex:       9912,
 	}
 
 	// First, we'll insert the resolver into the database and ensure that
 	// we get the same resolver out the other side.
-	err = testLog.InsertUnresolvedContracts(timeoutResolver)
+	err = testLog.InsertUnresolvedContracts(nil, timeoutResolver)
 	if err != nil {
 		t.Fatalf("unable to insert contract into db: %v", err)
 	}
@@ -443,32 +445,31 @@ func TestContractSwapping(t *testing.T) {
 
 	// We'll create two resolvers, a regular timeout resolver, and the
 	// contest resolver that eventually turns into the timeout resolver.
-	timeoutResolver := htlcTimeoutResolver{
-		htlcResolution: lnwallet.OutgoingHtlcResolution{
-			Expiry:          99,
-			SignedTimeoutTx: nil,
-			CsvDelay:        99,
-			ClaimOutpoint:   randOutPoint(),
-			SweepSignDesc:   testSignDesc,
-		},
-		outputIncubating: true,
-		resolved:         true,
-		broadcastHeight:  102,
-		htlcIndex:        12,
-	}
 	contestResolver := &htlcOutgoingContestResolver{
-		htlcTimeoutResolver: timeoutResolver,
+		htlcTimeoutResolver: htlcTimeoutResolver{
+			htlcResolution: lnwallet.OutgoingHtlcResolution{
+				Expiry:          99,
+				SignedTimeoutTx: nil,
+				CsvDelay:        99,
+				ClaimOutpoint:   randOutPoint(),
+				SweepSignDesc:   testSignDesc,
+			},
+			resolved:        true,
+			broadcastHeight: 102,
+			htlcIndex:       12,
+		},
 	}
+	timeoutResolver := &contestResolver.htlcTimeoutResolver
 
 	// We'll first insert the contest resolver into the log.
-	err = testLog.InsertUnresolvedContracts(contestResolver)
+	err = testLog.InsertUnresolvedContracts(nil, contestResolver)
 	if err != nil {
 		t.Fatalf("unable to insert contract into db: %v", err)
 	}
 
 	// With the resolver inserted, we'll now attempt to atomically swap it
 	// for its underlying timeout resolver.
-	err = testLog.SwapContract(contestResolver, &timeoutResolver)
+	err = testLog.SwapContract(contestResolver, timeoutResolver)
 	if err != nil {
 		t.Fatalf("unable to swap contracts: %v", err)
 	}
@@ -485,7 +486,7 @@ func TestContractSwapping(t *testing.T) {
 	}
 
 	// That single contract should be the underlying timeout resolver.
-	assertResolversEqual(t, &timeoutResolver, dbContracts[0])
+	assertResolversEqual(t, timeoutResolver, dbContracts[0])
 }
 
 // TestContractResolutionsStorage tests that we're able to properly store and
@@ -736,6 +737,178 @@ func TestCommitSetStorage(t *testing.T) {
 
 }
 
+// TestResolverReportStorage tests that resolver reports are stored along with
+// the resolvers, replace previous reports of the same output, and outlive the
+// wiping of the log.
+func TestResolverReportStorage(t *testing.T) {
+	t.Parallel()
+
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	// Initially, no reports should be stored.
+	reports, err := testLog.FetchResolverReports()
+	if err != nil {
+		t.Fatalf("unable to fetch reports: %v", err)
+	}
+	if len(reports) != 0 {
+		t.Fatalf("expected no reports, got %v", len(reports))
+	}
+
+	timeoutResolver := &htlcTimeoutResolver{
+		htlcResolution: lnwallet.OutgoingHtlcResolution{
+			Expiry:        991,
+			CsvDelay:      992,
+			ClaimOutpoint: randOutPoint(),
+			SweepSignDesc: testSignDesc,
+		},
+		broadcastHeight: 192,
+		htlcIndex:       9912,
+	}
+
+	spendTxID := chainhash.Hash{1}
+	timeoutReport := &ResolverReport{
+		OutPoint:        timeoutResolver.htlcOutpoint(),
+		Amount:          1000,
+		ResolverType:    ResolverTypeOutgoingHtlc,
+		ResolverOutcome: ResolverOutcomeTimeout,
+		SpendTxID:       &spendTxID,
+	}
+	abandonedReport := &ResolverReport{
+		OutPoint:        randOutPoint(),
+		Amount:          2000,
+		ResolverType:    ResolverTypeIncomingHtlc,
+		ResolverOutcome: ResolverOutcomeAbandoned,
+	}
+
+	// We'll insert the resolver along with a first report of its output,
+	// then checkpoint it with the final report, which should replace the
+	// first one.
+	claimedReport := *timeoutReport
+	claimedReport.ResolverOutcome = ResolverOutcomeClaimed
+	err = testLog.InsertUnresolvedContracts(
+		[]*ResolverReport{&claimedReport, abandonedReport},
+		timeoutResolver,
+	)
+	if err != nil {
+		t.Fatalf("unable to insert contract into db: %v", err)
+	}
+
+	boltLog := testLog.(*boltArbitratorLog)
+	timeoutResolver.resolved = true
+	err = boltLog.checkpointContract(timeoutResolver, timeoutReport)
+	if err != nil {
+		t.Fatalf("unable to checkpoint contract: %v", err)
+	}
+
+	// Written off HTLCs have no outpoint, so HTLCs sharing a payment hash
+	// must be stored separately.
+	writtenOffReport := newWrittenOffReport(&channeldb.HTLC{
+		RHash:     [32]byte{2},
+		Amt:       3000000,
+		HtlcIndex: 1,
+	})
+	writtenOffReport2 := newWrittenOffReport(&channeldb.HTLC{
+		RHash:     [32]byte{2},
+		Amt:       4000000,
+		HtlcIndex: 2,
+		Incoming:  true,
+	})
+	err = testLog.LogResolverReports(writtenOffReport, writtenOffReport2)
+	if err != nil {
+		t.Fatalf("unable to log reports: %v", err)
+	}
+
+	assertReports := func(expected ...*ResolverReport) {
+		t.Helper()
+
+		reports, err := testLog.FetchResolverReports()
+		if err != nil {
+			t.Fatalf("unable to fetch reports: %v", err)
+		}
+
+		expectedSet := make(map[string]*ResolverReport)
+		for _, report := range expected {
+			expectedSet[string(report.reportKey())] = report
+		}
+		if len(reports) != len(expectedSet) {
+			t.Fatalf("expected %v reports, got %v",
+				len(expectedSet), len(reports))
+		}
+		for _, report := range reports {
+			expected := expectedSet[string(report.reportKey())]
+			if !reflect.DeepEqual(expected, report) {
+				t.Fatalf("report mismatch: expected %v, got "+
+					"%v", spew.Sdump(expected),
+					spew.Sdump(report))
+			}
+		}
+	}
+	assertReports(
+		timeoutReport, abandonedReport, writtenOffReport,
+		writtenOffReport2,
+	)
+
+	// Once the log is wiped, the reports should still be available.
+	if err := testLog.WipeHistory(); err != nil {
+		t.Fatalf("unable to wipe history: %v", err)
+	}
+	assertReports(
+		timeoutReport, abandonedReport, writtenOffReport,
+		writtenOffReport2,
+	)
+}
+
+// TestDecodeLegacyWrittenOffReport asserts that reports of written off HTLCs
+// that were stored with the payment hash and index of the HTLC in place of the
+// outpoint are decoded into a report without outpoint.
+func TestDecodeLegacyWrittenOffReport(t *testing.T) {
+	t.Parallel()
+
+	legacyReport := &ResolverReport{
+		OutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{3},
+			Index: 7,
+		},
+		Amount:          500,
+		ResolverType:    ResolverTypeOutgoingHtlc,
+		ResolverOutcome: ResolverOutcomeWrittenOff,
+	}
+
+	// Strip the trailing written off HTLC field to arrive at the legacy
+	// encoding.
+	var b bytes.Buffer
+	if err := encodeResolverReport(&b, legacyReport); err != nil {
+		t.Fatalf("unable to encode report: %v", err)
+	}
+	legacy := b.Bytes()[:b.Len()-1]
+
+	report := &ResolverReport{}
+	err := decodeResolverReport(bytes.NewReader(legacy), report)
+	if err != nil {
+		t.Fatalf("unable to decode report: %v", err)
+	}
+
+	expected := &ResolverReport{
+		Amount:          500,
+		ResolverType:    ResolverTypeOutgoingHtlc,
+		ResolverOutcome: ResolverOutcomeWrittenOff,
+		WrittenOffHtlc: &WrittenOffHtlc{
+			PaymentHash: lntypes.Hash{3},
+			HtlcIndex:   7,
+		},
+	}
+	if !reflect.DeepEqual(expected, report) {
+		t.Fatalf("expected report %v, got %v", spew.Sdump(expected),
+			spew.Sdump(report))
+	}
+}
+
 func init() {
 	testSignDesc.KeyDesc.PubKey, _ = btcec.ParsePubKey(key1, btcec.S256())
 
@@ -9,12 +9,13 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
-	"github.com/lightningnetwork/lnd/sweep"
 )
 
 // ErrChainArbExiting signals that the chain arbitrator is shutting down.
@@ -76,8 +77,9 @@ type ChainArbitratorConfig struct {
 
 	// PublishTx reliably broadcasts a transaction to the network. Once
 	// this function exits without an error, then they transaction MUST
-	// continually be rebroadcast if needed.
-	PublishTx func(*wire.MsgTx) error
+	// continually be rebroadcast if needed. The given label is stored for
+	// the transaction.
+	PublishTx func(*wire.MsgTx, string) error
 
 	// DeliverResolutionMsg is a function that will append an outgoing
 	// message to the "out box" for a ChannelLink. This is used to cancel
@@ -106,16 +108,6 @@ type ChainArbitratorConfig struct {
 	// returned.
 	IsOurAddress func(btcutil.Address) bool
 
-	// IncubateOutput sends either an incoming HTLC, an outgoing HTLC, or
-	// both to the utxo nursery. Once this function returns, the nursery
-	// should have safely persisted the outputs to disk, and should start
-	// the process of incubation. This is used when a resolver wishes to
-	// pass off the output to the nursery as we're only waiting on an
-	// absolute/relative item block.
-	IncubateOutputs func(wire.OutPoint, *lnwallet.CommitOutputResolution,
-		*lnwallet.OutgoingHtlcResolution,
-		*lnwallet.IncomingHtlcResolution, uint32) error
-
 	// PreimageDB is a global store of all known pre-images. We'll use this
 	// to decide if we should broadcast a commitment transaction to claim
 	// an HTLC on-chain.
@@ -140,8 +132,9 @@ type ChainArbitratorConfig struct {
 	// forward payments.
 	DisableChannel func(wire.OutPoint) error
 
-	// Sweeper allows resolvers to sweep their final outputs.
-	Sweeper *sweep.UtxoSweeper
+	// Sweeper allows resolvers to sweep their final outputs, including
+	// the time locked ones once they have matured.
+	Sweeper UtxoSweeper
 
 	// Registry is the invoice database that is used by resolvers to lookup
 	// preimages and settle invoices.
@@ -150,8 +143,20 @@ type ChainArbitratorConfig struct {
 	// NotifyClosedChannel is a function closure that the ChainArbitrator
 	// will use to notify the ChannelNotifier about a newly closed channel.
 	NotifyClosedChannel func(wire.OutPoint)
+
+	// PruneWindow is the number of blocks after the close of a fully
+	// resolved channel at which the state that is still stored for it is
+	// pruned. Pruning runs at startup, and every pruneInterval blocks
+	// thereafter. Only the close summary and resolver reports of the
+	// channel are kept, while its forwarding packages are wiped through
+	// ChannelPackager.Wipe. If zero, no state is pruned.
+	PruneWindow uint32
 }
 
+// pruneInterval is the number of blocks, roughly a day, between two runs of
+// pruning the state of resolved channels.
+const pruneInterval = 144
+
 // ChainArbitrator is a sub-system that oversees the on-chain resolution of all
 // active, and channel that are in the "pending close" state. Within the
 // contractcourt package, the ChainArbitrator manages a set of active
@@ -225,6 +230,18 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 
 	chanPoint := channel.FundingOutpoint
 
+	// We'll also load the deadline policy of the channel, if one was set.
+	scope, err := newLogScope(c.cfg.ChainHash, chanPoint)
+	if err != nil {
+		blockEpoch.Cancel()
+		return nil, err
+	}
+	deadlinePolicy, err := fetchDeadlinePolicy(c.chanSource.DB, *scope)
+	if err != nil {
+		blockEpoch.Cancel()
+		return nil, err
+	}
+
 	// Next we'll create the matching configuration struct that contains
 	// all interfaces and methods the arbitrator needs to do its job.
 	arbCfg := ChannelArbitratorConfig{
@@ -274,6 +291,7 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 			return nil
 		},
 		IsPendingClose:        false,
+		DeadlinePolicy:        *deadlinePolicy,
 		ChainArbitratorConfig: c.cfg,
 		ChainEvents:           chanEvents,
 	}
@@ -346,6 +364,15 @@ func (c *ChainArbitrator) resolveContract(chanPoint wire.OutPoint,
 		}
 	}
 
+	// The deadline policy of the channel is no longer of use either.
+	scope, err := newLogScope(c.cfg.ChainHash, chanPoint)
+	if err != nil {
+		return err
+	}
+	if err := deleteDeadlinePolicy(c.chanSource.DB, *scope); err != nil {
+		return err
+	}
+
 	c.Lock()
 	delete(c.activeChannels, chanPoint)
 
@@ -437,7 +464,12 @@ func (c *ChainArbitrator) Start() error {
 
 		log.Infof("Re-publishing closing tx(%v) for channel %v",
 			closeTx.TxHash(), chanPoint)
-		err = c.cfg.PublishTx(closeTx)
+		shortChanID := channel.ShortChanID()
+		err = c.cfg.PublishTx(
+			closeTx, labels.MakeLabel(
+				labels.LabelTypeChannelClose, &shortChanID,
+			),
+		)
 		if err != nil && err != lnwallet.ErrDoubleSpend {
 			log.Warnf("Unable to broadcast close tx(%v): %v",
 				closeTx.TxHash(), err)
@@ -546,6 +578,20 @@ func (c *ChainArbitrator) Start() error {
 		}
 	}
 
+	// Now that all active channels are being watched over, we'll
+	// periodically prune the state of the channels that were resolved
+	// long enough ago, if enabled.
+	if c.cfg.PruneWindow != 0 {
+		blockEpoch, err := c.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+		if err != nil {
+			c.Stop()
+			return err
+		}
+
+		c.wg.Add(1)
+		go c.pruneResolvedChannelsOnBlocks(blockEpoch)
+	}
+
 	// TODO(roasbeef): eventually move all breach watching here
 
 	return nil
@@ -668,6 +714,229 @@ func (c *ChainArbitrator) GetChannelArbitrator(chanPoint wire.OutPoint) (
 	return arbitrator, nil
 }
 
+// UpdateDeadlinePolicy stores the deadline policy of the channel with the
+// given channel point, and hands it to its arbitrator. The policy decides when
+// the arbitrator goes to chain for the HTLCs of the channel.
+func (c *ChainArbitrator) UpdateDeadlinePolicy(chanPoint wire.OutPoint,
+	policy DeadlinePolicy) error {
+
+	c.Lock()
+	arbitrator, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+	if !ok {
+		return fmt.Errorf("unable to find arbitrator")
+	}
+
+	scope, err := newLogScope(c.cfg.ChainHash, chanPoint)
+	if err != nil {
+		return err
+	}
+	if err := putDeadlinePolicy(c.chanSource.DB, *scope, &policy); err != nil {
+		return err
+	}
+
+	log.Infof("Updated deadline policy of ChannelPoint(%v): "+
+		"incoming_broadcast_delta=%v, outgoing_broadcast_delta=%v, "+
+		"min_chain_htlc_value=%v", chanPoint,
+		policy.IncomingBroadcastDelta, policy.OutgoingBroadcastDelta,
+		policy.MinChainHtlcValue)
+
+	arbitrator.UpdateDeadlinePolicy(policy)
+
+	return nil
+}
+
+// PrunableChannel is a fully resolved closed channel of which state beyond its
+// close summary is still stored.
+type PrunableChannel struct {
+	// ChanPoint is the channel point of the channel.
+	ChanPoint wire.OutPoint
+
+	// CloseHeight is the height at which the channel was closed.
+	CloseHeight uint32
+
+	// PruneHeight is the height from which on the state of the channel is
+	// pruned. It's zero if pruning is disabled.
+	PruneHeight uint32
+
+	// Size is the number of bytes of keys and values that pruning the
+	// state of the channel removes from the database.
+	Size uint64
+
+	summary *channeldb.ChannelCloseSummary
+}
+
+// PrunableChannels returns all fully resolved closed channels of which state
+// beyond their close summary is still stored, along with the size of that
+// state.
+func (c *ChainArbitrator) PrunableChannels() ([]*PrunableChannel, error) {
+	summaries, err := c.chanSource.FetchClosedChannels(false)
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []*PrunableChannel
+	for _, summary := range summaries {
+		if summary.IsPending {
+			continue
+		}
+
+		size, err := c.chanSource.ClosedChannelStateSize(summary)
+		if err != nil {
+			return nil, err
+		}
+
+		scope, err := newLogScope(c.cfg.ChainHash, summary.ChanPoint)
+		if err != nil {
+			return nil, err
+		}
+		logSize, err := arbitratorLogSize(c.chanSource.DB, *scope)
+		if err != nil {
+			return nil, err
+		}
+		size += logSize
+
+		if size == 0 {
+			continue
+		}
+
+		channel := &PrunableChannel{
+			ChanPoint:   summary.ChanPoint,
+			CloseHeight: summary.CloseHeight,
+			Size:        size,
+			summary:     summary,
+		}
+		if c.cfg.PruneWindow != 0 {
+			channel.PruneHeight = summary.CloseHeight +
+				c.cfg.PruneWindow
+		}
+
+		channels = append(channels, channel)
+	}
+
+	return channels, nil
+}
+
+// pruneResolvedChannelsOnBlocks prunes the state of resolved channels as of
+// the first block delivered by the given block epoch notifications, which is
+// the current best block, and every pruneInterval blocks after.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *ChainArbitrator) pruneResolvedChannelsOnBlocks(
+	blockEpoch *chainntnfs.BlockEpochEvent) {
+
+	defer c.wg.Done()
+	defer blockEpoch.Cancel()
+
+	var lastPruneHeight uint32
+	for {
+		select {
+		case epoch, ok := <-blockEpoch.Epochs:
+			if !ok {
+				return
+			}
+
+			height := uint32(epoch.Height)
+			if lastPruneHeight != 0 &&
+				height < lastPruneHeight+pruneInterval {
+
+				continue
+			}
+			lastPruneHeight = height
+
+			if err := c.pruneResolvedChannels(height); err != nil {
+				log.Errorf("Unable to prune resolved "+
+					"channels: %v", err)
+			}
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// pruneResolvedChannels removes the state that is still stored for all fully
+// resolved channels that were closed at least PruneWindow blocks before the
+// given height, except for their close summaries and resolver reports. This
+// wipes the forwarding packages of the channels through ChannelPackager.Wipe,
+// along with any arbitrator log left behind, which WipeHistory normally
+// removes on full resolution. Revocation logs aren't pruned here, as they're
+// already removed when the channels are closed.
+func (c *ChainArbitrator) pruneResolvedChannels(height uint32) error {
+	if c.cfg.PruneWindow == 0 {
+		return nil
+	}
+
+	channels, err := c.PrunableChannels()
+	if err != nil {
+		return err
+	}
+
+	var (
+		numPruned int
+		pruned    uint64
+	)
+	for _, channel := range channels {
+		if channel.PruneHeight > height {
+			continue
+		}
+
+		scope, err := newLogScope(c.cfg.ChainHash, channel.ChanPoint)
+		if err != nil {
+			return err
+		}
+
+		// The forwarding packages and the arbitrator log are removed
+		// atomically, so the channel is never left partially pruned.
+		var size uint64
+		err = c.chanSource.Update(func(tx *bbolt.Tx) error {
+			pkgSize, err := channeldb.PruneClosedChannel(
+				tx, channel.summary,
+			)
+			if err != nil {
+				return err
+			}
+			logSize, err := wipeArbitratorLog(tx, *scope)
+			if err != nil {
+				return err
+			}
+
+			size = pkgSize + logSize
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Debugf("Pruned %v bytes of state of resolved "+
+			"ChannelPoint(%v)", size, channel.ChanPoint)
+
+		numPruned++
+		pruned += size
+	}
+
+	if numPruned > 0 {
+		log.Infof("Pruned %v bytes of state of %v resolved channels",
+			pruned, numPruned)
+	}
+
+	return nil
+}
+
+// ResolverReports returns the reports of all outputs of the channel with the
+// given channel point that have been resolved on-chain. The reports remain
+// available once the channel has been fully resolved.
+func (c *ChainArbitrator) ResolverReports(chanPoint wire.OutPoint) (
+	[]*ResolverReport, error) {
+
+	scope, err := newLogScope(c.cfg.ChainHash, chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetchResolverReports(c.chanSource.DB, *scope)
+}
+
 // forceCloseReq is a request sent from an outside sub-system to the arbitrator
 // that watches a particular channel to broadcast the commitment transaction,
 // and enter the resolution phase of the channel.
@@ -1,14 +1,19 @@
 package contractcourt
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
@@ -74,7 +79,7 @@ func TestChainArbitratorRepublishCommitment(t *testing.T) {
 	chainArbCfg := ChainArbitratorConfig{
 		ChainIO:  &mockChainIO{},
 		Notifier: &mockNotifier{},
-		PublishTx: func(tx *wire.MsgTx) error {
+		PublishTx: func(tx *wire.MsgTx, _ string) error {
 			published[tx.TxHash()] = struct{}{}
 			return nil
 		},
@@ -115,3 +120,204 @@ func TestChainArbitratorRepublishCommitment(t *testing.T) {
 		t.Fatalf("unexpected tx published")
 	}
 }
+
+// TestChainArbitratorPruneResolvedChannels tests that the state of a fully
+// resolved channel is pruned once it's past the prune window, while its close
+// summary and resolver reports are kept.
+func TestChainArbitratorPruneResolvedChannels(t *testing.T) {
+	t.Parallel()
+
+	tempPath, err := ioutil.TempDir("", "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempPath)
+
+	db, err := channeldb.Open(tempPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	lChannel, _, cleanup, err := lnwallet.CreateTestChannels(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	channel := lChannel.State()
+	channel.Db = db
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := channel.SyncPending(addr, 101); err != nil {
+		t.Fatal(err)
+	}
+
+	// We'll leave a forwarding package, an arbitrator log and a resolver
+	// report behind for the channel.
+	chanPoint := channel.FundingOutpoint
+	fwdPkg := channeldb.NewFwdPkg(channel.ShortChanID(), 1, nil, nil)
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return channel.Packager.AddFwdPkg(tx, fwdPkg)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arbLog, err := newBoltArbitratorLog(
+		db.DB, ChannelArbitratorConfig{}, chainhash.Hash{}, chanPoint,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := arbLog.CommitState(StateFullyResolved); err != nil {
+		t.Fatal(err)
+	}
+	report := &ResolverReport{
+		OutPoint:        chanPoint,
+		Amount:          1000,
+		ResolverType:    ResolverTypeCommit,
+		ResolverOutcome: ResolverOutcomeClaimed,
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return putResolverReports(
+			tx, arbLog.scopeKey[:], []*ResolverReport{report},
+		)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Now we'll close the channel at height 100, and mark it fully
+	// resolved.
+	const closeHeight = 100
+	err = channel.CloseChannel(&channeldb.ChannelCloseSummary{
+		ChanPoint:   chanPoint,
+		ShortChanID: channel.ShortChanID(),
+		RemotePub:   channel.IdentityPub,
+		CloseHeight: closeHeight,
+		IsPending:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.MarkChanFullyClosed(&chanPoint); err != nil {
+		t.Fatal(err)
+	}
+
+	const pruneWindow = 10
+	chainArb := NewChainArbitrator(ChainArbitratorConfig{
+		PruneWindow: pruneWindow,
+	}, db)
+
+	channels, err := chainArb.PrunableChannels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected 1 prunable channel, got %v", len(channels))
+	}
+	if channels[0].ChanPoint != chanPoint {
+		t.Fatalf("expected channel %v, got %v", chanPoint,
+			channels[0].ChanPoint)
+	}
+	if channels[0].PruneHeight != closeHeight+pruneWindow {
+		t.Fatalf("expected prune height %v, got %v",
+			closeHeight+pruneWindow, channels[0].PruneHeight)
+	}
+	if channels[0].Size == 0 {
+		t.Fatalf("expected non-zero size")
+	}
+
+	// Pruning is driven by blocks. The first block is the current best
+	// block, which is before the prune height, so nothing should be
+	// pruned.
+	epochs := make(chan *chainntnfs.BlockEpoch)
+	blockEpoch := &chainntnfs.BlockEpochEvent{
+		Epochs: epochs,
+		Cancel: func() {},
+	}
+	chainArb.wg.Add(1)
+	go chainArb.pruneResolvedChannelsOnBlocks(blockEpoch)
+	defer func() {
+		close(chainArb.quit)
+		chainArb.wg.Wait()
+	}()
+
+	sendBlock := func(height uint32) {
+		t.Helper()
+
+		select {
+		case epochs <- &chainntnfs.BlockEpoch{
+			Height: int32(height),
+		}:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("block %v not received", height)
+		}
+	}
+	assertPrunable := func(expected int) {
+		t.Helper()
+
+		channels, err := chainArb.PrunableChannels()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(channels) != expected {
+			t.Fatalf("expected %v prunable channels, got %v",
+				expected, len(channels))
+		}
+	}
+
+	firstHeight := uint32(closeHeight + pruneWindow - 1)
+	sendBlock(firstHeight)
+
+	// The prune height is reached with the next block, but pruning only
+	// runs again once pruneInterval blocks have passed. As the next block
+	// is only received once the previous one has been handled, the state
+	// must still be around.
+	sendBlock(closeHeight + pruneWindow)
+	sendBlock(closeHeight + pruneWindow + 1)
+	assertPrunable(1)
+
+	// Once the interval has passed, the state of the channel should be
+	// pruned.
+	sendBlock(firstHeight + pruneInterval)
+	err = wait.NoError(func() error {
+		channels, err := chainArb.PrunableChannels()
+		if err != nil {
+			return err
+		}
+		if len(channels) != 0 {
+			return fmt.Errorf("expected no prunable channels, "+
+				"got %v", len(channels))
+		}
+
+		return nil
+	}, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fwdPkgs, err := lChannel.LoadFwdPkgs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fwdPkgs) != 0 {
+		t.Fatalf("expected no fwd pkgs, got %v", len(fwdPkgs))
+	}
+
+	// The close summary and resolver report should still be around.
+	if _, err := db.FetchClosedChannel(&chanPoint); err != nil {
+		t.Fatal(err)
+	}
+	reports, err := arbLog.FetchResolverReports()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 resolver report, got %v", len(reports))
+	}
+}
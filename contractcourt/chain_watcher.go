@@ -900,6 +900,7 @@ func (c *chainWatcher) dispatchContractBreach(spendEvent *chainntnfs.SpendDetail
 	// TODO(roasbeef): move to same package
 	retribution, err := lnwallet.NewBreachRetribution(
 		c.cfg.chanState, broadcastStateNum, spendHeight,
+		spendEvent.SpendingTx,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to create breach retribution: %v", err)
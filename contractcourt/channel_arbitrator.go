@@ -12,6 +12,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -126,16 +127,38 @@ type ChannelArbitratorConfig struct {
 	// TODO(roasbeef): need RPC's to combine for pendingchannels RPC
 	MarkChannelResolved func() error
 
+	// DeadlinePolicy is the policy that decides when to go to chain for
+	// the HTLCs of the channel, as it was stored when the arbitrator was
+	// created.
+	DeadlinePolicy DeadlinePolicy
+
 	ChainArbitratorConfig
 }
 
+// ReportOutputType describes the type of output that is being reported on.
+type ReportOutputType uint8
+
+const (
+	// ReportOutputIncomingHtlc is an incoming hash time locked contract on
+	// the commitment tx.
+	ReportOutputIncomingHtlc ReportOutputType = iota
+
+	// ReportOutputOutgoingHtlc is an outgoing hash time locked contract on
+	// the commitment tx.
+	ReportOutputOutgoingHtlc
+
+	// ReportOutputUnencumbered is an uncontested output on the commitment
+	// transaction paying to us directly.
+	ReportOutputUnencumbered
+)
+
 // ContractReport provides a summary of a commitment tx output.
 type ContractReport struct {
 	// Outpoint is the final output that will be swept back to the wallet.
 	Outpoint wire.OutPoint
 
-	// Incoming indicates whether the htlc was incoming to this channel.
-	Incoming bool
+	// Type indicates the type of the reported output.
+	Type ReportOutputType
 
 	// Amount is the final value that will be swept in back to the wallet.
 	Amount btcutil.Amount
@@ -250,6 +273,11 @@ type ChannelArbitrator struct {
 	// currently valid commitment transactions.
 	activeHTLCs map[HtlcSetKey]htlcSet
 
+	// writtenOff caches the HTLCs that have been written off, so the
+	// resolver reports don't need to be read on every block. It's loaded
+	// from the log on first use, and only accessed by the state machine.
+	writtenOff map[WrittenOffHtlc]struct{}
+
 	// cfg contains all the functionality that the ChannelArbitrator requires
 	// to do its duty.
 	cfg ChannelArbitratorConfig
@@ -284,6 +312,12 @@ type ChannelArbitrator struct {
 	// upon start up to decide which actions to take.
 	state ArbitratorState
 
+	// deadlinePolicy is the policy that decides when to go to chain for
+	// the HTLCs of the channel. It can be updated while the arbitrator is
+	// running, so access is guarded by policyMtx.
+	deadlinePolicy DeadlinePolicy
+	policyMtx      sync.RWMutex
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -301,10 +335,29 @@ func NewChannelArbitrator(cfg ChannelArbitratorConfig,
 		forceCloseReqs:   make(chan *forceCloseReq),
 		activeHTLCs:      htlcSets,
 		cfg:              cfg,
+		deadlinePolicy:   cfg.DeadlinePolicy,
 		quit:             make(chan struct{}),
 	}
 }
 
+// UpdateDeadlinePolicy replaces the policy that decides when to go to chain
+// for the HTLCs of the channel. It takes effect as of the next block.
+func (c *ChannelArbitrator) UpdateDeadlinePolicy(policy DeadlinePolicy) {
+	c.policyMtx.Lock()
+	c.deadlinePolicy = policy
+	c.policyMtx.Unlock()
+}
+
+// DeadlinePolicy returns the policy that decides when to go to chain for the
+// HTLCs of the channel, with the global broadcast deltas filled in for the
+// ones that aren't set.
+func (c *ChannelArbitrator) DeadlinePolicy() DeadlinePolicy {
+	c.policyMtx.RLock()
+	defer c.policyMtx.RUnlock()
+
+	return c.deadlinePolicy.withDefaults(&c.cfg.ChainArbitratorConfig)
+}
+
 // Start starts all the goroutines that the ChannelArbitrator needs to operate.
 func (c *ChannelArbitrator) Start() error {
 	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
@@ -594,19 +647,25 @@ func (c *ChannelArbitrator) supplementTimeoutResolver(r *htlcTimeoutResolver,
 	return nil
 }
 
-// Report returns htlc reports for the active resolvers.
+// Report returns the reports of the active resolvers. Resolvers that have
+// already swept their output remain part of the report, so the recovered
+// balance of the channel can be accounted for.
 func (c *ChannelArbitrator) Report() []*ContractReport {
 	c.activeResolversLock.RLock()
 	defer c.activeResolversLock.RUnlock()
 
 	var reports []*ContractReport
 	for _, resolver := range c.activeResolvers {
-		r, ok := resolver.(reportingContractResolver)
-		if !ok {
+		// Resolvers aren't relaunched once they're resolved, so we'll
+		// leave them out consistently, rather than only until the next
+		// restart. The balance they recovered is reported by
+		// RecoveredBalance instead.
+		if resolver.IsResolved() {
 			continue
 		}
 
-		if r.IsResolved() {
+		r, ok := resolver.(reportingContractResolver)
+		if !ok {
 			continue
 		}
 
@@ -621,6 +680,26 @@ func (c *ChannelArbitrator) Report() []*ContractReport {
 	return reports
 }
 
+// RecoveredBalance returns the total value of the outputs of the channel that
+// have been swept back to our wallet so far. As it's derived from the
+// persisted resolver reports, it includes the outputs of resolvers that were
+// resolved before a restart.
+func (c *ChannelArbitrator) RecoveredBalance() (btcutil.Amount, error) {
+	reports, err := c.log.FetchResolverReports()
+	if err != nil {
+		return 0, err
+	}
+
+	var recovered btcutil.Amount
+	for _, report := range reports {
+		if report.recovered() {
+			recovered += report.Amount
+		}
+	}
+
+	return recovered, nil
+}
+
 // Stop signals the ChannelArbitrator for a graceful shutdown.
 func (c *ChannelArbitrator) Stop() error {
 	if !atomic.CompareAndSwapInt32(&c.stopped, 0, 1) {
@@ -740,6 +819,17 @@ func (c *ChannelArbitrator) stateStep(
 		} else {
 			htlcs = c.activeHTLCs
 		}
+
+		// Before deciding whether to go to chain, we'll write off the
+		// HTLCs that are about to expire, but aren't worth going to
+		// chain for.
+		if trigger == chainTrigger {
+			err := c.writeOffHtlcs(triggerHeight, htlcs)
+			if err != nil {
+				return StateDefault, nil, err
+			}
+		}
+
 		chainActions, err := c.checkLocalChainActions(
 			triggerHeight, trigger, htlcs, false,
 		)
@@ -865,7 +955,10 @@ func (c *ChannelArbitrator) stateStep(
 
 		// At this point, we'll now broadcast the commitment
 		// transaction itself.
-		if err := c.cfg.PublishTx(closeTx); err != nil {
+		label := labels.MakeLabel(
+			labels.LabelTypeChannelClose, &c.cfg.ShortChanID,
+		)
+		if err := c.cfg.PublishTx(closeTx, label); err != nil {
 			log.Errorf("ChannelArbitrator(%v): unable to broadcast "+
 				"close tx: %v", c.cfg.ChanPoint, err)
 			if err != lnwallet.ErrDoubleSpend {
@@ -931,27 +1024,6 @@ func (c *ChannelArbitrator) stateStep(
 			break
 		}
 
-		// If we've have broadcast the commitment transaction, we send
-		// our commitment output for incubation, but only if it wasn't
-		// trimmed.  We'll need to wait for a CSV timeout before we can
-		// reclaim the funds.
-		commitRes := contractResolutions.CommitResolution
-		if commitRes != nil && commitRes.MaturityDelay > 0 {
-			log.Infof("ChannelArbitrator(%v): sending commit "+
-				"output for incubation", c.cfg.ChanPoint)
-
-			err = c.cfg.IncubateOutputs(
-				c.cfg.ChanPoint, commitRes,
-				nil, nil, triggerHeight,
-			)
-			if err != nil {
-				// TODO(roasbeef): check for AlreadyExists errors
-				log.Errorf("unable to incubate commitment "+
-					"output: %v", err)
-				return StateError, closeTx, err
-			}
-		}
-
 		// Now that we know we'll need to act, we'll process the htlc
 		// actions, wen create the structures we need to resolve all
 		// outstanding contracts.
@@ -986,7 +1058,7 @@ func (c *ChannelArbitrator) stateStep(
 		log.Debugf("ChannelArbitrator(%v): inserting %v contract "+
 			"resolvers", c.cfg.ChanPoint, len(htlcResolvers))
 
-		err = c.log.InsertUnresolvedContracts(htlcResolvers...)
+		err = c.log.InsertUnresolvedContracts(nil, htlcResolvers...)
 		if err != nil {
 			return StateError, closeTx, err
 		}
@@ -1046,6 +1118,12 @@ func (c *ChannelArbitrator) launchResolvers(resolvers []ContractResolver) {
 
 	c.activeResolvers = resolvers
 	for _, contract := range resolvers {
+		// Set up the initial report of the resolver before launching
+		// it, so it is visible right away.
+		if r, ok := contract.(reportingContractResolver); ok {
+			r.initReport()
+		}
+
 		c.wg.Add(1)
 		go c.resolveContract(contract)
 	}
@@ -1210,6 +1288,134 @@ func (c *ChannelArbitrator) shouldGoOnChain(htlcExpiry, broadcastDelta,
 	return currentHeight >= broadcastCutOff
 }
 
+// writeOffHtlcs examines the active HTLCs for those that are about to expire,
+// but are of lower value than the minimum value worth going to chain for of
+// the channel. Rather than force closing the channel, outgoing HTLCs are
+// failed back right away, and incoming HTLCs we know the preimage of are given
+// up on. Each decision is recorded in the resolver reports, so it's only made
+// once per HTLC.
+func (c *ChannelArbitrator) writeOffHtlcs(height uint32,
+	activeHTLCs map[HtlcSetKey]htlcSet) error {
+
+	policy := c.DeadlinePolicy()
+	if policy.MinChainHtlcValue == 0 {
+		return nil
+	}
+
+	if c.writtenOff == nil {
+		reports, err := c.log.FetchResolverReports()
+		if err != nil {
+			return err
+		}
+
+		c.writtenOff = make(map[WrittenOffHtlc]struct{})
+		for _, report := range reports {
+			if report.WrittenOffHtlc != nil {
+				c.writtenOff[*report.WrittenOffHtlc] = struct{}{}
+			}
+		}
+	}
+
+	var (
+		newReports []*ResolverReport
+		msgsToSend []ResolutionMsg
+	)
+
+	// writeOff adds a report for the given HTLC, and returns false if it
+	// was already written off before.
+	writtenOff := make(map[WrittenOffHtlc]struct{})
+	writeOff := func(htlc channeldb.HTLC) bool {
+		report := newWrittenOffReport(&htlc)
+		if _, ok := c.writtenOff[*report.WrittenOffHtlc]; ok {
+			return false
+		}
+		if _, ok := writtenOff[*report.WrittenOffHtlc]; ok {
+			return false
+		}
+		writtenOff[*report.WrittenOffHtlc] = struct{}{}
+
+		log.Infof("ChannelArbitrator(%v): writing off %v htlc %x of "+
+			"%v below min_chain_htlc_value=%v: timeout=%v, "+
+			"height=%v", c.cfg.ChanPoint, report.ResolverType,
+			htlc.RHash[:], report.Amount, policy.MinChainHtlcValue,
+			htlc.RefundTimeout, height)
+
+		newReports = append(newReports, report)
+		return true
+	}
+
+	// The same HTLC may be present on several commitments, but it's only
+	// written off once.
+	for _, htlcs := range activeHTLCs {
+		for _, htlc := range htlcs.outgoingHTLCs {
+			if policy.worthChain(&htlc) || !c.shouldGoOnChain(
+				htlc.RefundTimeout,
+				policy.OutgoingBroadcastDelta, height,
+			) {
+				continue
+			}
+
+			if !writeOff(htlc) {
+				continue
+			}
+
+			msgsToSend = append(msgsToSend, ResolutionMsg{
+				SourceChan: c.cfg.ShortChanID,
+				HtlcIndex:  htlc.HtlcIndex,
+				Failure:    &lnwire.FailPermanentChannelFailure{},
+			})
+		}
+
+		for _, htlc := range htlcs.incomingHTLCs {
+			if policy.worthChain(&htlc) || !c.shouldGoOnChain(
+				htlc.RefundTimeout,
+				policy.IncomingBroadcastDelta, height,
+			) {
+				continue
+			}
+
+			// Incoming HTLCs we don't know the preimage of don't
+			// make us go to chain in the first place.
+			preimageAvailable, err := c.isPreimageAvailable(
+				htlc.RHash,
+			)
+			if err != nil {
+				return err
+			}
+			if !preimageAvailable {
+				continue
+			}
+
+			writeOff(htlc)
+		}
+	}
+
+	if len(newReports) == 0 {
+		return nil
+	}
+
+	// We'll fail back the outgoing HTLCs before recording the decision,
+	// as failing back twice after a restart is harmless, while not
+	// failing back at all would make the incoming channel go to chain.
+	if len(msgsToSend) > 0 {
+		if err := c.cfg.DeliverResolutionMsg(msgsToSend...); err != nil {
+			return err
+		}
+	}
+
+	if err := c.log.LogResolverReports(newReports...); err != nil {
+		return err
+	}
+
+	// Only once the decisions are recorded, we'll add them to the cache,
+	// so they're retried if recording them failed.
+	for htlc := range writtenOff {
+		c.writtenOff[htlc] = struct{}{}
+	}
+
+	return nil
+}
+
 // checkCommitChainActions is called for each new block connected to the end of
 // the main chain. Given the new block height, this new method will examine all
 // active HTLC's, and determine if we need to go on-chain to claim any of them.
@@ -1228,15 +1434,22 @@ func (c *ChannelArbitrator) checkCommitChainActions(height uint32,
 		"height=%v", c.cfg.ChanPoint, height)
 
 	actionMap := make(ChainActionMap)
+	policy := c.DeadlinePolicy()
 
 	// First, we'll make an initial pass over the set of incoming and
-	// outgoing HTLC's to decide if we need to go on chain at all.
+	// outgoing HTLC's to decide if we need to go on chain at all. HTLCs
+	// that aren't worth going to chain for are written off instead, so
+	// they're skipped here.
 	haveChainActions := false
 	for _, htlc := range htlcs.outgoingHTLCs {
+		if !policy.worthChain(&htlc) {
+			continue
+		}
+
 		// We'll need to go on-chain for an outgoing HTLC if it was
 		// never resolved downstream, and it's "close" to timing out.
 		toChain := c.shouldGoOnChain(
-			htlc.RefundTimeout, c.cfg.OutgoingBroadcastDelta,
+			htlc.RefundTimeout, policy.OutgoingBroadcastDelta,
 			height,
 		)
 
@@ -1246,7 +1459,7 @@ func (c *ChannelArbitrator) checkCommitChainActions(height uint32,
 				"blocks_until_expiry=%v, broadcast_delta=%v",
 				c.cfg.ChanPoint, htlc.RHash[:],
 				htlc.RefundTimeout, htlc.RefundTimeout-height,
-				c.cfg.OutgoingBroadcastDelta,
+				policy.OutgoingBroadcastDelta,
 			)
 		}
 
@@ -1258,6 +1471,10 @@ func (c *ChannelArbitrator) checkCommitChainActions(height uint32,
 		// know the pre-image and it's close to timing out. We need to
 		// ensure that we claim the funds that our rightfully ours
 		// on-chain.
+		if !policy.worthChain(&htlc) {
+			continue
+		}
+
 		preimageAvailable, err := c.isPreimageAvailable(htlc.RHash)
 		if err != nil {
 			return nil, err
@@ -1268,7 +1485,7 @@ func (c *ChannelArbitrator) checkCommitChainActions(height uint32,
 		}
 
 		toChain := c.shouldGoOnChain(
-			htlc.RefundTimeout, c.cfg.IncomingBroadcastDelta,
+			htlc.RefundTimeout, policy.IncomingBroadcastDelta,
 			height,
 		)
 
@@ -1278,7 +1495,7 @@ func (c *ChannelArbitrator) checkCommitChainActions(height uint32,
 				"blocks_until_expiry=%v, broadcast_delta=%v",
 				c.cfg.ChanPoint, htlc.RHash[:],
 				htlc.RefundTimeout, htlc.RefundTimeout-height,
-				c.cfg.IncomingBroadcastDelta,
+				policy.IncomingBroadcastDelta,
 			)
 		}
 
@@ -1319,7 +1536,7 @@ func (c *ChannelArbitrator) checkCommitChainActions(height uint32,
 		// until the HTLC times out to see if we can also redeem it
 		// on-chain.
 		case !c.shouldGoOnChain(
-			htlc.RefundTimeout, c.cfg.OutgoingBroadcastDelta,
+			htlc.RefundTimeout, policy.OutgoingBroadcastDelta,
 			height,
 		):
 			// TODO(roasbeef): also need to be able to query
@@ -1485,11 +1702,13 @@ func (c *ChannelArbitrator) checkRemoteDanglingActions(
 	// Finally, we'll examine all the pending remote HTLCs for those that
 	// have expired. If we find any, then we'll recommend that they be
 	// failed now so we can free up the incoming HTLC.
+	policy := c.DeadlinePolicy()
 	for _, htlc := range pendingRemoteHTLCs {
 		// We'll now check if we need to go to chain in order to cancel
-		// the incoming HTLC.
-		goToChain := c.shouldGoOnChain(
-			htlc.RefundTimeout, c.cfg.OutgoingBroadcastDelta,
+		// the incoming HTLC. HTLCs that aren't worth going to chain
+		// for are written off instead.
+		goToChain := policy.worthChain(&htlc) && c.shouldGoOnChain(
+			htlc.RefundTimeout, policy.OutgoingBroadcastDelta,
 			height,
 		)
 
@@ -1687,8 +1906,10 @@ func (c *ChannelArbitrator) prepContractResolutions(
 	// resolver so they each can do their duty.
 	resKit := ResolverKit{
 		ChannelArbitratorConfig: c.cfg,
-		Checkpoint: func(res ContractResolver) error {
-			return c.log.InsertUnresolvedContracts(res)
+		Checkpoint: func(res ContractResolver,
+			reports ...*ResolverReport) error {
+
+			return c.log.InsertUnresolvedContracts(reports, res)
 		},
 	}
 
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
@@ -29,6 +30,8 @@ type mockArbitratorLog struct {
 	failCommitState ArbitratorState
 	resolutions     *ContractResolutions
 	resolvers       map[ContractResolver]struct{}
+	reports         []*ResolverReport
+	reportFetches   int
 
 	commitSet *CommitSet
 
@@ -69,12 +72,13 @@ func (b *mockArbitratorLog) FetchUnresolvedContracts() ([]ContractResolver,
 }
 
 func (b *mockArbitratorLog) InsertUnresolvedContracts(
-	resolvers ...ContractResolver) error {
+	reports []*ResolverReport, resolvers ...ContractResolver) error {
 
 	b.Lock()
 	for _, resolver := range resolvers {
 		b.resolvers[resolver] = struct{}{}
 	}
+	b.reports = append(b.reports, reports...)
 	b.Unlock()
 	return nil
 }
@@ -114,6 +118,24 @@ func (b *mockArbitratorLog) FetchContractResolutions() (*ContractResolutions, er
 	return b.resolutions, nil
 }
 
+func (b *mockArbitratorLog) FetchResolverReports() ([]*ResolverReport, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.reportFetches++
+	return b.reports, nil
+}
+
+func (b *mockArbitratorLog) LogResolverReports(
+	reports ...*ResolverReport) error {
+
+	b.Lock()
+	b.reports = append(b.reports, reports...)
+	b.Unlock()
+
+	return nil
+}
+
 func (b *mockArbitratorLog) FetchChainActions() (ChainActionMap, error) {
 	return nil, nil
 }
@@ -182,7 +204,7 @@ type chanArbTestCtx struct {
 
 	blockEpochs chan *chainntnfs.BlockEpoch
 
-	incubationRequests chan struct{}
+	sweeper *mockSweeper
 
 	resolutions chan []ResolutionMsg
 
@@ -282,12 +304,12 @@ func createTestChannelArbitrator(t *testing.T, log ArbitratorLog) (*chanArbTestC
 	}
 
 	resolutionChan := make(chan []ResolutionMsg, 1)
-	incubateChan := make(chan struct{})
+	sweeper := newMockSweeper()
 
 	chainIO := &mockChainIO{}
 	chainArbCfg := ChainArbitratorConfig{
 		ChainIO: chainIO,
-		PublishTx: func(*wire.MsgTx) error {
+		PublishTx: func(*wire.MsgTx, string) error {
 			return nil
 		},
 		DeliverResolutionMsg: func(msgs ...ResolutionMsg) error {
@@ -301,13 +323,7 @@ func createTestChannelArbitrator(t *testing.T, log ArbitratorLog) (*chanArbTestC
 			spendChan: make(chan *chainntnfs.SpendDetail),
 			confChan:  make(chan *chainntnfs.TxConfirmation),
 		},
-		IncubateOutputs: func(wire.OutPoint, *lnwallet.CommitOutputResolution,
-			*lnwallet.OutgoingHtlcResolution,
-			*lnwallet.IncomingHtlcResolution, uint32) error {
-
-			incubateChan <- struct{}{}
-			return nil
-		},
+		Sweeper: sweeper,
 	}
 
 	// We'll use the resolvedChan to synchronize on call to
@@ -376,14 +392,14 @@ func createTestChannelArbitrator(t *testing.T, log ArbitratorLog) (*chanArbTestC
 	chanArb := NewChannelArbitrator(arbCfg, htlcSets, log)
 
 	return &chanArbTestCtx{
-		t:                  t,
-		chanArb:            chanArb,
-		cleanUp:            cleanUp,
-		resolvedChan:       resolvedChan,
-		resolutions:        resolutionChan,
-		blockEpochs:        blockEpochs,
-		log:                log,
-		incubationRequests: incubateChan,
+		t:            t,
+		chanArb:      chanArb,
+		cleanUp:      cleanUp,
+		resolvedChan: resolvedChan,
+		resolutions:  resolutionChan,
+		blockEpochs:  blockEpochs,
+		log:          log,
+		sweeper:      sweeper,
 	}, nil
 }
 
@@ -527,7 +543,7 @@ func TestChannelArbitratorLocalForceClose(t *testing.T) {
 	// We create a channel we can use to pause the ChannelArbitrator at the
 	// point where it broadcasts the close tx, and check its state.
 	stateChan := make(chan ArbitratorState)
-	chanArb.cfg.PublishTx = func(*wire.MsgTx) error {
+	chanArb.cfg.PublishTx = func(*wire.MsgTx, string) error {
 		// When the force close tx is being broadcasted, check that the
 		// state is correct at that point.
 		select {
@@ -865,10 +881,10 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	}
 
 	// htlcOutgoingContestResolver is now active and waiting for the HTLC to
-	// expire. It should not yet have passed it on for incubation.
+	// expire. It should not yet have offered anything to the sweeper.
 	select {
-	case <-chanArbCtx.incubationRequests:
-		t.Fatalf("contract should not be incubated yet")
+	case <-chanArbCtx.sweeper.sweptInputs:
+		t.Fatalf("contract should not be swept yet")
 	default:
 	}
 
@@ -876,12 +892,9 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	oldNotifier.epochChan <- &chainntnfs.BlockEpoch{Height: 10}
 
 	// htlcOutgoingContestResolver is now transforming into a
-	// htlcTimeoutResolver and should send the contract off for incubation.
-	select {
-	case <-chanArbCtx.incubationRequests:
-	case <-time.After(5 * time.Second):
-		t.Fatalf("no response received")
-	}
+	// htlcTimeoutResolver, which will publish the second-level timeout
+	// transaction once it learns about the expiry height as well.
+	oldNotifier.epochChan <- &chainntnfs.BlockEpoch{Height: 10}
 
 	// Notify resolver that the HTLC output of the commitment has been
 	// spent.
@@ -903,18 +916,19 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 		t.Fatalf("resolution msgs not sent")
 	}
 
-	// As this is our own commitment transaction, the HTLC will go through
-	// to the second level. Channel arbitrator should still not be marked
-	// as resolved.
+	// As this is our own commitment transaction, the HTLC went through to
+	// the second level, and its output should now be offered to the
+	// sweeper.
 	select {
-	case <-chanArbCtxNew.resolvedChan:
-		t.Fatalf("channel resolved prematurely")
-	default:
+	case inp := <-chanArbCtx.sweeper.sweptInputs:
+		if *inp.OutPoint() != outgoingRes.ClaimOutpoint {
+			t.Fatalf("wrong input swept: expected %v, got %v",
+				outgoingRes.ClaimOutpoint, inp.OutPoint())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("second-level output not swept")
 	}
 
-	// Notify resolver that the second level transaction is spent.
-	oldNotifier.spendChan <- &chainntnfs.SpendDetail{SpendingTx: closeTx}
-
 	// At this point channel should be marked as resolved.
 	chanArbCtxNew.AssertStateTransitions(StateFullyResolved)
 	select {
@@ -950,7 +964,7 @@ func TestChannelArbitratorLocalForceCloseRemoteConfirmed(t *testing.T) {
 	// Create a channel we can use to assert the state when it publishes
 	// the close tx.
 	stateChan := make(chan ArbitratorState)
-	chanArb.cfg.PublishTx = func(*wire.MsgTx) error {
+	chanArb.cfg.PublishTx = func(*wire.MsgTx, string) error {
 		// When the force close tx is being broadcasted, check that the
 		// state is correct at that point.
 		select {
@@ -1058,7 +1072,7 @@ func TestChannelArbitratorLocalForceDoubleSpend(t *testing.T) {
 
 	// Return ErrDoubleSpend when attempting to publish the tx.
 	stateChan := make(chan ArbitratorState)
-	chanArb.cfg.PublishTx = func(*wire.MsgTx) error {
+	chanArb.cfg.PublishTx = func(*wire.MsgTx, string) error {
 		// When the force close tx is being broadcasted, check that the
 		// state is correct at that point.
 		select {
@@ -1289,7 +1303,7 @@ func TestChannelArbitratorForceCloseBreachedChannel(t *testing.T) {
 	// unexpected publication error, causing the state machine to halt.
 	expErr := errors.New("intentional publication error")
 	stateChan := make(chan ArbitratorState)
-	chanArb.cfg.PublishTx = func(*wire.MsgTx) error {
+	chanArb.cfg.PublishTx = func(*wire.MsgTx, string) error {
 		// When the force close tx is being broadcasted, check that the
 		// state is correct at that point.
 		select {
@@ -1810,3 +1824,209 @@ func TestChannelArbitratorDanglingCommitForceClose(t *testing.T) {
 		})
 	}
 }
+
+// TestChannelArbitratorWriteOffHtlcs tests that an outgoing HTLC below the
+// min chain HTLC value of the channel's deadline policy is failed back and
+// written off instead of causing the channel to go to chain, while an HTLC
+// above it still forces the channel closed.
+func TestChannelArbitratorWriteOffHtlcs(t *testing.T) {
+	t.Parallel()
+
+	arbLog := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+		resolvers: make(map[ContractResolver]struct{}),
+	}
+
+	chanArbCtx, err := createTestChannelArbitrator(t, arbLog)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	chanArb := chanArbCtx.chanArb
+	chanArb.UpdateDeadlinePolicy(DeadlinePolicy{
+		MinChainHtlcValue: 1000,
+	})
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer chanArb.Stop()
+
+	htlcUpdates := make(chan *ContractUpdate)
+	signals := &ContractSignals{
+		HtlcUpdates: htlcUpdates,
+		ShortChanID: lnwire.ShortChannelID{},
+	}
+	chanArb.UpdateContractSignals(signals)
+
+	// We'll add an outgoing HTLC worth 10 satoshis to our commitment,
+	// which expires in 10 blocks.
+	smallHTLC := channeldb.HTLC{
+		Incoming:      false,
+		Amt:           10000,
+		HtlcIndex:     99,
+		RefundTimeout: 10,
+	}
+	htlcUpdates <- &ContractUpdate{
+		HtlcKey: LocalHtlcSet,
+		Htlcs:   []channeldb.HTLC{smallHTLC},
+	}
+
+	// Mining a block at height 5 brings the HTLC within our outgoing
+	// broadcast delta. Rather than going to chain, the arbitrator should
+	// fail the HTLC back.
+	chanArbCtx.blockEpochs <- &chainntnfs.BlockEpoch{Height: 5}
+
+	select {
+	case msgs := <-chanArbCtx.resolutions:
+		if len(msgs) != 1 {
+			t.Fatalf("expected 1 message, instead got %v", len(msgs))
+		}
+		if msgs[0].HtlcIndex != smallHTLC.HtlcIndex {
+			t.Fatalf("wrong htlc index: expected %v, got %v",
+				smallHTLC.HtlcIndex, msgs[0].HtlcIndex)
+		}
+		if msgs[0].Failure == nil {
+			t.Fatalf("expected htlc to be failed back")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("resolution msgs not sent")
+	}
+
+	select {
+	case state := <-arbLog.newStates:
+		t.Fatalf("unexpected state transition to %v", state)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// The HTLC should be reported as written off.
+	reports, err := arbLog.FetchResolverReports()
+	if err != nil {
+		t.Fatalf("unable to fetch reports: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report, got %v", len(reports))
+	}
+	if reports[0].ResolverOutcome != ResolverOutcomeWrittenOff {
+		t.Fatalf("expected outcome %v, got %v",
+			ResolverOutcomeWrittenOff, reports[0].ResolverOutcome)
+	}
+	if reports[0].Amount != 10 {
+		t.Fatalf("expected amount 10, got %v", reports[0].Amount)
+	}
+	expectedHtlc := &WrittenOffHtlc{
+		PaymentHash: smallHTLC.RHash,
+		HtlcIndex:   smallHTLC.HtlcIndex,
+	}
+	if !reflect.DeepEqual(reports[0].WrittenOffHtlc, expectedHtlc) {
+		t.Fatalf("expected written off htlc %v, got %v", expectedHtlc,
+			reports[0].WrittenOffHtlc)
+	}
+	if reports[0].OutPoint != (wire.OutPoint{}) {
+		t.Fatalf("expected no outpoint, got %v", reports[0].OutPoint)
+	}
+
+	// The next block shouldn't fail the HTLC back a second time.
+	chanArbCtx.blockEpochs <- &chainntnfs.BlockEpoch{Height: 6}
+
+	select {
+	case <-chanArbCtx.resolutions:
+		t.Fatalf("htlc failed back twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// The written off HTLCs are cached, so the reports should only have
+	// been read from the log once by the arbitrator, besides our own read
+	// above.
+	arbLog.Lock()
+	reportFetches := arbLog.reportFetches
+	arbLog.Unlock()
+	if reportFetches != 2 {
+		t.Fatalf("expected reports to be fetched once by the "+
+			"arbitrator, got %v fetches", reportFetches-1)
+	}
+
+	// Now we'll add an HTLC above the min chain HTLC value. Once it's
+	// within our broadcast delta, the channel should go to chain.
+	largeHTLC := channeldb.HTLC{
+		Incoming:      false,
+		Amt:           10000000,
+		HtlcIndex:     100,
+		RefundTimeout: 20,
+	}
+	htlcUpdates <- &ContractUpdate{
+		HtlcKey: LocalHtlcSet,
+		Htlcs:   []channeldb.HTLC{smallHTLC, largeHTLC},
+	}
+	chanArbCtx.blockEpochs <- &chainntnfs.BlockEpoch{Height: 15}
+
+	chanArbCtx.AssertStateTransitions(
+		StateBroadcastCommit, StateCommitmentBroadcasted,
+	)
+}
+
+// TestChannelArbitratorRecoveredBalance asserts that resolved resolvers are
+// left out of the report of a channel arbitrator, while the balance they
+// recovered is derived from the persisted resolver reports.
+func TestChannelArbitratorRecoveredBalance(t *testing.T) {
+	arbLog := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+		resolvers: make(map[ContractResolver]struct{}),
+		reports: []*ResolverReport{
+			{
+				Amount:          1000,
+				ResolverType:    ResolverTypeCommit,
+				ResolverOutcome: ResolverOutcomeClaimed,
+			},
+			{
+				Amount:          200,
+				ResolverType:    ResolverTypeOutgoingHtlc,
+				ResolverOutcome: ResolverOutcomeTimeout,
+			},
+			{
+				Amount:          300,
+				ResolverType:    ResolverTypeOutgoingHtlc,
+				ResolverOutcome: ResolverOutcomeClaimed,
+			},
+			{
+				Amount:          50,
+				ResolverType:    ResolverTypeIncomingHtlc,
+				ResolverOutcome: ResolverOutcomeAbandoned,
+			},
+		},
+	}
+
+	chanArbCtx, err := createTestChannelArbitrator(t, arbLog)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	chanArb := chanArbCtx.chanArb
+
+	// Only the unresolved resolver should be reported.
+	unresolved := &commitSweepResolver{
+		currentReport: ContractReport{LimboBalance: 500},
+	}
+	chanArb.activeResolvers = []ContractResolver{
+		&commitSweepResolver{resolved: true},
+		unresolved,
+	}
+
+	reports := chanArb.Report()
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report, got %v", len(reports))
+	}
+	if reports[0].LimboBalance != 500 {
+		t.Fatalf("expected limbo balance 500, got %v",
+			reports[0].LimboBalance)
+	}
+
+	// Only our claimed commitment output and the timed out outgoing HTLC
+	// have been recovered.
+	recovered, err := chanArb.RecoveredBalance()
+	if err != nil {
+		t.Fatalf("unable to fetch recovered balance: %v", err)
+	}
+	if recovered != 1200 {
+		t.Fatalf("expected recovered balance 1200, got %v", recovered)
+	}
+}
@@ -3,23 +3,21 @@ package contractcourt
 import (
 	"encoding/binary"
 	"io"
+	"sync"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lnwallet"
-	"github.com/lightningnetwork/lnd/sweep"
-)
-
-const (
-	// commitOutputConfTarget is the default confirmation target we'll use
-	// for sweeps of commit outputs that belong to us.
-	commitOutputConfTarget = 6
 )
 
 // commitSweepResolver is a resolver that will attempt to sweep the commitment
-// output paying to us, in the case that the remote party broadcasts their
-// version of the commitment transaction. We can sweep this output immediately,
-// as it doesn't have a time-lock delay.
+// output paying to us. In the case that the remote party broadcasts their
+// version of the commitment transaction, we can sweep this output immediately,
+// as it doesn't have a time-lock delay. If it's our own commitment
+// transaction, we'll wait for the CSV delay to expire before offering the
+// output to the sweeper.
 type commitSweepResolver struct {
 	// commitResolution contains all data required to successfully sweep
 	// this HTLC on-chain.
@@ -36,6 +34,13 @@ type commitSweepResolver struct {
 	// chanPoint is the channel point of the original contract.
 	chanPoint wire.OutPoint
 
+	// reportLock prevents concurrent access to the resolver report.
+	reportLock sync.Mutex
+
+	// currentReport stores the current state of the resolver for reporting
+	// over the rpc interface.
+	currentReport ContractReport
+
 	ResolverKit
 }
 
@@ -61,8 +66,6 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 
 	// First, we'll register for a notification once the commitment output
 	// itself has been confirmed.
-	//
-	// TODO(roasbeef): instead sweep asap if remote commit? yeh
 	commitTXID := c.commitResolution.SelfOutPoint.Hash
 	sweepScript := c.commitResolution.SelfOutputSignDesc.Output.PkScript
 	confNtfn, err := c.Notifier.RegisterConfirmationsNtfn(
@@ -74,11 +77,13 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 
 	log.Debugf("%T(%v): waiting for commit tx to confirm", c, c.chanPoint)
 
+	var confHeight uint32
 	select {
-	case _, ok := <-confNtfn.Confirmed:
+	case confInfo, ok := <-confNtfn.Confirmed:
 		if !ok {
 			return nil, errResolverShuttingDown
 		}
+		confHeight = confInfo.BlockHeight
 
 	case <-c.Quit:
 		return nil, errResolverShuttingDown
@@ -88,132 +93,135 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	// resolution isn't zero.
 	isLocalCommitTx := c.commitResolution.MaturityDelay != 0
 
-	if !isLocalCommitTx {
-		// There're two types of commitments, those that have tweaks
-		// for the remote key (us in this case), and those that don't.
-		// We'll rely on the presence of the commitment tweak to to
-		// discern which type of commitment this is.
-		var witnessType input.WitnessType
-		if c.commitResolution.SelfOutputSignDesc.SingleTweak == nil {
-			witnessType = input.CommitSpendNoDelayTweakless
-		} else {
-			witnessType = input.CommitmentNoDelay
-		}
+	// We'll craft an input with all the information required for the
+	// sweeper to create a fully valid sweeping transaction to recover
+	// these coins.
+	var (
+		inp            input.Input
+		maturityHeight uint32
+	)
+	switch {
 
-		// We'll craft an input with all the information required for
-		// the sweeper to create a fully valid sweeping transaction to
-		// recover these coins.
-		inp := input.MakeBaseInput(
+	// Our output on our own commitment transaction is encumbered by a CSV
+	// delay, which needs to expire before we can sweep it.
+	case isLocalCommitTx:
+		maturityHeight = confHeight + c.commitResolution.MaturityDelay
+
+		c.reportLock.Lock()
+		c.currentReport.MaturityHeight = maturityHeight
+		c.reportLock.Unlock()
+
+		inp = input.NewCsvInput(
 			&c.commitResolution.SelfOutPoint,
-			witnessType,
+			input.CommitmentTimeLock,
 			&c.commitResolution.SelfOutputSignDesc,
-			c.broadcastHeight,
+			c.broadcastHeight, c.commitResolution.MaturityDelay,
 		)
 
-		// With our input constructed, we'll now offer it to the
-		// sweeper.
-		log.Infof("%T(%v): sweeping commit output", c, c.chanPoint)
-
-		feePref := sweep.FeePreference{ConfTarget: commitOutputConfTarget}
-		resultChan, err := c.Sweeper.SweepInput(&inp, feePref)
-		if err != nil {
-			log.Errorf("%T(%v): unable to sweep input: %v",
-				c, c.chanPoint, err)
-
-			return nil, err
-		}
-
-		// Sweeper is going to join this input with other inputs if
-		// possible and publish the sweep tx. When the sweep tx
-		// confirms, it signals us through the result channel with the
-		// outcome. Wait for this to happen.
-		select {
-		case sweepResult := <-resultChan:
-			if sweepResult.Err != nil {
-				log.Errorf("%T(%v): unable to sweep input: %v",
-					c, c.chanPoint, sweepResult.Err)
-
-				return nil, sweepResult.Err
-			}
-
-			log.Infof("ChannelPoint(%v) commit tx is fully resolved by "+
-				"sweep tx: %v", c.chanPoint, sweepResult.Tx.TxHash())
-		case <-c.Quit:
-			return nil, errResolverShuttingDown
-		}
+	// There're two types of commitments, those that have tweaks for the
+	// remote key (us in this case), and those that don't. We'll rely on
+	// the presence of the commitment tweak to to discern which type of
+	// commitment this is.
+	case c.commitResolution.SelfOutputSignDesc.SingleTweak == nil:
+		inp = input.NewBaseInput(
+			&c.commitResolution.SelfOutPoint,
+			input.CommitSpendNoDelayTweakless,
+			&c.commitResolution.SelfOutputSignDesc,
+			c.broadcastHeight,
+		)
 
-		c.resolved = true
-		return nil, c.Checkpoint(c)
+	default:
+		inp = input.NewBaseInput(
+			&c.commitResolution.SelfOutPoint,
+			input.CommitmentNoDelay,
+			&c.commitResolution.SelfOutputSignDesc,
+			c.broadcastHeight,
+		)
 	}
 
-	// Otherwise we are dealing with a local commitment transaction and the
-	// output we need to sweep has been sent to the nursery for incubation.
-	// In this case, we'll wait until the commitment output has been spent.
-	spendNtfn, err := c.Notifier.RegisterSpendNtfn(
-		&c.commitResolution.SelfOutPoint,
-		c.commitResolution.SelfOutputSignDesc.Output.PkScript,
-		c.broadcastHeight,
-	)
+	// With our input constructed, we'll now hand it to the sweeper once
+	// it has matured, and wait for the sweep to confirm.
+	log.Infof("%T(%v): sweeping commit output", c, c.chanPoint)
+
+	sweepTx, err := c.sweepMatureOutput(inp, maturityHeight)
 	if err != nil {
+		log.Errorf("%T(%v): unable to sweep commit output: %v",
+			c, c.chanPoint, err)
+
 		return nil, err
 	}
 
-	log.Infof("%T(%v): waiting for commit output to be swept", c,
-		c.chanPoint)
+	// If the output was swept through the revocation clause, our
+	// commitment was revoked and the output is lost.
+	outcome := ResolverOutcomeClaimed
+	if isRevocationSweep(sweepTx, c.commitResolution.SelfOutPoint) {
+		log.Warnf("%T(%v): commit output swept by remote party "+
+			"through revocation clause", c, c.chanPoint)
 
-	var sweepTx *wire.MsgTx
-	select {
-	case commitSpend, ok := <-spendNtfn.Spend:
-		if !ok {
-			return nil, errResolverShuttingDown
-		}
+		outcome = ResolverOutcomeBreached
+	}
 
-		// Once we detect the commitment output has been spent,
-		// we'll extract the spending transaction itself, as we
-		// now consider this to be our sweep transaction.
-		sweepTx = commitSpend.SpendingTx
+	sweepTXID := sweepTx.TxHash()
 
-		log.Infof("%T(%v): commit output swept by txid=%v",
-			c, c.chanPoint, sweepTx.TxHash())
+	log.Infof("ChannelPoint(%v) commit tx is fully resolved by sweep "+
+		"tx: %v", c.chanPoint, sweepTXID)
 
-		if err := c.Checkpoint(c); err != nil {
-			log.Errorf("unable to Checkpoint: %v", err)
-			return nil, err
-		}
-	case <-c.Quit:
-		return nil, errResolverShuttingDown
+	// The funds are no longer in limbo. We'll only account for them as
+	// recovered if they actually came back to us.
+	c.reportLock.Lock()
+	if outcome == ResolverOutcomeClaimed {
+		c.currentReport.RecoveredBalance = c.currentReport.LimboBalance
 	}
+	c.currentReport.LimboBalance = 0
+	c.reportLock.Unlock()
 
-	log.Infof("%T(%v): waiting for commit sweep txid=%v conf", c, c.chanPoint,
-		sweepTx.TxHash())
+	c.resolved = true
+	return nil, c.Checkpoint(c, c.resolverReport(outcome, &sweepTXID))
+}
 
-	// Now we'll wait until the sweeping transaction has been fully
-	// confirmed.  Once it's confirmed, we can mark this contract resolved.
-	sweepTXID := sweepTx.TxHash()
-	sweepingScript := sweepTx.TxOut[0].PkScript
-	confNtfn, err = c.Notifier.RegisterConfirmationsNtfn(
-		&sweepTXID, sweepingScript, 1, c.broadcastHeight,
-	)
-	if err != nil {
-		return nil, err
+// initReport initializes the pending channels report for this resolver.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (c *commitSweepResolver) initReport() {
+	amt := btcutil.Amount(c.commitResolution.SelfOutputSignDesc.Output.Value)
+
+	c.reportLock.Lock()
+	defer c.reportLock.Unlock()
+
+	// The maturity height is filled in once the commitment transaction
+	// has confirmed.
+	c.currentReport = ContractReport{
+		Outpoint:     c.commitResolution.SelfOutPoint,
+		Type:         ReportOutputUnencumbered,
+		Amount:       amt,
+		LimboBalance: amt,
 	}
-	select {
-	case confInfo, ok := <-confNtfn.Confirmed:
-		if !ok {
-			return nil, errResolverShuttingDown
-		}
+}
 
-		log.Infof("ChannelPoint(%v) commit tx is fully resolved, at height: %v",
-			c.chanPoint, confInfo.BlockHeight)
+// report returns a report on the resolution state of the contract.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (c *commitSweepResolver) report() *ContractReport {
+	c.reportLock.Lock()
+	defer c.reportLock.Unlock()
 
-	case <-c.Quit:
-		return nil, errResolverShuttingDown
-	}
+	report := c.currentReport
+	return &report
+}
 
-	// Once the transaction has received a sufficient number of
-	// confirmations, we'll mark ourselves as fully resolved and exit.
-	c.resolved = true
-	return nil, c.Checkpoint(c)
+// resolverReport creates a report for the commitment output with the given
+// outcome.
+func (c *commitSweepResolver) resolverReport(outcome ResolverOutcome,
+	spendTxID *chainhash.Hash) *ResolverReport {
+
+	amt := c.commitResolution.SelfOutputSignDesc.Output.Value
+	return &ResolverReport{
+		OutPoint:        c.commitResolution.SelfOutPoint,
+		Amount:          btcutil.Amount(amt),
+		ResolverType:    ResolverTypeCommit,
+		ResolverOutcome: outcome,
+		SpendTxID:       spendTxID,
+	}
 }
 
 // Stop signals the resolver to cancel any current resolution processes, and
@@ -303,5 +311,5 @@ func (c *commitSweepResolver) AttachResolverKit(r ResolverKit) {
 }
 
 // A compile time assertion to ensure commitSweepResolver meets the
-// ContractResolver interface.
-var _ ContractResolver = (*commitSweepResolver)(nil)
+// reportingContractResolver interface.
+var _ reportingContractResolver = (*commitSweepResolver)(nil)
@@ -4,6 +4,12 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 var (
@@ -66,6 +72,12 @@ type ContractResolver interface {
 type reportingContractResolver interface {
 	ContractResolver
 
+	// initReport sets up the initial report of the resolver. It is called
+	// before the resolver is launched, once all of its fields have been
+	// populated.
+	initReport()
+
+	// report returns a report on the resolution state of the contract.
 	report() *ContractReport
 }
 
@@ -79,8 +91,9 @@ type ResolverKit struct {
 
 	// Checkpoint allows a resolver to check point its state. This function
 	// should write the state of the resolver to persistent storage, and
-	// return a non-nil error upon success.
-	Checkpoint func(ContractResolver) error
+	// return a non-nil error upon success. Reports on the resolution of
+	// the resolver's output are stored along with its state.
+	Checkpoint func(ContractResolver, ...*ResolverReport) error
 
 	Quit chan struct{}
 }
@@ -90,3 +103,139 @@ var (
 	// progressing because it received the quit signal.
 	errResolverShuttingDown = errors.New("resolver shutting down")
 )
+
+// isRevocationSpend returns true if the passed spend of an output on our
+// commitment transaction, or of a second-level HTLC output, claims it through
+// the revocation clause. This means that the remote party swept the output, as
+// the commitment transaction was revoked.
+func isRevocationSpend(spend *chainntnfs.SpendDetail) bool {
+	spendingInput := spend.SpendingTx.TxIn[spend.SpenderInputIndex]
+	witness := spendingInput.Witness
+
+	// The revocation spends of the outputs we watch have a witness of
+	// three elements, with the witness script being the last one:
+	//
+	//  TO-SELF: <revoke sig> 1 <witness script>
+	//  HTLC:    <revoke sig> <revoke key> <witness script>
+	//
+	// Our own spend of a time locked output places an empty element
+	// within the second position instead, while the spend of an HTLC
+	// output with the preimage places the 32 byte preimage there.
+	if len(witness) != 3 {
+		return false
+	}
+
+	switch len(witness[1]) {
+	case 1:
+		return witness[1][0] == 1
+
+	case btcec.PubKeyBytesLenCompressed:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// isRevocationSweep returns true if the passed transaction spends the given
+// outpoint through the revocation clause of its script.
+func isRevocationSweep(tx *wire.MsgTx, op wire.OutPoint) bool {
+	for i, txIn := range tx.TxIn {
+		if txIn.PreviousOutPoint != op {
+			continue
+		}
+
+		return isRevocationSpend(&chainntnfs.SpendDetail{
+			SpentOutPoint:     &op,
+			SpendingTx:        tx,
+			SpenderInputIndex: uint32(i),
+		})
+	}
+
+	return false
+}
+
+// waitForHeight registers for block notifications and blocks until the
+// provided block height has been reached.
+func waitForHeight(waitHeight uint32, notifier chainntnfs.ChainNotifier,
+	quit <-chan struct{}) error {
+
+	// Register for block epochs. After registration, the current height
+	// will be sent on the channel immediately.
+	blockEpochs, err := notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case newBlock, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return errResolverShuttingDown
+			}
+
+			if uint32(newBlock.Height) >= waitHeight {
+				return nil
+			}
+
+		case <-quit:
+			return errResolverShuttingDown
+		}
+	}
+}
+
+// sweepMatureOutput waits for the time lock of the passed input to expire at
+// the given maturity height, then offers the input to the sweeper and waits
+// for its spend to confirm. The confirmed spending transaction is returned,
+// which may have been published by another party than the sweeper. A zero
+// maturity height indicates that the input can be swept right away.
+func (r *ResolverKit) sweepMatureOutput(inp input.Input,
+	maturityHeight uint32) (*wire.MsgTx, error) {
+
+	// The sweep transaction can be broadcast one block before the output
+	// matures, as it's then valid for inclusion in the next block.
+	if maturityHeight > 0 {
+		log.Infof("Waiting for output %v to mature at height=%v",
+			inp.OutPoint(), maturityHeight)
+
+		err := waitForHeight(maturityHeight-1, r.Notifier, r.Quit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Infof("Offering output %v to the sweeper", inp.OutPoint())
+
+	feePref := sweep.FeePreference{ConfTarget: sweepConfTarget}
+	resultChan, err := r.Sweeper.SweepInput(inp, feePref)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sweeper is going to join this input with other inputs if
+	// possible and publish the sweep tx. Once the spend of the input
+	// confirms, it signals us the outcome through the result channel.
+	select {
+	case result := <-resultChan:
+		switch result.Err {
+
+		// The output was spent by a transaction that wasn't published
+		// by the sweeper. It's up to the caller to inspect the
+		// spending transaction.
+		case sweep.ErrRemoteSpend:
+			log.Warnf("Output %v spent by foreign tx=%v",
+				inp.OutPoint(), result.Tx.TxHash())
+
+		case nil:
+
+		default:
+			return nil, result.Err
+		}
+
+		return result.Tx, nil
+
+	case <-r.Quit:
+		return nil, errResolverShuttingDown
+	}
+}
@@ -0,0 +1,148 @@
+package contractcourt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// deadlinePolicyBucketKey is the top-level bucket that stores the deadline
+// policy of each channel that has one set, keyed by the logScope of the
+// channel.
+var deadlinePolicyBucketKey = []byte("deadline-policy")
+
+// DeadlinePolicy describes when the ChannelArbitrator of a channel goes to
+// chain in order to resolve the HTLCs of the channel.
+type DeadlinePolicy struct {
+	// IncomingBroadcastDelta overrides the IncomingBroadcastDelta of the
+	// ChainArbitratorConfig for this channel. If zero, the global value
+	// is used.
+	IncomingBroadcastDelta uint32
+
+	// OutgoingBroadcastDelta overrides the OutgoingBroadcastDelta of the
+	// ChainArbitratorConfig for this channel. If zero, the global value
+	// is used.
+	OutgoingBroadcastDelta uint32
+
+	// MinChainHtlcValue is the minimum value of an HTLC that is worth
+	// going to chain for. An outgoing HTLC of lower value that is about
+	// to time out is failed back instead, while an incoming HTLC of lower
+	// value is written off. Either way, the channel isn't force closed on
+	// their behalf.
+	MinChainHtlcValue btcutil.Amount
+}
+
+// withDefaults returns a copy of the policy in which all unset broadcast
+// deltas have been replaced by the global ones of the passed config.
+func (p DeadlinePolicy) withDefaults(cfg *ChainArbitratorConfig) DeadlinePolicy {
+	if p.IncomingBroadcastDelta == 0 {
+		p.IncomingBroadcastDelta = cfg.IncomingBroadcastDelta
+	}
+	if p.OutgoingBroadcastDelta == 0 {
+		p.OutgoingBroadcastDelta = cfg.OutgoingBroadcastDelta
+	}
+
+	return p
+}
+
+// worthChain returns whether the given HTLC is of high enough value to go to
+// chain for.
+func (p *DeadlinePolicy) worthChain(htlc *channeldb.HTLC) bool {
+	return htlc.Amt.ToSatoshis() >= p.MinChainHtlcValue
+}
+
+// putDeadlinePolicy stores the deadline policy of the channel identified by
+// the given log scope, replacing any previous policy.
+func putDeadlinePolicy(db *bbolt.DB, scope logScope,
+	policy *DeadlinePolicy) error {
+
+	return db.Update(func(tx *bbolt.Tx) error {
+		policyBucket, err := tx.CreateBucketIfNotExists(
+			deadlinePolicyBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := encodeDeadlinePolicy(&b, policy); err != nil {
+			return err
+		}
+
+		return policyBucket.Put(scope[:], b.Bytes())
+	})
+}
+
+// fetchDeadlinePolicy returns the deadline policy stored for the channel
+// identified by the given log scope. If none was stored, the empty policy is
+// returned, which applies the global broadcast deltas to HTLCs of any value.
+func fetchDeadlinePolicy(db *bbolt.DB, scope logScope) (*DeadlinePolicy,
+	error) {
+
+	policy := &DeadlinePolicy{}
+	err := db.View(func(tx *bbolt.Tx) error {
+		policyBucket := tx.Bucket(deadlinePolicyBucketKey)
+		if policyBucket == nil {
+			return nil
+		}
+
+		policyBytes := policyBucket.Get(scope[:])
+		if policyBytes == nil {
+			return nil
+		}
+
+		return decodeDeadlinePolicy(bytes.NewReader(policyBytes), policy)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// deleteDeadlinePolicy removes the deadline policy of the channel identified
+// by the given log scope, if any.
+func deleteDeadlinePolicy(db *bbolt.DB, scope logScope) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		policyBucket := tx.Bucket(deadlinePolicyBucketKey)
+		if policyBucket == nil {
+			return nil
+		}
+
+		return policyBucket.Delete(scope[:])
+	})
+}
+
+func encodeDeadlinePolicy(w io.Writer, p *DeadlinePolicy) error {
+	if err := binary.Write(w, endian, p.IncomingBroadcastDelta); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, p.OutgoingBroadcastDelta); err != nil {
+		return err
+	}
+
+	return binary.Write(w, endian, uint64(p.MinChainHtlcValue))
+}
+
+func decodeDeadlinePolicy(r io.Reader, p *DeadlinePolicy) error {
+	err := binary.Read(r, endian, &p.IncomingBroadcastDelta)
+	if err != nil {
+		return err
+	}
+	err = binary.Read(r, endian, &p.OutgoingBroadcastDelta)
+	if err != nil {
+		return err
+	}
+
+	var minValue uint64
+	if err := binary.Read(r, endian, &minValue); err != nil {
+		return err
+	}
+	p.MinChainHtlcValue = btcutil.Amount(minValue)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package contractcourt
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestDeadlinePolicyStorage tests that a channel's deadline policy can be
+// stored, fetched and deleted.
+func TestDeadlinePolicyStorage(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to create test db: %v", err)
+	}
+	defer cleanUp()
+
+	scope, err := newLogScope(testChainHash, testChanPoint1)
+	if err != nil {
+		t.Fatalf("unable to create log scope: %v", err)
+	}
+
+	// Without a stored policy, the empty policy should be returned.
+	policy, err := fetchDeadlinePolicy(db, *scope)
+	if err != nil {
+		t.Fatalf("unable to fetch policy: %v", err)
+	}
+	if *policy != (DeadlinePolicy{}) {
+		t.Fatalf("expected empty policy, got %v", spew.Sdump(policy))
+	}
+
+	storedPolicy := &DeadlinePolicy{
+		IncomingBroadcastDelta: 20,
+		OutgoingBroadcastDelta: 5,
+		MinChainHtlcValue:      5000,
+	}
+	if err := putDeadlinePolicy(db, *scope, storedPolicy); err != nil {
+		t.Fatalf("unable to store policy: %v", err)
+	}
+
+	policy, err = fetchDeadlinePolicy(db, *scope)
+	if err != nil {
+		t.Fatalf("unable to fetch policy: %v", err)
+	}
+	if !reflect.DeepEqual(policy, storedPolicy) {
+		t.Fatalf("policy mismatch: expected %v, got %v",
+			spew.Sdump(storedPolicy), spew.Sdump(policy))
+	}
+
+	if err := deleteDeadlinePolicy(db, *scope); err != nil {
+		t.Fatalf("unable to delete policy: %v", err)
+	}
+
+	policy, err = fetchDeadlinePolicy(db, *scope)
+	if err != nil {
+		t.Fatalf("unable to fetch policy: %v", err)
+	}
+	if *policy != (DeadlinePolicy{}) {
+		t.Fatalf("expected empty policy, got %v", spew.Sdump(policy))
+	}
+}
+
+// TestDeadlinePolicyWorthChain tests that only HTLCs of at least the min
+// chain HTLC value are considered worth going to chain for.
+func TestDeadlinePolicyWorthChain(t *testing.T) {
+	t.Parallel()
+
+	policy := &DeadlinePolicy{MinChainHtlcValue: 1000}
+
+	tests := []struct {
+		amt   lnwire.MilliSatoshi
+		worth bool
+	}{
+		{amt: 999999, worth: false},
+		{amt: 1000000, worth: true},
+		{amt: 5000000, worth: true},
+	}
+	for _, test := range tests {
+		htlc := &channeldb.HTLC{Amt: test.amt}
+		if policy.worthChain(htlc) != test.worth {
+			t.Fatalf("expected worthChain(%v)=%v", test.amt,
+				test.worth)
+		}
+	}
+}
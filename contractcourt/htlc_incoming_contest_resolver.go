@@ -89,7 +89,9 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 			"abandoning", h, h.htlcResolution.ClaimOutpoint,
 			h.htlcExpiry, currentHeight)
 		h.resolved = true
-		return nil, h.Checkpoint(h)
+		return nil, h.Checkpoint(
+			h, h.resolverReport(ResolverOutcomeAbandoned, nil),
+		)
 	}
 
 	// tryApplyPreimage is a helper function that will populate our internal
@@ -149,7 +151,11 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 				h.htlcExpiry, currentHeight)
 
 			h.resolved = true
-			return nil, h.Checkpoint(h)
+			return nil, h.Checkpoint(
+				h, h.resolverReport(
+					ResolverOutcomeAbandoned, nil,
+				),
+			)
 		}
 
 		if err := applyPreimage(*e.Preimage); err != nil {
@@ -236,7 +242,11 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 					h.htlcResolution.ClaimOutpoint,
 					h.htlcExpiry, currentHeight)
 				h.resolved = true
-				return nil, h.Checkpoint(h)
+				return nil, h.Checkpoint(
+					h, h.resolverReport(
+						ResolverOutcomeAbandoned, nil,
+					),
+				)
 			}
 
 		case <-h.Quit:
@@ -249,6 +259,12 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 func (h *htlcIncomingContestResolver) report() *ContractReport {
 	// No locking needed as these values are read-only.
 
+	// Once resolved without handing off to a next resolver, the HTLC
+	// isn't ours to recover anymore.
+	if h.resolved {
+		return nil
+	}
+
 	finalAmt := h.htlcAmt.ToSatoshis()
 	if h.htlcResolution.SignedSuccessTx != nil {
 		finalAmt = btcutil.Amount(
@@ -258,7 +274,7 @@ func (h *htlcIncomingContestResolver) report() *ContractReport {
 
 	return &ContractReport{
 		Outpoint:       h.htlcResolution.ClaimOutpoint,
-		Incoming:       true,
+		Type:           ReportOutputIncomingHtlc,
 		Amount:         finalAmt,
 		MaturityHeight: h.htlcExpiry,
 		LimboBalance:   finalAmt,
@@ -320,5 +336,5 @@ func (h *htlcIncomingContestResolver) AttachResolverKit(r ResolverKit) {
 }
 
 // A compile time assertion to ensure htlcIncomingContestResolver meets the
-// ContractResolver interface.
-var _ ContractResolver = (*htlcIncomingContestResolver)(nil)
+// reportingContractResolver interface.
+var _ reportingContractResolver = (*htlcIncomingContestResolver)(nil)
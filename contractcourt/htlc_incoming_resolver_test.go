@@ -203,7 +203,9 @@ func newIncomingResolverTestContext(t *testing.T) *incomingResolverTestContext {
 		htlcSuccessResolver: htlcSuccessResolver{
 			ResolverKit: ResolverKit{
 				ChannelArbitratorConfig: chainCfg,
-				Checkpoint: func(_ ContractResolver) error {
+				Checkpoint: func(_ ContractResolver,
+					_ ...*ResolverReport) error {
+
 					checkPointChan <- struct{}{}
 					return nil
 				},
@@ -161,6 +161,12 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 func (h *htlcOutgoingContestResolver) report() *ContractReport {
 	// No locking needed as these values are read-only.
 
+	// Once resolved without handing off to a next resolver, the HTLC
+	// isn't ours to recover anymore.
+	if h.resolved {
+		return nil
+	}
+
 	finalAmt := h.htlcAmt.ToSatoshis()
 	if h.htlcResolution.SignedTimeoutTx != nil {
 		finalAmt = btcutil.Amount(
@@ -170,7 +176,7 @@ func (h *htlcOutgoingContestResolver) report() *ContractReport {
 
 	return &ContractReport{
 		Outpoint:       h.htlcResolution.ClaimOutpoint,
-		Incoming:       false,
+		Type:           ReportOutputOutgoingHtlc,
 		Amount:         finalAmt,
 		MaturityHeight: h.htlcResolution.Expiry,
 		LimboBalance:   finalAmt,
@@ -220,5 +226,5 @@ func (h *htlcOutgoingContestResolver) AttachResolverKit(r ResolverKit) {
 }
 
 // A compile time assertion to ensure htlcOutgoingContestResolver meets the
-// ContractResolver interface.
-var _ ContractResolver = (*htlcOutgoingContestResolver)(nil)
+// reportingContractResolver interface.
+var _ reportingContractResolver = (*htlcOutgoingContestResolver)(nil)
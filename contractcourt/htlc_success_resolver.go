@@ -3,10 +3,15 @@ package contractcourt
 import (
 	"encoding/binary"
 	"io"
+	"sync"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -16,9 +21,9 @@ import (
 // htlcSuccessResolver is a resolver that's capable of sweeping an incoming
 // HTLC output on-chain. If this is the remote party's commitment, we'll sweep
 // it directly from the commitment output *immediately*. If this is our
-// commitment, we'll first broadcast the success transaction, then send it to
-// the incubator for sweeping. That's it, no need to send any clean up
-// messages.
+// commitment, we'll first broadcast the success transaction, then sweep its
+// output once the CSV delay has expired. That's it, no need to send any clean
+// up messages.
 //
 // TODO(roasbeef): don't need to broadcast?
 type htlcSuccessResolver struct {
@@ -26,10 +31,6 @@ type htlcSuccessResolver struct {
 	// contains everything we need to properly resolve this HTLC.
 	htlcResolution lnwallet.IncomingHtlcResolution
 
-	// outputIncubating returns true if we've sent the output to the output
-	// incubator (utxo nursery).
-	outputIncubating bool
-
 	// resolved reflects if the contract has been fully resolved or not.
 	resolved bool
 
@@ -52,6 +53,13 @@ type htlcSuccessResolver struct {
 	// account any fees that may have to be paid if it goes on chain.
 	htlcAmt lnwire.MilliSatoshi
 
+	// reportLock prevents concurrent access to the resolver report.
+	reportLock sync.Mutex
+
+	// currentReport stores the current state of the resolver for reporting
+	// over the rpc interface.
+	currentReport ContractReport
+
 	ResolverKit
 }
 
@@ -61,26 +69,43 @@ type htlcSuccessResolver struct {
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcSuccessResolver) ResolverKey() []byte {
 	// The primary key for this resolver will be the outpoint of the HTLC
-	// on the commitment transaction itself. If this is our commitment,
-	// then the output can be found within the signed success tx,
-	// otherwise, it's just the ClaimOutpoint.
-	var op wire.OutPoint
+	// on the commitment transaction itself.
+	key := newResolverID(h.htlcOutpoint())
+	return key[:]
+}
+
+// htlcOutpoint returns the outpoint of the HTLC on the commitment transaction.
+// If this is our commitment, then the output can be found within the signed
+// success tx, otherwise, it's just the ClaimOutpoint.
+func (h *htlcSuccessResolver) htlcOutpoint() wire.OutPoint {
 	if h.htlcResolution.SignedSuccessTx != nil {
-		op = h.htlcResolution.SignedSuccessTx.TxIn[0].PreviousOutPoint
-	} else {
-		op = h.htlcResolution.ClaimOutpoint
+		return h.htlcResolution.SignedSuccessTx.TxIn[0].PreviousOutPoint
 	}
 
-	key := newResolverID(op)
-	return key[:]
+	return h.htlcResolution.ClaimOutpoint
+}
+
+// resolverReport creates a report for the incoming HTLC with the given
+// outcome.
+func (h *htlcSuccessResolver) resolverReport(outcome ResolverOutcome,
+	spendTxID *chainhash.Hash) *ResolverReport {
+
+	return &ResolverReport{
+		OutPoint:        h.htlcOutpoint(),
+		Amount:          h.htlcAmt.ToSatoshis(),
+		ResolverType:    ResolverTypeIncomingHtlc,
+		ResolverOutcome: outcome,
+		SpendTxID:       spendTxID,
+	}
 }
 
 // Resolve attempts to resolve an unresolved incoming HTLC that we know the
 // preimage to. If the HTLC is on the commitment of the remote party, then we'll
-// simply sweep it directly. Otherwise, we'll hand this off to the utxo nursery
-// to do its duty. There is no need to make a call to the invoice registry
-// anymore. Every HTLC has already passed through the incoming contest resolver
-// and in there the invoice was already marked as settled.
+// simply sweep it directly. Otherwise, we'll broadcast the second-level success
+// transaction and sweep its output through the sweeper. There is no need to
+// make a call to the invoice registry anymore. Every HTLC has already passed
+// through the incoming contest resolver and in there the invoice was already
+// marked as settled.
 //
 // TODO(roasbeef): create multi to batch
 //
@@ -146,7 +171,10 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		// Regardless of whether an existing transaction was found or newly
 		// constructed, we'll broadcast the sweep transaction to the
 		// network.
-		err := h.PublishTx(h.sweepTx)
+		label := labels.MakeLabel(
+			labels.LabelTypeChannelClose, &h.ShortChanID,
+		)
+		err := h.PublishTx(h.sweepTx, label)
 		if err != nil {
 			log.Infof("%T(%x): unable to publish tx: %v",
 				h, h.payHash[:], err)
@@ -179,71 +207,180 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 
 		// Once the transaction has received a sufficient number of
 		// confirmations, we'll mark ourselves as fully resolved and exit.
+		h.reportLock.Lock()
+		h.currentReport.RecoveredBalance = h.currentReport.LimboBalance
+		h.currentReport.LimboBalance = 0
+		h.reportLock.Unlock()
+
 		h.resolved = true
-		return nil, h.Checkpoint(h)
+		return nil, h.Checkpoint(
+			h, h.resolverReport(ResolverOutcomeClaimed, &sweepTXID),
+		)
 	}
 
 	log.Infof("%T(%x): broadcasting second-layer transition tx: %v",
 		h, h.payHash[:], spew.Sdump(h.htlcResolution.SignedSuccessTx))
 
 	// We'll now broadcast the second layer transaction so we can kick off
-	// the claiming process.
+	// the claiming process. A double spend indicates that the HTLC output
+	// was already spent by another transaction, which we'll learn about
+	// below.
 	//
 	// TODO(roasbeef): after changing sighashes send to tx bundler
-	err := h.PublishTx(h.htlcResolution.SignedSuccessTx)
-	if err != nil {
+	label := labels.MakeLabel(
+		labels.LabelTypeChannelClose, &h.ShortChanID,
+	)
+	err := h.PublishTx(h.htlcResolution.SignedSuccessTx, label)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
 		return nil, err
 	}
 
-	// Otherwise, this is an output on our commitment transaction. In this
-	// case, we'll send it to the incubator, but only if we haven't already
-	// done so.
-	if !h.outputIncubating {
-		log.Infof("%T(%x): incubating incoming htlc output",
-			h, h.payHash[:])
-
-		err := h.IncubateOutputs(
-			h.ChanPoint, nil, nil, &h.htlcResolution,
-			h.broadcastHeight,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		h.outputIncubating = true
-
-		if err := h.Checkpoint(h); err != nil {
-			log.Errorf("unable to Checkpoint: %v", err)
-			return nil, err
-		}
+	// We'll now wait until the HTLC output on our commitment has been
+	// spent, which should be by our second-level transaction.
+	htlcOutpoint := h.htlcOutpoint()
+	successWitness := h.htlcResolution.SignedSuccessTx.TxIn[0].Witness
+	htlcScript, err := input.WitnessScriptHash(
+		successWitness[len(successWitness)-1],
+	)
+	if err != nil {
+		return nil, err
 	}
-
-	// To wrap this up, we'll wait until the second-level transaction has
-	// been spent, then fully resolve the contract.
 	spendNtfn, err := h.Notifier.RegisterSpendNtfn(
-		&h.htlcResolution.ClaimOutpoint,
-		h.htlcResolution.SweepSignDesc.Output.PkScript,
-		h.broadcastHeight,
+		&htlcOutpoint, htlcScript, h.broadcastHeight,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	log.Infof("%T(%x): waiting for second-level HTLC output to be spent "+
-		"after csv_delay=%v", h, h.payHash[:], h.htlcResolution.CsvDelay)
+	log.Infof("%T(%x): waiting for second-level HTLC tx to confirm",
+		h, h.payHash[:])
 
+	var spend *chainntnfs.SpendDetail
 	select {
-	case _, ok := <-spendNtfn.Spend:
+	case s, ok := <-spendNtfn.Spend:
 		if !ok {
 			return nil, errResolverShuttingDown
 		}
+		spend = s
 
 	case <-h.Quit:
 		return nil, errResolverShuttingDown
 	}
 
+	// If the HTLC output was swept through the revocation clause, our
+	// commitment was revoked and the output is lost. Otherwise, if it
+	// wasn't spent by our second-level transaction, the remote party
+	// managed to time out the HTLC before our transaction confirmed.
+	// There's no second-level output to sweep in either case.
+	sweepTx := spend.SpendingTx
+	outcome := ResolverOutcomeClaimed
+	switch {
+	case isRevocationSpend(spend):
+		log.Warnf("%T(%x): HTLC output swept by remote party through "+
+			"revocation clause", h, h.payHash[:])
+
+		outcome = ResolverOutcomeBreached
+
+	case *spend.SpenderTxHash != h.htlcResolution.SignedSuccessTx.TxHash():
+		log.Warnf("%T(%x): HTLC output timed out by remote party "+
+			"with tx=%v", h, h.payHash[:], spend.SpenderTxHash)
+
+		outcome = ResolverOutcomeTimeout
+
+	// Our second-level transaction confirmed, so we'll sweep its output
+	// once the CSV delay has expired.
+	default:
+		sweepTx, err = h.sweepSecondLevelOutput(
+			uint32(spend.SpendingHeight),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if isRevocationSweep(sweepTx, h.htlcResolution.ClaimOutpoint) {
+			log.Warnf("%T(%x): second-level HTLC output swept by "+
+				"remote party through revocation clause", h,
+				h.payHash[:])
+
+			outcome = ResolverOutcomeBreached
+		}
+	}
+
+	// The funds are no longer in limbo. We'll only account for them as
+	// recovered if they actually came back to us.
+	h.reportLock.Lock()
+	if outcome == ResolverOutcomeClaimed {
+		h.currentReport.RecoveredBalance = h.currentReport.LimboBalance
+	}
+	h.currentReport.LimboBalance = 0
+	h.reportLock.Unlock()
+
+	spendTXID := sweepTx.TxHash()
+
 	h.resolved = true
-	return nil, h.Checkpoint(h)
+	return nil, h.Checkpoint(h, h.resolverReport(outcome, &spendTXID))
+}
+
+// sweepSecondLevelOutput sweeps the output of our second-level success
+// transaction, that confirmed at the given height, once its CSV delay has
+// expired. The confirmed spending transaction of the output is returned.
+func (h *htlcSuccessResolver) sweepSecondLevelOutput(
+	confHeight uint32) (*wire.MsgTx, error) {
+
+	maturityHeight := confHeight + h.htlcResolution.CsvDelay
+
+	h.reportLock.Lock()
+	h.currentReport.Stage = 2
+	h.currentReport.MaturityHeight = maturityHeight
+	h.reportLock.Unlock()
+
+	log.Infof("%T(%x): sweeping second-level HTLC output after "+
+		"csv_delay=%v", h, h.payHash[:], h.htlcResolution.CsvDelay)
+
+	inp := input.NewCsvInput(
+		&h.htlcResolution.ClaimOutpoint,
+		input.HtlcAcceptedSuccessSecondLevel,
+		&h.htlcResolution.SweepSignDesc,
+		confHeight, h.htlcResolution.CsvDelay,
+	)
+
+	return h.sweepMatureOutput(inp, maturityHeight)
+}
+
+// initReport initializes the pending channels report for this resolver.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (h *htlcSuccessResolver) initReport() {
+	// On our commitment, the amount we'll eventually recover is the output
+	// of the second-level success transaction.
+	finalAmt := h.htlcAmt.ToSatoshis()
+	if h.htlcResolution.SignedSuccessTx != nil {
+		finalAmt = btcutil.Amount(
+			h.htlcResolution.SignedSuccessTx.TxOut[0].Value,
+		)
+	}
+
+	h.reportLock.Lock()
+	defer h.reportLock.Unlock()
+
+	h.currentReport = ContractReport{
+		Outpoint:     h.htlcResolution.ClaimOutpoint,
+		Type:         ReportOutputIncomingHtlc,
+		Amount:       finalAmt,
+		LimboBalance: finalAmt,
+		Stage:        1,
+	}
+}
+
+// report returns a report on the resolution state of the contract.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (h *htlcSuccessResolver) report() *ContractReport {
+	h.reportLock.Lock()
+	defer h.reportLock.Unlock()
+
+	report := h.currentReport
+	return &report
 }
 
 // Stop signals the resolver to cancel any current resolution processes, and
@@ -273,8 +410,10 @@ func (h *htlcSuccessResolver) Encode(w io.Writer) error {
 	}
 
 	// Next, we'll write out the fields that are specified to the contract
-	// resolver.
-	if err := binary.Write(w, endian, h.outputIncubating); err != nil {
+	// resolver. Previously a flag indicating whether the output was handed
+	// to the utxo nursery was serialized first, which we'll keep in place
+	// to remain compatible with the stored format.
+	if err := binary.Write(w, endian, false); err != nil {
 		return err
 	}
 	if err := binary.Write(w, endian, h.resolved); err != nil {
@@ -301,8 +440,9 @@ func (h *htlcSuccessResolver) Decode(r io.Reader) error {
 	}
 
 	// Next, we'll read all the fields that are specified to the contract
-	// resolver.
-	if err := binary.Read(r, endian, &h.outputIncubating); err != nil {
+	// resolver, skipping the legacy utxo nursery flag.
+	var legacyIncubating bool
+	if err := binary.Read(r, endian, &legacyIncubating); err != nil {
 		return err
 	}
 	if err := binary.Read(r, endian, &h.resolved); err != nil {
@@ -328,5 +468,5 @@ func (h *htlcSuccessResolver) AttachResolverKit(r ResolverKit) {
 }
 
 // A compile time assertion to ensure htlcSuccessResolver meets the
-// ContractResolver interface.
-var _ ContractResolver = (*htlcSuccessResolver)(nil)
+// reportingContractResolver interface.
+var _ reportingContractResolver = (*htlcSuccessResolver)(nil)
@@ -4,21 +4,26 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sync"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 // htlcTimeoutResolver is a ContractResolver that's capable of resolving an
 // outgoing HTLC. The HTLC may be on our commitment transaction, or on the
 // commitment transaction of the remote party. An output on our commitment
-// transaction is considered fully resolved once the second-level transaction
-// has been confirmed (and reached a sufficient depth). An output on the
+// transaction is considered fully resolved once the output of the second-level
+// timeout transaction has been swept after its CSV delay. An output on the
 // commitment transaction of the remote party is resolved once we detect a
 // spend of the direct HTLC output using the timeout clause.
 type htlcTimeoutResolver struct {
@@ -26,10 +31,6 @@ type htlcTimeoutResolver struct {
 	// resolve this outgoing HTLC.
 	htlcResolution lnwallet.OutgoingHtlcResolution
 
-	// outputIncubating returns true if we've sent the output to the output
-	// incubator (utxo nursery).
-	outputIncubating bool
-
 	// resolved reflects if the contract has been fully resolved or not.
 	resolved bool
 
@@ -48,6 +49,13 @@ type htlcTimeoutResolver struct {
 	// account any fees that may have to be paid if it goes on chain.
 	htlcAmt lnwire.MilliSatoshi
 
+	// reportLock prevents concurrent access to the resolver report.
+	reportLock sync.Mutex
+
+	// currentReport stores the current state of the resolver for reporting
+	// over the rpc interface.
+	currentReport ContractReport
+
 	ResolverKit
 }
 
@@ -57,18 +65,34 @@ type htlcTimeoutResolver struct {
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcTimeoutResolver) ResolverKey() []byte {
 	// The primary key for this resolver will be the outpoint of the HTLC
-	// on the commitment transaction itself. If this is our commitment,
-	// then the output can be found within the signed timeout tx,
-	// otherwise, it's just the ClaimOutpoint.
-	var op wire.OutPoint
+	// on the commitment transaction itself.
+	key := newResolverID(h.htlcOutpoint())
+	return key[:]
+}
+
+// htlcOutpoint returns the outpoint of the HTLC on the commitment transaction.
+// If this is our commitment, then the output can be found within the signed
+// timeout tx, otherwise, it's just the ClaimOutpoint.
+func (h *htlcTimeoutResolver) htlcOutpoint() wire.OutPoint {
 	if h.htlcResolution.SignedTimeoutTx != nil {
-		op = h.htlcResolution.SignedTimeoutTx.TxIn[0].PreviousOutPoint
-	} else {
-		op = h.htlcResolution.ClaimOutpoint
+		return h.htlcResolution.SignedTimeoutTx.TxIn[0].PreviousOutPoint
 	}
 
-	key := newResolverID(op)
-	return key[:]
+	return h.htlcResolution.ClaimOutpoint
+}
+
+// resolverReport creates a report for the outgoing HTLC with the given
+// outcome.
+func (h *htlcTimeoutResolver) resolverReport(outcome ResolverOutcome,
+	spendTxID *chainhash.Hash) *ResolverReport {
+
+	return &ResolverReport{
+		OutPoint:        h.htlcOutpoint(),
+		Amount:          h.htlcAmt.ToSatoshis(),
+		ResolverType:    ResolverTypeOutgoingHtlc,
+		ResolverOutcome: outcome,
+		SpendTxID:       spendTxID,
+	}
 }
 
 const (
@@ -147,8 +171,20 @@ func (h *htlcTimeoutResolver) claimCleanUp(
 	}); err != nil {
 		return nil, err
 	}
+
+	// The HTLC was claimed by the remote party, which we'll report along
+	// with the transaction revealing the preimage. Its value is no longer
+	// in limbo.
+	spendTXID := commitSpend.SpendingTx.TxHash()
+
+	h.reportLock.Lock()
+	h.currentReport.LimboBalance = 0
+	h.reportLock.Unlock()
+
 	h.resolved = true
-	return nil, h.Checkpoint(h)
+	return nil, h.Checkpoint(
+		h, h.resolverReport(ResolverOutcomeClaimed, &spendTXID),
+	)
 }
 
 // chainDetailsToWatch returns the output and script which we use to watch for
@@ -220,9 +256,11 @@ func isSuccessSpend(spend *chainntnfs.SpendDetail, localCommit bool) bool {
 }
 
 // Resolve kicks off full resolution of an outgoing HTLC output. If it's our
-// commitment, it isn't resolved until we see the second level HTLC txn
-// confirmed. If it's the remote party's commitment, we don't resolve until we
-// see a direct sweep via the timeout clause.
+// commitment, we'll broadcast the second-level timeout transaction once the
+// HTLC expires, and sweep its output after the CSV delay. If it's the remote
+// party's commitment, we'll sweep the HTLC output directly via the timeout
+// clause once it expires. In either case, the remote party may still claim the
+// HTLC with the preimage in the meantime.
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
@@ -231,60 +269,10 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		return nil, nil
 	}
 
-	// If we haven't already sent the output to the utxo nursery, then
-	// we'll do so now.
-	if !h.outputIncubating {
-		log.Tracef("%T(%v): incubating htlc output", h,
-			h.htlcResolution.ClaimOutpoint)
-
-		err := h.IncubateOutputs(
-			h.ChanPoint, nil, &h.htlcResolution, nil,
-			h.broadcastHeight,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		h.outputIncubating = true
-
-		if err := h.Checkpoint(h); err != nil {
-			log.Errorf("unable to Checkpoint: %v", err)
-			return nil, err
-		}
-	}
-
-	// waitForOutputResolution waits for the HTLC output to be fully
-	// resolved. The output is considered fully resolved once it has been
-	// spent, and the spending transaction has been fully confirmed.
-	waitForOutputResolution := func() error {
-		// We first need to register to see when the HTLC output itself
-		// has been spent by a confirmed transaction.
-		spendNtfn, err := h.Notifier.RegisterSpendNtfn(
-			&h.htlcResolution.ClaimOutpoint,
-			h.htlcResolution.SweepSignDesc.Output.PkScript,
-			h.broadcastHeight,
-		)
-		if err != nil {
-			return err
-		}
-
-		select {
-		case _, ok := <-spendNtfn.Spend:
-			if !ok {
-				return errResolverShuttingDown
-			}
-
-		case <-h.Quit:
-			return errResolverShuttingDown
-		}
-
-		return nil
-	}
-
-	// Now that we've handed off the HTLC to the nursery, we'll watch for a
-	// spend of the output, and make our next move off of that. Depending
-	// on if this is our commitment, or the remote party's commitment,
-	// we'll be watching a different outpoint and script.
+	// We'll watch for a spend of the HTLC output on the commitment, and
+	// make our next move off of that. Depending on if this is our
+	// commitment, or the remote party's commitment, we'll be watching a
+	// different outpoint and script.
 	outpointToWatch, scriptToWatch, err := h.chainDetailsToWatch()
 	if err != nil {
 		return nil, err
@@ -296,24 +284,51 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		return nil, err
 	}
 
-	log.Infof("%T(%v): waiting for HTLC output %v to be spent"+
-		"fully confirmed", h, h.htlcResolution.ClaimOutpoint,
-		outpointToWatch)
+	// We'll also need to know when the HTLC expires, as we'll claim it
+	// ourselves from that point on. After registration, the current
+	// height will be sent on the channel immediately.
+	blockEpochs, err := h.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer blockEpochs.Cancel()
+
+	log.Infof("%T(%v): waiting for HTLC output %v to be spent, expiry=%v",
+		h, h.htlcResolution.ClaimOutpoint, outpointToWatch,
+		h.htlcResolution.Expiry)
 
 	// We'll block here until either we exit, or the HTLC output on the
 	// commitment transaction has been spent.
 	var (
-		spend *chainntnfs.SpendDetail
-		ok    bool
+		spend   *chainntnfs.SpendDetail
+		claimed bool
 	)
-	select {
-	case spend, ok = <-spendNtfn.Spend:
-		if !ok {
+	for spend == nil {
+		select {
+		case s, ok := <-spendNtfn.Spend:
+			if !ok {
+				return nil, errResolverShuttingDown
+			}
+			spend = s
+
+		case newBlock, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return nil, errResolverShuttingDown
+			}
+
+			height := uint32(newBlock.Height)
+			if claimed || height < h.htlcResolution.Expiry {
+				continue
+			}
+
+			if err := h.claimExpiredHtlc(); err != nil {
+				return nil, err
+			}
+			claimed = true
+
+		case <-h.Quit:
 			return nil, errResolverShuttingDown
 		}
-
-	case <-h.Quit:
-		return nil, errResolverShuttingDown
 	}
 
 	// If the spend reveals the pre-image, then we'll enter the clean up
@@ -322,7 +337,7 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 	if isSuccessSpend(spend, h.htlcResolution.SignedTimeoutTx != nil) {
 		log.Infof("%T(%v): HTLC has been swept with pre-image by "+
 			"remote party during timeout flow! Adding pre-image to "+
-			"witness cache", h.htlcResolution.ClaimOutpoint)
+			"witness cache", h, h.htlcResolution.ClaimOutpoint)
 
 		return h.claimCleanUp(spend)
 	}
@@ -330,10 +345,10 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 	log.Infof("%T(%v): resolving htlc with incoming fail msg, fully "+
 		"confirmed", h, h.htlcResolution.ClaimOutpoint)
 
-	// At this point, the second-level transaction is sufficiently
-	// confirmed, or a transaction directly spending the output is.
-	// Therefore, we can now send back our clean up message, failing the
-	// HTLC on the incoming link.
+	// At this point, the second-level transaction is confirmed, or a
+	// transaction directly spending the output is. Therefore, we can now
+	// send back our clean up message, failing the HTLC on the incoming
+	// link.
 	failureMsg := &lnwire.FailPermanentChannelFailure{}
 	if err := h.DeliverResolutionMsg(ResolutionMsg{
 		SourceChan: h.ShortChanID,
@@ -343,21 +358,160 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		return nil, err
 	}
 
+	// If the HTLC output on our commitment transaction was swept through
+	// the revocation clause, our commitment was revoked and the output is
+	// lost. There is no second-level output to sweep in this case.
+	localCommit := h.htlcResolution.SignedTimeoutTx != nil
+	outcome := ResolverOutcomeTimeout
+	if localCommit && isRevocationSpend(spend) {
+		log.Warnf("%T(%v): HTLC output swept by remote party through "+
+			"revocation clause", h, h.htlcResolution.ClaimOutpoint)
+
+		outcome = ResolverOutcomeBreached
+	}
+
 	// Finally, if this was an output on our commitment transaction, we'll
-	// wait for the second-level HTLC output to be spent, and for that
-	// transaction itself to confirm.
-	if h.htlcResolution.SignedTimeoutTx != nil {
-		log.Infof("%T(%v): waiting for nursery to spend CSV delayed "+
-			"output", h, h.htlcResolution.ClaimOutpoint)
-		if err := waitForOutputResolution(); err != nil {
+	// sweep the output of the second-level timeout transaction once its
+	// CSV delay has expired.
+	sweepTx := spend.SpendingTx
+	if localCommit && outcome != ResolverOutcomeBreached {
+		sweepTx, err = h.sweepSecondLevelOutput(
+			uint32(spend.SpendingHeight),
+		)
+		if err != nil {
 			return nil, err
 		}
+
+		if isRevocationSweep(sweepTx, h.htlcResolution.ClaimOutpoint) {
+			log.Warnf("%T(%v): second-level HTLC output swept by "+
+				"remote party through revocation clause", h,
+				h.htlcResolution.ClaimOutpoint)
+
+			outcome = ResolverOutcomeBreached
+		}
+	}
+
+	// The funds are no longer in limbo. We'll only account for them as
+	// recovered if they actually came back to us.
+	h.reportLock.Lock()
+	if outcome == ResolverOutcomeTimeout {
+		h.currentReport.RecoveredBalance = h.currentReport.LimboBalance
 	}
+	h.currentReport.LimboBalance = 0
+	h.reportLock.Unlock()
 
 	// With the clean up message sent, we'll now mark the contract
 	// resolved, and wait.
+	sweepTXID := sweepTx.TxHash()
+
 	h.resolved = true
-	return nil, h.Checkpoint(h)
+	return nil, h.Checkpoint(h, h.resolverReport(outcome, &sweepTXID))
+}
+
+// claimExpiredHtlc claims the HTLC output once it has expired. If this is our
+// commitment, we'll broadcast the pre-signed second-level timeout transaction.
+// Otherwise, we'll offer the HTLC output to the sweeper to sweep it directly.
+func (h *htlcTimeoutResolver) claimExpiredHtlc() error {
+	if h.htlcResolution.SignedTimeoutTx != nil {
+		log.Infof("%T(%v): publishing second-level timeout tx=%v", h,
+			h.htlcResolution.ClaimOutpoint,
+			h.htlcResolution.SignedTimeoutTx.TxHash())
+
+		// A double spend indicates that the remote party already
+		// claimed the HTLC, which we'll learn about through the spend
+		// notification.
+		label := labels.MakeLabel(
+			labels.LabelTypeChannelClose, &h.ShortChanID,
+		)
+		err := h.PublishTx(h.htlcResolution.SignedTimeoutTx, label)
+		if err != nil && err != lnwallet.ErrDoubleSpend {
+			return err
+		}
+
+		return nil
+	}
+
+	log.Infof("%T(%v): offering expired HTLC output to the sweeper", h,
+		h.htlcResolution.ClaimOutpoint)
+
+	// The sweeper will notify us of the outcome, but we'll learn about
+	// the spend of the HTLC output through our own spend notification
+	// regardless of who spent it.
+	inp := input.NewBaseInput(
+		&h.htlcResolution.ClaimOutpoint,
+		input.HtlcOfferedRemoteTimeout,
+		&h.htlcResolution.SweepSignDesc,
+		h.broadcastHeight,
+	)
+	_, err := h.Sweeper.SweepInput(
+		inp, sweep.FeePreference{ConfTarget: sweepConfTarget},
+	)
+
+	return err
+}
+
+// sweepSecondLevelOutput sweeps the output of our second-level timeout
+// transaction, that confirmed at the given height, once its CSV delay has
+// expired. The confirmed spending transaction of the output is returned.
+func (h *htlcTimeoutResolver) sweepSecondLevelOutput(
+	confHeight uint32) (*wire.MsgTx, error) {
+
+	maturityHeight := confHeight + h.htlcResolution.CsvDelay
+
+	h.reportLock.Lock()
+	h.currentReport.Stage = 2
+	h.currentReport.MaturityHeight = maturityHeight
+	h.reportLock.Unlock()
+
+	log.Infof("%T(%v): sweeping second-level HTLC output after "+
+		"csv_delay=%v", h, h.htlcResolution.ClaimOutpoint,
+		h.htlcResolution.CsvDelay)
+
+	inp := input.NewCsvInput(
+		&h.htlcResolution.ClaimOutpoint,
+		input.HtlcOfferedTimeoutSecondLevel,
+		&h.htlcResolution.SweepSignDesc,
+		confHeight, h.htlcResolution.CsvDelay,
+	)
+
+	return h.sweepMatureOutput(inp, maturityHeight)
+}
+
+// initReport initializes the pending channels report for this resolver.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (h *htlcTimeoutResolver) initReport() {
+	// On our commitment, the amount we'll eventually recover is the output
+	// of the second-level timeout transaction.
+	finalAmt := h.htlcAmt.ToSatoshis()
+	if h.htlcResolution.SignedTimeoutTx != nil {
+		finalAmt = btcutil.Amount(
+			h.htlcResolution.SignedTimeoutTx.TxOut[0].Value,
+		)
+	}
+
+	h.reportLock.Lock()
+	defer h.reportLock.Unlock()
+
+	h.currentReport = ContractReport{
+		Outpoint:       h.htlcResolution.ClaimOutpoint,
+		Type:           ReportOutputOutgoingHtlc,
+		Amount:         finalAmt,
+		MaturityHeight: h.htlcResolution.Expiry,
+		LimboBalance:   finalAmt,
+		Stage:          1,
+	}
+}
+
+// report returns a report on the resolution state of the contract.
+//
+// NOTE: Part of the reportingContractResolver interface.
+func (h *htlcTimeoutResolver) report() *ContractReport {
+	h.reportLock.Lock()
+	defer h.reportLock.Unlock()
+
+	report := h.currentReport
+	return &report
 }
 
 // Stop signals the resolver to cancel any current resolution processes, and
@@ -388,8 +542,10 @@ func (h *htlcTimeoutResolver) Encode(w io.Writer) error {
 	}
 
 	// With that portion written, we can now write out the fields specific
-	// to the resolver itself.
-	if err := binary.Write(w, endian, h.outputIncubating); err != nil {
+	// to the resolver itself. Previously a flag indicating whether the
+	// output was handed to the utxo nursery was serialized first, which
+	// we'll keep in place to remain compatible with the stored format.
+	if err := binary.Write(w, endian, false); err != nil {
 		return err
 	}
 	if err := binary.Write(w, endian, h.resolved); err != nil {
@@ -418,8 +574,10 @@ func (h *htlcTimeoutResolver) Decode(r io.Reader) error {
 	}
 
 	// With those fields read, we can now read back the fields that are
-	// specific to the resolver itself.
-	if err := binary.Read(r, endian, &h.outputIncubating); err != nil {
+	// specific to the resolver itself, skipping the legacy utxo nursery
+	// flag.
+	var legacyIncubating bool
+	if err := binary.Read(r, endian, &legacyIncubating); err != nil {
 		return err
 	}
 	if err := binary.Read(r, endian, &h.resolved); err != nil {
@@ -446,5 +604,5 @@ func (h *htlcTimeoutResolver) AttachResolverKit(r ResolverKit) {
 }
 
 // A compile time assertion to ensure htlcTimeoutResolver meets the
-// ContractResolver interface.
-var _ ContractResolver = (*htlcTimeoutResolver)(nil)
+// reportingContractResolver interface.
+var _ reportingContractResolver = (*htlcTimeoutResolver)(nil)
@@ -7,11 +7,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lntypes"
-	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
 type mockSigner struct {
@@ -78,6 +78,11 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 
 	copy(fakePreimage[:], fakePreimageBytes)
 
+	revokeKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate revocation key: %v", err)
+	}
+
 	signer := &mockSigner{}
 	sweepTx := &wire.MsgTx{
 		TxIn: []*wire.TxIn{
@@ -115,6 +120,9 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 		// can use this to customize the witness used when spending to
 		// trigger various redemption cases.
 		txToBroadcast func() (*wire.MsgTx, error)
+
+		// outcome is the outcome we expect the resolver to report.
+		outcome ResolverOutcome
 	}{
 		// Remote commitment is broadcast, we time out the HTLC on
 		// chain, and should expect a fail HTLC resolution.
@@ -134,6 +142,7 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				templateTx.TxIn[0].Witness = witness
 				return templateTx, nil
 			},
+			outcome: ResolverOutcomeTimeout,
 		},
 
 		// Our local commitment is broadcast, we timeout the HTLC and
@@ -153,6 +162,7 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				templateTx.TxIn[0].Witness = witness
 				return templateTx, nil
 			},
+			outcome: ResolverOutcomeTimeout,
 		},
 
 		// The remote commitment is broadcast, they sweep with the
@@ -173,6 +183,7 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				templateTx.TxIn[0].Witness = witness
 				return templateTx, nil
 			},
+			outcome: ResolverOutcomeClaimed,
 		},
 
 		// The local commitment is broadcast, they sweep it with a
@@ -194,6 +205,30 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				templateTx.TxIn[0].Witness = witness
 				return templateTx, nil
 			},
+			outcome: ResolverOutcomeClaimed,
+		},
+
+		// Our revoked local commitment is broadcast, they sweep the
+		// HTLC output through the revocation clause. The HTLC is
+		// failed back, and reported as lost to the breach.
+		{
+			name:         "breach local tx",
+			remoteCommit: false,
+			timeout:      true,
+			txToBroadcast: func() (*wire.MsgTx, error) {
+				witness, err :=
+					input.SenderHtlcSpendRevokeWithKey(
+						signer, fakeSignDesc,
+						revokeKey.PubKey(), sweepTx,
+					)
+				if err != nil {
+					return nil, err
+				}
+
+				templateTx.TxIn[0].Witness = witness
+				return templateTx, nil
+			},
+			outcome: ResolverOutcomeBreached,
 		},
 	}
 
@@ -207,23 +242,20 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Logf("Running test case: %v", testCase.name)
 
-		checkPointChan := make(chan struct{}, 1)
-		incubateChan := make(chan struct{}, 1)
+		checkPointChan := make(chan []*ResolverReport, 1)
+		publishChan := make(chan *wire.MsgTx, 1)
 		resolutionChan := make(chan ResolutionMsg, 1)
+		sweeper := newMockSweeper()
 
 		chainCfg := ChannelArbitratorConfig{
 			ChainArbitratorConfig: ChainArbitratorConfig{
 				Notifier:   notifier,
 				PreimageDB: witnessBeacon,
-				IncubateOutputs: func(wire.OutPoint,
-					*lnwallet.CommitOutputResolution,
-					*lnwallet.OutgoingHtlcResolution,
-					*lnwallet.IncomingHtlcResolution,
-					uint32) error {
-
-					incubateChan <- struct{}{}
+				PublishTx: func(tx *wire.MsgTx, _ string) error {
+					publishChan <- tx
 					return nil
 				},
+				Sweeper: sweeper,
 				DeliverResolutionMsg: func(msgs ...ResolutionMsg) error {
 					if len(msgs) != 1 {
 						return fmt.Errorf("expected 1 "+
@@ -240,13 +272,20 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 		resolver := &htlcTimeoutResolver{
 			ResolverKit: ResolverKit{
 				ChannelArbitratorConfig: chainCfg,
-				Checkpoint: func(_ ContractResolver) error {
-					checkPointChan <- struct{}{}
+				Checkpoint: func(_ ContractResolver,
+					reports ...*ResolverReport) error {
+
+					// Only the final checkpoint carries
+					// the report of the outcome.
+					if len(reports) > 0 {
+						checkPointChan <- reports
+					}
 					return nil
 				},
 			},
 		}
 		resolver.htlcResolution.SweepSignDesc = *fakeSignDesc
+		resolver.htlcResolution.Expiry = uint32(fakeTimeout)
 
 		// If the test case needs the remote commitment to be
 		// broadcast, then we'll set the timeout commit to a fake
@@ -269,14 +308,37 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 			}
 		}()
 
-		// At the output isn't yet in the nursery, we expect that we
-		// should receive an incubation request.
+		// Once the HTLC expires, the resolver should claim it. On our
+		// commitment, it'll publish the second-level timeout
+		// transaction, otherwise it'll sweep the HTLC output directly.
 		select {
-		case <-incubateChan:
-		case err := <-resolveErr:
-			t.Fatalf("unable to resolve HTLC: %v", err)
+		case notifier.epochChan <- &chainntnfs.BlockEpoch{
+			Height: fakeTimeout,
+		}:
 		case <-time.After(time.Second * 5):
-			t.Fatalf("failed to receive incubation request")
+			t.Fatalf("failed to request block epoch ntfn")
+		}
+
+		if testCase.remoteCommit {
+			select {
+			case <-sweeper.sweptInputs:
+			case err := <-resolveErr:
+				t.Fatalf("unable to resolve HTLC: %v", err)
+			case <-time.After(time.Second * 5):
+				t.Fatalf("failed to receive sweep request")
+			}
+		} else {
+			select {
+			case tx := <-publishChan:
+				if tx != sweepTx {
+					t.Fatalf("expected timeout tx to be " +
+						"published")
+				}
+			case err := <-resolveErr:
+				t.Fatalf("unable to resolve HTLC: %v", err)
+			case <-time.After(time.Second * 5):
+				t.Fatalf("failed to publish timeout tx")
+			}
 		}
 
 		// Next, the resolver should request a spend notification for
@@ -338,25 +400,60 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 				t.Fatalf("resolution not sent")
 			}
 
-			// We should also get another request for the spend
-			// notification of the second-level transaction to
-			// indicate that it's been swept by the nursery, but
-			// only if this is a local commitment transaction.
-			if !testCase.remoteCommit {
+			// The output of the second-level transaction should
+			// also be offered to the sweeper, but only if this is
+			// a local commitment transaction that wasn't breached.
+			if !testCase.remoteCommit &&
+				testCase.outcome != ResolverOutcomeBreached {
+
 				select {
-				case notifier.spendChan <- &chainntnfs.SpendDetail{
-					SpendingTx: spendingTx,
-				}:
+				case inp := <-sweeper.sweptInputs:
+					if inp.WitnessType() !=
+						input.HtlcOfferedTimeoutSecondLevel {
+
+						t.Fatalf("wrong witness type "+
+							"swept: %v",
+							inp.WitnessType())
+					}
+
+					// The mock sweeper sweeps the input
+					// by itself, which is the spend we
+					// expect to be reported.
+					spendingTx = &wire.MsgTx{
+						TxIn: []*wire.TxIn{{
+							PreviousOutPoint: *inp.OutPoint(),
+						}},
+					}
 				case <-time.After(time.Second * 5):
-					t.Fatalf("failed to request spend ntfn")
+					t.Fatalf("second-level output not " +
+						"swept")
 				}
 			}
 		}
 
 		// In any case, before the resolver exits, it should checkpoint
-		// its final state.
+		// its final state, along with a report of its outcome.
 		select {
-		case <-checkPointChan:
+		case reports := <-checkPointChan:
+			if len(reports) != 1 {
+				t.Fatalf("expected 1 report, got %v",
+					len(reports))
+			}
+			report := reports[0]
+			if report.ResolverOutcome != testCase.outcome {
+				t.Fatalf("expected outcome %v, got %v",
+					testCase.outcome,
+					report.ResolverOutcome)
+			}
+			if report.ResolverType != ResolverTypeOutgoingHtlc {
+				t.Fatalf("expected outgoing htlc report, "+
+					"got %v", report.ResolverType)
+			}
+			spendTxID := spendingTx.TxHash()
+			if *report.SpendTxID != spendTxID {
+				t.Fatalf("expected spend by %v, got %v",
+					spendTxID, report.SpendTxID)
+			}
 		case err := <-resolveErr:
 			t.Fatalf("unable to resolve HTLC: %v", err)
 		case <-time.After(time.Second * 5):
@@ -1,10 +1,13 @@
 package contractcourt
 
 import (
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 // Registry is an interface which represents the invoice registry.
@@ -26,3 +29,18 @@ type Registry interface {
 	// HodlUnsubscribeAll unsubscribes from all hodl events.
 	HodlUnsubscribeAll(subscriber chan<- interface{})
 }
+
+// UtxoSweeper defines the sweep functions that contract resolvers need.
+type UtxoSweeper interface {
+	// SweepInput hands the input over to the sweeper, which will sweep it
+	// back into the wallet once it's mature. The outcome is sent over the
+	// returned channel once the sweep, or a spend by another party, has
+	// confirmed.
+	SweepInput(input.Input, sweep.FeePreference) (chan sweep.Result, error)
+
+	// CreateSweepTx accepts a list of inputs and signs and generates a txn
+	// that spends from them. This method also makes an accurate fee
+	// estimate before generating the required witnesses.
+	CreateSweepTx(inputs []input.Input, feePref sweep.FeePreference,
+		currentBlockHeight uint32) (*wire.MsgTx, error)
+}
@@ -0,0 +1,45 @@
+package contractcourt
+
+import (
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/sweep"
+)
+
+type mockSweeper struct {
+	sweptInputs chan input.Input
+}
+
+func newMockSweeper() *mockSweeper {
+	return &mockSweeper{
+		sweptInputs: make(chan input.Input, 1),
+	}
+}
+
+// SweepInput signals the offered input to the test, and immediately reports
+// it as swept by a transaction spending just that input.
+func (s *mockSweeper) SweepInput(inp input.Input,
+	feePref sweep.FeePreference) (chan sweep.Result, error) {
+
+	s.sweptInputs <- inp
+
+	result := make(chan sweep.Result, 1)
+	result <- sweep.Result{
+		Tx: &wire.MsgTx{
+			TxIn: []*wire.TxIn{
+				{
+					PreviousOutPoint: *inp.OutPoint(),
+				},
+			},
+		},
+	}
+
+	return result, nil
+}
+
+func (s *mockSweeper) CreateSweepTx(inputs []input.Input,
+	feePref sweep.FeePreference, currentBlockHeight uint32) (*wire.MsgTx,
+	error) {
+
+	return &wire.MsgTx{}, nil
+}
@@ -0,0 +1,812 @@
+package electrum
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+const (
+	// BackEnd is the name of the Electrum chain backend.
+	BackEnd = "electrum"
+
+	// isCurrentDelta is the maximum age of the best block for the backend
+	// to be considered synced to the tip of the chain.
+	isCurrentDelta = 2 * time.Hour
+)
+
+// ErrBlocksUnsupported is returned when requesting a full block, which
+// Electrum servers are unable to serve.
+var ErrBlocksUnsupported = errors.New("electrum servers don't serve full " +
+	"blocks")
+
+// Compile time check to ensure ChainClient satisfies the chain.Interface
+// interface.
+var _ chain.Interface = (*ChainClient)(nil)
+
+// ChainClient is an implementation of btcwallet's chain.Interface backed by an
+// Electrum server. As the server is unable to serve full blocks, the wallet
+// is kept in sync through scripthash subscriptions for its addresses.
+type ChainClient struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	*Client
+
+	chainParams *chaincfg.Params
+
+	sub *Subscription
+
+	notifyBlocks uint32 // To be used atomically.
+
+	// chainMtx guards our view of the best chain.
+	chainMtx  sync.Mutex
+	bestBlock wtxmgr.BlockMeta
+	blocks    map[int32]wtxmgr.BlockMeta
+
+	// watchMtx guards the scripts we're watching, as well as the
+	// transactions we've notified the wallet about. It is held while
+	// processing the history of a script, to ensure transactions are
+	// delivered exactly once and in order.
+	watchMtx    sync.Mutex
+	watched     map[string][]byte
+	statuses    map[string]string
+	notifiedTxs map[chainhash.Hash]int32
+
+	// historyMtx guards the cache of script histories used when
+	// filtering blocks.
+	historyMtx   sync.Mutex
+	historyCache map[string][]HistoryItem
+
+	ntfnQueue *queue.ConcurrentQueue
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewChainClient creates a new chain client backed by the given Electrum
+// client, which must be started before the chain client.
+func NewChainClient(client *Client,
+	chainParams *chaincfg.Params) *ChainClient {
+
+	return &ChainClient{
+		Client:       client,
+		chainParams:  chainParams,
+		blocks:       make(map[int32]wtxmgr.BlockMeta),
+		watched:      make(map[string][]byte),
+		statuses:     make(map[string]string),
+		notifiedTxs:  make(map[chainhash.Hash]int32),
+		historyCache: make(map[string][]HistoryItem),
+		ntfnQueue:    queue.NewConcurrentQueue(20),
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start starts the chain client and notifies the wallet that it's connected
+// to the backend.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) Start() error {
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return nil
+	}
+
+	c.ntfnQueue.Start()
+
+	// We subscribe before querying the best block to ensure we don't miss
+	// any block found in between.
+	c.sub = c.Subscribe()
+
+	bestHash, bestHeight, err := c.GetBestBlock()
+	if err != nil {
+		c.sub.Cancel()
+		c.ntfnQueue.Stop()
+		return err
+	}
+	header, err := c.GetBlockHeader(bestHash)
+	if err != nil {
+		c.sub.Cancel()
+		c.ntfnQueue.Stop()
+		return err
+	}
+
+	c.chainMtx.Lock()
+	c.bestBlock = wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{
+			Hash:   *bestHash,
+			Height: bestHeight,
+		},
+		Time: header.Timestamp,
+	}
+	c.blocks[bestHeight] = c.bestBlock
+	c.chainMtx.Unlock()
+
+	c.ntfnQueue.ChanIn() <- chain.ClientConnected{}
+
+	c.wg.Add(1)
+	go c.notificationHandler()
+
+	return nil
+}
+
+// Stop stops the chain client.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) Stop() {
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return
+	}
+
+	close(c.quit)
+	if c.sub != nil {
+		c.sub.Cancel()
+	}
+	c.ntfnQueue.Stop()
+}
+
+// WaitForShutdown blocks until the chain client has stopped.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) WaitForShutdown() {
+	c.wg.Wait()
+}
+
+// GetBlock returns the block with the given hash. As Electrum servers don't
+// serve full blocks, this always fails.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) GetBlock(*chainhash.Hash) (*wire.MsgBlock, error) {
+	return nil, ErrBlocksUnsupported
+}
+
+// IsCurrent returns whether the backend is synced to the tip of the chain,
+// which we assume to be the case if its best block is recent.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) IsCurrent() bool {
+	c.chainMtx.Lock()
+	defer c.chainMtx.Unlock()
+
+	return time.Since(c.bestBlock.Time) < isCurrentDelta
+}
+
+// BlockStamp returns the best block the wallet has been notified about.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) BlockStamp() (*waddrmgr.BlockStamp, error) {
+	c.chainMtx.Lock()
+	defer c.chainMtx.Unlock()
+
+	return &waddrmgr.BlockStamp{
+		Hash:      c.bestBlock.Hash,
+		Height:    c.bestBlock.Height,
+		Timestamp: c.bestBlock.Time,
+	}, nil
+}
+
+// SendRawTransaction broadcasts the transaction to the network.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) SendRawTransaction(tx *wire.MsgTx,
+	_ bool) (*chainhash.Hash, error) {
+
+	return c.Broadcast(tx)
+}
+
+// NotifyBlocks requests notifications for blocks connected to and
+// disconnected from the best chain.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) NotifyBlocks() error {
+	atomic.StoreUint32(&c.notifyBlocks, 1)
+	return nil
+}
+
+// NotifyReceived requests notifications for transactions paying to or
+// spending from the given addresses.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) NotifyReceived(addrs []btcutil.Address) error {
+	pkScripts, err := addrScripts(addrs, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.watchScripts(pkScripts, 0)
+}
+
+// Rescan notifies the wallet about all transactions paying to or spending
+// from the given addresses and outpoints since the block with the given hash,
+// and requests notifications for any future ones. Once done, the wallet is
+// notified with a RescanFinished notification.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) Rescan(startHash *chainhash.Hash,
+	addrs []btcutil.Address,
+	outPoints map[wire.OutPoint]btcutil.Address) error {
+
+	// As the server is only able to look up blocks by their height, we'll
+	// rescan from genesis if we're unaware of the start block.
+	startHeight, err := c.GetBlockHeight(startHash)
+	if err != nil {
+		log.Debugf("Unknown rescan start block %v, rescanning from "+
+			"genesis", startHash)
+		startHeight = 0
+	}
+
+	// Spends of the outpoints show up in the history of the scripts they
+	// pay to, so watching those is enough.
+	pkScripts, err := addrScripts(addrs, outPoints)
+	if err != nil {
+		return err
+	}
+	if err := c.watchScripts(pkScripts, startHeight); err != nil {
+		return err
+	}
+
+	bestBlock, err := c.BlockStamp()
+	if err != nil {
+		return err
+	}
+
+	select {
+	case c.ntfnQueue.ChanIn() <- &chain.RescanFinished{
+		Hash:   &bestBlock.Hash,
+		Height: bestBlock.Height,
+		Time:   bestBlock.Timestamp,
+	}:
+	case <-c.quit:
+		return ErrClientShuttingDown
+	}
+
+	return nil
+}
+
+// FilterBlocks scans the blocks contained in the FilterBlocksRequest for any
+// addresses of interest, and returns a FilterBlocksResponse for the first
+// block containing any. If no matches are found in the range of blocks
+// requested, the returned response will be nil.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) FilterBlocks(
+	req *chain.FilterBlocksRequest) (*chain.FilterBlocksResponse, error) {
+
+	if len(req.Blocks) == 0 {
+		return nil, nil
+	}
+
+	addrs := make(
+		[]btcutil.Address, 0,
+		len(req.ExternalAddrs)+len(req.InternalAddrs),
+	)
+	for _, addr := range req.ExternalAddrs {
+		addrs = append(addrs, addr)
+	}
+	for _, addr := range req.InternalAddrs {
+		addrs = append(addrs, addr)
+	}
+	pkScripts, err := addrScripts(addrs, req.WatchedOutPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll gather the relevant transactions confirmed within the range
+	// of blocks requested from the history of every script.
+	startHeight := req.Blocks[0].Height
+	endHeight := req.Blocks[len(req.Blocks)-1].Height
+	txsByHeight := make(map[int32]map[chainhash.Hash]struct{})
+	for _, pkScript := range pkScripts {
+		history, err := c.cachedHistory(pkScript)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range history {
+			if item.Height < startHeight || item.Height > endHeight {
+				continue
+			}
+
+			txs, ok := txsByHeight[item.Height]
+			if !ok {
+				txs = make(map[chainhash.Hash]struct{})
+				txsByHeight[item.Height] = txs
+			}
+			txs[item.TxHash] = struct{}{}
+		}
+	}
+
+	// We'll then run the first block with any relevant transactions
+	// through the block filterer, populated with these transactions only,
+	// in the order they appear in the block.
+	blockFilterer := chain.NewBlockFilterer(c.chainParams, req)
+	for i, blk := range req.Blocks {
+		txHashes, ok := txsByHeight[blk.Height]
+		if !ok {
+			continue
+		}
+
+		txs, err := c.confirmedTxs(txHashes, &blk)
+		if err != nil {
+			return nil, err
+		}
+
+		block := &wire.MsgBlock{
+			Transactions: make([]*wire.MsgTx, 0, len(txs)),
+		}
+		for _, tx := range txs {
+			block.Transactions = append(block.Transactions, tx.tx)
+		}
+		if !blockFilterer.FilterBlock(block) {
+			continue
+		}
+
+		return &chain.FilterBlocksResponse{
+			BatchIndex:         uint32(i),
+			BlockMeta:          blk,
+			FoundExternalAddrs: blockFilterer.FoundExternal,
+			FoundInternalAddrs: blockFilterer.FoundInternal,
+			FoundOutPoints:     blockFilterer.FoundOutPoints,
+			RelevantTxns:       blockFilterer.RelevantTxns,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// BackEnd returns the name of the backend.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) BackEnd() string {
+	return BackEnd
+}
+
+// Notifications returns the channel over which the wallet is notified about
+// chain events.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) Notifications() <-chan interface{} {
+	return c.ntfnQueue.ChanOut()
+}
+
+// notificationHandler processes the notifications sent by the server.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *ChainClient) notificationHandler() {
+	defer c.wg.Done()
+
+	var (
+		pendingTip *HeaderNotification
+		settled    <-chan time.Time
+	)
+	for {
+		select {
+		case ntfn, ok := <-c.sub.Notifications:
+			if !ok {
+				return
+			}
+
+			switch ntfn := ntfn.(type) {
+			// The wallet only associates the transactions confirmed
+			// within a block with it if they're delivered before
+			// the block itself, so we'll give the server the chance
+			// to notify us of the scripts the new blocks touched
+			// before connecting them.
+			case *HeaderNotification:
+				c.historyMtx.Lock()
+				c.historyCache = make(map[string][]HistoryItem)
+				c.historyMtx.Unlock()
+
+				pendingTip = ntfn
+				if settled == nil {
+					settled = time.After(
+						ScriptHashSettleDelay,
+					)
+				}
+
+			case *ScriptHashNotification:
+				err := c.handleScriptHashNotification(ntfn)
+				if err != nil {
+					log.Errorf("Unable to process status "+
+						"of scripthash %v: %v",
+						ntfn.ScriptHash, err)
+				}
+			}
+
+		case <-settled:
+			settled = nil
+
+			if err := c.syncChain(pendingTip.Height); err != nil {
+				log.Errorf("Unable to sync to block %d: %v",
+					pendingTip.Height, err)
+			}
+			pendingTip = nil
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// syncChain updates our view of the best chain up to the given height. Any
+// blocks that have been reorged out are disconnected first, and all blocks
+// up to the new tip are then connected.
+func (c *ChainClient) syncChain(tipHeight int32) error {
+	c.chainMtx.Lock()
+	defer c.chainMtx.Unlock()
+
+	notify := atomic.LoadUint32(&c.notifyBlocks) == 1
+
+	// We'll start by locating the fork point between our view of the
+	// chain and the server's, assuming blocks we don't know about anymore
+	// can't be reorged out.
+	forkHeight := c.bestBlock.Height
+	if tipHeight < forkHeight {
+		forkHeight = tipHeight
+	}
+	for ; forkHeight > 0; forkHeight-- {
+		blk, ok := c.blocks[forkHeight]
+		if !ok {
+			break
+		}
+
+		hash, err := c.GetBlockHash(int64(forkHeight))
+		if err != nil {
+			return err
+		}
+		if *hash == blk.Hash {
+			break
+		}
+	}
+
+	for height := c.bestBlock.Height; height > forkHeight; height-- {
+		blk, ok := c.blocks[height]
+		if !ok {
+			continue
+		}
+		delete(c.blocks, height)
+
+		log.Debugf("Disconnecting block %d (%v)", height, blk.Hash)
+
+		if notify {
+			c.notify(chain.BlockDisconnected(blk))
+		}
+	}
+	if blk, ok := c.blocks[forkHeight]; ok {
+		c.bestBlock = blk
+	}
+
+	for height := forkHeight + 1; height <= tipHeight; height++ {
+		header, err := c.GetBlockHeaderByHeight(height)
+		if err != nil {
+			return err
+		}
+
+		blk := wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{
+				Hash:   header.BlockHash(),
+				Height: height,
+			},
+			Time: header.Timestamp,
+		}
+		c.bestBlock = blk
+		c.blocks[height] = blk
+		delete(c.blocks, height-2*reorgSafetyDepth)
+
+		if notify {
+			c.notify(chain.BlockConnected(blk))
+		}
+	}
+
+	return nil
+}
+
+// handleScriptHashNotification notifies the wallet about any new
+// transactions paying to or spending from a script whose status changed.
+func (c *ChainClient) handleScriptHashNotification(
+	ntfn *ScriptHashNotification) error {
+
+	c.watchMtx.Lock()
+	defer c.watchMtx.Unlock()
+
+	pkScript, ok := c.watched[ntfn.ScriptHash]
+	if !ok || c.statuses[ntfn.ScriptHash] == ntfn.Status {
+		return nil
+	}
+	c.statuses[ntfn.ScriptHash] = ntfn.Status
+
+	return c.notifyHistory([][]byte{pkScript}, 0)
+}
+
+// watchScripts subscribes to the given scripts and notifies the wallet about
+// any transactions paying to or spending from them, confirmed since the
+// given height or still unconfirmed.
+func (c *ChainClient) watchScripts(pkScripts [][]byte,
+	startHeight int32) error {
+
+	c.watchMtx.Lock()
+	defer c.watchMtx.Unlock()
+
+	for _, pkScript := range pkScripts {
+		scriptHash, status, err := c.SubscribeScriptHash(pkScript)
+		if err != nil {
+			return err
+		}
+
+		c.watched[scriptHash] = pkScript
+		c.statuses[scriptHash] = status
+	}
+
+	return c.notifyHistory(pkScripts, startHeight)
+}
+
+// notifyHistory notifies the wallet about the transactions paying to or
+// spending from the given scripts, confirmed since the given height or still
+// unconfirmed, which it hasn't been notified about yet. Transactions are
+// notified in the order they were confirmed, as the wallet is only able to
+// detect the spend of an output it knows about.
+//
+// NOTE: The watch mutex MUST be held when calling this method.
+func (c *ChainClient) notifyHistory(pkScripts [][]byte,
+	startHeight int32) error {
+
+	unconfirmed := make(map[chainhash.Hash]struct{})
+	confirmed := make(map[int32]map[chainhash.Hash]struct{})
+	for _, pkScript := range pkScripts {
+		history, err := c.ScriptHistory(pkScript)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range history {
+			height := item.Height
+			if height < 0 {
+				height = 0
+			}
+
+			notifiedHeight, ok := c.notifiedTxs[item.TxHash]
+			if ok && notifiedHeight == height {
+				continue
+			}
+
+			switch {
+			case height == 0:
+				unconfirmed[item.TxHash] = struct{}{}
+
+			case height >= startHeight:
+				txs, ok := confirmed[height]
+				if !ok {
+					txs = make(map[chainhash.Hash]struct{})
+					confirmed[height] = txs
+				}
+				txs[item.TxHash] = struct{}{}
+			}
+		}
+	}
+
+	heights := make([]int32, 0, len(confirmed))
+	for height := range confirmed {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool {
+		return heights[i] < heights[j]
+	})
+
+	for _, height := range heights {
+		header, err := c.GetBlockHeaderByHeight(height)
+		if err != nil {
+			return err
+		}
+		blk := &wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{
+				Hash:   header.BlockHash(),
+				Height: height,
+			},
+			Time: header.Timestamp,
+		}
+
+		txs, err := c.confirmedTxs(confirmed[height], blk)
+		if err != nil {
+			return err
+		}
+		for _, tx := range txs {
+			err := c.notifyRelevantTx(tx.tx, blk, blk.Time)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Unconfirmed transactions may depend on each other, so we'll
+	// deliver them in dependency order.
+	unconfirmedTxs := make([]*wire.MsgTx, 0, len(unconfirmed))
+	for txHash := range unconfirmed {
+		txHash := txHash
+		tx, err := c.GetTransaction(&txHash)
+		if err != nil {
+			return err
+		}
+		unconfirmedTxs = append(unconfirmedTxs, tx)
+	}
+	for _, tx := range sortUnconfirmed(unconfirmedTxs) {
+		if err := c.notifyRelevantTx(tx, nil, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// notifyRelevantTx notifies the wallet about a relevant transaction.
+//
+// NOTE: The watch mutex MUST be held when calling this method.
+func (c *ChainClient) notifyRelevantTx(tx *wire.MsgTx,
+	blk *wtxmgr.BlockMeta, received time.Time) error {
+
+	rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, received)
+	if err != nil {
+		return err
+	}
+
+	var height int32
+	if blk != nil {
+		height = blk.Height
+	}
+	c.notifiedTxs[rec.Hash] = height
+
+	c.notify(chain.RelevantTx{
+		TxRecord: rec,
+		Block:    blk,
+	})
+
+	return nil
+}
+
+// notify sends a notification to the wallet.
+func (c *ChainClient) notify(ntfn interface{}) {
+	select {
+	case c.ntfnQueue.ChanIn() <- ntfn:
+	case <-c.quit:
+	}
+}
+
+// positionedTx is a transaction along with its position within the block it
+// was confirmed in.
+type positionedTx struct {
+	tx  *wire.MsgTx
+	pos uint32
+}
+
+// confirmedTxs fetches the given transactions confirmed in the given block,
+// verifying their inclusion, and returns them in the order they appear in
+// the block.
+func (c *ChainClient) confirmedTxs(txHashes map[chainhash.Hash]struct{},
+	blk *wtxmgr.BlockMeta) ([]positionedTx, error) {
+
+	txs := make([]positionedTx, 0, len(txHashes))
+	for txHash := range txHashes {
+		txHash := txHash
+		tx, header, pos, err := c.GetConfirmedTransaction(
+			&txHash, blk.Height,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if header.BlockHash() != blk.Hash {
+			return nil, fmt.Errorf("block %d has been reorged out",
+				blk.Height)
+		}
+
+		txs = append(txs, positionedTx{tx: tx, pos: pos})
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].pos < txs[j].pos
+	})
+
+	return txs, nil
+}
+
+// cachedHistory returns the history of the script, from the cache if
+// possible.
+func (c *ChainClient) cachedHistory(pkScript []byte) ([]HistoryItem, error) {
+	scriptHash := ScriptHash(pkScript)
+
+	c.historyMtx.Lock()
+	history, ok := c.historyCache[scriptHash]
+	c.historyMtx.Unlock()
+	if ok {
+		return history, nil
+	}
+
+	history, err := c.ScriptHistory(pkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	c.historyMtx.Lock()
+	c.historyCache[scriptHash] = history
+	c.historyMtx.Unlock()
+
+	return history, nil
+}
+
+// sortUnconfirmed sorts the transactions such that every transaction comes
+// after the transactions it spends from.
+func sortUnconfirmed(txs []*wire.MsgTx) []*wire.MsgTx {
+	pending := make(map[chainhash.Hash]*wire.MsgTx, len(txs))
+	for _, tx := range txs {
+		pending[tx.TxHash()] = tx
+	}
+
+	sorted := make([]*wire.MsgTx, 0, len(txs))
+	var visit func(tx *wire.MsgTx)
+	visit = func(tx *wire.MsgTx) {
+		txHash := tx.TxHash()
+		if _, ok := pending[txHash]; !ok {
+			return
+		}
+		delete(pending, txHash)
+
+		for _, txIn := range tx.TxIn {
+			parent, ok := pending[txIn.PreviousOutPoint.Hash]
+			if ok {
+				visit(parent)
+			}
+		}
+		sorted = append(sorted, tx)
+	}
+	for _, tx := range txs {
+		visit(tx)
+	}
+
+	return sorted
+}
+
+// addrScripts returns the unique output scripts of the given addresses and
+// the addresses of the given outpoints.
+func addrScripts(addrs []btcutil.Address,
+	outPoints map[wire.OutPoint]btcutil.Address) ([][]byte, error) {
+
+	seen := make(map[string]struct{})
+	pkScripts := make([][]byte, 0, len(addrs)+len(outPoints))
+	addScript := func(addr btcutil.Address) error {
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := seen[string(pkScript)]; ok {
+			return nil
+		}
+		seen[string(pkScript)] = struct{}{}
+		pkScripts = append(pkScripts, pkScript)
+
+		return nil
+	}
+
+	for _, addr := range addrs {
+		if err := addScript(addr); err != nil {
+			return nil, err
+		}
+	}
+	for _, addr := range outPoints {
+		if err := addScript(addr); err != nil {
+			return nil, err
+		}
+	}
+
+	return pkScripts, nil
+}
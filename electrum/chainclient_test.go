@@ -0,0 +1,63 @@
+package electrum
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestSortUnconfirmed ensures unconfirmed transactions are sorted such that
+// every transaction comes after the transactions it spends from.
+func TestSortUnconfirmed(t *testing.T) {
+	t.Parallel()
+
+	// spend creates a transaction spending from the given parents.
+	spend := func(lockTime uint32, parents ...*wire.MsgTx) *wire.MsgTx {
+		tx := wire.NewMsgTx(2)
+		tx.LockTime = lockTime
+		for _, parent := range parents {
+			tx.AddTxIn(&wire.TxIn{
+				PreviousOutPoint: wire.OutPoint{
+					Hash: parent.TxHash(),
+				},
+			})
+		}
+		tx.AddTxOut(&wire.TxOut{Value: 1000})
+
+		return tx
+	}
+
+	// We'll create the following graph of transactions, where an arrow
+	// points from a parent to its child:
+	//
+	//   a -> b -> d
+	//   a -> c -> d
+	//   e
+	a := spend(1)
+	b := spend(2, a)
+	c := spend(3, a)
+	d := spend(4, b, c)
+	e := spend(5)
+
+	sorted := sortUnconfirmed([]*wire.MsgTx{d, e, c, b, a})
+	if len(sorted) != 5 {
+		t.Fatalf("expected 5 transactions, got %d", len(sorted))
+	}
+
+	positions := make(map[*wire.MsgTx]int, len(sorted))
+	for i, tx := range sorted {
+		positions[tx] = i
+	}
+
+	edges := []struct {
+		parent, child *wire.MsgTx
+	}{
+		{a, b}, {a, c}, {b, d}, {c, d},
+	}
+	for _, edge := range edges {
+		if positions[edge.parent] > positions[edge.child] {
+			t.Fatalf("tx %v sorted after its child %v",
+				edge.parent.TxHash(), edge.child.TxHash())
+		}
+	}
+}
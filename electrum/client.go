@@ -0,0 +1,625 @@
+package electrum
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+const (
+	// protocolVersion is the version of the Electrum protocol spoken by
+	// the client.
+	protocolVersion = "1.4"
+
+	// clientName is the name the client identifies itself with to the
+	// server.
+	clientName = "lnd"
+
+	// DefaultRequestTimeout is the default duration we'll wait for the
+	// server to respond to a request.
+	DefaultRequestTimeout = 30 * time.Second
+
+	// pingInterval is the interval at which we'll ping the server to keep
+	// the connection alive, as servers disconnect idle clients.
+	pingInterval = time.Minute
+
+	// minReconnectBackoff is the initial duration we'll wait before
+	// attempting to reconnect to the server after losing the connection.
+	minReconnectBackoff = time.Second
+
+	// maxReconnectBackoff is the maximum duration we'll wait in between
+	// attempts to reconnect to the server.
+	maxReconnectBackoff = time.Minute
+)
+
+var (
+	// ErrClientShuttingDown is returned when a request is made to a
+	// client that is shutting down.
+	ErrClientShuttingDown = errors.New("electrum client shutting down")
+
+	// ErrNotConnected is returned when a request is made while the client
+	// isn't connected to the server.
+	ErrNotConnected = errors.New("not connected to electrum server")
+
+	// ErrRequestTimeout is returned when the server doesn't respond to a
+	// request in time.
+	ErrRequestTimeout = errors.New("electrum request timed out")
+)
+
+// RPCError is an error returned by the Electrum server in response to a
+// request.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error returns a human readable description of the error.
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("electrum server error %d: %s", e.Code, e.Message)
+}
+
+// request is a JSON-RPC request sent to the server.
+type request struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+// response is either a JSON-RPC response to one of our requests, or a
+// notification sent by the server, in which case the ID is nil.
+type response struct {
+	ID     *uint64         `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *RPCError       `json:"error"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// ClientConfig houses the parameters required to connect to an Electrum
+// server.
+type ClientConfig struct {
+	// Server is the host:port of the Electrum server.
+	Server string
+
+	// TLSConfig, if set, is used to connect to the server over TLS.
+	// Otherwise, a plaintext connection is used.
+	TLSConfig *tls.Config
+
+	// Dial is the function used to establish the TCP connection to the
+	// server, which allows connecting through a proxy like Tor.
+	Dial func(network, address string) (net.Conn, error)
+
+	// RequestTimeout is the duration we'll wait for the server to respond
+	// to a request. If zero, DefaultRequestTimeout is used.
+	RequestTimeout time.Duration
+}
+
+// Client is a client of the Electrum protocol. It maintains a single
+// connection to the server, which is re-established if lost. All scripthash
+// subscriptions are restored upon reconnection, and every notification sent
+// by the server is forwarded to all active subscriptions of the client.
+//
+// NOTE: Electrum servers are only able to serve block headers and the
+// transactions related to a script, but no full blocks.
+type Client struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	nextID uint64 // To be used atomically.
+
+	cfg *ClientConfig
+
+	// connMtx guards the connection as well as the requests pending on
+	// it.
+	connMtx sync.Mutex
+	conn    net.Conn
+	pending map[uint64]chan *response
+
+	// subMtx guards the scripthashes subscribed to at the server, and the
+	// subscriptions of the client's users.
+	subMtx        sync.Mutex
+	scriptHashes  map[string]struct{}
+	subscriptions map[uint64]*queue.ConcurrentQueue
+	nextSubID     uint64
+
+	// headers tracks the best chain as advertised by the server.
+	headers *headerCache
+
+	// ntfnQueue queues the notifications sent by the server, such that
+	// they can be processed outside of the goroutine reading from the
+	// connection.
+	ntfnQueue *queue.ConcurrentQueue
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewClient creates a new client of the Electrum server described by the
+// passed config. The connection to the server is established once the client
+// is started.
+func NewClient(cfg *ClientConfig) *Client {
+	if cfg.Dial == nil {
+		cfg.Dial = net.Dial
+	}
+	if cfg.RequestTimeout == 0 {
+		cfg.RequestTimeout = DefaultRequestTimeout
+	}
+
+	c := &Client{
+		cfg:           cfg,
+		pending:       make(map[uint64]chan *response),
+		scriptHashes:  make(map[string]struct{}),
+		subscriptions: make(map[uint64]*queue.ConcurrentQueue),
+		ntfnQueue:     queue.NewConcurrentQueue(20),
+		quit:          make(chan struct{}),
+	}
+	c.headers = newHeaderCache(c)
+
+	return c
+}
+
+// Start connects to the server and launches the goroutines that maintain
+// the connection.
+func (c *Client) Start() error {
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return nil
+	}
+
+	log.Infof("Connecting to electrum server %v", c.cfg.Server)
+
+	c.ntfnQueue.Start()
+
+	disconnected, err := c.connect()
+	if err != nil {
+		c.ntfnQueue.Stop()
+		return fmt.Errorf("unable to connect to electrum server %v: "+
+			"%v", c.cfg.Server, err)
+	}
+
+	c.wg.Add(3)
+	go c.connectionHandler(disconnected)
+	go c.notificationHandler()
+	go c.pinger()
+
+	return nil
+}
+
+// Stop disconnects from the server and stops all goroutines of the client.
+func (c *Client) Stop() error {
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return nil
+	}
+
+	close(c.quit)
+
+	c.connMtx.Lock()
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	c.connMtx.Unlock()
+
+	c.wg.Wait()
+	c.ntfnQueue.Stop()
+
+	c.subMtx.Lock()
+	for id, subscription := range c.subscriptions {
+		subscription.Stop()
+		delete(c.subscriptions, id)
+	}
+	c.subMtx.Unlock()
+
+	return nil
+}
+
+// dial establishes a new connection to the server.
+func (c *Client) dial() (net.Conn, error) {
+	conn, err := c.cfg.Dial("tcp", c.cfg.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.cfg.TLSConfig == nil {
+		return conn, nil
+	}
+
+	tlsConn := tls.Client(conn, c.cfg.TLSConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return tlsConn, nil
+}
+
+// connect establishes a new connection to the server, negotiates the
+// protocol version and restores all subscriptions. The returned channel is
+// closed once the connection is lost.
+func (c *Client) connect() (<-chan struct{}, error) {
+	conn, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+
+	c.connMtx.Lock()
+	c.conn = conn
+	c.connMtx.Unlock()
+
+	disconnected := make(chan struct{})
+	c.wg.Add(1)
+	go c.readHandler(conn, disconnected)
+
+	var version []string
+	err = c.call("server.version", &version, clientName, protocolVersion)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	log.Infof("Connected to electrum server %v running %v", c.cfg.Server,
+		version)
+
+	// We'll subscribe to new headers, which also tells us about the
+	// server's current tip. We pass it on to our subscribers, such that
+	// they're able to catch up with any blocks they may have missed while
+	// we were disconnected.
+	var tip headerResult
+	if err := c.call("blockchain.headers.subscribe", &tip); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	tipNtfn, err := tip.notification()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	c.handleTip(tipNtfn)
+	c.queueNotification(tipNtfn)
+
+	// Finally, we'll restore our scripthash subscriptions. Their current
+	// status is passed on as well, as they may have changed while we were
+	// disconnected.
+	c.subMtx.Lock()
+	scriptHashes := make([]string, 0, len(c.scriptHashes))
+	for scriptHash := range c.scriptHashes {
+		scriptHashes = append(scriptHashes, scriptHash)
+	}
+	c.subMtx.Unlock()
+
+	for _, scriptHash := range scriptHashes {
+		status, err := c.subscribeScriptHash(scriptHash)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		c.queueNotification(&ScriptHashNotification{
+			ScriptHash: scriptHash,
+			Status:     status,
+		})
+	}
+
+	return disconnected, nil
+}
+
+// connectionHandler re-establishes the connection to the server whenever it
+// is lost.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Client) connectionHandler(disconnected <-chan struct{}) {
+	defer c.wg.Done()
+
+	for {
+		select {
+		case <-disconnected:
+		case <-c.quit:
+			return
+		}
+
+		log.Warnf("Lost connection to electrum server %v, reconnecting",
+			c.cfg.Server)
+
+		backoff := minReconnectBackoff
+		for {
+			select {
+			case <-time.After(backoff):
+			case <-c.quit:
+				return
+			}
+
+			var err error
+			disconnected, err = c.connect()
+			if err == nil {
+				break
+			}
+
+			log.Errorf("Unable to reconnect to electrum server "+
+				"%v: %v", c.cfg.Server, err)
+
+			backoff *= 2
+			if backoff > maxReconnectBackoff {
+				backoff = maxReconnectBackoff
+			}
+		}
+	}
+}
+
+// pinger periodically pings the server to keep the connection alive.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Client) pinger() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.call("server.ping", nil); err != nil {
+				log.Debugf("Unable to ping electrum server: %v",
+					err)
+			}
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// readHandler reads all responses and notifications sent by the server over
+// the given connection until it is closed. All requests still pending once
+// the connection is lost fail.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Client) readHandler(conn net.Conn, disconnected chan struct{}) {
+	defer c.wg.Done()
+	defer close(disconnected)
+
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			select {
+			case <-c.quit:
+			default:
+				log.Debugf("Unable to read from electrum "+
+					"server: %v", err)
+			}
+			break
+		}
+
+		var resp response
+		if err := json.Unmarshal(line, &resp); err != nil {
+			log.Errorf("Unable to parse message from electrum "+
+				"server: %v", err)
+			continue
+		}
+
+		// Messages without an ID are notifications.
+		if resp.ID == nil {
+			c.handleNotification(&resp)
+			continue
+		}
+
+		c.connMtx.Lock()
+		respChan, ok := c.pending[*resp.ID]
+		delete(c.pending, *resp.ID)
+		c.connMtx.Unlock()
+
+		if ok {
+			respChan <- &resp
+		}
+	}
+
+	conn.Close()
+
+	c.connMtx.Lock()
+	if c.conn == conn {
+		c.conn = nil
+	}
+	for id, respChan := range c.pending {
+		close(respChan)
+		delete(c.pending, id)
+	}
+	c.connMtx.Unlock()
+}
+
+// handleNotification parses a notification sent by the server and queues it
+// for processing.
+func (c *Client) handleNotification(resp *response) {
+	switch resp.Method {
+	case "blockchain.headers.subscribe":
+		var params []headerResult
+		err := json.Unmarshal(resp.Params, &params)
+		if err != nil || len(params) != 1 {
+			log.Errorf("Invalid header notification: %s",
+				resp.Params)
+			return
+		}
+
+		ntfn, err := params[0].notification()
+		if err != nil {
+			log.Errorf("Invalid header notification: %v", err)
+			return
+		}
+
+		log.Debugf("New tip at height %d: %v", ntfn.Height,
+			ntfn.Header.BlockHash())
+
+		c.queueNotification(ntfn)
+
+	case "blockchain.scripthash.subscribe":
+		var params []*string
+		err := json.Unmarshal(resp.Params, &params)
+		if err != nil || len(params) != 2 || params[0] == nil {
+			log.Errorf("Invalid scripthash notification: %s",
+				resp.Params)
+			return
+		}
+
+		ntfn := &ScriptHashNotification{ScriptHash: *params[0]}
+		if params[1] != nil {
+			ntfn.Status = *params[1]
+		}
+		c.queueNotification(ntfn)
+
+	default:
+		log.Debugf("Ignoring unknown notification %v", resp.Method)
+	}
+}
+
+// call sends a request for the given method to the server and waits for its
+// response. If result is non-nil, the result of the request is decoded into
+// it.
+func (c *Client) call(method string, result interface{},
+	params ...interface{}) error {
+
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	id := atomic.AddUint64(&c.nextID, 1)
+	req, err := json.Marshal(&request{
+		JSONRPC: "2.0",
+		ID:      id,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+	req = append(req, '\n')
+
+	respChan := make(chan *response, 1)
+
+	c.connMtx.Lock()
+	if c.conn == nil {
+		c.connMtx.Unlock()
+		return ErrNotConnected
+	}
+	c.pending[id] = respChan
+
+	err = c.conn.SetWriteDeadline(time.Now().Add(c.cfg.RequestTimeout))
+	if err == nil {
+		_, err = c.conn.Write(req)
+	}
+	if err != nil {
+		delete(c.pending, id)
+		c.connMtx.Unlock()
+		return err
+	}
+	c.connMtx.Unlock()
+
+	select {
+	case resp, ok := <-respChan:
+		if !ok {
+			return ErrNotConnected
+		}
+		if resp.Error != nil {
+			return resp.Error
+		}
+		if result == nil {
+			return nil
+		}
+
+		return json.Unmarshal(resp.Result, result)
+
+	case <-time.After(c.cfg.RequestTimeout):
+		c.connMtx.Lock()
+		delete(c.pending, id)
+		c.connMtx.Unlock()
+
+		return ErrRequestTimeout
+
+	case <-c.quit:
+		return ErrClientShuttingDown
+	}
+}
+
+// Subscription is a subscription to the notifications sent by the server.
+type Subscription struct {
+	// Notifications receives every *HeaderNotification and
+	// *ScriptHashNotification sent by the server.
+	Notifications <-chan interface{}
+
+	// Cancel cancels the subscription.
+	Cancel func()
+}
+
+// Subscribe returns a new subscription to the notifications sent by the
+// server. Notifications about scripthashes are sent for any scripthash
+// subscribed to through SubscribeScriptHash.
+func (c *Client) Subscribe() *Subscription {
+	ntfnQueue := queue.NewConcurrentQueue(20)
+	ntfnQueue.Start()
+
+	c.subMtx.Lock()
+	id := c.nextSubID
+	c.nextSubID++
+	c.subscriptions[id] = ntfnQueue
+	c.subMtx.Unlock()
+
+	return &Subscription{
+		Notifications: ntfnQueue.ChanOut(),
+		Cancel: func() {
+			c.subMtx.Lock()
+			delete(c.subscriptions, id)
+			c.subMtx.Unlock()
+
+			ntfnQueue.Stop()
+		},
+	}
+}
+
+// queueNotification queues a notification to be processed by the
+// notificationHandler.
+func (c *Client) queueNotification(ntfn interface{}) {
+	select {
+	case c.ntfnQueue.ChanIn() <- ntfn:
+	case <-c.quit:
+	}
+}
+
+// notificationHandler processes the queued notifications, and forwards them
+// to all subscriptions of the client.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Client) notificationHandler() {
+	defer c.wg.Done()
+
+	for {
+		select {
+		case ntfn := <-c.ntfnQueue.ChanOut():
+			if tip, ok := ntfn.(*HeaderNotification); ok {
+				c.handleTip(tip)
+			}
+
+			c.notifySubscribers(ntfn)
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// notifySubscribers forwards the notification to all subscriptions.
+func (c *Client) notifySubscribers(ntfn interface{}) {
+	c.subMtx.Lock()
+	defer c.subMtx.Unlock()
+
+	for _, subscription := range c.subscriptions {
+		select {
+		case subscription.ChanIn() <- ntfn:
+		case <-c.quit:
+			return
+		}
+	}
+}
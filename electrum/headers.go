@@ -0,0 +1,369 @@
+package electrum
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// reorgSafetyDepth is the depth below the tip at which we consider
+	// blocks final, allowing us to cache the hashes of the blocks at these
+	// heights.
+	reorgSafetyDepth = 100
+
+	// maxHeadersPerRequest is the maximum number of headers we'll request
+	// from the server at once.
+	maxHeadersPerRequest = 2016
+
+	// ScriptHashSettleDelay is the duration subscribers should wait after
+	// being notified of a new tip before processing it, if they rely on
+	// scripthash notifications to learn about the transactions within the
+	// new blocks. Electrum servers announce a new tip before notifying us
+	// of the scripthashes it touched.
+	ScriptHashSettleDelay = 500 * time.Millisecond
+)
+
+// ErrUnknownBlock is returned when querying a block the client hasn't seen
+// yet. Electrum servers are only able to look up blocks by their height.
+var ErrUnknownBlock = errors.New("unknown block")
+
+// HeaderNotification is sent to subscribers whenever the server announces a
+// new tip. It is also sent after the connection to the server is
+// re-established.
+//
+// NOTE: The server may skip intermediate blocks, e.g. when multiple blocks
+// are found at once or after a reorg, so subscribers must verify the new tip
+// connects to the last block they've seen.
+type HeaderNotification struct {
+	// Height is the height of the new tip.
+	Height int32
+
+	// Header is the header of the new tip.
+	Header *wire.BlockHeader
+}
+
+// headerResult is the header representation used by the Electrum protocol.
+type headerResult struct {
+	Height int32  `json:"height"`
+	Hex    string `json:"hex"`
+}
+
+// notification parses the header into a HeaderNotification.
+func (h *headerResult) notification() (*HeaderNotification, error) {
+	header, err := parseHeader(h.Hex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HeaderNotification{
+		Height: h.Height,
+		Header: header,
+	}, nil
+}
+
+// parseHeader parses a hex encoded block header.
+func parseHeader(headerHex string) (*wire.BlockHeader, error) {
+	rawHeader, err := hex.DecodeString(headerHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(rawHeader)); err != nil {
+		return nil, err
+	}
+
+	return &header, nil
+}
+
+// headerEntry is a header cached along with its height.
+type headerEntry struct {
+	header wire.BlockHeader
+	height int32
+}
+
+// headerCache keeps track of the best chain advertised by the server, along
+// with the headers of all blocks we've seen, such that they can be queried by
+// their hash.
+type headerCache struct {
+	client *Client
+
+	mtx sync.RWMutex
+
+	// tip is the best block advertised by the server.
+	tip *HeaderNotification
+
+	// byHash contains the headers of all blocks we've seen. As a block's
+	// header commits to its hash, entries never go stale.
+	byHash map[chainhash.Hash]*headerEntry
+
+	// byHeight contains the hashes of the blocks deep enough in the chain
+	// to not be reorged out anymore.
+	byHeight map[int32]chainhash.Hash
+}
+
+// newHeaderCache creates a new header cache which fetches headers through
+// the given client.
+func newHeaderCache(client *Client) *headerCache {
+	return &headerCache{
+		client:   client,
+		byHash:   make(map[chainhash.Hash]*headerEntry),
+		byHeight: make(map[int32]chainhash.Hash),
+	}
+}
+
+// setTip updates the best block advertised by the server.
+func (h *headerCache) setTip(tip *HeaderNotification) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.tip = tip
+	h.add(tip.Header, tip.Height)
+}
+
+// add caches the header found at the given height.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (h *headerCache) add(header *wire.BlockHeader, height int32) {
+	hash := header.BlockHash()
+	h.byHash[hash] = &headerEntry{
+		header: *header,
+		height: height,
+	}
+
+	if h.tip != nil && height <= h.tip.Height-reorgSafetyDepth {
+		h.byHeight[height] = hash
+	}
+}
+
+// bestBlock returns the best block advertised by the server.
+func (h *headerCache) bestBlock() (*chainhash.Hash, int32, error) {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+
+	if h.tip == nil {
+		return nil, 0, ErrNotConnected
+	}
+
+	hash := h.tip.Header.BlockHash()
+	return &hash, h.tip.Height, nil
+}
+
+// headerByHeight returns the header of the block found at the given height
+// within the best chain.
+func (h *headerCache) headerByHeight(height int32) (*wire.BlockHeader,
+	error) {
+
+	h.mtx.RLock()
+	if hash, ok := h.byHeight[height]; ok {
+		header := h.byHash[hash].header
+		h.mtx.RUnlock()
+		return &header, nil
+	}
+	tip := h.tip
+	h.mtx.RUnlock()
+
+	// If the block is deep enough in the chain for its hash to be cached,
+	// we'll fetch the headers following it as well, as it's likely that
+	// the caller is syncing the chain.
+	if tip != nil && height <= tip.Height-reorgSafetyDepth {
+		count := tip.Height - reorgSafetyDepth - height + 1
+		if count > maxHeadersPerRequest {
+			count = maxHeadersPerRequest
+		}
+
+		headers, err := h.client.blockHeaders(height, count)
+		if err != nil {
+			return nil, err
+		}
+
+		h.mtx.Lock()
+		for i, header := range headers {
+			h.add(header, height+int32(i))
+		}
+		h.mtx.Unlock()
+
+		return headers[0], nil
+	}
+
+	var headerHex string
+	err := h.client.call("blockchain.block.header", &headerHex, height)
+	if err != nil {
+		return nil, err
+	}
+	header, err := parseHeader(headerHex)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mtx.Lock()
+	h.add(header, height)
+	h.mtx.Unlock()
+
+	return header, nil
+}
+
+// headerByHash returns the header of a block the cache has seen, along with
+// its height.
+func (h *headerCache) headerByHash(hash *chainhash.Hash) (*wire.BlockHeader,
+	int32, error) {
+
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+
+	entry, ok := h.byHash[*hash]
+	if !ok {
+		return nil, 0, ErrUnknownBlock
+	}
+
+	header := entry.header
+	return &header, entry.height, nil
+}
+
+// handleTip updates our tip to the one announced by the server. If the server
+// skipped any blocks since its previous tip, we'll fetch their headers as
+// well, such that we're able to look them up by their hash even if they're
+// reorged out later on.
+func (c *Client) handleTip(tip *HeaderNotification) {
+	_, prevHeight, err := c.headers.bestBlock()
+	if err == nil && tip.Height > prevHeight+1 {
+		startHeight := prevHeight + 1
+		if tip.Height-startHeight > maxHeadersPerRequest {
+			startHeight = tip.Height - maxHeadersPerRequest
+		}
+
+		headers, err := c.blockHeaders(
+			startHeight, tip.Height-startHeight,
+		)
+		if err != nil {
+			log.Debugf("Unable to fetch headers of blocks %d-%d: "+
+				"%v", startHeight, tip.Height-1, err)
+		}
+
+		c.headers.mtx.Lock()
+		for i, header := range headers {
+			c.headers.add(header, startHeight+int32(i))
+		}
+		c.headers.mtx.Unlock()
+	}
+
+	c.headers.setTip(tip)
+}
+
+// blockHeaders fetches count consecutive headers starting at the given
+// height.
+func (c *Client) blockHeaders(startHeight, count int32) ([]*wire.BlockHeader,
+	error) {
+
+	var result struct {
+		Count int32  `json:"count"`
+		Hex   string `json:"hex"`
+	}
+	err := c.call("blockchain.block.headers", &result, startHeight, count)
+	if err != nil {
+		return nil, err
+	}
+
+	rawHeaders, err := hex.DecodeString(result.Hex)
+	if err != nil {
+		return nil, err
+	}
+	if result.Count == 0 ||
+		len(rawHeaders) != int(result.Count)*wire.MaxBlockHeaderPayload {
+
+		return nil, fmt.Errorf("invalid headers response for height "+
+			"%d", startHeight)
+	}
+
+	headers := make([]*wire.BlockHeader, result.Count)
+	reader := bytes.NewReader(rawHeaders)
+	for i := range headers {
+		var header wire.BlockHeader
+		if err := header.Deserialize(reader); err != nil {
+			return nil, err
+		}
+		headers[i] = &header
+	}
+
+	return headers, nil
+}
+
+// GetBestBlock returns the hash and height of the best block advertised by
+// the server.
+func (c *Client) GetBestBlock() (*chainhash.Hash, int32, error) {
+	return c.headers.bestBlock()
+}
+
+// GetBlockHash returns the hash of the block found at the given height within
+// the best chain.
+func (c *Client) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	header, err := c.headers.headerByHeight(int32(height))
+	if err != nil {
+		return nil, err
+	}
+
+	hash := header.BlockHash()
+	return &hash, nil
+}
+
+// GetBlockHeaderByHeight returns the header of the block found at the given
+// height within the best chain.
+func (c *Client) GetBlockHeaderByHeight(height int32) (*wire.BlockHeader,
+	error) {
+
+	return c.headers.headerByHeight(height)
+}
+
+// GetBlockHeader returns the header of the block with the given hash.
+//
+// NOTE: As Electrum servers only serve headers by height, only the headers of
+// blocks the client has seen before can be returned.
+func (c *Client) GetBlockHeader(hash *chainhash.Hash) (*wire.BlockHeader,
+	error) {
+
+	header, _, err := c.headers.headerByHash(hash)
+	return header, err
+}
+
+// GetBlockHeight returns the height of the block with the given hash.
+//
+// NOTE: As Electrum servers only serve headers by height, only the heights of
+// blocks the client has seen before can be returned.
+func (c *Client) GetBlockHeight(hash *chainhash.Hash) (int32, error) {
+	_, height, err := c.headers.headerByHash(hash)
+	return height, err
+}
+
+// GetBlockHeaderVerbose returns the verbose header of the block with the
+// given hash. Only the fields that can be derived from the header itself and
+// its height are populated.
+//
+// NOTE: As Electrum servers only serve headers by height, only the headers of
+// blocks the client has seen before can be returned.
+func (c *Client) GetBlockHeaderVerbose(hash *chainhash.Hash) (
+	*btcjson.GetBlockHeaderVerboseResult, error) {
+
+	header, height, err := c.headers.headerByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &btcjson.GetBlockHeaderVerboseResult{
+		Hash:         hash.String(),
+		Height:       height,
+		Version:      header.Version,
+		MerkleRoot:   header.MerkleRoot.String(),
+		PreviousHash: header.PrevBlock.String(),
+		Nonce:        uint64(header.Nonce),
+		Time:         header.Timestamp.Unix(),
+		Bits:         fmt.Sprintf("%08x", header.Bits),
+	}, nil
+}
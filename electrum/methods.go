@@ -0,0 +1,376 @@
+package electrum
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// ErrInvalidMerkleProof is returned when the merkle proof served for a
+// transaction doesn't commit to the header of the block it's claimed to be
+// included in.
+var ErrInvalidMerkleProof = errors.New("invalid merkle proof")
+
+// ScriptHashNotification is sent to subscribers whenever the status of a
+// subscribed scripthash changes, i.e. a transaction paying to or spending
+// from the script has entered the mempool or has been confirmed. It is also
+// sent after the connection to the server is re-established.
+type ScriptHashNotification struct {
+	// ScriptHash is the scripthash whose status changed.
+	ScriptHash string
+
+	// Status is the new status of the scripthash, or the empty string if
+	// the script has no history.
+	Status string
+}
+
+// HistoryItem is a transaction within the history of a script.
+type HistoryItem struct {
+	// TxHash is the hash of the transaction.
+	TxHash chainhash.Hash
+
+	// Height is the height of the block the transaction was confirmed
+	// in. Unconfirmed transactions have a height of 0, or -1 if any of
+	// their inputs is unconfirmed.
+	Height int32
+}
+
+// historyResult is the history representation used by the Electrum
+// protocol.
+type historyResult struct {
+	TxHash string `json:"tx_hash"`
+	Height int32  `json:"height"`
+}
+
+// UnspentOutput is an unspent output paying to a script.
+type UnspentOutput struct {
+	// OutPoint is the outpoint of the output.
+	OutPoint wire.OutPoint
+
+	// Value is the value of the output.
+	Value btcutil.Amount
+
+	// Height is the height of the block the output was confirmed in, or
+	// 0 if it is unconfirmed.
+	Height int32
+}
+
+// MerkleProof proves the inclusion of a transaction in a block.
+type MerkleProof struct {
+	// BlockHeight is the height of the block the transaction is included
+	// in.
+	BlockHeight int32
+
+	// Pos is the position of the transaction within the block.
+	Pos uint32
+
+	// Merkle is the merkle branch of the transaction, starting from the
+	// leaves.
+	Merkle []chainhash.Hash
+}
+
+// ScriptHash returns the scripthash used by the Electrum protocol to refer to
+// the given output script: the hex encoded SHA256 hash of the script, in
+// reverse byte order.
+func ScriptHash(pkScript []byte) string {
+	hash := sha256.Sum256(pkScript)
+	for i, j := 0, len(hash)-1; i < j; i, j = i+1, j-1 {
+		hash[i], hash[j] = hash[j], hash[i]
+	}
+
+	return hex.EncodeToString(hash[:])
+}
+
+// subscribeScriptHash subscribes to the status of a scripthash at the server
+// and returns its current status.
+func (c *Client) subscribeScriptHash(scriptHash string) (string, error) {
+	var status *string
+	err := c.call("blockchain.scripthash.subscribe", &status, scriptHash)
+	if err != nil {
+		return "", err
+	}
+	if status == nil {
+		return "", nil
+	}
+
+	return *status, nil
+}
+
+// SubscribeScriptHash subscribes to changes of the status of the given
+// output script, which will be sent to all subscriptions of the client as
+// *ScriptHashNotification. The scripthash and its current status are
+// returned.
+func (c *Client) SubscribeScriptHash(pkScript []byte) (string, string,
+	error) {
+
+	scriptHash := ScriptHash(pkScript)
+
+	c.subMtx.Lock()
+	_, ok := c.scriptHashes[scriptHash]
+	c.scriptHashes[scriptHash] = struct{}{}
+	c.subMtx.Unlock()
+
+	status, err := c.subscribeScriptHash(scriptHash)
+	if err != nil {
+		if !ok {
+			c.subMtx.Lock()
+			delete(c.scriptHashes, scriptHash)
+			c.subMtx.Unlock()
+		}
+
+		return "", "", err
+	}
+
+	return scriptHash, status, nil
+}
+
+// ScriptHistory returns all confirmed and unconfirmed transactions paying to
+// or spending from the given output script.
+func (c *Client) ScriptHistory(pkScript []byte) ([]HistoryItem, error) {
+	var result []historyResult
+	err := c.call(
+		"blockchain.scripthash.get_history", &result,
+		ScriptHash(pkScript),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]HistoryItem, 0, len(result))
+	for _, item := range result {
+		txHash, err := chainhash.NewHashFromStr(item.TxHash)
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, HistoryItem{
+			TxHash: *txHash,
+			Height: item.Height,
+		})
+	}
+
+	return history, nil
+}
+
+// ListUnspent returns all unspent outputs paying to the given output script.
+func (c *Client) ListUnspent(pkScript []byte) ([]UnspentOutput, error) {
+	var result []struct {
+		TxHash string `json:"tx_hash"`
+		TxPos  uint32 `json:"tx_pos"`
+		Height int32  `json:"height"`
+		Value  int64  `json:"value"`
+	}
+	err := c.call(
+		"blockchain.scripthash.listunspent", &result,
+		ScriptHash(pkScript),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make([]UnspentOutput, 0, len(result))
+	for _, item := range result {
+		txHash, err := chainhash.NewHashFromStr(item.TxHash)
+		if err != nil {
+			return nil, err
+		}
+
+		utxos = append(utxos, UnspentOutput{
+			OutPoint: wire.OutPoint{
+				Hash:  *txHash,
+				Index: item.TxPos,
+			},
+			Value:  btcutil.Amount(item.Value),
+			Height: item.Height,
+		})
+	}
+
+	return utxos, nil
+}
+
+// GetTransaction returns the transaction with the given hash.
+func (c *Client) GetTransaction(txHash *chainhash.Hash) (*wire.MsgTx, error) {
+	var txHex string
+	err := c.call("blockchain.transaction.get", &txHex, txHash.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTx(txHex)
+}
+
+// GetMerkle returns the merkle proof of the inclusion of the transaction in
+// the block found at the given height.
+func (c *Client) GetMerkle(txHash *chainhash.Hash,
+	height int32) (*MerkleProof, error) {
+
+	var result struct {
+		BlockHeight int32    `json:"block_height"`
+		Pos         uint32   `json:"pos"`
+		Merkle      []string `json:"merkle"`
+	}
+	err := c.call(
+		"blockchain.transaction.get_merkle", &result, txHash.String(),
+		height,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &MerkleProof{
+		BlockHeight: result.BlockHeight,
+		Pos:         result.Pos,
+		Merkle:      make([]chainhash.Hash, 0, len(result.Merkle)),
+	}
+	for _, node := range result.Merkle {
+		hash, err := chainhash.NewHashFromStr(node)
+		if err != nil {
+			return nil, err
+		}
+		proof.Merkle = append(proof.Merkle, *hash)
+	}
+
+	return proof, nil
+}
+
+// VerifyMerkleProof verifies that the merkle proof commits the transaction to
+// the given block header.
+func VerifyMerkleProof(txHash *chainhash.Hash, proof *MerkleProof,
+	header *wire.BlockHeader) error {
+
+	var buf [chainhash.HashSize * 2]byte
+
+	root := *txHash
+	pos := proof.Pos
+	for _, node := range proof.Merkle {
+		if pos&1 == 1 {
+			copy(buf[:chainhash.HashSize], node[:])
+			copy(buf[chainhash.HashSize:], root[:])
+		} else {
+			copy(buf[:chainhash.HashSize], root[:])
+			copy(buf[chainhash.HashSize:], node[:])
+		}
+		root = chainhash.DoubleHashH(buf[:])
+		pos >>= 1
+	}
+
+	if pos != 0 || root != header.MerkleRoot {
+		return ErrInvalidMerkleProof
+	}
+
+	return nil
+}
+
+// GetConfirmedTransaction returns the transaction with the given hash, which
+// is confirmed in the block found at the given height within the best chain,
+// along with the header of the block and the position of the transaction
+// within it. The inclusion of the transaction in the block is verified.
+func (c *Client) GetConfirmedTransaction(txHash *chainhash.Hash,
+	height int32) (*wire.MsgTx, *wire.BlockHeader, uint32, error) {
+
+	proof, err := c.GetMerkle(txHash, height)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	header, err := c.headers.headerByHeight(height)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if err := VerifyMerkleProof(txHash, proof, header); err != nil {
+		return nil, nil, 0, err
+	}
+
+	tx, err := c.GetTransaction(txHash)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if tx.TxHash() != *txHash {
+		return nil, nil, 0, fmt.Errorf("server returned transaction "+
+			"%v instead of %v", tx.TxHash(), txHash)
+	}
+
+	return tx, header, proof.Pos, nil
+}
+
+// TxIDFromPos returns the hash of the transaction found at the given
+// position within the block at the given height.
+func (c *Client) TxIDFromPos(height int32, pos uint32) (*chainhash.Hash,
+	error) {
+
+	var txid string
+	err := c.call(
+		"blockchain.transaction.id_from_pos", &txid, height, pos,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return chainhash.NewHashFromStr(txid)
+}
+
+// Broadcast broadcasts the transaction to the network and returns its hash.
+func (c *Client) Broadcast(tx *wire.MsgTx) (*chainhash.Hash, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	var txid string
+	err := c.call(
+		"blockchain.transaction.broadcast", &txid,
+		hex.EncodeToString(buf.Bytes()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return chainhash.NewHashFromStr(txid)
+}
+
+// EstimateFee returns the fee rate in satoshis per kilobyte required for a
+// transaction to confirm within the given number of blocks. A negative fee
+// rate is returned if the server is unable to estimate the fee.
+func (c *Client) EstimateFee(numBlocks uint32) (btcutil.Amount, error) {
+	var feeRate float64
+	err := c.call("blockchain.estimatefee", &feeRate, numBlocks)
+	if err != nil {
+		return 0, err
+	}
+	if feeRate < 0 {
+		return -1, nil
+	}
+
+	return btcutil.NewAmount(feeRate)
+}
+
+// RelayFee returns the minimum fee rate in satoshis per kilobyte accepted by
+// the server for a transaction to be relayed.
+func (c *Client) RelayFee() (btcutil.Amount, error) {
+	var feeRate float64
+	if err := c.call("blockchain.relayfee", &feeRate); err != nil {
+		return 0, err
+	}
+
+	return btcutil.NewAmount(feeRate)
+}
+
+// parseTx parses a hex encoded transaction.
+func parseTx(txHex string) (*wire.MsgTx, error) {
+	rawTx, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(rawTx)); err != nil {
+		return nil, err
+	}
+
+	return &tx, nil
+}
@@ -0,0 +1,104 @@
+package electrum
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// TestScriptHash ensures we derive the scripthash of an output script as
+// specified by the Electrum protocol.
+func TestScriptHash(t *testing.T) {
+	t.Parallel()
+
+	// This is the example given by the protocol's documentation, for the
+	// P2PKH output script of 1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa.
+	pkScript, err := hex.DecodeString(
+		"76a91462e907b15cbf27d5425399ebf6f0fb50ebb88f1888ac",
+	)
+	if err != nil {
+		t.Fatalf("unable to decode script: %v", err)
+	}
+
+	const expected = "8b01df4e368ea28f8dc0423bcf7a4923e3a12d307c875e" +
+		"47a0cfbf90b5c39161"
+	if scriptHash := ScriptHash(pkScript); scriptHash != expected {
+		t.Fatalf("expected scripthash %v, got %v", expected,
+			scriptHash)
+	}
+}
+
+// TestVerifyMerkleProof ensures merkle proofs are only accepted if they
+// commit the transaction to the block at the claimed position.
+func TestVerifyMerkleProof(t *testing.T) {
+	t.Parallel()
+
+	// We'll create a block with an odd number of transactions, such that
+	// the last one is paired with itself.
+	const numTxs = 5
+	txs := make([]*btcutil.Tx, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		tx := wire.NewMsgTx(2)
+		tx.LockTime = uint32(i)
+		txs = append(txs, btcutil.NewTx(tx))
+	}
+
+	store := blockchain.BuildMerkleTreeStore(txs, false)
+	header := &wire.BlockHeader{
+		MerkleRoot: *store[len(store)-1],
+	}
+
+	// merkleBranch extracts the branch of the transaction at the given
+	// position from the merkle tree.
+	merkleBranch := func(pos uint32) []chainhash.Hash {
+		var (
+			branch []chainhash.Hash
+			offset uint32
+		)
+		width := uint32(len(store)+1) / 2
+		for ; width > 1; width /= 2 {
+			sibling := store[offset+pos^1]
+			if sibling == nil {
+				sibling = store[offset+pos]
+			}
+			branch = append(branch, *sibling)
+
+			offset += width
+			pos /= 2
+		}
+
+		return branch
+	}
+
+	for i, tx := range txs {
+		pos := uint32(i)
+		proof := &MerkleProof{
+			Pos:    pos,
+			Merkle: merkleBranch(pos),
+		}
+
+		if err := VerifyMerkleProof(tx.Hash(), proof, header); err != nil {
+			t.Fatalf("unable to verify proof of tx %d: %v", i, err)
+		}
+
+		// The proof shouldn't be valid for any other transaction.
+		otherTx := txs[(i+1)%numTxs].Hash()
+		err := VerifyMerkleProof(otherTx, proof, header)
+		if err != ErrInvalidMerkleProof {
+			t.Fatalf("expected ErrInvalidMerkleProof for other "+
+				"tx, got %v", err)
+		}
+
+		// Neither should it be valid for another position.
+		proof.Pos = (pos + 2) % numTxs
+		err = VerifyMerkleProof(tx.Hash(), proof, header)
+		if err != ErrInvalidMerkleProof {
+			t.Fatalf("expected ErrInvalidMerkleProof for other "+
+				"position, got %v", err)
+		}
+	}
+}
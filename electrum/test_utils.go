@@ -0,0 +1,747 @@
+// +build dev
+
+package electrum
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// testServerPollInterval is the interval at which the test server
+	// polls its backing node for new blocks and mempool transactions.
+	testServerPollInterval = 50 * time.Millisecond
+
+	// TestServerFeeRate is the fee rate in BTC/kB returned by the test
+	// server for every fee estimate.
+	TestServerFeeRate = 0.0005
+
+	// TestServerRelayFee is the relay fee rate in BTC/kB returned by the
+	// test server.
+	TestServerRelayFee = 0.00001
+)
+
+// testBlock is a block of the chain indexed by the test server.
+type testBlock struct {
+	hash   chainhash.Hash
+	header wire.BlockHeader
+	txs    []*wire.MsgTx
+}
+
+// testTx is a transaction indexed by the test server, along with the height
+// of the block it was confirmed in, or 0 if it's unconfirmed.
+type testTx struct {
+	tx     *wire.MsgTx
+	height int32
+	pos    int
+}
+
+// testSession is a client connection to the test server.
+type testSession struct {
+	conn net.Conn
+
+	writeMtx sync.Mutex
+
+	// The following fields are guarded by the server's mutex.
+	headers      bool
+	scriptHashes map[string]string
+}
+
+// TestServer is a minimal Electrum server backed by a btcd node, meant to be
+// used as a stand-in for a real Electrum server within tests. It indexes the
+// chain and mempool of the node by polling it, and supports the subset of
+// the protocol used by the Electrum client.
+type TestServer struct {
+	node     *rpcclient.Client
+	listener net.Listener
+
+	mtx      sync.Mutex
+	chain    []*testBlock
+	txs      map[chainhash.Hash]*testTx
+	history  map[string][]*testTx
+	sessions map[*testSession]struct{}
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewTestServer creates and starts a new test server backed by the btcd node
+// reachable with the given RPC config. The server listens on a random local
+// port, which can be retrieved through Addr.
+func NewTestServer(rpcConfig *rpcclient.ConnConfig) (*TestServer, error) {
+	connConfig := *rpcConfig
+	connConfig.DisableConnectOnNew = false
+	node, err := rpcclient.New(&connConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		node.Shutdown()
+		return nil, err
+	}
+
+	s := &TestServer{
+		node:     node,
+		listener: listener,
+		txs:      make(map[chainhash.Hash]*testTx),
+		history:  make(map[string][]*testTx),
+		sessions: make(map[*testSession]struct{}),
+		quit:     make(chan struct{}),
+	}
+
+	if err := s.sync(); err != nil {
+		s.Stop()
+		return nil, err
+	}
+
+	s.wg.Add(2)
+	go s.acceptConnections()
+	go s.pollNode()
+
+	return s, nil
+}
+
+// Addr returns the address the test server is listening on.
+func (s *TestServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Stop stops the test server and closes all client connections.
+func (s *TestServer) Stop() {
+	close(s.quit)
+	s.listener.Close()
+
+	s.mtx.Lock()
+	for session := range s.sessions {
+		session.conn.Close()
+	}
+	s.mtx.Unlock()
+
+	s.wg.Wait()
+	s.node.Shutdown()
+}
+
+// DisconnectClients closes all client connections, allowing tests to
+// exercise the reconnection logic of clients.
+func (s *TestServer) DisconnectClients() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for session := range s.sessions {
+		session.conn.Close()
+	}
+}
+
+// acceptConnections accepts new client connections.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *TestServer) acceptConnections() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		session := &testSession{
+			conn:         conn,
+			scriptHashes: make(map[string]string),
+		}
+
+		s.mtx.Lock()
+		s.sessions[session] = struct{}{}
+		s.mtx.Unlock()
+
+		s.wg.Add(1)
+		go s.handleSession(session)
+	}
+}
+
+// pollNode periodically syncs the index with the backing node.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *TestServer) pollNode() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(testServerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.sync(); err != nil {
+				log.Errorf("Unable to sync test server: %v", err)
+			}
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// sync brings the index up to date with the chain and mempool of the backing
+// node, and notifies subscribed clients of any changes.
+func (s *TestServer) sync() error {
+	_, bestHeight, err := s.node.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// Locate the fork point between our chain and the node's, and fetch
+	// all blocks following it.
+	forkHeight := int32(len(s.chain)) - 1
+	if forkHeight > bestHeight {
+		forkHeight = bestHeight
+	}
+	for ; forkHeight >= 0; forkHeight-- {
+		hash, err := s.node.GetBlockHash(int64(forkHeight))
+		if err != nil {
+			return err
+		}
+		if *hash == s.chain[forkHeight].hash {
+			break
+		}
+	}
+
+	var newBlocks []*testBlock
+	for height := forkHeight + 1; height <= bestHeight; height++ {
+		hash, err := s.node.GetBlockHash(int64(height))
+		if err != nil {
+			return err
+		}
+		block, err := s.node.GetBlock(hash)
+		if err != nil {
+			return err
+		}
+
+		newBlocks = append(newBlocks, &testBlock{
+			hash:   *hash,
+			header: block.Header,
+			txs:    block.Transactions,
+		})
+	}
+
+	mempool, err := s.node.GetRawMempool()
+	if err != nil {
+		return err
+	}
+	mempoolTxs := make([]*wire.MsgTx, 0, len(mempool))
+	for _, txHash := range mempool {
+		if tx, ok := s.txs[*txHash]; ok {
+			mempoolTxs = append(mempoolTxs, tx.tx)
+			continue
+		}
+
+		tx, err := s.node.GetRawTransaction(txHash)
+		if err != nil {
+			// The transaction may have been confirmed in the
+			// meantime.
+			continue
+		}
+		mempoolTxs = append(mempoolTxs, tx.MsgTx())
+	}
+
+	tipChanged := len(newBlocks) > 0
+	s.chain = append(s.chain[:forkHeight+1], newBlocks...)
+	s.reindex(mempoolTxs)
+
+	// Finally, we'll notify our clients of the new tip and any changes
+	// to the status of the scripthashes they're subscribed to.
+	for session := range s.sessions {
+		if tipChanged && session.headers {
+			s.notify(session, "blockchain.headers.subscribe",
+				s.tipResult())
+		}
+
+		for scriptHash, status := range session.scriptHashes {
+			newStatus := s.status(scriptHash)
+			if newStatus == status {
+				continue
+			}
+			session.scriptHashes[scriptHash] = newStatus
+
+			var statusParam interface{}
+			if newStatus != "" {
+				statusParam = newStatus
+			}
+			s.notify(session, "blockchain.scripthash.subscribe",
+				scriptHash, statusParam)
+		}
+	}
+
+	return nil
+}
+
+// reindex rebuilds the transaction and scripthash indexes from our chain and
+// the given mempool transactions.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *TestServer) reindex(mempoolTxs []*wire.MsgTx) {
+	s.txs = make(map[chainhash.Hash]*testTx)
+	s.history = make(map[string][]*testTx)
+
+	addToHistory := func(tx *testTx) {
+		seen := make(map[string]struct{})
+		add := func(pkScript []byte) {
+			scriptHash := ScriptHash(pkScript)
+			if _, ok := seen[scriptHash]; ok {
+				return
+			}
+			seen[scriptHash] = struct{}{}
+			s.history[scriptHash] = append(
+				s.history[scriptHash], tx,
+			)
+		}
+
+		for _, txIn := range tx.tx.TxIn {
+			prevTx, ok := s.txs[txIn.PreviousOutPoint.Hash]
+			if !ok {
+				continue
+			}
+			prevOut := prevTx.tx.TxOut[txIn.PreviousOutPoint.Index]
+			add(prevOut.PkScript)
+		}
+		for _, txOut := range tx.tx.TxOut {
+			add(txOut.PkScript)
+		}
+	}
+
+	for height, block := range s.chain {
+		for pos, tx := range block.txs {
+			indexedTx := &testTx{
+				tx:     tx,
+				height: int32(height),
+				pos:    pos,
+			}
+			s.txs[tx.TxHash()] = indexedTx
+			addToHistory(indexedTx)
+		}
+	}
+
+	// Mempool transactions may depend on each other, so we'll index them
+	// in dependency order.
+	for _, tx := range sortUnconfirmed(mempoolTxs) {
+		indexedTx := &testTx{tx: tx}
+		s.txs[tx.TxHash()] = indexedTx
+		addToHistory(indexedTx)
+	}
+}
+
+// historyHeight returns the height of the transaction as reported within
+// the history of a script: unconfirmed transactions with unconfirmed inputs
+// have a height of -1.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *TestServer) historyHeight(tx *testTx) int32 {
+	if tx.height > 0 {
+		return tx.height
+	}
+
+	for _, txIn := range tx.tx.TxIn {
+		prevTx, ok := s.txs[txIn.PreviousOutPoint.Hash]
+		if ok && prevTx.height == 0 {
+			return -1
+		}
+	}
+
+	return 0
+}
+
+// status returns the status of a scripthash, as defined by the Electrum
+// protocol.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *TestServer) status(scriptHash string) string {
+	history := s.history[scriptHash]
+	if len(history) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	for _, tx := range history {
+		fmt.Fprintf(&buf, "%v:%d:", tx.tx.TxHash(), s.historyHeight(tx))
+	}
+	status := sha256.Sum256(buf.Bytes())
+
+	return hex.EncodeToString(status[:])
+}
+
+// tipResult returns the tip of our chain in the format used by the Electrum
+// protocol.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *TestServer) tipResult() *headerResult {
+	height := int32(len(s.chain)) - 1
+	return &headerResult{
+		Height: height,
+		Hex:    serializeHeader(&s.chain[height].header),
+	}
+}
+
+// notify sends a notification to the client of the given session.
+func (s *TestServer) notify(session *testSession, method string,
+	params ...interface{}) {
+
+	msg, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return
+	}
+
+	session.write(msg)
+}
+
+// write sends a message to the client of the session.
+func (t *testSession) write(msg []byte) {
+	t.writeMtx.Lock()
+	defer t.writeMtx.Unlock()
+
+	_, _ = t.conn.Write(append(msg, '\n'))
+}
+
+// handleSession serves the requests of the client of the session.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *TestServer) handleSession(session *testSession) {
+	defer s.wg.Done()
+
+	defer func() {
+		session.conn.Close()
+
+		s.mtx.Lock()
+		delete(s.sessions, session)
+		s.mtx.Unlock()
+	}()
+
+	reader := bufio.NewReader(session.conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+
+		var req struct {
+			ID     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.Unmarshal(line, &req); err != nil {
+			return
+		}
+
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+		}
+		result, err := s.handleRequest(session, req.Method, req.Params)
+		if err != nil {
+			resp["error"] = &RPCError{Code: 1, Message: err.Error()}
+		} else {
+			resp["result"] = result
+		}
+
+		msg, err := json.Marshal(resp)
+		if err != nil {
+			return
+		}
+		session.write(msg)
+	}
+}
+
+// handleRequest handles a request sent by the client of the session.
+func (s *TestServer) handleRequest(session *testSession, method string,
+	params []json.RawMessage) (interface{}, error) {
+
+	param := func(i int, v interface{}) error {
+		if i >= len(params) {
+			return fmt.Errorf("missing parameter %d", i)
+		}
+		return json.Unmarshal(params[i], v)
+	}
+
+	// Broadcasting needs to be handled without holding the mutex, as
+	// we'll sync with the node once it accepted the transaction.
+	if method == "blockchain.transaction.broadcast" {
+		var txHex string
+		if err := param(0, &txHex); err != nil {
+			return nil, err
+		}
+		tx, err := parseTx(txHex)
+		if err != nil {
+			return nil, err
+		}
+
+		txHash, err := s.node.SendRawTransaction(tx, true)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.sync(); err != nil {
+			return nil, err
+		}
+
+		return txHash.String(), nil
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	switch method {
+	case "server.version":
+		return []string{"lnd electrum test server", protocolVersion},
+			nil
+
+	case "server.ping":
+		return nil, nil
+
+	case "blockchain.headers.subscribe":
+		session.headers = true
+		return s.tipResult(), nil
+
+	case "blockchain.block.header":
+		var height int32
+		if err := param(0, &height); err != nil {
+			return nil, err
+		}
+		if height < 0 || int(height) >= len(s.chain) {
+			return nil, fmt.Errorf("height %d out of range", height)
+		}
+
+		return serializeHeader(&s.chain[height].header), nil
+
+	case "blockchain.block.headers":
+		var startHeight, count int32
+		if err := param(0, &startHeight); err != nil {
+			return nil, err
+		}
+		if err := param(1, &count); err != nil {
+			return nil, err
+		}
+		if startHeight < 0 || int(startHeight) >= len(s.chain) {
+			return nil, fmt.Errorf("height %d out of range",
+				startHeight)
+		}
+
+		var headers string
+		var n int32
+		for height := startHeight; height < startHeight+count &&
+			int(height) < len(s.chain); height++ {
+
+			headers += serializeHeader(&s.chain[height].header)
+			n++
+		}
+
+		return map[string]interface{}{
+			"count": n,
+			"hex":   headers,
+			"max":   maxHeadersPerRequest,
+		}, nil
+
+	case "blockchain.scripthash.subscribe":
+		var scriptHash string
+		if err := param(0, &scriptHash); err != nil {
+			return nil, err
+		}
+
+		status := s.status(scriptHash)
+		session.scriptHashes[scriptHash] = status
+		if status == "" {
+			return nil, nil
+		}
+
+		return status, nil
+
+	case "blockchain.scripthash.get_history":
+		var scriptHash string
+		if err := param(0, &scriptHash); err != nil {
+			return nil, err
+		}
+
+		history := make([]*historyResult, 0)
+		for _, tx := range s.history[scriptHash] {
+			history = append(history, &historyResult{
+				TxHash: tx.tx.TxHash().String(),
+				Height: s.historyHeight(tx),
+			})
+		}
+
+		return history, nil
+
+	case "blockchain.scripthash.listunspent":
+		var scriptHash string
+		if err := param(0, &scriptHash); err != nil {
+			return nil, err
+		}
+
+		spent := make(map[wire.OutPoint]struct{})
+		for _, tx := range s.history[scriptHash] {
+			for _, txIn := range tx.tx.TxIn {
+				spent[txIn.PreviousOutPoint] = struct{}{}
+			}
+		}
+
+		utxos := make([]map[string]interface{}, 0)
+		for _, tx := range s.history[scriptHash] {
+			txHash := tx.tx.TxHash()
+			for i, txOut := range tx.tx.TxOut {
+				if ScriptHash(txOut.PkScript) != scriptHash {
+					continue
+				}
+
+				op := wire.OutPoint{Hash: txHash, Index: uint32(i)}
+				if _, ok := spent[op]; ok {
+					continue
+				}
+
+				utxos = append(utxos, map[string]interface{}{
+					"tx_hash": txHash.String(),
+					"tx_pos":  i,
+					"height":  tx.height,
+					"value":   txOut.Value,
+				})
+			}
+		}
+
+		return utxos, nil
+
+	case "blockchain.transaction.get":
+		var txid string
+		if err := param(0, &txid); err != nil {
+			return nil, err
+		}
+		txHash, err := chainhash.NewHashFromStr(txid)
+		if err != nil {
+			return nil, err
+		}
+
+		tx, ok := s.txs[*txHash]
+		if !ok {
+			return nil, fmt.Errorf("unknown transaction %v", txid)
+		}
+
+		var buf bytes.Buffer
+		if err := tx.tx.Serialize(&buf); err != nil {
+			return nil, err
+		}
+
+		return hex.EncodeToString(buf.Bytes()), nil
+
+	case "blockchain.transaction.get_merkle":
+		var txid string
+		var height int32
+		if err := param(0, &txid); err != nil {
+			return nil, err
+		}
+		if err := param(1, &height); err != nil {
+			return nil, err
+		}
+		txHash, err := chainhash.NewHashFromStr(txid)
+		if err != nil {
+			return nil, err
+		}
+
+		tx, ok := s.txs[*txHash]
+		if !ok || tx.height != height || height == 0 {
+			return nil, fmt.Errorf("transaction %v not found in "+
+				"block %d", txid, height)
+		}
+
+		branch := merkleBranch(s.chain[height].txs, tx.pos)
+		merkle := make([]string, 0, len(branch))
+		for _, hash := range branch {
+			merkle = append(merkle, hash.String())
+		}
+
+		return map[string]interface{}{
+			"block_height": height,
+			"pos":          tx.pos,
+			"merkle":       merkle,
+		}, nil
+
+	case "blockchain.transaction.id_from_pos":
+		var height int32
+		var pos int
+		if err := param(0, &height); err != nil {
+			return nil, err
+		}
+		if err := param(1, &pos); err != nil {
+			return nil, err
+		}
+		if height < 0 || int(height) >= len(s.chain) ||
+			pos < 0 || pos >= len(s.chain[height].txs) {
+
+			return nil, fmt.Errorf("no transaction at position "+
+				"%d in block %d", pos, height)
+		}
+
+		return s.chain[height].txs[pos].TxHash().String(), nil
+
+	case "blockchain.estimatefee":
+		return TestServerFeeRate, nil
+
+	case "blockchain.relayfee":
+		return TestServerRelayFee, nil
+
+	default:
+		return nil, fmt.Errorf("unknown method %v", method)
+	}
+}
+
+// serializeHeader hex encodes a block header.
+func serializeHeader(header *wire.BlockHeader) string {
+	var buf bytes.Buffer
+	_ = header.Serialize(&buf)
+	return hex.EncodeToString(buf.Bytes())
+}
+
+// merkleBranch returns the merkle branch of the transaction found at the
+// given position within the given transactions of a block.
+func merkleBranch(txs []*wire.MsgTx, pos int) []chainhash.Hash {
+	level := make([]chainhash.Hash, 0, len(txs))
+	for _, tx := range txs {
+		level = append(level, tx.TxHash())
+	}
+
+	var (
+		branch []chainhash.Hash
+		buf    [chainhash.HashSize * 2]byte
+	)
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+
+		branch = append(branch, level[pos^1])
+
+		next := make([]chainhash.Hash, 0, len(level)/2)
+		for i := 0; i < len(level); i += 2 {
+			copy(buf[:chainhash.HashSize], level[i][:])
+			copy(buf[chainhash.HashSize:], level[i+1][:])
+			next = append(next, chainhash.DoubleHashH(buf[:]))
+		}
+
+		level = next
+		pos /= 2
+	}
+
+	return branch
+}
@@ -0,0 +1,657 @@
+package esplora
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+const (
+	// BackEnd is the name of the Esplora chain backend.
+	BackEnd = "esplora"
+
+	// isCurrentDelta is the maximum age of the best block for the backend
+	// to be considered synced to the tip of the chain.
+	isCurrentDelta = 2 * time.Hour
+
+	// reorgSafetyLimit is the number of recent blocks whose hashes we'll
+	// keep track of, allowing us to locate the fork point upon a reorg.
+	reorgSafetyLimit = 100
+)
+
+// Compile time check to ensure ChainClient satisfies the chain.Interface
+// interface.
+var _ chain.Interface = (*ChainClient)(nil)
+
+// ChainClient is an implementation of btcwallet's chain.Interface backed by an
+// Esplora server. The wallet is kept in sync by fetching every new block and
+// matching its transactions against the addresses and outpoints we've been
+// asked to watch.
+//
+// As the server can only be polled for the unconfirmed transactions of each
+// script separately, only the addresses passed to NotifyReceived, i.e. the
+// ones handed out by the wallet, are watched within the mempool.
+type ChainClient struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	*Client
+
+	chainParams *chaincfg.Params
+
+	sub *Subscription
+
+	notifyBlocks uint32 // To be used atomically.
+
+	// chainMtx guards our view of the best chain. It is held while
+	// processing blocks, to ensure they're delivered in order.
+	chainMtx  sync.Mutex
+	bestBlock wtxmgr.BlockMeta
+	blocks    map[int32]wtxmgr.BlockMeta
+
+	// watchMtx guards the scripts and outpoints we're watching, as well
+	// as the unconfirmed transactions we've notified the wallet about.
+	watchMtx         sync.Mutex
+	watchedScripts   map[string]struct{}
+	watchedOutPoints map[wire.OutPoint]struct{}
+	mempoolScripts   map[string][]byte
+	unconfirmedTxs   map[chainhash.Hash]struct{}
+
+	ntfnQueue *queue.ConcurrentQueue
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewChainClient creates a new chain client backed by the given Esplora
+// client, which must be started before the chain client.
+func NewChainClient(client *Client,
+	chainParams *chaincfg.Params) *ChainClient {
+
+	return &ChainClient{
+		Client:           client,
+		chainParams:      chainParams,
+		blocks:           make(map[int32]wtxmgr.BlockMeta),
+		watchedScripts:   make(map[string]struct{}),
+		watchedOutPoints: make(map[wire.OutPoint]struct{}),
+		mempoolScripts:   make(map[string][]byte),
+		unconfirmedTxs:   make(map[chainhash.Hash]struct{}),
+		ntfnQueue:        queue.NewConcurrentQueue(20),
+		quit:             make(chan struct{}),
+	}
+}
+
+// Start starts the chain client and notifies the wallet that it's connected
+// to the backend.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) Start() error {
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return nil
+	}
+
+	c.ntfnQueue.Start()
+
+	// We subscribe before querying the best block to ensure we don't miss
+	// any block found in between.
+	c.sub = c.Subscribe()
+
+	bestHash, bestHeight, err := c.GetBestBlock()
+	if err != nil {
+		c.sub.Cancel()
+		c.ntfnQueue.Stop()
+		return err
+	}
+	header, err := c.GetBlockHeader(bestHash)
+	if err != nil {
+		c.sub.Cancel()
+		c.ntfnQueue.Stop()
+		return err
+	}
+
+	c.chainMtx.Lock()
+	c.bestBlock = wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{
+			Hash:   *bestHash,
+			Height: bestHeight,
+		},
+		Time: header.Timestamp,
+	}
+	c.blocks[bestHeight] = c.bestBlock
+	c.chainMtx.Unlock()
+
+	c.ntfnQueue.ChanIn() <- chain.ClientConnected{}
+
+	c.wg.Add(1)
+	go c.notificationHandler()
+
+	return nil
+}
+
+// Stop stops the chain client.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) Stop() {
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return
+	}
+
+	close(c.quit)
+	if c.sub != nil {
+		c.sub.Cancel()
+	}
+	c.ntfnQueue.Stop()
+}
+
+// WaitForShutdown blocks until the chain client has stopped.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) WaitForShutdown() {
+	c.wg.Wait()
+}
+
+// IsCurrent returns whether the backend is synced to the tip of the chain,
+// which we assume to be the case if its best block is recent.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) IsCurrent() bool {
+	c.chainMtx.Lock()
+	defer c.chainMtx.Unlock()
+
+	return time.Since(c.bestBlock.Time) < isCurrentDelta
+}
+
+// BlockStamp returns the best block the wallet has been notified about.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) BlockStamp() (*waddrmgr.BlockStamp, error) {
+	c.chainMtx.Lock()
+	defer c.chainMtx.Unlock()
+
+	return &waddrmgr.BlockStamp{
+		Hash:      c.bestBlock.Hash,
+		Height:    c.bestBlock.Height,
+		Timestamp: c.bestBlock.Time,
+	}, nil
+}
+
+// SendRawTransaction broadcasts the transaction to the network.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) SendRawTransaction(tx *wire.MsgTx,
+	_ bool) (*chainhash.Hash, error) {
+
+	return c.Broadcast(tx)
+}
+
+// NotifyBlocks requests notifications for blocks connected to and
+// disconnected from the best chain.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) NotifyBlocks() error {
+	atomic.StoreUint32(&c.notifyBlocks, 1)
+	return nil
+}
+
+// NotifyReceived requests notifications for transactions paying to or
+// spending from the given addresses, both within new blocks and the mempool.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) NotifyReceived(addrs []btcutil.Address) error {
+	pkScripts, err := addrScripts(addrs)
+	if err != nil {
+		return err
+	}
+
+	c.watchMtx.Lock()
+	for _, pkScript := range pkScripts {
+		c.watchedScripts[string(pkScript)] = struct{}{}
+		c.mempoolScripts[string(pkScript)] = pkScript
+	}
+	c.watchMtx.Unlock()
+
+	return nil
+}
+
+// Rescan notifies the wallet about all transactions paying to or spending
+// from the given addresses and outpoints since the block with the given hash,
+// and requests notifications for any future ones. Once done, the wallet is
+// notified with a RescanFinished notification.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) Rescan(startHash *chainhash.Hash,
+	addrs []btcutil.Address,
+	outPoints map[wire.OutPoint]btcutil.Address) error {
+
+	pkScripts, err := addrScripts(addrs)
+	if err != nil {
+		return err
+	}
+
+	c.watchMtx.Lock()
+	for _, pkScript := range pkScripts {
+		c.watchedScripts[string(pkScript)] = struct{}{}
+	}
+	for op := range outPoints {
+		c.watchedOutPoints[op] = struct{}{}
+	}
+	c.watchMtx.Unlock()
+
+	startHeight, err := c.GetBlockHeight(startHash)
+	if err != nil {
+		return fmt.Errorf("unable to look up rescan start block %v: "+
+			"%v", startHash, err)
+	}
+
+	// We'll hold the chain mutex while rescanning, such that new blocks
+	// are only processed once we're done.
+	c.chainMtx.Lock()
+	defer c.chainMtx.Unlock()
+
+	for height := startHeight; height <= c.bestBlock.Height; height++ {
+		blk, ok := c.blocks[height]
+		if !ok {
+			hash, err := c.GetBlockHash(int64(height))
+			if err != nil {
+				return err
+			}
+			blk = wtxmgr.BlockMeta{
+				Block: wtxmgr.Block{
+					Hash:   *hash,
+					Height: height,
+				},
+			}
+		}
+
+		block, err := c.GetBlock(&blk.Hash)
+		if err != nil {
+			return err
+		}
+		blk.Time = block.Header.Timestamp
+
+		for _, tx := range c.filterBlock(block) {
+			rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, blk.Time)
+			if err != nil {
+				return err
+			}
+
+			blk := blk
+			c.notify(chain.RelevantTx{
+				TxRecord: rec,
+				Block:    &blk,
+			})
+		}
+	}
+
+	c.notify(&chain.RescanFinished{
+		Hash:   &c.bestBlock.Hash,
+		Height: c.bestBlock.Height,
+		Time:   c.bestBlock.Time,
+	})
+
+	return nil
+}
+
+// FilterBlocks scans the blocks contained in the FilterBlocksRequest for any
+// addresses of interest, and returns a FilterBlocksResponse for the first
+// block containing any. If no matches are found in the range of blocks
+// requested, the returned response will be nil.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) FilterBlocks(
+	req *chain.FilterBlocksRequest) (*chain.FilterBlocksResponse, error) {
+
+	blockFilterer := chain.NewBlockFilterer(c.chainParams, req)
+	for i, blk := range req.Blocks {
+		blk := blk
+		block, err := c.GetBlock(&blk.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if !blockFilterer.FilterBlock(block) {
+			continue
+		}
+
+		return &chain.FilterBlocksResponse{
+			BatchIndex:         uint32(i),
+			BlockMeta:          blk,
+			FoundExternalAddrs: blockFilterer.FoundExternal,
+			FoundInternalAddrs: blockFilterer.FoundInternal,
+			FoundOutPoints:     blockFilterer.FoundOutPoints,
+			RelevantTxns:       blockFilterer.RelevantTxns,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// BackEnd returns the name of the backend.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) BackEnd() string {
+	return BackEnd
+}
+
+// Notifications returns the channel over which the wallet is notified about
+// chain events.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (c *ChainClient) Notifications() <-chan interface{} {
+	return c.ntfnQueue.ChanOut()
+}
+
+// notificationHandler processes the new tips advertised by the server, and
+// polls the mempool for transactions paying to the addresses handed out by
+// the wallet.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *ChainClient) notificationHandler() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case ntfn, ok := <-c.sub.Notifications:
+			if !ok {
+				return
+			}
+
+			tip, ok := ntfn.(*TipNotification)
+			if !ok {
+				continue
+			}
+
+			if err := c.syncChain(tip.Height); err != nil {
+				log.Errorf("Unable to sync to block %d: %v",
+					tip.Height, err)
+			}
+
+		case <-ticker.C:
+			if err := c.pollMempool(); err != nil {
+				log.Errorf("Unable to poll mempool: %v", err)
+			}
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// syncChain updates our view of the best chain up to the given height. Any
+// blocks that have been reorged out are disconnected first, and all blocks
+// up to the new tip are then connected.
+func (c *ChainClient) syncChain(tipHeight int32) error {
+	c.chainMtx.Lock()
+	defer c.chainMtx.Unlock()
+
+	notify := atomic.LoadUint32(&c.notifyBlocks) == 1
+
+	// We'll start by locating the fork point between our view of the
+	// chain and the server's, assuming blocks we don't know about anymore
+	// can't be reorged out.
+	forkHeight := c.bestBlock.Height
+	if tipHeight < forkHeight {
+		forkHeight = tipHeight
+	}
+	for ; forkHeight > 0; forkHeight-- {
+		blk, ok := c.blocks[forkHeight]
+		if !ok {
+			break
+		}
+
+		hash, err := c.GetBlockHash(int64(forkHeight))
+		if err != nil {
+			return err
+		}
+		if *hash == blk.Hash {
+			break
+		}
+	}
+
+	for height := c.bestBlock.Height; height > forkHeight; height-- {
+		blk, ok := c.blocks[height]
+		if !ok {
+			continue
+		}
+		delete(c.blocks, height)
+
+		log.Debugf("Disconnecting block %d (%v)", height, blk.Hash)
+
+		if notify {
+			c.notify(chain.BlockDisconnected(blk))
+		}
+	}
+	if blk, ok := c.blocks[forkHeight]; ok {
+		c.bestBlock = blk
+	}
+
+	for height := forkHeight + 1; height <= tipHeight; height++ {
+		hash, err := c.GetBlockHash(int64(height))
+		if err != nil {
+			return err
+		}
+		block, err := c.GetBlock(hash)
+		if err != nil {
+			return err
+		}
+
+		blk := wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{
+				Hash:   *hash,
+				Height: height,
+			},
+			Time: block.Header.Timestamp,
+		}
+
+		// The wallet only associates the transactions confirmed
+		// within a block with it if they're delivered before the
+		// block itself.
+		relevantTxs := c.filterBlock(block)
+		if len(relevantTxs) > 0 {
+			recs := make([]*wtxmgr.TxRecord, 0, len(relevantTxs))
+			for _, tx := range relevantTxs {
+				rec, err := wtxmgr.NewTxRecordFromMsgTx(
+					tx, blk.Time,
+				)
+				if err != nil {
+					return err
+				}
+				recs = append(recs, rec)
+			}
+
+			c.notify(chain.FilteredBlockConnected{
+				Block:       &blk,
+				RelevantTxs: recs,
+			})
+		}
+
+		c.bestBlock = blk
+		c.blocks[height] = blk
+		delete(c.blocks, height-reorgSafetyLimit)
+
+		if notify {
+			c.notify(chain.BlockConnected(blk))
+		}
+	}
+
+	return nil
+}
+
+// filterBlock returns the transactions of the block paying to any of our
+// watched scripts or spending any of our watched outpoints, in the order they
+// appear in the block. The outputs paying to our watched scripts are watched
+// from then on.
+func (c *ChainClient) filterBlock(block *wire.MsgBlock) []*wire.MsgTx {
+	c.watchMtx.Lock()
+	defer c.watchMtx.Unlock()
+
+	var relevantTxs []*wire.MsgTx
+	for _, tx := range block.Transactions {
+		if c.filterTx(tx) {
+			relevantTxs = append(relevantTxs, tx)
+		}
+
+		// Confirmed transactions are no longer part of the mempool.
+		delete(c.unconfirmedTxs, tx.TxHash())
+	}
+
+	return relevantTxs
+}
+
+// filterTx returns whether the transaction pays to any of our watched
+// scripts or spends any of our watched outpoints. The outputs paying to our
+// watched scripts are watched from then on.
+//
+// NOTE: The watch mutex MUST be held when calling this method.
+func (c *ChainClient) filterTx(tx *wire.MsgTx) bool {
+	var relevant bool
+	for _, txIn := range tx.TxIn {
+		if _, ok := c.watchedOutPoints[txIn.PreviousOutPoint]; ok {
+			relevant = true
+			break
+		}
+	}
+
+	txHash := tx.TxHash()
+	for i, txOut := range tx.TxOut {
+		if _, ok := c.watchedScripts[string(txOut.PkScript)]; !ok {
+			continue
+		}
+
+		relevant = true
+		c.watchedOutPoints[wire.OutPoint{
+			Hash:  txHash,
+			Index: uint32(i),
+		}] = struct{}{}
+	}
+
+	return relevant
+}
+
+// pollMempool notifies the wallet about any new unconfirmed transactions
+// paying to or spending from the addresses it handed out.
+func (c *ChainClient) pollMempool() error {
+	c.watchMtx.Lock()
+	pkScripts := make([][]byte, 0, len(c.mempoolScripts))
+	for _, pkScript := range c.mempoolScripts {
+		pkScripts = append(pkScripts, pkScript)
+	}
+	c.watchMtx.Unlock()
+
+	newTxs := make(map[chainhash.Hash]struct{})
+	for _, pkScript := range pkScripts {
+		txHashes, err := c.ScriptMempoolTxs(pkScript)
+		if err != nil {
+			return err
+		}
+
+		c.watchMtx.Lock()
+		for _, txHash := range txHashes {
+			if _, ok := c.unconfirmedTxs[txHash]; !ok {
+				newTxs[txHash] = struct{}{}
+			}
+		}
+		c.watchMtx.Unlock()
+	}
+
+	txs := make([]*wire.MsgTx, 0, len(newTxs))
+	for txHash := range newTxs {
+		txHash := txHash
+		tx, err := c.GetTransaction(&txHash)
+		if err != nil {
+			// The transaction may have been confirmed or replaced
+			// in the meantime.
+			log.Debugf("Unable to fetch unconfirmed transaction "+
+				"%v: %v", txHash, err)
+			continue
+		}
+		txs = append(txs, tx)
+	}
+
+	// Unconfirmed transactions may depend on each other, so we'll
+	// deliver them in dependency order.
+	c.watchMtx.Lock()
+	defer c.watchMtx.Unlock()
+
+	for _, tx := range sortUnconfirmed(txs) {
+		rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, time.Now())
+		if err != nil {
+			return err
+		}
+
+		c.filterTx(tx)
+		c.unconfirmedTxs[rec.Hash] = struct{}{}
+
+		c.notify(chain.RelevantTx{TxRecord: rec})
+	}
+
+	return nil
+}
+
+// notify sends a notification to the wallet.
+func (c *ChainClient) notify(ntfn interface{}) {
+	select {
+	case c.ntfnQueue.ChanIn() <- ntfn:
+	case <-c.quit:
+	}
+}
+
+// sortUnconfirmed sorts the transactions such that every transaction comes
+// after the transactions it spends from.
+func sortUnconfirmed(txs []*wire.MsgTx) []*wire.MsgTx {
+	pending := make(map[chainhash.Hash]*wire.MsgTx, len(txs))
+	for _, tx := range txs {
+		pending[tx.TxHash()] = tx
+	}
+
+	sorted := make([]*wire.MsgTx, 0, len(txs))
+	var visit func(tx *wire.MsgTx)
+	visit = func(tx *wire.MsgTx) {
+		txHash := tx.TxHash()
+		if _, ok := pending[txHash]; !ok {
+			return
+		}
+		delete(pending, txHash)
+
+		for _, txIn := range tx.TxIn {
+			parent, ok := pending[txIn.PreviousOutPoint.Hash]
+			if ok {
+				visit(parent)
+			}
+		}
+		sorted = append(sorted, tx)
+	}
+	for _, tx := range txs {
+		visit(tx)
+	}
+
+	return sorted
+}
+
+// addrScripts returns the output scripts of the given addresses.
+func addrScripts(addrs []btcutil.Address) ([][]byte, error) {
+	pkScripts := make([][]byte, 0, len(addrs))
+	for _, addr := range addrs {
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+		pkScripts = append(pkScripts, pkScript)
+	}
+
+	return pkScripts, nil
+}
@@ -0,0 +1,391 @@
+package esplora
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+const (
+	// DefaultPollInterval is the default interval at which we'll poll the
+	// server for a new tip.
+	DefaultPollInterval = 10 * time.Second
+
+	// DefaultRequestTimeout is the default duration we'll wait for the
+	// server to respond to a request.
+	DefaultRequestTimeout = 30 * time.Second
+)
+
+var (
+	// ErrClientShuttingDown is returned when a request is made to a
+	// client that is shutting down.
+	ErrClientShuttingDown = errors.New("esplora client shutting down")
+
+	// ErrNotFound is returned when the server is unable to find the
+	// requested block, transaction or output.
+	ErrNotFound = errors.New("not found by esplora server")
+)
+
+// RequestError is an error returned by the Esplora server in response to a
+// request.
+type RequestError struct {
+	StatusCode int
+	Message    string
+}
+
+// Error returns a human readable description of the error.
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("esplora server error %d: %s", e.StatusCode,
+		e.Message)
+}
+
+// TipNotification is sent to subscribers whenever the server advertises a
+// new best block. As the server is polled, intermediate blocks may be
+// skipped, so subscribers are expected to catch up with them on their own.
+type TipNotification struct {
+	// Height is the height of the new tip.
+	Height int32
+
+	// Header is the header of the new tip.
+	Header *wire.BlockHeader
+}
+
+// ClientConfig houses the parameters required to reach an Esplora server.
+type ClientConfig struct {
+	// URL is the base URL of the server's REST API, e.g.
+	// https://blockstream.info/api.
+	URL string
+
+	// Dial is the function used to establish the TCP connections to the
+	// server, which allows connecting through a proxy like Tor.
+	Dial func(network, address string) (net.Conn, error)
+
+	// PollInterval is the interval at which we'll poll the server for a
+	// new tip. If zero, DefaultPollInterval is used.
+	PollInterval time.Duration
+
+	// RequestTimeout is the duration we'll wait for the server to respond
+	// to a request. If zero, DefaultRequestTimeout is used.
+	RequestTimeout time.Duration
+}
+
+// Client is a client of the REST API exposed by Esplora servers. As the API
+// doesn't support any kind of subscription, the client polls the server for
+// its best block, and notifies all active subscriptions whenever it changes.
+type Client struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	cfg *ClientConfig
+
+	httpClient *http.Client
+
+	// tipMtx guards the best block advertised by the server.
+	tipMtx    sync.RWMutex
+	tipHash   chainhash.Hash
+	tipHeight int32
+
+	// subMtx guards the subscriptions of the client's users.
+	subMtx        sync.Mutex
+	subscriptions map[uint64]*queue.ConcurrentQueue
+	nextSubID     uint64
+
+	// ctx is canceled once the client is stopped, aborting all requests
+	// in flight.
+	ctx    context.Context
+	cancel func()
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewClient creates a new client of the Esplora server described by the
+// passed config. The server is only contacted once the client is started.
+func NewClient(cfg *ClientConfig) *Client {
+	if cfg.Dial == nil {
+		cfg.Dial = net.Dial
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.RequestTimeout == 0 {
+		cfg.RequestTimeout = DefaultRequestTimeout
+	}
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Client{
+		cfg: cfg,
+		httpClient: &http.Client{
+			Timeout: cfg.RequestTimeout,
+			Transport: &http.Transport{
+				Dial:                cfg.Dial,
+				TLSHandshakeTimeout: cfg.RequestTimeout,
+			},
+		},
+		subscriptions: make(map[uint64]*queue.ConcurrentQueue),
+		ctx:           ctx,
+		cancel:        cancel,
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start queries the server for its best block and launches the goroutine
+// polling it for new ones.
+func (c *Client) Start() error {
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return nil
+	}
+
+	log.Infof("Using esplora server %v", c.cfg.URL)
+
+	if _, err := c.pollTip(); err != nil {
+		return fmt.Errorf("unable to reach esplora server %v: %v",
+			c.cfg.URL, err)
+	}
+
+	c.wg.Add(1)
+	go c.tipPoller()
+
+	return nil
+}
+
+// Stop stops all goroutines of the client.
+func (c *Client) Stop() error {
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return nil
+	}
+
+	close(c.quit)
+	c.cancel()
+	c.wg.Wait()
+
+	c.subMtx.Lock()
+	for id, subscription := range c.subscriptions {
+		subscription.Stop()
+		delete(c.subscriptions, id)
+	}
+	c.subMtx.Unlock()
+
+	return nil
+}
+
+// tipPoller periodically polls the server for a new tip, and notifies all
+// subscriptions about it.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Client) tipPoller() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			tip, err := c.pollTip()
+			if err != nil {
+				log.Errorf("Unable to poll esplora server "+
+					"for its tip: %v", err)
+				continue
+			}
+			if tip == nil {
+				continue
+			}
+
+			log.Debugf("New tip at height %d: %v", tip.Height,
+				tip.Header.BlockHash())
+
+			c.notifySubscribers(tip)
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// pollTip queries the server for its best block. If it differs from the one
+// we know of, our view is updated and a notification for the new tip is
+// returned.
+func (c *Client) pollTip() (*TipNotification, error) {
+	hash, err := c.getHash("/blocks/tip/hash")
+	if err != nil {
+		return nil, err
+	}
+
+	c.tipMtx.RLock()
+	known := *hash == c.tipHash
+	c.tipMtx.RUnlock()
+	if known {
+		return nil, nil
+	}
+
+	// We'll look up the height of the block by its hash rather than
+	// querying the tip height, as the tip may have changed in between.
+	header, err := c.GetBlockHeader(hash)
+	if err != nil {
+		return nil, err
+	}
+	status, err := c.GetBlockStatus(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	c.tipMtx.Lock()
+	c.tipHash = *hash
+	c.tipHeight = status.Height
+	c.tipMtx.Unlock()
+
+	return &TipNotification{
+		Height: status.Height,
+		Header: header,
+	}, nil
+}
+
+// Subscription is a subscription to the new tips advertised by the server.
+type Subscription struct {
+	// Notifications receives a *TipNotification for every new tip
+	// advertised by the server.
+	Notifications <-chan interface{}
+
+	// Cancel cancels the subscription.
+	Cancel func()
+}
+
+// Subscribe returns a new subscription to the new tips advertised by the
+// server.
+func (c *Client) Subscribe() *Subscription {
+	ntfnQueue := queue.NewConcurrentQueue(20)
+	ntfnQueue.Start()
+
+	c.subMtx.Lock()
+	id := c.nextSubID
+	c.nextSubID++
+	c.subscriptions[id] = ntfnQueue
+	c.subMtx.Unlock()
+
+	return &Subscription{
+		Notifications: ntfnQueue.ChanOut(),
+		Cancel: func() {
+			c.subMtx.Lock()
+			delete(c.subscriptions, id)
+			c.subMtx.Unlock()
+
+			ntfnQueue.Stop()
+		},
+	}
+}
+
+// notifySubscribers forwards the notification to all subscriptions.
+func (c *Client) notifySubscribers(ntfn interface{}) {
+	c.subMtx.Lock()
+	defer c.subMtx.Unlock()
+
+	for _, subscription := range c.subscriptions {
+		select {
+		case subscription.ChanIn() <- ntfn:
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// do sends a request for the given path to the server and returns the body
+// of its response.
+func (c *Client) do(method, path string, body []byte) ([]byte, error) {
+	select {
+	case <-c.quit:
+		return nil, ErrClientShuttingDown
+	default:
+	}
+
+	req, err := http.NewRequest(
+		method, c.cfg.URL+path, bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(c.ctx)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		select {
+		case <-c.quit:
+			return nil, ErrClientShuttingDown
+		default:
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrNotFound
+
+	case resp.StatusCode != http.StatusOK:
+		return nil, &RequestError{
+			StatusCode: resp.StatusCode,
+			Message:    strings.TrimSpace(string(respBody)),
+		}
+	}
+
+	return respBody, nil
+}
+
+// get sends a GET request for the given path to the server and returns the
+// body of its response.
+func (c *Client) get(path string) ([]byte, error) {
+	return c.do(http.MethodGet, path, nil)
+}
+
+// getText sends a GET request for the given path to the server and returns
+// its plain text response.
+func (c *Client) getText(path string) (string, error) {
+	body, err := c.get(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(body)), nil
+}
+
+// getHash sends a GET request for the given path to the server and parses
+// its response as a hash.
+func (c *Client) getHash(path string) (*chainhash.Hash, error) {
+	text, err := c.getText(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return chainhash.NewHashFromStr(text)
+}
+
+// getJSON sends a GET request for the given path to the server and decodes
+// its JSON response into result.
+func (c *Client) getJSON(path string, result interface{}) error {
+	body, err := c.get(path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, result)
+}
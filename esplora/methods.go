@@ -0,0 +1,468 @@
+package esplora
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// confirmedTxsPerPage is the number of confirmed transactions returned by the
+// server per request for the transactions related to a script.
+const confirmedTxsPerPage = 25
+
+// BlockStatus describes whether a block is part of the best chain.
+type BlockStatus struct {
+	// InBestChain is true if the block is part of the best chain.
+	InBestChain bool `json:"in_best_chain"`
+
+	// Height is the height of the block.
+	Height int32 `json:"height"`
+}
+
+// TxStatus describes whether a transaction has been confirmed.
+type TxStatus struct {
+	// Confirmed is true if the transaction is included in a block of the
+	// best chain.
+	Confirmed bool `json:"confirmed"`
+
+	// BlockHeight is the height of the block the transaction is included
+	// in, if it's confirmed.
+	BlockHeight int32 `json:"block_height"`
+
+	// BlockHash is the hash of the block the transaction is included in,
+	// if it's confirmed.
+	BlockHash string `json:"block_hash"`
+}
+
+// OutSpend describes whether an output has been spent.
+type OutSpend struct {
+	// Spent is true if the output has been spent, either by a confirmed
+	// or an unconfirmed transaction.
+	Spent bool
+
+	// SpenderTxHash is the hash of the spending transaction, if any.
+	SpenderTxHash chainhash.Hash
+
+	// SpenderInputIndex is the index of the input of the spending
+	// transaction spending the output.
+	SpenderInputIndex uint32
+
+	// Status is the confirmation status of the spending transaction.
+	Status TxStatus
+}
+
+// outSpendResult is the output spend representation used by the REST API.
+type outSpendResult struct {
+	Spent  bool     `json:"spent"`
+	TxID   string   `json:"txid"`
+	Vin    uint32   `json:"vin"`
+	Status TxStatus `json:"status"`
+}
+
+// ScriptTx is a transaction paying to or spending from a script.
+type ScriptTx struct {
+	// TxHash is the hash of the transaction.
+	TxHash chainhash.Hash
+
+	// Status is the confirmation status of the transaction.
+	Status TxStatus
+}
+
+// scriptTxResult is the representation of a transaction used by the REST
+// API, stripped down to the fields we're interested in.
+type scriptTxResult struct {
+	TxID   string   `json:"txid"`
+	Status TxStatus `json:"status"`
+}
+
+// UnspentOutput is an unspent output paying to a script.
+type UnspentOutput struct {
+	// OutPoint is the outpoint of the output.
+	OutPoint wire.OutPoint
+
+	// Value is the value of the output.
+	Value btcutil.Amount
+
+	// Status is the confirmation status of the transaction creating the
+	// output.
+	Status TxStatus
+}
+
+// utxoResult is the unspent output representation used by the REST API.
+type utxoResult struct {
+	TxID   string   `json:"txid"`
+	Vout   uint32   `json:"vout"`
+	Value  int64    `json:"value"`
+	Status TxStatus `json:"status"`
+}
+
+// ScriptHash returns the scripthash used by the REST API to refer to the
+// given output script: the hex encoded SHA256 hash of the script.
+//
+// NOTE: Unlike the Electrum protocol, the hash isn't reversed.
+func ScriptHash(pkScript []byte) string {
+	hash := sha256.Sum256(pkScript)
+	return hex.EncodeToString(hash[:])
+}
+
+// GetBestBlock returns the hash and height of the best block advertised by
+// the server.
+func (c *Client) GetBestBlock() (*chainhash.Hash, int32, error) {
+	c.tipMtx.RLock()
+	defer c.tipMtx.RUnlock()
+
+	hash := c.tipHash
+	return &hash, c.tipHeight, nil
+}
+
+// GetBlockHash returns the hash of the block found at the given height within
+// the best chain.
+func (c *Client) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	return c.getHash(fmt.Sprintf("/block-height/%d", height))
+}
+
+// GetBlockHeader returns the header of the block with the given hash.
+func (c *Client) GetBlockHeader(hash *chainhash.Hash) (*wire.BlockHeader,
+	error) {
+
+	headerHex, err := c.getText(fmt.Sprintf("/block/%v/header", hash))
+	if err != nil {
+		return nil, err
+	}
+	headerBytes, err := hex.DecodeString(headerHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(headerBytes)); err != nil {
+		return nil, err
+	}
+	if header.BlockHash() != *hash {
+		return nil, fmt.Errorf("received header of block %v instead "+
+			"of %v", header.BlockHash(), hash)
+	}
+
+	return &header, nil
+}
+
+// GetBlockStatus returns whether the block with the given hash is part of the
+// best chain, along with its height.
+func (c *Client) GetBlockStatus(hash *chainhash.Hash) (*BlockStatus, error) {
+	var status BlockStatus
+	err := c.getJSON(fmt.Sprintf("/block/%v/status", hash), &status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// GetBlockHeight returns the height of the block with the given hash.
+func (c *Client) GetBlockHeight(hash *chainhash.Hash) (int32, error) {
+	status, err := c.GetBlockStatus(hash)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.Height, nil
+}
+
+// GetBlockHeaderVerbose returns the verbose header of the block with the
+// given hash. Only the fields that can be derived from the header itself and
+// its height are populated.
+func (c *Client) GetBlockHeaderVerbose(hash *chainhash.Hash) (
+	*btcjson.GetBlockHeaderVerboseResult, error) {
+
+	header, err := c.GetBlockHeader(hash)
+	if err != nil {
+		return nil, err
+	}
+	height, err := c.GetBlockHeight(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &btcjson.GetBlockHeaderVerboseResult{
+		Hash:         hash.String(),
+		Height:       height,
+		Version:      header.Version,
+		MerkleRoot:   header.MerkleRoot.String(),
+		PreviousHash: header.PrevBlock.String(),
+		Nonce:        uint64(header.Nonce),
+		Time:         header.Timestamp.Unix(),
+		Bits:         fmt.Sprintf("%08x", header.Bits),
+	}, nil
+}
+
+// GetBlock returns the block with the given hash.
+func (c *Client) GetBlock(hash *chainhash.Hash) (*wire.MsgBlock, error) {
+	blockBytes, err := c.get(fmt.Sprintf("/block/%v/raw", hash))
+	if err != nil {
+		return nil, err
+	}
+
+	var block wire.MsgBlock
+	if err := block.Deserialize(bytes.NewReader(blockBytes)); err != nil {
+		return nil, err
+	}
+	if block.BlockHash() != *hash {
+		return nil, fmt.Errorf("received block %v instead of %v",
+			block.BlockHash(), hash)
+	}
+
+	return &block, nil
+}
+
+// GetBlockTxIDs returns the hashes of all transactions included in the block
+// with the given hash, in the order they appear in the block.
+func (c *Client) GetBlockTxIDs(hash *chainhash.Hash) ([]chainhash.Hash,
+	error) {
+
+	var txids []string
+	err := c.getJSON(fmt.Sprintf("/block/%v/txids", hash), &txids)
+	if err != nil {
+		return nil, err
+	}
+
+	txHashes := make([]chainhash.Hash, len(txids))
+	for i, txid := range txids {
+		txHash, err := chainhash.NewHashFromStr(txid)
+		if err != nil {
+			return nil, err
+		}
+		txHashes[i] = *txHash
+	}
+
+	return txHashes, nil
+}
+
+// GetBlockTxID returns the hash of the transaction found at the given index
+// within the block with the given hash.
+func (c *Client) GetBlockTxID(hash *chainhash.Hash,
+	index uint32) (*chainhash.Hash, error) {
+
+	return c.getHash(fmt.Sprintf("/block/%v/txid/%d", hash, index))
+}
+
+// GetTransaction returns the confirmed or unconfirmed transaction with the
+// given hash.
+func (c *Client) GetTransaction(txHash *chainhash.Hash) (*wire.MsgTx, error) {
+	txHex, err := c.getText(fmt.Sprintf("/tx/%v/hex", txHash))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := parseTx(txHex)
+	if err != nil {
+		return nil, err
+	}
+	if tx.TxHash() != *txHash {
+		return nil, fmt.Errorf("received transaction %v instead of %v",
+			tx.TxHash(), txHash)
+	}
+
+	return tx, nil
+}
+
+// GetTxStatus returns the confirmation status of the transaction with the
+// given hash.
+func (c *Client) GetTxStatus(txHash *chainhash.Hash) (*TxStatus, error) {
+	var status TxStatus
+	err := c.getJSON(fmt.Sprintf("/tx/%v/status", txHash), &status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// GetOutSpend returns whether the given output has been spent, and by which
+// transaction.
+func (c *Client) GetOutSpend(op *wire.OutPoint) (*OutSpend, error) {
+	var result outSpendResult
+	path := fmt.Sprintf("/tx/%v/outspend/%d", op.Hash, op.Index)
+	if err := c.getJSON(path, &result); err != nil {
+		return nil, err
+	}
+
+	outSpend := &OutSpend{
+		Spent:             result.Spent,
+		SpenderInputIndex: result.Vin,
+		Status:            result.Status,
+	}
+	if !result.Spent {
+		return outSpend, nil
+	}
+
+	txHash, err := chainhash.NewHashFromStr(result.TxID)
+	if err != nil {
+		return nil, err
+	}
+	outSpend.SpenderTxHash = *txHash
+
+	return outSpend, nil
+}
+
+// ScriptTxs returns all confirmed and unconfirmed transactions paying to or
+// spending from the given output script. Unconfirmed transactions come
+// first, followed by the confirmed ones, newest first.
+func (c *Client) ScriptTxs(pkScript []byte) ([]ScriptTx, error) {
+	scriptHash := ScriptHash(pkScript)
+
+	// The first page contains the unconfirmed transactions along with
+	// the most recent confirmed ones, while the following pages contain
+	// the older confirmed ones, starting after the last one we've seen.
+	path := fmt.Sprintf("/scripthash/%v/txs", scriptHash)
+
+	var txs []ScriptTx
+	for {
+		var results []scriptTxResult
+		if err := c.getJSON(path, &results); err != nil {
+			return nil, err
+		}
+
+		var confirmed int
+		for _, result := range results {
+			txHash, err := chainhash.NewHashFromStr(result.TxID)
+			if err != nil {
+				return nil, err
+			}
+			if result.Status.Confirmed {
+				confirmed++
+			}
+
+			txs = append(txs, ScriptTx{
+				TxHash: *txHash,
+				Status: result.Status,
+			})
+		}
+
+		if confirmed < confirmedTxsPerPage {
+			return txs, nil
+		}
+
+		path = fmt.Sprintf("/scripthash/%v/txs/chain/%v", scriptHash,
+			txs[len(txs)-1].TxHash)
+	}
+}
+
+// ScriptMempoolTxs returns the hashes of the unconfirmed transactions paying
+// to or spending from the given output script.
+func (c *Client) ScriptMempoolTxs(pkScript []byte) ([]chainhash.Hash,
+	error) {
+
+	var results []scriptTxResult
+	path := fmt.Sprintf("/scripthash/%v/txs/mempool", ScriptHash(pkScript))
+	if err := c.getJSON(path, &results); err != nil {
+		return nil, err
+	}
+
+	txHashes := make([]chainhash.Hash, 0, len(results))
+	for _, result := range results {
+		txHash, err := chainhash.NewHashFromStr(result.TxID)
+		if err != nil {
+			return nil, err
+		}
+		txHashes = append(txHashes, *txHash)
+	}
+
+	return txHashes, nil
+}
+
+// ListUnspent returns the confirmed and unconfirmed unspent outputs paying to
+// the given output script.
+func (c *Client) ListUnspent(pkScript []byte) ([]UnspentOutput, error) {
+	var results []utxoResult
+	path := fmt.Sprintf("/scripthash/%v/utxo", ScriptHash(pkScript))
+	if err := c.getJSON(path, &results); err != nil {
+		return nil, err
+	}
+
+	utxos := make([]UnspentOutput, 0, len(results))
+	for _, result := range results {
+		txHash, err := chainhash.NewHashFromStr(result.TxID)
+		if err != nil {
+			return nil, err
+		}
+
+		utxos = append(utxos, UnspentOutput{
+			OutPoint: wire.OutPoint{
+				Hash:  *txHash,
+				Index: result.Vout,
+			},
+			Value:  btcutil.Amount(result.Value),
+			Status: result.Status,
+		})
+	}
+
+	return utxos, nil
+}
+
+// Broadcast broadcasts the transaction to the network.
+func (c *Client) Broadcast(tx *wire.MsgTx) (*chainhash.Hash, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	body, err := c.do(
+		http.MethodPost, "/tx", []byte(hex.EncodeToString(buf.Bytes())),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return chainhash.NewHashFromStr(string(bytes.TrimSpace(body)))
+}
+
+// FeeEstimates returns the fee rates estimated by the server, indexed by the
+// number of blocks within which a transaction paying them is expected to
+// confirm.
+func (c *Client) FeeEstimates() (map[uint32]btcutil.Amount, error) {
+	var results map[string]float64
+	if err := c.getJSON("/fee-estimates", &results); err != nil {
+		return nil, err
+	}
+
+	// The server expresses fee rates in sat/vbyte, so we'll convert them
+	// to sat/kvbyte.
+	estimates := make(map[uint32]btcutil.Amount, len(results))
+	for target, satPerVByte := range results {
+		numBlocks, err := strconv.ParseUint(target, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid confirmation target "+
+				"%q: %v", target, err)
+		}
+
+		estimates[uint32(numBlocks)] = btcutil.Amount(
+			math.Round(satPerVByte * 1000),
+		)
+	}
+
+	return estimates, nil
+}
+
+// parseTx decodes a hex encoded transaction.
+func parseTx(txHex string) (*wire.MsgTx, error) {
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+		return nil, err
+	}
+
+	return &tx, nil
+}
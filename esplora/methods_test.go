@@ -0,0 +1,109 @@
+package esplora
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// TestScriptHash ensures we derive the scripthash of an output script the way
+// Esplora indexes it, which unlike Electrum's isn't byte reversed.
+func TestScriptHash(t *testing.T) {
+	t.Parallel()
+
+	// This is the P2PKH output script of
+	// 1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa.
+	pkScript, err := hex.DecodeString(
+		"76a91462e907b15cbf27d5425399ebf6f0fb50ebb88f1888ac",
+	)
+	if err != nil {
+		t.Fatalf("unable to decode script: %v", err)
+	}
+
+	const expected = "6191c3b590bfcfa0475e877c302da1e323497acf3b42c0" +
+		"8d8fa28e364edf018b"
+	if scriptHash := ScriptHash(pkScript); scriptHash != expected {
+		t.Fatalf("expected scripthash %v, got %v", expected,
+			scriptHash)
+	}
+}
+
+// TestScriptTxsPagination ensures we follow the pages of a script's confirmed
+// transactions until the server returns a partial one.
+func TestScriptTxsPagination(t *testing.T) {
+	t.Parallel()
+
+	pkScript := []byte{0x51}
+	scriptHash := ScriptHash(pkScript)
+
+	// We'll have the server return a single unconfirmed transaction along
+	// with a full page of confirmed ones, followed by a partial page.
+	const numConfirmed = confirmedTxsPerPage + 3
+	txHashes := make([]chainhash.Hash, numConfirmed+1)
+	for i := range txHashes {
+		txHashes[i][0] = byte(i + 1)
+	}
+
+	result := func(i int) scriptTxResult {
+		return scriptTxResult{
+			TxID: txHashes[i].String(),
+			Status: TxStatus{
+				Confirmed:   i != 0,
+				BlockHeight: int32(numConfirmed - i + 1),
+			},
+		}
+	}
+
+	firstPage := fmt.Sprintf("/scripthash/%v/txs", scriptHash)
+	secondPage := fmt.Sprintf("/scripthash/%v/txs/chain/%v", scriptHash,
+		txHashes[confirmedTxsPerPage])
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var results []scriptTxResult
+			switch r.URL.Path {
+			case firstPage:
+				for i := 0; i <= confirmedTxsPerPage; i++ {
+					results = append(results, result(i))
+				}
+
+			case secondPage:
+				i := confirmedTxsPerPage + 1
+				for ; i <= numConfirmed; i++ {
+					results = append(results, result(i))
+				}
+
+			default:
+				http.NotFound(w, r)
+				return
+			}
+
+			json.NewEncoder(w).Encode(results)
+		},
+	))
+	defer server.Close()
+
+	client := NewClient(&ClientConfig{URL: server.URL + "/"})
+	txs, err := client.ScriptTxs(pkScript)
+	if err != nil {
+		t.Fatalf("unable to fetch script txs: %v", err)
+	}
+
+	if len(txs) != len(txHashes) {
+		t.Fatalf("expected %d txs, got %d", len(txHashes), len(txs))
+	}
+	for i, tx := range txs {
+		if tx.TxHash != txHashes[i] {
+			t.Fatalf("expected tx %v at index %d, got %v",
+				txHashes[i], i, tx.TxHash)
+		}
+		if tx.Status.Confirmed != (i != 0) {
+			t.Fatalf("unexpected confirmation status of tx %d", i)
+		}
+	}
+}
@@ -0,0 +1,629 @@
+// +build dev
+
+package esplora
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// testServerPollInterval is the interval at which the test server
+	// polls its backing node for new blocks and mempool transactions.
+	testServerPollInterval = 50 * time.Millisecond
+
+	// TestServerFeeRate is the fee rate in sat/vbyte returned by the test
+	// server for every confirmation target.
+	TestServerFeeRate = 50.0
+)
+
+// testBlock is a block of the chain indexed by the test server.
+type testBlock struct {
+	hash  chainhash.Hash
+	block *wire.MsgBlock
+}
+
+// testTx is a transaction indexed by the test server, along with the block
+// it was confirmed in, if any.
+type testTx struct {
+	tx     *wire.MsgTx
+	status TxStatus
+}
+
+// testSpend is the spend of an output indexed by the test server.
+type testSpend struct {
+	tx  *testTx
+	vin uint32
+}
+
+// TestServer is a minimal Esplora server backed by a btcd node, meant to be
+// used as a stand-in for a real Esplora server within tests. It indexes the
+// chain and mempool of the node by polling it, and supports the subset of
+// the REST API used by the Esplora client.
+type TestServer struct {
+	node     *rpcclient.Client
+	listener net.Listener
+	server   *http.Server
+
+	mtx     sync.Mutex
+	chain   []*testBlock
+	txs     map[chainhash.Hash]*testTx
+	spends  map[wire.OutPoint]*testSpend
+	history map[string][]*testTx
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewTestServer creates and starts a new test server backed by the btcd node
+// reachable with the given RPC config. The server listens on a random local
+// port, and its base URL can be retrieved through URL.
+func NewTestServer(rpcConfig *rpcclient.ConnConfig) (*TestServer, error) {
+	connConfig := *rpcConfig
+	connConfig.DisableConnectOnNew = false
+	node, err := rpcclient.New(&connConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		node.Shutdown()
+		return nil, err
+	}
+
+	s := &TestServer{
+		node:     node,
+		listener: listener,
+		txs:      make(map[chainhash.Hash]*testTx),
+		spends:   make(map[wire.OutPoint]*testSpend),
+		history:  make(map[string][]*testTx),
+		quit:     make(chan struct{}),
+	}
+	s.server = &http.Server{Handler: http.HandlerFunc(s.handleRequest)}
+
+	if err := s.sync(); err != nil {
+		listener.Close()
+		node.Shutdown()
+		return nil, err
+	}
+
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		_ = s.server.Serve(listener)
+	}()
+	go s.pollNode()
+
+	return s, nil
+}
+
+// URL returns the base URL of the test server's REST API.
+func (s *TestServer) URL() string {
+	return "http://" + s.listener.Addr().String()
+}
+
+// Stop stops the test server.
+func (s *TestServer) Stop() {
+	close(s.quit)
+	s.server.Close()
+	s.wg.Wait()
+	s.node.Shutdown()
+}
+
+// pollNode periodically syncs the index with the backing node.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *TestServer) pollNode() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(testServerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.sync(); err != nil {
+				log.Errorf("Unable to sync test server: %v", err)
+			}
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// sync brings the index up to date with the chain and mempool of the backing
+// node.
+func (s *TestServer) sync() error {
+	_, bestHeight, err := s.node.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// Locate the fork point between our chain and the node's, and fetch
+	// all blocks following it.
+	forkHeight := int32(len(s.chain)) - 1
+	if forkHeight > bestHeight {
+		forkHeight = bestHeight
+	}
+	for ; forkHeight >= 0; forkHeight-- {
+		hash, err := s.node.GetBlockHash(int64(forkHeight))
+		if err != nil {
+			return err
+		}
+		if *hash == s.chain[forkHeight].hash {
+			break
+		}
+	}
+
+	var newBlocks []*testBlock
+	for height := forkHeight + 1; height <= bestHeight; height++ {
+		hash, err := s.node.GetBlockHash(int64(height))
+		if err != nil {
+			return err
+		}
+		block, err := s.node.GetBlock(hash)
+		if err != nil {
+			return err
+		}
+
+		newBlocks = append(newBlocks, &testBlock{
+			hash:  *hash,
+			block: block,
+		})
+	}
+
+	mempool, err := s.node.GetRawMempool()
+	if err != nil {
+		return err
+	}
+	mempoolTxs := make([]*wire.MsgTx, 0, len(mempool))
+	for _, txHash := range mempool {
+		if tx, ok := s.txs[*txHash]; ok {
+			mempoolTxs = append(mempoolTxs, tx.tx)
+			continue
+		}
+
+		tx, err := s.node.GetRawTransaction(txHash)
+		if err != nil {
+			// The transaction may have been confirmed in the
+			// meantime.
+			continue
+		}
+		mempoolTxs = append(mempoolTxs, tx.MsgTx())
+	}
+
+	s.chain = append(s.chain[:forkHeight+1], newBlocks...)
+	s.reindex(mempoolTxs)
+
+	return nil
+}
+
+// reindex rebuilds the transaction, spend and scripthash indexes from our
+// chain and the given mempool transactions.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *TestServer) reindex(mempoolTxs []*wire.MsgTx) {
+	s.txs = make(map[chainhash.Hash]*testTx)
+	s.spends = make(map[wire.OutPoint]*testSpend)
+	s.history = make(map[string][]*testTx)
+
+	index := func(tx *testTx) {
+		s.txs[tx.tx.TxHash()] = tx
+
+		seen := make(map[string]struct{})
+		addToHistory := func(pkScript []byte) {
+			scriptHash := ScriptHash(pkScript)
+			if _, ok := seen[scriptHash]; ok {
+				return
+			}
+			seen[scriptHash] = struct{}{}
+			s.history[scriptHash] = append(
+				s.history[scriptHash], tx,
+			)
+		}
+
+		for i, txIn := range tx.tx.TxIn {
+			s.spends[txIn.PreviousOutPoint] = &testSpend{
+				tx:  tx,
+				vin: uint32(i),
+			}
+
+			prevTx, ok := s.txs[txIn.PreviousOutPoint.Hash]
+			if !ok {
+				continue
+			}
+			prevOut := prevTx.tx.TxOut[txIn.PreviousOutPoint.Index]
+			addToHistory(prevOut.PkScript)
+		}
+		for _, txOut := range tx.tx.TxOut {
+			addToHistory(txOut.PkScript)
+		}
+	}
+
+	for height, block := range s.chain {
+		for _, tx := range block.block.Transactions {
+			index(&testTx{
+				tx: tx,
+				status: TxStatus{
+					Confirmed:   true,
+					BlockHeight: int32(height),
+					BlockHash:   block.hash.String(),
+				},
+			})
+		}
+	}
+
+	// Mempool transactions may depend on each other, so we'll index them
+	// in dependency order.
+	for _, tx := range sortUnconfirmed(mempoolTxs) {
+		index(&testTx{tx: tx})
+	}
+}
+
+// handleRequest serves a request to the REST API.
+func (s *TestServer) handleRequest(w http.ResponseWriter, r *http.Request) {
+	result, err := s.route(r)
+	switch {
+	case err == ErrNotFound:
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+	default:
+		switch result := result.(type) {
+		case []byte:
+			_, _ = w.Write(result)
+
+		case string:
+			_, _ = w.Write([]byte(result))
+
+		default:
+			_ = json.NewEncoder(w).Encode(result)
+		}
+	}
+}
+
+// route handles a request to the REST API, returning either raw bytes, plain
+// text or a value to be JSON encoded.
+func (s *TestServer) route(r *http.Request) (interface{}, error) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	// Broadcasting needs to be handled without holding the mutex, as
+	// we'll sync with the node once it accepted the transaction.
+	if r.Method == http.MethodPost {
+		if len(parts) != 1 || parts[0] != "tx" {
+			return nil, ErrNotFound
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := parseTx(strings.TrimSpace(string(body)))
+		if err != nil {
+			return nil, err
+		}
+
+		txHash, err := s.node.SendRawTransaction(tx, true)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.sync(); err != nil {
+			return nil, err
+		}
+
+		return txHash.String(), nil
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	switch {
+	case len(parts) == 3 && parts[0] == "blocks" && parts[1] == "tip":
+		tip := s.chain[len(s.chain)-1]
+		switch parts[2] {
+		case "hash":
+			return tip.hash.String(), nil
+		case "height":
+			return strconv.Itoa(len(s.chain) - 1), nil
+		}
+
+	case len(parts) == 2 && parts[0] == "block-height":
+		height, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if height < 0 || height >= len(s.chain) {
+			return nil, ErrNotFound
+		}
+
+		return s.chain[height].hash.String(), nil
+
+	case len(parts) >= 3 && parts[0] == "block":
+		return s.routeBlock(parts[1], parts[2:])
+
+	case len(parts) >= 3 && parts[0] == "tx":
+		return s.routeTx(parts[1], parts[2:])
+
+	case len(parts) >= 3 && parts[0] == "scripthash":
+		return s.routeScriptHash(parts[1], parts[2:])
+
+	case len(parts) == 1 && parts[0] == "fee-estimates":
+		estimates := make(map[string]float64)
+		for _, target := range []int{1, 2, 3, 6, 12, 25, 144, 1008} {
+			estimates[strconv.Itoa(target)] = TestServerFeeRate
+		}
+
+		return estimates, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+// routeBlock handles a request related to the block with the given hash.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *TestServer) routeBlock(hashStr string,
+	parts []string) (interface{}, error) {
+
+	hash, err := chainhash.NewHashFromStr(hashStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Blocks that aren't part of the best chain are looked up through
+	// the node, which still knows about the ones that have been reorged
+	// out.
+	var (
+		block  *wire.MsgBlock
+		height int32
+	)
+	inBestChain := false
+	for i := len(s.chain) - 1; i >= 0; i-- {
+		if s.chain[i].hash == *hash {
+			block = s.chain[i].block
+			height = int32(i)
+			inBestChain = true
+			break
+		}
+	}
+	if !inBestChain {
+		var err error
+		block, err = s.node.GetBlock(hash)
+		if err != nil {
+			return nil, ErrNotFound
+		}
+
+		height, err = s.staleBlockHeight(&block.Header)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case len(parts) == 1 && parts[0] == "header":
+		var buf bytes.Buffer
+		if err := block.Header.Serialize(&buf); err != nil {
+			return nil, err
+		}
+
+		return hex.EncodeToString(buf.Bytes()), nil
+
+	case len(parts) == 1 && parts[0] == "status":
+		return &BlockStatus{
+			InBestChain: inBestChain,
+			Height:      height,
+		}, nil
+
+	case len(parts) == 1 && parts[0] == "raw":
+		var buf bytes.Buffer
+		if err := block.Serialize(&buf); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+
+	case len(parts) == 1 && parts[0] == "txids":
+		txids := make([]string, 0, len(block.Transactions))
+		for _, tx := range block.Transactions {
+			txids = append(txids, tx.TxHash().String())
+		}
+
+		return txids, nil
+
+	case len(parts) == 2 && parts[0] == "txid":
+		index, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if index < 0 || index >= len(block.Transactions) {
+			return nil, ErrNotFound
+		}
+
+		return block.Transactions[index].TxHash().String(), nil
+	}
+
+	return nil, ErrNotFound
+}
+
+// staleBlockHeight returns the height of the block with the given header,
+// which isn't part of our chain, by walking back its chain until we reach a
+// block that is.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *TestServer) staleBlockHeight(header *wire.BlockHeader) (int32,
+	error) {
+
+	var height int32
+	for {
+		height++
+
+		for i := len(s.chain) - 1; i >= 0; i-- {
+			if s.chain[i].hash == header.PrevBlock {
+				return int32(i) + height, nil
+			}
+		}
+
+		var err error
+		header, err = s.node.GetBlockHeader(&header.PrevBlock)
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// routeTx handles a request related to the transaction with the given hash.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *TestServer) routeTx(txid string, parts []string) (interface{},
+	error) {
+
+	txHash, err := chainhash.NewHashFromStr(txid)
+	if err != nil {
+		return nil, err
+	}
+	tx, ok := s.txs[*txHash]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	switch {
+	case len(parts) == 1 && parts[0] == "hex":
+		var buf bytes.Buffer
+		if err := tx.tx.Serialize(&buf); err != nil {
+			return nil, err
+		}
+
+		return hex.EncodeToString(buf.Bytes()), nil
+
+	case len(parts) == 1 && parts[0] == "status":
+		return &tx.status, nil
+
+	case len(parts) == 2 && parts[0] == "outspend":
+		index, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if index < 0 || index >= len(tx.tx.TxOut) {
+			return nil, ErrNotFound
+		}
+
+		op := wire.OutPoint{Hash: *txHash, Index: uint32(index)}
+		spend, ok := s.spends[op]
+		if !ok {
+			return &outSpendResult{}, nil
+		}
+
+		return &outSpendResult{
+			Spent:  true,
+			TxID:   spend.tx.tx.TxHash().String(),
+			Vin:    spend.vin,
+			Status: spend.tx.status,
+		}, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+// routeScriptHash handles a request related to the given scripthash.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (s *TestServer) routeScriptHash(scriptHash string,
+	parts []string) (interface{}, error) {
+
+	history := s.history[scriptHash]
+
+	var mempool, confirmed []scriptTxResult
+	for i := len(history) - 1; i >= 0; i-- {
+		result := scriptTxResult{
+			TxID:   history[i].tx.TxHash().String(),
+			Status: history[i].status,
+		}
+		if result.Status.Confirmed {
+			confirmed = append(confirmed, result)
+		} else {
+			mempool = append(mempool, result)
+		}
+	}
+
+	// firstPage returns the first page of confirmed transactions
+	// following the transaction with the given hash, if any.
+	firstPage := func(lastSeen string) []scriptTxResult {
+		start := 0
+		if lastSeen != "" {
+			for i, result := range confirmed {
+				if result.TxID == lastSeen {
+					start = i + 1
+					break
+				}
+			}
+		}
+
+		end := start + confirmedTxsPerPage
+		if end > len(confirmed) {
+			end = len(confirmed)
+		}
+
+		return confirmed[start:end]
+	}
+
+	switch {
+	case len(parts) == 1 && parts[0] == "txs":
+		return append(
+			append([]scriptTxResult{}, mempool...),
+			firstPage("")...,
+		), nil
+
+	case len(parts) == 2 && parts[0] == "txs" && parts[1] == "mempool":
+		return append([]scriptTxResult{}, mempool...), nil
+
+	case len(parts) == 3 && parts[0] == "txs" && parts[1] == "chain":
+		return append([]scriptTxResult{}, firstPage(parts[2])...), nil
+
+	case len(parts) == 1 && parts[0] == "utxo":
+		utxos := make([]utxoResult, 0)
+		for _, tx := range history {
+			txHash := tx.tx.TxHash()
+			for i, txOut := range tx.tx.TxOut {
+				if ScriptHash(txOut.PkScript) != scriptHash {
+					continue
+				}
+
+				op := wire.OutPoint{Hash: txHash, Index: uint32(i)}
+				if _, ok := s.spends[op]; ok {
+					continue
+				}
+
+				utxos = append(utxos, utxoResult{
+					TxID:   txHash.String(),
+					Vout:   uint32(i),
+					Value:  txOut.Value,
+					Status: tx.status,
+				})
+			}
+		}
+
+		return utxos, nil
+	}
+
+	return nil, fmt.Errorf("unknown scripthash request %v", parts)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
@@ -225,8 +226,8 @@ type fundingConfig struct {
 	Wallet *lnwallet.LightningWallet
 
 	// PublishTransaction facilitates the process of broadcasting a
-	// transaction to the network.
-	PublishTransaction func(*wire.MsgTx) error
+	// transaction to the network, storing the given label for it.
+	PublishTransaction func(*wire.MsgTx, string) error
 
 	// FeeEstimator calculates appropriate fee rates based on historical
 	// transaction information.
@@ -342,6 +343,10 @@ type fundingConfig struct {
 	// incoming channels having a non-zero push amount.
 	RejectPush bool
 
+	// IsDraining returns true if the node is draining in preparation of a
+	// shutdown, in which case any incoming channels are rejected.
+	IsDraining func() bool
+
 	// NotifyOpenChannelEvent informs the ChannelNotifier when channels
 	// transition from pending open to open.
 	NotifyOpenChannelEvent func(wire.OutPoint)
@@ -524,8 +529,11 @@ func (f *fundingManager) start() error {
 			if channel.ChanType == channeldb.SingleFunder &&
 				channel.IsInitiator {
 
+				label := labels.MakeLabel(
+					labels.LabelTypeChannelOpen, nil,
+				)
 				err := f.cfg.PublishTransaction(
-					channel.FundingTxn,
+					channel.FundingTxn, label,
 				)
 				if err != nil {
 					fndgLog.Errorf("Unable to rebroadcast "+
@@ -1144,6 +1152,16 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// We'll also reject any requests to create channels if we're about to
+	// shut down.
+	if f.cfg.IsDraining() {
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID,
+			lnwire.ErrShuttingDown,
+		)
+		return
+	}
+
 	// We'll reject any request to create a channel that's above the
 	// current soft-limit for channel size.
 	if msg.FundingAmount > MaxFundingAmount {
@@ -1742,7 +1760,8 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): %v",
 		completeChan.FundingOutpoint, spew.Sdump(fundingTx))
 
-	err = f.cfg.PublishTransaction(fundingTx)
+	label := labels.MakeLabel(labels.LabelTypeChannelOpen, nil)
+	err = f.cfg.PublishTransaction(fundingTx, label)
 	if err != nil {
 		fndgLog.Errorf("Unable to broadcast funding tx for "+
 			"ChannelPoint(%v): %v", completeChan.FundingOutpoint,
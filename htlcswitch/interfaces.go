@@ -104,11 +104,12 @@ type ChannelLink interface {
 	// details satisfy the current forwarding policy fo the target link.
 	// Otherwise, a valid protocol failure message should be returned in
 	// order to signal to the source of the HTLC, the policy consistency
-	// issue.
+	// issue. The inbound fee is the fee charged by the link the HTLC
+	// arrived on, which is added to the fee of the target link.
 	HtlcSatifiesPolicy(payHash [32]byte, incomingAmt lnwire.MilliSatoshi,
 		amtToForward lnwire.MilliSatoshi,
 		incomingTimeout, outgoingTimeout uint32,
-		heightNow uint32) lnwire.FailureMessage
+		inboundFee lnwire.Fee, heightNow uint32) lnwire.FailureMessage
 
 	// HtlcSatifiesPolicyLocal should return a nil error if the passed HTLC
 	// details satisfy the current channel policy.  Otherwise, a valid
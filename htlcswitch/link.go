@@ -99,6 +99,11 @@ type ForwardingPolicy struct {
 	//    per-hop payload of the incoming HTLC's onion packet.
 	TimeLockDelta uint32
 
+	// InboundFee is the fee that is charged, or discounted if negative,
+	// for HTLCs that arrive over this link. It is applied on top of the
+	// fee of the outgoing link when forwarding.
+	InboundFee lnwire.Fee
+
 	// TODO(roasbeef): add fee module inside of switch
 }
 
@@ -115,6 +120,17 @@ func ExpectedFee(f ForwardingPolicy,
 	return f.BaseFee + (htlcAmt*f.FeeRate)/1000000
 }
 
+// ExpectedTotalFee computes the expected fee for forwarding an htlc of the
+// given amount, taking into account the inbound fee of the link the htlc
+// arrived on. The inbound fee is computed over the amount the incoming htlc
+// would carry without it. As a negative inbound fee can at most cancel out the
+// outgoing fee, the returned fee is never negative.
+func ExpectedTotalFee(f ForwardingPolicy, inboundFee lnwire.Fee,
+	htlcAmt lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+
+	return inboundFee.TotalFee(ExpectedFee(f, htlcAmt), htlcAmt)
+}
+
 // ChannelLinkConfig defines the configuration for the channel link. ALL
 // elements within the configuration MUST be non-nil for channel link to carry
 // out its duties.
@@ -273,6 +289,11 @@ type ChannelLinkConfig struct {
 	// NotifyInactiveChannel allows the switch to tell the ChannelNotifier
 	// when channels become inactive.
 	NotifyInactiveChannel func(wire.OutPoint)
+
+	// NotifyDustRejection is called whenever the link fails an HTLC
+	// because it would push the channel's dust exposure beyond the
+	// configured maximum.
+	NotifyDustRejection func()
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -798,6 +819,13 @@ func (l *channelLink) resolveFwdPkg(fwdPkg *channeldb.FwdPkg) (bool, error) {
 				err)
 			return false, err
 		}
+
+		// Whether an Add exceeded our dust exposure isn't persisted,
+		// so we'll recompute it against the current channel state.
+		for _, add := range adds {
+			add.ExceedsDustExposure =
+				l.channel.RemoteAddExceedsDustExposure(add)
+		}
 		needUpdate = l.processRemoteAdds(fwdPkg, adds)
 
 		// If the link failed during processing the adds, we must
@@ -1307,6 +1335,10 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 				l.log.Warnf("unable to handle downstream add "+
 					"HTLC: %v", err)
 
+				if err == lnwallet.ErrDustExposureExceeded {
+					l.cfg.NotifyDustRejection()
+				}
+
 				var (
 					localFailure = false
 					reason       lnwire.OpaqueReason
@@ -1838,11 +1870,13 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		}
 
 		// If we have a tower client, we'll proceed in backing up the
-		// state that was just revoked.
+		// state that was just revoked. As the revoked commitment hasn't
+		// been broadcast, there's no spending transaction to pass.
 		if l.cfg.TowerClient != nil {
 			state := l.channel.State()
 			breachInfo, err := lnwallet.NewBreachRetribution(
 				state, state.RemoteCommitment.CommitHeight-1, 0,
+				nil,
 			)
 			if err != nil {
 				l.fail(LinkFailureError{code: ErrInternalError},
@@ -2183,7 +2217,7 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 // NOTE: Part of the ChannelLink interface.
 func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 	incomingHtlcAmt, amtToForward lnwire.MilliSatoshi,
-	incomingTimeout, outgoingTimeout uint32,
+	incomingTimeout, outgoingTimeout uint32, inboundFee lnwire.Fee,
 	heightNow uint32) lnwire.FailureMessage {
 
 	l.RLock()
@@ -2200,8 +2234,9 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 
 	// Next, using the amount of the incoming HTLC, we'll calculate the
 	// expected fee this incoming HTLC must carry in order to satisfy the
-	// constraints of the outgoing link.
-	expectedFee := ExpectedFee(policy, amtToForward)
+	// constraints of the outgoing link, adjusted by the inbound fee of the
+	// link it arrived on.
+	expectedFee := ExpectedTotalFee(policy, inboundFee, amtToForward)
 
 	// If the actual fee is less than our expected fee, then we'll reject
 	// this HTLC as it didn't provide a sufficient amount of fees, or the
@@ -2585,6 +2620,13 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 		switchPackets []*htlcPacket
 	)
 
+	// All htlcs forwarded from this batch are subject to the inbound fee
+	// of our current forwarding policy. The outgoing link will take it
+	// into account when checking the forwarding fee.
+	l.RLock()
+	inboundFee := l.cfg.FwrdingPolicy.InboundFee
+	l.RUnlock()
+
 	for i, pd := range lockedInHtlcs {
 		idx := uint16(i)
 
@@ -2735,6 +2777,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					obfuscator:      obfuscator,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
+					inboundFee:      inboundFee,
 				}
 				switchPackets = append(
 					switchPackets, updatePacket,
@@ -2743,6 +2786,35 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				continue
 			}
 
+			// If the HTLC pushed our dust exposure beyond the
+			// maximum when it was added, we'll fail it back rather
+			// than forwarding it.
+			if pd.ExceedsDustExposure {
+				l.log.Warnf("failing htlc(%x) that exceeds max "+
+					"dust exposure", pd.RHash[:])
+
+				var failure lnwire.FailureMessage
+				update, err := l.cfg.FetchLastChannelUpdate(
+					l.ShortChanID(),
+				)
+				if err != nil {
+					failure = &lnwire.FailTemporaryNodeFailure{}
+				} else {
+					failure = lnwire.NewTemporaryChannelFailure(
+						update,
+					)
+				}
+
+				l.sendHTLCError(
+					pd.HtlcIndex, failure, obfuscator,
+					pd.SourceRef,
+				)
+				needUpdate = true
+
+				l.cfg.NotifyDustRejection()
+				continue
+			}
+
 			// TODO(roasbeef): ensure don't accept outrageous
 			// timeout for htlc
 
@@ -2803,6 +2875,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					obfuscator:      obfuscator,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
+					inboundFee:      inboundFee,
 				}
 
 				fwdPkg.FwdFilter.Set(idx)
@@ -1101,6 +1101,82 @@ func TestChannelLinkMultiHopInsufficientPayment(t *testing.T) {
 	}
 }
 
+// TestChannelLinkMultiHopDustExposure checks that an incoming HTLC that pushes
+// the channel's dust exposure beyond the configured maximum is failed back
+// with a temporary channel failure rather than forwarded.
+func TestChannelLinkMultiHopDustExposure(t *testing.T) {
+	t.Parallel()
+
+	channels, cleanUp, _, err := createClusterChannels(
+		btcutil.SatoshiPerBitcoin*3,
+		btcutil.SatoshiPerBitcoin*5)
+	if err != nil {
+		t.Fatalf("unable to create channel: %v", err)
+	}
+	defer cleanUp()
+
+	// Bob won't allow any trimmed HTLCs on his channel with Alice, so any
+	// dust HTLC he receives from her exceeds his dust exposure.
+	lnwallet.WithMaxDustExposure(1)(channels.bobToAlice)
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	if err := n.start(); err != nil {
+		t.Fatalf("unable to start three hop network: %v", err)
+	}
+	defer n.stop()
+
+	carolBandwidthBefore := n.carolChannelLink.Bandwidth()
+	secondBobBandwidthBefore := n.secondBobChannelLink.Bandwidth()
+
+	// We'll send a payment that's below the dust limit of both
+	// commitments of the Alice<->Bob channel.
+	amount := lnwire.NewMSatFromSatoshis(100)
+	htlcAmt, totalTimelock, hops := generateHops(amount, testStartingHeight,
+		n.firstBobChannelLink, n.carolChannelLink)
+
+	receiver := n.carolServer
+	firstHop := n.firstBobChannelLink.ShortChanID()
+	rhash, err := makePayment(
+		n.aliceServer, n.carolServer, firstHop, hops, amount, htlcAmt,
+		totalTimelock,
+	).Wait(30 * time.Second)
+	if err == nil {
+		t.Fatal("error haven't been received")
+	}
+	assertFailureCode(t, err, lnwire.CodeTemporaryChannelFailure)
+
+	// Wait for Alice to receive the revocation.
+	time.Sleep(100 * time.Millisecond)
+
+	// Bob should have failed the HTLC back without forwarding it to
+	// Carol, so her invoice must not be settled and the bandwidth of the
+	// Bob<->Carol channel must remain unchanged.
+	invoice, err := receiver.registry.LookupInvoice(rhash)
+	if err != nil {
+		t.Fatalf("unable to get invoice: %v", err)
+	}
+	if invoice.Terms.State == channeldb.ContractSettled {
+		t.Fatal("carol invoice have been settled")
+	}
+
+	if n.secondBobChannelLink.Bandwidth() != secondBobBandwidthBefore {
+		t.Fatal("the bandwidth of bob channel link which handles " +
+			"bob->carol channel should be the same")
+	}
+
+	if n.carolChannelLink.Bandwidth() != carolBandwidthBefore {
+		t.Fatal("the bandwidth of carol channel link which handles " +
+			"bob->carol channel should be the same")
+	}
+
+	// Finally, the rejection should have been counted by Bob's switch.
+	numRejections := n.bobServer.htlcSwitch.NumDustRejections()
+	if numRejections != 1 {
+		t.Fatalf("expected 1 dust rejection, got %v", numRejections)
+	}
+}
+
 // TestChannelLinkMultiHopUnknownPaymentHash checks that we receive remote error
 // from Alice if she received not suitable payment hash for htlc.
 func TestChannelLinkMultiHopUnknownPaymentHash(t *testing.T) {
@@ -1737,6 +1813,7 @@ func newSingleLinkTestHarness(chanAmt, chanReserve btcutil.Amount) (
 		MaxFeeAllocation:      DefaultMaxLinkFeeAllocation,
 		NotifyActiveChannel:   func(wire.OutPoint) {},
 		NotifyInactiveChannel: func(wire.OutPoint) {},
+		NotifyDustRejection:   func() {},
 	}
 
 	aliceLink := NewChannelLink(aliceCfg, aliceLc.channel)
@@ -4300,6 +4377,7 @@ func (h *persistentLinkHarness) restartLink(
 		MaxFeeAllocation:      DefaultMaxLinkFeeAllocation,
 		NotifyActiveChannel:   func(wire.OutPoint) {},
 		NotifyInactiveChannel: func(wire.OutPoint) {},
+		NotifyDustRejection:   func() {},
 	}
 
 	aliceLink := NewChannelLink(aliceCfg, aliceChannel)
@@ -5088,6 +5166,10 @@ func (*mockPackager) RemovePkg(tx *bbolt.Tx, height uint64) error {
 	return nil
 }
 
+func (*mockPackager) Wipe(tx *bbolt.Tx) error {
+	return nil
+}
+
 func (*mockPackager) AckSettleFails(tx *bbolt.Tx,
 	settleFailRefs ...channeldb.SettleFailRef) error {
 	return nil
@@ -5424,7 +5506,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("satisfied", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
-			200, 150, 0)
+			200, 150, lnwire.Fee{}, 0)
 		if result != nil {
 			t.Fatalf("expected policy to be satisfied")
 		}
@@ -5432,7 +5514,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("below minhtlc", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 100, 50,
-			200, 150, 0)
+			200, 150, lnwire.Fee{}, 0)
 		if _, ok := result.(*lnwire.FailAmountBelowMinimum); !ok {
 			t.Fatalf("expected FailAmountBelowMinimum failure code")
 		}
@@ -5440,7 +5522,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("above maxhtlc", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1500, 1200,
-			200, 150, 0)
+			200, 150, lnwire.Fee{}, 0)
 		if _, ok := result.(*lnwire.FailTemporaryChannelFailure); !ok {
 			t.Fatalf("expected FailTemporaryChannelFailure failure code")
 		}
@@ -5448,7 +5530,31 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("insufficient fee", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1005, 1000,
-			200, 150, 0)
+			200, 150, lnwire.Fee{}, 0)
+		if _, ok := result.(*lnwire.FailFeeInsufficient); !ok {
+			t.Fatalf("expected FailFeeInsufficient failure code")
+		}
+	})
+
+	t.Run("inbound discount", func(t *testing.T) {
+		result := link.HtlcSatifiesPolicy(hash, 1000, 1000,
+			200, 150, lnwire.Fee{BaseFee: -10}, 0)
+		if result != nil {
+			t.Fatalf("expected policy to be satisfied")
+		}
+	})
+
+	t.Run("inbound discount exceeds fee", func(t *testing.T) {
+		result := link.HtlcSatifiesPolicy(hash, 1000, 1000,
+			200, 150, lnwire.Fee{BaseFee: -100, FeeRate: -100}, 0)
+		if result != nil {
+			t.Fatalf("expected policy to be satisfied")
+		}
+	})
+
+	t.Run("insufficient inbound fee", func(t *testing.T) {
+		result := link.HtlcSatifiesPolicy(hash, 1015, 1000,
+			200, 150, lnwire.Fee{BaseFee: 10}, 0)
 		if _, ok := result.(*lnwire.FailFeeInsufficient); !ok {
 			t.Fatalf("expected FailFeeInsufficient failure code")
 		}
@@ -5456,7 +5562,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("expiry too soon", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
-			200, 150, 190)
+			200, 150, lnwire.Fee{}, 190)
 		if _, ok := result.(*lnwire.FailExpiryTooSoon); !ok {
 			t.Fatalf("expected FailExpiryTooSoon failure code")
 		}
@@ -5464,7 +5570,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("incorrect cltv expiry", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
-			200, 190, 0)
+			200, 190, lnwire.Fee{}, 0)
 		if _, ok := result.(*lnwire.FailIncorrectCltvExpiry); !ok {
 			t.Fatalf("expected FailIncorrectCltvExpiry failure code")
 		}
@@ -5474,7 +5580,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 	t.Run("cltv expiry too far in the future", func(t *testing.T) {
 		// Check that expiry isn't too far in the future.
 		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
-			10200, 10100, 0)
+			10200, 10100, lnwire.Fee{}, 0)
 		if _, ok := result.(*lnwire.FailExpiryTooFar); !ok {
 			t.Fatalf("expected FailExpiryTooFar failure code")
 		}
@@ -86,6 +86,11 @@ type htlcPacket struct {
 	// will be extraced from the hop payload recevived by the incoming
 	// link.
 	outgoingTimeout uint32
+
+	// inboundFee is the inbound fee of the incoming link at the time the
+	// HTLC was received. It is used by the outgoing link to determine the
+	// total fee the HTLC must pay.
+	inboundFee lnwire.Fee
 }
 
 // inKey returns the circuit key used to identify the incoming htlc.
@@ -0,0 +1,787 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/monitoring"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultStuckHtlcCheckInterval is the default interval at which the
+	// stuck HTLC monitor checks the age of all outgoing HTLCs.
+	DefaultStuckHtlcCheckInterval = time.Minute
+
+	// DefaultStuckHtlcReconnectGrace is the default time the stuck HTLC
+	// monitor gives a channel to recover after reconnecting to the peer,
+	// before it force closes the channel.
+	DefaultStuckHtlcReconnectGrace = 10 * time.Minute
+)
+
+var (
+	// stuckHtlcBucketKey is the key of the bucket in which the stuck HTLC
+	// monitor persists when it first saw each pending outgoing HTLC, so
+	// their age survives restarts.
+	stuckHtlcBucketKey = []byte("stuck-htlc-monitor")
+
+	// The following metrics export the actions of the stuck HTLC monitor
+	// at each stage if lnd is built with monitoring support.
+	stuckChannelsMetric = monitoring.NewCounter(
+		"htlcswitch_stuck_channels_total", "Total number of times a "+
+			"channel was found to have stuck outgoing HTLCs.",
+	)
+	stuckHtlcsMetric = monitoring.NewCounter(
+		"htlcswitch_stuck_htlcs_total", "Total number of stuck "+
+			"outgoing HTLCs found.",
+	)
+	stuckReconnectsMetric = monitoring.NewCounter(
+		"htlcswitch_stuck_htlc_reconnects_total", "Total number of "+
+			"reconnections to peers because of stuck HTLCs.",
+	)
+	stuckFailedReconnectsMetric = monitoring.NewCounter(
+		"htlcswitch_stuck_htlc_failed_reconnects_total", "Total "+
+			"number of failed reconnections to peers because of "+
+			"stuck HTLCs.",
+	)
+	stuckRecoveredMetric = monitoring.NewCounter(
+		"htlcswitch_stuck_htlc_recovered_total", "Total number of "+
+			"channels whose stuck HTLCs were resolved after "+
+			"reconnecting.",
+	)
+	stuckForceClosesMetric = monitoring.NewCounter(
+		"htlcswitch_stuck_htlc_force_closes_total", "Total number of "+
+			"channels force closed because of stuck HTLCs.",
+	)
+	stuckFailedForceClosesMetric = monitoring.NewCounter(
+		"htlcswitch_stuck_htlc_failed_force_closes_total", "Total "+
+			"number of failed force closes because of stuck HTLCs.",
+	)
+)
+
+// StuckHtlcStage denotes the stage of the escalation of a channel with stuck
+// HTLCs.
+type StuckHtlcStage uint8
+
+const (
+	// StuckHtlcReconnect is the first stage, in which the connection to
+	// the peer is re-established in order to have the channel
+	// re-synchronized through channel_reestablish.
+	StuckHtlcReconnect StuckHtlcStage = iota
+
+	// StuckHtlcRecovered is reached if the stuck HTLCs were resolved
+	// after reconnecting to the peer.
+	StuckHtlcRecovered
+
+	// StuckHtlcForceClose is the final stage, in which the channel is
+	// force closed as its HTLCs remained stuck after reconnecting.
+	StuckHtlcForceClose
+)
+
+// String returns a human readable representation of the stage.
+func (s StuckHtlcStage) String() string {
+	switch s {
+	case StuckHtlcReconnect:
+		return "Reconnect"
+
+	case StuckHtlcRecovered:
+		return "Recovered"
+
+	case StuckHtlcForceClose:
+		return "ForceClose"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(s))
+	}
+}
+
+// StuckHtlcEvent is dispatched to all subscribers of the StuckHtlcMonitor
+// each time a channel with stuck HTLCs moves to another stage.
+type StuckHtlcEvent struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// Peer is the identity key of the channel peer.
+	Peer *btcec.PublicKey
+
+	// Stage is the stage the channel moved to.
+	Stage StuckHtlcStage
+
+	// Htlcs is the set of outgoing HTLCs that were considered stuck. It is
+	// empty for the StuckHtlcRecovered stage.
+	Htlcs []channeldb.HTLC
+
+	// Err is the error the action of the stage failed with, if any.
+	Err error
+}
+
+// StuckHtlcStats is a collection of in-memory statistics of the actions the
+// stuck HTLC monitor has taken since its creation. Each action is exported as
+// a metric as well.
+type StuckHtlcStats struct {
+	mu sync.Mutex
+
+	// NumStuckChannels is the total number of times a channel was found
+	// to have stuck HTLCs.
+	NumStuckChannels int
+
+	// NumStuckHtlcs is the total number of stuck HTLCs found.
+	NumStuckHtlcs int
+
+	// NumReconnects is the total number of reconnection attempts made.
+	NumReconnects int
+
+	// NumFailedReconnects is the total number of reconnection attempts
+	// that failed.
+	NumFailedReconnects int
+
+	// NumRecovered is the total number of channels whose stuck HTLCs were
+	// resolved after reconnecting.
+	NumRecovered int
+
+	// NumForceCloses is the total number of channels that were force
+	// closed because of stuck HTLCs.
+	NumForceCloses int
+
+	// NumFailedForceCloses is the total number of force close attempts
+	// that failed.
+	NumFailedForceCloses int
+}
+
+// stuckChannel records a channel found to have stuck HTLCs.
+func (s *StuckHtlcStats) stuckChannel(numHtlcs int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.NumStuckChannels++
+	s.NumStuckHtlcs += numHtlcs
+
+	stuckChannelsMetric.Inc()
+	for i := 0; i < numHtlcs; i++ {
+		stuckHtlcsMetric.Inc()
+	}
+}
+
+// reconnect records a reconnection attempt and whether it failed.
+func (s *StuckHtlcStats) reconnect(failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.NumReconnects++
+	stuckReconnectsMetric.Inc()
+	if failed {
+		s.NumFailedReconnects++
+		stuckFailedReconnectsMetric.Inc()
+	}
+}
+
+// recovered records a channel that recovered after reconnecting.
+func (s *StuckHtlcStats) recovered() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.NumRecovered++
+	stuckRecoveredMetric.Inc()
+}
+
+// forceClose records a force close attempt and whether it failed.
+func (s *StuckHtlcStats) forceClose(failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if failed {
+		s.NumFailedForceCloses++
+		stuckFailedForceClosesMetric.Inc()
+		return
+	}
+	s.NumForceCloses++
+	stuckForceClosesMetric.Inc()
+}
+
+// String returns a human readable summary of the monitor's metrics.
+func (s *StuckHtlcStats) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fmt.Sprintf("stuck(channels=%d htlcs=%d) reconnects(total=%d "+
+		"failed=%d recovered=%d) force_closes(total=%d failed=%d)",
+		s.NumStuckChannels, s.NumStuckHtlcs, s.NumReconnects,
+		s.NumFailedReconnects, s.NumRecovered, s.NumForceCloses,
+		s.NumFailedForceCloses)
+}
+
+// Copy returns a copy of the current stats.
+func (s *StuckHtlcStats) Copy() StuckHtlcStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return StuckHtlcStats{
+		NumStuckChannels:     s.NumStuckChannels,
+		NumStuckHtlcs:        s.NumStuckHtlcs,
+		NumReconnects:        s.NumReconnects,
+		NumFailedReconnects:  s.NumFailedReconnects,
+		NumRecovered:         s.NumRecovered,
+		NumForceCloses:       s.NumForceCloses,
+		NumFailedForceCloses: s.NumFailedForceCloses,
+	}
+}
+
+// StuckHtlcMonitorConfig houses the parameters and resources the stuck HTLC
+// monitor requires.
+type StuckHtlcMonitorConfig struct {
+	// MaxPendingTime is the duration after which a pending outgoing HTLC
+	// is considered stuck. Zero disables the time based check.
+	MaxPendingTime time.Duration
+
+	// MaxPendingBlocks is the number of blocks after which a pending
+	// outgoing HTLC is considered stuck. Zero disables the block based
+	// check.
+	MaxPendingBlocks uint32
+
+	// ReconnectGrace is the time a channel is given to recover after
+	// reconnecting to the peer, before it is force closed.
+	ReconnectGrace time.Duration
+
+	// DB is the database in which the monitor persists when it first saw
+	// each pending outgoing HTLC.
+	DB *channeldb.DB
+
+	// FetchAllOpenChannels returns all of our open channels, whose
+	// outgoing HTLCs are monitored.
+	FetchAllOpenChannels func() ([]*channeldb.OpenChannel, error)
+
+	// BestHeight returns the current best height of the main chain.
+	BestHeight func() uint32
+
+	// Reconnect tears down the connection to the given peer, if any, and
+	// connects to it again, which re-synchronizes all channels with the
+	// peer through channel_reestablish.
+	Reconnect func(*btcec.PublicKey) error
+
+	// ForceClose force closes the given channel through the chain
+	// arbitrator, which resolves its HTLCs on chain.
+	ForceClose func(*channeldb.OpenChannel) error
+
+	// Ticker fires whenever the monitor should check the age of all
+	// outgoing HTLCs.
+	Ticker ticker.Ticker
+
+	// Now returns the current time. It can be overridden in tests.
+	Now func() time.Time
+}
+
+// trackedHtlc records when the monitor first saw an outgoing HTLC.
+type trackedHtlc struct {
+	htlc        channeldb.HTLC
+	firstSeen   time.Time
+	firstHeight uint32
+}
+
+// trackedHtlcKey identifies a tracked HTLC across all channels.
+type trackedHtlcKey struct {
+	chanPoint wire.OutPoint
+	htlcIndex uint64
+}
+
+// encode returns the key the tracked HTLC is persisted under.
+func (k *trackedHtlcKey) encode() []byte {
+	var b bytes.Buffer
+	b.Write(k.chanPoint.Hash[:])
+	binary.Write(&b, binary.BigEndian, k.chanPoint.Index)
+	binary.Write(&b, binary.BigEndian, k.htlcIndex)
+
+	return b.Bytes()
+}
+
+// decode reads a persisted key of a tracked HTLC.
+func (k *trackedHtlcKey) decode(key []byte) error {
+	r := bytes.NewReader(key)
+	if _, err := io.ReadFull(r, k.chanPoint.Hash[:]); err != nil {
+		return err
+	}
+	err := binary.Read(r, binary.BigEndian, &k.chanPoint.Index)
+	if err != nil {
+		return err
+	}
+
+	return binary.Read(r, binary.BigEndian, &k.htlcIndex)
+}
+
+// encodeTrackedHtlc returns the persisted value of a tracked HTLC, which is
+// when the monitor first saw it.
+func encodeTrackedHtlc(tracked *trackedHtlc) []byte {
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, tracked.firstSeen.UnixNano())
+	binary.Write(&b, binary.BigEndian, tracked.firstHeight)
+
+	return b.Bytes()
+}
+
+// decodeTrackedHtlc reads the persisted value of a tracked HTLC.
+func decodeTrackedHtlc(value []byte) (*trackedHtlc, error) {
+	var (
+		r         = bytes.NewReader(value)
+		firstSeen int64
+		tracked   trackedHtlc
+	)
+	if err := binary.Read(r, binary.BigEndian, &firstSeen); err != nil {
+		return nil, err
+	}
+	err := binary.Read(r, binary.BigEndian, &tracked.firstHeight)
+	if err != nil {
+		return nil, err
+	}
+	tracked.firstSeen = time.Unix(0, firstSeen)
+
+	return &tracked, nil
+}
+
+// monitoredChannel is the state the monitor keeps for each channel with
+// pending outgoing HTLCs.
+type monitoredChannel struct {
+	// htlcs are the pending outgoing HTLCs of the channel, keyed by their
+	// HTLC index.
+	htlcs map[uint64]*trackedHtlc
+
+	// reconnectedAt is the time the monitor reconnected to the peer
+	// because of stuck HTLCs. It is zero if the channel isn't stuck.
+	reconnectedAt time.Time
+}
+
+// StuckHtlcMonitor watches the outgoing HTLCs of all our open channels, and
+// escalates if any of them remains pending for too long, as that locks up
+// our liquidity and risks a late on-chain resolution. It first reconnects to
+// the peer, and force closes the channel if that doesn't resolve the HTLCs
+// within the reconnect grace period.
+//
+// The age of an HTLC is counted from when the monitor first saw it, which is
+// persisted so that restarts don't reset the clock.
+type StuckHtlcMonitor struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *StuckHtlcMonitorConfig
+
+	stats *StuckHtlcStats
+
+	ntfnServer *subscribe.Server
+
+	// channels is only accessed by the monitor goroutine.
+	channels map[wire.OutPoint]*monitoredChannel
+
+	// added and removed are the tracked HTLCs that are yet to be written
+	// to or removed from the database. They're only accessed by the
+	// monitor goroutine.
+	added   map[trackedHtlcKey]*trackedHtlc
+	removed map[trackedHtlcKey]struct{}
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewStuckHtlcMonitor creates a new stuck HTLC monitor.
+func NewStuckHtlcMonitor(cfg *StuckHtlcMonitorConfig) *StuckHtlcMonitor {
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	return &StuckHtlcMonitor{
+		cfg:        cfg,
+		stats:      &StuckHtlcStats{},
+		ntfnServer: subscribe.NewServer(),
+		channels:   make(map[wire.OutPoint]*monitoredChannel),
+		added:      make(map[trackedHtlcKey]*trackedHtlc),
+		removed:    make(map[trackedHtlcKey]struct{}),
+		quit:       make(chan struct{}),
+	}
+}
+
+// Start launches the monitor's periodic HTLC checks.
+func (m *StuckHtlcMonitor) Start() error {
+	var err error
+	m.started.Do(func() {
+		log.Infof("Stuck HTLC monitor starting")
+
+		if err = m.loadTrackedHtlcs(); err != nil {
+			return
+		}
+
+		if err = m.ntfnServer.Start(); err != nil {
+			return
+		}
+
+		m.cfg.Ticker.Resume()
+
+		m.wg.Add(1)
+		go m.monitorLoop()
+	})
+
+	return err
+}
+
+// Stop halts the monitor's periodic HTLC checks.
+func (m *StuckHtlcMonitor) Stop() error {
+	m.stopped.Do(func() {
+		log.Infof("Stuck HTLC monitor shutting down")
+
+		close(m.quit)
+		m.wg.Wait()
+
+		m.cfg.Ticker.Stop()
+		m.ntfnServer.Stop()
+	})
+
+	return nil
+}
+
+// SubscribeEvents returns a subscribe.Client that receives a StuckHtlcEvent
+// each time a channel with stuck HTLCs moves to another stage.
+func (m *StuckHtlcMonitor) SubscribeEvents() (*subscribe.Client, error) {
+	return m.ntfnServer.Subscribe()
+}
+
+// Stats returns a copy of the monitor's statistics.
+func (m *StuckHtlcMonitor) Stats() StuckHtlcStats {
+	return m.stats.Copy()
+}
+
+// loadTrackedHtlcs restores the pending outgoing HTLCs the monitor saw before
+// it was restarted, along with when it first saw them.
+func (m *StuckHtlcMonitor) loadTrackedHtlcs() error {
+	return m.cfg.DB.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(stuckHtlcBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var key trackedHtlcKey
+			if err := key.decode(k); err != nil {
+				return err
+			}
+			tracked, err := decodeTrackedHtlc(v)
+			if err != nil {
+				return err
+			}
+			tracked.htlc.HtlcIndex = key.htlcIndex
+
+			monitored, ok := m.channels[key.chanPoint]
+			if !ok {
+				monitored = &monitoredChannel{
+					htlcs: make(map[uint64]*trackedHtlc),
+				}
+				m.channels[key.chanPoint] = monitored
+			}
+			monitored.htlcs[key.htlcIndex] = tracked
+
+			return nil
+		})
+	})
+}
+
+// storeTrackedHtlcs writes the HTLCs the monitor started or stopped tracking
+// since the last call to the database.
+func (m *StuckHtlcMonitor) storeTrackedHtlcs() error {
+	if len(m.added) == 0 && len(m.removed) == 0 {
+		return nil
+	}
+
+	err := m.cfg.DB.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(stuckHtlcBucketKey)
+		if err != nil {
+			return err
+		}
+
+		for key := range m.removed {
+			if err := bucket.Delete(key.encode()); err != nil {
+				return err
+			}
+		}
+		for key, tracked := range m.added {
+			err := bucket.Put(
+				key.encode(), encodeTrackedHtlc(tracked),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.added = make(map[trackedHtlcKey]*trackedHtlc)
+	m.removed = make(map[trackedHtlcKey]struct{})
+
+	return nil
+}
+
+// trackHtlc starts tracking the given outgoing HTLC of the channel.
+func (m *StuckHtlcMonitor) trackHtlc(monitored *monitoredChannel,
+	chanPoint wire.OutPoint, tracked *trackedHtlc) {
+
+	key := trackedHtlcKey{
+		chanPoint: chanPoint,
+		htlcIndex: tracked.htlc.HtlcIndex,
+	}
+	monitored.htlcs[key.htlcIndex] = tracked
+	m.added[key] = tracked
+	delete(m.removed, key)
+}
+
+// untrackHtlc stops tracking the outgoing HTLC of the channel with the given
+// index.
+func (m *StuckHtlcMonitor) untrackHtlc(monitored *monitoredChannel,
+	chanPoint wire.OutPoint, htlcIndex uint64) {
+
+	key := trackedHtlcKey{
+		chanPoint: chanPoint,
+		htlcIndex: htlcIndex,
+	}
+	delete(monitored.htlcs, htlcIndex)
+	delete(m.added, key)
+	m.removed[key] = struct{}{}
+}
+
+// forgetChannel stops tracking the given channel and all of its HTLCs.
+func (m *StuckHtlcMonitor) forgetChannel(chanPoint wire.OutPoint) {
+	monitored, ok := m.channels[chanPoint]
+	if !ok {
+		return
+	}
+
+	for htlcIndex := range monitored.htlcs {
+		m.untrackHtlc(monitored, chanPoint, htlcIndex)
+	}
+	delete(m.channels, chanPoint)
+}
+
+// monitorLoop checks the outgoing HTLCs every time the ticker fires.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *StuckHtlcMonitor) monitorLoop() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-m.cfg.Ticker.Ticks():
+			if err := m.checkHtlcs(); err != nil {
+				log.Errorf("Unable to check for stuck HTLCs: %v",
+					err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// checkHtlcs updates the set of pending outgoing HTLCs of all open channels,
+// and escalates for each channel that has stuck HTLCs.
+func (m *StuckHtlcMonitor) checkHtlcs() error {
+	channels, err := m.cfg.FetchAllOpenChannels()
+	if err != nil {
+		return err
+	}
+
+	now := m.cfg.Now()
+	height := m.cfg.BestHeight()
+
+	open := make(map[wire.OutPoint]struct{}, len(channels))
+	for _, channel := range channels {
+		// Channels that are pending or already being closed are of no
+		// concern to us, as there's nothing left to escalate to.
+		if channel.IsPending ||
+			channel.ChanStatus() != channeldb.ChanStatusDefault {
+
+			continue
+		}
+
+		chanPoint := channel.FundingOutpoint
+		open[chanPoint] = struct{}{}
+
+		m.checkChannel(channel, now, height)
+	}
+
+	// Forget about all channels that have been closed in the meantime.
+	for chanPoint := range m.channels {
+		if _, ok := open[chanPoint]; !ok {
+			m.forgetChannel(chanPoint)
+		}
+	}
+
+	return m.storeTrackedHtlcs()
+}
+
+// checkChannel updates the pending outgoing HTLCs of a single channel and
+// moves it to the next stage if some of them are stuck.
+func (m *StuckHtlcMonitor) checkChannel(channel *channeldb.OpenChannel,
+	now time.Time, height uint32) {
+
+	chanPoint := channel.FundingOutpoint
+
+	outgoing := outgoingHtlcs(channel)
+	monitored, ok := m.channels[chanPoint]
+	if !ok {
+		if len(outgoing) == 0 {
+			return
+		}
+
+		monitored = &monitoredChannel{
+			htlcs: make(map[uint64]*trackedHtlc),
+		}
+		m.channels[chanPoint] = monitored
+	}
+
+	// Forget about the HTLCs that were resolved, and start tracking the
+	// ones we haven't seen before.
+	for htlcIndex := range monitored.htlcs {
+		if _, ok := outgoing[htlcIndex]; !ok {
+			m.untrackHtlc(monitored, chanPoint, htlcIndex)
+		}
+	}
+	for htlcIndex, htlc := range outgoing {
+		// HTLCs restored from the database only carry their index.
+		if tracked, ok := monitored.htlcs[htlcIndex]; ok {
+			tracked.htlc = htlc
+			continue
+		}
+
+		m.trackHtlc(monitored, chanPoint, &trackedHtlc{
+			htlc:        htlc,
+			firstSeen:   now,
+			firstHeight: height,
+		})
+	}
+
+	var stuck []channeldb.HTLC
+	for _, tracked := range monitored.htlcs {
+		if m.isStuck(tracked, now, height) {
+			stuck = append(stuck, tracked.htlc)
+		}
+	}
+
+	switch {
+	// If the channel recovered after we reconnected, there's nothing left
+	// to do.
+	case len(stuck) == 0:
+		if !monitored.reconnectedAt.IsZero() {
+			log.Infof("Stuck HTLCs of ChannelPoint(%v) were "+
+				"resolved after reconnecting", chanPoint)
+
+			m.stats.recovered()
+			m.notify(&StuckHtlcEvent{
+				ChanPoint: chanPoint,
+				Peer:      channel.IdentityPub,
+				Stage:     StuckHtlcRecovered,
+			})
+		}
+
+		if len(monitored.htlcs) == 0 {
+			delete(m.channels, chanPoint)
+		} else {
+			monitored.reconnectedAt = time.Time{}
+		}
+
+	// If we haven't tried yet, we'll first reconnect to the peer, which
+	// has the channel state re-synchronized through channel_reestablish.
+	case monitored.reconnectedAt.IsZero():
+		log.Warnf("ChannelPoint(%v) has %d stuck outgoing HTLCs, "+
+			"reconnecting to peer %x", chanPoint, len(stuck),
+			channel.IdentityPub.SerializeCompressed())
+
+		m.stats.stuckChannel(len(stuck))
+
+		err := m.cfg.Reconnect(channel.IdentityPub)
+		if err != nil {
+			log.Errorf("Unable to reconnect to peer %x: %v",
+				channel.IdentityPub.SerializeCompressed(), err)
+		}
+		m.stats.reconnect(err != nil)
+
+		// Even if we failed to reconnect, the peer is given the grace
+		// period to come back before we escalate.
+		monitored.reconnectedAt = now
+
+		m.notify(&StuckHtlcEvent{
+			ChanPoint: chanPoint,
+			Peer:      channel.IdentityPub,
+			Stage:     StuckHtlcReconnect,
+			Htlcs:     stuck,
+			Err:       err,
+		})
+
+	// If the HTLCs remained stuck after the grace period, we'll go to
+	// chain.
+	case now.Sub(monitored.reconnectedAt) >= m.cfg.ReconnectGrace:
+		log.Warnf("ChannelPoint(%v) still has %d stuck outgoing HTLCs "+
+			"after reconnecting, force closing", chanPoint,
+			len(stuck))
+
+		err := m.cfg.ForceClose(channel)
+		if err != nil {
+			// We'll retry on the next check.
+			log.Errorf("Unable to force close ChannelPoint(%v): %v",
+				chanPoint, err)
+		} else {
+			m.forgetChannel(chanPoint)
+		}
+		m.stats.forceClose(err != nil)
+
+		m.notify(&StuckHtlcEvent{
+			ChanPoint: chanPoint,
+			Peer:      channel.IdentityPub,
+			Stage:     StuckHtlcForceClose,
+			Htlcs:     stuck,
+			Err:       err,
+		})
+	}
+}
+
+// isStuck returns whether the HTLC has been pending for longer than allowed.
+func (m *StuckHtlcMonitor) isStuck(tracked *trackedHtlc, now time.Time,
+	height uint32) bool {
+
+	if m.cfg.MaxPendingTime > 0 &&
+		now.Sub(tracked.firstSeen) >= m.cfg.MaxPendingTime {
+
+		return true
+	}
+
+	return m.cfg.MaxPendingBlocks > 0 &&
+		height >= tracked.firstHeight+m.cfg.MaxPendingBlocks
+}
+
+// notify dispatches the event to all subscribers and logs the updated stats.
+func (m *StuckHtlcMonitor) notify(event *StuckHtlcEvent) {
+	log.Infof("Stuck HTLC monitor stats: %v", m.stats)
+
+	if err := m.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send stuck HTLC event: %v", err)
+	}
+}
+
+// outgoingHtlcs returns the outgoing HTLCs that are locked in on either
+// commitment of the channel, keyed by their HTLC index.
+func outgoingHtlcs(channel *channeldb.OpenChannel) map[uint64]channeldb.HTLC {
+	htlcs := make(map[uint64]channeldb.HTLC)
+	commitments := []channeldb.ChannelCommitment{
+		channel.LocalCommitment, channel.RemoteCommitment,
+	}
+	for _, commitment := range commitments {
+		for _, htlc := range commitment.Htlcs {
+			if htlc.Incoming {
+				continue
+			}
+
+			htlcs[htlc.HtlcIndex] = htlc
+		}
+	}
+
+	return htlcs
+}
@@ -0,0 +1,337 @@
+package htlcswitch
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// stuckHtlcTestContext houses the stuck HTLC monitor under test along with
+// the mocked resources it uses.
+type stuckHtlcTestContext struct {
+	t *testing.T
+
+	db      *channeldb.DB
+	dbPath  string
+	monitor *StuckHtlcMonitor
+	events  *subscribe.Client
+
+	channels []*channeldb.OpenChannel
+	now      time.Time
+	height   uint32
+
+	reconnects  []*btcec.PublicKey
+	forceCloses []wire.OutPoint
+	closeErr    error
+}
+
+func newStuckHtlcTestContext(t *testing.T) *stuckHtlcTestContext {
+	dbPath, err := ioutil.TempDir("", "stuckhtlc")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	db, err := channeldb.Open(dbPath)
+	if err != nil {
+		os.RemoveAll(dbPath)
+		t.Fatalf("unable to open db: %v", err)
+	}
+
+	ctx := &stuckHtlcTestContext{
+		t:      t,
+		db:     db,
+		dbPath: dbPath,
+		now:    time.Unix(1000000, 0),
+		height: 100,
+	}
+	ctx.start()
+
+	return ctx
+}
+
+// start creates and starts a new monitor, and subscribes to its events.
+func (ctx *stuckHtlcTestContext) start() {
+	ctx.monitor = NewStuckHtlcMonitor(&StuckHtlcMonitorConfig{
+		MaxPendingTime:   time.Hour,
+		MaxPendingBlocks: 10,
+		ReconnectGrace:   10 * time.Minute,
+		DB:               ctx.db,
+		FetchAllOpenChannels: func() ([]*channeldb.OpenChannel,
+			error) {
+
+			return ctx.channels, nil
+		},
+		BestHeight: func() uint32 {
+			return ctx.height
+		},
+		Reconnect: func(pub *btcec.PublicKey) error {
+			ctx.reconnects = append(ctx.reconnects, pub)
+			return nil
+		},
+		ForceClose: func(channel *channeldb.OpenChannel) error {
+			ctx.forceCloses = append(
+				ctx.forceCloses, channel.FundingOutpoint,
+			)
+			return ctx.closeErr
+		},
+		Ticker: ticker.NewForce(time.Hour),
+		Now: func() time.Time {
+			return ctx.now
+		},
+	})
+	if err := ctx.monitor.Start(); err != nil {
+		ctx.t.Fatalf("unable to start monitor: %v", err)
+	}
+
+	events, err := ctx.monitor.SubscribeEvents()
+	if err != nil {
+		ctx.t.Fatalf("unable to subscribe to events: %v", err)
+	}
+	ctx.events = events
+}
+
+// restart stops the monitor and starts a new one on the same database.
+func (ctx *stuckHtlcTestContext) restart() {
+	ctx.events.Cancel()
+	ctx.monitor.Stop()
+	ctx.start()
+}
+
+func (ctx *stuckHtlcTestContext) stop() {
+	ctx.events.Cancel()
+	ctx.monitor.Stop()
+	ctx.db.Close()
+	os.RemoveAll(ctx.dbPath)
+}
+
+// addChannel adds an open channel with the given outgoing HTLC indexes, along
+// with an incoming HTLC that must never be considered stuck.
+func (ctx *stuckHtlcTestContext) addChannel(index uint32,
+	htlcIndexes ...uint64) *channeldb.OpenChannel {
+
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		ctx.t.Fatalf("unable to create key: %v", err)
+	}
+
+	channel := &channeldb.OpenChannel{
+		FundingOutpoint: wire.OutPoint{
+			Hash:  chainhash.Hash{1},
+			Index: index,
+		},
+		IdentityPub: priv.PubKey(),
+	}
+	ctx.setHtlcs(channel, htlcIndexes...)
+	ctx.channels = append(ctx.channels, channel)
+
+	return channel
+}
+
+// setHtlcs replaces the outgoing HTLCs of the channel.
+func (ctx *stuckHtlcTestContext) setHtlcs(channel *channeldb.OpenChannel,
+	htlcIndexes ...uint64) {
+
+	htlcs := []channeldb.HTLC{{Incoming: true, HtlcIndex: 0}}
+	for _, htlcIndex := range htlcIndexes {
+		htlcs = append(htlcs, channeldb.HTLC{HtlcIndex: htlcIndex})
+	}
+	channel.LocalCommitment.Htlcs = htlcs
+}
+
+// check advances the time and height, then runs a check of all HTLCs.
+func (ctx *stuckHtlcTestContext) check(elapsed time.Duration,
+	blocks uint32) {
+
+	ctx.now = ctx.now.Add(elapsed)
+	ctx.height += blocks
+
+	if err := ctx.monitor.checkHtlcs(); err != nil {
+		ctx.t.Fatalf("unable to check htlcs: %v", err)
+	}
+}
+
+// assertEvent asserts that the next event is of the given stage for the given
+// channel and carries the expected number of HTLCs.
+func (ctx *stuckHtlcTestContext) assertEvent(channel *channeldb.OpenChannel,
+	stage StuckHtlcStage, numHtlcs int) {
+
+	ctx.t.Helper()
+
+	select {
+	case update := <-ctx.events.Updates():
+		event := update.(*StuckHtlcEvent)
+		if event.ChanPoint != channel.FundingOutpoint {
+			ctx.t.Fatalf("expected event for %v, got %v",
+				channel.FundingOutpoint, event.ChanPoint)
+		}
+		if event.Stage != stage {
+			ctx.t.Fatalf("expected stage %v, got %v", stage,
+				event.Stage)
+		}
+		if len(event.Htlcs) != numHtlcs {
+			ctx.t.Fatalf("expected %d htlcs, got %d", numHtlcs,
+				len(event.Htlcs))
+		}
+
+	case <-time.After(5 * time.Second):
+		ctx.t.Fatalf("no %v event received", stage)
+	}
+}
+
+// assertNoEvent asserts that no event is pending.
+func (ctx *stuckHtlcTestContext) assertNoEvent() {
+	ctx.t.Helper()
+
+	select {
+	case update := <-ctx.events.Updates():
+		ctx.t.Fatalf("unexpected event: %v", update)
+
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// TestStuckHtlcMonitorEscalation asserts that the monitor reconnects to the
+// peer of a channel with stuck outgoing HTLCs, and force closes the channel
+// once the reconnect grace period expired without the HTLCs being resolved.
+func TestStuckHtlcMonitorEscalation(t *testing.T) {
+	t.Parallel()
+
+	ctx := newStuckHtlcTestContext(t)
+	defer ctx.stop()
+
+	channel := ctx.addChannel(0, 1, 2)
+
+	// The HTLCs are first seen now, so they aren't stuck yet.
+	ctx.check(0, 0)
+	ctx.assertNoEvent()
+
+	// After the block limit is reached, both outgoing HTLCs are stuck and
+	// we should reconnect to the peer.
+	ctx.check(time.Minute, 10)
+	ctx.assertEvent(channel, StuckHtlcReconnect, 2)
+	if len(ctx.reconnects) != 1 ||
+		!ctx.reconnects[0].IsEqual(channel.IdentityPub) {
+
+		t.Fatalf("expected reconnect to peer, got %v", ctx.reconnects)
+	}
+
+	// Within the grace period, nothing should happen.
+	ctx.check(5*time.Minute, 1)
+	ctx.assertNoEvent()
+
+	// If the force close fails, it should be retried on the next check.
+	ctx.closeErr = errors.New("unable to force close")
+	ctx.check(5*time.Minute, 1)
+	ctx.assertEvent(channel, StuckHtlcForceClose, 2)
+
+	ctx.closeErr = nil
+	ctx.check(time.Minute, 0)
+	ctx.assertEvent(channel, StuckHtlcForceClose, 2)
+	if len(ctx.forceCloses) != 2 {
+		t.Fatalf("expected 2 force close attempts, got %d",
+			len(ctx.forceCloses))
+	}
+
+	stats := ctx.monitor.Stats()
+	if stats.NumStuckChannels != 1 || stats.NumStuckHtlcs != 2 ||
+		stats.NumReconnects != 1 || stats.NumForceCloses != 1 ||
+		stats.NumFailedForceCloses != 1 {
+
+		t.Fatalf("unexpected stats: %v", &stats)
+	}
+}
+
+// TestStuckHtlcMonitorRecovery asserts that a channel whose stuck HTLCs are
+// resolved after reconnecting isn't force closed, and that the age of the
+// remaining HTLCs is tracked independently.
+func TestStuckHtlcMonitorRecovery(t *testing.T) {
+	t.Parallel()
+
+	ctx := newStuckHtlcTestContext(t)
+	defer ctx.stop()
+
+	channel := ctx.addChannel(0, 1)
+	ctx.check(0, 0)
+
+	// A second HTLC is added half way.
+	ctx.check(30*time.Minute, 0)
+	ctx.setHtlcs(channel, 1, 2)
+	ctx.check(0, 0)
+
+	// Once the time limit of the first HTLC is reached, we should
+	// reconnect.
+	ctx.check(30*time.Minute, 0)
+	ctx.assertEvent(channel, StuckHtlcReconnect, 1)
+
+	// The stuck HTLC is resolved after reconnecting, so the channel has
+	// recovered even though the second HTLC is still pending.
+	ctx.setHtlcs(channel, 2)
+	ctx.check(time.Minute, 0)
+	ctx.assertEvent(channel, StuckHtlcRecovered, 0)
+
+	// The grace period expiring shouldn't cause a force close, as the
+	// remaining HTLC isn't stuck yet.
+	ctx.check(10*time.Minute, 0)
+	ctx.assertNoEvent()
+	if len(ctx.forceCloses) != 0 {
+		t.Fatalf("channel shouldn't have been force closed")
+	}
+
+	// Once the second HTLC is stuck as well, the escalation starts over.
+	ctx.check(20*time.Minute, 0)
+	ctx.assertEvent(channel, StuckHtlcReconnect, 1)
+	if len(ctx.reconnects) != 2 {
+		t.Fatalf("expected 2 reconnects, got %d", len(ctx.reconnects))
+	}
+
+	stats := ctx.monitor.Stats()
+	if stats.NumRecovered != 1 || stats.NumStuckChannels != 2 {
+		t.Fatalf("unexpected stats: %v", &stats)
+	}
+}
+
+// TestStuckHtlcMonitorRestart asserts that the age of pending outgoing HTLCs
+// isn't reset when the monitor is restarted, and that HTLCs of closed channels
+// are forgotten.
+func TestStuckHtlcMonitorRestart(t *testing.T) {
+	t.Parallel()
+
+	ctx := newStuckHtlcTestContext(t)
+	defer ctx.stop()
+
+	channel := ctx.addChannel(0, 1)
+	closed := ctx.addChannel(1, 1)
+	ctx.check(0, 0)
+
+	// The second channel is closed before the restart, so it must not be
+	// tracked any longer.
+	ctx.channels = ctx.channels[:1]
+	ctx.check(30*time.Minute, 5)
+	ctx.assertNoEvent()
+
+	ctx.restart()
+
+	// The HTLC was first seen before the restart, so it should be stuck
+	// once the block limit is reached counting from that time.
+	ctx.check(time.Minute, 5)
+	ctx.assertEvent(channel, StuckHtlcReconnect, 1)
+
+	// The escalation stage isn't persisted, so after another restart we
+	// reconnect to the peer right away. The HTLC of the closed channel,
+	// which shows up again with the same index, must be treated as new.
+	ctx.channels = append(ctx.channels, closed)
+	ctx.restart()
+	ctx.check(0, 0)
+	ctx.assertEvent(channel, StuckHtlcReconnect, 1)
+	ctx.assertNoEvent()
+	ctx.check(time.Minute, 9)
+	ctx.assertNoEvent()
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/monitoring"
 	"github.com/lightningnetwork/lnd/ticker"
 )
 
@@ -67,6 +68,13 @@ var (
 	ErrUnreadableFailureMessage = errors.New("unreadable failure message")
 )
 
+// dustRejectionsMetric exports the number of HTLCs that were failed because of
+// a channel's max dust exposure if lnd is built with monitoring support.
+var dustRejectionsMetric = monitoring.NewCounter(
+	"htlcswitch_dust_rejections_total", "Total number of HTLCs that were "+
+		"failed because they would exceed a channel's max dust exposure.",
+)
+
 // plexPacket encapsulates switch packet and adds error channel to receive
 // error from request handler.
 type plexPacket struct {
@@ -180,6 +188,15 @@ type Switch struct {
 	started  int32 // To be used atomically.
 	shutdown int32 // To be used atomically.
 
+	// draining is set once the switch has been instructed to stop
+	// forwarding new HTLCs in preparation of a shutdown.
+	draining int32 // To be used atomically.
+
+	// dustRejections is the total number of HTLCs that were failed by
+	// any of our links because they would have pushed the channel's dust
+	// exposure beyond the configured maximum.
+	dustRejections uint64 // To be used atomically.
+
 	// bestHeight is the best known height of the main chain. The links will
 	// be used this information to govern decisions based on HTLC timeouts.
 	// This will be retrieved by the registered links atomically.
@@ -991,9 +1008,11 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 	// payment circuit within our internal state so we can properly forward
 	// the ultimate settle message back latter.
 	case *lnwire.UpdateAddHTLC:
-		// Check if the node is set to reject all onward HTLCs and also make
-		// sure that HTLC is not from the source node.
-		if s.cfg.RejectHTLC && packet.incomingChanID != hop.Source {
+		// Check if the node is set to reject all onward HTLCs, or is
+		// draining in preparation of a shutdown, and also make sure
+		// that HTLC is not from the source node.
+		rejectHTLC := s.cfg.RejectHTLC || s.IsDraining()
+		if rejectHTLC && packet.incomingChanID != hop.Source {
 			failure := &lnwire.FailChannelDisabled{}
 			addErr := fmt.Errorf("unable to forward any htlcs")
 
@@ -1053,7 +1072,8 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			err := link.HtlcSatifiesPolicy(
 				htlc.PaymentHash, packet.incomingAmount,
 				packet.amount, packet.incomingTimeout,
-				packet.outgoingTimeout, currentHeight,
+				packet.outgoingTimeout, packet.inboundFee,
+				currentHeight,
 			)
 			if err != nil {
 				linkErrs[link.ShortChanID()] = err
@@ -1504,9 +1524,10 @@ func (s *Switch) htlcForwarder() {
 
 	// TODO(roasbeef): cleared vs settled distinction
 	var (
-		totalNumUpdates uint64
-		totalSatSent    btcutil.Amount
-		totalSatRecv    btcutil.Amount
+		totalNumUpdates    uint64
+		totalSatSent       btcutil.Amount
+		totalSatRecv       btcutil.Amount
+		prevDustRejections uint64
 	)
 	s.cfg.LogEventTicker.Resume()
 	defer s.cfg.LogEventTicker.Stop()
@@ -1622,6 +1643,16 @@ out:
 			prevSatRecv := totalSatRecv
 			prevNumUpdates := totalNumUpdates
 
+			// Report any HTLCs that were rejected due to excessive
+			// dust exposure since the last interval.
+			numDustRejections := s.NumDustRejections()
+			if numDustRejections > prevDustRejections {
+				log.Infof("Rejected %d HTLCs exceeding max "+
+					"dust exposure in the last 10 seconds",
+					numDustRejections-prevDustRejections)
+			}
+			prevDustRejections = numDustRejections
+
 			var (
 				newNumUpdates uint64
 				newSatSent    btcutil.Amount
@@ -1907,6 +1938,35 @@ func handleBatchFwdErrs(errChan chan error) {
 	}
 }
 
+// Drain instructs the switch to reject any new HTLCs that are meant as onward
+// payments. HTLCs that are already in flight are still resolved as usual, so
+// the node can settle down before it is shut down.
+func (s *Switch) Drain() {
+	if atomic.CompareAndSwapInt32(&s.draining, 0, 1) {
+		log.Infof("HTLC Switch draining, rejecting new forwards")
+	}
+}
+
+// RecordDustRejection records that an HTLC was failed because it would have
+// pushed a channel's dust exposure beyond the configured maximum.
+func (s *Switch) RecordDustRejection() {
+	atomic.AddUint64(&s.dustRejections, 1)
+	dustRejectionsMetric.Inc()
+}
+
+// NumDustRejections returns the total number of HTLCs that were failed because
+// they would have pushed a channel's dust exposure beyond the configured
+// maximum.
+func (s *Switch) NumDustRejections() uint64 {
+	return atomic.LoadUint64(&s.dustRejections)
+}
+
+// IsDraining returns true if the switch has been instructed to reject any new
+// HTLCs that are meant as onward payments.
+func (s *Switch) IsDraining() bool {
+	return atomic.LoadInt32(&s.draining) == 1
+}
+
 // Stop gracefully stops all active helper goroutines, then waits until they've
 // exited.
 func (s *Switch) Stop() error {
@@ -353,6 +353,95 @@ func TestSwitchForward(t *testing.T) {
 	}
 }
 
+// TestSwitchDrain checks that a draining switch rejects any new forwards, and
+// fails them back to the incoming link.
+func TestSwitchDrain(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	if s.IsDraining() {
+		t.Fatal("switch shouldn't be draining")
+	}
+	s.Drain()
+	if !s.IsDraining() {
+		t.Fatal("switch should be draining")
+	}
+
+	// Create request which would be forwarded from Alice channel link to
+	// bob channel link if the switch wasn't draining.
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+	packet := &htlcPacket{
+		incomingChanID: aliceChannelLink.ShortChanID(),
+		incomingHTLCID: 0,
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		obfuscator:     NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+
+	// The request should be failed back to alice, without reaching bob.
+	if err := s.forward(packet); err == nil {
+		t.Fatal("expected forward to be rejected")
+	}
+
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+			t.Fatalf("expected fail htlc, got %T", pkt.htlc)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("fail was not propagated to alice")
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+		t.Fatal("bob shouldn't receive the htlc")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if s.circuits.NumOpen() != 0 {
+		t.Fatal("wrong amount of circuits")
+	}
+}
+
 func TestSwitchForwardFailAfterFullAdd(t *testing.T) {
 	t.Parallel()
 
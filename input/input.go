@@ -44,10 +44,11 @@ type Input interface {
 }
 
 type inputKit struct {
-	outpoint    wire.OutPoint
-	witnessType WitnessType
-	signDesc    SignDescriptor
-	heightHint  uint32
+	outpoint        wire.OutPoint
+	witnessType     WitnessType
+	signDesc        SignDescriptor
+	heightHint      uint32
+	blockToMaturity uint32
 }
 
 // OutPoint returns the breached output's identifier that is to be included as
@@ -107,6 +108,23 @@ func NewBaseInput(outpoint *wire.OutPoint, witnessType WitnessType,
 	return &input
 }
 
+// NewCsvInput assembles a new csv-locked *BaseInput that can be used to
+// construct a sweep transaction.
+func NewCsvInput(outpoint *wire.OutPoint, witnessType WitnessType,
+	signDescriptor *SignDescriptor, heightHint uint32,
+	blockToMaturity uint32) *BaseInput {
+
+	return &BaseInput{
+		inputKit{
+			outpoint:        *outpoint,
+			witnessType:     witnessType,
+			signDesc:        *signDescriptor,
+			heightHint:      heightHint,
+			blockToMaturity: blockToMaturity,
+		},
+	}
+}
+
 // CraftInputScript returns a valid set of input scripts allowing this output
 // to be spent. The returned input scripts should target the input at location
 // txIndex within the passed transaction. The input scripts generated by this
@@ -123,7 +141,7 @@ func (bi *BaseInput) CraftInputScript(signer Signer, txn *wire.MsgTx,
 // must be built on top of the confirmation height before the output can be
 // spent. For non-CSV locked inputs this is always zero.
 func (bi *BaseInput) BlocksToMaturity() uint32 {
-	return 0
+	return bi.blockToMaturity
 }
 
 // HtlcSucceedInput constitutes a sweep input that needs a pre-image. The input
@@ -152,6 +170,11 @@ func MakeHtlcSucceedInput(outpoint *wire.OutPoint,
 	}
 }
 
+// Preimage returns the pre-image required to spend the input.
+func (h *HtlcSucceedInput) Preimage() []byte {
+	return h.preimage
+}
+
 // CraftInputScript returns a valid set of input scripts allowing this output
 // to be spent. The returns input scripts should target the input at location
 // txIndex within the passed transaction. The input scripts generated by this
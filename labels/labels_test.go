@@ -0,0 +1,70 @@
+package labels
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestMakeLabel tests the creation of labels with and without a short channel
+// ID.
+func TestMakeLabel(t *testing.T) {
+	chanID := lnwire.NewShortChanIDFromInt(123)
+
+	tests := []struct {
+		name      string
+		labelType LabelType
+		chanID    *lnwire.ShortChannelID
+		expected  string
+	}{
+		{
+			name:      "sweep without channel",
+			labelType: LabelTypeSweepTransaction,
+			expected:  "0:sweep",
+		},
+		{
+			name:      "close with channel",
+			labelType: LabelTypeChannelClose,
+			chanID:    &chanID,
+			expected:  "0:closechannel:shortchanid-123",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			label := MakeLabel(test.labelType, test.chanID)
+			if label != test.expected {
+				t.Fatalf("expected: %v, got: %v",
+					test.expected, label)
+			}
+		})
+	}
+}
+
+// TestValidateAPI tests that labels provided via the api default to the
+// external label and are limited in length.
+func TestValidateAPI(t *testing.T) {
+	label, err := ValidateAPI("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if label != External {
+		t.Fatalf("expected: %v, got: %v", External, label)
+	}
+
+	label, err = ValidateAPI("withdrawal")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if label != "withdrawal" {
+		t.Fatalf("expected: withdrawal, got: %v", label)
+	}
+
+	_, err = ValidateAPI(strings.Repeat("a", MaxLabelLength+1))
+	if err != ErrLabelTooLong {
+		t.Fatalf("expected: %v, got: %v", ErrLabelTooLong, err)
+	}
+}
@@ -0,0 +1,55 @@
+package lncfg
+
+import "fmt"
+
+// Consolidation holds the configuration of the consolidation of the wallet's
+// UTXOs, which merges them whenever fee rates are low.
+type Consolidation struct {
+	// Active determines whether the wallet's UTXOs are consolidated
+	// automatically. Consolidations can be triggered through the RPC
+	// regardless.
+	Active bool `long:"active" description:"If true, the wallet's UTXOs will automatically be consolidated whenever the estimated fee rate drops below the configured maximum"`
+
+	// ConfTarget is the confirmation target used to estimate the fee rate
+	// of consolidations.
+	ConfTarget uint32 `long:"conftarget" description:"The confirmation target used to estimate the fee rate of consolidations"`
+
+	// MaxFeeRate is the highest estimated fee rate, in sat/vbyte, at
+	// which the wallet's UTXOs are consolidated.
+	MaxFeeRate uint64 `long:"maxfeerate" description:"The highest estimated fee rate in sat/vbyte at which the wallet's UTXOs are consolidated"`
+
+	// MinInputs is the number of UTXOs required for a consolidation to
+	// take place.
+	MinInputs int `long:"mininputs" description:"The number of wallet UTXOs worth spending required for a consolidation to take place"`
+
+	// MaxInputs is the maximum number of UTXOs merged by a single
+	// consolidation.
+	MaxInputs int `long:"maxinputs" description:"The maximum number of wallet UTXOs merged by a single consolidation, smallest first"`
+}
+
+// Validate checks the Consolidation configuration for values that aren't
+// sane.
+func (c *Consolidation) Validate() error {
+	if c.ConfTarget < 2 {
+		return fmt.Errorf("consolidation conf target (%v) must be at "+
+			"least 2", c.ConfTarget)
+	}
+	if c.MaxFeeRate == 0 {
+		return fmt.Errorf("consolidation max fee rate must be " +
+			"positive")
+	}
+	if c.MinInputs < 2 {
+		return fmt.Errorf("consolidation min inputs (%v) must be at "+
+			"least 2", c.MinInputs)
+	}
+	if c.MaxInputs < c.MinInputs {
+		return fmt.Errorf("consolidation max inputs (%v) must not be "+
+			"below min inputs (%v)", c.MaxInputs, c.MinInputs)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Consolidation implements the Validator
+// interface.
+var _ Validator = (*Consolidation)(nil)
@@ -0,0 +1,63 @@
+package lncfg
+
+import "fmt"
+
+// FeeEstimator holds the configuration of the composite fee estimator, which
+// combines the estimates of the chain backend with those of additional fee
+// estimation web APIs.
+type FeeEstimator struct {
+	// FeeURLs are the URLs of the fee estimation web APIs queried in
+	// addition to the chain backend.
+	FeeURLs []string `long:"feeurl" description:"The URL of a fee estimation web API to query in addition to the chain backend. The estimates of all sources are combined, ignoring those that fail or deviate too much from the others. Can be specified multiple times."`
+
+	// Percentile is the percentile of the sources' estimates that is
+	// used, within [0, 1].
+	Percentile float64 `long:"percentile" description:"The percentile of the fee sources' estimates to use, within [0, 1]. The default of 0.5 uses their median."`
+
+	// MaxDeviation is the factor by which the estimate of a source may
+	// deviate from the median of all estimates before it's ignored.
+	MaxDeviation float64 `long:"maxdeviation" description:"The factor by which the estimate of a fee source may deviate from the median of all estimates before it's ignored as an outlier. Outliers are only detected among at least three estimates. Set to 0 to disable."`
+
+	// MinSources is the number of sane estimates required in order to
+	// aggregate them.
+	MinSources int `long:"minsources" description:"The number of fee sources that must return a sane estimate for them to be aggregated. If fewer do, the estimate of the first of them is used, with the chain backend coming first followed by the web APIs in the order they're specified."`
+
+	// MinFeeRate is the lowest fee rate, in sat/vbyte, that is used.
+	MinFeeRate uint64 `long:"minfeerate" description:"The lowest fee rate in sat/vbyte to use for on-chain transactions, regardless of the fee estimates. Set to 0 to only enforce the minimum relay fee."`
+
+	// MaxFeeRate is the highest fee rate, in sat/vbyte, that is used.
+	MaxFeeRate uint64 `long:"maxfeerate" description:"The highest fee rate in sat/vbyte to use for on-chain transactions, regardless of the fee estimates. Set to 0 to disable."`
+}
+
+// Active returns true if the composite fee estimator should be used in place
+// of the chain backend's own.
+func (f *FeeEstimator) Active() bool {
+	return len(f.FeeURLs) > 0 || f.MinFeeRate != 0 || f.MaxFeeRate != 0
+}
+
+// Validate checks the FeeEstimator configuration for values that aren't sane.
+func (f *FeeEstimator) Validate() error {
+	if f.Percentile < 0 || f.Percentile > 1 {
+		return fmt.Errorf("fee estimator percentile (%v) must be "+
+			"within [0, 1]", f.Percentile)
+	}
+	if f.MaxDeviation != 0 && f.MaxDeviation < 1 {
+		return fmt.Errorf("fee estimator max deviation (%v) must be "+
+			"at least 1", f.MaxDeviation)
+	}
+	if f.MinSources < 1 {
+		return fmt.Errorf("fee estimator min sources (%v) must be "+
+			"positive", f.MinSources)
+	}
+	if f.MaxFeeRate != 0 && f.MaxFeeRate < f.MinFeeRate {
+		return fmt.Errorf("fee estimator max fee rate (%v) must not "+
+			"be below min fee rate (%v)", f.MaxFeeRate,
+			f.MinFeeRate)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure FeeEstimator implements the Validator
+// interface.
+var _ Validator = (*FeeEstimator)(nil)
@@ -0,0 +1,52 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+// FeePolicy holds the configuration of the dynamic fee engine, which
+// periodically re-prices our channels according to their configured
+// strategies.
+type FeePolicy struct {
+	// Active determines whether the engine applies its proposed policies.
+	// Strategies can be configured and previewed while it is inactive.
+	Active bool `long:"active" description:"If true, the dynamic fee engine will periodically update the fees of channels that have a fee strategy"`
+
+	// Interval is the interval at which the engine re-prices channels.
+	Interval time.Duration `long:"interval" description:"The interval at which the dynamic fee engine re-prices channels"`
+
+	// MinUpdateInterval is the minimum time that must pass since a
+	// channel's last policy update before the engine updates it again.
+	MinUpdateInterval time.Duration `long:"minupdateinterval" description:"The minimum time since a channel's last policy update before the dynamic fee engine will update it again"`
+
+	// MinFeeRateDelta is the minimum fee rate change, in millionths,
+	// before the engine broadcasts a new policy.
+	MinFeeRateDelta uint32 `long:"minfeeratedelta" description:"The minimum change of a channel's fee rate, in millionths, before the dynamic fee engine will broadcast a new policy"`
+
+	// VolumeWindow is the period of forwarding history the engine uses to
+	// determine a channel's recent volume.
+	VolumeWindow time.Duration `long:"volumewindow" description:"The period of forwarding history the dynamic fee engine takes into account"`
+}
+
+// Validate checks the FeePolicy configuration for values that aren't sane.
+func (f *FeePolicy) Validate() error {
+	if f.Interval <= 0 {
+		return fmt.Errorf("fee policy interval (%v) must be positive",
+			f.Interval)
+	}
+	if f.MinUpdateInterval < 0 {
+		return fmt.Errorf("fee policy min update interval (%v) must "+
+			"not be negative", f.MinUpdateInterval)
+	}
+	if f.VolumeWindow <= 0 {
+		return fmt.Errorf("fee policy volume window (%v) must be "+
+			"positive", f.VolumeWindow)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure FeePolicy implements the Validator
+// interface.
+var _ Validator = (*FeePolicy)(nil)
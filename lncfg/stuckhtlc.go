@@ -0,0 +1,55 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+// StuckHtlc holds the configuration of the stuck HTLC monitor, which
+// reconnects to peers and eventually force closes channels whose outgoing
+// HTLCs remain pending for too long.
+type StuckHtlc struct {
+	// MaxPendingTime is the duration after which a pending outgoing HTLC
+	// is considered stuck.
+	MaxPendingTime time.Duration `long:"maxpendingtime" description:"The duration after which a pending outgoing HTLC is considered stuck. 0 disables the time based check"`
+
+	// MaxPendingBlocks is the number of blocks after which a pending
+	// outgoing HTLC is considered stuck.
+	MaxPendingBlocks uint32 `long:"maxpendingblocks" description:"The number of blocks after which a pending outgoing HTLC is considered stuck. 0 disables the block based check"`
+
+	// ReconnectGrace is the time a channel with stuck HTLCs is given to
+	// recover after reconnecting to the peer, before it is force closed.
+	ReconnectGrace time.Duration `long:"reconnectgrace" description:"The time a channel with stuck HTLCs is given to recover after reconnecting to the peer, before it is force closed"`
+
+	// Interval is the interval at which the age of all outgoing HTLCs is
+	// checked.
+	Interval time.Duration `long:"interval" description:"The interval at which the age of all outgoing HTLCs is checked"`
+}
+
+// Active returns whether the stuck HTLC monitor should run, which is the case
+// if either of the thresholds is set.
+func (s *StuckHtlc) Active() bool {
+	return s.MaxPendingTime > 0 || s.MaxPendingBlocks > 0
+}
+
+// Validate checks the StuckHtlc configuration for values that aren't sane.
+func (s *StuckHtlc) Validate() error {
+	if s.MaxPendingTime < 0 {
+		return fmt.Errorf("stuck htlc max pending time (%v) must not "+
+			"be negative", s.MaxPendingTime)
+	}
+	if s.ReconnectGrace <= 0 {
+		return fmt.Errorf("stuck htlc reconnect grace (%v) must be "+
+			"positive", s.ReconnectGrace)
+	}
+	if s.Interval <= 0 {
+		return fmt.Errorf("stuck htlc interval (%v) must be positive",
+			s.Interval)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure StuckHtlc implements the Validator
+// interface.
+var _ Validator = (*StuckHtlc)(nil)
@@ -0,0 +1,28 @@
+package lncfg
+
+import "fmt"
+
+// Sweeper holds the configuration of the UtxoSweeper, which sweeps the outputs
+// of lnd back into the wallet.
+type Sweeper struct {
+	// FeeFunction is the name of the curve along which the fee rate of
+	// inputs with a deadline is raised.
+	FeeFunction string `long:"feefunction" description:"The curve along which the fee rate of time-sensitive sweeps, such as HTLC claims, is raised every block from the estimated fee rate to the one allowed by their fee budget at their deadline" choice:"linear" choice:"exponential"`
+
+	// DeadlineBucketSize is the number of blocks spanned by a deadline
+	// bucket.
+	DeadlineBucketSize int32 `long:"deadlinebucketsize" description:"The number of blocks spanned by a deadline bucket. Only time-sensitive sweeps with deadlines within the same bucket are batched within the same transaction."`
+}
+
+// Validate checks the Sweeper configuration for values that aren't sane.
+func (s *Sweeper) Validate() error {
+	if s.DeadlineBucketSize < 1 {
+		return fmt.Errorf("sweeper deadline bucket size (%v) must be "+
+			"positive", s.DeadlineBucketSize)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Sweeper implements the Validator interface.
+var _ Validator = (*Sweeper)(nil)
@@ -469,6 +469,7 @@ func Main(lisCfg ListenerCfg) error {
 			NewAddress: func() (btcutil.Address, error) {
 				return activeChainControl.wallet.NewAddress(
 					lnwallet.WitnessPubKey, false,
+					lnwallet.DefaultAccountName,
 				)
 			},
 			NodePrivKey: towerPrivKey,
@@ -22,6 +22,33 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type BlockEventType int32
+
+const (
+	// The block was connected to the tip of the main chain.
+	BlockEventType_CONNECTED BlockEventType = 0
+	// The block was disconnected from the tip of the main chain.
+	BlockEventType_DISCONNECTED BlockEventType = 1
+)
+
+var BlockEventType_name = map[int32]string{
+	0: "CONNECTED",
+	1: "DISCONNECTED",
+}
+
+var BlockEventType_value = map[string]int32{
+	"CONNECTED":    0,
+	"DISCONNECTED": 1,
+}
+
+func (x BlockEventType) String() string {
+	return proto.EnumName(BlockEventType_name, int32(x))
+}
+
+func (BlockEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b10e6f8a1c9d2638, []int{0}
+}
+
 type ConfRequest struct {
 	//
 	//The transaction hash for which we should request a confirmation notification
@@ -199,21 +226,57 @@ func (m *Reorg) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Reorg proto.InternalMessageInfo
 
+type Done struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Done) Reset()         { *m = Done{} }
+func (m *Done) String() string { return proto.CompactTextString(m) }
+func (*Done) ProtoMessage()    {}
+func (*Done) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10e6f8a1c9d2638, []int{3}
+}
+
+func (m *Done) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Done.Unmarshal(m, b)
+}
+func (m *Done) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Done.Marshal(b, m, deterministic)
+}
+func (m *Done) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Done.Merge(m, src)
+}
+func (m *Done) XXX_Size() int {
+	return xxx_messageInfo_Done.Size(m)
+}
+func (m *Done) XXX_DiscardUnknown() {
+	xxx_messageInfo_Done.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Done proto.InternalMessageInfo
+
 type ConfEvent struct {
 	// Types that are valid to be assigned to Event:
 	//	*ConfEvent_Conf
 	//	*ConfEvent_Reorg
-	Event                isConfEvent_Event `protobuf_oneof:"event"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	//	*ConfEvent_Done
+	Event isConfEvent_Event `protobuf_oneof:"event"`
+	//
+	//The index of the request within the batch this event is for. This is always
+	//zero for single requests.
+	RequestIndex         uint32   `protobuf:"varint,4,opt,name=request_index,json=requestIndex,proto3" json:"request_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ConfEvent) Reset()         { *m = ConfEvent{} }
 func (m *ConfEvent) String() string { return proto.CompactTextString(m) }
 func (*ConfEvent) ProtoMessage()    {}
 func (*ConfEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10e6f8a1c9d2638, []int{3}
+	return fileDescriptor_b10e6f8a1c9d2638, []int{4}
 }
 
 func (m *ConfEvent) XXX_Unmarshal(b []byte) error {
@@ -246,10 +309,16 @@ type ConfEvent_Reorg struct {
 	Reorg *Reorg `protobuf:"bytes,2,opt,name=reorg,proto3,oneof"`
 }
 
+type ConfEvent_Done struct {
+	Done *Done `protobuf:"bytes,3,opt,name=done,proto3,oneof"`
+}
+
 func (*ConfEvent_Conf) isConfEvent_Event() {}
 
 func (*ConfEvent_Reorg) isConfEvent_Event() {}
 
+func (*ConfEvent_Done) isConfEvent_Event() {}
+
 func (m *ConfEvent) GetEvent() isConfEvent_Event {
 	if m != nil {
 		return m.Event
@@ -271,14 +340,69 @@ func (m *ConfEvent) GetReorg() *Reorg {
 	return nil
 }
 
+func (m *ConfEvent) GetDone() *Done {
+	if x, ok := m.GetEvent().(*ConfEvent_Done); ok {
+		return x.Done
+	}
+	return nil
+}
+
+func (m *ConfEvent) GetRequestIndex() uint32 {
+	if m != nil {
+		return m.RequestIndex
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ConfEvent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ConfEvent_Conf)(nil),
 		(*ConfEvent_Reorg)(nil),
+		(*ConfEvent_Done)(nil),
 	}
 }
 
+type ConfRequestBatch struct {
+	// The confirmation requests to register within a single stream.
+	Requests             []*ConfRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ConfRequestBatch) Reset()         { *m = ConfRequestBatch{} }
+func (m *ConfRequestBatch) String() string { return proto.CompactTextString(m) }
+func (*ConfRequestBatch) ProtoMessage()    {}
+func (*ConfRequestBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10e6f8a1c9d2638, []int{5}
+}
+
+func (m *ConfRequestBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfRequestBatch.Unmarshal(m, b)
+}
+func (m *ConfRequestBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfRequestBatch.Marshal(b, m, deterministic)
+}
+func (m *ConfRequestBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfRequestBatch.Merge(m, src)
+}
+func (m *ConfRequestBatch) XXX_Size() int {
+	return xxx_messageInfo_ConfRequestBatch.Size(m)
+}
+func (m *ConfRequestBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfRequestBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfRequestBatch proto.InternalMessageInfo
+
+func (m *ConfRequestBatch) GetRequests() []*ConfRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
 type Outpoint struct {
 	// The hash of the transaction.
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -293,7 +417,7 @@ func (m *Outpoint) Reset()         { *m = Outpoint{} }
 func (m *Outpoint) String() string { return proto.CompactTextString(m) }
 func (*Outpoint) ProtoMessage()    {}
 func (*Outpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10e6f8a1c9d2638, []int{4}
+	return fileDescriptor_b10e6f8a1c9d2638, []int{6}
 }
 
 func (m *Outpoint) XXX_Unmarshal(b []byte) error {
@@ -353,7 +477,7 @@ func (m *SpendRequest) Reset()         { *m = SpendRequest{} }
 func (m *SpendRequest) String() string { return proto.CompactTextString(m) }
 func (*SpendRequest) ProtoMessage()    {}
 func (*SpendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10e6f8a1c9d2638, []int{5}
+	return fileDescriptor_b10e6f8a1c9d2638, []int{7}
 }
 
 func (m *SpendRequest) XXX_Unmarshal(b []byte) error {
@@ -415,7 +539,7 @@ func (m *SpendDetails) Reset()         { *m = SpendDetails{} }
 func (m *SpendDetails) String() string { return proto.CompactTextString(m) }
 func (*SpendDetails) ProtoMessage()    {}
 func (*SpendDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10e6f8a1c9d2638, []int{6}
+	return fileDescriptor_b10e6f8a1c9d2638, []int{8}
 }
 
 func (m *SpendDetails) XXX_Unmarshal(b []byte) error {
@@ -475,17 +599,22 @@ type SpendEvent struct {
 	// Types that are valid to be assigned to Event:
 	//	*SpendEvent_Spend
 	//	*SpendEvent_Reorg
-	Event                isSpendEvent_Event `protobuf_oneof:"event"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	//	*SpendEvent_Done
+	Event isSpendEvent_Event `protobuf_oneof:"event"`
+	//
+	//The index of the request within the batch this event is for. This is always
+	//zero for single requests.
+	RequestIndex         uint32   `protobuf:"varint,4,opt,name=request_index,json=requestIndex,proto3" json:"request_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SpendEvent) Reset()         { *m = SpendEvent{} }
 func (m *SpendEvent) String() string { return proto.CompactTextString(m) }
 func (*SpendEvent) ProtoMessage()    {}
 func (*SpendEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10e6f8a1c9d2638, []int{7}
+	return fileDescriptor_b10e6f8a1c9d2638, []int{9}
 }
 
 func (m *SpendEvent) XXX_Unmarshal(b []byte) error {
@@ -518,10 +647,16 @@ type SpendEvent_Reorg struct {
 	Reorg *Reorg `protobuf:"bytes,2,opt,name=reorg,proto3,oneof"`
 }
 
+type SpendEvent_Done struct {
+	Done *Done `protobuf:"bytes,3,opt,name=done,proto3,oneof"`
+}
+
 func (*SpendEvent_Spend) isSpendEvent_Event() {}
 
 func (*SpendEvent_Reorg) isSpendEvent_Event() {}
 
+func (*SpendEvent_Done) isSpendEvent_Event() {}
+
 func (m *SpendEvent) GetEvent() isSpendEvent_Event {
 	if m != nil {
 		return m.Event
@@ -543,12 +678,67 @@ func (m *SpendEvent) GetReorg() *Reorg {
 	return nil
 }
 
+func (m *SpendEvent) GetDone() *Done {
+	if x, ok := m.GetEvent().(*SpendEvent_Done); ok {
+		return x.Done
+	}
+	return nil
+}
+
+func (m *SpendEvent) GetRequestIndex() uint32 {
+	if m != nil {
+		return m.RequestIndex
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SpendEvent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*SpendEvent_Spend)(nil),
 		(*SpendEvent_Reorg)(nil),
+		(*SpendEvent_Done)(nil),
+	}
+}
+
+type SpendRequestBatch struct {
+	// The spend requests to register within a single stream.
+	Requests             []*SpendRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SpendRequestBatch) Reset()         { *m = SpendRequestBatch{} }
+func (m *SpendRequestBatch) String() string { return proto.CompactTextString(m) }
+func (*SpendRequestBatch) ProtoMessage()    {}
+func (*SpendRequestBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10e6f8a1c9d2638, []int{10}
+}
+
+func (m *SpendRequestBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpendRequestBatch.Unmarshal(m, b)
+}
+func (m *SpendRequestBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpendRequestBatch.Marshal(b, m, deterministic)
+}
+func (m *SpendRequestBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendRequestBatch.Merge(m, src)
+}
+func (m *SpendRequestBatch) XXX_Size() int {
+	return xxx_messageInfo_SpendRequestBatch.Size(m)
+}
+func (m *SpendRequestBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendRequestBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendRequestBatch proto.InternalMessageInfo
+
+func (m *SpendRequestBatch) GetRequests() []*SpendRequest {
+	if m != nil {
+		return m.Requests
 	}
+	return nil
 }
 
 type BlockEpoch struct {
@@ -565,7 +755,7 @@ func (m *BlockEpoch) Reset()         { *m = BlockEpoch{} }
 func (m *BlockEpoch) String() string { return proto.CompactTextString(m) }
 func (*BlockEpoch) ProtoMessage()    {}
 func (*BlockEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10e6f8a1c9d2638, []int{8}
+	return fileDescriptor_b10e6f8a1c9d2638, []int{11}
 }
 
 func (m *BlockEpoch) XXX_Unmarshal(b []byte) error {
@@ -600,58 +790,203 @@ func (m *BlockEpoch) GetHeight() uint32 {
 	return 0
 }
 
+type BlockFilter struct {
+	//
+	//The output scripts to match. A transaction of a connected block is included
+	//in its event if any of its outputs pays to one of them.
+	Scripts [][]byte `protobuf:"bytes,1,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	//
+	//The outpoints to match. A transaction of a connected block is included in
+	//its event if it spends any of them.
+	Outpoints            []*Outpoint `protobuf:"bytes,2,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *BlockFilter) Reset()         { *m = BlockFilter{} }
+func (m *BlockFilter) String() string { return proto.CompactTextString(m) }
+func (*BlockFilter) ProtoMessage()    {}
+func (*BlockFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10e6f8a1c9d2638, []int{12}
+}
+
+func (m *BlockFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockFilter.Unmarshal(m, b)
+}
+func (m *BlockFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockFilter.Marshal(b, m, deterministic)
+}
+func (m *BlockFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFilter.Merge(m, src)
+}
+func (m *BlockFilter) XXX_Size() int {
+	return xxx_messageInfo_BlockFilter.Size(m)
+}
+func (m *BlockFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFilter proto.InternalMessageInfo
+
+func (m *BlockFilter) GetScripts() [][]byte {
+	if m != nil {
+		return m.Scripts
+	}
+	return nil
+}
+
+func (m *BlockFilter) GetOutpoints() []*Outpoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+type BlockEvent struct {
+	// Whether the block was connected or disconnected.
+	Type BlockEventType `protobuf:"varint,1,opt,name=type,proto3,enum=chainrpc.BlockEventType" json:"type,omitempty"`
+	//
+	//The hash of the block. This may be empty for a disconnected block that was
+	//connected before lnd started.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The height of the block.
+	Height uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	//
+	//The raw bytes of the transactions of a connected block that match the
+	//filter of the subscription, in the order they appear in the block.
+	RawTxs               [][]byte `protobuf:"bytes,4,rep,name=raw_txs,json=rawTxs,proto3" json:"raw_txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockEvent) Reset()         { *m = BlockEvent{} }
+func (m *BlockEvent) String() string { return proto.CompactTextString(m) }
+func (*BlockEvent) ProtoMessage()    {}
+func (*BlockEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10e6f8a1c9d2638, []int{13}
+}
+
+func (m *BlockEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockEvent.Unmarshal(m, b)
+}
+func (m *BlockEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockEvent.Marshal(b, m, deterministic)
+}
+func (m *BlockEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockEvent.Merge(m, src)
+}
+func (m *BlockEvent) XXX_Size() int {
+	return xxx_messageInfo_BlockEvent.Size(m)
+}
+func (m *BlockEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockEvent proto.InternalMessageInfo
+
+func (m *BlockEvent) GetType() BlockEventType {
+	if m != nil {
+		return m.Type
+	}
+	return BlockEventType_CONNECTED
+}
+
+func (m *BlockEvent) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BlockEvent) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockEvent) GetRawTxs() [][]byte {
+	if m != nil {
+		return m.RawTxs
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("chainrpc.BlockEventType", BlockEventType_name, BlockEventType_value)
 	proto.RegisterType((*ConfRequest)(nil), "chainrpc.ConfRequest")
 	proto.RegisterType((*ConfDetails)(nil), "chainrpc.ConfDetails")
 	proto.RegisterType((*Reorg)(nil), "chainrpc.Reorg")
+	proto.RegisterType((*Done)(nil), "chainrpc.Done")
 	proto.RegisterType((*ConfEvent)(nil), "chainrpc.ConfEvent")
+	proto.RegisterType((*ConfRequestBatch)(nil), "chainrpc.ConfRequestBatch")
 	proto.RegisterType((*Outpoint)(nil), "chainrpc.Outpoint")
 	proto.RegisterType((*SpendRequest)(nil), "chainrpc.SpendRequest")
 	proto.RegisterType((*SpendDetails)(nil), "chainrpc.SpendDetails")
 	proto.RegisterType((*SpendEvent)(nil), "chainrpc.SpendEvent")
+	proto.RegisterType((*SpendRequestBatch)(nil), "chainrpc.SpendRequestBatch")
 	proto.RegisterType((*BlockEpoch)(nil), "chainrpc.BlockEpoch")
+	proto.RegisterType((*BlockFilter)(nil), "chainrpc.BlockFilter")
+	proto.RegisterType((*BlockEvent)(nil), "chainrpc.BlockEvent")
 }
 
 func init() { proto.RegisterFile("chainrpc/chainnotifier.proto", fileDescriptor_b10e6f8a1c9d2638) }
 
 var fileDescriptor_b10e6f8a1c9d2638 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0x13, 0x3f,
-	0x10, 0xed, 0xb6, 0xdd, 0xfc, 0x99, 0x24, 0xbf, 0xb4, 0xfe, 0xa5, 0x51, 0x5a, 0x40, 0x94, 0x3d,
-	0xd0, 0x48, 0x48, 0x21, 0x0a, 0x1c, 0xb8, 0x21, 0x35, 0x14, 0x25, 0x97, 0x22, 0x6d, 0x7b, 0x5f,
-	0x6d, 0x37, 0x4e, 0xd6, 0xd0, 0xd8, 0x8b, 0xed, 0x90, 0xbd, 0xf2, 0x69, 0xf9, 0x12, 0x1c, 0x90,
-	0x67, 0xed, 0x4d, 0x1a, 0x8a, 0x84, 0xb8, 0x79, 0x66, 0xde, 0x3e, 0xbf, 0xf1, 0x7b, 0x09, 0x3c,
-	0x4d, 0xd2, 0x98, 0x71, 0x99, 0x25, 0xaf, 0xf1, 0xc0, 0x85, 0x66, 0x73, 0x46, 0xe5, 0x20, 0x93,
-	0x42, 0x0b, 0x52, 0x73, 0xd3, 0x60, 0x0d, 0x8d, 0xb1, 0xe0, 0xf3, 0x90, 0x7e, 0x5d, 0x51, 0xa5,
-	0x09, 0x81, 0x43, 0x9d, 0xb3, 0x59, 0xcf, 0x3b, 0xf7, 0xfa, 0xcd, 0x10, 0xcf, 0xa4, 0x0b, 0x15,
-	0x95, 0x48, 0x96, 0xe9, 0xde, 0x3e, 0x76, 0x6d, 0x45, 0x9e, 0x40, 0x9d, 0xaf, 0x96, 0x51, 0x22,
-	0xf8, 0x5c, 0xf5, 0x0e, 0xce, 0xbd, 0x7e, 0x2b, 0xac, 0xf1, 0xd5, 0xd2, 0xd0, 0x29, 0xf2, 0x1c,
-	0x1a, 0x29, 0x65, 0x8b, 0x54, 0x47, 0x29, 0xe3, 0xba, 0x77, 0x88, 0x63, 0x28, 0x5a, 0x13, 0xc6,
-	0x75, 0xf0, 0xdd, 0x2b, 0x6e, 0xfe, 0x40, 0x75, 0xcc, 0xee, 0x15, 0x39, 0x81, 0x8a, 0x8c, 0xd7,
-	0x91, 0xce, 0xed, 0xdd, 0xbe, 0x8c, 0xd7, 0xb7, 0x39, 0x79, 0x06, 0x70, 0x77, 0x2f, 0x92, 0x2f,
-	0x51, 0x1a, 0xab, 0xd4, 0x0a, 0xa8, 0x63, 0x67, 0x12, 0xab, 0x94, 0xbc, 0x80, 0xa6, 0x1d, 0x23,
-	0xb3, 0x95, 0xd1, 0x28, 0x00, 0xd8, 0x22, 0xa7, 0x50, 0xd3, 0x79, 0xc4, 0xf8, 0x8c, 0xe6, 0x56,
-	0x46, 0x55, 0xe7, 0x53, 0x53, 0x06, 0x55, 0xf0, 0x43, 0x2a, 0xe4, 0x22, 0xf8, 0x0c, 0x75, 0xa3,
-	0xe5, 0xea, 0x1b, 0xe5, 0x9a, 0xbc, 0x82, 0x43, 0xb3, 0x13, 0xea, 0x68, 0x8c, 0x4e, 0x06, 0xee,
-	0xad, 0x06, 0x5b, 0x72, 0x27, 0x7b, 0x21, 0x82, 0xc8, 0x05, 0xf8, 0xd2, 0x50, 0xa0, 0xb4, 0xc6,
-	0xa8, 0xbd, 0x41, 0x23, 0xf3, 0x64, 0x2f, 0x2c, 0xe6, 0x97, 0x55, 0xf0, 0xa9, 0xa1, 0x0f, 0xde,
-	0x42, 0xed, 0xd3, 0x4a, 0x67, 0x82, 0x71, 0x7c, 0x6e, 0xdc, 0xcb, 0x3e, 0xb7, 0x39, 0x93, 0x0e,
-	0xf8, 0x85, 0xd8, 0x7d, 0x14, 0x5b, 0x14, 0xc1, 0x1a, 0x9a, 0x37, 0x19, 0xe5, 0x33, 0x67, 0xd4,
-	0x00, 0x6a, 0xc2, 0xb2, 0x58, 0xa1, 0x64, 0x73, 0xb5, 0xe3, 0x0f, 0x4b, 0xcc, 0x1f, 0x4d, 0xdc,
-	0xf1, 0xe9, 0xe0, 0x37, 0x9f, 0x7e, 0x7a, 0xf6, 0x66, 0x67, 0xd4, 0x7b, 0x38, 0x56, 0xa6, 0x66,
-	0x7c, 0x11, 0xfd, 0x85, 0x84, 0x23, 0x07, 0x2e, 0x97, 0x7e, 0x09, 0x6d, 0xe3, 0x74, 0x49, 0xa2,
-	0x73, 0xab, 0xa9, 0x25, 0xe3, 0xf5, 0x8d, 0xed, 0xde, 0xe6, 0xa4, 0x0f, 0x47, 0x5b, 0x98, 0x22,
-	0x00, 0x07, 0x08, 0xfc, 0x4f, 0x95, 0x28, 0x4c, 0xc1, 0x10, 0x3a, 0x25, 0x92, 0xf1, 0x6c, 0xa5,
-	0x1f, 0xd8, 0x4d, 0xdc, 0x6c, 0x6a, 0x46, 0xe8, 0x3c, 0xb9, 0x80, 0x76, 0xf9, 0x85, 0x8d, 0x8e,
-	0x8f, 0xe0, 0x92, 0xba, 0x48, 0x4f, 0xc0, 0x01, 0x50, 0x52, 0x11, 0x8d, 0x01, 0xf8, 0x38, 0xb7,
-	0xfb, 0x76, 0x37, 0xfb, 0x6e, 0x3f, 0x91, 0x31, 0x1d, 0x61, 0xff, 0x90, 0x8e, 0x77, 0x00, 0x97,
-	0x26, 0xbc, 0x57, 0x99, 0x48, 0xd2, 0x47, 0xf3, 0xd1, 0x85, 0x8a, 0x55, 0x5c, 0x04, 0xc4, 0x56,
-	0xa3, 0x1f, 0x1e, 0xb4, 0xc6, 0x86, 0xfe, 0xda, 0xfe, 0xd6, 0xc9, 0x14, 0x4e, 0x43, 0xba, 0x60,
-	0x4a, 0x53, 0x69, 0xa2, 0xcb, 0xe4, 0x32, 0xd6, 0x4c, 0x70, 0x75, 0xad, 0xe7, 0x9c, 0xec, 0xe4,
-	0xda, 0xe6, 0xea, 0xec, 0xff, 0x87, 0x6d, 0x5c, 0x7b, 0xe8, 0x91, 0x31, 0x1c, 0x3b, 0x2a, 0xdc,
-	0x14, 0x29, 0x76, 0xd7, 0x77, 0x1c, 0x9d, 0x9d, 0xbe, 0x23, 0xf9, 0x08, 0x5d, 0x47, 0xb2, 0xd9,
-	0x11, 0x99, 0xb6, 0xbe, 0xd8, 0x4c, 0xce, 0x1e, 0xed, 0x0e, 0xbd, 0xbb, 0x0a, 0xfe, 0x89, 0xbd,
-	0xf9, 0x15, 0x00, 0x00, 0xff, 0xff, 0x65, 0xe6, 0xc2, 0xe4, 0xe4, 0x04, 0x00, 0x00,
+	// 814 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcb, 0x72, 0xe3, 0x44,
+	0x14, 0x8d, 0xe2, 0xf7, 0xb5, 0x9d, 0x38, 0x4d, 0x26, 0x68, 0x32, 0x3c, 0x82, 0xa0, 0x98, 0x14,
+	0x50, 0xc6, 0x63, 0x58, 0xb0, 0x83, 0x8a, 0xed, 0xc1, 0x5e, 0xe0, 0xa9, 0x92, 0xbd, 0x61, 0xe5,
+	0x92, 0xed, 0xb6, 0xd5, 0x45, 0xd2, 0x12, 0xea, 0x36, 0x56, 0x56, 0x14, 0x1f, 0xc5, 0x82, 0x8f,
+	0xe0, 0x8f, 0x58, 0x50, 0x7d, 0xbb, 0xf5, 0xf0, 0xab, 0x8a, 0x1d, 0x3b, 0xf5, 0xbd, 0x47, 0xa7,
+	0xcf, 0xb9, 0xf7, 0x58, 0x86, 0x0f, 0x16, 0xbe, 0xc7, 0x78, 0x14, 0x2e, 0xbe, 0xc6, 0x07, 0x1e,
+	0x48, 0xb6, 0x62, 0x34, 0x6a, 0x87, 0x51, 0x20, 0x03, 0x52, 0x4d, 0xba, 0xce, 0x16, 0xea, 0xbd,
+	0x80, 0xaf, 0x5c, 0xfa, 0xeb, 0x86, 0x0a, 0x49, 0x08, 0x14, 0x65, 0xcc, 0x96, 0xb6, 0x75, 0x67,
+	0xdd, 0x37, 0x5c, 0x7c, 0x26, 0x37, 0x50, 0x16, 0x8b, 0x88, 0x85, 0xd2, 0x3e, 0xc7, 0xaa, 0x39,
+	0x91, 0x57, 0x50, 0xe3, 0x9b, 0xa7, 0xd9, 0x22, 0xe0, 0x2b, 0x61, 0x17, 0xee, 0xac, 0xfb, 0xa6,
+	0x5b, 0xe5, 0x9b, 0x27, 0x45, 0x27, 0xc8, 0xc7, 0x50, 0xf7, 0x29, 0x5b, 0xfb, 0x72, 0xe6, 0x33,
+	0x2e, 0xed, 0x22, 0xb6, 0x41, 0x97, 0x86, 0x8c, 0x4b, 0xe7, 0x0f, 0x4b, 0xdf, 0xdc, 0xa7, 0xd2,
+	0x63, 0x8f, 0x82, 0xbc, 0x80, 0x72, 0xe4, 0x6d, 0x67, 0x32, 0x36, 0x77, 0x97, 0x22, 0x6f, 0x3b,
+	0x8d, 0xc9, 0x87, 0x00, 0xf3, 0xc7, 0x60, 0xf1, 0xcb, 0xcc, 0xf7, 0x84, 0x6f, 0x04, 0xd4, 0xb0,
+	0x32, 0xf4, 0x84, 0x4f, 0x3e, 0x81, 0x86, 0x69, 0x23, 0xb3, 0x91, 0x51, 0xd7, 0x00, 0x2c, 0x91,
+	0x97, 0x50, 0x95, 0xf1, 0x8c, 0xf1, 0x25, 0x8d, 0x8d, 0x8c, 0x8a, 0x8c, 0x47, 0xea, 0xe8, 0x54,
+	0xa0, 0xe4, 0xd2, 0x20, 0x5a, 0x3b, 0x65, 0x28, 0xf6, 0x03, 0x4e, 0x9d, 0x3f, 0x2d, 0xa8, 0x29,
+	0x51, 0x83, 0xdf, 0x28, 0x97, 0xe4, 0x4b, 0x28, 0x2a, 0x73, 0x28, 0xa8, 0xde, 0x7d, 0xd1, 0x4e,
+	0x86, 0xd6, 0xce, 0xe9, 0x1e, 0x9e, 0xb9, 0x08, 0x22, 0xaf, 0xa1, 0x14, 0x29, 0x2e, 0xd4, 0x58,
+	0xef, 0x5e, 0x66, 0x68, 0xbc, 0x62, 0x78, 0xe6, 0xea, 0x3e, 0xf9, 0x0c, 0x8a, 0xcb, 0x80, 0x53,
+	0x94, 0x5a, 0xef, 0x5e, 0x64, 0x38, 0xa5, 0x40, 0xd1, 0xa9, 0x2e, 0xf9, 0x14, 0x9a, 0x91, 0xde,
+	0xc9, 0x8e, 0xf4, 0x86, 0x29, 0xa2, 0xfe, 0x87, 0x0a, 0x94, 0xa8, 0x52, 0xea, 0x0c, 0xa0, 0x95,
+	0xdb, 0xe2, 0x83, 0x27, 0x17, 0x3e, 0x79, 0x03, 0x55, 0x03, 0x16, 0xb6, 0x75, 0x57, 0x38, 0x74,
+	0x60, 0xd0, 0x6e, 0x0a, 0x73, 0xbe, 0x85, 0xea, 0xbb, 0x8d, 0x0c, 0x03, 0xc6, 0x31, 0x09, 0x38,
+	0x72, 0x93, 0x04, 0xf5, 0x4c, 0xae, 0xa1, 0xa4, 0xc5, 0x9c, 0xa3, 0x18, 0x7d, 0x70, 0xb6, 0xd0,
+	0x98, 0x84, 0x94, 0x2f, 0x93, 0x0c, 0xb5, 0xa1, 0x1a, 0x18, 0x16, 0x33, 0x3a, 0x92, 0x5d, 0x9c,
+	0xf0, 0xbb, 0x29, 0xe6, 0x64, 0xbe, 0xf6, 0x22, 0x54, 0x38, 0x88, 0xd0, 0x3f, 0x96, 0xb9, 0x39,
+	0xc9, 0xd0, 0xf7, 0x70, 0x25, 0xd4, 0x99, 0xf1, 0xf5, 0xec, 0x3f, 0x48, 0x68, 0x25, 0xe0, 0xd4,
+	0xf4, 0xe7, 0x70, 0xa9, 0x42, 0x98, 0x92, 0xc8, 0xd8, 0x68, 0x6a, 0x46, 0xde, 0x76, 0x62, 0xaa,
+	0xd3, 0x98, 0xdc, 0x43, 0x2b, 0x87, 0xd1, 0xd9, 0x2c, 0x20, 0xf0, 0x42, 0xa4, 0x28, 0x0c, 0x68,
+	0x07, 0xae, 0x53, 0x24, 0xe3, 0xe1, 0x66, 0x77, 0x9d, 0x24, 0xe9, 0x8d, 0x54, 0x0b, 0x97, 0x4a,
+	0x5e, 0xc3, 0x65, 0xfa, 0x86, 0x49, 0x75, 0x09, 0xc1, 0x29, 0xb5, 0x0e, 0xb6, 0xf3, 0x97, 0x05,
+	0x80, 0x9a, 0x74, 0x5a, 0xdb, 0x50, 0x42, 0x80, 0x31, 0x7c, 0x93, 0x19, 0xce, 0xcf, 0x48, 0xe5,
+	0x10, 0x61, 0xff, 0x6f, 0x60, 0x7f, 0x84, 0xab, 0x7c, 0x66, 0x74, 0x62, 0xbb, 0x07, 0x89, 0xdd,
+	0x37, 0x71, 0x18, 0xd9, 0xef, 0x00, 0x1e, 0xd4, 0x8f, 0x7d, 0x10, 0x06, 0x0b, 0xff, 0x68, 0x68,
+	0x6f, 0xa0, 0x6c, 0xc6, 0xa8, 0x53, 0x6b, 0x4e, 0xce, 0xcf, 0x50, 0xc7, 0x37, 0xdf, 0xb2, 0x47,
+	0x49, 0x23, 0x62, 0x43, 0x45, 0xe7, 0x4e, 0xdf, 0xdd, 0x70, 0x93, 0x23, 0xe9, 0x40, 0x2d, 0x09,
+	0x93, 0xb0, 0xcf, 0xef, 0x0a, 0x27, 0xd2, 0x94, 0x81, 0x9c, 0xdf, 0x13, 0x51, 0xb8, 0x98, 0xaf,
+	0xa0, 0x28, 0x9f, 0x43, 0x8a, 0xa2, 0x2e, 0xba, 0x76, 0xf6, 0x6a, 0x86, 0x99, 0x3e, 0x87, 0xd4,
+	0x45, 0x54, 0x6a, 0xe1, 0xfc, 0xa8, 0x85, 0x42, 0xde, 0x02, 0x79, 0x1f, 0x2a, 0xfa, 0x9b, 0x29,
+	0xec, 0x22, 0x6a, 0x2e, 0xe3, 0x47, 0x53, 0x7c, 0xf1, 0x06, 0x2e, 0x76, 0xc9, 0x49, 0x13, 0x6a,
+	0xbd, 0x77, 0xe3, 0xf1, 0xa0, 0x37, 0x1d, 0xf4, 0x5b, 0x67, 0xa4, 0x05, 0x8d, 0xfe, 0x68, 0x92,
+	0x55, 0xac, 0xee, 0xdf, 0x05, 0x68, 0xf6, 0x94, 0xb2, 0xb1, 0xf9, 0xab, 0x20, 0x23, 0x78, 0xe9,
+	0xd2, 0x35, 0x13, 0x92, 0x46, 0xea, 0x73, 0xc1, 0xa2, 0x27, 0x4f, 0xb2, 0x80, 0x8b, 0xb1, 0x5c,
+	0x71, 0x72, 0xfc, 0x5b, 0x72, 0xfb, 0xde, 0x6e, 0x19, 0xef, 0xef, 0x58, 0xa4, 0x07, 0x57, 0x09,
+	0x15, 0xee, 0x11, 0x29, 0x4e, 0x2c, 0xf7, 0xf6, 0x7a, 0xaf, 0x9e, 0x90, 0xbc, 0x85, 0x9b, 0x84,
+	0x24, 0x5b, 0x39, 0x32, 0x5d, 0xef, 0xcf, 0x54, 0x75, 0x6e, 0x8f, 0x56, 0x3b, 0x16, 0x99, 0xc0,
+	0x47, 0x27, 0x7d, 0xe9, 0x20, 0xde, 0x1e, 0x35, 0x87, 0xbd, 0x53, 0x0e, 0x7f, 0xca, 0xc4, 0xa5,
+	0x0e, 0x35, 0xd9, 0xab, 0xe3, 0x36, 0x35, 0xdb, 0x29, 0xaf, 0x3f, 0xc0, 0xe5, 0x64, 0x33, 0x57,
+	0x09, 0x9c, 0x53, 0x14, 0x2f, 0xf2, 0x13, 0xcf, 0xe5, 0xf6, 0xd0, 0xa5, 0x66, 0x98, 0x97, 0xf1,
+	0x9f, 0xfe, 0x9b, 0x7f, 0x07, 0x00, 0x83, 0xff, 0x12, 0xe1, 0x09, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -694,6 +1029,30 @@ type ChainNotifierClient interface {
 	//point. This allows clients to be idempotent by ensuring that they do not
 	//missing processing a single block within the chain.
 	RegisterBlockEpochNtfn(ctx context.Context, in *BlockEpoch, opts ...grpc.CallOption) (ChainNotifier_RegisterBlockEpochNtfnClient, error)
+	//
+	//RegisterConfirmationsNtfnBatch is a synchronous response-streaming RPC that
+	//registers a batch of confirmation requests within a single stream. Each
+	//event carries the index of the request within the batch it is for. Once a
+	//request is no longer under the risk of being reorged out of the chain, a
+	//done event is sent for it. The stream is closed once all requests are done.
+	RegisterConfirmationsNtfnBatch(ctx context.Context, in *ConfRequestBatch, opts ...grpc.CallOption) (ChainNotifier_RegisterConfirmationsNtfnBatchClient, error)
+	//
+	//RegisterSpendNtfnBatch is a synchronous response-streaming RPC that
+	//registers a batch of spend requests within a single stream. Each event
+	//carries the index of the request within the batch it is for. Once a
+	//request is no longer under the risk of being reorged out of the chain, a
+	//done event is sent for it. The stream is closed once all requests are done.
+	RegisterSpendNtfnBatch(ctx context.Context, in *SpendRequestBatch, opts ...grpc.CallOption) (ChainNotifier_RegisterSpendNtfnBatchClient, error)
+	//
+	//SubscribeBlocks is a synchronous response-streaming RPC that notifies the
+	//client of each block connected to and disconnected from the tip of the main
+	//chain, in order. This allows clients to follow the main chain including its
+	//reorganizations. The events of connected blocks include the transactions
+	//matching the given filter.
+	//
+	//This is only supported by chain backends that process the full content of
+	//each block, which are btcd and bitcoind.
+	SubscribeBlocks(ctx context.Context, in *BlockFilter, opts ...grpc.CallOption) (ChainNotifier_SubscribeBlocksClient, error)
 }
 
 type chainNotifierClient struct {
@@ -800,6 +1159,102 @@ func (x *chainNotifierRegisterBlockEpochNtfnClient) Recv() (*BlockEpoch, error)
 	return m, nil
 }
 
+func (c *chainNotifierClient) RegisterConfirmationsNtfnBatch(ctx context.Context, in *ConfRequestBatch, opts ...grpc.CallOption) (ChainNotifier_RegisterConfirmationsNtfnBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ChainNotifier_serviceDesc.Streams[3], "/chainrpc.ChainNotifier/RegisterConfirmationsNtfnBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainNotifierRegisterConfirmationsNtfnBatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainNotifier_RegisterConfirmationsNtfnBatchClient interface {
+	Recv() (*ConfEvent, error)
+	grpc.ClientStream
+}
+
+type chainNotifierRegisterConfirmationsNtfnBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainNotifierRegisterConfirmationsNtfnBatchClient) Recv() (*ConfEvent, error) {
+	m := new(ConfEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chainNotifierClient) RegisterSpendNtfnBatch(ctx context.Context, in *SpendRequestBatch, opts ...grpc.CallOption) (ChainNotifier_RegisterSpendNtfnBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ChainNotifier_serviceDesc.Streams[4], "/chainrpc.ChainNotifier/RegisterSpendNtfnBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainNotifierRegisterSpendNtfnBatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainNotifier_RegisterSpendNtfnBatchClient interface {
+	Recv() (*SpendEvent, error)
+	grpc.ClientStream
+}
+
+type chainNotifierRegisterSpendNtfnBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainNotifierRegisterSpendNtfnBatchClient) Recv() (*SpendEvent, error) {
+	m := new(SpendEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chainNotifierClient) SubscribeBlocks(ctx context.Context, in *BlockFilter, opts ...grpc.CallOption) (ChainNotifier_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ChainNotifier_serviceDesc.Streams[5], "/chainrpc.ChainNotifier/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainNotifierSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainNotifier_SubscribeBlocksClient interface {
+	Recv() (*BlockEvent, error)
+	grpc.ClientStream
+}
+
+type chainNotifierSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainNotifierSubscribeBlocksClient) Recv() (*BlockEvent, error) {
+	m := new(BlockEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ChainNotifierServer is the server API for ChainNotifier service.
 type ChainNotifierServer interface {
 	//
@@ -830,6 +1285,30 @@ type ChainNotifierServer interface {
 	//point. This allows clients to be idempotent by ensuring that they do not
 	//missing processing a single block within the chain.
 	RegisterBlockEpochNtfn(*BlockEpoch, ChainNotifier_RegisterBlockEpochNtfnServer) error
+	//
+	//RegisterConfirmationsNtfnBatch is a synchronous response-streaming RPC that
+	//registers a batch of confirmation requests within a single stream. Each
+	//event carries the index of the request within the batch it is for. Once a
+	//request is no longer under the risk of being reorged out of the chain, a
+	//done event is sent for it. The stream is closed once all requests are done.
+	RegisterConfirmationsNtfnBatch(*ConfRequestBatch, ChainNotifier_RegisterConfirmationsNtfnBatchServer) error
+	//
+	//RegisterSpendNtfnBatch is a synchronous response-streaming RPC that
+	//registers a batch of spend requests within a single stream. Each event
+	//carries the index of the request within the batch it is for. Once a
+	//request is no longer under the risk of being reorged out of the chain, a
+	//done event is sent for it. The stream is closed once all requests are done.
+	RegisterSpendNtfnBatch(*SpendRequestBatch, ChainNotifier_RegisterSpendNtfnBatchServer) error
+	//
+	//SubscribeBlocks is a synchronous response-streaming RPC that notifies the
+	//client of each block connected to and disconnected from the tip of the main
+	//chain, in order. This allows clients to follow the main chain including its
+	//reorganizations. The events of connected blocks include the transactions
+	//matching the given filter.
+	//
+	//This is only supported by chain backends that process the full content of
+	//each block, which are btcd and bitcoind.
+	SubscribeBlocks(*BlockFilter, ChainNotifier_SubscribeBlocksServer) error
 }
 
 func RegisterChainNotifierServer(s *grpc.Server, srv ChainNotifierServer) {
@@ -899,6 +1378,69 @@ func (x *chainNotifierRegisterBlockEpochNtfnServer) Send(m *BlockEpoch) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ChainNotifier_RegisterConfirmationsNtfnBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConfRequestBatch)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainNotifierServer).RegisterConfirmationsNtfnBatch(m, &chainNotifierRegisterConfirmationsNtfnBatchServer{stream})
+}
+
+type ChainNotifier_RegisterConfirmationsNtfnBatchServer interface {
+	Send(*ConfEvent) error
+	grpc.ServerStream
+}
+
+type chainNotifierRegisterConfirmationsNtfnBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainNotifierRegisterConfirmationsNtfnBatchServer) Send(m *ConfEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChainNotifier_RegisterSpendNtfnBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpendRequestBatch)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainNotifierServer).RegisterSpendNtfnBatch(m, &chainNotifierRegisterSpendNtfnBatchServer{stream})
+}
+
+type ChainNotifier_RegisterSpendNtfnBatchServer interface {
+	Send(*SpendEvent) error
+	grpc.ServerStream
+}
+
+type chainNotifierRegisterSpendNtfnBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainNotifierRegisterSpendNtfnBatchServer) Send(m *SpendEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChainNotifier_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainNotifierServer).SubscribeBlocks(m, &chainNotifierSubscribeBlocksServer{stream})
+}
+
+type ChainNotifier_SubscribeBlocksServer interface {
+	Send(*BlockEvent) error
+	grpc.ServerStream
+}
+
+type chainNotifierSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainNotifierSubscribeBlocksServer) Send(m *BlockEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _ChainNotifier_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chainrpc.ChainNotifier",
 	HandlerType: (*ChainNotifierServer)(nil),
@@ -919,6 +1461,21 @@ var _ChainNotifier_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ChainNotifier_RegisterBlockEpochNtfn_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RegisterConfirmationsNtfnBatch",
+			Handler:       _ChainNotifier_RegisterConfirmationsNtfnBatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RegisterSpendNtfnBatch",
+			Handler:       _ChainNotifier_RegisterSpendNtfnBatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _ChainNotifier_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "chainrpc/chainnotifier.proto",
 }
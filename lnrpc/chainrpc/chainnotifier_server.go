@@ -51,6 +51,18 @@ var (
 			Entity: "onchain",
 			Action: "read",
 		}},
+		"/chainrpc.ChainNotifier/RegisterConfirmationsNtfnBatch": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/chainrpc.ChainNotifier/RegisterSpendNtfnBatch": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/chainrpc.ChainNotifier/SubscribeBlocks": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 	}
 
 	// DefaultChainNotifierMacFilename is the default name of the chain
@@ -63,6 +75,16 @@ var (
 	// has been shut down.
 	ErrChainNotifierServerShuttingDown = errors.New("chain notifier RPC " +
 		"subserver shutting down")
+
+	// ErrEmptyBatch is an error returned when a batch of requests doesn't
+	// contain any requests.
+	ErrEmptyBatch = errors.New("batch doesn't contain any requests")
+
+	// ErrBlockSubscriptionsUnsupported is an error returned when
+	// subscribing to blocks while the active chain backend doesn't process
+	// the full content of each block.
+	ErrBlockSubscriptionsUnsupported = errors.New("block subscriptions " +
+		"are not supported by the active chain backend")
 )
 
 // fileExists reports whether the named file or directory exists.
@@ -196,15 +218,9 @@ func (s *Server) RegisterWithRootServer(grpcServer *grpc.Server) error {
 func (s *Server) RegisterConfirmationsNtfn(in *ConfRequest,
 	confStream ChainNotifier_RegisterConfirmationsNtfnServer) error {
 
-	// We'll start by reconstructing the RPC request into what the
-	// underlying ChainNotifier expects.
-	var txid chainhash.Hash
-	copy(txid[:], in.Txid)
-
-	// We'll then register for the spend notification of the request.
-	confEvent, err := s.cfg.ChainNotifier.RegisterConfirmationsNtfn(
-		&txid, in.Script, in.NumConfs, in.HeightHint,
-	)
+	// We'll start by registering for the confirmation notification of the
+	// request.
+	confEvent, err := s.registerConfirmationsNtfn(in)
 	if err != nil {
 		return err
 	}
@@ -222,19 +238,11 @@ func (s *Server) RegisterConfirmationsNtfn(in *ConfRequest,
 				return chainntnfs.ErrChainNotifierShuttingDown
 			}
 
-			var rawTxBuf bytes.Buffer
-			err := details.Tx.Serialize(&rawTxBuf)
+			rpcConfDetails, err := marshallConfDetails(details)
 			if err != nil {
 				return err
 			}
 
-			rpcConfDetails := &ConfDetails{
-				RawTx:       rawTxBuf.Bytes(),
-				BlockHash:   details.BlockHash[:],
-				BlockHeight: details.BlockHeight,
-				TxIndex:     details.TxIndex,
-			}
-
 			conf := &ConfEvent{
 				Event: &ConfEvent_Conf{
 					Conf: rpcConfDetails,
@@ -292,19 +300,9 @@ func (s *Server) RegisterConfirmationsNtfn(in *ConfRequest,
 func (s *Server) RegisterSpendNtfn(in *SpendRequest,
 	spendStream ChainNotifier_RegisterSpendNtfnServer) error {
 
-	// We'll start by reconstructing the RPC request into what the
-	// underlying ChainNotifier expects.
-	var op *wire.OutPoint
-	if in.Outpoint != nil {
-		var txid chainhash.Hash
-		copy(txid[:], in.Outpoint.Hash)
-		op = &wire.OutPoint{Hash: txid, Index: in.Outpoint.Index}
-	}
-
-	// We'll then register for the spend notification of the request.
-	spendEvent, err := s.cfg.ChainNotifier.RegisterSpendNtfn(
-		op, in.Script, in.HeightHint,
-	)
+	// We'll start by registering for the spend notification of the
+	// request.
+	spendEvent, err := s.registerSpendNtfn(in)
 	if err != nil {
 		return err
 	}
@@ -322,23 +320,11 @@ func (s *Server) RegisterSpendNtfn(in *SpendRequest,
 				return chainntnfs.ErrChainNotifierShuttingDown
 			}
 
-			var rawSpendingTxBuf bytes.Buffer
-			err := details.SpendingTx.Serialize(&rawSpendingTxBuf)
+			rpcSpendDetails, err := marshallSpendDetails(details)
 			if err != nil {
 				return err
 			}
 
-			rpcSpendDetails := &SpendDetails{
-				SpendingOutpoint: &Outpoint{
-					Hash:  details.SpentOutPoint.Hash[:],
-					Index: details.SpentOutPoint.Index,
-				},
-				RawSpendingTx:      rawSpendingTxBuf.Bytes(),
-				SpendingTxHash:     details.SpenderTxHash[:],
-				SpendingInputIndex: details.SpenderInputIndex,
-				SpendingHeight:     uint32(details.SpendingHeight),
-			}
-
 			spend := &SpendEvent{
 				Event: &SpendEvent_Spend{
 					Spend: rpcSpendDetails,
@@ -451,3 +437,433 @@ func (s *Server) RegisterBlockEpochNtfn(in *BlockEpoch,
 		}
 	}
 }
+
+// RegisterConfirmationsNtfnBatch is a synchronous response-streaming RPC that
+// registers a batch of confirmation requests within a single stream. Each event
+// carries the index of the request within the batch it is for. Once a request
+// is no longer under the risk of being reorged out of the chain, a done event
+// is sent for it. The stream is closed once all requests are done.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierService interface.
+func (s *Server) RegisterConfirmationsNtfnBatch(in *ConfRequestBatch,
+	confStream ChainNotifier_RegisterConfirmationsNtfnBatchServer) error {
+
+	if len(in.Requests) == 0 {
+		return ErrEmptyBatch
+	}
+
+	// We'll register all requests of the batch up front, such that we
+	// don't dispatch any events for a batch that can't be registered as a
+	// whole.
+	confEvents := make([]*chainntnfs.ConfirmationEvent, 0, len(in.Requests))
+	defer func() {
+		for _, confEvent := range confEvents {
+			confEvent.Cancel()
+		}
+	}()
+	for _, req := range in.Requests {
+		confEvent, err := s.registerConfirmationsNtfn(req)
+		if err != nil {
+			return err
+		}
+		confEvents = append(confEvents, confEvent)
+	}
+
+	// Each request is handled by its own goroutine, which hands its events
+	// over to us to be sent over the stream.
+	events := make(chan interface{})
+	results := make(chan error, len(confEvents))
+	quit := make(chan struct{})
+
+	var wg sync.WaitGroup
+	defer func() {
+		close(quit)
+		wg.Wait()
+	}()
+
+	for i, confEvent := range confEvents {
+		wg.Add(1)
+		go func(index uint32, confEvent *chainntnfs.ConfirmationEvent) {
+			defer wg.Done()
+			results <- forwardConfEvents(index, confEvent, events, quit)
+		}(uint32(i), confEvent)
+	}
+
+	return s.dispatchBatchEvents(confStream, len(confEvents), events, results)
+}
+
+// RegisterSpendNtfnBatch is a synchronous response-streaming RPC that registers
+// a batch of spend requests within a single stream. Each event carries the
+// index of the request within the batch it is for. Once a request is no longer
+// under the risk of being reorged out of the chain, a done event is sent for
+// it. The stream is closed once all requests are done.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierService interface.
+func (s *Server) RegisterSpendNtfnBatch(in *SpendRequestBatch,
+	spendStream ChainNotifier_RegisterSpendNtfnBatchServer) error {
+
+	if len(in.Requests) == 0 {
+		return ErrEmptyBatch
+	}
+
+	// We'll register all requests of the batch up front, such that we
+	// don't dispatch any events for a batch that can't be registered as a
+	// whole.
+	spendEvents := make([]*chainntnfs.SpendEvent, 0, len(in.Requests))
+	defer func() {
+		for _, spendEvent := range spendEvents {
+			spendEvent.Cancel()
+		}
+	}()
+	for _, req := range in.Requests {
+		spendEvent, err := s.registerSpendNtfn(req)
+		if err != nil {
+			return err
+		}
+		spendEvents = append(spendEvents, spendEvent)
+	}
+
+	// Each request is handled by its own goroutine, which hands its events
+	// over to us to be sent over the stream.
+	events := make(chan interface{})
+	results := make(chan error, len(spendEvents))
+	quit := make(chan struct{})
+
+	var wg sync.WaitGroup
+	defer func() {
+		close(quit)
+		wg.Wait()
+	}()
+
+	for i, spendEvent := range spendEvents {
+		wg.Add(1)
+		go func(index uint32, spendEvent *chainntnfs.SpendEvent) {
+			defer wg.Done()
+			results <- forwardSpendEvents(index, spendEvent, events, quit)
+		}(uint32(i), spendEvent)
+	}
+
+	return s.dispatchBatchEvents(spendStream, len(spendEvents), events, results)
+}
+
+// SubscribeBlocks is a synchronous response-streaming RPC that notifies the
+// client of each block connected to and disconnected from the tip of the main
+// chain, in order. The events of connected blocks include the transactions
+// matching the given filter.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierService interface.
+func (s *Server) SubscribeBlocks(in *BlockFilter,
+	blockStream ChainNotifier_SubscribeBlocksServer) error {
+
+	// Only chain backends that process the full content of each block are
+	// able to deliver the matching transactions.
+	blockNotifier, ok := s.cfg.ChainNotifier.(chainntnfs.BlockNotifier)
+	if !ok {
+		return ErrBlockSubscriptionsUnsupported
+	}
+
+	// We'll start by reconstructing the RPC filter into what the
+	// underlying BlockNotifier expects.
+	filter := &chainntnfs.BlockFilter{
+		PkScripts: in.Scripts,
+	}
+	for _, outpoint := range in.Outpoints {
+		var txid chainhash.Hash
+		copy(txid[:], outpoint.Hash)
+		filter.OutPoints = append(filter.OutPoints, wire.OutPoint{
+			Hash:  txid,
+			Index: outpoint.Index,
+		})
+	}
+
+	blockSub, err := blockNotifier.SubscribeBlocks(filter)
+	if err != nil {
+		return err
+	}
+	defer blockSub.Cancel()
+
+	for {
+		select {
+		// A block has been connected to or disconnected from the tip
+		// of the main chain.
+		case blockEvent, ok := <-blockSub.Events:
+			if !ok {
+				return chainntnfs.ErrChainNotifierShuttingDown
+			}
+
+			event, err := marshallBlockEvent(blockEvent)
+			if err != nil {
+				return err
+			}
+			if err := blockStream.Send(event); err != nil {
+				return err
+			}
+
+		// The response stream's context for whatever reason has been
+		// closed. We'll return the error indicated by the context
+		// itself to the caller.
+		case <-blockStream.Context().Done():
+			return blockStream.Context().Err()
+
+		// The server has been requested to shut down.
+		case <-s.quit:
+			return ErrChainNotifierServerShuttingDown
+		}
+	}
+}
+
+// registerConfirmationsNtfn reconstructs the RPC confirmation request into what
+// the underlying ChainNotifier expects and registers it.
+func (s *Server) registerConfirmationsNtfn(
+	in *ConfRequest) (*chainntnfs.ConfirmationEvent, error) {
+
+	var txid chainhash.Hash
+	copy(txid[:], in.Txid)
+
+	return s.cfg.ChainNotifier.RegisterConfirmationsNtfn(
+		&txid, in.Script, in.NumConfs, in.HeightHint,
+	)
+}
+
+// registerSpendNtfn reconstructs the RPC spend request into what the
+// underlying ChainNotifier expects and registers it.
+func (s *Server) registerSpendNtfn(
+	in *SpendRequest) (*chainntnfs.SpendEvent, error) {
+
+	var op *wire.OutPoint
+	if in.Outpoint != nil {
+		var txid chainhash.Hash
+		copy(txid[:], in.Outpoint.Hash)
+		op = &wire.OutPoint{Hash: txid, Index: in.Outpoint.Index}
+	}
+
+	return s.cfg.ChainNotifier.RegisterSpendNtfn(
+		op, in.Script, in.HeightHint,
+	)
+}
+
+// dispatchBatchEvents sends the events of a batch of requests over the stream
+// one at a time, until the forwarding goroutines of all requests have reported
+// their result.
+func (s *Server) dispatchBatchEvents(stream grpc.ServerStream,
+	numRequests int, events <-chan interface{}, results <-chan error) error {
+
+	for numRequests > 0 {
+		select {
+		case event := <-events:
+			if err := stream.SendMsg(event); err != nil {
+				return err
+			}
+
+		// A request is either done or failed. As its events are handed
+		// over synchronously, all of them have been sent by now.
+		case err := <-results:
+			if err != nil {
+				return err
+			}
+			numRequests--
+
+		// The response stream's context for whatever reason has been
+		// closed. We'll return the error indicated by the context
+		// itself to the caller.
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		// The server has been requested to shut down.
+		case <-s.quit:
+			return ErrChainNotifierServerShuttingDown
+		}
+	}
+
+	return nil
+}
+
+// forwardConfEvents hands the events of the confirmation request with the
+// given index within its batch over to the events channel, until the request
+// is done or quit is closed.
+func forwardConfEvents(index uint32, confEvent *chainntnfs.ConfirmationEvent,
+	events chan<- interface{}, quit <-chan struct{}) error {
+
+	send := func(event *ConfEvent) bool {
+		event.RequestIndex = index
+
+		select {
+		case events <- event:
+			return true
+		case <-quit:
+			return false
+		}
+	}
+
+	for {
+		select {
+		case details, ok := <-confEvent.Confirmed:
+			if !ok {
+				return chainntnfs.ErrChainNotifierShuttingDown
+			}
+
+			rpcConfDetails, err := marshallConfDetails(details)
+			if err != nil {
+				return err
+			}
+
+			conf := &ConfEvent{
+				Event: &ConfEvent_Conf{Conf: rpcConfDetails},
+			}
+			if !send(conf) {
+				return nil
+			}
+
+		case _, ok := <-confEvent.NegativeConf:
+			if !ok {
+				return chainntnfs.ErrChainNotifierShuttingDown
+			}
+
+			reorg := &ConfEvent{
+				Event: &ConfEvent_Reorg{Reorg: &Reorg{}},
+			}
+			if !send(reorg) {
+				return nil
+			}
+
+		case _, ok := <-confEvent.Done:
+			if !ok {
+				return chainntnfs.ErrChainNotifierShuttingDown
+			}
+
+			send(&ConfEvent{Event: &ConfEvent_Done{Done: &Done{}}})
+			return nil
+
+		case <-quit:
+			return nil
+		}
+	}
+}
+
+// forwardSpendEvents hands the events of the spend request with the given
+// index within its batch over to the events channel, until the request is done
+// or quit is closed.
+func forwardSpendEvents(index uint32, spendEvent *chainntnfs.SpendEvent,
+	events chan<- interface{}, quit <-chan struct{}) error {
+
+	send := func(event *SpendEvent) bool {
+		event.RequestIndex = index
+
+		select {
+		case events <- event:
+			return true
+		case <-quit:
+			return false
+		}
+	}
+
+	for {
+		select {
+		case details, ok := <-spendEvent.Spend:
+			if !ok {
+				return chainntnfs.ErrChainNotifierShuttingDown
+			}
+
+			rpcSpendDetails, err := marshallSpendDetails(details)
+			if err != nil {
+				return err
+			}
+
+			spend := &SpendEvent{
+				Event: &SpendEvent_Spend{Spend: rpcSpendDetails},
+			}
+			if !send(spend) {
+				return nil
+			}
+
+		case _, ok := <-spendEvent.Reorg:
+			if !ok {
+				return chainntnfs.ErrChainNotifierShuttingDown
+			}
+
+			reorg := &SpendEvent{
+				Event: &SpendEvent_Reorg{Reorg: &Reorg{}},
+			}
+			if !send(reorg) {
+				return nil
+			}
+
+		case _, ok := <-spendEvent.Done:
+			if !ok {
+				return chainntnfs.ErrChainNotifierShuttingDown
+			}
+
+			send(&SpendEvent{Event: &SpendEvent_Done{Done: &Done{}}})
+			return nil
+
+		case <-quit:
+			return nil
+		}
+	}
+}
+
+// marshallConfDetails converts the confirmation details of a request into
+// their RPC counterpart.
+func marshallConfDetails(
+	details *chainntnfs.TxConfirmation) (*ConfDetails, error) {
+
+	var rawTxBuf bytes.Buffer
+	if err := details.Tx.Serialize(&rawTxBuf); err != nil {
+		return nil, err
+	}
+
+	return &ConfDetails{
+		RawTx:       rawTxBuf.Bytes(),
+		BlockHash:   details.BlockHash[:],
+		BlockHeight: details.BlockHeight,
+		TxIndex:     details.TxIndex,
+	}, nil
+}
+
+// marshallSpendDetails converts the spend details of a request into their RPC
+// counterpart.
+func marshallSpendDetails(
+	details *chainntnfs.SpendDetail) (*SpendDetails, error) {
+
+	var rawSpendingTxBuf bytes.Buffer
+	if err := details.SpendingTx.Serialize(&rawSpendingTxBuf); err != nil {
+		return nil, err
+	}
+
+	return &SpendDetails{
+		SpendingOutpoint: &Outpoint{
+			Hash:  details.SpentOutPoint.Hash[:],
+			Index: details.SpentOutPoint.Index,
+		},
+		RawSpendingTx:      rawSpendingTxBuf.Bytes(),
+		SpendingTxHash:     details.SpenderTxHash[:],
+		SpendingInputIndex: details.SpenderInputIndex,
+		SpendingHeight:     uint32(details.SpendingHeight),
+	}, nil
+}
+
+// marshallBlockEvent converts a block event into its RPC counterpart.
+func marshallBlockEvent(blockEvent *chainntnfs.BlockEvent) (*BlockEvent,
+	error) {
+
+	event := &BlockEvent{
+		Type:   BlockEventType_CONNECTED,
+		Height: blockEvent.Height,
+	}
+	if blockEvent.Type == chainntnfs.BlockDisconnected {
+		event.Type = BlockEventType_DISCONNECTED
+	}
+	if blockEvent.Hash != nil {
+		event.Hash = blockEvent.Hash[:]
+	}
+
+	for _, tx := range blockEvent.Txns {
+		var rawTxBuf bytes.Buffer
+		if err := tx.Serialize(&rawTxBuf); err != nil {
+			return nil, err
+		}
+		event.RawTxs = append(event.RawTxs, rawTxBuf.Bytes())
+	}
+
+	return event, nil
+}
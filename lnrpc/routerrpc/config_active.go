@@ -4,6 +4,7 @@ package routerrpc
 
 import (
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/routing"
 )
 
@@ -39,6 +40,10 @@ type Config struct {
 	// RouterBackend contains shared logic between this sub server and the
 	// main rpc server.
 	RouterBackend *RouterBackend
+
+	// ChanStatusMgr is the channel status manager that is used to manually
+	// enable or disable channels.
+	ChanStatusMgr *netann.ChanStatusManager
 }
 
 // DefaultConfig defines the config defaults.
@@ -74,6 +74,34 @@ func (PaymentState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7a0613f69d37b0a5, []int{0}
 }
 
+type ChanStatusAction int32
+
+const (
+	ChanStatusAction_ENABLE  ChanStatusAction = 0
+	ChanStatusAction_DISABLE ChanStatusAction = 1
+	ChanStatusAction_AUTO    ChanStatusAction = 2
+)
+
+var ChanStatusAction_name = map[int32]string{
+	0: "ENABLE",
+	1: "DISABLE",
+	2: "AUTO",
+}
+
+var ChanStatusAction_value = map[string]int32{
+	"ENABLE":  0,
+	"DISABLE": 1,
+	"AUTO":    2,
+}
+
+func (x ChanStatusAction) String() string {
+	return proto.EnumName(ChanStatusAction_name, int32(x))
+}
+
+func (ChanStatusAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{1}
+}
+
 type Failure_FailureCode int32
 
 const (
@@ -1317,8 +1345,92 @@ func (m *BuildRouteResponse) GetRoute() *lnrpc.Route {
 	return nil
 }
 
+type UpdateChanStatusRequest struct {
+	/// The channel point of the channel to update.
+	ChanPoint *lnrpc.ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	//*
+	//The action to take. ENABLE and DISABLE override the automatic management
+	//of the channel's status, AUTO hands control back to lnd. A manually
+	//disabled channel stays disabled across reconnects and restarts.
+	Action               ChanStatusAction `protobuf:"varint,2,opt,name=action,proto3,enum=routerrpc.ChanStatusAction" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateChanStatusRequest) Reset()         { *m = UpdateChanStatusRequest{} }
+func (m *UpdateChanStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateChanStatusRequest) ProtoMessage()    {}
+func (*UpdateChanStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{17}
+}
+
+func (m *UpdateChanStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateChanStatusRequest.Unmarshal(m, b)
+}
+func (m *UpdateChanStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateChanStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateChanStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateChanStatusRequest.Merge(m, src)
+}
+func (m *UpdateChanStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateChanStatusRequest.Size(m)
+}
+func (m *UpdateChanStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateChanStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateChanStatusRequest proto.InternalMessageInfo
+
+func (m *UpdateChanStatusRequest) GetChanPoint() *lnrpc.ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *UpdateChanStatusRequest) GetAction() ChanStatusAction {
+	if m != nil {
+		return m.Action
+	}
+	return ChanStatusAction_ENABLE
+}
+
+type UpdateChanStatusResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateChanStatusResponse) Reset()         { *m = UpdateChanStatusResponse{} }
+func (m *UpdateChanStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateChanStatusResponse) ProtoMessage()    {}
+func (*UpdateChanStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{18}
+}
+
+func (m *UpdateChanStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateChanStatusResponse.Unmarshal(m, b)
+}
+func (m *UpdateChanStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateChanStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateChanStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateChanStatusResponse.Merge(m, src)
+}
+func (m *UpdateChanStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateChanStatusResponse.Size(m)
+}
+func (m *UpdateChanStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateChanStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateChanStatusResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("routerrpc.PaymentState", PaymentState_name, PaymentState_value)
+	proto.RegisterEnum("routerrpc.ChanStatusAction", ChanStatusAction_name, ChanStatusAction_value)
 	proto.RegisterEnum("routerrpc.Failure_FailureCode", Failure_FailureCode_name, Failure_FailureCode_value)
 	proto.RegisterType((*SendPaymentRequest)(nil), "routerrpc.SendPaymentRequest")
 	proto.RegisterMapType((map[uint64][]byte)(nil), "routerrpc.SendPaymentRequest.DestTlvEntry")
@@ -1338,130 +1450,138 @@ func init() {
 	proto.RegisterType((*PairHistory)(nil), "routerrpc.PairHistory")
 	proto.RegisterType((*BuildRouteRequest)(nil), "routerrpc.BuildRouteRequest")
 	proto.RegisterType((*BuildRouteResponse)(nil), "routerrpc.BuildRouteResponse")
+	proto.RegisterType((*UpdateChanStatusRequest)(nil), "routerrpc.UpdateChanStatusRequest")
+	proto.RegisterType((*UpdateChanStatusResponse)(nil), "routerrpc.UpdateChanStatusResponse")
 }
 
 func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_7a0613f69d37b0a5) }
 
 var fileDescriptor_7a0613f69d37b0a5 = []byte{
-	// 1875 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xd1, 0x72, 0x22, 0xb9,
-	0xd5, 0xde, 0x36, 0x60, 0xe0, 0x00, 0x76, 0x5b, 0xf6, 0x78, 0x7a, 0xb0, 0xbd, 0xe3, 0x65, 0xf7,
-	0x9f, 0x75, 0x4d, 0xcd, 0x6f, 0x4f, 0x9c, 0xda, 0xad, 0xa9, 0xbd, 0x48, 0x0a, 0x83, 0x58, 0xf7,
-	0x0c, 0x34, 0x5e, 0x01, 0xb3, 0x3b, 0xc9, 0x85, 0x4a, 0x06, 0xd9, 0x74, 0xb9, 0xe9, 0x66, 0xbb,
-	0x85, 0x33, 0xce, 0x45, 0xaa, 0x52, 0xb9, 0xce, 0x73, 0xe4, 0x26, 0x77, 0x79, 0x91, 0x3c, 0x45,
-	0xf2, 0x04, 0x7b, 0x9f, 0x92, 0xd4, 0x0d, 0x8d, 0x8d, 0x27, 0xb9, 0x72, 0xeb, 0x3b, 0x9f, 0x8e,
-	0xa4, 0x73, 0x8e, 0x3e, 0x1d, 0x0c, 0xbb, 0x61, 0x30, 0x13, 0x3c, 0x0c, 0xa7, 0xc3, 0x13, 0xfd,
-	0x75, 0x3c, 0x0d, 0x03, 0x11, 0xa0, 0xe2, 0x1c, 0xaf, 0x16, 0xc3, 0xe9, 0x50, 0xa3, 0xb5, 0x3f,
-	0x67, 0x01, 0xf5, 0xb8, 0x3f, 0xba, 0x60, 0x77, 0x13, 0xee, 0x0b, 0xc2, 0x7f, 0x9e, 0xf1, 0x48,
-	0x20, 0x04, 0xd9, 0x11, 0x8f, 0x84, 0x65, 0x1c, 0x1a, 0x47, 0x65, 0xa2, 0xbe, 0x91, 0x09, 0x19,
-	0x36, 0x11, 0xd6, 0xda, 0xa1, 0x71, 0x94, 0x21, 0xf2, 0x13, 0x7d, 0x01, 0xe5, 0xa9, 0x9e, 0x47,
-	0xc7, 0x2c, 0x1a, 0x5b, 0x19, 0xc5, 0x2e, 0xc5, 0xd8, 0x39, 0x8b, 0xc6, 0xe8, 0x08, 0xcc, 0x2b,
-	0xd7, 0x67, 0x1e, 0x1d, 0x7a, 0xe2, 0x96, 0x8e, 0xb8, 0x27, 0x98, 0x95, 0x3d, 0x34, 0x8e, 0x72,
-	0x64, 0x43, 0xe1, 0x0d, 0x4f, 0xdc, 0x36, 0x25, 0x8a, 0xbe, 0x86, 0xcd, 0xc4, 0x59, 0xa8, 0x77,
-	0x61, 0xe5, 0x0e, 0x8d, 0xa3, 0x22, 0xd9, 0x98, 0x2e, 0xef, 0xed, 0x6b, 0xd8, 0x14, 0xee, 0x84,
-	0x07, 0x33, 0x41, 0x23, 0x3e, 0x0c, 0xfc, 0x51, 0x64, 0xad, 0x6b, 0x8f, 0x31, 0xdc, 0xd3, 0x28,
-	0xaa, 0x41, 0xe5, 0x8a, 0x73, 0xea, 0xb9, 0x13, 0x57, 0xd0, 0x88, 0x09, 0x2b, 0xaf, 0xb6, 0x5e,
-	0xba, 0xe2, 0xbc, 0x2d, 0xb1, 0x1e, 0x13, 0xe8, 0x15, 0x98, 0xc1, 0x4c, 0x5c, 0x07, 0xae, 0x7f,
-	0x4d, 0x87, 0x63, 0xe6, 0x53, 0x77, 0x64, 0x15, 0x0e, 0x8d, 0xa3, 0xec, 0xd9, 0xda, 0x6b, 0x83,
-	0x6c, 0x24, 0xb6, 0xc6, 0x98, 0xf9, 0xf6, 0x08, 0x1d, 0x00, 0xa8, 0x73, 0x28, 0x97, 0x56, 0x51,
-	0xad, 0x5a, 0x94, 0x88, 0xf2, 0x87, 0x4e, 0xa1, 0xa4, 0x82, 0x4c, 0xc7, 0xae, 0x2f, 0x22, 0x0b,
-	0x0e, 0x33, 0x47, 0xa5, 0x53, 0xf3, 0xd8, 0xf3, 0x65, 0xbc, 0x89, 0xb4, 0x9c, 0xbb, 0xbe, 0x20,
-	0x69, 0x12, 0xc2, 0x50, 0x90, 0xd1, 0xa5, 0xc2, 0xbb, 0xb5, 0x4a, 0x6a, 0xc2, 0xcb, 0xe3, 0x79,
-	0xa6, 0x8e, 0x1f, 0xa6, 0xe6, 0xb8, 0xc9, 0x23, 0xd1, 0xf7, 0x6e, 0xb1, 0x2f, 0xc2, 0x3b, 0x92,
-	0x1f, 0xe9, 0x51, 0xf5, 0x3b, 0x28, 0xa7, 0x0d, 0x32, 0x59, 0x37, 0xfc, 0x4e, 0xe5, 0x2f, 0x4b,
-	0xe4, 0x27, 0xda, 0x81, 0xdc, 0x2d, 0xf3, 0x66, 0x5c, 0x25, 0xb0, 0x4c, 0xf4, 0xe0, 0xbb, 0xb5,
-	0x37, 0x46, 0xed, 0x0d, 0x6c, 0xf7, 0x43, 0x36, 0xbc, 0xb9, 0x57, 0x03, 0xf7, 0xb3, 0x6b, 0x3c,
-	0xc8, 0x6e, 0xed, 0x4f, 0x50, 0x89, 0x27, 0xf5, 0x04, 0x13, 0xb3, 0x08, 0xfd, 0x3f, 0xe4, 0x22,
-	0xc1, 0x04, 0x57, 0xe4, 0x8d, 0xd3, 0xa7, 0xa9, 0xa3, 0xa4, 0x88, 0x9c, 0x68, 0x16, 0xaa, 0x42,
-	0x61, 0x1a, 0x72, 0x77, 0xc2, 0xae, 0x93, 0x6d, 0xcd, 0xc7, 0xa8, 0x06, 0x39, 0x35, 0x59, 0x55,
-	0x55, 0xe9, 0xb4, 0x9c, 0x0e, 0x23, 0xd1, 0xa6, 0xda, 0x6f, 0x60, 0x53, 0x8d, 0x5b, 0x9c, 0x7f,
-	0xaa, 0x72, 0x9f, 0x42, 0x9e, 0x4d, 0x74, 0x09, 0xe8, 0xea, 0x5d, 0x67, 0x13, 0x99, 0xfd, 0xda,
-	0x08, 0xcc, 0xc5, 0xfc, 0x68, 0x1a, 0xf8, 0x11, 0x97, 0x15, 0x2b, 0x9d, 0xcb, 0x82, 0x90, 0xd5,
-	0x33, 0x91, 0xb3, 0x0c, 0x35, 0x6b, 0x23, 0xc6, 0x5b, 0x9c, 0x77, 0x22, 0x26, 0xd0, 0x0b, 0x5d,
-	0x88, 0xd4, 0x0b, 0x86, 0x37, 0xb2, 0xb4, 0xd9, 0x5d, 0xec, 0xbe, 0x22, 0xe1, 0x76, 0x30, 0xbc,
-	0x69, 0x4a, 0xb0, 0xf6, 0x7b, 0x7d, 0xc5, 0xfa, 0x81, 0xde, 0xfb, 0xff, 0x1c, 0xde, 0x45, 0x08,
-	0xd6, 0x1e, 0x0f, 0x01, 0x85, 0xed, 0x25, 0xe7, 0xf1, 0x29, 0xd2, 0x91, 0x35, 0xee, 0x45, 0xf6,
-	0x15, 0xe4, 0xaf, 0x98, 0xeb, 0xcd, 0xc2, 0xc4, 0x31, 0x4a, 0xa5, 0xa9, 0xa5, 0x2d, 0x24, 0xa1,
-	0xd4, 0x7e, 0xc9, 0x43, 0x3e, 0x06, 0xd1, 0x29, 0x64, 0x87, 0xc1, 0x28, 0xc9, 0xee, 0xe7, 0x0f,
-	0xa7, 0x25, 0x7f, 0x1b, 0xc1, 0x88, 0x13, 0xc5, 0x45, 0xbf, 0x85, 0x0d, 0x79, 0xb1, 0x7c, 0xee,
-	0xd1, 0xd9, 0x74, 0xc4, 0xe6, 0x09, 0xb5, 0x52, 0xb3, 0x1b, 0x9a, 0x30, 0x50, 0x76, 0x52, 0x19,
-	0xa6, 0x87, 0x68, 0x0f, 0x8a, 0x63, 0xe1, 0x0d, 0x75, 0x26, 0xb2, 0xaa, 0xa0, 0x0b, 0x12, 0x50,
-	0x39, 0xa8, 0x41, 0x25, 0xf0, 0xdd, 0xc0, 0xa7, 0xd1, 0x98, 0xd1, 0xd3, 0x6f, 0xbe, 0x55, 0x9a,
-	0x51, 0x26, 0x25, 0x05, 0xf6, 0xc6, 0xec, 0xf4, 0x9b, 0x6f, 0xd1, 0x73, 0x28, 0xa9, 0x5b, 0xcb,
-	0x3f, 0x4e, 0xdd, 0xf0, 0x4e, 0x89, 0x45, 0x85, 0xa8, 0x8b, 0x8c, 0x15, 0x22, 0xaf, 0xc6, 0x95,
-	0xc7, 0xae, 0x23, 0x25, 0x10, 0x15, 0xa2, 0x07, 0xe8, 0x35, 0xec, 0xc4, 0x31, 0xa0, 0x51, 0x30,
-	0x0b, 0x87, 0x9c, 0xba, 0xfe, 0x88, 0x7f, 0x54, 0xf2, 0x50, 0x21, 0x28, 0xb6, 0xf5, 0x94, 0xc9,
-	0x96, 0x16, 0xb4, 0x0b, 0xeb, 0x63, 0xee, 0x5e, 0x8f, 0xb5, 0x34, 0x54, 0x48, 0x3c, 0xaa, 0xfd,
-	0x3d, 0x07, 0xa5, 0x54, 0x60, 0x50, 0x19, 0x0a, 0x04, 0xf7, 0x30, 0x79, 0x8f, 0x9b, 0xe6, 0x67,
-	0xe8, 0x08, 0xbe, 0xb2, 0x9d, 0x46, 0x97, 0x10, 0xdc, 0xe8, 0xd3, 0x2e, 0xa1, 0x03, 0xe7, 0x9d,
-	0xd3, 0xfd, 0xd1, 0xa1, 0x17, 0xf5, 0x0f, 0x1d, 0xec, 0xf4, 0x69, 0x13, 0xf7, 0xeb, 0x76, 0xbb,
-	0x67, 0x1a, 0x68, 0x1f, 0xac, 0x05, 0x33, 0x31, 0xd7, 0x3b, 0xdd, 0x81, 0xd3, 0x37, 0xd7, 0xd0,
-	0x73, 0xd8, 0x6b, 0xd9, 0x4e, 0xbd, 0x4d, 0x17, 0x9c, 0x46, 0xbb, 0xff, 0x9e, 0xe2, 0x9f, 0x2e,
-	0x6c, 0xf2, 0xc1, 0xcc, 0xac, 0x22, 0x9c, 0xf7, 0xdb, 0x8d, 0xc4, 0x43, 0x16, 0x3d, 0x83, 0x27,
-	0x9a, 0xa0, 0xa7, 0xd0, 0x7e, 0xb7, 0x4b, 0x7b, 0xdd, 0xae, 0x63, 0xe6, 0xd0, 0x16, 0x54, 0x6c,
-	0xe7, 0x7d, 0xbd, 0x6d, 0x37, 0x29, 0xc1, 0xf5, 0x76, 0xc7, 0x5c, 0x47, 0xdb, 0xb0, 0x79, 0x9f,
-	0x97, 0x97, 0x2e, 0x12, 0x5e, 0xd7, 0xb1, 0xbb, 0x0e, 0x7d, 0x8f, 0x49, 0xcf, 0xee, 0x3a, 0x66,
-	0x01, 0xed, 0x02, 0x5a, 0x36, 0x9d, 0x77, 0xea, 0x0d, 0xb3, 0x88, 0x9e, 0xc0, 0xd6, 0x32, 0xfe,
-	0x0e, 0x7f, 0x30, 0x01, 0x59, 0xb0, 0xa3, 0x37, 0x46, 0xcf, 0x70, 0xbb, 0xfb, 0x23, 0xed, 0xd8,
-	0x8e, 0xdd, 0x19, 0x74, 0xcc, 0x12, 0xda, 0x01, 0xb3, 0x85, 0x31, 0xb5, 0x9d, 0xde, 0xa0, 0xd5,
-	0xb2, 0x1b, 0x36, 0x76, 0xfa, 0x66, 0x59, 0xaf, 0xbc, 0xea, 0xe0, 0x15, 0x39, 0xa1, 0x71, 0x5e,
-	0x77, 0x1c, 0xdc, 0xa6, 0x4d, 0xbb, 0x57, 0x3f, 0x6b, 0xe3, 0xa6, 0xb9, 0x81, 0x0e, 0xe0, 0x59,
-	0x1f, 0x77, 0x2e, 0xba, 0xa4, 0x4e, 0x3e, 0xd0, 0xc4, 0xde, 0xaa, 0xdb, 0xed, 0x01, 0xc1, 0xe6,
-	0x26, 0xfa, 0x02, 0x0e, 0x08, 0xfe, 0x61, 0x60, 0x13, 0xdc, 0xa4, 0x4e, 0xb7, 0x89, 0x69, 0x0b,
-	0xd7, 0xfb, 0x03, 0x82, 0x69, 0xc7, 0xee, 0xf5, 0x6c, 0xe7, 0x7b, 0xd3, 0x44, 0x5f, 0xc1, 0xe1,
-	0x9c, 0x32, 0x77, 0x70, 0x8f, 0xb5, 0x25, 0xcf, 0x97, 0xa4, 0xd4, 0xc1, 0x3f, 0xf5, 0xe9, 0x05,
-	0xc6, 0xc4, 0x44, 0xa8, 0x0a, 0xbb, 0x8b, 0xe5, 0xf5, 0x02, 0xf1, 0xda, 0xdb, 0xd2, 0x76, 0x81,
-	0x49, 0xa7, 0xee, 0xc8, 0x04, 0x2f, 0xd9, 0x76, 0xe4, 0xb6, 0x17, 0xb6, 0xfb, 0xdb, 0x7e, 0x82,
-	0x10, 0x6c, 0xa4, 0xb2, 0xd2, 0xaa, 0x13, 0x73, 0x17, 0xed, 0xc0, 0x66, 0xb2, 0x83, 0x84, 0xf8,
-	0xaf, 0x3c, 0x7a, 0x0a, 0x68, 0xe0, 0x10, 0x5c, 0x6f, 0xca, 0x80, 0xcc, 0x0d, 0xff, 0xce, 0xbf,
-	0xcd, 0x16, 0xd6, 0xcc, 0x4c, 0xed, 0x1f, 0x19, 0xa8, 0x2c, 0xdd, 0x4b, 0xb4, 0x0f, 0xc5, 0xc8,
-	0xbd, 0xf6, 0x99, 0x90, 0xca, 0xa1, 0x45, 0x65, 0x01, 0xa8, 0xb7, 0x71, 0xcc, 0x5c, 0x5f, 0xab,
-	0x99, 0x56, 0xf3, 0xa2, 0x42, 0x94, 0x96, 0xed, 0x41, 0x3e, 0x79, 0x5f, 0x33, 0xf3, 0xf7, 0x75,
-	0x7d, 0xa8, 0xdf, 0xd5, 0x7d, 0x28, 0x4a, 0xc9, 0x8c, 0x04, 0x9b, 0x4c, 0xd5, 0x15, 0xaf, 0x90,
-	0x05, 0x80, 0xbe, 0x84, 0xca, 0x84, 0x47, 0x11, 0xbb, 0xe6, 0x54, 0x5f, 0x53, 0x50, 0x8c, 0x72,
-	0x0c, 0xb6, 0xd4, 0x6d, 0xfd, 0x12, 0x12, 0xd9, 0x88, 0x49, 0x39, 0x4d, 0x8a, 0x41, 0x4d, 0xba,
-	0xaf, 0xd8, 0x82, 0xc5, 0x6a, 0x90, 0x56, 0x6c, 0xc1, 0xd0, 0x4b, 0xd8, 0xd2, 0x92, 0xe3, 0xfa,
-	0xee, 0x64, 0x36, 0xd1, 0xd2, 0x93, 0x57, 0xd2, 0xb3, 0xa9, 0xa4, 0x47, 0xe3, 0x4a, 0x81, 0x9e,
-	0x41, 0xe1, 0x92, 0x45, 0x5c, 0x3e, 0x16, 0xb1, 0x34, 0xe4, 0xe5, 0xb8, 0xc5, 0xb9, 0x34, 0xc9,
-	0x27, 0x24, 0x94, 0xa2, 0xa7, 0x15, 0x21, 0x7f, 0xc5, 0x39, 0x91, 0xb1, 0x9c, 0xaf, 0xc0, 0x3e,
-	0x2e, 0x56, 0x28, 0xa5, 0x56, 0xd0, 0xb8, 0x5a, 0xe1, 0x25, 0x6c, 0xf1, 0x8f, 0x22, 0x64, 0x34,
-	0x98, 0xb2, 0x9f, 0x67, 0x9c, 0x8e, 0x98, 0x60, 0x56, 0x59, 0x05, 0x78, 0x53, 0x19, 0xba, 0x0a,
-	0x6f, 0x32, 0xc1, 0x6a, 0xfb, 0x50, 0x25, 0x3c, 0xe2, 0xa2, 0xe3, 0x46, 0x91, 0x1b, 0xf8, 0x8d,
-	0xc0, 0x17, 0x61, 0xe0, 0xc5, 0x6f, 0x4e, 0xed, 0x00, 0xf6, 0x56, 0x5a, 0xf5, 0xa3, 0x21, 0x27,
-	0xff, 0x30, 0xe3, 0xe1, 0xdd, 0xea, 0xc9, 0x77, 0xb0, 0xb7, 0xd2, 0x1a, 0xbf, 0x38, 0xaf, 0x20,
-	0xe7, 0x07, 0x23, 0x1e, 0x59, 0x86, 0xea, 0x62, 0x76, 0x53, 0xf2, 0xee, 0x04, 0x23, 0x7e, 0xee,
-	0x46, 0x22, 0x08, 0xef, 0x88, 0x26, 0x49, 0xf6, 0x94, 0xb9, 0x61, 0x64, 0xad, 0x3d, 0x60, 0x5f,
-	0x30, 0x37, 0x9c, 0xb3, 0x15, 0xa9, 0xf6, 0x17, 0x03, 0x4a, 0x29, 0x27, 0x52, 0x68, 0xa7, 0xb3,
-	0xcb, 0xa4, 0xc1, 0x29, 0x93, 0x78, 0x84, 0x5e, 0xc0, 0x86, 0xc7, 0x22, 0x41, 0xa5, 0x36, 0x53,
-	0x99, 0xd2, 0xf8, 0x41, 0xbe, 0x87, 0xa2, 0x63, 0x40, 0x81, 0x18, 0xf3, 0x90, 0x46, 0xb3, 0xe1,
-	0x90, 0x47, 0x11, 0x9d, 0x86, 0xc1, 0xa5, 0xaa, 0xcb, 0x35, 0xb2, 0xc2, 0xf2, 0x36, 0x5b, 0xc8,
-	0x9a, 0xb9, 0xda, 0x2f, 0x06, 0x94, 0x52, 0x9b, 0x93, 0x55, 0x2b, 0x0f, 0x43, 0xaf, 0xc2, 0x60,
-	0x92, 0xdc, 0x87, 0x39, 0x80, 0x2c, 0xc8, 0xab, 0x81, 0x08, 0xe2, 0xcb, 0x90, 0x0c, 0x97, 0xab,
-	0x3d, 0xa3, 0x36, 0x98, 0xaa, 0xf6, 0x53, 0xd8, 0x99, 0xb8, 0x3e, 0x9d, 0x72, 0x9f, 0x79, 0xee,
-	0x1f, 0x39, 0x4d, 0x3a, 0x97, 0xac, 0x22, 0xae, 0xb4, 0xa1, 0x1a, 0x94, 0x97, 0x4e, 0x92, 0x53,
-	0x27, 0x59, 0xc2, 0xd0, 0x1b, 0x78, 0xaa, 0xa2, 0xc0, 0x84, 0xe0, 0x93, 0xa9, 0x48, 0x0e, 0x78,
-	0x35, 0xf3, 0xd4, 0x1d, 0x28, 0x90, 0xc7, 0xcc, 0xb5, 0xbf, 0x19, 0xb0, 0x75, 0x36, 0x73, 0xbd,
-	0xd1, 0x52, 0xff, 0xf2, 0x0c, 0x0a, 0x72, 0xf9, 0x54, 0x7f, 0x24, 0x9b, 0x2c, 0x55, 0xb0, 0xab,
-	0x9a, 0xfe, 0xb5, 0x95, 0x4d, 0xff, 0xaa, 0xf6, 0x3b, 0xf3, 0x68, 0xfb, 0xfd, 0x1c, 0x4a, 0xe3,
-	0x60, 0x4a, 0x75, 0xb2, 0x23, 0x2b, 0x7b, 0x98, 0x39, 0x2a, 0x13, 0x18, 0x07, 0xd3, 0x0b, 0x8d,
-	0xd4, 0xde, 0x00, 0x4a, 0x6f, 0x34, 0xae, 0xcc, 0x79, 0x1b, 0x65, 0x3c, 0xda, 0x46, 0xbd, 0xfc,
-	0xab, 0x01, 0xe5, 0x74, 0x87, 0x8a, 0x2a, 0x50, 0xb4, 0x1d, 0xda, 0x6a, 0xdb, 0xdf, 0x9f, 0xf7,
-	0xcd, 0xcf, 0xe4, 0xb0, 0x37, 0x68, 0x34, 0x30, 0x6e, 0xe2, 0xa6, 0x69, 0x48, 0x95, 0x95, 0x82,
-	0x89, 0x9b, 0xb4, 0x6f, 0x77, 0x70, 0x77, 0x20, 0xdf, 0xdf, 0x6d, 0xd8, 0x8c, 0x31, 0xa7, 0x4b,
-	0x49, 0x77, 0xd0, 0xc7, 0x66, 0x06, 0x99, 0x50, 0x8e, 0x41, 0x4c, 0x48, 0x97, 0x98, 0x59, 0xf9,
-	0x68, 0xc4, 0xc8, 0xc3, 0xb7, 0x3c, 0x79, 0xea, 0x73, 0xa7, 0xff, 0xcc, 0xc2, 0xba, 0xda, 0x60,
-	0x88, 0xce, 0xa1, 0x94, 0xfa, 0x19, 0x80, 0x0e, 0x3e, 0xf9, 0xf3, 0xa0, 0x6a, 0xad, 0x6e, 0xb9,
-	0x67, 0xd1, 0x6b, 0x03, 0xbd, 0x85, 0x72, 0xba, 0xd1, 0x47, 0xe9, 0x06, 0x6e, 0xc5, 0x2f, 0x80,
-	0x4f, 0xfa, 0x7a, 0x07, 0x26, 0x8e, 0x84, 0x3b, 0x91, 0x0d, 0x5b, 0xdc, 0x42, 0xa3, 0x6a, 0x8a,
-	0x7f, 0xaf, 0x2f, 0xaf, 0xee, 0xad, 0xb4, 0xc5, 0x19, 0x6a, 0xeb, 0x23, 0xc6, 0x4d, 0xec, 0x83,
-	0x23, 0x2e, 0x77, 0xce, 0xd5, 0xcf, 0x1f, 0x33, 0xc7, 0xde, 0x46, 0xb0, 0xbd, 0x42, 0xe5, 0xd0,
-	0xff, 0xa5, 0x77, 0xf0, 0xa8, 0x46, 0x56, 0x5f, 0xfc, 0x37, 0xda, 0x62, 0x95, 0x15, 0x72, 0xb8,
-	0xb4, 0xca, 0xe3, 0x62, 0xba, 0xb4, 0xca, 0xa7, 0x54, 0xd5, 0x06, 0x58, 0x54, 0x34, 0xda, 0x4f,
-	0xcd, 0x7a, 0x70, 0x23, 0xab, 0x07, 0x8f, 0x58, 0xb5, 0xab, 0xb3, 0x5f, 0xfd, 0xee, 0xe4, 0xda,
-	0x15, 0xe3, 0xd9, 0xe5, 0xf1, 0x30, 0x98, 0x9c, 0x78, 0xb2, 0x33, 0xf5, 0x5d, 0xff, 0xda, 0xe7,
-	0xe2, 0x0f, 0x41, 0x78, 0x73, 0xe2, 0xf9, 0xa3, 0x13, 0x75, 0x31, 0x4e, 0xe6, 0x5e, 0x2e, 0xd7,
-	0xd5, 0x3f, 0x09, 0x7e, 0xfd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe1, 0xb1, 0x0f, 0x8c, 0x54,
-	0x10, 0x00, 0x00,
+	// 1983 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0x4f, 0x73, 0xdb, 0xc6,
+	0x15, 0x37, 0x44, 0x52, 0x24, 0x1f, 0x49, 0x09, 0x5a, 0xc9, 0x32, 0x4c, 0x59, 0xb1, 0x02, 0xa7,
+	0x8e, 0xc6, 0xe3, 0x4a, 0xae, 0x3c, 0xce, 0x78, 0x72, 0x68, 0x87, 0x26, 0xc1, 0x08, 0x36, 0x09,
+	0x28, 0x4b, 0xd2, 0x89, 0x9b, 0xc3, 0xce, 0x9a, 0x5c, 0x89, 0x18, 0x83, 0x00, 0x03, 0x2c, 0x5d,
+	0xab, 0x87, 0xce, 0x74, 0x72, 0xee, 0xe7, 0xe8, 0xa5, 0x3d, 0xf5, 0x3b, 0xb5, 0x9f, 0x20, 0xf7,
+	0xce, 0xee, 0x02, 0x24, 0x48, 0x51, 0x6e, 0x4f, 0xe2, 0xfe, 0xde, 0x6f, 0xdf, 0xfe, 0x79, 0x6f,
+	0x7f, 0xef, 0x41, 0xb0, 0x1f, 0x85, 0x33, 0xce, 0xa2, 0x68, 0x3a, 0x3c, 0x55, 0xbf, 0x4e, 0xa6,
+	0x51, 0xc8, 0x43, 0x54, 0x9e, 0xe3, 0xf5, 0x72, 0x34, 0x1d, 0x2a, 0xd4, 0xfc, 0x6b, 0x1e, 0x50,
+	0x8f, 0x05, 0xa3, 0x0b, 0x7a, 0x3d, 0x61, 0x01, 0xc7, 0xec, 0xe7, 0x19, 0x8b, 0x39, 0x42, 0x90,
+	0x1f, 0xb1, 0x98, 0x1b, 0xda, 0x91, 0x76, 0x5c, 0xc5, 0xf2, 0x37, 0xd2, 0x21, 0x47, 0x27, 0xdc,
+	0xd8, 0x38, 0xd2, 0x8e, 0x73, 0x58, 0xfc, 0x44, 0x5f, 0x42, 0x75, 0xaa, 0xe6, 0x91, 0x31, 0x8d,
+	0xc7, 0x46, 0x4e, 0xb2, 0x2b, 0x09, 0x76, 0x4e, 0xe3, 0x31, 0x3a, 0x06, 0xfd, 0xd2, 0x0b, 0xa8,
+	0x4f, 0x86, 0x3e, 0xff, 0x48, 0x46, 0xcc, 0xe7, 0xd4, 0xc8, 0x1f, 0x69, 0xc7, 0x05, 0xbc, 0x25,
+	0xf1, 0xa6, 0xcf, 0x3f, 0xb6, 0x04, 0x8a, 0xbe, 0x86, 0xed, 0xd4, 0x59, 0xa4, 0x76, 0x61, 0x14,
+	0x8e, 0xb4, 0xe3, 0x32, 0xde, 0x9a, 0x2e, 0xef, 0xed, 0x6b, 0xd8, 0xe6, 0xde, 0x84, 0x85, 0x33,
+	0x4e, 0x62, 0x36, 0x0c, 0x83, 0x51, 0x6c, 0x6c, 0x2a, 0x8f, 0x09, 0xdc, 0x53, 0x28, 0x32, 0xa1,
+	0x76, 0xc9, 0x18, 0xf1, 0xbd, 0x89, 0xc7, 0x49, 0x4c, 0xb9, 0x51, 0x94, 0x5b, 0xaf, 0x5c, 0x32,
+	0xd6, 0x11, 0x58, 0x8f, 0x72, 0xf4, 0x14, 0xf4, 0x70, 0xc6, 0xaf, 0x42, 0x2f, 0xb8, 0x22, 0xc3,
+	0x31, 0x0d, 0x88, 0x37, 0x32, 0x4a, 0x47, 0xda, 0x71, 0xfe, 0xd5, 0xc6, 0x33, 0x0d, 0x6f, 0xa5,
+	0xb6, 0xe6, 0x98, 0x06, 0xf6, 0x08, 0x1d, 0x02, 0xc8, 0x73, 0x48, 0x97, 0x46, 0x59, 0xae, 0x5a,
+	0x16, 0x88, 0xf4, 0x87, 0xce, 0xa0, 0x22, 0x2f, 0x99, 0x8c, 0xbd, 0x80, 0xc7, 0x06, 0x1c, 0xe5,
+	0x8e, 0x2b, 0x67, 0xfa, 0x89, 0x1f, 0x88, 0xfb, 0xc6, 0xc2, 0x72, 0xee, 0x05, 0x1c, 0x67, 0x49,
+	0xc8, 0x82, 0x92, 0xb8, 0x5d, 0xc2, 0xfd, 0x8f, 0x46, 0x45, 0x4e, 0x78, 0x72, 0x32, 0x8f, 0xd4,
+	0xc9, 0xcd, 0xd0, 0x9c, 0xb4, 0x58, 0xcc, 0xfb, 0xfe, 0x47, 0x2b, 0xe0, 0xd1, 0x35, 0x2e, 0x8e,
+	0xd4, 0xa8, 0xfe, 0x2d, 0x54, 0xb3, 0x06, 0x11, 0xac, 0x0f, 0xec, 0x5a, 0xc6, 0x2f, 0x8f, 0xc5,
+	0x4f, 0xb4, 0x07, 0x85, 0x8f, 0xd4, 0x9f, 0x31, 0x19, 0xc0, 0x2a, 0x56, 0x83, 0x6f, 0x37, 0x5e,
+	0x6a, 0xe6, 0x4b, 0xd8, 0xed, 0x47, 0x74, 0xf8, 0x61, 0x25, 0x07, 0x56, 0xa3, 0xab, 0xdd, 0x88,
+	0xae, 0xf9, 0x17, 0xa8, 0x25, 0x93, 0x7a, 0x9c, 0xf2, 0x59, 0x8c, 0x7e, 0x0b, 0x85, 0x98, 0x53,
+	0xce, 0x24, 0x79, 0xeb, 0xec, 0x5e, 0xe6, 0x28, 0x19, 0x22, 0xc3, 0x8a, 0x85, 0xea, 0x50, 0x9a,
+	0x46, 0xcc, 0x9b, 0xd0, 0xab, 0x74, 0x5b, 0xf3, 0x31, 0x32, 0xa1, 0x20, 0x27, 0xcb, 0xac, 0xaa,
+	0x9c, 0x55, 0xb3, 0xd7, 0x88, 0x95, 0xc9, 0xfc, 0x3d, 0x6c, 0xcb, 0x71, 0x9b, 0xb1, 0xcf, 0x65,
+	0xee, 0x3d, 0x28, 0xd2, 0x89, 0x4a, 0x01, 0x95, 0xbd, 0x9b, 0x74, 0x22, 0xa2, 0x6f, 0x8e, 0x40,
+	0x5f, 0xcc, 0x8f, 0xa7, 0x61, 0x10, 0x33, 0x91, 0xb1, 0xc2, 0xb9, 0x48, 0x08, 0x91, 0x3d, 0x13,
+	0x31, 0x4b, 0x93, 0xb3, 0xb6, 0x12, 0xbc, 0xcd, 0x58, 0x37, 0xa6, 0x1c, 0x3d, 0x56, 0x89, 0x48,
+	0xfc, 0x70, 0xf8, 0x41, 0xa4, 0x36, 0xbd, 0x4e, 0xdc, 0xd7, 0x04, 0xdc, 0x09, 0x87, 0x1f, 0x5a,
+	0x02, 0x34, 0x7f, 0x52, 0x4f, 0xac, 0x1f, 0xaa, 0xbd, 0xff, 0xdf, 0xd7, 0xbb, 0xb8, 0x82, 0x8d,
+	0xdb, 0xaf, 0x80, 0xc0, 0xee, 0x92, 0xf3, 0xe4, 0x14, 0xd9, 0x9b, 0xd5, 0x56, 0x6e, 0xf6, 0x29,
+	0x14, 0x2f, 0xa9, 0xe7, 0xcf, 0xa2, 0xd4, 0x31, 0xca, 0x84, 0xa9, 0xad, 0x2c, 0x38, 0xa5, 0x98,
+	0xbf, 0x16, 0xa1, 0x98, 0x80, 0xe8, 0x0c, 0xf2, 0xc3, 0x70, 0x94, 0x46, 0xf7, 0x8b, 0x9b, 0xd3,
+	0xd2, 0xbf, 0xcd, 0x70, 0xc4, 0xb0, 0xe4, 0xa2, 0x3f, 0xc0, 0x96, 0x78, 0x58, 0x01, 0xf3, 0xc9,
+	0x6c, 0x3a, 0xa2, 0xf3, 0x80, 0x1a, 0x99, 0xd9, 0x4d, 0x45, 0x18, 0x48, 0x3b, 0xae, 0x0d, 0xb3,
+	0x43, 0x74, 0x00, 0xe5, 0x31, 0xf7, 0x87, 0x2a, 0x12, 0x79, 0x99, 0xd0, 0x25, 0x01, 0xc8, 0x18,
+	0x98, 0x50, 0x0b, 0x03, 0x2f, 0x0c, 0x48, 0x3c, 0xa6, 0xe4, 0xec, 0xc5, 0x37, 0x52, 0x33, 0xaa,
+	0xb8, 0x22, 0xc1, 0xde, 0x98, 0x9e, 0xbd, 0xf8, 0x06, 0x3d, 0x84, 0x8a, 0x7c, 0xb5, 0xec, 0xd3,
+	0xd4, 0x8b, 0xae, 0xa5, 0x58, 0xd4, 0xb0, 0x7c, 0xc8, 0x96, 0x44, 0xc4, 0xd3, 0xb8, 0xf4, 0xe9,
+	0x55, 0x2c, 0x05, 0xa2, 0x86, 0xd5, 0x00, 0x3d, 0x83, 0xbd, 0xe4, 0x0e, 0x48, 0x1c, 0xce, 0xa2,
+	0x21, 0x23, 0x5e, 0x30, 0x62, 0x9f, 0xa4, 0x3c, 0xd4, 0x30, 0x4a, 0x6c, 0x3d, 0x69, 0xb2, 0x85,
+	0x05, 0xed, 0xc3, 0xe6, 0x98, 0x79, 0x57, 0x63, 0x25, 0x0d, 0x35, 0x9c, 0x8c, 0xcc, 0x7f, 0x14,
+	0xa0, 0x92, 0xb9, 0x18, 0x54, 0x85, 0x12, 0xb6, 0x7a, 0x16, 0x7e, 0x6b, 0xb5, 0xf4, 0x3b, 0xe8,
+	0x18, 0xbe, 0xb2, 0x9d, 0xa6, 0x8b, 0xb1, 0xd5, 0xec, 0x13, 0x17, 0x93, 0x81, 0xf3, 0xc6, 0x71,
+	0x7f, 0x70, 0xc8, 0x45, 0xe3, 0x5d, 0xd7, 0x72, 0xfa, 0xa4, 0x65, 0xf5, 0x1b, 0x76, 0xa7, 0xa7,
+	0x6b, 0xe8, 0x01, 0x18, 0x0b, 0x66, 0x6a, 0x6e, 0x74, 0xdd, 0x81, 0xd3, 0xd7, 0x37, 0xd0, 0x43,
+	0x38, 0x68, 0xdb, 0x4e, 0xa3, 0x43, 0x16, 0x9c, 0x66, 0xa7, 0xff, 0x96, 0x58, 0x3f, 0x5e, 0xd8,
+	0xf8, 0x9d, 0x9e, 0x5b, 0x47, 0x38, 0xef, 0x77, 0x9a, 0xa9, 0x87, 0x3c, 0xba, 0x0f, 0x77, 0x15,
+	0x41, 0x4d, 0x21, 0x7d, 0xd7, 0x25, 0x3d, 0xd7, 0x75, 0xf4, 0x02, 0xda, 0x81, 0x9a, 0xed, 0xbc,
+	0x6d, 0x74, 0xec, 0x16, 0xc1, 0x56, 0xa3, 0xd3, 0xd5, 0x37, 0xd1, 0x2e, 0x6c, 0xaf, 0xf2, 0x8a,
+	0xc2, 0x45, 0xca, 0x73, 0x1d, 0xdb, 0x75, 0xc8, 0x5b, 0x0b, 0xf7, 0x6c, 0xd7, 0xd1, 0x4b, 0x68,
+	0x1f, 0xd0, 0xb2, 0xe9, 0xbc, 0xdb, 0x68, 0xea, 0x65, 0x74, 0x17, 0x76, 0x96, 0xf1, 0x37, 0xd6,
+	0x3b, 0x1d, 0x90, 0x01, 0x7b, 0x6a, 0x63, 0xe4, 0x95, 0xd5, 0x71, 0x7f, 0x20, 0x5d, 0xdb, 0xb1,
+	0xbb, 0x83, 0xae, 0x5e, 0x41, 0x7b, 0xa0, 0xb7, 0x2d, 0x8b, 0xd8, 0x4e, 0x6f, 0xd0, 0x6e, 0xdb,
+	0x4d, 0xdb, 0x72, 0xfa, 0x7a, 0x55, 0xad, 0xbc, 0xee, 0xe0, 0x35, 0x31, 0xa1, 0x79, 0xde, 0x70,
+	0x1c, 0xab, 0x43, 0x5a, 0x76, 0xaf, 0xf1, 0xaa, 0x63, 0xb5, 0xf4, 0x2d, 0x74, 0x08, 0xf7, 0xfb,
+	0x56, 0xf7, 0xc2, 0xc5, 0x0d, 0xfc, 0x8e, 0xa4, 0xf6, 0x76, 0xc3, 0xee, 0x0c, 0xb0, 0xa5, 0x6f,
+	0xa3, 0x2f, 0xe1, 0x10, 0x5b, 0xdf, 0x0f, 0x6c, 0x6c, 0xb5, 0x88, 0xe3, 0xb6, 0x2c, 0xd2, 0xb6,
+	0x1a, 0xfd, 0x01, 0xb6, 0x48, 0xd7, 0xee, 0xf5, 0x6c, 0xe7, 0x3b, 0x5d, 0x47, 0x5f, 0xc1, 0xd1,
+	0x9c, 0x32, 0x77, 0xb0, 0xc2, 0xda, 0x11, 0xe7, 0x4b, 0x43, 0xea, 0x58, 0x3f, 0xf6, 0xc9, 0x85,
+	0x65, 0x61, 0x1d, 0xa1, 0x3a, 0xec, 0x2f, 0x96, 0x57, 0x0b, 0x24, 0x6b, 0xef, 0x0a, 0xdb, 0x85,
+	0x85, 0xbb, 0x0d, 0x47, 0x04, 0x78, 0xc9, 0xb6, 0x27, 0xb6, 0xbd, 0xb0, 0xad, 0x6e, 0xfb, 0x2e,
+	0x42, 0xb0, 0x95, 0x89, 0x4a, 0xbb, 0x81, 0xf5, 0x7d, 0xb4, 0x07, 0xdb, 0xe9, 0x0e, 0x52, 0xe2,
+	0xbf, 0x8b, 0xe8, 0x1e, 0xa0, 0x81, 0x83, 0xad, 0x46, 0x4b, 0x5c, 0xc8, 0xdc, 0xf0, 0x9f, 0xe2,
+	0xeb, 0x7c, 0x69, 0x43, 0xcf, 0x99, 0xff, 0xca, 0x41, 0x6d, 0xe9, 0x5d, 0xa2, 0x07, 0x50, 0x8e,
+	0xbd, 0xab, 0x80, 0x72, 0xa1, 0x1c, 0x4a, 0x54, 0x16, 0x80, 0xac, 0x8d, 0x63, 0xea, 0x05, 0x4a,
+	0xcd, 0x94, 0x9a, 0x97, 0x25, 0x22, 0xb5, 0xec, 0x00, 0x8a, 0x69, 0x7d, 0xcd, 0xcd, 0xeb, 0xeb,
+	0xe6, 0x50, 0xd5, 0xd5, 0x07, 0x50, 0x16, 0x92, 0x19, 0x73, 0x3a, 0x99, 0xca, 0x27, 0x5e, 0xc3,
+	0x0b, 0x00, 0x3d, 0x82, 0xda, 0x84, 0xc5, 0x31, 0xbd, 0x62, 0x44, 0x3d, 0x53, 0x90, 0x8c, 0x6a,
+	0x02, 0xb6, 0xe5, 0x6b, 0x7d, 0x04, 0xa9, 0x6c, 0x24, 0xa4, 0x82, 0x22, 0x25, 0xa0, 0x22, 0xad,
+	0x2a, 0x36, 0xa7, 0x89, 0x1a, 0x64, 0x15, 0x9b, 0x53, 0xf4, 0x04, 0x76, 0x94, 0xe4, 0x78, 0x81,
+	0x37, 0x99, 0x4d, 0x94, 0xf4, 0x14, 0xa5, 0xf4, 0x6c, 0x4b, 0xe9, 0x51, 0xb8, 0x54, 0xa0, 0xfb,
+	0x50, 0x7a, 0x4f, 0x63, 0x26, 0x8a, 0x45, 0x22, 0x0d, 0x45, 0x31, 0x6e, 0x33, 0x26, 0x4c, 0xa2,
+	0x84, 0x44, 0x42, 0xf4, 0x94, 0x22, 0x14, 0x2f, 0x19, 0xc3, 0xe2, 0x2e, 0xe7, 0x2b, 0xd0, 0x4f,
+	0x8b, 0x15, 0x2a, 0x99, 0x15, 0x14, 0x2e, 0x57, 0x78, 0x02, 0x3b, 0xec, 0x13, 0x8f, 0x28, 0x09,
+	0xa7, 0xf4, 0xe7, 0x19, 0x23, 0x23, 0xca, 0xa9, 0x51, 0x95, 0x17, 0xbc, 0x2d, 0x0d, 0xae, 0xc4,
+	0x5b, 0x94, 0x53, 0xf3, 0x01, 0xd4, 0x31, 0x8b, 0x19, 0xef, 0x7a, 0x71, 0xec, 0x85, 0x41, 0x33,
+	0x0c, 0x78, 0x14, 0xfa, 0x49, 0xcd, 0x31, 0x0f, 0xe1, 0x60, 0xad, 0x55, 0x15, 0x0d, 0x31, 0xf9,
+	0xfb, 0x19, 0x8b, 0xae, 0xd7, 0x4f, 0xbe, 0x86, 0x83, 0xb5, 0xd6, 0xa4, 0xe2, 0x3c, 0x85, 0x42,
+	0x10, 0x8e, 0x58, 0x6c, 0x68, 0xb2, 0x8b, 0xd9, 0xcf, 0xc8, 0xbb, 0x13, 0x8e, 0xd8, 0xb9, 0x17,
+	0xf3, 0x30, 0xba, 0xc6, 0x8a, 0x24, 0xd8, 0x53, 0xea, 0x45, 0xb1, 0xb1, 0x71, 0x83, 0x7d, 0x41,
+	0xbd, 0x68, 0xce, 0x96, 0x24, 0xf3, 0x17, 0x0d, 0x2a, 0x19, 0x27, 0x42, 0x68, 0xa7, 0xb3, 0xf7,
+	0x69, 0x83, 0x53, 0xc5, 0xc9, 0x08, 0x3d, 0x86, 0x2d, 0x9f, 0xc6, 0x9c, 0x08, 0x6d, 0x26, 0x22,
+	0xa4, 0x49, 0x41, 0x5e, 0x41, 0xd1, 0x09, 0xa0, 0x90, 0x8f, 0x59, 0x44, 0xe2, 0xd9, 0x70, 0xc8,
+	0xe2, 0x98, 0x4c, 0xa3, 0xf0, 0xbd, 0xcc, 0xcb, 0x0d, 0xbc, 0xc6, 0xf2, 0x3a, 0x5f, 0xca, 0xeb,
+	0x05, 0xf3, 0x57, 0x0d, 0x2a, 0x99, 0xcd, 0x89, 0xac, 0x15, 0x87, 0x21, 0x97, 0x51, 0x38, 0x49,
+	0xdf, 0xc3, 0x1c, 0x40, 0x06, 0x14, 0xe5, 0x80, 0x87, 0xc9, 0x63, 0x48, 0x87, 0xcb, 0xd9, 0x9e,
+	0x93, 0x1b, 0xcc, 0x64, 0xfb, 0x19, 0xec, 0x4d, 0xbc, 0x80, 0x4c, 0x59, 0x40, 0x7d, 0xef, 0xcf,
+	0x8c, 0xa4, 0x9d, 0x4b, 0x5e, 0x12, 0xd7, 0xda, 0x90, 0x09, 0xd5, 0xa5, 0x93, 0x14, 0xe4, 0x49,
+	0x96, 0x30, 0xf4, 0x12, 0xee, 0xc9, 0x5b, 0xa0, 0x9c, 0xb3, 0xc9, 0x94, 0xa7, 0x07, 0xbc, 0x9c,
+	0xf9, 0xf2, 0x0d, 0x94, 0xf0, 0x6d, 0x66, 0xf3, 0xef, 0x1a, 0xec, 0xbc, 0x9a, 0x79, 0xfe, 0x68,
+	0xa9, 0x7f, 0xb9, 0x0f, 0x25, 0xb1, 0x7c, 0xa6, 0x3f, 0x12, 0x4d, 0x96, 0x4c, 0xd8, 0x75, 0x4d,
+	0xff, 0xc6, 0xda, 0xa6, 0x7f, 0x5d, 0xfb, 0x9d, 0xbb, 0xb5, 0xfd, 0x7e, 0x08, 0x95, 0x71, 0x38,
+	0x25, 0x2a, 0xd8, 0xb1, 0x91, 0x3f, 0xca, 0x1d, 0x57, 0x31, 0x8c, 0xc3, 0xe9, 0x85, 0x42, 0xcc,
+	0x97, 0x80, 0xb2, 0x1b, 0x4d, 0x32, 0x73, 0xde, 0x46, 0x69, 0xb7, 0xb7, 0x51, 0xbf, 0x68, 0x70,
+	0x4f, 0xc9, 0x9c, 0x58, 0x4b, 0x75, 0xb3, 0xe9, 0x49, 0x9f, 0x4b, 0x65, 0x0b, 0xc8, 0x34, 0xf4,
+	0x02, 0x9e, 0x38, 0xd9, 0x4d, 0x9c, 0x24, 0x0a, 0x79, 0x21, 0x4c, 0x38, 0x43, 0x43, 0xcf, 0x61,
+	0x93, 0x0e, 0xb9, 0x17, 0x06, 0xf2, 0xe4, 0x5b, 0x67, 0x07, 0x2b, 0xed, 0x8e, 0x5a, 0xa2, 0x21,
+	0x29, 0x38, 0xa1, 0x9a, 0x75, 0x30, 0x6e, 0x6e, 0x42, 0x9d, 0xe2, 0xc9, 0xdf, 0x34, 0xa8, 0x66,
+	0x7b, 0x68, 0x54, 0x83, 0xb2, 0xed, 0x90, 0x76, 0xc7, 0xfe, 0xee, 0xbc, 0xaf, 0xdf, 0x11, 0xc3,
+	0xde, 0xa0, 0xd9, 0xb4, 0xac, 0x96, 0xd5, 0xd2, 0x35, 0x51, 0x07, 0x84, 0xa4, 0x5b, 0x2d, 0xd2,
+	0xb7, 0xbb, 0x96, 0x3b, 0x10, 0x1d, 0xc2, 0x2e, 0x6c, 0x27, 0x98, 0xe3, 0x12, 0xec, 0x0e, 0xfa,
+	0x96, 0x9e, 0x43, 0x3a, 0x54, 0x13, 0xd0, 0xc2, 0xd8, 0xc5, 0x7a, 0x5e, 0x94, 0xb5, 0x04, 0xb9,
+	0xd9, 0x6d, 0xa4, 0xcd, 0x48, 0xe1, 0xc9, 0x0b, 0xd0, 0x57, 0xcf, 0x81, 0x00, 0x36, 0x2d, 0x47,
+	0x94, 0x13, 0xfd, 0x0e, 0xaa, 0x40, 0x31, 0x29, 0xb6, 0xba, 0x86, 0x4a, 0x90, 0x6f, 0x0c, 0xfa,
+	0xae, 0xbe, 0x71, 0xf6, 0xcf, 0x02, 0x6c, 0xca, 0x9b, 0x8f, 0xd0, 0x39, 0x54, 0x32, 0xdf, 0x37,
+	0xe8, 0xf0, 0xb3, 0xdf, 0x3d, 0x75, 0x63, 0xfd, 0xb7, 0xc4, 0x2c, 0x7e, 0xa6, 0xa1, 0xd7, 0x50,
+	0xcd, 0x7e, 0xc1, 0xa0, 0x6c, 0x67, 0xba, 0xe6, 0xd3, 0xe6, 0xb3, 0xbe, 0xde, 0x80, 0x6e, 0xc5,
+	0xdc, 0x9b, 0x88, 0x4e, 0x34, 0xf9, 0x36, 0x40, 0xf5, 0x0c, 0x7f, 0xe5, 0x83, 0xa3, 0x7e, 0xb0,
+	0xd6, 0x96, 0xa4, 0x5e, 0x47, 0x1d, 0x31, 0xe9, 0xce, 0x6f, 0x1c, 0x71, 0xf9, 0x93, 0xa0, 0xfe,
+	0xc5, 0x6d, 0xe6, 0xc4, 0xdb, 0x08, 0x76, 0xd7, 0xc8, 0x37, 0xfa, 0x4d, 0x76, 0x07, 0xb7, 0x8a,
+	0x7f, 0xfd, 0xf1, 0xff, 0xa2, 0x2d, 0x56, 0x59, 0xa3, 0xf3, 0x4b, 0xab, 0xdc, 0x5e, 0x25, 0x96,
+	0x56, 0xf9, 0x5c, 0xb9, 0xb0, 0x01, 0x16, 0x4f, 0x15, 0x3d, 0xc8, 0xcc, 0xba, 0x21, 0x35, 0xf5,
+	0xc3, 0x5b, 0xac, 0x89, 0xab, 0x9f, 0x40, 0x5f, 0x7d, 0x35, 0xc8, 0xcc, 0x4c, 0xb9, 0xe5, 0x5d,
+	0xd7, 0x1f, 0x7d, 0x96, 0xa3, 0x9c, 0xbf, 0xfa, 0xdd, 0x1f, 0x4f, 0xaf, 0x3c, 0x3e, 0x9e, 0xbd,
+	0x3f, 0x19, 0x86, 0x93, 0x53, 0x5f, 0xf4, 0xf3, 0x81, 0x17, 0x5c, 0x05, 0x8c, 0xff, 0x29, 0x8c,
+	0x3e, 0x9c, 0xfa, 0xc1, 0xe8, 0x54, 0x2a, 0xc1, 0xe9, 0xdc, 0xd7, 0xfb, 0x4d, 0xf9, 0xaf, 0x95,
+	0xe7, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xba, 0x25, 0xbe, 0x8a, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1507,6 +1627,12 @@ type RouterClient interface {
 	//keys. It retrieves the relevant channel policies from the graph in order to
 	//calculate the correct fees and time locks.
 	BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error)
+	//*
+	//UpdateChanStatus attempts to manually set the state of a channel
+	//(enabled, disabled, or auto). A manual "disable" request will cause the
+	//channel to stay disabled until a subsequent manual request of either
+	//"enable" or "auto".
+	UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error)
 }
 
 type routerClient struct {
@@ -1626,6 +1752,15 @@ func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, op
 	return out, nil
 }
 
+func (c *routerClient) UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error) {
+	out := new(UpdateChanStatusResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/UpdateChanStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RouterServer is the server API for Router service.
 type RouterServer interface {
 	//*
@@ -1659,6 +1794,12 @@ type RouterServer interface {
 	//keys. It retrieves the relevant channel policies from the graph in order to
 	//calculate the correct fees and time locks.
 	BuildRoute(context.Context, *BuildRouteRequest) (*BuildRouteResponse, error)
+	//*
+	//UpdateChanStatus attempts to manually set the state of a channel
+	//(enabled, disabled, or auto). A manual "disable" request will cause the
+	//channel to stay disabled until a subsequent manual request of either
+	//"enable" or "auto".
+	UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error)
 }
 
 func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
@@ -1797,6 +1938,24 @@ func _Router_BuildRoute_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_UpdateChanStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChanStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).UpdateChanStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/UpdateChanStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).UpdateChanStatus(ctx, req.(*UpdateChanStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Router_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "routerrpc.Router",
 	HandlerType: (*RouterServer)(nil),
@@ -1821,6 +1980,10 @@ var _Router_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BuildRoute",
 			Handler:    _Router_BuildRoute_Handler,
 		},
+		{
+			MethodName: "UpdateChanStatus",
+			Handler:    _Router_UpdateChanStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -10,6 +10,8 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
@@ -76,6 +78,10 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerrpc.Router/UpdateChanStatus": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -672,3 +678,66 @@ func (s *Server) BuildRoute(ctx context.Context,
 
 	return routeResp, nil
 }
+
+// UpdateChanStatus allows channel state to be set manually.
+func (s *Server) UpdateChanStatus(ctx context.Context,
+	req *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error) {
+
+	if req.ChanPoint == nil {
+		return nil, errors.New("channel point must be specified")
+	}
+
+	outPoint, err := parseChanPoint(req.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	action := req.GetAction()
+
+	log.Debugf("UpdateChanStatus called for channel(%v) with "+
+		"action %v", outPoint, action)
+
+	switch action {
+	case ChanStatusAction_ENABLE:
+		err = s.cfg.ChanStatusMgr.RequestEnable(*outPoint, true)
+	case ChanStatusAction_DISABLE:
+		err = s.cfg.ChanStatusMgr.RequestDisable(*outPoint, true)
+	case ChanStatusAction_AUTO:
+		err = s.cfg.ChanStatusMgr.RequestAuto(*outPoint)
+
+	default:
+		err = fmt.Errorf("unrecognized ChannelStatusAction %v", action)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateChanStatusResponse{}, nil
+}
+
+// parseChanPoint converts the given rpc channel point into a wire.OutPoint.
+func parseChanPoint(chanPoint *lnrpc.ChannelPoint) (*wire.OutPoint, error) {
+	var txid []byte
+
+	// A channel point's funding txid can be get/set as a byte slice or a
+	// string. In the case it is a string, decode it.
+	switch chanPoint.GetFundingTxid().(type) {
+	case *lnrpc.ChannelPoint_FundingTxidBytes:
+		txid = chanPoint.GetFundingTxidBytes()
+	case *lnrpc.ChannelPoint_FundingTxidStr:
+		s := chanPoint.GetFundingTxidStr()
+		h, err := chainhash.NewHashFromStr(s)
+		if err != nil {
+			return nil, err
+		}
+
+		txid = h[:]
+	}
+
+	hash, err := chainhash.NewHash(txid)
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewOutPoint(hash, chanPoint.OutputIndex), nil
+}
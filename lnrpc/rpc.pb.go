@@ -59,6 +59,91 @@ func (AddressType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{0}
 }
 
+type ResolutionType int32
+
+const (
+	ResolutionType_TYPE_UNKNOWN ResolutionType = 0
+	/// Our output on the commitment transaction.
+	ResolutionType_COMMIT ResolutionType = 1
+	/// An incoming HTLC output.
+	ResolutionType_INCOMING_HTLC ResolutionType = 2
+	/// An outgoing HTLC output.
+	ResolutionType_OUTGOING_HTLC ResolutionType = 3
+)
+
+var ResolutionType_name = map[int32]string{
+	0: "TYPE_UNKNOWN",
+	1: "COMMIT",
+	2: "INCOMING_HTLC",
+	3: "OUTGOING_HTLC",
+}
+
+var ResolutionType_value = map[string]int32{
+	"TYPE_UNKNOWN":  0,
+	"COMMIT":        1,
+	"INCOMING_HTLC": 2,
+	"OUTGOING_HTLC": 3,
+}
+
+func (x ResolutionType) String() string {
+	return proto.EnumName(ResolutionType_name, int32(x))
+}
+
+func (ResolutionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{1}
+}
+
+type ResolutionOutcome int32
+
+const (
+	ResolutionOutcome_OUTCOME_UNKNOWN ResolutionOutcome = 0
+	//*
+	//The output was claimed. Our commitment output and incoming HTLCs are
+	//claimed by us, while outgoing HTLCs are claimed by the remote party with
+	//the preimage.
+	ResolutionOutcome_CLAIMED ResolutionOutcome = 1
+	/// An outgoing HTLC timed out and was swept back to us.
+	ResolutionOutcome_TIMEOUT ResolutionOutcome = 2
+	//*
+	//We gave up on claiming an incoming HTLC, as it expired or was canceled
+	//before we learned of the preimage.
+	ResolutionOutcome_ABANDONED ResolutionOutcome = 3
+	//*
+	//The output was swept by the remote party through the revocation clause,
+	//as the commitment transaction was revoked.
+	ResolutionOutcome_BREACHED ResolutionOutcome = 4
+	//*
+	//An HTLC below the minimum chain HTLC value of the channel's deadline
+	//policy was written off, rather than going to chain for it.
+	ResolutionOutcome_WRITTEN_OFF ResolutionOutcome = 5
+)
+
+var ResolutionOutcome_name = map[int32]string{
+	0: "OUTCOME_UNKNOWN",
+	1: "CLAIMED",
+	2: "TIMEOUT",
+	3: "ABANDONED",
+	4: "BREACHED",
+	5: "WRITTEN_OFF",
+}
+
+var ResolutionOutcome_value = map[string]int32{
+	"OUTCOME_UNKNOWN": 0,
+	"CLAIMED":         1,
+	"TIMEOUT":         2,
+	"ABANDONED":       3,
+	"BREACHED":        4,
+	"WRITTEN_OFF":     5,
+}
+
+func (x ResolutionOutcome) String() string {
+	return proto.EnumName(ResolutionOutcome_name, int32(x))
+}
+
+func (ResolutionOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{2}
+}
+
 type InvoiceHTLCState int32
 
 const (
@@ -84,7 +169,7 @@ func (x InvoiceHTLCState) String() string {
 }
 
 func (InvoiceHTLCState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{1}
+	return fileDescriptor_77a6da22d6a3feb1, []int{3}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -155,7 +240,44 @@ func (x Peer_SyncType) String() string {
 }
 
 func (Peer_SyncType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{46, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{50, 0}
+}
+
+type PendingChannelsResponse_JusticeOutput_JusticeState int32
+
+const (
+	/// The output is being swept into our wallet.
+	PendingChannelsResponse_JusticeOutput_PENDING PendingChannelsResponse_JusticeOutput_JusticeState = 0
+	//*
+	//The remote party spent the HTLC output to the second level, whose
+	//output is being swept instead.
+	PendingChannelsResponse_JusticeOutput_SECOND_LEVEL PendingChannelsResponse_JusticeOutput_JusticeState = 1
+	/// The output was swept into our wallet.
+	PendingChannelsResponse_JusticeOutput_SWEPT PendingChannelsResponse_JusticeOutput_JusticeState = 2
+	/// The remote party managed to spend the output.
+	PendingChannelsResponse_JusticeOutput_LOST PendingChannelsResponse_JusticeOutput_JusticeState = 3
+)
+
+var PendingChannelsResponse_JusticeOutput_JusticeState_name = map[int32]string{
+	0: "PENDING",
+	1: "SECOND_LEVEL",
+	2: "SWEPT",
+	3: "LOST",
+}
+
+var PendingChannelsResponse_JusticeOutput_JusticeState_value = map[string]int32{
+	"PENDING":      0,
+	"SECOND_LEVEL": 1,
+	"SWEPT":        2,
+	"LOST":         3,
+}
+
+func (x PendingChannelsResponse_JusticeOutput_JusticeState) String() string {
+	return proto.EnumName(PendingChannelsResponse_JusticeOutput_JusticeState_name, int32(x))
+}
+
+func (PendingChannelsResponse_JusticeOutput_JusticeState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{66, 6, 0}
 }
 
 type ChannelEventUpdate_UpdateType int32
@@ -186,7 +308,40 @@ func (x ChannelEventUpdate_UpdateType) String() string {
 }
 
 func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{64, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{68, 0}
+}
+
+type DrainUpdate_DrainState int32
+
+const (
+	/// The daemon is waiting for in-flight HTLCs to be resolved.
+	DrainUpdate_DRAINING DrainUpdate_DrainState = 0
+	/// All in-flight HTLCs were resolved, the daemon is shutting down.
+	DrainUpdate_DRAINED DrainUpdate_DrainState = 1
+	//*
+	//The timeout expired before all in-flight HTLCs were resolved, the
+	//daemon is shutting down regardless.
+	DrainUpdate_TIMED_OUT DrainUpdate_DrainState = 2
+)
+
+var DrainUpdate_DrainState_name = map[int32]string{
+	0: "DRAINING",
+	1: "DRAINED",
+	2: "TIMED_OUT",
+}
+
+var DrainUpdate_DrainState_value = map[string]int32{
+	"DRAINING":  0,
+	"DRAINED":   1,
+	"TIMED_OUT": 2,
+}
+
+func (x DrainUpdate_DrainState) String() string {
+	return proto.EnumName(DrainUpdate_DrainState_name, int32(x))
+}
+
+func (DrainUpdate_DrainState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{93, 0}
 }
 
 type Invoice_InvoiceState int32
@@ -217,7 +372,7 @@ func (x Invoice_InvoiceState) String() string {
 }
 
 func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{95, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{101, 0}
 }
 
 type Payment_PaymentStatus int32
@@ -248,7 +403,67 @@ func (x Payment_PaymentStatus) String() string {
 }
 
 func (Payment_PaymentStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{102, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{108, 0}
+}
+
+type FeeStrategy_CurveType int32
+
+const (
+	//*
+	//The fee rate scales linearly from max_fee_per_mil for a channel without
+	//any local balance to min_fee_per_mil for a channel whose entire capacity
+	//is local.
+	FeeStrategy_PROPORTIONAL FeeStrategy_CurveType = 0
+	//*
+	//The fee rate is taken from the first step whose threshold is at or
+	//above the channel's local balance percentage.
+	FeeStrategy_STEP FeeStrategy_CurveType = 1
+)
+
+var FeeStrategy_CurveType_name = map[int32]string{
+	0: "PROPORTIONAL",
+	1: "STEP",
+}
+
+var FeeStrategy_CurveType_value = map[string]int32{
+	"PROPORTIONAL": 0,
+	"STEP":         1,
+}
+
+func (x FeeStrategy_CurveType) String() string {
+	return proto.EnumName(FeeStrategy_CurveType_name, int32(x))
+}
+
+func (FeeStrategy_CurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{126, 0}
+}
+
+type StuckHtlcEvent_Stage int32
+
+const (
+	StuckHtlcEvent_RECONNECT   StuckHtlcEvent_Stage = 0
+	StuckHtlcEvent_RECOVERED   StuckHtlcEvent_Stage = 1
+	StuckHtlcEvent_FORCE_CLOSE StuckHtlcEvent_Stage = 2
+)
+
+var StuckHtlcEvent_Stage_name = map[int32]string{
+	0: "RECONNECT",
+	1: "RECOVERED",
+	2: "FORCE_CLOSE",
+}
+
+var StuckHtlcEvent_Stage_value = map[string]int32{
+	"RECONNECT":   0,
+	"RECOVERED":   1,
+	"FORCE_CLOSE": 2,
+}
+
+func (x StuckHtlcEvent_Stage) String() string {
+	return proto.EnumName(StuckHtlcEvent_Stage_name, int32(x))
+}
+
+func (StuckHtlcEvent_Stage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{149, 0}
 }
 
 type GenSeedRequest struct {
@@ -776,7 +991,9 @@ type Transaction struct {
 	/// Addresses that received funds for this transaction
 	DestAddresses []string `protobuf:"bytes,8,rep,name=dest_addresses,proto3" json:"dest_addresses,omitempty"`
 	/// The raw transaction hex.
-	RawTxHex             string   `protobuf:"bytes,9,opt,name=raw_tx_hex,proto3" json:"raw_tx_hex,omitempty"`
+	RawTxHex string `protobuf:"bytes,9,opt,name=raw_tx_hex,proto3" json:"raw_tx_hex,omitempty"`
+	/// A label that was optionally set on transaction broadcast.
+	Label                string   `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -870,7 +1087,17 @@ func (m *Transaction) GetRawTxHex() string {
 	return ""
 }
 
+func (m *Transaction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type GetTransactionsRequest struct {
+	//
+	//An optional filter to only include transactions relevant to an account.
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -901,6 +1128,13 @@ func (m *GetTransactionsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetTransactionsRequest proto.InternalMessageInfo
 
+func (m *GetTransactionsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 type TransactionDetails struct {
 	/// The list of transactions relevant to the wallet.
 	Transactions         []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
@@ -1788,7 +2022,14 @@ type SendManyRequest struct {
 	/// The target number of blocks that this transaction should be confirmed by.
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
 	/// A manual fee rate set in sat/byte that should be used when crafting the transaction.
-	SatPerByte           int64    `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	//*
+	//An optional list of wallet outpoints to spend. If set, the transaction will
+	//spend exactly these outputs, returning any remaining value to a change
+	//address, instead of performing automatic coin selection.
+	Outpoints []*OutPoint `protobuf:"bytes,6,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	/// An optional label for the transaction, limited to 500 characters.
+	Label                string   `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1840,6 +2081,20 @@ func (m *SendManyRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *SendManyRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+func (m *SendManyRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type SendManyResponse struct {
 	/// The id of the transaction
 	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
@@ -1893,7 +2148,15 @@ type SendCoinsRequest struct {
 	//If set, then the amount field will be ignored, and lnd will attempt to
 	//send all the coins under control of the internal wallet to the specified
 	//address.
-	SendAll              bool     `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	SendAll bool `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	//*
+	//An optional list of wallet outpoints to spend. If set, the transaction will
+	//spend exactly these outputs, returning any remaining value to a change
+	//address, instead of performing automatic coin selection. If send_all is
+	//also set, all funds of these outputs are sent to the specified address.
+	Outpoints []*OutPoint `protobuf:"bytes,7,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	/// An optional label for the transaction, limited to 500 characters.
+	Label                string   `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1959,6 +2222,20 @@ func (m *SendCoinsRequest) GetSendAll() bool {
 	return false
 }
 
+func (m *SendCoinsRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+func (m *SendCoinsRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type SendCoinsResponse struct {
 	/// The transaction ID of the transaction
 	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
@@ -2003,7 +2280,9 @@ type ListUnspentRequest struct {
 	/// The minimum number of confirmations to be included.
 	MinConfs int32 `protobuf:"varint,1,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
 	/// The maximum number of confirmations to be included.
-	MaxConfs             int32    `protobuf:"varint,2,opt,name=max_confs,json=maxConfs,proto3" json:"max_confs,omitempty"`
+	MaxConfs int32 `protobuf:"varint,2,opt,name=max_confs,json=maxConfs,proto3" json:"max_confs,omitempty"`
+	/// An optional filter to only include outputs belonging to an account.
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2048,6 +2327,13 @@ func (m *ListUnspentRequest) GetMaxConfs() int32 {
 	return 0
 }
 
+func (m *ListUnspentRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 type ListUnspentResponse struct {
 	/// A list of utxos
 	Utxos                []*Utxo  `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
@@ -2090,10 +2376,14 @@ func (m *ListUnspentResponse) GetUtxos() []*Utxo {
 
 type NewAddressRequest struct {
 	/// The address type
-	Type                 AddressType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.AddressType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Type AddressType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.AddressType" json:"type,omitempty"`
+	//
+	//The name of the account to generate a new address for. If empty, the
+	//default wallet account is used.
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *NewAddressRequest) Reset()         { *m = NewAddressRequest{} }
@@ -2128,6 +2418,13 @@ func (m *NewAddressRequest) GetType() AddressType {
 	return AddressType_WITNESS_PUBKEY_HASH
 }
 
+func (m *NewAddressRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 type NewAddressResponse struct {
 	/// The newly generated wallet address
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -2937,10 +3234,14 @@ type ChannelCloseSummary struct {
 	/// The sum of all the time-locked outputs at the time of channel closure
 	TimeLockedBalance int64 `protobuf:"varint,9,opt,name=time_locked_balance,proto3" json:"time_locked_balance,omitempty"`
 	/// Details on how the channel was closed.
-	CloseType            ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,proto3,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+	CloseType ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,proto3,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
+	//*
+	//The resolutions of the outputs of the commitment transaction of a force
+	//closed channel, accounting for all funds that were at stake.
+	Resolutions          []*Resolution `protobuf:"bytes,11,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ChannelCloseSummary) Reset()         { *m = ChannelCloseSummary{} }
@@ -3038,6 +3339,111 @@ func (m *ChannelCloseSummary) GetCloseType() ChannelCloseSummary_ClosureType {
 	return ChannelCloseSummary_COOPERATIVE_CLOSE
 }
 
+func (m *ChannelCloseSummary) GetResolutions() []*Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
+}
+
+type Resolution struct {
+	/// The type of output that was resolved.
+	ResolutionType ResolutionType `protobuf:"varint,1,opt,name=resolution_type,proto3,enum=lnrpc.ResolutionType" json:"resolution_type,omitempty"`
+	/// How the output was resolved.
+	Outcome ResolutionOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=lnrpc.ResolutionOutcome" json:"outcome,omitempty"`
+	/// The outpoint of the output on the commitment transaction.
+	Outpoint *OutPoint `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	/// The value of the output in satoshis.
+	AmountSat uint64 `protobuf:"varint,4,opt,name=amount_sat,proto3" json:"amount_sat,omitempty"`
+	//*
+	//The txid of the transaction that finally resolved the output, if it was
+	//spent.
+	SweepTxid string `protobuf:"bytes,5,opt,name=sweep_txid,proto3" json:"sweep_txid,omitempty"`
+	//*
+	//The payment hash of a written off HTLC. Such an HTLC never made it to
+	//chain, so no outpoint is set.
+	PaymentHash []byte `protobuf:"bytes,6,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	/// The index of a written off HTLC within the channel.
+	HtlcIndex            uint64   `protobuf:"varint,7,opt,name=htlc_index,proto3" json:"htlc_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Resolution) Reset()         { *m = Resolution{} }
+func (m *Resolution) String() string { return proto.CompactTextString(m) }
+func (*Resolution) ProtoMessage()    {}
+func (*Resolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{44}
+}
+
+func (m *Resolution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resolution.Unmarshal(m, b)
+}
+func (m *Resolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resolution.Marshal(b, m, deterministic)
+}
+func (m *Resolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resolution.Merge(m, src)
+}
+func (m *Resolution) XXX_Size() int {
+	return xxx_messageInfo_Resolution.Size(m)
+}
+func (m *Resolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resolution proto.InternalMessageInfo
+
+func (m *Resolution) GetResolutionType() ResolutionType {
+	if m != nil {
+		return m.ResolutionType
+	}
+	return ResolutionType_TYPE_UNKNOWN
+}
+
+func (m *Resolution) GetOutcome() ResolutionOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return ResolutionOutcome_OUTCOME_UNKNOWN
+}
+
+func (m *Resolution) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *Resolution) GetAmountSat() uint64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *Resolution) GetSweepTxid() string {
+	if m != nil {
+		return m.SweepTxid
+	}
+	return ""
+}
+
+func (m *Resolution) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *Resolution) GetHtlcIndex() uint64 {
+	if m != nil {
+		return m.HtlcIndex
+	}
+	return 0
+}
+
 type ClosedChannelsRequest struct {
 	Cooperative          bool     `protobuf:"varint,1,opt,name=cooperative,proto3" json:"cooperative,omitempty"`
 	LocalForce           bool     `protobuf:"varint,2,opt,name=local_force,json=localForce,proto3" json:"local_force,omitempty"`
@@ -3054,7 +3460,7 @@ func (m *ClosedChannelsRequest) Reset()         { *m = ClosedChannelsRequest{} }
 func (m *ClosedChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsRequest) ProtoMessage()    {}
 func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{44}
+	return fileDescriptor_77a6da22d6a3feb1, []int{45}
 }
 
 func (m *ClosedChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -3128,7 +3534,7 @@ func (m *ClosedChannelsResponse) Reset()         { *m = ClosedChannelsResponse{}
 func (m *ClosedChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsResponse) ProtoMessage()    {}
 func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{45}
+	return fileDescriptor_77a6da22d6a3feb1, []int{46}
 }
 
 func (m *ClosedChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -3156,63 +3562,223 @@ func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	return nil
 }
 
-type Peer struct {
-	/// The identity pubkey of the peer
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	/// Network address of the peer; eg `127.0.0.1:10011`
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	/// Bytes of data transmitted to this peer
-	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent,proto3" json:"bytes_sent,omitempty"`
-	/// Bytes of data transmitted from this peer
-	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv,proto3" json:"bytes_recv,omitempty"`
-	/// Satoshis sent to this peer
-	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent,proto3" json:"sat_sent,omitempty"`
-	/// Satoshis received from this peer
-	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv,proto3" json:"sat_recv,omitempty"`
-	/// A channel is inbound if the counterparty initiated the channel
-	Inbound bool `protobuf:"varint,8,opt,name=inbound,proto3" json:"inbound,omitempty"`
-	/// Ping time to this peer
-	PingTime int64 `protobuf:"varint,9,opt,name=ping_time,proto3" json:"ping_time,omitempty"`
-	// The type of sync we are currently performing with this peer.
-	SyncType             Peer_SyncType `protobuf:"varint,10,opt,name=sync_type,proto3,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type PrunableChannelsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Peer) Reset()         { *m = Peer{} }
-func (m *Peer) String() string { return proto.CompactTextString(m) }
-func (*Peer) ProtoMessage()    {}
-func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{46}
+func (m *PrunableChannelsRequest) Reset()         { *m = PrunableChannelsRequest{} }
+func (m *PrunableChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*PrunableChannelsRequest) ProtoMessage()    {}
+func (*PrunableChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{47}
 }
 
-func (m *Peer) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Peer.Unmarshal(m, b)
+func (m *PrunableChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrunableChannelsRequest.Unmarshal(m, b)
 }
-func (m *Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Peer.Marshal(b, m, deterministic)
+func (m *PrunableChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrunableChannelsRequest.Marshal(b, m, deterministic)
 }
-func (m *Peer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Peer.Merge(m, src)
+func (m *PrunableChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunableChannelsRequest.Merge(m, src)
 }
-func (m *Peer) XXX_Size() int {
-	return xxx_messageInfo_Peer.Size(m)
+func (m *PrunableChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_PrunableChannelsRequest.Size(m)
 }
-func (m *Peer) XXX_DiscardUnknown() {
-	xxx_messageInfo_Peer.DiscardUnknown(m)
+func (m *PrunableChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunableChannelsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Peer proto.InternalMessageInfo
+var xxx_messageInfo_PrunableChannelsRequest proto.InternalMessageInfo
 
-func (m *Peer) GetPubKey() string {
-	if m != nil {
-		return m.PubKey
-	}
-	return ""
+type PrunableChannel struct {
+	/// The outpoint (txid:index) of the funding transaction.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	/// The height at which the channel was closed.
+	CloseHeight uint32 `protobuf:"varint,2,opt,name=close_height,proto3" json:"close_height,omitempty"`
+	//*
+	//The height from which on the state of the channel is pruned. Zero if
+	//pruning is disabled, which is the default.
+	PruneHeight uint32 `protobuf:"varint,3,opt,name=prune_height,proto3" json:"prune_height,omitempty"`
+	/// The number of bytes pruning the state of the channel reclaims.
+	SizeBytes            uint64   `protobuf:"varint,4,opt,name=size_bytes,proto3" json:"size_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Peer) GetAddress() string {
+func (m *PrunableChannel) Reset()         { *m = PrunableChannel{} }
+func (m *PrunableChannel) String() string { return proto.CompactTextString(m) }
+func (*PrunableChannel) ProtoMessage()    {}
+func (*PrunableChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+}
+
+func (m *PrunableChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrunableChannel.Unmarshal(m, b)
+}
+func (m *PrunableChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrunableChannel.Marshal(b, m, deterministic)
+}
+func (m *PrunableChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunableChannel.Merge(m, src)
+}
+func (m *PrunableChannel) XXX_Size() int {
+	return xxx_messageInfo_PrunableChannel.Size(m)
+}
+func (m *PrunableChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunableChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrunableChannel proto.InternalMessageInfo
+
+func (m *PrunableChannel) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *PrunableChannel) GetCloseHeight() uint32 {
+	if m != nil {
+		return m.CloseHeight
+	}
+	return 0
+}
+
+func (m *PrunableChannel) GetPruneHeight() uint32 {
+	if m != nil {
+		return m.PruneHeight
+	}
+	return 0
+}
+
+func (m *PrunableChannel) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+type PrunableChannelsResponse struct {
+	/// The channels of which state can be pruned.
+	Channels []*PrunableChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	/// The number of bytes pruning the state of all channels reclaims.
+	TotalBytes uint64 `protobuf:"varint,2,opt,name=total_bytes,proto3" json:"total_bytes,omitempty"`
+	//*
+	//The number of bytes that will be reclaimed by the next prune, as the
+	//channels are past their prune height.
+	ReclaimableBytes     uint64   `protobuf:"varint,3,opt,name=reclaimable_bytes,proto3" json:"reclaimable_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrunableChannelsResponse) Reset()         { *m = PrunableChannelsResponse{} }
+func (m *PrunableChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*PrunableChannelsResponse) ProtoMessage()    {}
+func (*PrunableChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{49}
+}
+
+func (m *PrunableChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrunableChannelsResponse.Unmarshal(m, b)
+}
+func (m *PrunableChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrunableChannelsResponse.Marshal(b, m, deterministic)
+}
+func (m *PrunableChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunableChannelsResponse.Merge(m, src)
+}
+func (m *PrunableChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_PrunableChannelsResponse.Size(m)
+}
+func (m *PrunableChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunableChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrunableChannelsResponse proto.InternalMessageInfo
+
+func (m *PrunableChannelsResponse) GetChannels() []*PrunableChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *PrunableChannelsResponse) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *PrunableChannelsResponse) GetReclaimableBytes() uint64 {
+	if m != nil {
+		return m.ReclaimableBytes
+	}
+	return 0
+}
+
+type Peer struct {
+	/// The identity pubkey of the peer
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	/// Network address of the peer; eg `127.0.0.1:10011`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	/// Bytes of data transmitted to this peer
+	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent,proto3" json:"bytes_sent,omitempty"`
+	/// Bytes of data transmitted from this peer
+	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv,proto3" json:"bytes_recv,omitempty"`
+	/// Satoshis sent to this peer
+	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent,proto3" json:"sat_sent,omitempty"`
+	/// Satoshis received from this peer
+	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv,proto3" json:"sat_recv,omitempty"`
+	/// A channel is inbound if the counterparty initiated the channel
+	Inbound bool `protobuf:"varint,8,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	/// Ping time to this peer
+	PingTime int64 `protobuf:"varint,9,opt,name=ping_time,proto3" json:"ping_time,omitempty"`
+	// The type of sync we are currently performing with this peer.
+	SyncType             Peer_SyncType `protobuf:"varint,10,opt,name=sync_type,proto3,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Peer) Reset()         { *m = Peer{} }
+func (m *Peer) String() string { return proto.CompactTextString(m) }
+func (*Peer) ProtoMessage()    {}
+func (*Peer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{50}
+}
+
+func (m *Peer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Peer.Unmarshal(m, b)
+}
+func (m *Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Peer.Marshal(b, m, deterministic)
+}
+func (m *Peer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Peer.Merge(m, src)
+}
+func (m *Peer) XXX_Size() int {
+	return xxx_messageInfo_Peer.Size(m)
+}
+func (m *Peer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Peer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Peer proto.InternalMessageInfo
+
+func (m *Peer) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *Peer) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
@@ -3278,7 +3844,7 @@ func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
 func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPeersRequest) ProtoMessage()    {}
 func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{47}
+	return fileDescriptor_77a6da22d6a3feb1, []int{51}
 }
 
 func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
@@ -3311,7 +3877,7 @@ func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
 func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPeersResponse) ProtoMessage()    {}
 func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+	return fileDescriptor_77a6da22d6a3feb1, []int{52}
 }
 
 func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
@@ -3349,7 +3915,7 @@ func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
 func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInfoRequest) ProtoMessage()    {}
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{49}
+	return fileDescriptor_77a6da22d6a3feb1, []int{53}
 }
 
 func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3414,7 +3980,7 @@ func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
 func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInfoResponse) ProtoMessage()    {}
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{50}
+	return fileDescriptor_77a6da22d6a3feb1, []int{54}
 }
 
 func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3562,7 +4128,7 @@ func (m *Chain) Reset()         { *m = Chain{} }
 func (m *Chain) String() string { return proto.CompactTextString(m) }
 func (*Chain) ProtoMessage()    {}
 func (*Chain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{51}
+	return fileDescriptor_77a6da22d6a3feb1, []int{55}
 }
 
 func (m *Chain) XXX_Unmarshal(b []byte) error {
@@ -3610,7 +4176,7 @@ func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
 func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationUpdate) ProtoMessage()    {}
 func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{52}
+	return fileDescriptor_77a6da22d6a3feb1, []int{56}
 }
 
 func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
@@ -3663,7 +4229,7 @@ func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
 func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelOpenUpdate) ProtoMessage()    {}
 func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{53}
+	return fileDescriptor_77a6da22d6a3feb1, []int{57}
 }
 
 func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
@@ -3703,7 +4269,7 @@ func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
 func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseUpdate) ProtoMessage()    {}
 func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{54}
+	return fileDescriptor_77a6da22d6a3feb1, []int{58}
 }
 
 func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
@@ -3759,7 +4325,7 @@ func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
 func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseChannelRequest) ProtoMessage()    {}
 func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{55}
+	return fileDescriptor_77a6da22d6a3feb1, []int{59}
 }
 
 func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -3822,7 +4388,7 @@ func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()    {}
 func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{56}
+	return fileDescriptor_77a6da22d6a3feb1, []int{60}
 }
 
 func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
@@ -3900,7 +4466,7 @@ func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()    {}
 func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{57}
+	return fileDescriptor_77a6da22d6a3feb1, []int{61}
 }
 
 func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
@@ -3967,7 +4533,7 @@ func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()    {}
 func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{58}
+	return fileDescriptor_77a6da22d6a3feb1, []int{62}
 }
 
 func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -4079,7 +4645,7 @@ func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
 func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*OpenStatusUpdate) ProtoMessage()    {}
 func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{59}
+	return fileDescriptor_77a6da22d6a3feb1, []int{63}
 }
 
 func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
@@ -4170,7 +4736,7 @@ func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
 func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
 func (*PendingHTLC) ProtoMessage()    {}
 func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{60}
+	return fileDescriptor_77a6da22d6a3feb1, []int{64}
 }
 
 func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
@@ -4243,7 +4809,7 @@ func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{}
 func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsRequest) ProtoMessage()    {}
 func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{61}
+	return fileDescriptor_77a6da22d6a3feb1, []int{65}
 }
 
 func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -4275,16 +4841,18 @@ type PendingChannelsResponse struct {
 	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,proto3" json:"pending_force_closing_channels,omitempty"`
 	/// Channels waiting for closing tx to confirm
 	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,proto3" json:"waiting_close_channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+	/// Channels whose revoked state was broadcast by the remote party
+	PendingBreachedChannels []*PendingChannelsResponse_BreachedChannel `protobuf:"bytes,6,rep,name=pending_breached_channels,proto3" json:"pending_breached_channels,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                                   `json:"-"`
+	XXX_unrecognized        []byte                                     `json:"-"`
+	XXX_sizecache           int32                                      `json:"-"`
 }
 
 func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse{} }
 func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse) ProtoMessage()    {}
 func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66}
 }
 
 func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -4340,6 +4908,13 @@ func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsRe
 	return nil
 }
 
+func (m *PendingChannelsResponse) GetPendingBreachedChannels() []*PendingChannelsResponse_BreachedChannel {
+	if m != nil {
+		return m.PendingBreachedChannels
+	}
+	return nil
+}
+
 type PendingChannelsResponse_PendingChannel struct {
 	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
 	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
@@ -4363,7 +4938,7 @@ func (m *PendingChannelsResponse_PendingChannel) Reset() {
 func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66, 0}
 }
 
 func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
@@ -4465,7 +5040,7 @@ func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
 }
 func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62, 1}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66, 1}
 }
 
 func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
@@ -4539,7 +5114,7 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
 }
 func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62, 2}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66, 2}
 }
 
 func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
@@ -4588,7 +5163,7 @@ func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingCh
 func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62, 3}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66, 3}
 }
 
 func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
@@ -4638,11 +5213,13 @@ type PendingChannelsResponse_ForceClosedChannel struct {
 	//mature.
 	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
 	/// The total value of funds successfully recovered from this channel
-	RecoveredBalance     int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
-	PendingHtlcs         []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	RecoveredBalance int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
+	PendingHtlcs     []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
+	/// The resolutions of the outputs that have been resolved so far.
+	Resolutions          []*Resolution `protobuf:"bytes,9,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
@@ -4653,7 +5230,7 @@ func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
 }
 func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62, 4}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66, 4}
 }
 
 func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
@@ -4723,85 +5300,268 @@ func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*Pendin
 	return nil
 }
 
-type ChannelEventSubscription struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PendingChannelsResponse_ForceClosedChannel) GetResolutions() []*Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
 }
 
-func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscription{} }
-func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
-func (*ChannelEventSubscription) ProtoMessage()    {}
-func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{63}
+type PendingChannelsResponse_BreachedChannel struct {
+	/// The pending channel whose revoked state was broadcast
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	/// The transaction id of the revoked commitment transaction
+	BreachTxid string `protobuf:"bytes,2,opt,name=breach_txid,proto3" json:"breach_txid,omitempty"`
+	//*
+	//The height at which the revoked commitment transaction confirmed, zero
+	//if it is still unconfirmed.
+	BreachHeight uint32 `protobuf:"varint,3,opt,name=breach_height,proto3" json:"breach_height,omitempty"`
+	/// The balance in satoshis that is still to be claimed
+	LimboBalance int64 `protobuf:"varint,4,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
+	/// The total value of funds successfully recovered from this channel
+	RecoveredBalance int64 `protobuf:"varint,5,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
+	/// The revoked outputs that are claimed through justice transactions
+	JusticeOutputs       []*PendingChannelsResponse_JusticeOutput `protobuf:"bytes,6,rep,name=justice_outputs,proto3" json:"justice_outputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
 }
 
-func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
+func (m *PendingChannelsResponse_BreachedChannel) Reset() {
+	*m = PendingChannelsResponse_BreachedChannel{}
 }
-func (m *ChannelEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEventSubscription.Marshal(b, m, deterministic)
+func (m *PendingChannelsResponse_BreachedChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_BreachedChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_BreachedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{66, 5}
 }
-func (m *ChannelEventSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEventSubscription.Merge(m, src)
+
+func (m *PendingChannelsResponse_BreachedChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_BreachedChannel.Unmarshal(m, b)
 }
-func (m *ChannelEventSubscription) XXX_Size() int {
-	return xxx_messageInfo_ChannelEventSubscription.Size(m)
+func (m *PendingChannelsResponse_BreachedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_BreachedChannel.Marshal(b, m, deterministic)
 }
-func (m *ChannelEventSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEventSubscription.DiscardUnknown(m)
+func (m *PendingChannelsResponse_BreachedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_BreachedChannel.Merge(m, src)
+}
+func (m *PendingChannelsResponse_BreachedChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_BreachedChannel.Size(m)
+}
+func (m *PendingChannelsResponse_BreachedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_BreachedChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelEventSubscription proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsResponse_BreachedChannel proto.InternalMessageInfo
 
-type ChannelEventUpdate struct {
-	// Types that are valid to be assigned to Channel:
-	//	*ChannelEventUpdate_OpenChannel
-	//	*ChannelEventUpdate_ClosedChannel
-	//	*ChannelEventUpdate_ActiveChannel
-	//	*ChannelEventUpdate_InactiveChannel
-	Channel              isChannelEventUpdate_Channel  `protobuf_oneof:"channel"`
-	Type                 ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+func (m *PendingChannelsResponse_BreachedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
 }
 
-func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
-func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelEventUpdate) ProtoMessage()    {}
-func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{64}
+func (m *PendingChannelsResponse_BreachedChannel) GetBreachTxid() string {
+	if m != nil {
+		return m.BreachTxid
+	}
+	return ""
 }
 
-func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEventUpdate.Unmarshal(m, b)
-}
-func (m *ChannelEventUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEventUpdate.Marshal(b, m, deterministic)
-}
-func (m *ChannelEventUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEventUpdate.Merge(m, src)
-}
-func (m *ChannelEventUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelEventUpdate.Size(m)
+func (m *PendingChannelsResponse_BreachedChannel) GetBreachHeight() uint32 {
+	if m != nil {
+		return m.BreachHeight
+	}
+	return 0
 }
-func (m *ChannelEventUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEventUpdate.DiscardUnknown(m)
+
+func (m *PendingChannelsResponse_BreachedChannel) GetLimboBalance() int64 {
+	if m != nil {
+		return m.LimboBalance
+	}
+	return 0
 }
 
-var xxx_messageInfo_ChannelEventUpdate proto.InternalMessageInfo
+func (m *PendingChannelsResponse_BreachedChannel) GetRecoveredBalance() int64 {
+	if m != nil {
+		return m.RecoveredBalance
+	}
+	return 0
+}
 
-type isChannelEventUpdate_Channel interface {
-	isChannelEventUpdate_Channel()
+func (m *PendingChannelsResponse_BreachedChannel) GetJusticeOutputs() []*PendingChannelsResponse_JusticeOutput {
+	if m != nil {
+		return m.JusticeOutputs
+	}
+	return nil
 }
 
-type ChannelEventUpdate_OpenChannel struct {
-	OpenChannel *Channel `protobuf:"bytes,1,opt,name=open_channel,proto3,oneof"`
+type PendingChannelsResponse_JusticeOutput struct {
+	/// The type of the revoked output.
+	ResolutionType ResolutionType `protobuf:"varint,1,opt,name=resolution_type,proto3,enum=lnrpc.ResolutionType" json:"resolution_type,omitempty"`
+	/// The state of the justice sweep of the output.
+	State PendingChannelsResponse_JusticeOutput_JusticeState `protobuf:"varint,2,opt,name=state,proto3,enum=lnrpc.PendingChannelsResponse_JusticeOutput_JusticeState" json:"state,omitempty"`
+	/// The outpoint that is being swept.
+	Outpoint *OutPoint `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	/// The value of the output in satoshis.
+	AmountSat uint64 `protobuf:"varint,4,opt,name=amount_sat,proto3" json:"amount_sat,omitempty"`
+	//*
+	//The height by which the sweep should confirm, before the remote party
+	//is able to spend the output as well. Zero if unknown.
+	DeadlineHeight uint32 `protobuf:"varint,5,opt,name=deadline_height,proto3" json:"deadline_height,omitempty"`
+	/// The txid of the transaction that spent the output, if any.
+	SweepTxid            string   `protobuf:"bytes,6,opt,name=sweep_txid,proto3" json:"sweep_txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type ChannelEventUpdate_ClosedChannel struct {
-	ClosedChannel *ChannelCloseSummary `protobuf:"bytes,2,opt,name=closed_channel,proto3,oneof"`
+func (m *PendingChannelsResponse_JusticeOutput) Reset()         { *m = PendingChannelsResponse_JusticeOutput{} }
+func (m *PendingChannelsResponse_JusticeOutput) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_JusticeOutput) ProtoMessage()    {}
+func (*PendingChannelsResponse_JusticeOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{66, 6}
+}
+
+func (m *PendingChannelsResponse_JusticeOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_JusticeOutput.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_JusticeOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_JusticeOutput.Marshal(b, m, deterministic)
+}
+func (m *PendingChannelsResponse_JusticeOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_JusticeOutput.Merge(m, src)
+}
+func (m *PendingChannelsResponse_JusticeOutput) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_JusticeOutput.Size(m)
+}
+func (m *PendingChannelsResponse_JusticeOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_JusticeOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_JusticeOutput proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_JusticeOutput) GetResolutionType() ResolutionType {
+	if m != nil {
+		return m.ResolutionType
+	}
+	return ResolutionType_TYPE_UNKNOWN
+}
+
+func (m *PendingChannelsResponse_JusticeOutput) GetState() PendingChannelsResponse_JusticeOutput_JusticeState {
+	if m != nil {
+		return m.State
+	}
+	return PendingChannelsResponse_JusticeOutput_PENDING
+}
+
+func (m *PendingChannelsResponse_JusticeOutput) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse_JusticeOutput) GetAmountSat() uint64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_JusticeOutput) GetDeadlineHeight() uint32 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_JusticeOutput) GetSweepTxid() string {
+	if m != nil {
+		return m.SweepTxid
+	}
+	return ""
+}
+
+type ChannelEventSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscription{} }
+func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*ChannelEventSubscription) ProtoMessage()    {}
+func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{67}
+}
+
+func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
+}
+func (m *ChannelEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEventSubscription.Marshal(b, m, deterministic)
+}
+func (m *ChannelEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEventSubscription.Merge(m, src)
+}
+func (m *ChannelEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_ChannelEventSubscription.Size(m)
+}
+func (m *ChannelEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEventSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEventSubscription proto.InternalMessageInfo
+
+type ChannelEventUpdate struct {
+	// Types that are valid to be assigned to Channel:
+	//	*ChannelEventUpdate_OpenChannel
+	//	*ChannelEventUpdate_ClosedChannel
+	//	*ChannelEventUpdate_ActiveChannel
+	//	*ChannelEventUpdate_InactiveChannel
+	Channel              isChannelEventUpdate_Channel  `protobuf_oneof:"channel"`
+	Type                 ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
+func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelEventUpdate) ProtoMessage()    {}
+func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{68}
+}
+
+func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEventUpdate.Unmarshal(m, b)
+}
+func (m *ChannelEventUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEventUpdate.Marshal(b, m, deterministic)
+}
+func (m *ChannelEventUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEventUpdate.Merge(m, src)
+}
+func (m *ChannelEventUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelEventUpdate.Size(m)
+}
+func (m *ChannelEventUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEventUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEventUpdate proto.InternalMessageInfo
+
+type isChannelEventUpdate_Channel interface {
+	isChannelEventUpdate_Channel()
+}
+
+type ChannelEventUpdate_OpenChannel struct {
+	OpenChannel *Channel `protobuf:"bytes,1,opt,name=open_channel,proto3,oneof"`
+}
+
+type ChannelEventUpdate_ClosedChannel struct {
+	ClosedChannel *ChannelCloseSummary `protobuf:"bytes,2,opt,name=closed_channel,proto3,oneof"`
 }
 
 type ChannelEventUpdate_ActiveChannel struct {
@@ -4873,6 +5633,10 @@ func (*ChannelEventUpdate) XXX_OneofWrappers() []interface{} {
 }
 
 type WalletBalanceRequest struct {
+	//
+	//An optional filter to only include the balance of an account. If empty,
+	//the balance of all accounts is returned.
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4882,7 +5646,7 @@ func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
 func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceRequest) ProtoMessage()    {}
 func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{65}
+	return fileDescriptor_77a6da22d6a3feb1, []int{69}
 }
 
 func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -4903,6 +5667,13 @@ func (m *WalletBalanceRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WalletBalanceRequest proto.InternalMessageInfo
 
+func (m *WalletBalanceRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 type WalletBalanceResponse struct {
 	/// The balance of the wallet
 	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance,proto3" json:"total_balance,omitempty"`
@@ -4919,7 +5690,7 @@ func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
 func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceResponse) ProtoMessage()    {}
 func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{66}
+	return fileDescriptor_77a6da22d6a3feb1, []int{70}
 }
 
 func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -4971,7 +5742,7 @@ func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
 func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceRequest) ProtoMessage()    {}
 func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{67}
+	return fileDescriptor_77a6da22d6a3feb1, []int{71}
 }
 
 func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -5006,7 +5777,7 @@ func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{}
 func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceResponse) ProtoMessage()    {}
 func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{68}
+	return fileDescriptor_77a6da22d6a3feb1, []int{72}
 }
 
 func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -5085,7 +5856,7 @@ func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
 func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesRequest) ProtoMessage()    {}
 func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{69}
+	return fileDescriptor_77a6da22d6a3feb1, []int{73}
 }
 
 func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
@@ -5191,7 +5962,7 @@ func (m *NodePair) Reset()         { *m = NodePair{} }
 func (m *NodePair) String() string { return proto.CompactTextString(m) }
 func (*NodePair) ProtoMessage()    {}
 func (*NodePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{70}
+	return fileDescriptor_77a6da22d6a3feb1, []int{74}
 }
 
 func (m *NodePair) XXX_Unmarshal(b []byte) error {
@@ -5244,7 +6015,7 @@ func (m *EdgeLocator) Reset()         { *m = EdgeLocator{} }
 func (m *EdgeLocator) String() string { return proto.CompactTextString(m) }
 func (*EdgeLocator) ProtoMessage()    {}
 func (*EdgeLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71}
+	return fileDescriptor_77a6da22d6a3feb1, []int{75}
 }
 
 func (m *EdgeLocator) XXX_Unmarshal(b []byte) error {
@@ -5297,7 +6068,7 @@ func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
 func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesResponse) ProtoMessage()    {}
 func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72}
+	return fileDescriptor_77a6da22d6a3feb1, []int{76}
 }
 
 func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
@@ -5361,7 +6132,7 @@ func (m *Hop) Reset()         { *m = Hop{} }
 func (m *Hop) String() string { return proto.CompactTextString(m) }
 func (*Hop) ProtoMessage()    {}
 func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{73}
+	return fileDescriptor_77a6da22d6a3feb1, []int{77}
 }
 
 func (m *Hop) XXX_Unmarshal(b []byte) error {
@@ -5490,7 +6261,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{74}
+	return fileDescriptor_77a6da22d6a3feb1, []int{78}
 }
 
 func (m *Route) XXX_Unmarshal(b []byte) error {
@@ -5569,7 +6340,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{75}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79}
 }
 
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -5626,7 +6397,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{76}
+	return fileDescriptor_77a6da22d6a3feb1, []int{80}
 }
 
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
@@ -5695,7 +6466,7 @@ func (m *LightningNode) Reset()         { *m = LightningNode{} }
 func (m *LightningNode) String() string { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()    {}
 func (*LightningNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{77}
+	return fileDescriptor_77a6da22d6a3feb1, []int{81}
 }
 
 func (m *LightningNode) XXX_Unmarshal(b []byte) error {
@@ -5763,7 +6534,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{78}
+	return fileDescriptor_77a6da22d6a3feb1, []int{82}
 }
 
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
@@ -5815,7 +6586,7 @@ func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()    {}
 func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{79}
+	return fileDescriptor_77a6da22d6a3feb1, []int{83}
 }
 
 func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
@@ -5913,7 +6684,7 @@ func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()    {}
 func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+	return fileDescriptor_77a6da22d6a3feb1, []int{84}
 }
 
 func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
@@ -6006,7 +6777,7 @@ func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()    {}
 func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{81}
+	return fileDescriptor_77a6da22d6a3feb1, []int{85}
 }
 
 func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
@@ -6049,7 +6820,7 @@ func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()    {}
 func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{82}
+	return fileDescriptor_77a6da22d6a3feb1, []int{86}
 }
 
 func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
@@ -6099,7 +6870,7 @@ func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()    {}
 func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{83}
+	return fileDescriptor_77a6da22d6a3feb1, []int{87}
 }
 
 func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -6137,7 +6908,7 @@ func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()    {}
 func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{84}
+	return fileDescriptor_77a6da22d6a3feb1, []int{88}
 }
 
 func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -6180,7 +6951,7 @@ func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()    {}
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{85}
+	return fileDescriptor_77a6da22d6a3feb1, []int{89}
 }
 
 func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
@@ -6288,7 +7059,7 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{86}
+	return fileDescriptor_77a6da22d6a3feb1, []int{90}
 }
 
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
@@ -6319,7 +7090,7 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{87}
+	return fileDescriptor_77a6da22d6a3feb1, []int{91}
 }
 
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
@@ -6340,6 +7111,116 @@ func (m *StopResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StopResponse proto.InternalMessageInfo
 
+type DrainRequest struct {
+	//*
+	//The maximum number of seconds to wait for in-flight HTLCs to be resolved.
+	//Once the timeout expires, the daemon is shut down regardless. If zero, a
+	//default of 10 minutes is used.
+	TimeoutSeconds       uint32   `protobuf:"varint,1,opt,name=timeout_seconds,proto3" json:"timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainRequest) Reset()         { *m = DrainRequest{} }
+func (m *DrainRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainRequest) ProtoMessage()    {}
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+}
+
+func (m *DrainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainRequest.Unmarshal(m, b)
+}
+func (m *DrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainRequest.Marshal(b, m, deterministic)
+}
+func (m *DrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainRequest.Merge(m, src)
+}
+func (m *DrainRequest) XXX_Size() int {
+	return xxx_messageInfo_DrainRequest.Size(m)
+}
+func (m *DrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainRequest proto.InternalMessageInfo
+
+func (m *DrainRequest) GetTimeoutSeconds() uint32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type DrainUpdate struct {
+	/// The current state of the drain.
+	State DrainUpdate_DrainState `protobuf:"varint,1,opt,name=state,proto3,enum=lnrpc.DrainUpdate_DrainState" json:"state,omitempty"`
+	/// The number of channels that were disabled on the network.
+	DisabledChannels uint32 `protobuf:"varint,2,opt,name=disabled_channels,proto3" json:"disabled_channels,omitempty"`
+	/// The number of HTLCs that are still in flight.
+	PendingHtlcs uint32 `protobuf:"varint,3,opt,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
+	/// The number of seconds left until the timeout expires.
+	SecondsRemaining     uint32   `protobuf:"varint,4,opt,name=seconds_remaining,proto3" json:"seconds_remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainUpdate) Reset()         { *m = DrainUpdate{} }
+func (m *DrainUpdate) String() string { return proto.CompactTextString(m) }
+func (*DrainUpdate) ProtoMessage()    {}
+func (*DrainUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+}
+
+func (m *DrainUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainUpdate.Unmarshal(m, b)
+}
+func (m *DrainUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainUpdate.Marshal(b, m, deterministic)
+}
+func (m *DrainUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainUpdate.Merge(m, src)
+}
+func (m *DrainUpdate) XXX_Size() int {
+	return xxx_messageInfo_DrainUpdate.Size(m)
+}
+func (m *DrainUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainUpdate proto.InternalMessageInfo
+
+func (m *DrainUpdate) GetState() DrainUpdate_DrainState {
+	if m != nil {
+		return m.State
+	}
+	return DrainUpdate_DRAINING
+}
+
+func (m *DrainUpdate) GetDisabledChannels() uint32 {
+	if m != nil {
+		return m.DisabledChannels
+	}
+	return 0
+}
+
+func (m *DrainUpdate) GetPendingHtlcs() uint32 {
+	if m != nil {
+		return m.PendingHtlcs
+	}
+	return 0
+}
+
+func (m *DrainUpdate) GetSecondsRemaining() uint32 {
+	if m != nil {
+		return m.SecondsRemaining
+	}
+	return 0
+}
+
 type GraphTopologySubscription struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -6350,7 +7231,7 @@ func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscrip
 func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()    {}
 func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{88}
+	return fileDescriptor_77a6da22d6a3feb1, []int{94}
 }
 
 func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
@@ -6384,7 +7265,7 @@ func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()    {}
 func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+	return fileDescriptor_77a6da22d6a3feb1, []int{95}
 }
 
 func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
@@ -6441,7 +7322,7 @@ func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()    {}
 func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+	return fileDescriptor_77a6da22d6a3feb1, []int{96}
 }
 
 func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
@@ -6517,7 +7398,7 @@ func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()    {}
 func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{91}
+	return fileDescriptor_77a6da22d6a3feb1, []int{97}
 }
 
 func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
@@ -6598,7 +7479,7 @@ func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()    {}
 func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+	return fileDescriptor_77a6da22d6a3feb1, []int{98}
 }
 
 func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
@@ -6669,7 +7550,7 @@ func (m *HopHint) Reset()         { *m = HopHint{} }
 func (m *HopHint) String() string { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()    {}
 func (*HopHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+	return fileDescriptor_77a6da22d6a3feb1, []int{99}
 }
 
 func (m *HopHint) XXX_Unmarshal(b []byte) error {
@@ -6739,7 +7620,7 @@ func (m *RouteHint) Reset()         { *m = RouteHint{} }
 func (m *RouteHint) String() string { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()    {}
 func (*RouteHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{94}
+	return fileDescriptor_77a6da22d6a3feb1, []int{100}
 }
 
 func (m *RouteHint) XXX_Unmarshal(b []byte) error {
@@ -6857,7 +7738,7 @@ func (m *Invoice) Reset()         { *m = Invoice{} }
 func (m *Invoice) String() string { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()    {}
 func (*Invoice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{95}
+	return fileDescriptor_77a6da22d6a3feb1, []int{101}
 }
 
 func (m *Invoice) XXX_Unmarshal(b []byte) error {
@@ -7062,7 +7943,7 @@ func (m *InvoiceHTLC) Reset()         { *m = InvoiceHTLC{} }
 func (m *InvoiceHTLC) String() string { return proto.CompactTextString(m) }
 func (*InvoiceHTLC) ProtoMessage()    {}
 func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{96}
+	return fileDescriptor_77a6da22d6a3feb1, []int{102}
 }
 
 func (m *InvoiceHTLC) XXX_Unmarshal(b []byte) error {
@@ -7161,7 +8042,7 @@ func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
 func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*AddInvoiceResponse) ProtoMessage()    {}
 func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{97}
+	return fileDescriptor_77a6da22d6a3feb1, []int{103}
 }
 
 func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
@@ -7219,7 +8100,7 @@ func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
 func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()    {}
 func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{98}
+	return fileDescriptor_77a6da22d6a3feb1, []int{104}
 }
 
 func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
@@ -7276,7 +8157,7 @@ func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()    {}
 func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{99}
+	return fileDescriptor_77a6da22d6a3feb1, []int{105}
 }
 
 func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
@@ -7347,7 +8228,7 @@ func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()    {}
 func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{100}
+	return fileDescriptor_77a6da22d6a3feb1, []int{106}
 }
 
 func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
@@ -7411,7 +8292,7 @@ func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()    {}
 func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{101}
+	return fileDescriptor_77a6da22d6a3feb1, []int{107}
 }
 
 func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
@@ -7480,7 +8361,7 @@ func (m *Payment) Reset()         { *m = Payment{} }
 func (m *Payment) String() string { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()    {}
 func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{102}
+	return fileDescriptor_77a6da22d6a3feb1, []int{108}
 }
 
 func (m *Payment) XXX_Unmarshal(b []byte) error {
@@ -7602,7 +8483,7 @@ func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()    {}
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{103}
+	return fileDescriptor_77a6da22d6a3feb1, []int{109}
 }
 
 func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
@@ -7642,7 +8523,7 @@ func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()    {}
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+	return fileDescriptor_77a6da22d6a3feb1, []int{110}
 }
 
 func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
@@ -7680,7 +8561,7 @@ func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsReque
 func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
 func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+	return fileDescriptor_77a6da22d6a3feb1, []int{111}
 }
 
 func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
@@ -7711,7 +8592,7 @@ func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResp
 func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
 func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{106}
+	return fileDescriptor_77a6da22d6a3feb1, []int{112}
 }
 
 func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
@@ -7743,7 +8624,7 @@ func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
 func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelRequest) ProtoMessage()    {}
 func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+	return fileDescriptor_77a6da22d6a3feb1, []int{113}
 }
 
 func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -7781,7 +8662,7 @@ func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{}
 func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelResponse) ProtoMessage()    {}
 func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{108}
+	return fileDescriptor_77a6da22d6a3feb1, []int{114}
 }
 
 func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -7814,7 +8695,7 @@ func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()    {}
 func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{109}
+	return fileDescriptor_77a6da22d6a3feb1, []int{115}
 }
 
 func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
@@ -7860,7 +8741,7 @@ func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()    {}
 func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{110}
+	return fileDescriptor_77a6da22d6a3feb1, []int{116}
 }
 
 func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
@@ -7900,7 +8781,7 @@ func (m *PayReqString) Reset()         { *m = PayReqString{} }
 func (m *PayReqString) String() string { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()    {}
 func (*PayReqString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{111}
+	return fileDescriptor_77a6da22d6a3feb1, []int{117}
 }
 
 func (m *PayReqString) XXX_Unmarshal(b []byte) error {
@@ -7948,7 +8829,7 @@ func (m *PayReq) Reset()         { *m = PayReq{} }
 func (m *PayReq) String() string { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()    {}
 func (*PayReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{112}
+	return fileDescriptor_77a6da22d6a3feb1, []int{118}
 }
 
 func (m *PayReq) XXX_Unmarshal(b []byte) error {
@@ -8049,7 +8930,7 @@ func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()    {}
 func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{113}
+	return fileDescriptor_77a6da22d6a3feb1, []int{119}
 }
 
 func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
@@ -8078,7 +8959,11 @@ type ChannelFeeReport struct {
 	/// The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
 	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
 	/// The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
-	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	/// The inbound base fee charged for HTLCs arriving over this channel. A negative value is a discount.
+	InboundBaseFeeMsat int32 `protobuf:"varint,5,opt,name=inbound_base_fee_msat,proto3" json:"inbound_base_fee_msat,omitempty"`
+	/// The inbound fee rate charged for HTLCs arriving over this channel, expressed in millionths of a satoshi. A negative value is a discount.
+	InboundFeePerMil     int32    `protobuf:"varint,6,opt,name=inbound_fee_per_mil,proto3" json:"inbound_fee_per_mil,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -8088,7 +8973,7 @@ func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()    {}
 func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{114}
+	return fileDescriptor_77a6da22d6a3feb1, []int{120}
 }
 
 func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
@@ -8137,6 +9022,20 @@ func (m *ChannelFeeReport) GetFeeRate() float64 {
 	return 0
 }
 
+func (m *ChannelFeeReport) GetInboundBaseFeeMsat() int32 {
+	if m != nil {
+		return m.InboundBaseFeeMsat
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetInboundFeePerMil() int32 {
+	if m != nil {
+		return m.InboundFeePerMil
+	}
+	return 0
+}
+
 type FeeReportResponse struct {
 	/// An array of channel fee reports which describes the current fee schedule for each channel.
 	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees,proto3" json:"channel_fees,omitempty"`
@@ -8155,7 +9054,7 @@ func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()    {}
 func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{115}
+	return fileDescriptor_77a6da22d6a3feb1, []int{121}
 }
 
 func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
@@ -8216,17 +9115,19 @@ type PolicyUpdateRequest struct {
 	/// The required timelock delta for HTLCs forwarded over the channel.
 	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
 	/// If set, the maximum HTLC size in milli-satoshis. If unset, the maximum HTLC will be unchanged.
-	MaxHtlcMsat          uint64   `protobuf:"varint,6,opt,name=max_htlc_msat,proto3" json:"max_htlc_msat,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MaxHtlcMsat uint64 `protobuf:"varint,6,opt,name=max_htlc_msat,proto3" json:"max_htlc_msat,omitempty"`
+	/// If set, the fee charged for HTLCs arriving over the channel. If unset, the inbound fee will be unchanged.
+	InboundFee           *InboundFee `protobuf:"bytes,7,opt,name=inbound_fee,proto3" json:"inbound_fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()    {}
 func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{116}
+	return fileDescriptor_77a6da22d6a3feb1, []int{122}
 }
 
 func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
@@ -8312,6 +9213,13 @@ func (m *PolicyUpdateRequest) GetMaxHtlcMsat() uint64 {
 	return 0
 }
 
+func (m *PolicyUpdateRequest) GetInboundFee() *InboundFee {
+	if m != nil {
+		return m.InboundFee
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*PolicyUpdateRequest) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -8330,7 +9238,7 @@ func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()    {}
 func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{117}
+	return fileDescriptor_77a6da22d6a3feb1, []int{123}
 }
 
 func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
@@ -8345,11 +9253,722 @@ func (m *PolicyUpdateResponse) XXX_Merge(src proto.Message) {
 func (m *PolicyUpdateResponse) XXX_Size() int {
 	return xxx_messageInfo_PolicyUpdateResponse.Size(m)
 }
-func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyUpdateResponse.DiscardUnknown(m)
+func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
+
+type InboundFee struct {
+	/// The base fee in milli-satoshis. A negative value is a discount on the fee of the outgoing channel.
+	BaseFeeMsat int32 `protobuf:"varint,1,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
+	/// The fee rate in millionths of a satoshi. A negative value is a discount on the fee of the outgoing channel.
+	FeePerMil            int32    `protobuf:"varint,2,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InboundFee) Reset()         { *m = InboundFee{} }
+func (m *InboundFee) String() string { return proto.CompactTextString(m) }
+func (*InboundFee) ProtoMessage()    {}
+func (*InboundFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{124}
+}
+
+func (m *InboundFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InboundFee.Unmarshal(m, b)
+}
+func (m *InboundFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InboundFee.Marshal(b, m, deterministic)
+}
+func (m *InboundFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundFee.Merge(m, src)
+}
+func (m *InboundFee) XXX_Size() int {
+	return xxx_messageInfo_InboundFee.Size(m)
+}
+func (m *InboundFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InboundFee proto.InternalMessageInfo
+
+func (m *InboundFee) GetBaseFeeMsat() int32 {
+	if m != nil {
+		return m.BaseFeeMsat
+	}
+	return 0
+}
+
+func (m *InboundFee) GetFeePerMil() int32 {
+	if m != nil {
+		return m.FeePerMil
+	}
+	return 0
+}
+
+type FeeStrategyStep struct {
+	/// The inclusive upper bound of the local balance, as a percentage of the channel capacity, for which this step applies.
+	MaxLocalPercent uint32 `protobuf:"varint,1,opt,name=max_local_percent,proto3" json:"max_local_percent,omitempty"`
+	/// The fee rate charged while this step applies, expressed in millionths of a satoshi.
+	FeePerMil            uint32   `protobuf:"varint,2,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeStrategyStep) Reset()         { *m = FeeStrategyStep{} }
+func (m *FeeStrategyStep) String() string { return proto.CompactTextString(m) }
+func (*FeeStrategyStep) ProtoMessage()    {}
+func (*FeeStrategyStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{125}
+}
+
+func (m *FeeStrategyStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeStrategyStep.Unmarshal(m, b)
+}
+func (m *FeeStrategyStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeStrategyStep.Marshal(b, m, deterministic)
+}
+func (m *FeeStrategyStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeStrategyStep.Merge(m, src)
+}
+func (m *FeeStrategyStep) XXX_Size() int {
+	return xxx_messageInfo_FeeStrategyStep.Size(m)
+}
+func (m *FeeStrategyStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeStrategyStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeStrategyStep proto.InternalMessageInfo
+
+func (m *FeeStrategyStep) GetMaxLocalPercent() uint32 {
+	if m != nil {
+		return m.MaxLocalPercent
+	}
+	return 0
+}
+
+func (m *FeeStrategyStep) GetFeePerMil() uint32 {
+	if m != nil {
+		return m.FeePerMil
+	}
+	return 0
+}
+
+type FeeStrategy struct {
+	/// The curve used to derive the fee rate from the channel's local balance.
+	Curve FeeStrategy_CurveType `protobuf:"varint,1,opt,name=curve,proto3,enum=lnrpc.FeeStrategy_CurveType" json:"curve,omitempty"`
+	/// The base fee set on every channel priced by this strategy.
+	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
+	/// The lowest fee rate the strategy will set, expressed in millionths of a satoshi.
+	MinFeePerMil uint32 `protobuf:"varint,3,opt,name=min_fee_per_mil,proto3" json:"min_fee_per_mil,omitempty"`
+	/// The highest fee rate the strategy will set, expressed in millionths of a satoshi.
+	MaxFeePerMil uint32 `protobuf:"varint,4,opt,name=max_fee_per_mil,proto3" json:"max_fee_per_mil,omitempty"`
+	/// The steps of a STEP curve, ordered by increasing threshold.
+	Steps []*FeeStrategyStep `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	/// The amount we'd like to forward out of the channel during the engine's volume window. If zero, forwarding volume is ignored.
+	VolumeTargetMsat uint64 `protobuf:"varint,6,opt,name=volume_target_msat,proto3" json:"volume_target_msat,omitempty"`
+	/// The percentage by which the fee rate is raised if the volume target was met, or lowered if it wasn't.
+	VolumeAdjustPercent  uint32   `protobuf:"varint,7,opt,name=volume_adjust_percent,proto3" json:"volume_adjust_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeStrategy) Reset()         { *m = FeeStrategy{} }
+func (m *FeeStrategy) String() string { return proto.CompactTextString(m) }
+func (*FeeStrategy) ProtoMessage()    {}
+func (*FeeStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{126}
+}
+
+func (m *FeeStrategy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeStrategy.Unmarshal(m, b)
+}
+func (m *FeeStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeStrategy.Marshal(b, m, deterministic)
+}
+func (m *FeeStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeStrategy.Merge(m, src)
+}
+func (m *FeeStrategy) XXX_Size() int {
+	return xxx_messageInfo_FeeStrategy.Size(m)
+}
+func (m *FeeStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeStrategy proto.InternalMessageInfo
+
+func (m *FeeStrategy) GetCurve() FeeStrategy_CurveType {
+	if m != nil {
+		return m.Curve
+	}
+	return FeeStrategy_PROPORTIONAL
+}
+
+func (m *FeeStrategy) GetBaseFeeMsat() int64 {
+	if m != nil {
+		return m.BaseFeeMsat
+	}
+	return 0
+}
+
+func (m *FeeStrategy) GetMinFeePerMil() uint32 {
+	if m != nil {
+		return m.MinFeePerMil
+	}
+	return 0
+}
+
+func (m *FeeStrategy) GetMaxFeePerMil() uint32 {
+	if m != nil {
+		return m.MaxFeePerMil
+	}
+	return 0
+}
+
+func (m *FeeStrategy) GetSteps() []*FeeStrategyStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *FeeStrategy) GetVolumeTargetMsat() uint64 {
+	if m != nil {
+		return m.VolumeTargetMsat
+	}
+	return 0
+}
+
+func (m *FeeStrategy) GetVolumeAdjustPercent() uint32 {
+	if m != nil {
+		return m.VolumeAdjustPercent
+	}
+	return 0
+}
+
+type SetFeeStrategyRequest struct {
+	// Types that are valid to be assigned to Scope:
+	//	*SetFeeStrategyRequest_Global
+	//	*SetFeeStrategyRequest_ChanPoint
+	Scope isSetFeeStrategyRequest_Scope `protobuf_oneof:"scope"`
+	/// The new strategy. If unset, the existing strategy is removed.
+	Strategy             *FeeStrategy `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetFeeStrategyRequest) Reset()         { *m = SetFeeStrategyRequest{} }
+func (m *SetFeeStrategyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetFeeStrategyRequest) ProtoMessage()    {}
+func (*SetFeeStrategyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{127}
+}
+
+func (m *SetFeeStrategyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetFeeStrategyRequest.Unmarshal(m, b)
+}
+func (m *SetFeeStrategyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetFeeStrategyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetFeeStrategyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFeeStrategyRequest.Merge(m, src)
+}
+func (m *SetFeeStrategyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetFeeStrategyRequest.Size(m)
+}
+func (m *SetFeeStrategyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFeeStrategyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFeeStrategyRequest proto.InternalMessageInfo
+
+type isSetFeeStrategyRequest_Scope interface {
+	isSetFeeStrategyRequest_Scope()
+}
+
+type SetFeeStrategyRequest_Global struct {
+	Global bool `protobuf:"varint,1,opt,name=global,proto3,oneof"`
+}
+
+type SetFeeStrategyRequest_ChanPoint struct {
+	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,proto3,oneof"`
+}
+
+func (*SetFeeStrategyRequest_Global) isSetFeeStrategyRequest_Scope() {}
+
+func (*SetFeeStrategyRequest_ChanPoint) isSetFeeStrategyRequest_Scope() {}
+
+func (m *SetFeeStrategyRequest) GetScope() isSetFeeStrategyRequest_Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *SetFeeStrategyRequest) GetGlobal() bool {
+	if x, ok := m.GetScope().(*SetFeeStrategyRequest_Global); ok {
+		return x.Global
+	}
+	return false
+}
+
+func (m *SetFeeStrategyRequest) GetChanPoint() *ChannelPoint {
+	if x, ok := m.GetScope().(*SetFeeStrategyRequest_ChanPoint); ok {
+		return x.ChanPoint
+	}
+	return nil
+}
+
+func (m *SetFeeStrategyRequest) GetStrategy() *FeeStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SetFeeStrategyRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SetFeeStrategyRequest_Global)(nil),
+		(*SetFeeStrategyRequest_ChanPoint)(nil),
+	}
+}
+
+type SetFeeStrategyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetFeeStrategyResponse) Reset()         { *m = SetFeeStrategyResponse{} }
+func (m *SetFeeStrategyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetFeeStrategyResponse) ProtoMessage()    {}
+func (*SetFeeStrategyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{128}
+}
+
+func (m *SetFeeStrategyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetFeeStrategyResponse.Unmarshal(m, b)
+}
+func (m *SetFeeStrategyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetFeeStrategyResponse.Marshal(b, m, deterministic)
+}
+func (m *SetFeeStrategyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFeeStrategyResponse.Merge(m, src)
+}
+func (m *SetFeeStrategyResponse) XXX_Size() int {
+	return xxx_messageInfo_SetFeeStrategyResponse.Size(m)
+}
+func (m *SetFeeStrategyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFeeStrategyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFeeStrategyResponse proto.InternalMessageInfo
+
+type PreviewFeeUpdatesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreviewFeeUpdatesRequest) Reset()         { *m = PreviewFeeUpdatesRequest{} }
+func (m *PreviewFeeUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*PreviewFeeUpdatesRequest) ProtoMessage()    {}
+func (*PreviewFeeUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{129}
+}
+
+func (m *PreviewFeeUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewFeeUpdatesRequest.Unmarshal(m, b)
+}
+func (m *PreviewFeeUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewFeeUpdatesRequest.Marshal(b, m, deterministic)
+}
+func (m *PreviewFeeUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewFeeUpdatesRequest.Merge(m, src)
+}
+func (m *PreviewFeeUpdatesRequest) XXX_Size() int {
+	return xxx_messageInfo_PreviewFeeUpdatesRequest.Size(m)
+}
+func (m *PreviewFeeUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewFeeUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewFeeUpdatesRequest proto.InternalMessageInfo
+
+type ChannelFeeStrategy struct {
+	/// The channel this strategy applies to.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point,proto3" json:"chan_point,omitempty"`
+	/// The strategy configured for the channel.
+	Strategy             *FeeStrategy `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ChannelFeeStrategy) Reset()         { *m = ChannelFeeStrategy{} }
+func (m *ChannelFeeStrategy) String() string { return proto.CompactTextString(m) }
+func (*ChannelFeeStrategy) ProtoMessage()    {}
+func (*ChannelFeeStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{130}
+}
+
+func (m *ChannelFeeStrategy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelFeeStrategy.Unmarshal(m, b)
+}
+func (m *ChannelFeeStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelFeeStrategy.Marshal(b, m, deterministic)
+}
+func (m *ChannelFeeStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFeeStrategy.Merge(m, src)
+}
+func (m *ChannelFeeStrategy) XXX_Size() int {
+	return xxx_messageInfo_ChannelFeeStrategy.Size(m)
+}
+func (m *ChannelFeeStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFeeStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFeeStrategy proto.InternalMessageInfo
+
+func (m *ChannelFeeStrategy) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *ChannelFeeStrategy) GetStrategy() *FeeStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return nil
+}
+
+type FeeUpdateProposal struct {
+	/// The channel this proposal belongs to.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point,proto3" json:"chan_point,omitempty"`
+	/// The unique channel ID for the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	/// The share of the channel capacity that is currently local, between 0 and 1.
+	LocalRatio float64 `protobuf:"fixed64,3,opt,name=local_ratio,proto3" json:"local_ratio,omitempty"`
+	/// The amount forwarded out of the channel during the engine's volume window.
+	VolumeMsat uint64 `protobuf:"varint,4,opt,name=volume_msat,proto3" json:"volume_msat,omitempty"`
+	/// The base fee currently advertised for the channel.
+	CurrentBaseFeeMsat int64 `protobuf:"varint,5,opt,name=current_base_fee_msat,proto3" json:"current_base_fee_msat,omitempty"`
+	/// The fee rate currently advertised for the channel, expressed in millionths of a satoshi.
+	CurrentFeePerMil int64 `protobuf:"varint,6,opt,name=current_fee_per_mil,proto3" json:"current_fee_per_mil,omitempty"`
+	/// The base fee the channel's strategy selected.
+	NewBaseFeeMsat int64 `protobuf:"varint,7,opt,name=new_base_fee_msat,proto3" json:"new_base_fee_msat,omitempty"`
+	/// The fee rate the channel's strategy selected, expressed in millionths of a satoshi.
+	NewFeePerMil int64 `protobuf:"varint,8,opt,name=new_fee_per_mil,proto3" json:"new_fee_per_mil,omitempty"`
+	/// Whether the engine would apply the new policy during its next round.
+	Apply bool `protobuf:"varint,9,opt,name=apply,proto3" json:"apply,omitempty"`
+	/// If the new policy wouldn't be applied, the reason why not.
+	SkipReason           string   `protobuf:"bytes,10,opt,name=skip_reason,proto3" json:"skip_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeUpdateProposal) Reset()         { *m = FeeUpdateProposal{} }
+func (m *FeeUpdateProposal) String() string { return proto.CompactTextString(m) }
+func (*FeeUpdateProposal) ProtoMessage()    {}
+func (*FeeUpdateProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{131}
+}
+
+func (m *FeeUpdateProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeUpdateProposal.Unmarshal(m, b)
+}
+func (m *FeeUpdateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeUpdateProposal.Marshal(b, m, deterministic)
+}
+func (m *FeeUpdateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeUpdateProposal.Merge(m, src)
+}
+func (m *FeeUpdateProposal) XXX_Size() int {
+	return xxx_messageInfo_FeeUpdateProposal.Size(m)
+}
+func (m *FeeUpdateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeUpdateProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeUpdateProposal proto.InternalMessageInfo
+
+func (m *FeeUpdateProposal) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *FeeUpdateProposal) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *FeeUpdateProposal) GetLocalRatio() float64 {
+	if m != nil {
+		return m.LocalRatio
+	}
+	return 0
+}
+
+func (m *FeeUpdateProposal) GetVolumeMsat() uint64 {
+	if m != nil {
+		return m.VolumeMsat
+	}
+	return 0
+}
+
+func (m *FeeUpdateProposal) GetCurrentBaseFeeMsat() int64 {
+	if m != nil {
+		return m.CurrentBaseFeeMsat
+	}
+	return 0
+}
+
+func (m *FeeUpdateProposal) GetCurrentFeePerMil() int64 {
+	if m != nil {
+		return m.CurrentFeePerMil
+	}
+	return 0
+}
+
+func (m *FeeUpdateProposal) GetNewBaseFeeMsat() int64 {
+	if m != nil {
+		return m.NewBaseFeeMsat
+	}
+	return 0
+}
+
+func (m *FeeUpdateProposal) GetNewFeePerMil() int64 {
+	if m != nil {
+		return m.NewFeePerMil
+	}
+	return 0
+}
+
+func (m *FeeUpdateProposal) GetApply() bool {
+	if m != nil {
+		return m.Apply
+	}
+	return false
+}
+
+func (m *FeeUpdateProposal) GetSkipReason() string {
+	if m != nil {
+		return m.SkipReason
+	}
+	return ""
+}
+
+type PreviewFeeUpdatesResponse struct {
+	/// The default strategy used for all channels without a strategy of their own, if set.
+	DefaultStrategy *FeeStrategy `protobuf:"bytes,1,opt,name=default_strategy,proto3" json:"default_strategy,omitempty"`
+	/// The channel specific strategies.
+	ChannelStrategies []*ChannelFeeStrategy `protobuf:"bytes,2,rep,name=channel_strategies,proto3" json:"channel_strategies,omitempty"`
+	/// The policy the engine would select for each channel.
+	Proposals []*FeeUpdateProposal `protobuf:"bytes,3,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	/// Whether the fee engine is actively updating channel policies.
+	Active               bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreviewFeeUpdatesResponse) Reset()         { *m = PreviewFeeUpdatesResponse{} }
+func (m *PreviewFeeUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*PreviewFeeUpdatesResponse) ProtoMessage()    {}
+func (*PreviewFeeUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{132}
+}
+
+func (m *PreviewFeeUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewFeeUpdatesResponse.Unmarshal(m, b)
+}
+func (m *PreviewFeeUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewFeeUpdatesResponse.Marshal(b, m, deterministic)
+}
+func (m *PreviewFeeUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewFeeUpdatesResponse.Merge(m, src)
+}
+func (m *PreviewFeeUpdatesResponse) XXX_Size() int {
+	return xxx_messageInfo_PreviewFeeUpdatesResponse.Size(m)
+}
+func (m *PreviewFeeUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewFeeUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewFeeUpdatesResponse proto.InternalMessageInfo
+
+func (m *PreviewFeeUpdatesResponse) GetDefaultStrategy() *FeeStrategy {
+	if m != nil {
+		return m.DefaultStrategy
+	}
+	return nil
+}
+
+func (m *PreviewFeeUpdatesResponse) GetChannelStrategies() []*ChannelFeeStrategy {
+	if m != nil {
+		return m.ChannelStrategies
+	}
+	return nil
+}
+
+func (m *PreviewFeeUpdatesResponse) GetProposals() []*FeeUpdateProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *PreviewFeeUpdatesResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+type DeadlinePolicyRequest struct {
+	/// The channel to set the deadline policy of.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	//*
+	//The number of blocks before an incoming HTLC expires at which we go to
+	//chain in order to claim it. If zero, the global default is used.
+	IncomingBroadcastDelta uint32 `protobuf:"varint,2,opt,name=incoming_broadcast_delta,proto3" json:"incoming_broadcast_delta,omitempty"`
+	//*
+	//The number of blocks after an outgoing HTLC expired at which we go to
+	//chain in order to time it out. If zero, the global default is used.
+	OutgoingBroadcastDelta uint32 `protobuf:"varint,3,opt,name=outgoing_broadcast_delta,proto3" json:"outgoing_broadcast_delta,omitempty"`
+	//*
+	//The minimum value in satoshis of an HTLC we're willing to go to chain
+	//for. If zero, we go to chain for HTLCs of any value.
+	MinChainHtlcSat      int64    `protobuf:"varint,4,opt,name=min_chain_htlc_sat,proto3" json:"min_chain_htlc_sat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeadlinePolicyRequest) Reset()         { *m = DeadlinePolicyRequest{} }
+func (m *DeadlinePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeadlinePolicyRequest) ProtoMessage()    {}
+func (*DeadlinePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{133}
+}
+
+func (m *DeadlinePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadlinePolicyRequest.Unmarshal(m, b)
+}
+func (m *DeadlinePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadlinePolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *DeadlinePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadlinePolicyRequest.Merge(m, src)
+}
+func (m *DeadlinePolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_DeadlinePolicyRequest.Size(m)
+}
+func (m *DeadlinePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadlinePolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadlinePolicyRequest proto.InternalMessageInfo
+
+func (m *DeadlinePolicyRequest) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *DeadlinePolicyRequest) GetIncomingBroadcastDelta() uint32 {
+	if m != nil {
+		return m.IncomingBroadcastDelta
+	}
+	return 0
+}
+
+func (m *DeadlinePolicyRequest) GetOutgoingBroadcastDelta() uint32 {
+	if m != nil {
+		return m.OutgoingBroadcastDelta
+	}
+	return 0
+}
+
+func (m *DeadlinePolicyRequest) GetMinChainHtlcSat() int64 {
+	if m != nil {
+		return m.MinChainHtlcSat
+	}
+	return 0
+}
+
+type DeadlinePolicyResponse struct {
+	/// The incoming broadcast delta in effect for the channel.
+	IncomingBroadcastDelta uint32 `protobuf:"varint,1,opt,name=incoming_broadcast_delta,proto3" json:"incoming_broadcast_delta,omitempty"`
+	/// The outgoing broadcast delta in effect for the channel.
+	OutgoingBroadcastDelta uint32 `protobuf:"varint,2,opt,name=outgoing_broadcast_delta,proto3" json:"outgoing_broadcast_delta,omitempty"`
+	/// The minimum value in satoshis of an HTLC we go to chain for.
+	MinChainHtlcSat      int64    `protobuf:"varint,3,opt,name=min_chain_htlc_sat,proto3" json:"min_chain_htlc_sat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeadlinePolicyResponse) Reset()         { *m = DeadlinePolicyResponse{} }
+func (m *DeadlinePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*DeadlinePolicyResponse) ProtoMessage()    {}
+func (*DeadlinePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{134}
+}
+
+func (m *DeadlinePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadlinePolicyResponse.Unmarshal(m, b)
+}
+func (m *DeadlinePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadlinePolicyResponse.Marshal(b, m, deterministic)
+}
+func (m *DeadlinePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadlinePolicyResponse.Merge(m, src)
+}
+func (m *DeadlinePolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_DeadlinePolicyResponse.Size(m)
+}
+func (m *DeadlinePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadlinePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadlinePolicyResponse proto.InternalMessageInfo
+
+func (m *DeadlinePolicyResponse) GetIncomingBroadcastDelta() uint32 {
+	if m != nil {
+		return m.IncomingBroadcastDelta
+	}
+	return 0
 }
 
-var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
+func (m *DeadlinePolicyResponse) GetOutgoingBroadcastDelta() uint32 {
+	if m != nil {
+		return m.OutgoingBroadcastDelta
+	}
+	return 0
+}
+
+func (m *DeadlinePolicyResponse) GetMinChainHtlcSat() int64 {
+	if m != nil {
+		return m.MinChainHtlcSat
+	}
+	return 0
+}
 
 type ForwardingHistoryRequest struct {
 	/// Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
@@ -8369,7 +9988,7 @@ func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryReque
 func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()    {}
 func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{118}
+	return fileDescriptor_77a6da22d6a3feb1, []int{135}
 }
 
 func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
@@ -8446,7 +10065,7 @@ func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
 func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
 func (*ForwardingEvent) ProtoMessage()    {}
 func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{119}
+	return fileDescriptor_77a6da22d6a3feb1, []int{136}
 }
 
 func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
@@ -8544,7 +10163,7 @@ func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResp
 func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryResponse) ProtoMessage()    {}
 func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{120}
+	return fileDescriptor_77a6da22d6a3feb1, []int{137}
 }
 
 func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
@@ -8591,7 +10210,7 @@ func (m *ExportChannelBackupRequest) Reset()         { *m = ExportChannelBackupR
 func (m *ExportChannelBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*ExportChannelBackupRequest) ProtoMessage()    {}
 func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{121}
+	return fileDescriptor_77a6da22d6a3feb1, []int{138}
 }
 
 func (m *ExportChannelBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -8637,7 +10256,7 @@ func (m *ChannelBackup) Reset()         { *m = ChannelBackup{} }
 func (m *ChannelBackup) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackup) ProtoMessage()    {}
 func (*ChannelBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{122}
+	return fileDescriptor_77a6da22d6a3feb1, []int{139}
 }
 
 func (m *ChannelBackup) XXX_Unmarshal(b []byte) error {
@@ -8690,7 +10309,7 @@ func (m *MultiChanBackup) Reset()         { *m = MultiChanBackup{} }
 func (m *MultiChanBackup) String() string { return proto.CompactTextString(m) }
 func (*MultiChanBackup) ProtoMessage()    {}
 func (*MultiChanBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{123}
+	return fileDescriptor_77a6da22d6a3feb1, []int{140}
 }
 
 func (m *MultiChanBackup) XXX_Unmarshal(b []byte) error {
@@ -8735,7 +10354,7 @@ func (m *ChanBackupExportRequest) Reset()         { *m = ChanBackupExportRequest
 func (m *ChanBackupExportRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupExportRequest) ProtoMessage()    {}
 func (*ChanBackupExportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{124}
+	return fileDescriptor_77a6da22d6a3feb1, []int{141}
 }
 
 func (m *ChanBackupExportRequest) XXX_Unmarshal(b []byte) error {
@@ -8774,7 +10393,7 @@ func (m *ChanBackupSnapshot) Reset()         { *m = ChanBackupSnapshot{} }
 func (m *ChanBackupSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupSnapshot) ProtoMessage()    {}
 func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{125}
+	return fileDescriptor_77a6da22d6a3feb1, []int{142}
 }
 
 func (m *ChanBackupSnapshot) XXX_Unmarshal(b []byte) error {
@@ -8822,7 +10441,7 @@ func (m *ChannelBackups) Reset()         { *m = ChannelBackups{} }
 func (m *ChannelBackups) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackups) ProtoMessage()    {}
 func (*ChannelBackups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{126}
+	return fileDescriptor_77a6da22d6a3feb1, []int{143}
 }
 
 func (m *ChannelBackups) XXX_Unmarshal(b []byte) error {
@@ -8864,7 +10483,7 @@ func (m *RestoreChanBackupRequest) Reset()         { *m = RestoreChanBackupReque
 func (m *RestoreChanBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreChanBackupRequest) ProtoMessage()    {}
 func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{127}
+	return fileDescriptor_77a6da22d6a3feb1, []int{144}
 }
 
 func (m *RestoreChanBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -8940,7 +10559,7 @@ func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
 func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreBackupResponse) ProtoMessage()    {}
 func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{128}
+	return fileDescriptor_77a6da22d6a3feb1, []int{145}
 }
 
 func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
@@ -8971,7 +10590,7 @@ func (m *ChannelBackupSubscription) Reset()         { *m = ChannelBackupSubscrip
 func (m *ChannelBackupSubscription) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackupSubscription) ProtoMessage()    {}
 func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{129}
+	return fileDescriptor_77a6da22d6a3feb1, []int{146}
 }
 
 func (m *ChannelBackupSubscription) XXX_Unmarshal(b []byte) error {
@@ -9002,7 +10621,7 @@ func (m *VerifyChanBackupResponse) Reset()         { *m = VerifyChanBackupRespon
 func (m *VerifyChanBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyChanBackupResponse) ProtoMessage()    {}
 func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{130}
+	return fileDescriptor_77a6da22d6a3feb1, []int{147}
 }
 
 func (m *VerifyChanBackupResponse) XXX_Unmarshal(b []byte) error {
@@ -9023,14 +10642,127 @@ func (m *VerifyChanBackupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VerifyChanBackupResponse proto.InternalMessageInfo
 
+type StuckHtlcEventSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StuckHtlcEventSubscription) Reset()         { *m = StuckHtlcEventSubscription{} }
+func (m *StuckHtlcEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*StuckHtlcEventSubscription) ProtoMessage()    {}
+func (*StuckHtlcEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{148}
+}
+
+func (m *StuckHtlcEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StuckHtlcEventSubscription.Unmarshal(m, b)
+}
+func (m *StuckHtlcEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StuckHtlcEventSubscription.Marshal(b, m, deterministic)
+}
+func (m *StuckHtlcEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StuckHtlcEventSubscription.Merge(m, src)
+}
+func (m *StuckHtlcEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_StuckHtlcEventSubscription.Size(m)
+}
+func (m *StuckHtlcEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_StuckHtlcEventSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StuckHtlcEventSubscription proto.InternalMessageInfo
+
+type StuckHtlcEvent struct {
+	/// The funding outpoint of the channel.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	/// The identity pubkey of the channel peer.
+	RemotePubkey string `protobuf:"bytes,2,opt,name=remote_pubkey,proto3" json:"remote_pubkey,omitempty"`
+	/// The stage the channel moved to.
+	Stage StuckHtlcEvent_Stage `protobuf:"varint,3,opt,name=stage,proto3,enum=lnrpc.StuckHtlcEvent_Stage" json:"stage,omitempty"`
+	/// The outgoing HTLCs that were considered stuck, empty for RECOVERED.
+	Htlcs []*HTLC `protobuf:"bytes,4,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
+	/// The error the action of the stage failed with, if any.
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StuckHtlcEvent) Reset()         { *m = StuckHtlcEvent{} }
+func (m *StuckHtlcEvent) String() string { return proto.CompactTextString(m) }
+func (*StuckHtlcEvent) ProtoMessage()    {}
+func (*StuckHtlcEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{149}
+}
+
+func (m *StuckHtlcEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StuckHtlcEvent.Unmarshal(m, b)
+}
+func (m *StuckHtlcEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StuckHtlcEvent.Marshal(b, m, deterministic)
+}
+func (m *StuckHtlcEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StuckHtlcEvent.Merge(m, src)
+}
+func (m *StuckHtlcEvent) XXX_Size() int {
+	return xxx_messageInfo_StuckHtlcEvent.Size(m)
+}
+func (m *StuckHtlcEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StuckHtlcEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StuckHtlcEvent proto.InternalMessageInfo
+
+func (m *StuckHtlcEvent) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *StuckHtlcEvent) GetRemotePubkey() string {
+	if m != nil {
+		return m.RemotePubkey
+	}
+	return ""
+}
+
+func (m *StuckHtlcEvent) GetStage() StuckHtlcEvent_Stage {
+	if m != nil {
+		return m.Stage
+	}
+	return StuckHtlcEvent_RECONNECT
+}
+
+func (m *StuckHtlcEvent) GetHtlcs() []*HTLC {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+func (m *StuckHtlcEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
+	proto.RegisterEnum("lnrpc.ResolutionType", ResolutionType_name, ResolutionType_value)
+	proto.RegisterEnum("lnrpc.ResolutionOutcome", ResolutionOutcome_name, ResolutionOutcome_value)
 	proto.RegisterEnum("lnrpc.InvoiceHTLCState", InvoiceHTLCState_name, InvoiceHTLCState_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
 	proto.RegisterEnum("lnrpc.Peer_SyncType", Peer_SyncType_name, Peer_SyncType_value)
+	proto.RegisterEnum("lnrpc.PendingChannelsResponse_JusticeOutput_JusticeState", PendingChannelsResponse_JusticeOutput_JusticeState_name, PendingChannelsResponse_JusticeOutput_JusticeState_value)
 	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
+	proto.RegisterEnum("lnrpc.DrainUpdate_DrainState", DrainUpdate_DrainState_name, DrainUpdate_DrainState_value)
 	proto.RegisterEnum("lnrpc.Invoice_InvoiceState", Invoice_InvoiceState_name, Invoice_InvoiceState_value)
 	proto.RegisterEnum("lnrpc.Payment_PaymentStatus", Payment_PaymentStatus_name, Payment_PaymentStatus_value)
+	proto.RegisterEnum("lnrpc.StuckHtlcEvent_Stage", StuckHtlcEvent_Stage_name, StuckHtlcEvent_Stage_value)
+	proto.RegisterEnum("lnrpc.FeeStrategy_CurveType", FeeStrategy_CurveType_name, FeeStrategy_CurveType_value)
 	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
 	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
 	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
@@ -9078,8 +10810,12 @@ func init() {
 	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
 	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
 	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
+	proto.RegisterType((*Resolution)(nil), "lnrpc.Resolution")
 	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
 	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
+	proto.RegisterType((*PrunableChannelsRequest)(nil), "lnrpc.PrunableChannelsRequest")
+	proto.RegisterType((*PrunableChannel)(nil), "lnrpc.PrunableChannel")
+	proto.RegisterType((*PrunableChannelsResponse)(nil), "lnrpc.PrunableChannelsResponse")
 	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
 	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
 	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
@@ -9102,6 +10838,8 @@ func init() {
 	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
 	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
 	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
+	proto.RegisterType((*PendingChannelsResponse_BreachedChannel)(nil), "lnrpc.PendingChannelsResponse.BreachedChannel")
+	proto.RegisterType((*PendingChannelsResponse_JusticeOutput)(nil), "lnrpc.PendingChannelsResponse.JusticeOutput")
 	proto.RegisterType((*ChannelEventSubscription)(nil), "lnrpc.ChannelEventSubscription")
 	proto.RegisterType((*ChannelEventUpdate)(nil), "lnrpc.ChannelEventUpdate")
 	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
@@ -9127,6 +10865,8 @@ func init() {
 	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
 	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
 	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
+	proto.RegisterType((*DrainRequest)(nil), "lnrpc.DrainRequest")
+	proto.RegisterType((*DrainUpdate)(nil), "lnrpc.DrainUpdate")
 	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
 	proto.RegisterType((*GraphTopologyUpdate)(nil), "lnrpc.GraphTopologyUpdate")
 	proto.RegisterType((*NodeUpdate)(nil), "lnrpc.NodeUpdate")
@@ -9157,6 +10897,17 @@ func init() {
 	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
 	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
 	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
+	proto.RegisterType((*InboundFee)(nil), "lnrpc.InboundFee")
+	proto.RegisterType((*FeeStrategyStep)(nil), "lnrpc.FeeStrategyStep")
+	proto.RegisterType((*FeeStrategy)(nil), "lnrpc.FeeStrategy")
+	proto.RegisterType((*SetFeeStrategyRequest)(nil), "lnrpc.SetFeeStrategyRequest")
+	proto.RegisterType((*SetFeeStrategyResponse)(nil), "lnrpc.SetFeeStrategyResponse")
+	proto.RegisterType((*PreviewFeeUpdatesRequest)(nil), "lnrpc.PreviewFeeUpdatesRequest")
+	proto.RegisterType((*ChannelFeeStrategy)(nil), "lnrpc.ChannelFeeStrategy")
+	proto.RegisterType((*FeeUpdateProposal)(nil), "lnrpc.FeeUpdateProposal")
+	proto.RegisterType((*PreviewFeeUpdatesResponse)(nil), "lnrpc.PreviewFeeUpdatesResponse")
+	proto.RegisterType((*DeadlinePolicyRequest)(nil), "lnrpc.DeadlinePolicyRequest")
+	proto.RegisterType((*DeadlinePolicyResponse)(nil), "lnrpc.DeadlinePolicyResponse")
 	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
 	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
 	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
@@ -9170,545 +10921,633 @@ func init() {
 	proto.RegisterType((*RestoreBackupResponse)(nil), "lnrpc.RestoreBackupResponse")
 	proto.RegisterType((*ChannelBackupSubscription)(nil), "lnrpc.ChannelBackupSubscription")
 	proto.RegisterType((*VerifyChanBackupResponse)(nil), "lnrpc.VerifyChanBackupResponse")
+	proto.RegisterType((*StuckHtlcEventSubscription)(nil), "lnrpc.StuckHtlcEventSubscription")
+	proto.RegisterType((*StuckHtlcEvent)(nil), "lnrpc.StuckHtlcEvent")
 }
 
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 8516 bytes of a gzipped FileDescriptorProto
+	// 9894 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x5d, 0x6c, 0x24, 0x59,
-	0x96, 0x56, 0x45, 0xfe, 0xd8, 0x99, 0x27, 0xd3, 0x76, 0xfa, 0xda, 0x65, 0x67, 0x65, 0x57, 0x57,
-	0x57, 0xc7, 0xd4, 0x76, 0xd7, 0xd4, 0xf4, 0xb8, 0xaa, 0x6b, 0x66, 0x9a, 0xde, 0x2e, 0x96, 0xc5,
-	0x65, 0xbb, 0xca, 0x35, 0xed, 0x76, 0x79, 0xc2, 0x55, 0x53, 0xcc, 0xcc, 0xae, 0x72, 0xc2, 0x99,
-	0xd7, 0x76, 0x4c, 0x65, 0x46, 0xe4, 0x44, 0x44, 0xda, 0xe5, 0x69, 0x1a, 0x09, 0x84, 0x10, 0xe2,
-	0x05, 0x35, 0xbc, 0x00, 0x02, 0xad, 0x34, 0x8b, 0xc4, 0x2e, 0x08, 0x01, 0x0f, 0x48, 0x80, 0x56,
-	0xe2, 0x81, 0x07, 0x9e, 0x10, 0x0f, 0x3c, 0x20, 0xf1, 0xc0, 0x0a, 0x81, 0x04, 0x23, 0x04, 0x0f,
-	0x48, 0xbc, 0xa3, 0x73, 0xee, 0x4f, 0xdc, 0x1b, 0x11, 0x59, 0xae, 0x9e, 0x19, 0xf6, 0xc9, 0x79,
-	0xbf, 0x7b, 0xe3, 0xfe, 0x9e, 0x73, 0xee, 0x39, 0xe7, 0x9e, 0x7b, 0x0d, 0xcd, 0x78, 0x32, 0xd8,
-	0x98, 0xc4, 0x51, 0x1a, 0xb1, 0xfa, 0x28, 0x8c, 0x27, 0x83, 0xde, 0xf5, 0x93, 0x28, 0x3a, 0x19,
-	0xf1, 0xbb, 0xfe, 0x24, 0xb8, 0xeb, 0x87, 0x61, 0x94, 0xfa, 0x69, 0x10, 0x85, 0x89, 0x28, 0xe4,
-	0xfe, 0x18, 0x16, 0x1f, 0xf3, 0xf0, 0x90, 0xf3, 0xa1, 0xc7, 0x7f, 0x3a, 0xe5, 0x49, 0xca, 0xbe,
-	0x01, 0xcb, 0x3e, 0xff, 0x19, 0xe7, 0xc3, 0xfe, 0xc4, 0x4f, 0x92, 0xc9, 0x69, 0xec, 0x27, 0xbc,
-	0xeb, 0xdc, 0x74, 0x6e, 0xb7, 0xbd, 0x8e, 0xc8, 0x38, 0xd0, 0x38, 0x7b, 0x17, 0xda, 0x09, 0x16,
-	0xe5, 0x61, 0x1a, 0x47, 0x93, 0x8b, 0x6e, 0x85, 0xca, 0xb5, 0x10, 0xdb, 0x11, 0x90, 0x3b, 0x82,
-	0x25, 0xdd, 0x42, 0x32, 0x89, 0xc2, 0x84, 0xb3, 0x7b, 0xb0, 0x3a, 0x08, 0x26, 0xa7, 0x3c, 0xee,
-	0xd3, 0xc7, 0xe3, 0x90, 0x8f, 0xa3, 0x30, 0x18, 0x74, 0x9d, 0x9b, 0xd5, 0xdb, 0x4d, 0x8f, 0x89,
-	0x3c, 0xfc, 0xe2, 0x33, 0x99, 0xc3, 0xde, 0x87, 0x25, 0x1e, 0x0a, 0x9c, 0x0f, 0xe9, 0x2b, 0xd9,
-	0xd4, 0x62, 0x06, 0xe3, 0x07, 0xee, 0x5f, 0xad, 0xc0, 0xf2, 0x93, 0x30, 0x48, 0x5f, 0xf8, 0xa3,
-	0x11, 0x4f, 0xd5, 0x98, 0xde, 0x87, 0xa5, 0x73, 0x02, 0x68, 0x4c, 0xe7, 0x51, 0x3c, 0x94, 0x23,
-	0x5a, 0x14, 0xf0, 0x81, 0x44, 0x67, 0xf6, 0xac, 0x32, 0xb3, 0x67, 0xa5, 0xd3, 0x55, 0x9d, 0x31,
-	0x5d, 0xef, 0xc3, 0x52, 0xcc, 0x07, 0xd1, 0x19, 0x8f, 0x2f, 0xfa, 0xe7, 0x41, 0x38, 0x8c, 0xce,
-	0xbb, 0xb5, 0x9b, 0xce, 0xed, 0xba, 0xb7, 0xa8, 0xe0, 0x17, 0x84, 0xb2, 0x87, 0xb0, 0x34, 0x38,
-	0xf5, 0xc3, 0x90, 0x8f, 0xfa, 0x47, 0xfe, 0xe0, 0xe5, 0x74, 0x92, 0x74, 0xeb, 0x37, 0x9d, 0xdb,
-	0xad, 0xfb, 0xd7, 0x36, 0x68, 0x55, 0x37, 0xb6, 0x4e, 0xfd, 0xf0, 0x21, 0xe5, 0x1c, 0x86, 0xfe,
-	0x24, 0x39, 0x8d, 0x52, 0x6f, 0x51, 0x7e, 0x21, 0xe0, 0xc4, 0x5d, 0x05, 0x66, 0xce, 0x84, 0x98,
-	0x7b, 0xf7, 0x1f, 0x39, 0xb0, 0xf2, 0x3c, 0x1c, 0x45, 0x83, 0x97, 0xbf, 0xe4, 0x14, 0x95, 0x8c,
-	0xa1, 0xf2, 0xa6, 0x63, 0xa8, 0x7e, 0xd5, 0x31, 0xac, 0xc1, 0xaa, 0xdd, 0x59, 0x39, 0x0a, 0x0e,
-	0x57, 0xf1, 0xeb, 0x13, 0xae, 0xba, 0xa5, 0x86, 0xf1, 0x75, 0xe8, 0x0c, 0xa6, 0x71, 0xcc, 0xc3,
-	0xc2, 0x38, 0x96, 0x24, 0xae, 0x07, 0xf2, 0x2e, 0xb4, 0x43, 0x7e, 0x9e, 0x15, 0x93, 0xb4, 0x1b,
-	0xf2, 0x73, 0x55, 0xc4, 0xed, 0xc2, 0x5a, 0xbe, 0x19, 0xd9, 0x81, 0xff, 0xe2, 0x40, 0xed, 0x79,
-	0xfa, 0x2a, 0x62, 0x1b, 0x50, 0x4b, 0x2f, 0x26, 0x82, 0x43, 0x16, 0xef, 0x33, 0x39, 0xb4, 0xcd,
-	0xe1, 0x30, 0xe6, 0x49, 0xf2, 0xec, 0x62, 0xc2, 0xbd, 0xb6, 0x2f, 0x12, 0x7d, 0x2c, 0xc7, 0xba,
-	0x30, 0x2f, 0xd3, 0xd4, 0x60, 0xd3, 0x53, 0x49, 0x76, 0x03, 0xc0, 0x1f, 0x47, 0xd3, 0x30, 0xed,
-	0x27, 0x7e, 0x4a, 0x53, 0x55, 0xf5, 0x0c, 0x84, 0x5d, 0x87, 0xe6, 0xe4, 0x65, 0x3f, 0x19, 0xc4,
-	0xc1, 0x24, 0x25, 0xb2, 0x69, 0x7a, 0x19, 0xc0, 0xbe, 0x01, 0x8d, 0x68, 0x9a, 0x4e, 0xa2, 0x20,
-	0x4c, 0x25, 0xa9, 0x2c, 0xc9, 0xbe, 0x3c, 0x9d, 0xa6, 0x07, 0x08, 0x7b, 0xba, 0x00, 0xbb, 0x05,
-	0x0b, 0x83, 0x28, 0x3c, 0x0e, 0xe2, 0xb1, 0x10, 0x06, 0xdd, 0x39, 0x6a, 0xcd, 0x06, 0xdd, 0x7f,
-	0x59, 0x81, 0xd6, 0xb3, 0xd8, 0x0f, 0x13, 0x7f, 0x80, 0x00, 0x76, 0x3d, 0x7d, 0xd5, 0x3f, 0xf5,
-	0x93, 0x53, 0x1a, 0x6d, 0xd3, 0x53, 0x49, 0xb6, 0x06, 0x73, 0xa2, 0xa3, 0x34, 0xa6, 0xaa, 0x27,
-	0x53, 0xec, 0x03, 0x58, 0x0e, 0xa7, 0xe3, 0xbe, 0xdd, 0x56, 0x95, 0xa8, 0xa5, 0x98, 0x81, 0x13,
-	0x70, 0x84, 0x6b, 0x2d, 0x9a, 0x10, 0x23, 0x34, 0x10, 0xe6, 0x42, 0x5b, 0xa6, 0x78, 0x70, 0x72,
-	0x2a, 0x86, 0x59, 0xf7, 0x2c, 0x0c, 0xeb, 0x48, 0x83, 0x31, 0xef, 0x27, 0xa9, 0x3f, 0x9e, 0xc8,
-	0x61, 0x19, 0x08, 0xe5, 0x47, 0xa9, 0x3f, 0xea, 0x1f, 0x73, 0x9e, 0x74, 0xe7, 0x65, 0xbe, 0x46,
-	0xd8, 0x7b, 0xb0, 0x38, 0xe4, 0x49, 0xda, 0x97, 0x8b, 0xc2, 0x93, 0x6e, 0x83, 0x58, 0x3f, 0x87,
-	0x62, 0x3d, 0xb1, 0x7f, 0xde, 0xc7, 0x09, 0xe0, 0xaf, 0xba, 0x4d, 0xd1, 0xd7, 0x0c, 0x41, 0xca,
-	0x79, 0xcc, 0x53, 0x63, 0xf6, 0x12, 0x49, 0xa1, 0xee, 0x1e, 0x30, 0x03, 0xde, 0xe6, 0xa9, 0x1f,
-	0x8c, 0x12, 0xf6, 0x11, 0xb4, 0x53, 0xa3, 0x30, 0x89, 0xc2, 0x96, 0x26, 0x27, 0xe3, 0x03, 0xcf,
-	0x2a, 0xe7, 0x3e, 0x86, 0xc6, 0x23, 0xce, 0xf7, 0x82, 0x71, 0x90, 0xb2, 0x35, 0xa8, 0x1f, 0x07,
-	0xaf, 0xb8, 0x20, 0xf8, 0xea, 0xee, 0x15, 0x4f, 0x24, 0x59, 0x0f, 0xe6, 0x27, 0x3c, 0x1e, 0x70,
-	0xb5, 0x3c, 0xbb, 0x57, 0x3c, 0x05, 0x3c, 0x9c, 0x87, 0xfa, 0x08, 0x3f, 0x76, 0xff, 0x57, 0x15,
-	0x5a, 0x87, 0x3c, 0xd4, 0x8c, 0xc4, 0xa0, 0x86, 0x43, 0x96, 0xcc, 0x43, 0xbf, 0xd9, 0x3b, 0xd0,
-	0xa2, 0x69, 0x48, 0xd2, 0x38, 0x08, 0x4f, 0x24, 0xfd, 0x02, 0x42, 0x87, 0x84, 0xb0, 0x0e, 0x54,
-	0xfd, 0xb1, 0xa2, 0x5d, 0xfc, 0x89, 0x4c, 0x36, 0xf1, 0x2f, 0xc6, 0xc8, 0x8f, 0x7a, 0x55, 0xdb,
-	0x5e, 0x4b, 0x62, 0xbb, 0xb8, 0xac, 0x1b, 0xb0, 0x62, 0x16, 0x51, 0xb5, 0xd7, 0xa9, 0xf6, 0x65,
-	0xa3, 0xa4, 0x6c, 0xe4, 0x7d, 0x58, 0x52, 0xe5, 0x63, 0xd1, 0x59, 0x5a, 0xe7, 0xa6, 0xb7, 0x28,
-	0x61, 0x35, 0x84, 0xdb, 0xd0, 0x39, 0x0e, 0x42, 0x7f, 0xd4, 0x1f, 0x8c, 0xd2, 0xb3, 0xfe, 0x90,
-	0x8f, 0x52, 0x9f, 0x56, 0xbc, 0xee, 0x2d, 0x12, 0xbe, 0x35, 0x4a, 0xcf, 0xb6, 0x11, 0x65, 0x1f,
-	0x40, 0xf3, 0x98, 0xf3, 0x3e, 0xcd, 0x44, 0xb7, 0x61, 0x71, 0x8f, 0x9a, 0x5d, 0xaf, 0x71, 0xac,
-	0xe6, 0xf9, 0x03, 0xe8, 0x44, 0xd3, 0xf4, 0x24, 0x0a, 0xc2, 0x93, 0x3e, 0xca, 0xab, 0x7e, 0x30,
-	0x24, 0x0a, 0xa8, 0x3d, 0xac, 0xdc, 0x73, 0xbc, 0x45, 0x95, 0x87, 0x92, 0xe3, 0xc9, 0x90, 0xbd,
-	0x0d, 0x40, 0xed, 0x8b, 0xca, 0xe1, 0xa6, 0x73, 0x7b, 0xc1, 0x6b, 0x22, 0x22, 0x2a, 0xfb, 0x04,
-	0x1a, 0x34, 0xa7, 0xe9, 0xe8, 0xac, 0xdb, 0xa2, 0x45, 0x7f, 0x47, 0xb6, 0x6c, 0xac, 0xc6, 0xc6,
-	0x36, 0x4f, 0xd2, 0x67, 0xa3, 0x33, 0xdc, 0x53, 0x2f, 0xbc, 0xf9, 0xa1, 0x48, 0xf5, 0x3e, 0x81,
-	0xb6, 0x99, 0x81, 0xd3, 0xff, 0x92, 0x5f, 0xd0, 0x92, 0xd5, 0x3c, 0xfc, 0xc9, 0x56, 0xa1, 0x7e,
-	0xe6, 0x8f, 0xa6, 0x5c, 0x0a, 0x37, 0x91, 0xf8, 0xa4, 0xf2, 0xb1, 0xe3, 0xfe, 0x0b, 0x07, 0xda,
-	0xa2, 0x05, 0xb9, 0x29, 0xdf, 0x82, 0x05, 0x35, 0xad, 0x3c, 0x8e, 0xa3, 0x58, 0xf2, 0xb8, 0x0d,
-	0xb2, 0x3b, 0xd0, 0x51, 0xc0, 0x24, 0xe6, 0xc1, 0xd8, 0x3f, 0x51, 0x75, 0x17, 0x70, 0x76, 0x3f,
-	0xab, 0x31, 0x8e, 0xa6, 0x29, 0x97, 0xe2, 0xbf, 0x2d, 0xc7, 0xe7, 0x21, 0xe6, 0xd9, 0x45, 0x90,
-	0xc7, 0x4b, 0xe8, 0xc5, 0xc2, 0xdc, 0x2f, 0x1d, 0x60, 0xd8, 0xf5, 0x67, 0x91, 0xa8, 0x42, 0x2e,
-	0x77, 0x9e, 0xd4, 0x9c, 0x37, 0x26, 0xb5, 0xca, 0x2c, 0x52, 0x73, 0xa1, 0x2e, 0x7a, 0x5e, 0x2b,
-	0xe9, 0xb9, 0xc8, 0xfa, 0x6e, 0xad, 0x51, 0xed, 0xd4, 0xdc, 0xff, 0x54, 0x85, 0xd5, 0x2d, 0xb1,
-	0x77, 0x6d, 0x0e, 0x06, 0x7c, 0xa2, 0x89, 0xf0, 0x1d, 0x68, 0x85, 0xd1, 0x90, 0xf7, 0x27, 0xd3,
-	0x23, 0xb5, 0x36, 0x6d, 0x0f, 0x10, 0x3a, 0x20, 0x84, 0xe8, 0xe3, 0xd4, 0x0f, 0x42, 0xd1, 0x69,
-	0x31, 0x97, 0x4d, 0x42, 0xa8, 0xcb, 0xef, 0xc1, 0xd2, 0x84, 0x87, 0x43, 0x93, 0xd6, 0x84, 0x76,
-	0xb1, 0x20, 0x61, 0x49, 0x66, 0xef, 0x40, 0xeb, 0x78, 0x2a, 0xca, 0x21, 0x0b, 0xd6, 0x88, 0x06,
-	0x40, 0x42, 0x9b, 0xe3, 0x94, 0x5d, 0x83, 0xc6, 0x64, 0x9a, 0x9c, 0x52, 0x6e, 0x9d, 0x72, 0xe7,
-	0x31, 0x8d, 0x59, 0x6f, 0x03, 0x0c, 0xa7, 0x49, 0x2a, 0x49, 0x74, 0x8e, 0x32, 0x9b, 0x88, 0x08,
-	0x12, 0xfd, 0x26, 0xac, 0x8c, 0xfd, 0x57, 0x7d, 0xa2, 0x9d, 0x7e, 0x10, 0xf6, 0x8f, 0x47, 0x24,
-	0x7e, 0xe7, 0xa9, 0x5c, 0x67, 0xec, 0xbf, 0xfa, 0x3e, 0xe6, 0x3c, 0x09, 0x1f, 0x11, 0x8e, 0xfc,
-	0xa9, 0xf6, 0xfd, 0x98, 0x27, 0x3c, 0x3e, 0xe3, 0xc4, 0x52, 0x35, 0xbd, 0xb9, 0x7b, 0x02, 0xc5,
-	0x1e, 0x8d, 0x71, 0xdc, 0xe9, 0x68, 0x20, 0xf8, 0xc7, 0x9b, 0x1f, 0x07, 0xe1, 0x6e, 0x3a, 0x1a,
-	0xb0, 0xeb, 0x00, 0xc8, 0x90, 0x13, 0x1e, 0xf7, 0x5f, 0x9e, 0x13, 0xd3, 0xd4, 0x88, 0x01, 0x0f,
-	0x78, 0xfc, 0xe9, 0x39, 0x7b, 0x0b, 0x9a, 0x83, 0x84, 0x38, 0xda, 0xbf, 0xe8, 0xb6, 0x88, 0xa3,
-	0x1a, 0x83, 0x04, 0x79, 0xd9, 0xbf, 0x60, 0x1f, 0x00, 0xc3, 0xde, 0xfa, 0xb4, 0x0a, 0x7c, 0x48,
-	0xd5, 0x27, 0xdd, 0x36, 0x95, 0xc2, 0xce, 0x6e, 0xca, 0x0c, 0x6c, 0x27, 0x61, 0x5f, 0x83, 0x05,
-	0xd5, 0xd9, 0xe3, 0x91, 0x7f, 0x92, 0x74, 0x17, 0xa8, 0x60, 0x5b, 0x82, 0x8f, 0x10, 0x73, 0x5f,
-	0x08, 0x6d, 0xc3, 0x58, 0x5b, 0xc9, 0x33, 0xb8, 0xef, 0x11, 0x42, 0xeb, 0xda, 0xf0, 0x64, 0xaa,
-	0x6c, 0xd1, 0x2a, 0x25, 0x8b, 0xe6, 0xfe, 0xdc, 0x81, 0xb6, 0xac, 0x99, 0xb6, 0x68, 0x76, 0x0f,
-	0x98, 0x5a, 0xc5, 0xf4, 0x55, 0x30, 0xec, 0x1f, 0x5d, 0xa4, 0x3c, 0x11, 0x44, 0xb3, 0x7b, 0xc5,
-	0x2b, 0xc9, 0x43, 0x61, 0x64, 0xa1, 0x49, 0x1a, 0x0b, 0x7a, 0xde, 0xbd, 0xe2, 0x15, 0x72, 0x90,
-	0xbd, 0x50, 0x09, 0x98, 0xa6, 0xfd, 0x20, 0x1c, 0xf2, 0x57, 0x44, 0x4a, 0x0b, 0x9e, 0x85, 0x3d,
-	0x5c, 0x84, 0xb6, 0xf9, 0x9d, 0xfb, 0x13, 0x68, 0x28, 0x15, 0x82, 0xb6, 0xcf, 0x5c, 0xbf, 0x3c,
-	0x03, 0x61, 0x3d, 0x68, 0xd8, 0xbd, 0xf0, 0x1a, 0x5f, 0xa5, 0x6d, 0xf7, 0xcf, 0x40, 0x67, 0x0f,
-	0x89, 0x28, 0x44, 0xa2, 0x95, 0x7a, 0xd1, 0x1a, 0xcc, 0x19, 0xcc, 0xd3, 0xf4, 0x64, 0x0a, 0x77,
-	0xa8, 0xd3, 0x28, 0x49, 0x65, 0x3b, 0xf4, 0xdb, 0xfd, 0xb7, 0x0e, 0xb0, 0x9d, 0x24, 0x0d, 0xc6,
-	0x7e, 0xca, 0x1f, 0x71, 0x2d, 0x1a, 0x9e, 0x42, 0x1b, 0x6b, 0x7b, 0x16, 0x6d, 0x0a, 0x2d, 0x45,
-	0xec, 0xae, 0xdf, 0x90, 0xec, 0x5c, 0xfc, 0x60, 0xc3, 0x2c, 0x2d, 0x84, 0xae, 0x55, 0x01, 0x72,
-	0x5b, 0xea, 0xc7, 0x27, 0x3c, 0x25, 0x15, 0x46, 0x2a, 0xc0, 0x20, 0xa0, 0xad, 0x28, 0x3c, 0xee,
-	0xfd, 0x36, 0x2c, 0x17, 0xea, 0x30, 0xe5, 0x73, 0xb3, 0x44, 0x3e, 0x57, 0x4d, 0xf9, 0x3c, 0x80,
-	0x15, 0xab, 0x5f, 0x92, 0xe2, 0xba, 0x30, 0x8f, 0x8c, 0x81, 0x1a, 0x22, 0xed, 0xf2, 0x9e, 0x4a,
-	0xb2, 0xfb, 0xb0, 0x7a, 0xcc, 0x79, 0xec, 0xa7, 0x94, 0x24, 0xd6, 0xc1, 0x35, 0x91, 0x35, 0x97,
-	0xe6, 0xb9, 0xff, 0xd5, 0x81, 0x25, 0x94, 0xa4, 0x9f, 0xf9, 0xe1, 0x85, 0x9a, 0xab, 0xbd, 0xd2,
-	0xb9, 0xba, 0x6d, 0x6c, 0x4a, 0x46, 0xe9, 0xaf, 0x3a, 0x51, 0xd5, 0xfc, 0x44, 0xb1, 0x9b, 0xd0,
-	0xb6, 0xba, 0x5b, 0x17, 0x2a, 0x59, 0xe2, 0xa7, 0x07, 0x3c, 0x7e, 0x78, 0x91, 0xf2, 0x5f, 0x7d,
-	0x2a, 0xdf, 0x83, 0x4e, 0xd6, 0x6d, 0x39, 0x8f, 0x0c, 0x6a, 0x48, 0x98, 0xb2, 0x02, 0xfa, 0xed,
-	0xfe, 0x5d, 0x47, 0x14, 0xdc, 0x8a, 0x02, 0xad, 0xae, 0x61, 0x41, 0xd4, 0xfa, 0x54, 0x41, 0xfc,
-	0x3d, 0x53, 0xdd, 0xfd, 0xd5, 0x07, 0x8b, 0x32, 0x31, 0xe1, 0xe1, 0xb0, 0xef, 0x8f, 0x46, 0x24,
-	0x88, 0x1b, 0xde, 0x3c, 0xa6, 0x37, 0x47, 0x23, 0xf7, 0x7d, 0x58, 0x36, 0x7a, 0xf7, 0x9a, 0x71,
-	0xec, 0x03, 0xdb, 0x0b, 0x92, 0xf4, 0x79, 0x98, 0x4c, 0x0c, 0x6d, 0xe8, 0x2d, 0x68, 0xa2, 0xb4,
-	0xc5, 0x9e, 0x09, 0xce, 0xad, 0x7b, 0x28, 0x7e, 0xb1, 0x5f, 0x09, 0x65, 0xfa, 0xaf, 0x64, 0x66,
-	0x45, 0x66, 0xfa, 0xaf, 0x28, 0xd3, 0xfd, 0x18, 0x56, 0xac, 0xfa, 0x64, 0xd3, 0xef, 0x42, 0x7d,
-	0x9a, 0xbe, 0x8a, 0x94, 0xae, 0xda, 0x92, 0x14, 0x82, 0x56, 0x91, 0x27, 0x72, 0xdc, 0x07, 0xb0,
-	0xbc, 0xcf, 0xcf, 0x25, 0x23, 0xab, 0x8e, 0xbc, 0x77, 0xa9, 0xc5, 0x44, 0xf9, 0xee, 0x06, 0x30,
-	0xf3, 0xe3, 0x8c, 0x01, 0x94, 0xfd, 0xe4, 0x58, 0xf6, 0x93, 0xfb, 0x1e, 0xb0, 0xc3, 0xe0, 0x24,
-	0xfc, 0x8c, 0x27, 0x89, 0x7f, 0xa2, 0x59, 0xbf, 0x03, 0xd5, 0x71, 0x72, 0x22, 0x45, 0x15, 0xfe,
-	0x74, 0xbf, 0x05, 0x2b, 0x56, 0x39, 0x59, 0xf1, 0x75, 0x68, 0x26, 0xc1, 0x49, 0xe8, 0xa7, 0xd3,
-	0x98, 0xcb, 0xaa, 0x33, 0xc0, 0x7d, 0x04, 0xab, 0xdf, 0xe7, 0x71, 0x70, 0x7c, 0x71, 0x59, 0xf5,
-	0x76, 0x3d, 0x95, 0x7c, 0x3d, 0x3b, 0x70, 0x35, 0x57, 0x8f, 0x6c, 0x5e, 0x90, 0xaf, 0x5c, 0xc9,
-	0x86, 0x27, 0x12, 0x86, 0xec, 0xab, 0x98, 0xb2, 0xcf, 0x7d, 0x0e, 0x6c, 0x2b, 0x0a, 0x43, 0x3e,
-	0x48, 0x0f, 0x38, 0x8f, 0x33, 0xd7, 0x4d, 0x46, 0xab, 0xad, 0xfb, 0xeb, 0x72, 0x66, 0xf3, 0x02,
-	0x55, 0x12, 0x31, 0x83, 0xda, 0x84, 0xc7, 0x63, 0xaa, 0xb8, 0xe1, 0xd1, 0x6f, 0xf7, 0x2a, 0xac,
-	0x58, 0xd5, 0x4a, 0x63, 0xf7, 0x43, 0xb8, 0xba, 0x1d, 0x24, 0x83, 0x62, 0x83, 0x5d, 0x98, 0x9f,
-	0x4c, 0x8f, 0xfa, 0x19, 0x27, 0xaa, 0x24, 0xda, 0x3f, 0xf9, 0x4f, 0x64, 0x65, 0x7f, 0xc5, 0x81,
-	0xda, 0xee, 0xb3, 0xbd, 0x2d, 0xdc, 0x2b, 0x82, 0x70, 0x10, 0x8d, 0x51, 0x03, 0x13, 0x83, 0xd6,
-	0xe9, 0x99, 0x1c, 0x76, 0x1d, 0x9a, 0xa4, 0xb8, 0xa1, 0xc9, 0x27, 0xf5, 0xa0, 0x0c, 0x40, 0x73,
-	0x93, 0xbf, 0x9a, 0x04, 0x31, 0xd9, 0x93, 0xca, 0x4a, 0xac, 0xd1, 0x36, 0x53, 0xcc, 0x70, 0xff,
-	0xf7, 0x1c, 0xcc, 0xcb, 0xcd, 0x57, 0x6c, 0xe4, 0x69, 0x70, 0xc6, 0xb3, 0x8d, 0x1c, 0x53, 0xa8,
-	0x14, 0xc7, 0x7c, 0x1c, 0xa5, 0x5a, 0x7f, 0x13, 0xcb, 0x60, 0x83, 0x64, 0x4e, 0x4b, 0x25, 0x42,
-	0x18, 0xe0, 0x55, 0x51, 0xca, 0x02, 0xd9, 0x75, 0x98, 0x57, 0xca, 0x40, 0x4d, 0x5b, 0x0b, 0x0a,
-	0xc2, 0xd9, 0x18, 0xf8, 0x13, 0x7f, 0x10, 0xa4, 0x17, 0x52, 0x2c, 0xe8, 0x34, 0xd6, 0x3f, 0x8a,
-	0x06, 0xfe, 0xa8, 0x7f, 0xe4, 0x8f, 0xfc, 0x70, 0xc0, 0x95, 0xb9, 0x6e, 0x81, 0x68, 0xba, 0xca,
-	0x6e, 0xa9, 0x62, 0xc2, 0xbc, 0xcd, 0xa1, 0xb8, 0x87, 0x0f, 0xa2, 0xf1, 0x38, 0x48, 0xd1, 0xe2,
-	0x25, 0xd5, 0xac, 0xea, 0x19, 0x88, 0x70, 0x0e, 0x50, 0xea, 0x5c, 0xcc, 0x60, 0x53, 0x39, 0x07,
-	0x0c, 0x10, 0x6b, 0xc9, 0x69, 0x68, 0x55, 0xcf, 0x40, 0x70, 0x2d, 0xa6, 0x61, 0xc2, 0xd3, 0x74,
-	0xc4, 0x87, 0xba, 0x43, 0x2d, 0x2a, 0x56, 0xcc, 0x60, 0xf7, 0x60, 0x45, 0x18, 0xe1, 0x89, 0x9f,
-	0x46, 0xc9, 0x69, 0x90, 0xf4, 0x13, 0x34, 0x57, 0xdb, 0x54, 0xbe, 0x2c, 0x8b, 0x7d, 0x0c, 0xeb,
-	0x39, 0x38, 0xe6, 0x03, 0x1e, 0x9c, 0xf1, 0x21, 0xa9, 0x70, 0x55, 0x6f, 0x56, 0x36, 0xbb, 0x09,
-	0xad, 0x70, 0x3a, 0xee, 0x4f, 0x27, 0x43, 0x1f, 0x95, 0x98, 0x45, 0x52, 0x2e, 0x4d, 0x88, 0x7d,
-	0x08, 0x4a, 0x4f, 0x93, 0xda, 0xe3, 0x92, 0x25, 0xe1, 0x90, 0x7a, 0x3d, 0xbb, 0x04, 0x12, 0x66,
-	0xa6, 0x92, 0x76, 0xa4, 0x91, 0xa7, 0x00, 0xe2, 0x93, 0x38, 0x38, 0xf3, 0x53, 0xde, 0x5d, 0x16,
-	0x42, 0x5d, 0x26, 0xf1, 0xbb, 0x20, 0x0c, 0xd2, 0xc0, 0x4f, 0xa3, 0xb8, 0xcb, 0x28, 0x2f, 0x03,
-	0x70, 0x12, 0x89, 0x3e, 0x92, 0xd4, 0x4f, 0xa7, 0x89, 0xd4, 0x50, 0x57, 0x84, 0xb5, 0x52, 0xc8,
-	0x60, 0x1f, 0xc1, 0x9a, 0xa0, 0x08, 0xca, 0x92, 0xba, 0x37, 0xa9, 0x0a, 0xab, 0x34, 0x23, 0x33,
-	0x72, 0x71, 0x2a, 0x25, 0x89, 0x14, 0x3e, 0xbc, 0x2a, 0xa6, 0x72, 0x46, 0x36, 0xf6, 0x0f, 0x7b,
-	0x10, 0x0c, 0xfa, 0xb2, 0x04, 0xb2, 0xc8, 0x1a, 0x8d, 0xa2, 0x98, 0xe1, 0xfe, 0x9e, 0x23, 0x36,
-	0x12, 0xc9, 0x74, 0x89, 0x61, 0x22, 0x09, 0x76, 0xeb, 0x47, 0xe1, 0xe8, 0x42, 0x72, 0x20, 0x08,
-	0xe8, 0x69, 0x38, 0xba, 0x40, 0x25, 0x3d, 0x08, 0xcd, 0x22, 0x42, 0x66, 0xb5, 0x15, 0x48, 0x85,
-	0xde, 0x81, 0xd6, 0x64, 0x7a, 0x34, 0x0a, 0x06, 0xa2, 0x48, 0x55, 0xd4, 0x22, 0x20, 0x2a, 0x80,
-	0xf6, 0xa1, 0x98, 0x75, 0x51, 0xa2, 0x46, 0x25, 0x5a, 0x12, 0xc3, 0x22, 0xee, 0x43, 0x58, 0xb5,
-	0x3b, 0x28, 0x85, 0xf3, 0x1d, 0x68, 0x48, 0x5e, 0x4e, 0xa4, 0x91, 0xbe, 0x68, 0xf8, 0x30, 0xd1,
-	0xa4, 0xd1, 0xf9, 0xee, 0xbf, 0xaa, 0xc1, 0x8a, 0x44, 0xb7, 0x46, 0x51, 0xc2, 0x0f, 0xa7, 0xe3,
-	0xb1, 0x1f, 0x97, 0x08, 0x09, 0xe7, 0x12, 0x21, 0x51, 0x29, 0x0a, 0x89, 0x1b, 0x96, 0xad, 0x28,
-	0xa4, 0x8c, 0x81, 0xb0, 0xdb, 0xb0, 0x34, 0x18, 0x45, 0x89, 0x50, 0xdd, 0x4d, 0x37, 0x5a, 0x1e,
-	0x2e, 0x0a, 0xb6, 0x7a, 0x99, 0x60, 0x33, 0x85, 0xd2, 0x5c, 0x4e, 0x28, 0xb9, 0xd0, 0xc6, 0x4a,
-	0xb9, 0x92, 0xb3, 0xf3, 0xd2, 0x70, 0x32, 0x30, 0xec, 0x4f, 0x5e, 0x04, 0x08, 0x79, 0xb3, 0x54,
-	0x26, 0x00, 0x82, 0x31, 0x27, 0x39, 0x6e, 0x94, 0x6e, 0x4a, 0x01, 0x50, 0xcc, 0x62, 0x8f, 0x00,
-	0x44, 0x5b, 0xa4, 0x4c, 0x00, 0x29, 0x13, 0xef, 0xd9, 0xab, 0x62, 0xce, 0xff, 0x06, 0x26, 0xa6,
-	0x31, 0x27, 0x05, 0xc3, 0xf8, 0xd2, 0xfd, 0x6b, 0x0e, 0xb4, 0x8c, 0x3c, 0x76, 0x15, 0x96, 0xb7,
-	0x9e, 0x3e, 0x3d, 0xd8, 0xf1, 0x36, 0x9f, 0x3d, 0xf9, 0xfe, 0x4e, 0x7f, 0x6b, 0xef, 0xe9, 0xe1,
-	0x4e, 0xe7, 0x0a, 0xc2, 0x7b, 0x4f, 0xb7, 0x36, 0xf7, 0xfa, 0x8f, 0x9e, 0x7a, 0x5b, 0x0a, 0x76,
-	0xd8, 0x1a, 0x30, 0x6f, 0xe7, 0xb3, 0xa7, 0xcf, 0x76, 0x2c, 0xbc, 0xc2, 0x3a, 0xd0, 0x7e, 0xe8,
-	0xed, 0x6c, 0x6e, 0xed, 0x4a, 0xa4, 0xca, 0x56, 0xa1, 0xf3, 0xe8, 0xf9, 0xfe, 0xf6, 0x93, 0xfd,
-	0xc7, 0xfd, 0xad, 0xcd, 0xfd, 0xad, 0x9d, 0xbd, 0x9d, 0xed, 0x4e, 0x8d, 0x2d, 0x40, 0x73, 0xf3,
-	0xe1, 0xe6, 0xfe, 0xf6, 0xd3, 0xfd, 0x9d, 0xed, 0x4e, 0xdd, 0xfd, 0xcf, 0x0e, 0x5c, 0xa5, 0x5e,
-	0x0f, 0xf3, 0x4c, 0x72, 0x13, 0x5a, 0x83, 0x28, 0x9a, 0xa0, 0x12, 0x9f, 0x6d, 0x53, 0x26, 0x84,
-	0x0c, 0x20, 0x18, 0xfc, 0x38, 0x8a, 0x07, 0x5c, 0xf2, 0x08, 0x10, 0xf4, 0x08, 0x11, 0x64, 0x00,
-	0xb9, 0xbc, 0xa2, 0x84, 0x60, 0x91, 0x96, 0xc0, 0x44, 0x91, 0x35, 0x98, 0x3b, 0x8a, 0xb9, 0x3f,
-	0x38, 0x95, 0xdc, 0x21, 0x53, 0xec, 0xeb, 0x99, 0x95, 0x39, 0xc0, 0xd9, 0x1f, 0xf1, 0x21, 0x51,
-	0x4c, 0xc3, 0x5b, 0x92, 0xf8, 0x96, 0x84, 0x51, 0xa2, 0xf9, 0x47, 0x7e, 0x38, 0x8c, 0x42, 0x3e,
-	0x94, 0x2a, 0x6c, 0x06, 0xb8, 0x07, 0xb0, 0x96, 0x1f, 0x9f, 0xe4, 0xb1, 0x8f, 0x0c, 0x1e, 0x13,
-	0x1a, 0x65, 0x6f, 0xf6, 0x6a, 0x1a, 0xfc, 0xf6, 0xc7, 0x15, 0xa8, 0xa1, 0x82, 0x31, 0x5b, 0x19,
-	0x31, 0x75, 0xc6, 0x6a, 0xc1, 0xe7, 0x4e, 0x86, 0xab, 0xd8, 0x6e, 0xa4, 0xd3, 0x24, 0x43, 0xb2,
-	0xfc, 0x98, 0x0f, 0xce, 0xa4, 0xdb, 0xc4, 0x40, 0x90, 0x41, 0x50, 0xa1, 0xa7, 0xaf, 0x25, 0x83,
-	0xa8, 0xb4, 0xca, 0xa3, 0x2f, 0xe7, 0xb3, 0x3c, 0xfa, 0xae, 0x0b, 0xf3, 0x41, 0x78, 0x14, 0x4d,
-	0xc3, 0x21, 0x31, 0x44, 0xc3, 0x53, 0x49, 0xf2, 0xf2, 0x13, 0xa3, 0x06, 0x63, 0x45, 0xfe, 0x19,
-	0xc0, 0xee, 0x43, 0x33, 0xb9, 0x08, 0x07, 0x26, 0xcd, 0xaf, 0xca, 0x59, 0xc2, 0x39, 0xd8, 0x38,
-	0xbc, 0x08, 0x07, 0x44, 0xe1, 0x59, 0x31, 0xf7, 0xb7, 0xa1, 0xa1, 0x60, 0x24, 0xcb, 0xe7, 0xfb,
-	0x9f, 0xee, 0x3f, 0x7d, 0xb1, 0xdf, 0x3f, 0xfc, 0xc1, 0xfe, 0x56, 0xe7, 0x0a, 0x5b, 0x82, 0xd6,
-	0xe6, 0x16, 0x51, 0x3a, 0x01, 0x0e, 0x16, 0x39, 0xd8, 0x3c, 0x3c, 0xd4, 0x48, 0xc5, 0x65, 0x68,
-	0x94, 0x27, 0xa4, 0xc5, 0x69, 0x2f, 0xf6, 0x47, 0xb0, 0x6c, 0x60, 0x99, 0x45, 0x30, 0x41, 0x20,
-	0x67, 0x11, 0x90, 0xfa, 0x27, 0x72, 0xdc, 0x0e, 0x2c, 0x3e, 0xe6, 0xe9, 0x93, 0xf0, 0x38, 0x52,
-	0x35, 0xfd, 0xf7, 0x1a, 0x2c, 0x69, 0x48, 0x56, 0x74, 0x1b, 0x96, 0x82, 0x21, 0x0f, 0xd3, 0x20,
-	0xbd, 0xe8, 0x5b, 0xb6, 0x7f, 0x1e, 0x46, 0xb5, 0xd9, 0x1f, 0x05, 0xbe, 0x3a, 0x4c, 0x11, 0x09,
-	0xb4, 0x85, 0x71, 0x3f, 0x37, 0x7d, 0x30, 0x44, 0x57, 0xc2, 0xe5, 0x50, 0x9a, 0x87, 0x12, 0x08,
-	0x71, 0xb9, 0xcd, 0xe8, 0x4f, 0x84, 0xfa, 0x58, 0x96, 0x85, 0x4b, 0x25, 0x6a, 0xc2, 0x21, 0xd7,
-	0xc5, 0x9e, 0xaf, 0x81, 0xc2, 0x69, 0xc5, 0x9c, 0x90, 0x8f, 0xf9, 0xd3, 0x0a, 0xe3, 0xc4, 0xa3,
-	0x51, 0x38, 0xf1, 0x40, 0xf9, 0x79, 0x11, 0x0e, 0xf8, 0xb0, 0x9f, 0x46, 0x7d, 0x92, 0xf3, 0x44,
-	0x12, 0x0d, 0x2f, 0x0f, 0xe3, 0xbe, 0x91, 0xf2, 0x24, 0x0d, 0xb9, 0x70, 0x31, 0x37, 0x1e, 0x56,
-	0xba, 0x8e, 0xa7, 0x20, 0xd4, 0xf5, 0xa7, 0x71, 0x90, 0x74, 0xdb, 0x74, 0x96, 0x41, 0xbf, 0xd9,
-	0xb7, 0xe1, 0xea, 0x11, 0x4f, 0xd2, 0xfe, 0x29, 0xf7, 0x87, 0x3c, 0x26, 0xf2, 0x12, 0x87, 0x26,
-	0x42, 0x7d, 0x2a, 0xcf, 0x44, 0xc2, 0x3d, 0xe3, 0x71, 0x12, 0x44, 0x21, 0x29, 0x4e, 0x4d, 0x4f,
-	0x25, 0xb1, 0x3e, 0x1c, 0xbc, 0xde, 0xa8, 0xf5, 0x0c, 0x2e, 0xd1, 0xc0, 0xcb, 0x33, 0xd9, 0x2d,
-	0x98, 0xa3, 0x01, 0x24, 0xdd, 0x0e, 0xd1, 0x4c, 0x3b, 0xe3, 0xf9, 0x20, 0xf4, 0x64, 0x1e, 0xae,
-	0xf2, 0x20, 0x1a, 0x45, 0x31, 0x69, 0x4f, 0x4d, 0x4f, 0x24, 0xec, 0xd9, 0x39, 0x89, 0xfd, 0xc9,
-	0xa9, 0xd4, 0xa0, 0xf2, 0xf0, 0x77, 0x6b, 0x8d, 0x56, 0xa7, 0xed, 0xfe, 0x29, 0xa8, 0x53, 0xb5,
-	0x54, 0x1d, 0x4d, 0xa6, 0x23, 0xab, 0x23, 0xb4, 0x0b, 0xf3, 0x21, 0x4f, 0xcf, 0xa3, 0xf8, 0xa5,
-	0x3a, 0x99, 0x93, 0x49, 0xf7, 0x67, 0x64, 0x6d, 0xe9, 0x93, 0xaa, 0xe7, 0xa4, 0x26, 0xa2, 0xcd,
-	0x2c, 0x96, 0x2a, 0x39, 0xf5, 0xa5, 0x01, 0xd8, 0x20, 0xe0, 0xf0, 0xd4, 0x47, 0x59, 0x6b, 0xad,
-	0xbe, 0xb0, 0xa9, 0x5b, 0x84, 0xed, 0x8a, 0xc5, 0xbf, 0x05, 0x8b, 0xea, 0x0c, 0x2c, 0xe9, 0x8f,
-	0xf8, 0x71, 0xaa, 0x3c, 0x62, 0xe1, 0x74, 0x4c, 0x86, 0xf7, 0x1e, 0x3f, 0x4e, 0xdd, 0x7d, 0x58,
-	0x96, 0xf2, 0xef, 0xe9, 0x84, 0xab, 0xa6, 0x7f, 0xb3, 0x4c, 0x97, 0x68, 0xdd, 0x5f, 0xb1, 0x05,
-	0xa6, 0x38, 0xf5, 0xb3, 0x4b, 0xba, 0x1e, 0x30, 0x53, 0x9e, 0xca, 0x0a, 0xe5, 0x66, 0xae, 0x7c,
-	0x7e, 0x72, 0x38, 0x16, 0x86, 0xf3, 0x93, 0x4c, 0x07, 0x03, 0x75, 0x72, 0xd9, 0xf0, 0x54, 0xd2,
-	0xfd, 0x03, 0x07, 0x56, 0xa8, 0x36, 0xa5, 0x0d, 0xc9, 0x3d, 0xeb, 0xe3, 0xaf, 0xd0, 0x4d, 0xe5,
-	0x71, 0x15, 0x7e, 0xc6, 0x55, 0xa8, 0x9b, 0xbb, 0x98, 0x48, 0x7c, 0x75, 0xff, 0x4a, 0x2d, 0xef,
-	0x5f, 0x71, 0xff, 0x96, 0x03, 0xcb, 0x62, 0x23, 0x21, 0xcd, 0x59, 0x0e, 0xff, 0x4f, 0xc3, 0x82,
-	0xd0, 0x08, 0xa4, 0x54, 0x90, 0x1d, 0xcd, 0x44, 0x2b, 0xa1, 0xa2, 0xf0, 0xee, 0x15, 0xcf, 0x2e,
-	0xcc, 0x1e, 0x90, 0x56, 0x16, 0xf6, 0x09, 0x2d, 0x39, 0xe3, 0xb6, 0xe7, 0x7a, 0xf7, 0x8a, 0x67,
-	0x14, 0x7f, 0xd8, 0x80, 0x39, 0x61, 0x76, 0xb8, 0x8f, 0x61, 0xc1, 0x6a, 0xc8, 0xf2, 0xed, 0xb4,
-	0x85, 0x6f, 0xa7, 0xe0, 0x44, 0xad, 0x94, 0x38, 0x51, 0xff, 0x59, 0x15, 0x18, 0x12, 0x4b, 0x6e,
-	0x35, 0x6e, 0xda, 0x27, 0x11, 0xea, 0xb8, 0x3b, 0x83, 0xd8, 0x06, 0x30, 0x23, 0xa9, 0x4e, 0x47,
-	0xc4, 0x96, 0x59, 0x92, 0x83, 0x62, 0x56, 0x6a, 0x1c, 0xfa, 0xe4, 0x81, 0x6c, 0x76, 0x31, 0xed,
-	0xa5, 0x79, 0xb8, 0x2b, 0xd2, 0x31, 0x04, 0x5a, 0x17, 0xd2, 0xce, 0x55, 0xe9, 0xfc, 0xfa, 0xce,
-	0x5d, 0xba, 0xbe, 0xf3, 0x05, 0xff, 0x99, 0x61, 0x69, 0x35, 0x6c, 0x4b, 0xeb, 0x16, 0x2c, 0xa8,
-	0xd3, 0x86, 0xfe, 0x18, 0x5b, 0x97, 0x66, 0xad, 0x05, 0xb2, 0x3b, 0xd0, 0x51, 0xc6, 0x8e, 0x36,
-	0xe7, 0xc4, 0x99, 0x5d, 0x01, 0x47, 0xf9, 0x9f, 0x79, 0xd4, 0x5a, 0xd4, 0xd9, 0x0c, 0x20, 0xdb,
-	0x08, 0x29, 0xa4, 0x3f, 0x0d, 0xe5, 0x31, 0x37, 0x1f, 0x92, 0x41, 0x8b, 0xb6, 0x51, 0x3e, 0xc3,
-	0xfd, 0x1b, 0x0e, 0x74, 0x70, 0xcd, 0x2c, 0xb2, 0xfc, 0x04, 0x88, 0x2b, 0xde, 0x90, 0x2a, 0xad,
-	0xb2, 0xec, 0x63, 0x68, 0x52, 0x3a, 0x9a, 0xf0, 0x50, 0xd2, 0x64, 0xd7, 0xa6, 0xc9, 0x4c, 0x9e,
-	0xec, 0x5e, 0xf1, 0xb2, 0xc2, 0x06, 0x45, 0xfe, 0x7b, 0x07, 0x5a, 0xb2, 0x95, 0x5f, 0xda, 0x63,
-	0xd3, 0x33, 0xe2, 0x12, 0x04, 0x25, 0x65, 0x61, 0x08, 0xb7, 0x61, 0x69, 0xec, 0xa7, 0xd3, 0x18,
-	0xf7, 0x73, 0xcb, 0x5b, 0x93, 0x87, 0x71, 0x73, 0x26, 0xd1, 0x99, 0xf4, 0xd3, 0x60, 0xd4, 0x57,
-	0xb9, 0x32, 0x02, 0xa0, 0x2c, 0x0b, 0x25, 0x48, 0x92, 0xfa, 0x27, 0x5c, 0xee, 0xbb, 0x22, 0xe1,
-	0x76, 0x61, 0xed, 0x20, 0x3b, 0x81, 0x31, 0xf4, 0x6b, 0xf7, 0x9f, 0x2c, 0xc0, 0x7a, 0x21, 0x4b,
-	0xc7, 0x2b, 0x49, 0x17, 0xc4, 0x28, 0x18, 0x1f, 0x45, 0xda, 0x38, 0x71, 0x4c, 0xef, 0x84, 0x95,
-	0xc5, 0x4e, 0xe0, 0xaa, 0x52, 0x30, 0x70, 0x4e, 0xb3, 0xcd, 0xb0, 0x42, 0xbb, 0xdc, 0x87, 0xf6,
-	0x12, 0xe6, 0x1b, 0x54, 0xb8, 0xc9, 0xc4, 0xe5, 0xf5, 0xb1, 0x53, 0xe8, 0x6a, 0x4d, 0x46, 0x0a,
-	0x6b, 0x43, 0xdb, 0xc1, 0xb6, 0x3e, 0xb8, 0xa4, 0x2d, 0x4b, 0x1d, 0xf7, 0x66, 0xd6, 0xc6, 0x2e,
-	0xe0, 0x86, 0xca, 0x23, 0x69, 0x5c, 0x6c, 0xaf, 0xf6, 0x46, 0x63, 0x23, 0x43, 0xc3, 0x6e, 0xf4,
-	0x92, 0x8a, 0xd9, 0x4f, 0x60, 0xed, 0xdc, 0x0f, 0x52, 0xd5, 0x2d, 0x43, 0xb7, 0xa8, 0x53, 0x93,
-	0xf7, 0x2f, 0x69, 0xf2, 0x85, 0xf8, 0xd8, 0xda, 0xa2, 0x66, 0xd4, 0xd8, 0xfb, 0xa3, 0x0a, 0x2c,
-	0xda, 0xf5, 0x20, 0x99, 0x4a, 0xde, 0x57, 0x32, 0x50, 0x69, 0xa3, 0x39, 0xb8, 0x68, 0xe3, 0x57,
-	0xca, 0x6c, 0x7c, 0xd3, 0xaa, 0xae, 0x5e, 0xe6, 0xea, 0xab, 0xbd, 0x99, 0xab, 0xaf, 0x5e, 0xea,
-	0xea, 0x9b, 0xed, 0x11, 0x9a, 0xfb, 0x65, 0x3d, 0x42, 0xf3, 0xaf, 0xf5, 0x08, 0xf5, 0xfe, 0xaf,
-	0x03, 0xac, 0x48, 0xbd, 0xec, 0xb1, 0x70, 0x6b, 0x84, 0x7c, 0x24, 0x85, 0xd8, 0x37, 0xdf, 0x8c,
-	0x03, 0xd4, 0x6a, 0xa9, 0xaf, 0x91, 0x15, 0xcd, 0xa0, 0x21, 0x53, 0xbd, 0x5a, 0xf0, 0xca, 0xb2,
-	0x72, 0xee, 0xce, 0xda, 0xe5, 0xee, 0xce, 0xfa, 0xe5, 0xee, 0xce, 0xb9, 0xbc, 0xbb, 0xb3, 0xf7,
-	0x97, 0x1d, 0x58, 0x29, 0x21, 0xb3, 0x5f, 0xdf, 0xc0, 0x91, 0x30, 0x2c, 0xe9, 0x53, 0x91, 0x84,
-	0x61, 0x82, 0xbd, 0x3f, 0x0f, 0x0b, 0x16, 0x6b, 0xfd, 0xfa, 0xda, 0xcf, 0x6b, 0x88, 0x82, 0xb2,
-	0x2d, 0xac, 0xf7, 0x3f, 0x2b, 0xc0, 0x8a, 0xec, 0xfd, 0x27, 0xda, 0x87, 0xe2, 0x3c, 0x55, 0x4b,
-	0xe6, 0xe9, 0xff, 0xeb, 0xce, 0xf3, 0x01, 0x2c, 0xcb, 0x48, 0x48, 0xc3, 0x91, 0x25, 0x28, 0xa6,
-	0x98, 0x81, 0x3a, 0xb2, 0xed, 0x6b, 0x6e, 0x58, 0x91, 0x5f, 0xc6, 0xf6, 0x9b, 0x73, 0x39, 0xbb,
-	0x3d, 0xe8, 0xca, 0x19, 0xda, 0x39, 0xe3, 0x61, 0x7a, 0x38, 0x3d, 0x12, 0xa1, 0x80, 0x41, 0x14,
-	0xba, 0xff, 0xbc, 0xaa, 0xd5, 0x7c, 0xca, 0x94, 0x0a, 0xc5, 0xb7, 0xa1, 0x6d, 0x6e, 0x1f, 0x72,
-	0x39, 0x72, 0xbe, 0x4c, 0x54, 0x25, 0xcc, 0x52, 0x6c, 0x1b, 0x16, 0x49, 0x48, 0x0e, 0xf5, 0x77,
-	0x15, 0xfa, 0xee, 0x35, 0xfe, 0x99, 0xdd, 0x2b, 0x5e, 0xee, 0x1b, 0xf6, 0x5b, 0xb0, 0x68, 0x1b,
-	0x7f, 0x52, 0x2b, 0x29, 0xb3, 0x06, 0xf0, 0x73, 0xbb, 0x30, 0xdb, 0x84, 0x4e, 0xde, 0x7a, 0x94,
-	0x51, 0x39, 0x33, 0x2a, 0x28, 0x14, 0x67, 0x1f, 0xcb, 0x83, 0xc7, 0x3a, 0xf9, 0x4d, 0x6e, 0xd9,
-	0x9f, 0x19, 0xd3, 0xb4, 0x21, 0xfe, 0x18, 0x47, 0x91, 0xbf, 0x03, 0x90, 0x61, 0xac, 0x03, 0xed,
-	0xa7, 0x07, 0x3b, 0xfb, 0xfd, 0xad, 0xdd, 0xcd, 0xfd, 0xfd, 0x9d, 0xbd, 0xce, 0x15, 0xc6, 0x60,
-	0x91, 0xdc, 0x7c, 0xdb, 0x1a, 0x73, 0x10, 0x93, 0x8e, 0x15, 0x85, 0x55, 0xd8, 0x2a, 0x74, 0x9e,
-	0xec, 0xe7, 0xd0, 0xea, 0xc3, 0xa6, 0xe6, 0x0f, 0x77, 0x0d, 0x56, 0x45, 0xa4, 0xeb, 0x43, 0x41,
-	0x1e, 0x4a, 0x3b, 0xf9, 0x7b, 0x0e, 0x5c, 0xcd, 0x65, 0x64, 0x61, 0x5b, 0x42, 0x01, 0xb1, 0xb5,
-	0x12, 0x1b, 0xa4, 0x83, 0x04, 0xa5, 0x6b, 0xe6, 0x24, 0x48, 0x31, 0x03, 0x69, 0xde, 0xd0, 0x4d,
-	0x73, 0x9c, 0x54, 0x96, 0xe5, 0xae, 0xeb, 0x08, 0x99, 0x5c, 0xc7, 0x8f, 0x45, 0x04, 0xad, 0x99,
-	0x91, 0x1d, 0xe4, 0xda, 0x5d, 0x56, 0x49, 0x34, 0x2b, 0x2c, 0x65, 0xc7, 0xee, 0x6f, 0x69, 0x9e,
-	0xfb, 0x0f, 0xab, 0xc0, 0xbe, 0x37, 0xe5, 0xf1, 0x05, 0xc5, 0x66, 0x69, 0xaf, 0xe9, 0x7a, 0xde,
-	0x27, 0x38, 0x37, 0x99, 0x1e, 0x7d, 0xca, 0x2f, 0x54, 0xa4, 0x62, 0x25, 0x8b, 0x54, 0x2c, 0x8b,
-	0x16, 0xac, 0x5d, 0x1e, 0x2d, 0x58, 0xbf, 0x2c, 0x5a, 0xf0, 0x6b, 0xb0, 0x10, 0x9c, 0x84, 0x11,
-	0xf2, 0x3c, 0xea, 0x09, 0x49, 0x77, 0xee, 0x66, 0x15, 0x6d, 0x6b, 0x09, 0xee, 0x23, 0xc6, 0x1e,
-	0x64, 0x85, 0xf8, 0xf0, 0x84, 0x22, 0x53, 0x4d, 0x29, 0xb0, 0x33, 0x3c, 0xe1, 0x7b, 0xd1, 0xc0,
-	0x4f, 0xa3, 0x98, 0x1c, 0x3b, 0xea, 0x63, 0xc4, 0x13, 0x76, 0x0b, 0x16, 0x93, 0x68, 0x8a, 0x9a,
-	0x93, 0x1a, 0xab, 0xf0, 0x24, 0xb5, 0x05, 0x7a, 0x20, 0x46, 0xbc, 0x01, 0x2b, 0xd3, 0x84, 0xf7,
-	0xc7, 0x41, 0x92, 0xe0, 0xee, 0x38, 0x88, 0xc2, 0x34, 0x8e, 0x46, 0xd2, 0x9f, 0xb4, 0x3c, 0x4d,
-	0xf8, 0x67, 0x22, 0x67, 0x4b, 0x64, 0xb0, 0x6f, 0x67, 0x5d, 0x9a, 0xf8, 0x41, 0x9c, 0x74, 0x81,
-	0xba, 0xa4, 0x46, 0x8a, 0xfd, 0x3e, 0xf0, 0x83, 0x58, 0xf7, 0x05, 0x13, 0x49, 0x2e, 0xda, 0xb1,
-	0x95, 0x8b, 0x76, 0x94, 0xc1, 0x72, 0x1b, 0xd0, 0x50, 0x9f, 0xa3, 0x91, 0x7b, 0x1c, 0x47, 0x63,
-	0x65, 0xe4, 0xe2, 0x6f, 0xb6, 0x08, 0x95, 0x34, 0x92, 0x06, 0x6a, 0x25, 0x8d, 0xdc, 0xdf, 0x85,
-	0x96, 0x31, 0x03, 0xec, 0x5d, 0x61, 0x6f, 0xa3, 0x42, 0x25, 0xad, 0x63, 0x71, 0x4c, 0xd2, 0x94,
-	0xe8, 0x93, 0x21, 0xfb, 0x06, 0x2c, 0x0f, 0x83, 0x98, 0x53, 0x90, 0x6c, 0x3f, 0xe6, 0x67, 0x3c,
-	0x4e, 0x94, 0x2f, 0xa1, 0xa3, 0x33, 0x3c, 0x81, 0xbb, 0x7d, 0x58, 0xb1, 0x48, 0x47, 0x73, 0xd6,
-	0x1c, 0x45, 0xf8, 0x29, 0x77, 0xa6, 0x1d, 0xfd, 0x27, 0xf3, 0x70, 0x4f, 0x92, 0x6e, 0x90, 0xfe,
-	0x24, 0x8e, 0x8e, 0xa8, 0x11, 0xc7, 0xb3, 0x30, 0xf7, 0x1f, 0x57, 0xa0, 0xba, 0x1b, 0x4d, 0xcc,
-	0xc3, 0x1d, 0xa7, 0x78, 0xb8, 0x23, 0x95, 0xc7, 0xbe, 0xd6, 0x0d, 0xe5, 0x0e, 0x6f, 0x81, 0xec,
-	0x0e, 0x2c, 0xfa, 0xe3, 0xb4, 0x9f, 0x46, 0xa8, 0x2c, 0x9f, 0xfb, 0xb1, 0x08, 0x07, 0xac, 0x12,
-	0x59, 0xe4, 0x72, 0xd8, 0x2a, 0x54, 0xb5, 0xce, 0x43, 0x05, 0x30, 0x89, 0x96, 0x1a, 0x1d, 0x86,
-	0x5f, 0x48, 0x9f, 0xa5, 0x4c, 0x21, 0xd7, 0xdb, 0xdf, 0x0b, 0x33, 0x59, 0xec, 0x5c, 0x65, 0x59,
-	0xa8, 0xc8, 0x22, 0x23, 0x8c, 0x33, 0xbd, 0x50, 0xa7, 0x4d, 0x6f, 0x7c, 0xc3, 0xf6, 0xc6, 0xdf,
-	0x84, 0x56, 0x3a, 0x3a, 0xeb, 0x4f, 0xfc, 0x8b, 0x51, 0xe4, 0x0f, 0x25, 0x01, 0x9a, 0x90, 0xfb,
-	0x0b, 0x07, 0xea, 0x34, 0xcb, 0xb8, 0x4f, 0x0b, 0x41, 0xa6, 0x4f, 0x80, 0x68, 0xe6, 0x16, 0xbc,
-	0x3c, 0xcc, 0x5c, 0x2b, 0xb0, 0xbb, 0xa2, 0x87, 0x6c, 0x06, 0x77, 0xdf, 0x84, 0xa6, 0x48, 0xe9,
-	0x20, 0x65, 0x2a, 0x92, 0x81, 0xec, 0x06, 0xd4, 0x4e, 0xa3, 0x89, 0x32, 0x65, 0x40, 0x1d, 0xf8,
-	0x46, 0x13, 0x8f, 0xf0, 0xac, 0x3f, 0x58, 0x9f, 0x18, 0xb8, 0x50, 0x17, 0xf3, 0x30, 0xaa, 0xe8,
-	0xba, 0x5a, 0x73, 0x22, 0x73, 0xa8, 0xfb, 0x1c, 0x96, 0x90, 0x17, 0x0c, 0x8f, 0xf8, 0x6c, 0xa1,
-	0xf5, 0x75, 0xdc, 0x03, 0x07, 0xa3, 0xe9, 0x90, 0x9b, 0x06, 0x25, 0x79, 0x3c, 0x25, 0xae, 0x54,
-	0x29, 0xf7, 0x9f, 0x3a, 0x82, 0xc7, 0xb0, 0x5e, 0x76, 0x1b, 0x6a, 0x28, 0x7a, 0x72, 0xfe, 0x03,
-	0x1d, 0x17, 0x82, 0xe5, 0x3c, 0x2a, 0x81, 0xd4, 0x4c, 0x3e, 0x49, 0xb3, 0x76, 0xe1, 0x91, 0xcc,
-	0xac, 0x31, 0x3d, 0xb2, 0x9c, 0x11, 0x93, 0x43, 0xd9, 0x86, 0x71, 0xa0, 0x53, 0xb3, 0xc4, 0x99,
-	0xda, 0x72, 0x87, 0x27, 0xdc, 0x38, 0xc8, 0xf9, 0x43, 0x07, 0x16, 0xac, 0x3e, 0x21, 0xa5, 0x8c,
-	0xfc, 0x24, 0x95, 0xe7, 0xf2, 0x72, 0xe5, 0x4d, 0xc8, 0xa4, 0xb2, 0x8a, 0x4d, 0x65, 0xfa, 0x60,
-	0xa0, 0x6a, 0x1e, 0x0c, 0xdc, 0x83, 0x66, 0x16, 0xd9, 0x6f, 0x77, 0x0a, 0x5b, 0x54, 0x11, 0x32,
-	0x59, 0xa1, 0xcc, 0xf5, 0x5c, 0x37, 0x5c, 0xcf, 0xee, 0x03, 0x68, 0x19, 0xe5, 0x4d, 0xd7, 0xb1,
-	0x63, 0xb9, 0x8e, 0x75, 0xf8, 0x58, 0x25, 0x0b, 0x1f, 0x73, 0xbf, 0xac, 0xc0, 0x02, 0x92, 0x77,
-	0x10, 0x9e, 0x1c, 0x44, 0xa3, 0x60, 0x70, 0x41, 0x64, 0xa5, 0x28, 0x59, 0x6e, 0x3d, 0x8a, 0xcc,
-	0x6d, 0x18, 0x59, 0x4e, 0xc7, 0xcc, 0x0a, 0xf9, 0xa0, 0xd3, 0x28, 0x40, 0x90, 0xfd, 0x8e, 0xfc,
-	0x44, 0xf2, 0xa4, 0x54, 0x7d, 0x2d, 0x10, 0xd9, 0x1c, 0x01, 0x0a, 0x06, 0x1c, 0x07, 0xa3, 0x51,
-	0x20, 0xca, 0x0a, 0xc3, 0xa8, 0x2c, 0x0b, 0xdb, 0x1c, 0x06, 0x89, 0x7f, 0x94, 0x1d, 0xfa, 0xe9,
-	0x34, 0x79, 0xd5, 0xfc, 0x57, 0x86, 0x57, 0x4d, 0x44, 0x0f, 0xdb, 0x60, 0x7e, 0x21, 0xe7, 0x0b,
-	0x0b, 0xe9, 0xfe, 0x9b, 0x0a, 0xb4, 0x0c, 0xb2, 0x40, 0x76, 0x2e, 0x95, 0xf1, 0x06, 0x2a, 0x4f,
-	0xc3, 0x43, 0xcb, 0xd4, 0x36, 0x10, 0x76, 0xcb, 0x6e, 0x95, 0xbc, 0xeb, 0xc4, 0xf0, 0x16, 0x09,
-	0x5d, 0x87, 0x26, 0x92, 0xfe, 0x87, 0x64, 0xd7, 0xcb, 0x6b, 0x35, 0x1a, 0x50, 0xb9, 0xf7, 0x29,
-	0xb7, 0x9e, 0xe5, 0x12, 0xf0, 0xda, 0xf3, 0xf1, 0x8f, 0xa1, 0x2d, 0xab, 0xa1, 0x35, 0xa6, 0x41,
-	0x67, 0xcc, 0x67, 0xad, 0xbf, 0x67, 0x95, 0x54, 0x5f, 0xde, 0x57, 0x5f, 0x36, 0x2e, 0xfb, 0x52,
-	0x95, 0x74, 0x1f, 0xeb, 0xd0, 0x83, 0xc7, 0xb1, 0x3f, 0x39, 0x55, 0x02, 0xe5, 0x1e, 0xac, 0x28,
-	0xb9, 0x31, 0x0d, 0xfd, 0x30, 0x8c, 0xa6, 0xe1, 0x80, 0xab, 0x48, 0xb3, 0xb2, 0x2c, 0x77, 0xa8,
-	0xe3, 0x92, 0xa9, 0x22, 0x76, 0x07, 0xea, 0x42, 0x79, 0x11, 0x5b, 0x61, 0xb9, 0x08, 0x11, 0x45,
-	0xd8, 0x6d, 0xa8, 0x0b, 0x1d, 0xa6, 0x32, 0x93, 0xe9, 0x45, 0x01, 0x77, 0x03, 0x96, 0x28, 0x10,
-	0xda, 0x90, 0x7d, 0x6f, 0x95, 0x6d, 0x91, 0x73, 0x03, 0x11, 0x2e, 0xbd, 0x0a, 0x6c, 0x5f, 0xf0,
-	0x95, 0x79, 0x80, 0xf8, 0x8b, 0x2a, 0xb4, 0x0c, 0x18, 0xe5, 0x13, 0x9d, 0xfa, 0xf4, 0x87, 0x81,
-	0x3f, 0xe6, 0x29, 0x8f, 0x25, 0x2f, 0xe5, 0x50, 0x2c, 0xe7, 0x9f, 0x9d, 0xf4, 0xa3, 0x69, 0xda,
-	0x1f, 0xf2, 0x93, 0x98, 0x73, 0xb9, 0x77, 0xe7, 0x50, 0x2c, 0x87, 0xd4, 0x6c, 0x94, 0x13, 0xe7,
-	0x34, 0x39, 0x54, 0x1d, 0x07, 0x8a, 0x79, 0xaa, 0x65, 0xc7, 0x81, 0x62, 0x56, 0xf2, 0x92, 0xb5,
-	0x5e, 0x22, 0x59, 0x3f, 0x82, 0x35, 0x21, 0x43, 0xa5, 0xf4, 0xe8, 0xe7, 0x88, 0x6b, 0x46, 0x2e,
-	0xbb, 0x03, 0x1d, 0xec, 0xb3, 0x62, 0x8d, 0x24, 0xf8, 0x99, 0xe0, 0x31, 0xc7, 0x2b, 0xe0, 0x58,
-	0x96, 0x7c, 0xd4, 0x66, 0x59, 0x11, 0x93, 0x51, 0xc0, 0xa9, 0xac, 0xff, 0xca, 0x2e, 0xdb, 0x94,
-	0x65, 0x73, 0x38, 0xfb, 0x18, 0xd6, 0xc7, 0x7c, 0x18, 0xf8, 0x76, 0x15, 0xe4, 0x32, 0x12, 0xc1,
-	0x61, 0xb3, 0xb2, 0xb1, 0x15, 0x9c, 0x85, 0x9f, 0x45, 0xe3, 0xa3, 0x40, 0x6c, 0x6c, 0xc2, 0x9b,
-	0x5e, 0xf3, 0x0a, 0xb8, 0xbb, 0x00, 0xad, 0xc3, 0x34, 0x9a, 0xa8, 0xa5, 0x5f, 0x84, 0xb6, 0x48,
-	0xca, 0xd8, 0xc2, 0xb7, 0xe0, 0x1a, 0xd1, 0xeb, 0xb3, 0x68, 0x12, 0x8d, 0xa2, 0x93, 0x0b, 0xcb,
-	0x26, 0xfe, 0x77, 0x0e, 0xac, 0x58, 0xb9, 0x99, 0x51, 0x4c, 0x0e, 0x3c, 0x15, 0x10, 0x26, 0x48,
-	0x7c, 0xd9, 0xd8, 0x16, 0x44, 0x41, 0x71, 0x56, 0xf2, 0x5c, 0xc6, 0x88, 0x6d, 0x66, 0xb7, 0x1c,
-	0xd4, 0x87, 0x82, 0xde, 0xbb, 0x45, 0x7a, 0x97, 0xdf, 0xab, 0xfb, 0x0f, 0xaa, 0x8a, 0xdf, 0x92,
-	0x11, 0x34, 0x43, 0x39, 0xe8, 0xaa, 0x1d, 0xf5, 0x60, 0xfa, 0x50, 0x54, 0x0f, 0x06, 0x1a, 0x4c,
-	0xdc, 0x9f, 0x3b, 0x00, 0x59, 0xef, 0x28, 0xee, 0x42, 0x6f, 0x6d, 0xe2, 0x26, 0xad, 0xb1, 0x8d,
-	0xbd, 0x0b, 0x6d, 0x7d, 0x74, 0x9e, 0xed, 0x96, 0x2d, 0x85, 0xa1, 0x76, 0xf1, 0x3e, 0x2c, 0x9d,
-	0x8c, 0xa2, 0x23, 0xd2, 0x62, 0x28, 0x58, 0x35, 0x91, 0x11, 0x96, 0x8b, 0x02, 0x7e, 0x24, 0xd1,
-	0x6c, 0x6b, 0xad, 0x99, 0x5b, 0x6b, 0xf9, 0x46, 0xf9, 0x65, 0x45, 0x9f, 0x5f, 0x66, 0x33, 0xf1,
-	0x5a, 0x2e, 0x67, 0xf7, 0x0b, 0x62, 0x7d, 0xc6, 0x91, 0x21, 0xe9, 0xfb, 0x07, 0x97, 0xba, 0x54,
-	0x1f, 0xc0, 0x62, 0x2c, 0x64, 0xa6, 0x12, 0xa8, 0xb5, 0xd7, 0x08, 0xd4, 0x85, 0xd8, 0xda, 0x99,
-	0xbf, 0x0e, 0x1d, 0x7f, 0x78, 0xc6, 0xe3, 0x34, 0x20, 0x17, 0x13, 0xa9, 0x51, 0x62, 0x80, 0x4b,
-	0x06, 0x4e, 0xda, 0xca, 0xfb, 0xb0, 0x24, 0xe3, 0x5d, 0x75, 0x49, 0x79, 0x2f, 0x2d, 0x83, 0xb1,
-	0xa0, 0xfb, 0x0f, 0xd4, 0x71, 0xa9, 0xbd, 0xba, 0xaf, 0x9f, 0x15, 0x73, 0x84, 0x95, 0xdc, 0x08,
-	0xbf, 0x26, 0x8f, 0x2f, 0x87, 0xca, 0x97, 0x55, 0x35, 0x62, 0xb1, 0x86, 0xf2, 0xb8, 0xd9, 0x9e,
-	0xd6, 0xda, 0x9b, 0x4c, 0xab, 0xfb, 0x1f, 0x1d, 0x98, 0xdf, 0x8d, 0x26, 0xbb, 0x38, 0xc5, 0xa8,
-	0xe3, 0x20, 0x9b, 0xe8, 0x60, 0x73, 0x95, 0xbc, 0x24, 0x66, 0xad, 0x54, 0x2b, 0x59, 0xc8, 0x6b,
-	0x25, 0x7f, 0x16, 0xde, 0x22, 0x6f, 0x6a, 0x1c, 0x4d, 0xa2, 0x18, 0xd9, 0xd5, 0x1f, 0x09, 0x15,
-	0x24, 0x0a, 0xd3, 0x53, 0x25, 0x4e, 0x5f, 0x57, 0x84, 0x5c, 0x1c, 0x68, 0x79, 0x0a, 0x6b, 0x46,
-	0x6a, 0x51, 0x42, 0xca, 0x16, 0x33, 0xdc, 0xdf, 0x84, 0x26, 0x59, 0x18, 0x34, 0xb4, 0x0f, 0xa0,
-	0x79, 0x1a, 0x4d, 0xfa, 0xa7, 0x41, 0x98, 0x2a, 0xf6, 0x5f, 0xcc, 0x54, 0xff, 0x5d, 0x9a, 0x14,
-	0x5d, 0xc0, 0xfd, 0xd7, 0x73, 0x30, 0xff, 0x24, 0x3c, 0x8b, 0x82, 0x01, 0x1d, 0xd1, 0x8e, 0xf9,
-	0x38, 0x52, 0xe1, 0xf7, 0xf8, 0x1b, 0xa7, 0x83, 0x62, 0x4d, 0x27, 0x82, 0x78, 0xdb, 0x22, 0x14,
-	0x43, 0x42, 0x74, 0x71, 0x34, 0xbb, 0x3a, 0x27, 0x18, 0xcc, 0x40, 0xd0, 0x3a, 0x8b, 0xcd, 0xab,
-	0x6f, 0x32, 0x95, 0x5d, 0x6f, 0xa8, 0x1b, 0xd7, 0x1b, 0xb0, 0x2d, 0x19, 0x49, 0x27, 0x42, 0xad,
-	0x44, 0x5b, 0x12, 0x22, 0x8b, 0x32, 0xe6, 0xc2, 0x1b, 0xae, 0x15, 0x2f, 0xb4, 0x28, 0x4d, 0x10,
-	0x95, 0x33, 0xf1, 0x81, 0x28, 0x23, 0x36, 0x03, 0x13, 0x42, 0xf5, 0x34, 0x7f, 0xe3, 0x52, 0xdc,
-	0x78, 0xcd, 0xc3, 0x28, 0xcb, 0x87, 0x5c, 0x8b, 0x5c, 0x31, 0x0e, 0x10, 0xd7, 0x03, 0xf3, 0xb8,
-	0x61, 0x87, 0x8a, 0xb0, 0x60, 0x65, 0x87, 0x22, 0xc1, 0xf8, 0xa3, 0xd1, 0x91, 0x3f, 0x78, 0x49,
-	0x17, 0x6e, 0xe9, 0xd0, 0xb4, 0xe9, 0xd9, 0x20, 0xc5, 0xc3, 0x65, 0xab, 0x4a, 0x41, 0x2b, 0x35,
-	0xcf, 0x84, 0xd8, 0x7d, 0x68, 0x91, 0x8d, 0x2e, 0xd7, 0x75, 0x91, 0xd6, 0xb5, 0x63, 0x1a, 0xf1,
-	0xb4, 0xb2, 0x66, 0x21, 0xf3, 0xf8, 0x78, 0xa9, 0x10, 0xa8, 0xeb, 0x0f, 0x87, 0xf2, 0xd4, 0xbd,
-	0x23, 0xae, 0xc8, 0x69, 0x80, 0xbc, 0x00, 0x62, 0xc2, 0x44, 0x81, 0x65, 0x2a, 0x60, 0x61, 0xec,
-	0x06, 0x34, 0xd0, 0xea, 0x9b, 0xf8, 0xc1, 0x90, 0xe2, 0x54, 0x84, 0xf1, 0xa9, 0x31, 0xac, 0x43,
-	0xfd, 0xa6, 0x6d, 0x73, 0x85, 0x66, 0xc5, 0xc2, 0x70, 0x6e, 0x74, 0x7a, 0x9c, 0x45, 0xf6, 0xda,
-	0x20, 0xfb, 0x90, 0xce, 0x3e, 0x53, 0x4e, 0xe1, 0xbb, 0x8b, 0xf7, 0xdf, 0x92, 0x63, 0x96, 0x44,
-	0xab, 0xfe, 0x1e, 0x62, 0x11, 0x4f, 0x94, 0x44, 0xa5, 0x4d, 0xb8, 0x9f, 0xd7, 0x2c, 0xa5, 0x4d,
-	0x16, 0x25, 0xf7, 0xb3, 0x28, 0xe0, 0x6e, 0x42, 0xdb, 0xac, 0x80, 0x35, 0xa0, 0xf6, 0xf4, 0x60,
-	0x67, 0xbf, 0x73, 0x85, 0xb5, 0x60, 0xfe, 0x70, 0xe7, 0xd9, 0xb3, 0xbd, 0x9d, 0xed, 0x8e, 0xc3,
-	0xda, 0xd0, 0xd0, 0x61, 0x8e, 0x15, 0x4c, 0x6d, 0x6e, 0x6d, 0xed, 0x1c, 0x3c, 0xdb, 0xd9, 0xee,
-	0x54, 0xdd, 0x3f, 0xa8, 0x40, 0xcb, 0xa8, 0xf9, 0x12, 0xbf, 0xc8, 0x0d, 0x00, 0xb2, 0x24, 0xb2,
-	0x80, 0x87, 0x9a, 0x67, 0x20, 0x28, 0x19, 0xb5, 0x8d, 0x5d, 0x15, 0x37, 0x05, 0x55, 0x9a, 0xe6,
-	0x8b, 0xae, 0xe4, 0x99, 0x5e, 0xfe, 0xba, 0x67, 0x83, 0x48, 0x4b, 0x12, 0xa0, 0xa8, 0x3b, 0xc1,
-	0x61, 0x26, 0x84, 0x6b, 0x13, 0xf3, 0x24, 0x1a, 0x9d, 0x71, 0x51, 0x44, 0xe8, 0x63, 0x16, 0x86,
-	0x6d, 0x49, 0x11, 0x63, 0x44, 0xc4, 0xd6, 0x3d, 0x1b, 0x64, 0xdf, 0x54, 0x6b, 0xd3, 0xa0, 0xb5,
-	0x59, 0x2f, 0x4e, 0xb4, 0xb9, 0x2e, 0x6e, 0x0a, 0x6c, 0x73, 0x38, 0x94, 0xb9, 0xe6, 0xbd, 0xc3,
-	0xd8, 0xbc, 0xe4, 0xaa, 0x84, 0x44, 0x09, 0xa3, 0x56, 0xca, 0x19, 0xf5, 0xb5, 0xe4, 0xec, 0xee,
-	0x40, 0xeb, 0xc0, 0xb8, 0x36, 0x4b, 0x32, 0x4b, 0x5d, 0x98, 0x95, 0xb2, 0xce, 0x40, 0x8c, 0xee,
-	0x54, 0xcc, 0xee, 0xb8, 0x7f, 0xdf, 0x11, 0x37, 0x91, 0x74, 0xf7, 0x45, 0xdb, 0x2e, 0xb4, 0xb5,
-	0x0f, 0x37, 0x0b, 0xf8, 0xb6, 0x30, 0x2c, 0x43, 0x5d, 0xe9, 0x47, 0xc7, 0xc7, 0x09, 0x57, 0xa1,
-	0x99, 0x16, 0xa6, 0x14, 0x47, 0x54, 0x45, 0x03, 0xd1, 0x42, 0x22, 0x43, 0x34, 0x0b, 0x38, 0x12,
-	0x89, 0x74, 0x03, 0xaa, 0xa0, 0x54, 0x9d, 0xd6, 0x71, 0xe9, 0xf9, 0x59, 0xbe, 0x03, 0x0d, 0x5d,
-	0xaf, 0xbd, 0x2b, 0xa8, 0x92, 0x3a, 0x1f, 0x77, 0x1f, 0x32, 0x2a, 0xad, 0x4e, 0x0b, 0x5a, 0x2d,
-	0x66, 0xb0, 0x0d, 0x60, 0xc7, 0x41, 0x9c, 0x2f, 0x2e, 0x88, 0xb7, 0x24, 0xc7, 0x7d, 0x01, 0x2b,
-	0x8a, 0xe7, 0x0c, 0x8d, 0xd6, 0x5e, 0x44, 0xe7, 0x32, 0x99, 0x54, 0x29, 0xca, 0x24, 0xf7, 0x8f,
-	0xab, 0x30, 0x2f, 0x57, 0xba, 0x70, 0xf5, 0x5a, 0xac, 0xb3, 0x85, 0xb1, 0xae, 0x75, 0xc9, 0x8e,
-	0x04, 0x98, 0xdc, 0x89, 0x0a, 0x7b, 0x4d, 0xb5, 0x6c, 0xaf, 0x61, 0x50, 0x9b, 0xf8, 0xe9, 0x29,
-	0xb9, 0x5e, 0x9a, 0x1e, 0xfd, 0x56, 0x5e, 0xca, 0xba, 0xed, 0xa5, 0x2c, 0xbb, 0x68, 0x2e, 0xd4,
-	0xa9, 0xe2, 0x45, 0xf3, 0xeb, 0xd0, 0x14, 0x97, 0x93, 0x33, 0x47, 0x64, 0x06, 0x20, 0xf5, 0x8a,
-	0x04, 0x49, 0x08, 0x79, 0xdf, 0x25, 0x43, 0xbe, 0xc2, 0xee, 0xf6, 0x6d, 0x98, 0x13, 0x17, 0x2e,
-	0x64, 0xe8, 0xed, 0x75, 0x75, 0x48, 0x27, 0xca, 0xa9, 0xbf, 0x22, 0x86, 0xc7, 0x93, 0x65, 0xcd,
-	0x2b, 0x9b, 0x2d, 0xfb, 0xca, 0xa6, 0xe9, 0x3f, 0x6d, 0xdb, 0xfe, 0x53, 0xf7, 0x11, 0x2c, 0x58,
-	0xd5, 0xa1, 0x74, 0x95, 0xa1, 0xbb, 0x9d, 0x2b, 0x6c, 0x01, 0x9a, 0x4f, 0xf6, 0xfb, 0x8f, 0xf6,
-	0x9e, 0x3c, 0xde, 0x7d, 0xd6, 0x71, 0x30, 0x79, 0xf8, 0x7c, 0x6b, 0x6b, 0x67, 0x67, 0x9b, 0xa4,
-	0x2d, 0xc0, 0xdc, 0xa3, 0xcd, 0x27, 0x7b, 0x24, 0x6b, 0xb7, 0x05, 0x6d, 0xcb, 0xba, 0xf4, 0xc1,
-	0xc8, 0x37, 0x81, 0x29, 0xbb, 0x9f, 0x42, 0x78, 0x26, 0x23, 0x9e, 0xaa, 0xa8, 0xf2, 0x65, 0x99,
-	0xf3, 0x44, 0x67, 0xa8, 0x8b, 0x11, 0x59, 0x2d, 0x19, 0x8b, 0xc8, 0x49, 0xca, 0xb3, 0x88, 0x2c,
-	0xea, 0xe9, 0x7c, 0xb7, 0x07, 0xdd, 0x6d, 0x8e, 0xb5, 0x6d, 0x8e, 0x46, 0xb9, 0xee, 0xa0, 0xe1,
-	0x56, 0x92, 0x27, 0xad, 0xba, 0xef, 0xc1, 0xd5, 0x4d, 0x11, 0x40, 0xfe, 0xeb, 0x8a, 0x2f, 0x74,
-	0xbb, 0xb0, 0x96, 0xaf, 0x52, 0x36, 0xf6, 0x08, 0x96, 0xb7, 0xf9, 0xd1, 0xf4, 0x64, 0x8f, 0x9f,
-	0x65, 0x0d, 0x31, 0xa8, 0x25, 0xa7, 0xd1, 0xb9, 0x9c, 0x1f, 0xfa, 0xcd, 0xde, 0x06, 0x18, 0x61,
-	0x99, 0x7e, 0x32, 0xe1, 0x03, 0x75, 0xd1, 0x8f, 0x90, 0xc3, 0x09, 0x1f, 0xb8, 0x1f, 0x01, 0x33,
-	0xeb, 0x91, 0xf3, 0x85, 0xba, 0xd6, 0xf4, 0xa8, 0x9f, 0x5c, 0x24, 0x29, 0x1f, 0xab, 0x1b, 0x8c,
-	0x26, 0xe4, 0xbe, 0x0f, 0xed, 0x03, 0xff, 0xc2, 0xe3, 0x3f, 0x95, 0x4f, 0x10, 0xac, 0xc3, 0xfc,
-	0xc4, 0xbf, 0x40, 0x12, 0xd4, 0xce, 0x60, 0xca, 0x76, 0xff, 0x4f, 0x05, 0xe6, 0x44, 0x49, 0xac,
-	0x75, 0xc8, 0x93, 0x34, 0x08, 0x89, 0xd3, 0x54, 0xad, 0x06, 0x54, 0xe0, 0xed, 0x4a, 0x09, 0x6f,
-	0x4b, 0x0f, 0x85, 0xba, 0x30, 0x25, 0x19, 0xd8, 0xc2, 0x90, 0xd3, 0xb2, 0x40, 0x61, 0xe1, 0x32,
-	0xcc, 0x80, 0xdc, 0xc9, 0x42, 0xa6, 0xd1, 0x89, 0xfe, 0x29, 0xb1, 0x25, 0xd9, 0xd8, 0x84, 0x4a,
-	0xf5, 0xc6, 0x79, 0xc1, 0xed, 0x05, 0xbd, 0xb1, 0xa0, 0x1f, 0x36, 0xde, 0x40, 0x3f, 0x14, 0x6e,
-	0x8b, 0xd7, 0xe9, 0x87, 0xf0, 0x06, 0xfa, 0xa1, 0xcb, 0xa0, 0x43, 0xb7, 0xb1, 0xd1, 0x02, 0x51,
-	0xb4, 0xfb, 0xb7, 0x1d, 0xe8, 0x48, 0x2a, 0xd2, 0x79, 0xea, 0x8c, 0xea, 0x75, 0x57, 0x7d, 0x6e,
-	0xc1, 0x02, 0xd9, 0x3f, 0x5a, 0x04, 0xc8, 0xf3, 0x1e, 0x0b, 0xc4, 0x71, 0xa8, 0x30, 0x93, 0x71,
-	0x30, 0x92, 0x8b, 0x62, 0x42, 0x4a, 0x8a, 0xc4, 0xbe, 0x0c, 0x78, 0x75, 0x3c, 0x9d, 0x76, 0xff,
-	0xc8, 0x81, 0x65, 0xa3, 0xc3, 0x92, 0x0a, 0x1f, 0x40, 0x5b, 0x3f, 0x7a, 0xc0, 0xf5, 0xe6, 0xb6,
-	0x6e, 0xb3, 0x4d, 0xf6, 0x99, 0x55, 0x98, 0x16, 0xd3, 0xbf, 0xa0, 0x0e, 0x26, 0xd3, 0xb1, 0xdc,
-	0x55, 0x4c, 0x08, 0x09, 0xe9, 0x9c, 0xf3, 0x97, 0xba, 0x88, 0xd8, 0xd7, 0x2c, 0x8c, 0xfc, 0xc6,
-	0x68, 0xb7, 0xe9, 0x42, 0x35, 0xe9, 0x37, 0x36, 0x41, 0xf7, 0x2f, 0x56, 0x60, 0x45, 0x18, 0xe2,
-	0xd2, 0x01, 0xa2, 0xef, 0x9d, 0xce, 0x09, 0x9f, 0x84, 0xe0, 0xc8, 0xdd, 0x2b, 0x9e, 0x4c, 0xb3,
-	0xef, 0xbc, 0xa1, 0xf3, 0x40, 0x47, 0xe1, 0xce, 0x58, 0x8b, 0x6a, 0xd9, 0x5a, 0xbc, 0x66, 0xa6,
-	0xcb, 0x5c, 0xf8, 0xf5, 0x72, 0x17, 0xfe, 0x1b, 0xb9, 0xcc, 0x1f, 0xce, 0x43, 0x3d, 0x19, 0x44,
-	0x13, 0xee, 0xae, 0xc1, 0xaa, 0x3d, 0x05, 0x52, 0x50, 0xfd, 0xdc, 0x81, 0xee, 0x23, 0x71, 0x1a,
-	0x17, 0x84, 0x27, 0xbb, 0x41, 0x92, 0x46, 0xb1, 0xbe, 0xc4, 0x7f, 0x03, 0x20, 0x49, 0xfd, 0x58,
-	0x2a, 0xb4, 0x42, 0x35, 0x30, 0x10, 0x1c, 0x09, 0x0f, 0x87, 0x22, 0x57, 0xac, 0xa0, 0x4e, 0x17,
-	0x54, 0x2f, 0xe9, 0x4c, 0xb0, 0x14, 0x98, 0xf7, 0x44, 0xec, 0x3a, 0x76, 0x99, 0x9f, 0x91, 0xf4,
-	0x17, 0x16, 0x7a, 0x0e, 0x75, 0x7f, 0xaf, 0x02, 0x4b, 0x59, 0x27, 0x29, 0xc6, 0xc2, 0x96, 0x21,
-	0x52, 0x6b, 0xc9, 0x64, 0x88, 0x74, 0xfc, 0xf7, 0x03, 0x54, 0x63, 0x0c, 0x7f, 0x82, 0x81, 0xb2,
-	0x5b, 0xd0, 0x52, 0xa9, 0x68, 0x9a, 0x1a, 0xb7, 0x69, 0x4d, 0x58, 0x44, 0xa4, 0xa2, 0x22, 0x25,
-	0x95, 0x42, 0x99, 0xa2, 0xdb, 0x40, 0xe3, 0x94, 0xbe, 0x14, 0x33, 0xaf, 0x92, 0xac, 0x23, 0x34,
-	0x11, 0xf1, 0xb0, 0x09, 0x69, 0x21, 0xe6, 0x0e, 0xdd, 0xd0, 0xaf, 0x90, 0x68, 0xce, 0x14, 0x35,
-	0x66, 0xe1, 0xc4, 0x35, 0xcf, 0x84, 0x94, 0x45, 0x17, 0x4d, 0xa5, 0x75, 0x22, 0xde, 0x31, 0xb1,
-	0x30, 0xf7, 0xaf, 0x3b, 0x70, 0xad, 0x64, 0x19, 0x25, 0xa7, 0x6e, 0xc3, 0xf2, 0xb1, 0xce, 0x54,
-	0x53, 0x2d, 0xd8, 0x75, 0x4d, 0x85, 0x1c, 0xd8, 0xd3, 0xeb, 0x15, 0x3f, 0xd0, 0xca, 0xa9, 0x58,
-	0x3c, 0x2b, 0x72, 0xbc, 0x98, 0xe1, 0x1e, 0x40, 0x6f, 0xe7, 0x15, 0x32, 0xfe, 0x96, 0xf9, 0x16,
-	0x9b, 0xa2, 0xac, 0xfb, 0x05, 0xc1, 0x76, 0xb9, 0x1b, 0xe9, 0x18, 0x16, 0xac, 0xba, 0xd8, 0xb7,
-	0xde, 0xb4, 0x12, 0x93, 0x47, 0x6f, 0xca, 0x55, 0x17, 0x8f, 0xc9, 0xa9, 0xf8, 0x75, 0x03, 0x72,
-	0xcf, 0x60, 0xe9, 0xb3, 0xe9, 0x28, 0x0d, 0xb2, 0x87, 0xe5, 0xd8, 0x77, 0xe4, 0x47, 0x54, 0x85,
-	0x9a, 0xba, 0xd2, 0xa6, 0xcc, 0x72, 0x38, 0x63, 0x63, 0xac, 0xa9, 0x5f, 0x6c, 0xb1, 0x98, 0xe1,
-	0x5e, 0x83, 0xf5, 0xac, 0x49, 0x31, 0x77, 0x6a, 0x73, 0xf8, 0x7d, 0x47, 0x04, 0x62, 0xd9, 0xef,
-	0xdc, 0xb1, 0xc7, 0xb0, 0x92, 0x04, 0xe1, 0xc9, 0x88, 0x9b, 0xf5, 0x24, 0x72, 0x26, 0xae, 0xda,
-	0xdd, 0x93, 0x6f, 0xe1, 0x79, 0x65, 0x5f, 0x20, 0x81, 0x94, 0x77, 0x34, 0x23, 0x90, 0xdc, 0x94,
-	0x94, 0x0d, 0xe0, 0xbb, 0xb0, 0x68, 0x37, 0xc6, 0x3e, 0x96, 0xa1, 0xe7, 0x59, 0xcf, 0xcc, 0x73,
-	0x1f, 0x9b, 0x32, 0xac, 0x92, 0xee, 0x97, 0x0e, 0x74, 0x3d, 0x8e, 0x64, 0xcc, 0x8d, 0x46, 0x25,
-	0xf5, 0x3c, 0x28, 0x54, 0x3b, 0x7b, 0xc0, 0x3a, 0xa4, 0x5d, 0x8d, 0x75, 0x63, 0xe6, 0xa2, 0xec,
-	0x5e, 0x29, 0x19, 0xd5, 0xc3, 0x06, 0xcc, 0xc9, 0xf1, 0xad, 0xc3, 0x55, 0xd9, 0x25, 0xd5, 0x9d,
-	0xec, 0xc0, 0xc0, 0x6a, 0xd4, 0x3a, 0x30, 0xe8, 0x41, 0x57, 0xbc, 0xd5, 0x60, 0x8e, 0x43, 0x7c,
-	0x78, 0xe7, 0x0b, 0x68, 0x19, 0x2f, 0x56, 0xb0, 0x75, 0x58, 0x79, 0xf1, 0xe4, 0xd9, 0xfe, 0xce,
-	0xe1, 0x61, 0xff, 0xe0, 0xf9, 0xc3, 0x4f, 0x77, 0x7e, 0xd0, 0xdf, 0xdd, 0x3c, 0xdc, 0xed, 0x5c,
-	0x61, 0x6b, 0xc0, 0xf6, 0x77, 0x0e, 0x9f, 0xed, 0x6c, 0x5b, 0xb8, 0xc3, 0x6e, 0x40, 0xef, 0xf9,
-	0xfe, 0xf3, 0xc3, 0x9d, 0xed, 0x7e, 0xd9, 0x77, 0x15, 0xf6, 0x36, 0x5c, 0x93, 0xf9, 0x25, 0x9f,
-	0x57, 0xef, 0x3c, 0x80, 0x4e, 0xde, 0x63, 0x60, 0xf9, 0x58, 0x5e, 0xe7, 0x8c, 0xb9, 0xff, 0x65,
-	0x15, 0x16, 0x45, 0x30, 0x99, 0x78, 0x5b, 0x91, 0xc7, 0xec, 0x33, 0x98, 0x97, 0x8f, 0x74, 0x32,
-	0xb5, 0x18, 0xf6, 0xb3, 0xa0, 0xbd, 0xb5, 0x3c, 0x2c, 0x67, 0x70, 0xe5, 0x2f, 0xfd, 0x87, 0xff,
-	0xf6, 0x37, 0x2b, 0x0b, 0xac, 0x75, 0xf7, 0xec, 0xc3, 0xbb, 0x27, 0x3c, 0x4c, 0xb0, 0x8e, 0xdf,
-	0x01, 0xc8, 0x9e, 0x9e, 0x64, 0x5d, 0x6d, 0x35, 0xe7, 0xde, 0xe5, 0xec, 0x5d, 0x2b, 0xc9, 0x91,
-	0xf5, 0x5e, 0xa3, 0x7a, 0x57, 0xdc, 0x45, 0xac, 0x37, 0x08, 0x83, 0x54, 0x3c, 0x43, 0xf9, 0x89,
-	0x73, 0x87, 0x0d, 0xa1, 0x6d, 0x3e, 0x0a, 0xc9, 0xd4, 0x89, 0x49, 0xc9, 0xb3, 0x96, 0xbd, 0xb7,
-	0x4a, 0xf3, 0xd4, 0xea, 0x53, 0x1b, 0x57, 0xdd, 0x0e, 0xb6, 0x31, 0xa5, 0x12, 0x59, 0x2b, 0x23,
-	0xc1, 0x13, 0xd9, 0xdb, 0x8f, 0xec, 0xba, 0x41, 0xa6, 0x85, 0x97, 0x27, 0x7b, 0x6f, 0xcf, 0xc8,
-	0x95, 0x6d, 0xbd, 0x4d, 0x6d, 0xad, 0xbb, 0x0c, 0xdb, 0x1a, 0x50, 0x19, 0xf5, 0xf2, 0xe4, 0x27,
-	0xce, 0x9d, 0xfb, 0xff, 0xe3, 0x3d, 0x68, 0xea, 0xd3, 0x54, 0xf6, 0x13, 0x58, 0xb0, 0xa2, 0xfd,
-	0x98, 0x1a, 0x46, 0x59, 0x70, 0x60, 0xef, 0x7a, 0x79, 0xa6, 0x6c, 0xf8, 0x06, 0x35, 0xdc, 0x65,
-	0x6b, 0xd8, 0xb0, 0x0c, 0x97, 0xbb, 0x4b, 0x71, 0xab, 0xe2, 0xda, 0xdb, 0x4b, 0x83, 0xf7, 0x45,
-	0x63, 0xd7, 0xf3, 0xec, 0x68, 0xb5, 0xf6, 0xf6, 0x8c, 0x5c, 0xd9, 0xdc, 0x75, 0x6a, 0x6e, 0x8d,
-	0xad, 0x9a, 0xcd, 0xe9, 0x13, 0x4e, 0x4e, 0x77, 0x3d, 0xcd, 0x67, 0x11, 0xd9, 0xdb, 0x9a, 0xb0,
-	0xca, 0x9e, 0x4b, 0xd4, 0x24, 0x52, 0x7c, 0x33, 0xd1, 0xed, 0x52, 0x53, 0x8c, 0xd1, 0xf2, 0x99,
-	0xaf, 0x22, 0xb2, 0x23, 0x68, 0x19, 0x8f, 0x27, 0xb1, 0x6b, 0x33, 0x1f, 0x7a, 0xea, 0xf5, 0xca,
-	0xb2, 0xca, 0x86, 0x62, 0xd6, 0x7f, 0x17, 0x55, 0x83, 0x1f, 0x41, 0x53, 0x3f, 0xc7, 0xc3, 0xd6,
-	0x8d, 0xe7, 0x91, 0xcc, 0xe7, 0x83, 0x7a, 0xdd, 0x62, 0x46, 0x19, 0xf1, 0x99, 0xb5, 0x23, 0xf1,
-	0xbd, 0x80, 0x96, 0xf1, 0xe4, 0x8e, 0x1e, 0x40, 0xf1, 0x59, 0x1f, 0x3d, 0x80, 0x92, 0x17, 0x7a,
-	0xdc, 0x65, 0x6a, 0xa2, 0xc5, 0x9a, 0x44, 0xdf, 0xe9, 0xab, 0x28, 0x61, 0x7b, 0x70, 0x55, 0xca,
-	0xb8, 0x23, 0xfe, 0x55, 0x96, 0xa1, 0xe4, 0x25, 0xca, 0x7b, 0x0e, 0x7b, 0x00, 0x0d, 0xf5, 0xb2,
-	0x12, 0x5b, 0x2b, 0x7f, 0x21, 0xaa, 0xb7, 0x5e, 0xc0, 0xa5, 0x6e, 0xf3, 0x03, 0x80, 0xec, 0x7d,
-	0x1f, 0x2d, 0x24, 0x0a, 0xef, 0x05, 0x69, 0x0a, 0x28, 0x3e, 0x06, 0xe4, 0xae, 0xd1, 0x00, 0x3b,
-	0x8c, 0x84, 0x44, 0xc8, 0xcf, 0xd5, 0xb5, 0xee, 0x1f, 0x43, 0xcb, 0x78, 0xe2, 0x47, 0x4f, 0x5f,
-	0xf1, 0x79, 0x20, 0x3d, 0x7d, 0x25, 0x2f, 0x02, 0xb9, 0x3d, 0xaa, 0x7d, 0xd5, 0x5d, 0xc2, 0xda,
-	0x93, 0xe0, 0x24, 0x1c, 0x8b, 0x02, 0xb8, 0x40, 0xa7, 0xb0, 0x60, 0xbd, 0xe3, 0xa3, 0x39, 0xb4,
-	0xec, 0x95, 0x20, 0xcd, 0xa1, 0xa5, 0x4f, 0xff, 0x28, 0x3a, 0x73, 0x97, 0xb1, 0x9d, 0x33, 0x2a,
-	0x62, 0xb4, 0xf4, 0x43, 0x68, 0x19, 0x6f, 0xf2, 0xe8, 0xb1, 0x14, 0x9f, 0xff, 0xd1, 0x63, 0x29,
-	0x7b, 0xc2, 0x67, 0x95, 0xda, 0x58, 0x74, 0x89, 0x14, 0xe8, 0x82, 0x32, 0xd6, 0xfd, 0x13, 0x58,
-	0xb4, 0x5f, 0xe9, 0xd1, 0xbc, 0x5f, 0xfa, 0xde, 0x8f, 0xe6, 0xfd, 0x19, 0x4f, 0xfb, 0x48, 0x92,
-	0xbe, 0xb3, 0xa2, 0x1b, 0xb9, 0xfb, 0xb9, 0x8c, 0xc7, 0xfa, 0x82, 0x7d, 0x0f, 0x05, 0x9c, 0xbc,
-	0x31, 0xce, 0xd6, 0x0d, 0xaa, 0x35, 0xef, 0x95, 0x6b, 0x7e, 0x29, 0x5c, 0x2e, 0xb7, 0x89, 0x59,
-	0x5c, 0xb1, 0xa6, 0x5d, 0x8b, 0x6e, 0x8e, 0x1b, 0xbb, 0x96, 0x79, 0xb9, 0xdc, 0xd8, 0xb5, 0xac,
-	0x0b, 0xe6, 0xf9, 0x5d, 0x2b, 0x0d, 0xb0, 0x8e, 0x10, 0x96, 0x72, 0x37, 0x12, 0x34, 0x57, 0x94,
-	0x5f, 0x1a, 0xeb, 0xdd, 0x78, 0xfd, 0x45, 0x06, 0x5b, 0x82, 0x28, 0x21, 0x78, 0x57, 0x5d, 0xd1,
-	0xfb, 0x5d, 0x68, 0x9b, 0xaf, 0x8d, 0x30, 0x93, 0x95, 0xf3, 0x2d, 0xbd, 0x55, 0x9a, 0x67, 0x2f,
-	0x2e, 0x6b, 0x9b, 0xcd, 0xb0, 0xef, 0xc3, 0x9a, 0x66, 0x75, 0x33, 0xc8, 0x3d, 0x61, 0xef, 0x94,
-	0x84, 0xbe, 0x9b, 0x9a, 0x4f, 0xef, 0xda, 0xcc, 0xd8, 0xf8, 0x7b, 0x0e, 0x12, 0x8d, 0xfd, 0x84,
-	0x43, 0xb6, 0x61, 0x94, 0xbd, 0x5c, 0x91, 0x6d, 0x18, 0xa5, 0xef, 0x3e, 0x28, 0xa2, 0x61, 0x2b,
-	0xd6, 0x1c, 0x89, 0xa3, 0x6b, 0xf6, 0x43, 0x58, 0x32, 0xae, 0x11, 0x1d, 0x5e, 0x84, 0x03, 0xcd,
-	0x00, 0xc5, 0x1b, 0xae, 0xbd, 0x32, 0xbd, 0xde, 0x5d, 0xa7, 0xfa, 0x97, 0x5d, 0x6b, 0x72, 0x90,
-	0xf8, 0xb7, 0xa0, 0x65, 0x5e, 0x51, 0x7a, 0x4d, 0xbd, 0xeb, 0x46, 0x96, 0x79, 0x41, 0xf3, 0x9e,
-	0xc3, 0x0e, 0x44, 0x08, 0x93, 0x7e, 0x1a, 0x32, 0x8a, 0xf3, 0xdb, 0xa7, 0xfd, 0x64, 0xa4, 0x5e,
-	0xc8, 0xb2, 0xc7, 0x42, 0x6f, 0x3b, 0xf7, 0x1c, 0xf6, 0x77, 0x1c, 0x68, 0x5b, 0x57, 0x88, 0xac,
-	0x80, 0x90, 0x5c, 0xcf, 0xba, 0x66, 0x9e, 0xd9, 0x35, 0xd7, 0xa3, 0x61, 0xef, 0xdd, 0xf9, 0xae,
-	0x35, 0xad, 0x9f, 0x5b, 0x2e, 0xa8, 0x8d, 0xfc, 0xfb, 0x90, 0x5f, 0xe4, 0x0b, 0x98, 0xf7, 0x8a,
-	0xbf, 0xb8, 0xe7, 0xb0, 0x3f, 0x74, 0x60, 0xd1, 0x76, 0x9c, 0xea, 0xe1, 0x96, 0xba, 0x68, 0xf5,
-	0xe2, 0xcf, 0xf0, 0xb6, 0xfe, 0x90, 0x7a, 0xf9, 0xec, 0x8e, 0x67, 0xf5, 0x52, 0x3e, 0x17, 0xf2,
-	0xab, 0xf5, 0x96, 0x7d, 0x22, 0x1e, 0x34, 0x56, 0xc7, 0x1b, 0xac, 0xf8, 0xac, 0xae, 0x26, 0x18,
-	0xf3, 0x21, 0x5c, 0x5a, 0x84, 0x1f, 0x8b, 0x77, 0x11, 0x95, 0x07, 0x1e, 0xe9, 0xee, 0x4d, 0xbf,
-	0x77, 0x6f, 0xd1, 0x98, 0x6e, 0xb8, 0xd7, 0xac, 0x31, 0xe5, 0x77, 0xf8, 0x4d, 0xd1, 0x3b, 0xf9,
-	0x86, 0x6d, 0xb6, 0x45, 0x15, 0xde, 0xb5, 0x9d, 0xdd, 0xc9, 0xb1, 0xe8, 0xa4, 0x2c, 0x6e, 0x31,
-	0xc7, 0x1b, 0x56, 0xe3, 0xde, 0xa1, 0xbe, 0xde, 0x72, 0xdf, 0x99, 0xd9, 0xd7, 0xbb, 0xe4, 0xfe,
-	0xc4, 0x1e, 0x1f, 0x00, 0x64, 0x47, 0x91, 0x2c, 0x77, 0x14, 0xa6, 0x45, 0x46, 0xf1, 0xb4, 0xd2,
-	0xe6, 0x40, 0x75, 0x62, 0x86, 0x35, 0xfe, 0x48, 0x08, 0xc0, 0x27, 0xea, 0x10, 0xcd, 0x54, 0x73,
-	0xec, 0x33, 0x43, 0x4b, 0xcd, 0xc9, 0xd7, 0x6f, 0x89, 0x3f, 0x7d, 0x22, 0xf7, 0x1c, 0x16, 0xf6,
-	0xa2, 0xe8, 0xe5, 0x74, 0xa2, 0x63, 0x35, 0xec, 0x93, 0x89, 0x5d, 0x3f, 0x39, 0xed, 0xe5, 0x46,
-	0xe1, 0xde, 0xa4, 0xaa, 0x7a, 0xac, 0x6b, 0x54, 0x75, 0xf7, 0xf3, 0xec, 0xa8, 0xf3, 0x0b, 0xe6,
-	0xc3, 0xb2, 0x96, 0xaa, 0xba, 0xe3, 0x3d, 0xbb, 0x1a, 0x4b, 0x96, 0xe6, 0x9b, 0xb0, 0xf4, 0x71,
-	0xd5, 0xdb, 0xbb, 0x89, 0xaa, 0x93, 0x64, 0x4a, 0x7b, 0x9b, 0x0f, 0xe8, 0x82, 0x04, 0xb9, 0xf7,
-	0x57, 0xb2, 0x8e, 0xeb, 0x73, 0x81, 0xde, 0x82, 0x05, 0xda, 0x3b, 0xcd, 0xc4, 0xbf, 0x88, 0xf9,
-	0x4f, 0xef, 0x7e, 0x2e, 0x0f, 0x0e, 0xbe, 0x50, 0x3b, 0x8d, 0x3a, 0x59, 0xb1, 0x76, 0x9a, 0xdc,
-	0x51, 0x8c, 0xb5, 0xd3, 0x14, 0x8e, 0x62, 0xac, 0xa9, 0x56, 0x27, 0x3b, 0x6c, 0x04, 0xcb, 0x85,
-	0xd3, 0x1b, 0xbd, 0xc9, 0xcc, 0x3a, 0xf3, 0xe9, 0xdd, 0x9c, 0x5d, 0xc0, 0x6e, 0xed, 0x8e, 0xdd,
-	0xda, 0x21, 0x2c, 0x6c, 0x73, 0x31, 0x59, 0x22, 0x38, 0x35, 0x77, 0x0f, 0xcd, 0x0c, 0x7d, 0xcd,
-	0x6f, 0x09, 0x94, 0x67, 0xab, 0x12, 0x14, 0x15, 0xca, 0x7e, 0x04, 0xad, 0xc7, 0x3c, 0x55, 0xd1,
-	0xa8, 0x5a, 0x99, 0xcd, 0x85, 0xa7, 0xf6, 0x4a, 0x82, 0x59, 0x6d, 0x9a, 0xa1, 0xda, 0xee, 0xf2,
-	0xe1, 0x09, 0x17, 0xc2, 0xa9, 0x1f, 0x0c, 0xbf, 0x60, 0x7f, 0x8e, 0x2a, 0xd7, 0xe1, 0xf8, 0x6b,
-	0x46, 0x68, 0xa1, 0x59, 0xf9, 0x52, 0x0e, 0x2f, 0xab, 0x39, 0x8c, 0x86, 0xdc, 0x50, 0xaa, 0x42,
-	0x68, 0x19, 0x57, 0x57, 0x34, 0x03, 0x15, 0x6f, 0x42, 0x69, 0x06, 0x2a, 0xb9, 0xe9, 0xe2, 0xde,
-	0xa6, 0x76, 0x5c, 0x76, 0x33, 0x6b, 0x47, 0xdc, 0x6e, 0xc9, 0x5a, 0xba, 0xfb, 0xb9, 0x3f, 0x4e,
-	0xbf, 0x60, 0x2f, 0xe8, 0xf9, 0x1e, 0x33, 0xda, 0x36, 0xd3, 0xce, 0xf3, 0x81, 0xb9, 0x7a, 0xb2,
-	0x8c, 0x2c, 0x5b, 0x63, 0x17, 0x4d, 0x91, 0xee, 0xf5, 0x1d, 0x80, 0xc3, 0x34, 0x9a, 0x6c, 0xfb,
-	0x7c, 0x1c, 0x85, 0x99, 0xac, 0xcd, 0x62, 0x3d, 0x33, 0xf9, 0x65, 0x04, 0x7c, 0xb2, 0x17, 0x86,
-	0x39, 0x63, 0x05, 0x2c, 0x2b, 0xe2, 0x9a, 0x19, 0x0e, 0xaa, 0x27, 0xa4, 0x24, 0x24, 0xf4, 0x9e,
-	0xc3, 0x36, 0x01, 0xb2, 0xe3, 0x3b, 0x6d, 0x9c, 0x14, 0x4e, 0x06, 0xb5, 0xd8, 0x2b, 0x39, 0xeb,
-	0x3b, 0x80, 0x66, 0x76, 0x1e, 0xb4, 0x9e, 0x5d, 0x10, 0xb3, 0x4e, 0x8f, 0xf4, 0x0e, 0x5e, 0x38,
-	0xa5, 0x71, 0x3b, 0x34, 0x55, 0xc0, 0x1a, 0x38, 0x55, 0x74, 0xf4, 0x12, 0xc0, 0x8a, 0xe8, 0xa0,
-	0x56, 0x70, 0x28, 0x46, 0x51, 0x8d, 0xa4, 0xe4, 0xa4, 0x44, 0x73, 0x73, 0xe9, 0x11, 0x82, 0xe5,
-	0x63, 0x41, 0x6a, 0x15, 0xf1, 0x91, 0x28, 0x9a, 0xc7, 0xb0, 0x5c, 0xf0, 0x4a, 0x6b, 0x96, 0x9e,
-	0x75, 0xec, 0xa0, 0x59, 0x7a, 0xa6, 0x43, 0xdb, 0xbd, 0x4a, 0x4d, 0x2e, 0xb9, 0x40, 0x36, 0xd5,
-	0x79, 0x90, 0x0e, 0x4e, 0xb1, 0xb9, 0xdf, 0x77, 0x60, 0xa5, 0xc4, 0xe9, 0xcc, 0xde, 0x55, 0xe6,
-	0xf9, 0x4c, 0x87, 0x74, 0xaf, 0xd4, 0x27, 0xe9, 0x1e, 0x52, 0x3b, 0x9f, 0xb1, 0x4f, 0xad, 0x8d,
-	0x4d, 0xb8, 0x03, 0x25, 0x67, 0xbe, 0x56, 0xa9, 0x28, 0xd5, 0x28, 0x7e, 0x0a, 0xeb, 0xa2, 0x23,
-	0x9b, 0xa3, 0x51, 0xce, 0x5f, 0x7a, 0xa3, 0xf0, 0x3f, 0x4d, 0x2c, 0x3f, 0x70, 0x6f, 0xf6, 0xff,
-	0x3c, 0x99, 0xa1, 0x00, 0x8b, 0xae, 0xb2, 0x29, 0x74, 0xf2, 0x3e, 0x48, 0x36, 0xbb, 0xae, 0xde,
-	0x3b, 0x96, 0xa1, 0x59, 0xf4, 0x5b, 0xba, 0xbf, 0x41, 0x8d, 0xbd, 0xe3, 0xf6, 0xca, 0xe6, 0x45,
-	0xd8, 0x9e, 0xb8, 0x1e, 0x7f, 0x41, 0x3b, 0x4c, 0x73, 0xe3, 0x54, 0x0d, 0xcc, 0xf2, 0xf0, 0x6a,
-	0x53, 0xb7, 0xdc, 0xdf, 0xfa, 0x1e, 0x35, 0x7f, 0xd3, 0x7d, 0xab, 0xac, 0xf9, 0x58, 0x7c, 0x22,
-	0x8c, 0xde, 0xf5, 0x3c, 0x5f, 0xab, 0x1e, 0xdc, 0x2c, 0x5b, 0xef, 0x99, 0xd6, 0x4b, 0x6e, 0xae,
-	0xaf, 0xdc, 0x73, 0x1e, 0xbe, 0xff, 0xc3, 0xdf, 0x38, 0x09, 0xd2, 0xd3, 0xe9, 0xd1, 0xc6, 0x20,
-	0x1a, 0xdf, 0x1d, 0x29, 0xa7, 0x9b, 0x8c, 0xaa, 0xbf, 0x3b, 0x0a, 0x87, 0x77, 0xe9, 0xfb, 0xa3,
-	0x39, 0xfa, 0x17, 0x49, 0xdf, 0xfa, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x82, 0x61, 0x14,
-	0x54, 0x69, 0x00, 0x00,
+	0x96, 0x56, 0x45, 0xfe, 0xd8, 0x99, 0x27, 0xd3, 0xce, 0xf4, 0x75, 0xd9, 0x4e, 0x67, 0xfd, 0xb9,
+	0x63, 0x7b, 0xbb, 0x6b, 0x6b, 0xba, 0x5d, 0xd5, 0xee, 0x99, 0xa6, 0xa7, 0x9b, 0xdd, 0xc1, 0x65,
+	0xa7, 0xcb, 0xee, 0x76, 0xd9, 0x9e, 0xb0, 0xab, 0x8b, 0x99, 0xd9, 0x55, 0x4c, 0x38, 0xf3, 0xda,
+	0x8e, 0xae, 0xc8, 0x88, 0x9c, 0x88, 0x48, 0xbb, 0x3c, 0x4d, 0x23, 0x40, 0x08, 0x21, 0x84, 0x84,
+	0x06, 0x5e, 0x10, 0x12, 0x2c, 0xcc, 0x22, 0xb1, 0x0b, 0x42, 0x02, 0x24, 0x10, 0x0f, 0x2b, 0x81,
+	0xc4, 0xc3, 0x3e, 0xc1, 0x3e, 0xf0, 0x80, 0xc4, 0x03, 0x2b, 0x24, 0x24, 0xb4, 0x0f, 0x80, 0x84,
+	0x00, 0xf1, 0xb0, 0x0f, 0xe8, 0xdc, 0x9f, 0x88, 0x7b, 0x23, 0x22, 0x6d, 0x77, 0x4f, 0x2f, 0x4f,
+	0xf6, 0xfd, 0xce, 0x89, 0xfb, 0x7b, 0xee, 0xb9, 0xe7, 0x9e, 0x7b, 0xee, 0x4d, 0xa8, 0x87, 0xa3,
+	0xfe, 0xea, 0x28, 0x0c, 0xe2, 0x80, 0x54, 0x3d, 0x3f, 0x1c, 0xf5, 0xbb, 0x77, 0x4f, 0x83, 0xe0,
+	0xd4, 0xa3, 0x8f, 0x9d, 0x91, 0xfb, 0xd8, 0xf1, 0xfd, 0x20, 0x76, 0x62, 0x37, 0xf0, 0x23, 0xce,
+	0x64, 0xfe, 0x18, 0x66, 0x9f, 0x51, 0xff, 0x90, 0xd2, 0x81, 0x45, 0x7f, 0x32, 0xa6, 0x51, 0x4c,
+	0xbe, 0x05, 0x73, 0x0e, 0xfd, 0x29, 0xa5, 0x03, 0x7b, 0xe4, 0x44, 0xd1, 0xe8, 0x2c, 0x74, 0x22,
+	0xda, 0x31, 0x56, 0x8c, 0x87, 0x4d, 0xab, 0xcd, 0x09, 0x07, 0x09, 0x4e, 0xde, 0x80, 0x66, 0x84,
+	0xac, 0xd4, 0x8f, 0xc3, 0x60, 0x74, 0xd9, 0x29, 0x31, 0xbe, 0x06, 0x62, 0x3d, 0x0e, 0x99, 0x1e,
+	0xb4, 0x92, 0x12, 0xa2, 0x51, 0xe0, 0x47, 0x94, 0x3c, 0x81, 0xdb, 0x7d, 0x77, 0x74, 0x46, 0x43,
+	0x9b, 0x7d, 0x3c, 0xf4, 0xe9, 0x30, 0xf0, 0xdd, 0x7e, 0xc7, 0x58, 0x29, 0x3f, 0xac, 0x5b, 0x84,
+	0xd3, 0xf0, 0x8b, 0xe7, 0x82, 0x42, 0xde, 0x86, 0x16, 0xf5, 0x39, 0x4e, 0x07, 0xec, 0x2b, 0x51,
+	0xd4, 0x6c, 0x0a, 0xe3, 0x07, 0xe6, 0x5f, 0x2e, 0xc1, 0xdc, 0x8e, 0xef, 0xc6, 0x2f, 0x1d, 0xcf,
+	0xa3, 0xb1, 0x6c, 0xd3, 0xdb, 0xd0, 0xba, 0x60, 0x00, 0x6b, 0xd3, 0x45, 0x10, 0x0e, 0x44, 0x8b,
+	0x66, 0x39, 0x7c, 0x20, 0xd0, 0x89, 0x35, 0x2b, 0x4d, 0xac, 0x59, 0x61, 0x77, 0x95, 0x27, 0x74,
+	0xd7, 0xdb, 0xd0, 0x0a, 0x69, 0x3f, 0x38, 0xa7, 0xe1, 0xa5, 0x7d, 0xe1, 0xfa, 0x83, 0xe0, 0xa2,
+	0x53, 0x59, 0x31, 0x1e, 0x56, 0xad, 0x59, 0x09, 0xbf, 0x64, 0x28, 0x79, 0x0a, 0xad, 0xfe, 0x99,
+	0xe3, 0xfb, 0xd4, 0xb3, 0x8f, 0x9d, 0xfe, 0xab, 0xf1, 0x28, 0xea, 0x54, 0x57, 0x8c, 0x87, 0x8d,
+	0xb5, 0xe5, 0x55, 0x36, 0xaa, 0xab, 0x1b, 0x67, 0x8e, 0xff, 0x94, 0x51, 0x0e, 0x7d, 0x67, 0x14,
+	0x9d, 0x05, 0xb1, 0x35, 0x2b, 0xbe, 0xe0, 0x70, 0x64, 0xde, 0x06, 0xa2, 0xf6, 0x04, 0xef, 0x7b,
+	0xf3, 0x1f, 0x19, 0x30, 0xff, 0xc2, 0xf7, 0x82, 0xfe, 0xab, 0xaf, 0xd9, 0x45, 0x05, 0x6d, 0x28,
+	0xdd, 0xb4, 0x0d, 0xe5, 0xaf, 0xda, 0x86, 0x45, 0xb8, 0xad, 0x57, 0x56, 0xb4, 0x82, 0xc2, 0x02,
+	0x7e, 0x7d, 0x4a, 0x65, 0xb5, 0x64, 0x33, 0x7e, 0x05, 0xda, 0xfd, 0x71, 0x18, 0x52, 0x3f, 0xd7,
+	0x8e, 0x96, 0xc0, 0x93, 0x86, 0xbc, 0x01, 0x4d, 0x9f, 0x5e, 0xa4, 0x6c, 0x42, 0x76, 0x7d, 0x7a,
+	0x21, 0x59, 0xcc, 0x0e, 0x2c, 0x66, 0x8b, 0x11, 0x15, 0xf8, 0xcf, 0x06, 0x54, 0x5e, 0xc4, 0xaf,
+	0x03, 0xb2, 0x0a, 0x95, 0xf8, 0x72, 0xc4, 0x67, 0xc8, 0xec, 0x1a, 0x11, 0x4d, 0x5b, 0x1f, 0x0c,
+	0x42, 0x1a, 0x45, 0x47, 0x97, 0x23, 0x6a, 0x35, 0x1d, 0x9e, 0xb0, 0x91, 0x8f, 0x74, 0x60, 0x5a,
+	0xa4, 0x59, 0x81, 0x75, 0x4b, 0x26, 0xc9, 0x7d, 0x00, 0x67, 0x18, 0x8c, 0xfd, 0xd8, 0x8e, 0x9c,
+	0x98, 0x75, 0x55, 0xd9, 0x52, 0x10, 0x72, 0x17, 0xea, 0xa3, 0x57, 0x76, 0xd4, 0x0f, 0xdd, 0x51,
+	0xcc, 0xc4, 0xa6, 0x6e, 0xa5, 0x00, 0xf9, 0x16, 0xd4, 0x82, 0x71, 0x3c, 0x0a, 0x5c, 0x3f, 0x16,
+	0xa2, 0xd2, 0x12, 0x75, 0xd9, 0x1f, 0xc7, 0x07, 0x08, 0x5b, 0x09, 0x03, 0x79, 0x13, 0x66, 0xfa,
+	0x81, 0x7f, 0xe2, 0x86, 0x43, 0xae, 0x0c, 0x3a, 0x53, 0xac, 0x34, 0x1d, 0x34, 0x7f, 0xbf, 0x04,
+	0x8d, 0xa3, 0xd0, 0xf1, 0x23, 0xa7, 0x8f, 0x00, 0x56, 0x3d, 0x7e, 0x6d, 0x9f, 0x39, 0xd1, 0x19,
+	0x6b, 0x6d, 0xdd, 0x92, 0x49, 0xb2, 0x08, 0x53, 0xbc, 0xa2, 0xac, 0x4d, 0x65, 0x4b, 0xa4, 0xc8,
+	0x3b, 0x30, 0xe7, 0x8f, 0x87, 0xb6, 0x5e, 0x56, 0x99, 0x49, 0x4b, 0x9e, 0x80, 0x1d, 0x70, 0x8c,
+	0x63, 0xcd, 0x8b, 0xe0, 0x2d, 0x54, 0x10, 0x62, 0x42, 0x53, 0xa4, 0xa8, 0x7b, 0x7a, 0xc6, 0x9b,
+	0x59, 0xb5, 0x34, 0x0c, 0xf3, 0x88, 0xdd, 0x21, 0xb5, 0xa3, 0xd8, 0x19, 0x8e, 0x44, 0xb3, 0x14,
+	0x84, 0xd1, 0x83, 0xd8, 0xf1, 0xec, 0x13, 0x4a, 0xa3, 0xce, 0xb4, 0xa0, 0x27, 0x08, 0x79, 0x0b,
+	0x66, 0x07, 0x34, 0x8a, 0x6d, 0x31, 0x28, 0x34, 0xea, 0xd4, 0xd8, 0xd4, 0xcf, 0xa0, 0x98, 0x4f,
+	0xe8, 0x5c, 0xd8, 0xd8, 0x01, 0xf4, 0x75, 0xa7, 0xce, 0xeb, 0x9a, 0x22, 0xe4, 0x36, 0x54, 0x3d,
+	0xe7, 0x98, 0x7a, 0x1d, 0x60, 0x24, 0x9e, 0x30, 0xd7, 0x60, 0xf1, 0x19, 0x8d, 0x95, 0x3e, 0x8d,
+	0xa4, 0xdc, 0xa2, 0x58, 0xf4, 0xfb, 0xac, 0x0b, 0x45, 0xdf, 0x8a, 0xa4, 0xb9, 0x0b, 0x44, 0xf9,
+	0x60, 0x93, 0xc6, 0x8e, 0xeb, 0x45, 0xe4, 0x03, 0x68, 0xc6, 0x4a, 0x36, 0x4c, 0x75, 0x36, 0x12,
+	0xf1, 0x53, 0x3e, 0xb0, 0x34, 0x3e, 0xf3, 0x19, 0xd4, 0xb6, 0x28, 0xdd, 0x75, 0x87, 0x6e, 0x4c,
+	0x16, 0xa1, 0x7a, 0xe2, 0xbe, 0xa6, 0x7c, 0x82, 0x94, 0xb7, 0x6f, 0x59, 0x3c, 0x49, 0xba, 0x30,
+	0x3d, 0xa2, 0x61, 0x9f, 0xca, 0xe1, 0xdc, 0xbe, 0x65, 0x49, 0xe0, 0xe9, 0x34, 0x54, 0x3d, 0xfc,
+	0xd8, 0xfc, 0x6f, 0x65, 0x68, 0x1c, 0x52, 0x3f, 0x99, 0x78, 0x04, 0x2a, 0xd8, 0x45, 0x62, 0xb2,
+	0xb1, 0xff, 0xc9, 0x03, 0x68, 0xe0, 0x5f, 0x3b, 0x8a, 0x43, 0xd7, 0x3f, 0x15, 0xf2, 0x0e, 0x08,
+	0x1d, 0x32, 0x84, 0xb4, 0xa1, 0xec, 0x0c, 0xa5, 0xac, 0xe3, 0xbf, 0x38, 0x29, 0x47, 0xce, 0xe5,
+	0x10, 0xe7, 0x6f, 0x22, 0x05, 0x4d, 0xab, 0x21, 0xb0, 0x6d, 0x14, 0x83, 0x55, 0x98, 0x57, 0x59,
+	0x64, 0xee, 0x55, 0x96, 0xfb, 0x9c, 0xc2, 0x29, 0x0a, 0x79, 0x1b, 0x5a, 0x92, 0x3f, 0xe4, 0x95,
+	0x65, 0x72, 0x51, 0xb7, 0x66, 0x05, 0x2c, 0x9b, 0xf0, 0x10, 0xda, 0x27, 0xae, 0xef, 0x78, 0x76,
+	0xdf, 0x8b, 0xcf, 0xed, 0x01, 0xf5, 0x62, 0x87, 0x49, 0x48, 0xd5, 0x9a, 0x65, 0xf8, 0x86, 0x17,
+	0x9f, 0x6f, 0x22, 0x4a, 0xde, 0x81, 0xfa, 0x09, 0xa5, 0x36, 0xeb, 0x89, 0x4e, 0x4d, 0x9b, 0x6d,
+	0xb2, 0x77, 0xad, 0xda, 0x89, 0xf8, 0x8f, 0xbc, 0x03, 0xed, 0x60, 0x1c, 0x9f, 0x06, 0xae, 0x7f,
+	0x6a, 0xa3, 0x7e, 0xb3, 0xdd, 0x01, 0x93, 0x98, 0xca, 0xd3, 0xd2, 0x13, 0xc3, 0x9a, 0x95, 0x34,
+	0xd4, 0x34, 0x3b, 0x03, 0x72, 0x0f, 0x80, 0x95, 0xcf, 0x33, 0x47, 0xf1, 0x99, 0xb1, 0xea, 0x88,
+	0xf0, 0xcc, 0x3e, 0x82, 0x1a, 0xeb, 0xd3, 0xd8, 0x3b, 0xef, 0x34, 0xd8, 0xa0, 0x3f, 0x10, 0x25,
+	0x2b, 0xa3, 0xb1, 0xba, 0x49, 0xa3, 0xf8, 0xc8, 0x3b, 0xc7, 0x35, 0xf8, 0xd2, 0x9a, 0x1e, 0xf0,
+	0x54, 0xf7, 0x23, 0x68, 0xaa, 0x04, 0xec, 0xfe, 0x57, 0xf4, 0x92, 0x0d, 0x59, 0xc5, 0xc2, 0x7f,
+	0x51, 0x6c, 0xcf, 0x1d, 0x6f, 0x4c, 0x85, 0x32, 0xe4, 0x89, 0x8f, 0x4a, 0x1f, 0x1a, 0xe6, 0xbf,
+	0x34, 0xa0, 0xc9, 0x4b, 0x10, 0x8b, 0xf8, 0x9b, 0x30, 0x23, 0xbb, 0x95, 0x86, 0x61, 0x10, 0x0a,
+	0xb9, 0xd5, 0x41, 0xf2, 0x08, 0xda, 0x12, 0x18, 0x85, 0xd4, 0x1d, 0x3a, 0xa7, 0x32, 0xef, 0x1c,
+	0x4e, 0xd6, 0xd2, 0x1c, 0xc3, 0x60, 0x1c, 0x53, 0xb1, 0x5c, 0x34, 0x45, 0xfb, 0x2c, 0xc4, 0x2c,
+	0x9d, 0x05, 0x75, 0x42, 0x81, 0xbc, 0x68, 0x98, 0xf9, 0x33, 0x03, 0x08, 0x56, 0xfd, 0x28, 0xe0,
+	0x59, 0x88, 0xe1, 0xce, 0x8a, 0x9a, 0x71, 0x63, 0x51, 0x2b, 0x4d, 0x12, 0x35, 0x13, 0xaa, 0xbc,
+	0xe6, 0x95, 0x82, 0x9a, 0x73, 0xd2, 0x27, 0x95, 0x5a, 0xb9, 0x5d, 0x31, 0xff, 0x63, 0x19, 0x6e,
+	0x6f, 0xf0, 0xb5, 0x6e, 0xbd, 0xdf, 0xa7, 0xa3, 0x44, 0x08, 0x1f, 0x40, 0xc3, 0x0f, 0x06, 0xd4,
+	0x1e, 0x8d, 0x8f, 0xe5, 0xd8, 0x34, 0x2d, 0x40, 0xe8, 0x80, 0x21, 0x4c, 0x3e, 0xce, 0x1c, 0xd7,
+	0xe7, 0x95, 0xe6, 0x7d, 0x59, 0x67, 0x08, 0xab, 0xf2, 0x5b, 0xd0, 0x1a, 0x51, 0x7f, 0xa0, 0xca,
+	0x1a, 0xb7, 0x46, 0x66, 0x04, 0x2c, 0xc4, 0xec, 0x01, 0x34, 0x4e, 0xc6, 0x9c, 0x0f, 0xa7, 0x60,
+	0x85, 0xc9, 0x00, 0x08, 0x68, 0x7d, 0x18, 0x93, 0x65, 0xa8, 0x8d, 0xc6, 0xd1, 0x19, 0xa3, 0x56,
+	0x19, 0x75, 0x1a, 0xd3, 0x48, 0xba, 0x07, 0x30, 0x18, 0x47, 0xb1, 0x10, 0xd1, 0x29, 0x46, 0xac,
+	0x23, 0xc2, 0x45, 0xf4, 0x5d, 0x98, 0x1f, 0x3a, 0xaf, 0x6d, 0x26, 0x3b, 0xb6, 0xeb, 0xdb, 0x27,
+	0x1e, 0x53, 0xd7, 0xd3, 0x8c, 0xaf, 0x3d, 0x74, 0x5e, 0x7f, 0x86, 0x94, 0x1d, 0x7f, 0x8b, 0xe1,
+	0x38, 0x3f, 0xa5, 0x9d, 0x10, 0xd2, 0x88, 0x86, 0xe7, 0x94, 0x4d, 0xa9, 0x4a, 0x62, 0x0c, 0x58,
+	0x1c, 0xc5, 0x1a, 0x0d, 0xb1, 0xdd, 0xb1, 0xd7, 0xe7, 0xf3, 0xc7, 0x9a, 0x1e, 0xba, 0xfe, 0x76,
+	0xec, 0xf5, 0xc9, 0x5d, 0x00, 0x9c, 0x90, 0x23, 0x1a, 0xda, 0xaf, 0x2e, 0xd8, 0xa4, 0xa9, 0xb0,
+	0x09, 0x78, 0x40, 0xc3, 0x4f, 0x2f, 0xc8, 0x1d, 0xa8, 0xf7, 0x23, 0x36, 0xa3, 0x9d, 0xcb, 0x4e,
+	0x83, 0xcd, 0xa8, 0x5a, 0x3f, 0xc2, 0xb9, 0xec, 0x5c, 0x92, 0x77, 0x80, 0x60, 0x6d, 0x1d, 0x36,
+	0x0a, 0x74, 0xc0, 0xb2, 0x8f, 0x3a, 0x4d, 0xc6, 0x85, 0x95, 0x5d, 0x17, 0x04, 0x2c, 0x27, 0x22,
+	0xbf, 0x04, 0x33, 0xb2, 0xb2, 0x27, 0x9e, 0x73, 0x1a, 0x75, 0x66, 0x18, 0x63, 0x53, 0x80, 0x5b,
+	0x88, 0x99, 0x2f, 0x61, 0x21, 0x33, 0xb6, 0x62, 0xce, 0xe0, 0x3a, 0xc9, 0x10, 0x36, 0xae, 0x35,
+	0x4b, 0xa4, 0x8a, 0x06, 0xad, 0x54, 0x30, 0x68, 0xe6, 0xcf, 0x0d, 0x68, 0x8a, 0x9c, 0xd9, 0x92,
+	0x4e, 0x9e, 0x00, 0x91, 0xa3, 0x18, 0xbf, 0x76, 0x07, 0xf6, 0xf1, 0x65, 0x4c, 0x23, 0x2e, 0x34,
+	0xdb, 0xb7, 0xac, 0x02, 0x1a, 0x2a, 0x23, 0x0d, 0x8d, 0xe2, 0x90, 0xcb, 0xf3, 0xf6, 0x2d, 0x2b,
+	0x47, 0xc1, 0xe9, 0x85, 0x46, 0xc3, 0x38, 0xb6, 0x5d, 0x7f, 0x40, 0x5f, 0x33, 0x51, 0x9a, 0xb1,
+	0x34, 0xec, 0xe9, 0x2c, 0x34, 0xd5, 0xef, 0xcc, 0xcf, 0xa1, 0x26, 0x4d, 0x0e, 0xb6, 0xdc, 0x66,
+	0xea, 0x65, 0x29, 0x08, 0xe9, 0x42, 0x4d, 0xaf, 0x85, 0x55, 0xfb, 0x2a, 0x65, 0x9b, 0xbf, 0x06,
+	0xed, 0x5d, 0x14, 0x22, 0x1f, 0x85, 0x56, 0xd8, 0x51, 0x8b, 0x30, 0xa5, 0x4c, 0x9e, 0xba, 0x25,
+	0x52, 0xb8, 0x42, 0x9d, 0x05, 0x51, 0x2c, 0xca, 0x61, 0xff, 0x9b, 0xbf, 0x67, 0x00, 0xe9, 0x45,
+	0xb1, 0x3b, 0x74, 0x62, 0xba, 0x45, 0x13, 0xd5, 0xb0, 0x0f, 0x4d, 0xcc, 0xed, 0x28, 0x58, 0x1f,
+	0x8a, 0x25, 0x19, 0x15, 0xed, 0xb7, 0xc4, 0x74, 0xce, 0x7f, 0xb0, 0xaa, 0x72, 0x73, 0xa5, 0xab,
+	0x65, 0x80, 0xb3, 0x2d, 0x76, 0xc2, 0x53, 0x1a, 0x33, 0x93, 0x47, 0x18, 0xcc, 0xc0, 0xa1, 0x8d,
+	0xc0, 0x3f, 0xe9, 0x7e, 0x0f, 0xe6, 0x72, 0x79, 0xa8, 0xfa, 0xb9, 0x5e, 0xa0, 0x9f, 0xcb, 0xaa,
+	0x7e, 0xee, 0xc3, 0xbc, 0x56, 0x2f, 0x21, 0x71, 0x1d, 0x98, 0xc6, 0x89, 0x81, 0x16, 0x25, 0x5b,
+	0xe5, 0x2d, 0x99, 0x24, 0x6b, 0x70, 0xfb, 0x84, 0xd2, 0xd0, 0x89, 0x59, 0x92, 0x4d, 0x1d, 0x1c,
+	0x13, 0x91, 0x73, 0x21, 0xcd, 0xfc, 0xed, 0x12, 0xb4, 0x50, 0x93, 0x3e, 0x77, 0xfc, 0x4b, 0xd9,
+	0x57, 0xbb, 0x85, 0x7d, 0xf5, 0x50, 0x59, 0x94, 0x14, 0xee, 0xaf, 0xda, 0x51, 0xe5, 0x6c, 0x47,
+	0x91, 0x15, 0x68, 0x6a, 0xd5, 0xad, 0x72, 0x13, 0x2e, 0x72, 0xe2, 0x03, 0x1a, 0x3e, 0xbd, 0x8c,
+	0x29, 0x79, 0x17, 0xea, 0xd2, 0xd0, 0x45, 0xc3, 0xb6, 0x5c, 0x64, 0x0a, 0xa7, 0x1c, 0xa9, 0xa5,
+	0x36, 0xad, 0x58, 0x6a, 0xbf, 0xf8, 0x78, 0xbc, 0x05, 0xed, 0xb4, 0xed, 0x62, 0x30, 0x08, 0x54,
+	0x50, 0xba, 0x45, 0x06, 0xec, 0x7f, 0xdc, 0x48, 0x30, 0xc6, 0x8d, 0xc0, 0x4d, 0xad, 0x41, 0x02,
+	0x15, 0x34, 0x35, 0x25, 0x23, 0xfe, 0x3f, 0xd1, 0xc6, 0xfe, 0x06, 0x7a, 0x6c, 0x19, 0x6a, 0x11,
+	0xf5, 0x07, 0xb6, 0xe3, 0x79, 0x4c, 0x9b, 0xd7, 0xac, 0x69, 0x4c, 0xaf, 0x7b, 0x9e, 0xde, 0x99,
+	0xd3, 0x37, 0xef, 0xcc, 0x9a, 0x6a, 0xf6, 0xbe, 0x0d, 0x73, 0x4a, 0x13, 0xaf, 0xe8, 0x8c, 0x33,
+	0x20, 0xbb, 0x6e, 0x14, 0xbf, 0xf0, 0xa3, 0x91, 0x62, 0x97, 0xdd, 0x81, 0x3a, 0xea, 0x7d, 0x6c,
+	0x1e, 0xd7, 0x21, 0x55, 0x0b, 0x17, 0x02, 0x6c, 0x5c, 0xc4, 0x88, 0xce, 0x6b, 0x41, 0x2c, 0x09,
+	0xa2, 0xf3, 0x9a, 0x13, 0x15, 0xab, 0xba, 0xac, 0x5b, 0xd5, 0x1f, 0xc2, 0xbc, 0x56, 0x92, 0xa8,
+	0xd4, 0x1b, 0x50, 0x1d, 0xc7, 0xaf, 0x03, 0x69, 0x4f, 0x37, 0x44, 0x53, 0x71, 0xa7, 0x67, 0x71,
+	0x8a, 0xf9, 0x02, 0xe6, 0xf6, 0xe8, 0x85, 0x50, 0x36, 0xb2, 0x8a, 0x6f, 0x5d, 0xbb, 0x0b, 0xac,
+	0x24, 0xbb, 0x3f, 0x51, 0xa1, 0x92, 0x5e, 0xa1, 0x55, 0x20, 0x6a, 0xb6, 0xe9, 0xf4, 0x95, 0xbb,
+	0x45, 0x43, 0xdb, 0x2d, 0x9a, 0x6f, 0x01, 0x39, 0x74, 0x4f, 0xfd, 0xe7, 0x34, 0x8a, 0x9c, 0xd3,
+	0x44, 0x71, 0xb5, 0xa1, 0x3c, 0x8c, 0x4e, 0x85, 0xa2, 0xc5, 0x7f, 0xcd, 0xf7, 0x61, 0x5e, 0xe3,
+	0x13, 0x19, 0xdf, 0x85, 0x7a, 0xe4, 0x9e, 0xfa, 0x4e, 0x3c, 0x0e, 0xa9, 0xc8, 0x3a, 0x05, 0xcc,
+	0x2d, 0xb8, 0xfd, 0x19, 0x0d, 0xdd, 0x93, 0xcb, 0xeb, 0xb2, 0xd7, 0xf3, 0x29, 0x65, 0xf3, 0xe9,
+	0xc1, 0x42, 0x26, 0x1f, 0x51, 0x3c, 0x9f, 0x37, 0x62, 0xf4, 0x6b, 0x16, 0x4f, 0x28, 0x9a, 0xbb,
+	0xa4, 0x6a, 0x6e, 0xf3, 0x05, 0x90, 0x8d, 0xc0, 0xf7, 0x69, 0x3f, 0x3e, 0xa0, 0x34, 0x4c, 0x1d,
+	0x55, 0xe9, 0x24, 0x69, 0xac, 0x2d, 0x89, 0x3e, 0xcf, 0x2e, 0x07, 0x62, 0xf6, 0x10, 0xa8, 0x8c,
+	0x68, 0x38, 0x64, 0x19, 0xd7, 0x2c, 0xf6, 0xbf, 0xb9, 0x00, 0xf3, 0x5a, 0xb6, 0x62, 0x6b, 0xff,
+	0x1e, 0x2c, 0x6c, 0xba, 0x51, 0x3f, 0x5f, 0x60, 0x07, 0xa6, 0x47, 0xe3, 0x63, 0x3b, 0x55, 0x01,
+	0x32, 0x89, 0x7e, 0x82, 0xec, 0x27, 0x22, 0xb3, 0xbf, 0x64, 0x40, 0x65, 0xfb, 0x68, 0x77, 0x03,
+	0x57, 0x3a, 0xd7, 0xef, 0x07, 0x43, 0xb4, 0x1f, 0x79, 0xa3, 0x93, 0xf4, 0xc4, 0xa9, 0x7d, 0x17,
+	0xea, 0xcc, 0xec, 0xc4, 0x0d, 0xae, 0xb0, 0xe2, 0x52, 0x00, 0x37, 0xd7, 0xf4, 0xf5, 0xc8, 0x0d,
+	0xd9, 0xee, 0x59, 0xee, 0x89, 0x2b, 0x6c, 0x91, 0xcc, 0x13, 0xcc, 0xff, 0x3e, 0x05, 0xd3, 0xc2,
+	0x74, 0x60, 0xe5, 0xf5, 0x63, 0xf7, 0x9c, 0xa6, 0x66, 0x08, 0xa6, 0xd0, 0xa4, 0x0f, 0xe9, 0x30,
+	0x88, 0x13, 0xeb, 0x93, 0x0f, 0x83, 0x0e, 0x22, 0x97, 0x34, 0x81, 0xb8, 0xbb, 0x81, 0x4f, 0x2d,
+	0x1d, 0x24, 0x77, 0x61, 0x5a, 0x9a, 0x32, 0x95, 0x64, 0xaf, 0x23, 0x21, 0xec, 0x8d, 0xbe, 0x33,
+	0x72, 0xfa, 0x6e, 0x7c, 0x29, 0xf4, 0x51, 0x92, 0xc6, 0xfc, 0xbd, 0xa0, 0xef, 0xa0, 0xd7, 0xc8,
+	0x73, 0xfc, 0x3e, 0x95, 0xce, 0x09, 0x0d, 0xc4, 0x8d, 0xba, 0xa8, 0x96, 0x64, 0xe3, 0x9b, 0xf9,
+	0x0c, 0x8a, 0x16, 0x48, 0x3f, 0x18, 0x0e, 0xdd, 0x18, 0xf7, 0xf7, 0x4c, 0x2d, 0x95, 0x2d, 0x05,
+	0x61, 0xad, 0xe1, 0xa9, 0x0b, 0xde, 0x83, 0x75, 0xe9, 0x0a, 0x51, 0x40, 0xcc, 0x25, 0x63, 0x5f,
+	0x96, 0x2d, 0x05, 0xc1, 0xb1, 0x18, 0xfb, 0x11, 0x8d, 0x63, 0x8f, 0x0e, 0x92, 0x0a, 0x35, 0x18,
+	0x5b, 0x9e, 0x40, 0x9e, 0xc0, 0x3c, 0x77, 0x39, 0x44, 0x4e, 0x1c, 0x44, 0x67, 0x6e, 0x64, 0x47,
+	0xb8, 0xd9, 0x6e, 0x32, 0xfe, 0x22, 0x12, 0xf9, 0x10, 0x96, 0x32, 0x70, 0x48, 0xfb, 0xd4, 0x3d,
+	0xa7, 0x03, 0x66, 0x80, 0x96, 0xad, 0x49, 0x64, 0xb2, 0x02, 0x0d, 0xf4, 0xb4, 0x8c, 0x47, 0x03,
+	0x07, 0x4d, 0xb0, 0x59, 0x66, 0x1a, 0xab, 0x10, 0x79, 0x0f, 0xa4, 0x95, 0x29, 0x6c, 0xdf, 0x96,
+	0xa6, 0xfb, 0x50, 0x7a, 0x2d, 0x9d, 0x83, 0xdc, 0x55, 0x0d, 0xea, 0xb6, 0xd8, 0xa2, 0x4a, 0x80,
+	0xcd, 0x93, 0xd0, 0x3d, 0x77, 0x62, 0xda, 0x99, 0xe3, 0xab, 0x89, 0x48, 0xe2, 0x77, 0xae, 0xef,
+	0xc6, 0xae, 0x13, 0x07, 0x61, 0x87, 0x30, 0x5a, 0x0a, 0x60, 0x27, 0x32, 0xf9, 0x88, 0x62, 0x27,
+	0x1e, 0x47, 0xc2, 0xbe, 0x9e, 0xe7, 0x7b, 0xad, 0x1c, 0x81, 0x7c, 0x00, 0x8b, 0x5c, 0x22, 0x18,
+	0x49, 0xec, 0x1c, 0x98, 0xa1, 0x73, 0x9b, 0xf5, 0xc8, 0x04, 0x2a, 0x76, 0xa5, 0x10, 0x91, 0xdc,
+	0x87, 0x0b, 0xbc, 0x2b, 0x27, 0x90, 0xb1, 0x7e, 0x58, 0x03, 0xb7, 0x6f, 0x0b, 0x0e, 0x9c, 0x22,
+	0x8b, 0xac, 0x15, 0x79, 0x82, 0xf9, 0x9b, 0x06, 0x5f, 0x62, 0xc4, 0xa4, 0x8b, 0x94, 0x0d, 0x1e,
+	0x9f, 0x6e, 0x76, 0xe0, 0x7b, 0x97, 0x62, 0x06, 0x02, 0x87, 0xf6, 0x7d, 0xef, 0x12, 0xb7, 0x18,
+	0xae, 0xaf, 0xb2, 0x70, 0x9d, 0xd5, 0x74, 0x7d, 0x85, 0xe9, 0x01, 0x34, 0x46, 0xe3, 0x63, 0xcf,
+	0xed, 0x73, 0x96, 0x32, 0xcf, 0x85, 0x43, 0x8c, 0x01, 0x77, 0xb7, 0xbc, 0xd7, 0x39, 0x47, 0x85,
+	0x71, 0x34, 0x04, 0x86, 0x2c, 0xe6, 0x53, 0xb8, 0xad, 0x57, 0x50, 0x28, 0xe7, 0x47, 0x50, 0x13,
+	0x73, 0x39, 0x12, 0x2e, 0x86, 0x59, 0xc5, 0x63, 0x8b, 0x1b, 0xb2, 0x84, 0x6e, 0xfe, 0x8f, 0x0a,
+	0xcc, 0x0b, 0x74, 0xc3, 0x0b, 0x22, 0x7a, 0x38, 0x1e, 0x0e, 0x9d, 0xb0, 0x40, 0x49, 0x18, 0xd7,
+	0x28, 0x89, 0x52, 0x5e, 0x49, 0xdc, 0xd7, 0x76, 0xba, 0x5c, 0xcb, 0x28, 0x08, 0x79, 0x08, 0xad,
+	0xbe, 0x17, 0x44, 0x7c, 0xe3, 0xa1, 0x3a, 0x0d, 0xb3, 0x70, 0x5e, 0xb1, 0x55, 0x8b, 0x14, 0x9b,
+	0xaa, 0x94, 0xa6, 0x32, 0x4a, 0xc9, 0x84, 0x26, 0x66, 0x4a, 0xa5, 0x9e, 0x9d, 0x16, 0xdb, 0x3e,
+	0x05, 0xc3, 0xfa, 0x64, 0x55, 0x00, 0xd7, 0x37, 0xad, 0x22, 0x05, 0x80, 0x3e, 0x49, 0xd4, 0xe3,
+	0x0a, 0x77, 0x5d, 0x28, 0x80, 0x3c, 0x89, 0x6c, 0x01, 0xf0, 0xb2, 0x98, 0x99, 0x01, 0xcc, 0xcc,
+	0x78, 0x4b, 0x1f, 0x15, 0xb5, 0xff, 0x57, 0x31, 0x31, 0x0e, 0x29, 0x33, 0x3d, 0x94, 0x2f, 0xc9,
+	0xfb, 0xd0, 0x08, 0x69, 0x14, 0x78, 0x63, 0xee, 0x36, 0xe4, 0xc3, 0x3b, 0x27, 0x32, 0xb2, 0x12,
+	0x8a, 0xa5, 0x72, 0x99, 0x7f, 0xc5, 0x80, 0x86, 0x92, 0x21, 0x59, 0x80, 0xb9, 0x8d, 0xfd, 0xfd,
+	0x83, 0x9e, 0xb5, 0x7e, 0xb4, 0xf3, 0x59, 0xcf, 0xde, 0xd8, 0xdd, 0x3f, 0xec, 0xb5, 0x6f, 0x21,
+	0xbc, 0xbb, 0xbf, 0xb1, 0xbe, 0x6b, 0x6f, 0xed, 0x5b, 0x1b, 0x12, 0x36, 0xc8, 0x22, 0x10, 0xab,
+	0xf7, 0x7c, 0xff, 0xa8, 0xa7, 0xe1, 0x25, 0xd2, 0x86, 0xe6, 0x53, 0xab, 0xb7, 0xbe, 0xb1, 0x2d,
+	0x90, 0x32, 0xb9, 0x0d, 0xed, 0xad, 0x17, 0x7b, 0x9b, 0x3b, 0x7b, 0xcf, 0xec, 0x8d, 0xf5, 0xbd,
+	0x8d, 0xde, 0x6e, 0x6f, 0xb3, 0x5d, 0x21, 0x33, 0x50, 0x5f, 0x7f, 0xba, 0xbe, 0xb7, 0xb9, 0xbf,
+	0xd7, 0xdb, 0x6c, 0x57, 0xcd, 0x7f, 0x56, 0x02, 0x48, 0x2b, 0x4a, 0xbe, 0x87, 0xc7, 0x11, 0x32,
+	0x65, 0x2b, 0x46, 0xd8, 0x42, 0xae, 0x51, 0xac, 0x33, 0xb2, 0xdc, 0x64, 0x0d, 0xa6, 0x83, 0x71,
+	0xdc, 0x0f, 0x86, 0xdc, 0x7e, 0x99, 0x5d, 0xeb, 0xe4, 0x3e, 0xdc, 0xe7, 0x74, 0x4b, 0x32, 0x6a,
+	0xce, 0xf6, 0xf2, 0x75, 0xce, 0x76, 0xdd, 0xaf, 0x2f, 0x1c, 0x2d, 0x29, 0x82, 0xf4, 0xe8, 0x82,
+	0xd2, 0x11, 0xdb, 0x3d, 0x0b, 0xc9, 0x54, 0x90, 0x9c, 0x8b, 0x6b, 0x2a, 0xef, 0xe2, 0xc2, 0x3c,
+	0x50, 0x33, 0x8b, 0x9d, 0x32, 0xf7, 0xb4, 0x28, 0x88, 0xf9, 0x9f, 0x0c, 0x58, 0x60, 0xf2, 0x31,
+	0xc8, 0xaa, 0xa3, 0x15, 0x68, 0xf4, 0x83, 0x60, 0x44, 0x43, 0x47, 0x31, 0x08, 0x54, 0x08, 0x55,
+	0x0d, 0x57, 0xa5, 0x27, 0x41, 0xd8, 0xa7, 0x42, 0x1b, 0x01, 0x83, 0xb6, 0x10, 0x41, 0x55, 0x23,
+	0x26, 0x12, 0xe7, 0xe0, 0xca, 0xa8, 0xc1, 0x31, 0xce, 0xb2, 0x08, 0x53, 0xc7, 0x21, 0x75, 0xfa,
+	0x67, 0x42, 0x0f, 0x89, 0x14, 0x1e, 0xd7, 0x48, 0xdf, 0x41, 0x1f, 0xe5, 0xdc, 0xa3, 0xbc, 0x07,
+	0x6a, 0x56, 0x4b, 0xe0, 0x1b, 0x02, 0xc6, 0xb5, 0xc3, 0x39, 0x76, 0xfc, 0x41, 0xe0, 0xd3, 0x81,
+	0xd8, 0xa5, 0xa4, 0x80, 0x79, 0x00, 0x8b, 0xd9, 0xf6, 0x09, 0x6d, 0xf6, 0x81, 0xa2, 0xcd, 0xb8,
+	0x55, 0xdf, 0x9d, 0x3c, 0x6f, 0x14, 0xcd, 0xb6, 0x0c, 0x4b, 0x07, 0xe1, 0xd8, 0x77, 0x8e, 0x3d,
+	0x9a, 0xe9, 0x33, 0x54, 0xed, 0xad, 0x0c, 0xed, 0x86, 0x0a, 0x2f, 0xab, 0x46, 0x4a, 0x05, 0x6a,
+	0x04, 0xc7, 0x3b, 0x1c, 0xfb, 0x09, 0x8f, 0xf0, 0x7b, 0xa8, 0x18, 0x93, 0x19, 0xf7, 0xa7, 0x54,
+	0xf8, 0x55, 0x84, 0x4c, 0xa5, 0x88, 0xf9, 0x77, 0x0c, 0xe8, 0xe4, 0x6b, 0x2f, 0x7a, 0x64, 0x2d,
+	0xd7, 0x23, 0x8b, 0xa2, 0x47, 0x32, 0x9f, 0xa4, 0xbd, 0x81, 0x62, 0xc2, 0x2d, 0x0c, 0x5e, 0x62,
+	0x89, 0x9b, 0x11, 0x0a, 0x84, 0xab, 0x63, 0x48, 0xfb, 0x9e, 0xe3, 0x0e, 0x31, 0x07, 0xc1, 0x57,
+	0x66, 0x7c, 0x79, 0x82, 0xf9, 0x07, 0x25, 0xa8, 0xa0, 0xa1, 0x3c, 0xd9, 0xa8, 0x56, 0xf7, 0x3e,
+	0xe5, 0xdc, 0x49, 0x19, 0xcb, 0x85, 0x9b, 0x4d, 0xa2, 0xf5, 0x29, 0x92, 0xd2, 0x43, 0xda, 0x3f,
+	0x17, 0xce, 0x4b, 0x05, 0x41, 0x45, 0x8f, 0x3b, 0x62, 0xf6, 0xb5, 0x50, 0xf4, 0x32, 0x2d, 0x69,
+	0xec, 0xcb, 0xe9, 0x94, 0xc6, 0xbe, 0xeb, 0xc0, 0xb4, 0xeb, 0x1f, 0x07, 0x63, 0x7f, 0xc0, 0x14,
+	0x7b, 0xcd, 0x92, 0x49, 0x76, 0x36, 0xc7, 0x16, 0x1c, 0x77, 0x28, 0xd5, 0x78, 0x0a, 0x90, 0x35,
+	0xa8, 0x47, 0x97, 0x7e, 0x5f, 0xd5, 0xdd, 0xb7, 0x65, 0x8f, 0x53, 0x1a, 0xae, 0x1e, 0x5e, 0xfa,
+	0x7d, 0xa6, 0x9c, 0x52, 0x36, 0xf3, 0x7b, 0x50, 0x93, 0x30, 0x6a, 0xca, 0x17, 0x7b, 0x9f, 0xee,
+	0xed, 0xbf, 0xdc, 0xb3, 0x0f, 0x7f, 0xb0, 0xb7, 0xd1, 0xbe, 0x45, 0x5a, 0xd0, 0x58, 0xdf, 0x60,
+	0xca, 0x97, 0x01, 0x06, 0xb2, 0x1c, 0xac, 0x1f, 0x1e, 0x26, 0x48, 0xc9, 0x24, 0xe8, 0x1a, 0x8b,
+	0xd8, 0x6e, 0x24, 0x11, 0xdc, 0x0f, 0x60, 0x4e, 0xc1, 0xd2, 0x3d, 0xef, 0x08, 0x81, 0xcc, 0x9e,
+	0x17, 0x99, 0x2c, 0x4e, 0x31, 0xdb, 0x18, 0x25, 0x10, 0xef, 0xf8, 0x27, 0x81, 0xcc, 0xe9, 0xbf,
+	0x56, 0xa0, 0x95, 0x40, 0x22, 0xa3, 0x87, 0xd0, 0x72, 0x07, 0xd4, 0x8f, 0xdd, 0xf8, 0xd2, 0xd6,
+	0x3c, 0x70, 0x59, 0x18, 0xb7, 0x7f, 0x8e, 0xe7, 0x3a, 0xf2, 0x08, 0x94, 0x27, 0xd0, 0x23, 0x85,
+	0x76, 0xa9, 0xea, 0x09, 0x65, 0x32, 0xca, 0x27, 0x40, 0x21, 0x0d, 0x57, 0x52, 0xc4, 0x85, 0xb9,
+	0x94, 0x7c, 0xc2, 0xb7, 0x41, 0x45, 0x24, 0x1c, 0x2a, 0x9e, 0x13, 0x36, 0xb9, 0xca, 0x6d, 0xd7,
+	0x04, 0xc8, 0x9d, 0x31, 0x4e, 0xf1, 0xc9, 0x97, 0x3d, 0x63, 0x54, 0xce, 0x29, 0x6b, 0xb9, 0x73,
+	0x4a, 0xb4, 0x03, 0x2e, 0xfd, 0x3e, 0x1d, 0xd8, 0x71, 0x60, 0x33, 0x7b, 0x85, 0x89, 0x44, 0xcd,
+	0xca, 0xc2, 0x68, 0xff, 0xc4, 0x34, 0x8a, 0x7d, 0xca, 0x0f, 0x7a, 0x6a, 0x4f, 0x4b, 0x1d, 0xc3,
+	0x92, 0x10, 0xee, 0x59, 0xc7, 0xa1, 0x8b, 0xbe, 0x68, 0x3c, 0x81, 0x64, 0xff, 0x93, 0x6f, 0xc3,
+	0xc2, 0x31, 0x8d, 0x62, 0xfb, 0x8c, 0x3a, 0x03, 0x1a, 0x32, 0xf1, 0xe2, 0x47, 0x9d, 0x7c, 0x1b,
+	0x50, 0x4c, 0x44, 0xc1, 0x3d, 0xa7, 0x61, 0xe4, 0x06, 0x3e, 0xdb, 0x00, 0xd4, 0x2d, 0x99, 0xc4,
+	0xfc, 0xb0, 0xf1, 0xae, 0x9f, 0xe9, 0xa6, 0x4e, 0x8b, 0x35, 0xbc, 0x98, 0x48, 0xde, 0x84, 0x29,
+	0xd6, 0x80, 0xa8, 0xd3, 0x5e, 0x29, 0x2b, 0x07, 0x1d, 0x1b, 0x08, 0x5a, 0x82, 0x86, 0xa3, 0xdc,
+	0x0f, 0xbc, 0x20, 0x64, 0xbb, 0x80, 0xba, 0xc5, 0x13, 0x7a, 0xef, 0x9c, 0x86, 0xce, 0xe8, 0x4c,
+	0xec, 0x04, 0xb2, 0xf0, 0x27, 0x95, 0x5a, 0xa3, 0xdd, 0x34, 0xff, 0x04, 0x54, 0x59, 0xb6, 0x2c,
+	0x3b, 0xd6, 0x99, 0x86, 0xc8, 0x8e, 0xa1, 0x1d, 0x98, 0xf6, 0x69, 0x7c, 0x11, 0x84, 0xaf, 0xa4,
+	0x47, 0x45, 0x24, 0xcd, 0x9f, 0x32, 0xaf, 0x41, 0x72, 0xbe, 0xfc, 0x82, 0x6d, 0x77, 0xd0, 0x5f,
+	0xc4, 0x87, 0x2a, 0x3a, 0x73, 0x84, 0x23, 0xa3, 0xc6, 0x80, 0xc3, 0x33, 0x07, 0x57, 0x32, 0x6d,
+	0xf4, 0xb9, 0x3f, 0xa9, 0xc1, 0xb0, 0x6d, 0x3e, 0xf8, 0x6f, 0xc2, 0xac, 0x3c, 0xb9, 0x8e, 0x6c,
+	0x8f, 0x9e, 0x24, 0xfa, 0xd9, 0x1f, 0x0f, 0xb1, 0xb8, 0x68, 0x97, 0x9e, 0xc4, 0xe6, 0x1e, 0xcc,
+	0x09, 0x1d, 0xba, 0x3f, 0xa2, 0xb2, 0xe8, 0xef, 0x16, 0x2d, 0x11, 0x8d, 0xb5, 0x79, 0x7d, 0x39,
+	0xe2, 0xe6, 0x83, 0xce, 0x69, 0x5a, 0x40, 0xd4, 0xd5, 0x4a, 0x64, 0x28, 0x56, 0x13, 0xe9, 0x79,
+	0x17, 0xcd, 0xd1, 0x30, 0xec, 0x9f, 0x68, 0xdc, 0xef, 0xcb, 0x78, 0x83, 0x9a, 0x25, 0x93, 0xe6,
+	0x6f, 0x1b, 0x30, 0xcf, 0x72, 0x93, 0xda, 0x5e, 0x58, 0x04, 0x1f, 0x7e, 0x85, 0x6a, 0x36, 0xfb,
+	0x4a, 0x0a, 0x47, 0x48, 0xb5, 0x11, 0x78, 0xe2, 0xab, 0x3b, 0x28, 0x2b, 0x59, 0x07, 0xa5, 0xf9,
+	0x37, 0x0d, 0x98, 0xe3, 0xcb, 0x34, 0xdb, 0x01, 0x8a, 0xe6, 0xff, 0x49, 0x98, 0xe1, 0x0b, 0xa7,
+	0xd0, 0x0a, 0xa2, 0xa2, 0xa9, 0x6a, 0x65, 0x28, 0x67, 0xde, 0xbe, 0x65, 0xe9, 0xcc, 0xe4, 0x63,
+	0xb6, 0xbb, 0xf0, 0x6d, 0x86, 0x16, 0x44, 0xa6, 0xe8, 0x7d, 0xbd, 0x7d, 0xcb, 0x52, 0xd8, 0x9f,
+	0xd6, 0x60, 0x8a, 0x6f, 0x9f, 0xcd, 0x67, 0x30, 0xa3, 0x15, 0xa4, 0xf9, 0x35, 0x9b, 0xdc, 0xaf,
+	0x99, 0x3b, 0xca, 0x28, 0x15, 0x1c, 0x65, 0xfc, 0xd3, 0x32, 0x10, 0x14, 0x96, 0xcc, 0x68, 0xac,
+	0xe8, 0xe7, 0x81, 0x32, 0x48, 0x25, 0x85, 0xc8, 0x2a, 0x10, 0x25, 0x29, 0xcf, 0x28, 0xf9, 0x92,
+	0x59, 0x40, 0x41, 0x35, 0x2b, 0xec, 0xb9, 0xe4, 0xfc, 0x8f, 0xf9, 0x9e, 0x78, 0xb7, 0x17, 0xd2,
+	0x70, 0x55, 0x64, 0x87, 0x81, 0x68, 0xc1, 0x0a, 0x7f, 0x8d, 0x4c, 0x67, 0xc7, 0x77, 0xea, 0xda,
+	0xf1, 0x9d, 0xce, 0x39, 0xa0, 0x15, 0x8f, 0x41, 0x4d, 0xf7, 0x18, 0xbc, 0x09, 0x33, 0xf2, 0xcc,
+	0xcf, 0x1e, 0x62, 0xe9, 0xc2, 0x3d, 0xa3, 0x81, 0x78, 0xca, 0x2c, 0x37, 0xed, 0x89, 0x5b, 0x82,
+	0x9f, 0x9c, 0xe7, 0x70, 0xd4, 0xff, 0xa9, 0x37, 0xb9, 0xc1, 0x2a, 0x9b, 0x02, 0x6c, 0x8f, 0x8f,
+	0x12, 0x62, 0x8f, 0x7d, 0x11, 0x9c, 0x42, 0x07, 0x9d, 0xa6, 0xd8, 0xe3, 0x67, 0x09, 0xe6, 0x5f,
+	0x37, 0xa0, 0x8d, 0x63, 0xa6, 0x89, 0xe5, 0x47, 0xc0, 0x66, 0xc5, 0x0d, 0xa5, 0x52, 0xe3, 0x25,
+	0x1f, 0x42, 0x9d, 0xa5, 0x83, 0x11, 0xf5, 0x85, 0x4c, 0x76, 0x74, 0x99, 0x4c, 0xf5, 0xc9, 0xf6,
+	0x2d, 0x2b, 0x65, 0x56, 0x24, 0xf2, 0xf7, 0x0d, 0x68, 0x88, 0x52, 0xbe, 0xb6, 0xe7, 0xb1, 0x9b,
+	0xd9, 0xe0, 0xd4, 0x95, 0xfd, 0xcc, 0x43, 0x68, 0x0d, 0xd1, 0xbd, 0x8b, 0xeb, 0xb9, 0xe6, 0x75,
+	0xcc, 0xc2, 0xb8, 0x38, 0x33, 0xd5, 0x19, 0xd9, 0xb1, 0xeb, 0xd9, 0x92, 0x2a, 0xe2, 0x76, 0x8a,
+	0x48, 0xa8, 0x41, 0xa2, 0x18, 0x63, 0x04, 0xf8, 0xba, 0xcb, 0x13, 0xe8, 0x5e, 0x3d, 0x48, 0xcf,
+	0x41, 0x55, 0x4b, 0xfc, 0xe7, 0xb7, 0x61, 0x29, 0x47, 0x4a, 0xa2, 0x0c, 0x85, 0x2b, 0xcd, 0x73,
+	0x87, 0xc7, 0x41, 0xb2, 0xc9, 0x36, 0x54, 0x2f, 0x9b, 0x46, 0x22, 0xa7, 0xb0, 0x20, 0x0d, 0x0c,
+	0xec, 0xd3, 0x74, 0x31, 0x2c, 0xb1, 0x55, 0xee, 0x3d, 0x7d, 0x08, 0xb3, 0x05, 0x4a, 0x5c, 0x9d,
+	0xc4, 0xc5, 0xf9, 0x91, 0x33, 0xe8, 0x48, 0x82, 0x54, 0xd6, 0x8a, 0xb5, 0x83, 0x65, 0xbd, 0x73,
+	0x4d, 0x59, 0xda, 0x66, 0xc7, 0x9a, 0x98, 0x1b, 0xb9, 0x84, 0xfb, 0x92, 0xc6, 0xb4, 0x71, 0xbe,
+	0xbc, 0xca, 0x8d, 0xda, 0xc6, 0xb6, 0x71, 0x7a, 0xa1, 0xd7, 0x64, 0x4c, 0x3e, 0x87, 0xc5, 0x0b,
+	0xc7, 0x8d, 0x65, 0xb5, 0x14, 0xdb, 0xa2, 0xca, 0x8a, 0x5c, 0xbb, 0xa6, 0xc8, 0x97, 0xfc, 0x63,
+	0x6d, 0x89, 0x9a, 0x90, 0x23, 0xf1, 0x60, 0x59, 0xd6, 0x86, 0xef, 0x2c, 0xe9, 0x20, 0x2d, 0x8e,
+	0x9f, 0x01, 0xae, 0x5e, 0x53, 0xdc, 0x53, 0xf1, 0x9d, 0x2c, 0x6a, 0x72, 0x86, 0xdd, 0xdf, 0x2d,
+	0xc1, 0xac, 0x9e, 0x0d, 0x4e, 0x0a, 0xa1, 0x69, 0xa4, 0xc6, 0x95, 0xb6, 0x6f, 0x06, 0xce, 0x6f,
+	0x14, 0x4b, 0x45, 0x1b, 0x45, 0xd5, 0x17, 0x55, 0xbe, 0xce, 0x41, 0x5e, 0xb9, 0x99, 0x83, 0xbc,
+	0x5a, 0xe8, 0x20, 0x9f, 0xec, 0x47, 0x9d, 0xfa, 0xba, 0x7e, 0xd4, 0xe9, 0x2b, 0xfd, 0xa8, 0xdd,
+	0xff, 0x6d, 0x00, 0xc9, 0xcf, 0x15, 0xf2, 0x8c, 0x3b, 0x03, 0x7d, 0xea, 0x09, 0x95, 0xf9, 0xee,
+	0xcd, 0xe6, 0x9b, 0x1c, 0x30, 0xf9, 0x35, 0x4e, 0x7c, 0x35, 0xb0, 0x50, 0xdf, 0x6b, 0x17, 0x91,
+	0x32, 0x87, 0x04, 0x95, 0xeb, 0x0f, 0x09, 0xaa, 0xd7, 0x1f, 0x12, 0x4c, 0x65, 0x0f, 0x09, 0xba,
+	0x7f, 0xd1, 0x80, 0xf9, 0x02, 0xa1, 0xfe, 0xe6, 0x1a, 0x8e, 0x82, 0xa1, 0xe9, 0xba, 0x92, 0x10,
+	0x0c, 0x15, 0xec, 0xfe, 0x19, 0x98, 0xd1, 0x26, 0xf2, 0x37, 0x57, 0x7e, 0xd6, 0x1e, 0xe5, 0x92,
+	0xad, 0x61, 0xdd, 0xbf, 0x5b, 0x06, 0x92, 0x57, 0x26, 0xff, 0x5f, 0xeb, 0x90, 0xef, 0xa7, 0x72,
+	0x41, 0x3f, 0xfd, 0xb1, 0xae, 0x73, 0xdc, 0x59, 0x82, 0xd1, 0xd2, 0x8a, 0xfb, 0x97, 0x4b, 0x4c,
+	0x9e, 0x80, 0x16, 0xb9, 0x7e, 0x42, 0x53, 0xd3, 0xa2, 0x3d, 0x95, 0xc5, 0x3e, 0x7b, 0x50, 0x93,
+	0x71, 0xf7, 0xd6, 0x6f, 0xe2, 0xee, 0xed, 0xfe, 0xbb, 0x12, 0xb4, 0x32, 0xda, 0xf0, 0x9b, 0x1b,
+	0x9f, 0x15, 0x68, 0x70, 0x85, 0xaa, 0x0e, 0x8f, 0x0a, 0xe1, 0xe8, 0x88, 0xa4, 0xe6, 0x00, 0xd3,
+	0xc1, 0xfc, 0x18, 0x56, 0x8a, 0xc6, 0xb0, 0xb0, 0x9f, 0xab, 0x93, 0xfa, 0xf9, 0x33, 0x68, 0x7d,
+	0x3e, 0x8e, 0x62, 0xb7, 0x4f, 0x6d, 0x6e, 0x9a, 0xcb, 0xb5, 0xe3, 0xba, 0xd5, 0xf8, 0x13, 0xfe,
+	0xd5, 0x3e, 0xfb, 0xc8, 0xca, 0x66, 0xd2, 0xfd, 0xa3, 0x12, 0xcc, 0x68, 0x2c, 0xbf, 0xb8, 0xd7,
+	0x7a, 0x9f, 0x19, 0x4a, 0xb1, 0xf4, 0x59, 0x7f, 0xf7, 0xab, 0x54, 0x50, 0xa6, 0xd0, 0x6a, 0xa5,
+	0x16, 0xcf, 0xe7, 0x9b, 0x75, 0x69, 0x3f, 0x84, 0xd6, 0x80, 0x3a, 0x03, 0xcf, 0x4d, 0xbd, 0x98,
+	0xdc, 0xd3, 0x92, 0x85, 0x33, 0xce, 0xef, 0xa9, 0xac, 0xf3, 0xdb, 0x7c, 0x0a, 0x4d, 0xb5, 0xb6,
+	0xa4, 0x01, 0xd3, 0x07, 0x3d, 0x76, 0x44, 0xd0, 0xbe, 0x85, 0x4e, 0xaf, 0xc3, 0xde, 0xc6, 0xfe,
+	0xde, 0xa6, 0xbd, 0xdb, 0xfb, 0xac, 0xb7, 0xdb, 0x36, 0x48, 0x1d, 0xaa, 0x87, 0x2f, 0x7b, 0x07,
+	0x47, 0xed, 0x12, 0xa9, 0x41, 0x65, 0x77, 0xff, 0xf0, 0xa8, 0x5d, 0x36, 0xbb, 0xd0, 0x11, 0x1d,
+	0xd2, 0x3b, 0xa7, 0x7e, 0x7c, 0x38, 0x3e, 0xe6, 0x31, 0xf3, 0x6e, 0xe0, 0x9b, 0xff, 0xbc, 0x0c,
+	0x44, 0x25, 0x0a, 0x1b, 0xfe, 0xdb, 0xd0, 0x54, 0x2d, 0x36, 0x21, 0xf3, 0x99, 0x63, 0x30, 0xb4,
+	0xde, 0x55, 0x2e, 0xb2, 0x09, 0xb3, 0xcc, 0x2e, 0x49, 0x4c, 0x05, 0x36, 0x3a, 0x57, 0x3a, 0x9c,
+	0xb7, 0x6f, 0x59, 0x99, 0x6f, 0xc8, 0xaf, 0xc2, 0xac, 0xee, 0x6f, 0xe9, 0x94, 0x27, 0x6e, 0xc0,
+	0xf1, 0x73, 0x9d, 0x99, 0xac, 0x43, 0x3b, 0xeb, 0xb0, 0xe9, 0x54, 0xae, 0xca, 0x20, 0xc7, 0x4e,
+	0x3e, 0x14, 0xd1, 0x2c, 0x55, 0x26, 0x5b, 0x6f, 0xea, 0x9f, 0x29, 0xdd, 0xb4, 0xca, 0xff, 0xa4,
+	0xf1, 0x2d, 0xe6, 0xaf, 0x03, 0xa4, 0x18, 0x8e, 0xcf, 0xfe, 0x41, 0x6f, 0xcf, 0xde, 0xd8, 0x5e,
+	0xdf, 0xdb, 0xeb, 0xed, 0xb6, 0x6f, 0x11, 0x02, 0xb3, 0xec, 0xb0, 0x67, 0x33, 0xc1, 0x0c, 0xc4,
+	0x84, 0x2f, 0x53, 0x62, 0x25, 0x3c, 0x09, 0xda, 0xd9, 0xcb, 0xa0, 0xe5, 0xa7, 0xf5, 0x44, 0x09,
+	0x99, 0x4f, 0xe0, 0x36, 0xbf, 0x12, 0xf2, 0x94, 0xcf, 0xdd, 0xeb, 0xe3, 0xe8, 0xff, 0xb6, 0x01,
+	0x0b, 0x99, 0x4f, 0xd2, 0x48, 0x66, 0xe1, 0xc8, 0xd6, 0xb6, 0x08, 0x3a, 0xc8, 0x4e, 0xa7, 0xe5,
+	0xc6, 0x2f, 0xb3, 0xc0, 0xe6, 0x09, 0xb8, 0x24, 0x8c, 0xfd, 0x1c, 0x2c, 0x16, 0x9a, 0x22, 0x92,
+	0xb9, 0x94, 0x04, 0x8d, 0xea, 0x4d, 0x32, 0x4f, 0x60, 0x31, 0x4b, 0x48, 0xa3, 0x83, 0xf4, 0x2a,
+	0xcb, 0x24, 0xee, 0xf1, 0xb5, 0x9d, 0x87, 0x5e, 0xdf, 0x42, 0x9a, 0xf9, 0x0f, 0xcb, 0x40, 0xbe,
+	0x3f, 0xa6, 0xe1, 0x25, 0x0b, 0x57, 0x4e, 0x0e, 0x88, 0x96, 0xb2, 0x0e, 0x7a, 0x8c, 0xca, 0xf9,
+	0x94, 0x5e, 0xca, 0xe0, 0xfd, 0x52, 0x1a, 0xbc, 0x5f, 0x14, 0x40, 0x5f, 0xb9, 0x3e, 0x80, 0xbe,
+	0x7a, 0x5d, 0x00, 0x3d, 0x9e, 0x88, 0x9f, 0xfa, 0x01, 0xaa, 0x6a, 0x34, 0xa3, 0xb9, 0x56, 0x6e,
+	0x5a, 0x4d, 0x01, 0xee, 0x21, 0x46, 0x3e, 0x4e, 0x99, 0xe8, 0xe0, 0x94, 0xca, 0x68, 0x35, 0xb9,
+	0x48, 0xf6, 0x06, 0xa7, 0x74, 0x37, 0xe8, 0x3b, 0x71, 0x10, 0x32, 0x2f, 0xab, 0xfc, 0x18, 0x71,
+	0x74, 0x68, 0xce, 0x46, 0xc1, 0x18, 0xb7, 0x31, 0xb2, 0xad, 0xdc, 0xad, 0xdb, 0xe4, 0xe8, 0x01,
+	0x6f, 0xf1, 0x2a, 0xcc, 0x8f, 0x23, 0x6a, 0x0f, 0xdd, 0x08, 0x7d, 0xa7, 0xe8, 0x31, 0x88, 0xc3,
+	0xc0, 0x13, 0xce, 0xdd, 0xb9, 0x71, 0x44, 0x9f, 0x73, 0xca, 0x06, 0x27, 0x90, 0x6f, 0xa7, 0x55,
+	0x1a, 0x39, 0x6e, 0x18, 0x75, 0x40, 0x0b, 0xa0, 0xc3, 0x7a, 0x1f, 0x38, 0x6e, 0x98, 0xd4, 0x05,
+	0x13, 0x51, 0xe6, 0x02, 0x40, 0x23, 0x73, 0x01, 0x40, 0xc4, 0x8f, 0xaf, 0x42, 0x4d, 0x7e, 0x8e,
+	0x1e, 0xa7, 0x93, 0x30, 0x18, 0x4a, 0x8f, 0x13, 0xfe, 0x4f, 0x66, 0xa1, 0x14, 0x07, 0xc2, 0x5b,
+	0x54, 0x8a, 0x03, 0xf3, 0x37, 0xa0, 0xa1, 0xf4, 0x00, 0x79, 0x03, 0x40, 0xee, 0x37, 0x84, 0xab,
+	0x8a, 0x9f, 0xbd, 0xd7, 0x05, 0xba, 0x33, 0xc0, 0x8b, 0x6d, 0x03, 0x37, 0xa4, 0xec, 0xde, 0x88,
+	0x1d, 0x52, 0x74, 0x18, 0x4b, 0xc7, 0x5e, 0x3b, 0x21, 0x58, 0x1c, 0x37, 0x6d, 0x98, 0xd7, 0x44,
+	0x27, 0x99, 0x59, 0x53, 0x2c, 0xe8, 0x5d, 0x9e, 0x2d, 0xe8, 0x01, 0xf1, 0x82, 0x86, 0x26, 0x9b,
+	0xf0, 0x49, 0xda, 0xa3, 0x30, 0x38, 0x66, 0x85, 0x18, 0x96, 0x86, 0x99, 0xff, 0xb8, 0x04, 0xe5,
+	0xed, 0x60, 0xa4, 0x46, 0x0c, 0x18, 0xf9, 0x88, 0x01, 0xb1, 0xb7, 0xb2, 0x93, 0xad, 0x93, 0x30,
+	0x80, 0x35, 0x90, 0x3c, 0x82, 0x59, 0x67, 0x18, 0xa3, 0x9f, 0xf9, 0x24, 0x08, 0x2f, 0x9c, 0x90,
+	0x47, 0xc8, 0x97, 0x99, 0x58, 0x64, 0x28, 0xe4, 0x36, 0x94, 0x93, 0x2d, 0x01, 0x63, 0xc0, 0x24,
+	0xba, 0x4d, 0x58, 0x84, 0xd5, 0xa5, 0x58, 0xd6, 0x44, 0x0a, 0x67, 0xbd, 0xfe, 0x3d, 0xf7, 0x59,
+	0x71, 0xc3, 0xae, 0x88, 0x84, 0xfb, 0x3c, 0x9c, 0x08, 0xc3, 0x74, 0xdb, 0x94, 0xa4, 0xd5, 0xa3,
+	0xb1, 0x9a, 0x7e, 0x34, 0x86, 0xa7, 0x71, 0xde, 0xb9, 0x3d, 0x72, 0x2e, 0xbd, 0xc0, 0x19, 0x08,
+	0x01, 0x54, 0x21, 0xf3, 0x0f, 0x0d, 0xa8, 0xb2, 0x5e, 0xc6, 0xb5, 0x98, 0x2b, 0xb2, 0x24, 0xac,
+	0x80, 0xf5, 0xdc, 0x8c, 0x95, 0x85, 0x89, 0xa9, 0xdd, 0x8d, 0x2a, 0x25, 0x4d, 0x56, 0x50, 0xb2,
+	0x02, 0x75, 0x9e, 0x4a, 0xee, 0xed, 0x30, 0x96, 0x14, 0x24, 0xf7, 0x31, 0xcc, 0x7a, 0x24, 0xfd,
+	0x0a, 0x20, 0xa3, 0x88, 0x82, 0x91, 0xc5, 0xf0, 0xb4, 0x3e, 0x98, 0x1f, 0x6f, 0x38, 0x37, 0xc8,
+	0xb2, 0x30, 0xee, 0x60, 0x93, 0x6c, 0xd5, 0x8e, 0xcc, 0xa0, 0xe6, 0x0b, 0x68, 0xe1, 0x5c, 0x50,
+	0x8e, 0xa7, 0x26, 0x2b, 0xad, 0x5f, 0xc1, 0xd5, 0xb1, 0xef, 0x8d, 0x07, 0x54, 0xf5, 0xee, 0xb0,
+	0xe3, 0x07, 0x81, 0x4b, 0xdb, 0xc9, 0xfc, 0x27, 0x06, 0xd4, 0x64, 0xbe, 0xe4, 0x21, 0x54, 0x50,
+	0xf5, 0x64, 0x9c, 0x79, 0x49, 0xb0, 0x21, 0xf2, 0x59, 0x8c, 0x03, 0xa5, 0x99, 0x1d, 0x10, 0xa8,
+	0xb9, 0xcf, 0x58, 0x1a, 0x96, 0xb6, 0x2c, 0xb3, 0xc7, 0xcf, 0xa0, 0x64, 0x55, 0x39, 0xa9, 0xad,
+	0x68, 0xea, 0x4c, 0x2e, 0xc6, 0x83, 0x53, 0xaa, 0x9c, 0x59, 0xff, 0x8e, 0x01, 0x33, 0x5a, 0x9d,
+	0x50, 0x52, 0x3c, 0x27, 0x8a, 0x45, 0xb0, 0x97, 0x18, 0x79, 0x15, 0x52, 0xa5, 0xac, 0xa4, 0x4b,
+	0x59, 0x72, 0x4a, 0x57, 0x56, 0x4f, 0xe9, 0x9e, 0x40, 0x3d, 0xbd, 0x1c, 0xa7, 0x57, 0x0a, 0x4b,
+	0x94, 0x61, 0x97, 0x29, 0x53, 0x7a, 0x0e, 0x54, 0x55, 0xce, 0x81, 0xcc, 0x8f, 0xa1, 0xa1, 0xf0,
+	0xab, 0xe7, 0x38, 0x86, 0x76, 0x8e, 0x93, 0x04, 0x43, 0x97, 0xd2, 0x60, 0x68, 0xf3, 0x67, 0x25,
+	0x98, 0x41, 0xf1, 0x76, 0xfd, 0xd3, 0x83, 0xc0, 0x73, 0xfb, 0x97, 0x4c, 0xac, 0xa4, 0x24, 0x8b,
+	0xa5, 0x47, 0x8a, 0xb9, 0x0e, 0xe3, 0x94, 0x4b, 0xae, 0x91, 0x70, 0xfd, 0x90, 0xa4, 0x51, 0x81,
+	0xe0, 0xf4, 0x3b, 0x76, 0x22, 0x31, 0x27, 0xc5, 0xce, 0x50, 0x03, 0x71, 0x9a, 0x23, 0xc0, 0xe2,
+	0xe3, 0x87, 0xae, 0xe7, 0xb9, 0x9c, 0x97, 0xef, 0x40, 0x8a, 0x48, 0x58, 0xe6, 0xc0, 0x8d, 0x9c,
+	0xe3, 0x34, 0xbe, 0x21, 0x49, 0x63, 0x99, 0x18, 0xc1, 0x9c, 0xba, 0xb8, 0xf9, 0x85, 0x1a, 0x1d,
+	0xcc, 0x0e, 0xe4, 0x74, 0x6e, 0x20, 0xcd, 0x7f, 0x53, 0x82, 0x86, 0x22, 0x16, 0x38, 0x9d, 0x0b,
+	0x75, 0xbc, 0x82, 0x8a, 0x10, 0x2b, 0x5f, 0xf3, 0x44, 0x29, 0x08, 0x79, 0x53, 0x2f, 0x95, 0xed,
+	0xc4, 0xd8, 0x84, 0x57, 0x61, 0x76, 0xa4, 0x1a, 0x0c, 0xe8, 0x7b, 0xcc, 0xed, 0x25, 0x6e, 0xa6,
+	0x26, 0x80, 0xa4, 0xae, 0x31, 0x6a, 0x35, 0xa5, 0x32, 0xe0, 0xca, 0xa0, 0xab, 0x0f, 0xa1, 0x29,
+	0xb2, 0x61, 0x63, 0xdc, 0x99, 0xd6, 0x26, 0x9f, 0x36, 0xfe, 0x96, 0xc6, 0x29, 0xbf, 0x5c, 0x93,
+	0x5f, 0xd6, 0xae, 0xfb, 0x52, 0x72, 0x9a, 0xcf, 0x92, 0x78, 0xb6, 0x67, 0x78, 0x08, 0x29, 0x15,
+	0xca, 0x13, 0x98, 0x97, 0x7a, 0x63, 0xec, 0x3b, 0xbe, 0x1f, 0x8c, 0xf1, 0xac, 0x52, 0xf8, 0xd3,
+	0x8b, 0x48, 0xe6, 0x00, 0x9a, 0x6a, 0x46, 0xe4, 0x11, 0x54, 0xb9, 0xf1, 0xc2, 0x97, 0xc2, 0x62,
+	0x15, 0xc2, 0x59, 0xc8, 0x43, 0xa8, 0x72, 0x1b, 0xa6, 0x34, 0x71, 0xd2, 0x73, 0x06, 0x73, 0x15,
+	0x5a, 0x88, 0xaa, 0xba, 0xef, 0x4e, 0xd1, 0x12, 0x39, 0xd5, 0xe7, 0x37, 0x88, 0x6e, 0x63, 0x98,
+	0x39, 0x9b, 0x57, 0xca, 0x27, 0xe6, 0x1f, 0x96, 0xa1, 0xa1, 0xc0, 0xa8, 0x9f, 0xd8, 0x11, 0xac,
+	0x3d, 0x70, 0x9d, 0x21, 0x8d, 0x69, 0x28, 0xe6, 0x52, 0x06, 0x45, 0x3e, 0xe7, 0xfc, 0x14, 0xf7,
+	0xb9, 0xf6, 0x80, 0x9e, 0x86, 0x94, 0x8a, 0xb5, 0x3b, 0x83, 0x22, 0x1f, 0x4a, 0xb3, 0xc2, 0xc7,
+	0xf7, 0xf4, 0x19, 0x54, 0x9e, 0xcd, 0xf3, 0x7e, 0xaa, 0xa4, 0x67, 0xf3, 0xbc, 0x57, 0xb2, 0x9a,
+	0xb5, 0x5a, 0xa0, 0x59, 0x3f, 0x80, 0x45, 0xae, 0x43, 0x85, 0xf6, 0xb0, 0x33, 0xc2, 0x35, 0x81,
+	0x8a, 0x27, 0x48, 0x58, 0x67, 0x39, 0x35, 0x30, 0x94, 0x86, 0x89, 0x9b, 0x61, 0xe5, 0x70, 0xe4,
+	0x65, 0x07, 0x46, 0x2a, 0x2f, 0x0f, 0xf4, 0xcb, 0xe1, 0x8c, 0xd7, 0x79, 0xad, 0x61, 0xe2, 0x08,
+	0x2b, 0x87, 0xa3, 0x47, 0x75, 0x48, 0x07, 0xae, 0xa3, 0x67, 0xc1, 0xb6, 0xd8, 0x3c, 0xe2, 0x78,
+	0x12, 0x19, 0x4b, 0xc1, 0x5e, 0xf8, 0x69, 0x30, 0x3c, 0x76, 0xf9, 0xc2, 0xc6, 0x8f, 0xb6, 0x2a,
+	0x56, 0x0e, 0x37, 0x67, 0xa0, 0x71, 0x18, 0x07, 0x23, 0x39, 0xf4, 0xb3, 0xd0, 0xe4, 0x49, 0x11,
+	0xb0, 0xfe, 0x21, 0x34, 0x37, 0x43, 0xc7, 0xf5, 0xd3, 0x4b, 0xb1, 0x4c, 0x81, 0xe2, 0x20, 0x45,
+	0xb4, 0x1f, 0xf8, 0x83, 0x48, 0xd5, 0xab, 0x0a, 0x6c, 0xfe, 0x91, 0x01, 0x0d, 0xf6, 0xa9, 0xd8,
+	0x43, 0xbf, 0x2f, 0x5d, 0x14, 0xdc, 0xb3, 0x71, 0x4f, 0x08, 0xb1, 0xc2, 0xc2, 0xff, 0xd7, 0xdc,
+	0x10, 0xef, 0xc0, 0x9c, 0x54, 0x8c, 0xd9, 0x25, 0x34, 0x4f, 0x60, 0x77, 0x50, 0x35, 0xc7, 0x98,
+	0x70, 0x15, 0x69, 0x20, 0xe6, 0x29, 0xea, 0x88, 0xf1, 0xb9, 0x8e, 0x8b, 0xb3, 0x4d, 0x06, 0xca,
+	0xe7, 0x08, 0xe6, 0x07, 0x00, 0x69, 0xb5, 0x48, 0x13, 0x6a, 0x9b, 0xd6, 0xfa, 0xce, 0x1e, 0x77,
+	0x38, 0x34, 0x60, 0x9a, 0xa5, 0x7a, 0x9b, 0x6d, 0x03, 0xe3, 0x12, 0x8f, 0x76, 0x9e, 0xf7, 0x36,
+	0xed, 0xfd, 0x17, 0x47, 0xed, 0x92, 0x79, 0x07, 0x96, 0xd9, 0x44, 0x3f, 0x0a, 0x46, 0x81, 0x17,
+	0x9c, 0x5e, 0x6a, 0x6e, 0x86, 0x7f, 0x6b, 0xc0, 0xbc, 0x46, 0x4d, 0xfd, 0x0c, 0xec, 0x60, 0x40,
+	0x86, 0x67, 0x1b, 0x9a, 0x83, 0x0e, 0x55, 0x02, 0x67, 0xe4, 0x27, 0xbe, 0xfc, 0xff, 0x88, 0xac,
+	0xa7, 0x37, 0x26, 0xe5, 0x87, 0x5c, 0x51, 0x74, 0xf2, 0x8a, 0x42, 0x7c, 0x2f, 0xef, 0x52, 0xca,
+	0x2c, 0x7e, 0x55, 0x04, 0xa2, 0x0d, 0x84, 0xb4, 0x94, 0xf5, 0xc8, 0x38, 0xd5, 0x37, 0x2b, 0x6b,
+	0xd0, 0x4f, 0xc0, 0x08, 0x2f, 0x22, 0x42, 0x5a, 0x3b, 0x9c, 0xb7, 0xa9, 0x4d, 0xc0, 0x5f, 0xf1,
+	0x48, 0x01, 0x8c, 0xaa, 0x48, 0x02, 0x80, 0x52, 0x33, 0xa3, 0x21, 0x31, 0x34, 0xcb, 0xde, 0x86,
+	0xd6, 0xa9, 0x17, 0x1c, 0x33, 0xf3, 0x8f, 0x5d, 0x1d, 0x89, 0xc4, 0x7d, 0x87, 0x59, 0x0e, 0x6f,
+	0x09, 0x34, 0xb5, 0x49, 0x2a, 0xaa, 0x4d, 0x52, 0x6c, 0x61, 0xfc, 0xac, 0x04, 0x73, 0xb9, 0x9e,
+	0xb8, 0x52, 0x3d, 0x92, 0xb5, 0xdc, 0x7a, 0x38, 0x21, 0xf0, 0x81, 0x6d, 0x94, 0x0e, 0xae, 0x3d,
+	0xaa, 0xf9, 0x18, 0x66, 0x43, 0xbe, 0xd8, 0xc8, 0x95, 0xa8, 0x72, 0xc5, 0x4a, 0x34, 0x13, 0xaa,
+	0x49, 0xb4, 0x55, 0x9d, 0xc1, 0x39, 0x0d, 0x63, 0x97, 0xb9, 0xae, 0x99, 0xfd, 0xc9, 0x1b, 0xd8,
+	0x52, 0x70, 0x66, 0xe6, 0xe1, 0x1d, 0x5a, 0x7e, 0xfb, 0x24, 0xe1, 0x14, 0x77, 0xdc, 0x53, 0x18,
+	0x19, 0xcd, 0x7f, 0x20, 0x83, 0x3e, 0xf4, 0xd1, 0xbd, 0xba, 0x57, 0xd4, 0x16, 0x96, 0x32, 0x2d,
+	0xfc, 0x25, 0x11, 0x84, 0x31, 0xc8, 0x84, 0x2b, 0x72, 0x50, 0x04, 0xcd, 0xe8, 0xdd, 0x5a, 0xb9,
+	0x49, 0xb7, 0x9a, 0xff, 0xc1, 0x80, 0xe9, 0xed, 0x60, 0xb4, 0x8d, 0x5d, 0x8c, 0xc6, 0x21, 0x4e,
+	0x93, 0xe4, 0xba, 0x98, 0x4c, 0x5e, 0x13, 0x41, 0x5e, 0x68, 0xce, 0xcd, 0x64, 0xcd, 0xb9, 0x3f,
+	0x05, 0x77, 0x10, 0x18, 0x85, 0xc1, 0x28, 0x08, 0x71, 0xba, 0x3a, 0x1e, 0xb7, 0xdd, 0x02, 0x3f,
+	0x3e, 0x93, 0xeb, 0xd0, 0x55, 0x2c, 0xcc, 0x37, 0x84, 0x5b, 0x76, 0xbe, 0x0d, 0x14, 0xe6, 0x27,
+	0x5f, 0x9e, 0xf2, 0x04, 0xf3, 0xbb, 0x50, 0x67, 0x5b, 0x33, 0xd6, 0xb4, 0x77, 0xa0, 0x7e, 0x16,
+	0x8c, 0xec, 0x33, 0x76, 0xc1, 0xce, 0xd0, 0xa2, 0xed, 0x45, 0xeb, 0xad, 0x94, 0xc1, 0xfc, 0x57,
+	0x53, 0x30, 0xbd, 0xe3, 0x9f, 0x07, 0x6e, 0x9f, 0x05, 0x9a, 0x0c, 0xe9, 0x30, 0x90, 0x17, 0xe8,
+	0xf0, 0x7f, 0xec, 0x0e, 0x76, 0xf3, 0x63, 0xc4, 0x85, 0xb7, 0xc9, 0x03, 0xca, 0x04, 0xc4, 0x1e,
+	0xad, 0x48, 0xaf, 0xe1, 0xf3, 0x09, 0xa6, 0x20, 0xb8, 0xad, 0x0d, 0xd5, 0x6b, 0xf4, 0x22, 0x95,
+	0xde, 0x72, 0xac, 0x2a, 0xb7, 0x1c, 0xb1, 0x2c, 0x11, 0xd7, 0xce, 0xc3, 0x71, 0x79, 0x59, 0x02,
+	0x62, 0x5b, 0xf1, 0x90, 0xf2, 0x53, 0xb6, 0xc4, 0x62, 0x2d, 0x5b, 0x3a, 0x88, 0x56, 0x2d, 0xff,
+	0x80, 0xf3, 0xf0, 0x55, 0x54, 0x85, 0x70, 0xfd, 0xc9, 0xbe, 0xde, 0xc0, 0x5f, 0xdb, 0xc8, 0xc2,
+	0xb8, 0x08, 0x0e, 0x68, 0xa2, 0x72, 0x79, 0x3b, 0x80, 0x3f, 0x35, 0x90, 0xc5, 0x95, 0x0d, 0x3c,
+	0xbf, 0xa4, 0x23, 0x52, 0x4c, 0x60, 0x1c, 0xcf, 0xc3, 0xf7, 0x6a, 0xd8, 0x63, 0x1f, 0x2c, 0xf4,
+	0xa3, 0x6e, 0xe9, 0x20, 0xd6, 0x5a, 0x19, 0x55, 0x16, 0x7a, 0x57, 0xb1, 0x54, 0x88, 0xac, 0x41,
+	0x83, 0x39, 0x37, 0xc4, 0xb8, 0xce, 0xb2, 0x71, 0x6d, 0xab, 0xde, 0x0f, 0x36, 0xb2, 0x2a, 0x93,
+	0x1a, 0x04, 0xd3, 0xca, 0x5d, 0x9b, 0x71, 0x06, 0x03, 0x11, 0x3b, 0xd4, 0x66, 0xa5, 0xa5, 0x00,
+	0x73, 0x9f, 0xf0, 0x0e, 0xe3, 0x0c, 0x73, 0x8c, 0x41, 0xc3, 0xc8, 0x7d, 0xa8, 0xe1, 0x76, 0x79,
+	0xe4, 0xb8, 0x83, 0x0e, 0x49, 0x76, 0xed, 0x09, 0x86, 0x79, 0xc8, 0xff, 0x99, 0xbd, 0x31, 0xcf,
+	0x7a, 0x45, 0xc3, 0xb0, 0x6f, 0x92, 0xf4, 0x30, 0xbd, 0x67, 0xa3, 0x83, 0xe4, 0x3d, 0xb9, 0xea,
+	0x2f, 0xb0, 0x55, 0xff, 0x8e, 0x68, 0xb3, 0x10, 0x5a, 0xf9, 0x57, 0x5b, 0xf3, 0x1f, 0x42, 0x95,
+	0xaf, 0xde, 0x8b, 0x9a, 0xb5, 0x2b, 0x58, 0xd9, 0xb1, 0x16, 0x67, 0x30, 0xd7, 0xa1, 0xa9, 0x66,
+	0x80, 0x3e, 0x7e, 0x74, 0x30, 0xf3, 0x95, 0xf9, 0xb0, 0x77, 0x74, 0xb4, 0xcb, 0x56, 0xe6, 0x26,
+	0xd4, 0x92, 0xfb, 0x03, 0x25, 0x4c, 0xad, 0x6f, 0x6c, 0xf4, 0x0e, 0x8e, 0x7a, 0x9b, 0xed, 0x32,
+	0x5e, 0x61, 0x6e, 0x28, 0x39, 0x5f, 0xe3, 0x50, 0xd2, 0xe3, 0xea, 0x4b, 0xd9, 0xb8, 0x7a, 0xd4,
+	0x8c, 0x89, 0x73, 0x82, 0xc7, 0x3a, 0x27, 0x69, 0xd6, 0x5f, 0xec, 0x7a, 0xbf, 0x7a, 0x7a, 0x58,
+	0xb5, 0x74, 0x10, 0x65, 0x49, 0x00, 0x2c, 0x76, 0x98, 0xcf, 0x30, 0x15, 0xc2, 0xb1, 0x61, 0xa7,
+	0x3f, 0xe7, 0x94, 0xb3, 0x70, 0x43, 0x56, 0xc3, 0xb0, 0x2c, 0xa1, 0x62, 0x94, 0xfb, 0x29, 0x55,
+	0x4b, 0x07, 0xc9, 0xbb, 0x72, 0x6c, 0x6a, 0x6c, 0x6c, 0x96, 0xf2, 0x1d, 0xad, 0x8e, 0x8b, 0x19,
+	0x03, 0x59, 0x1f, 0x0c, 0x04, 0x55, 0x7d, 0xc3, 0x20, 0x54, 0x1f, 0xcc, 0x10, 0xa9, 0xa2, 0x89,
+	0x5a, 0x2a, 0x9e, 0xa8, 0x57, 0x8a, 0xb3, 0xd9, 0x83, 0xc6, 0x81, 0xf2, 0x04, 0x07, 0xd3, 0x59,
+	0xf2, 0xf1, 0x0d, 0xa1, 0xeb, 0x14, 0x44, 0xa9, 0x4e, 0x49, 0xad, 0x8e, 0xf9, 0xf7, 0x0d, 0x7e,
+	0x97, 0x38, 0xa9, 0x3e, 0x2f, 0x1b, 0x83, 0xeb, 0xa5, 0xf3, 0x3b, 0xbd, 0x7e, 0xa5, 0x61, 0xc8,
+	0xc3, 0xaa, 0x62, 0x07, 0x27, 0x27, 0x11, 0x95, 0xe7, 0x5b, 0x1a, 0x26, 0x2d, 0x6e, 0xb4, 0xe1,
+	0x5d, 0x5e, 0x42, 0x24, 0x02, 0xcd, 0x73, 0x38, 0x0a, 0x89, 0xf0, 0x9f, 0xca, 0x8b, 0x0b, 0x49,
+	0x3a, 0xb9, 0x25, 0x96, 0xed, 0xe5, 0x47, 0x18, 0xb4, 0x25, 0xf2, 0xd5, 0x57, 0x05, 0xc9, 0x99,
+	0xd0, 0x71, 0xf5, 0x61, 0xbb, 0x71, 0xad, 0xd2, 0x5c, 0x56, 0xf3, 0x04, 0x0c, 0x17, 0x3c, 0x71,
+	0xc3, 0x2c, 0x3b, 0x17, 0xde, 0x02, 0x8a, 0xf9, 0x12, 0xe6, 0xe5, 0x9c, 0x53, 0x2c, 0x5a, 0x7d,
+	0x10, 0x8d, 0xeb, 0x74, 0x52, 0x29, 0xaf, 0x93, 0xcc, 0x3f, 0x28, 0xc3, 0xb4, 0x18, 0xe9, 0xdc,
+	0x1d, 0x17, 0x3e, 0xce, 0x1a, 0x46, 0x3a, 0xda, 0x5d, 0x7b, 0xa6, 0xc0, 0x38, 0x90, 0x5f, 0x6b,
+	0xca, 0x45, 0x6b, 0x0d, 0x5e, 0x01, 0x76, 0xe2, 0x33, 0xe6, 0xb3, 0xaa, 0x5b, 0xec, 0x7f, 0xe9,
+	0xde, 0xad, 0xea, 0xee, 0xdd, 0xa2, 0x47, 0x6b, 0xb8, 0x39, 0x95, 0xc3, 0xb1, 0x1f, 0x58, 0x25,
+	0x94, 0xc0, 0x97, 0x14, 0x40, 0xe9, 0xe5, 0x09, 0xa6, 0x21, 0xc4, 0xed, 0xd3, 0x14, 0xf9, 0x0a,
+	0xab, 0xdb, 0xb7, 0x61, 0x8a, 0x5f, 0x7f, 0x14, 0x17, 0x08, 0xee, 0xca, 0x13, 0x5f, 0xce, 0x27,
+	0xff, 0xf2, 0x48, 0x44, 0x4b, 0xf0, 0xaa, 0xcf, 0x3f, 0x34, 0xf4, 0xe7, 0x1f, 0x54, 0xc7, 0x73,
+	0x53, 0x77, 0x3c, 0x9b, 0x5b, 0x30, 0xa3, 0x65, 0x87, 0xda, 0x55, 0x5c, 0x40, 0x68, 0xdf, 0xc2,
+	0x7d, 0xcf, 0xce, 0x9e, 0xbd, 0xb5, 0xbb, 0xf3, 0x6c, 0xfb, 0x88, 0x6f, 0x83, 0x0e, 0x5f, 0x6c,
+	0x6c, 0xf4, 0x7a, 0x9b, 0x4c, 0xdb, 0x02, 0x4c, 0x6d, 0xad, 0xef, 0xec, 0x32, 0x5d, 0xbb, 0xc9,
+	0x65, 0x5b, 0xe4, 0x95, 0x9c, 0x28, 0xbd, 0x0b, 0x44, 0x3a, 0x4c, 0x58, 0x20, 0xe2, 0xc8, 0xa3,
+	0xb1, 0xbc, 0x79, 0x34, 0x27, 0x28, 0x3b, 0x09, 0x41, 0x5e, 0x53, 0x4c, 0x73, 0x49, 0xa7, 0x88,
+	0xe8, 0xa4, 0xec, 0x14, 0x11, 0xac, 0x56, 0x42, 0xc7, 0x23, 0xe0, 0x4d, 0x8a, 0xb9, 0xad, 0x7b,
+	0x5e, 0xa6, 0x3a, 0xb8, 0x71, 0x2b, 0xa0, 0x89, 0xed, 0xf0, 0xf7, 0x61, 0x61, 0x9d, 0x5f, 0x32,
+	0xfa, 0xa6, 0xa2, 0xa4, 0x31, 0x9a, 0x31, 0x9b, 0xa5, 0x28, 0x6c, 0x0b, 0xe6, 0x36, 0xe9, 0xf1,
+	0xf8, 0x74, 0x97, 0x9e, 0xa7, 0x05, 0x11, 0xa8, 0x44, 0x67, 0xc1, 0x85, 0xe8, 0x1f, 0xf6, 0x3f,
+	0x1e, 0x11, 0x79, 0xc8, 0x63, 0x47, 0x23, 0xda, 0x97, 0xd7, 0xee, 0x19, 0x72, 0x38, 0xa2, 0x7d,
+	0xf3, 0x03, 0x20, 0x6a, 0x3e, 0xa2, 0xbf, 0xd0, 0xd6, 0x1a, 0x1f, 0xdb, 0xd1, 0x65, 0x14, 0xd3,
+	0xa1, 0x7c, 0x4f, 0x40, 0x85, 0xcc, 0xb7, 0xa1, 0x79, 0xe0, 0xe0, 0x53, 0x1d, 0xe2, 0x39, 0x23,
+	0xf4, 0xa2, 0x3b, 0x97, 0x28, 0x82, 0x89, 0x17, 0x9d, 0x91, 0xcd, 0xff, 0x59, 0x82, 0x29, 0xce,
+	0x89, 0xb9, 0x0e, 0x68, 0x14, 0xbb, 0x3e, 0x9b, 0x69, 0x32, 0x57, 0x05, 0xca, 0xcd, 0xed, 0x52,
+	0xc1, 0xdc, 0x16, 0xae, 0x1d, 0x79, 0x7d, 0x59, 0x4c, 0x60, 0x0d, 0xc3, 0x99, 0x96, 0x5e, 0x77,
+	0xe0, 0xbe, 0xd6, 0x14, 0xc8, 0x1c, 0xc9, 0xa4, 0x16, 0x1d, 0xaf, 0x9f, 0x54, 0x5b, 0x62, 0x1a,
+	0xab, 0x50, 0xa1, 0xdd, 0xc8, 0xdf, 0x02, 0xc9, 0xe1, 0x79, 0xfb, 0xb0, 0x76, 0x03, 0xfb, 0x90,
+	0xfb, 0x7b, 0xae, 0xb2, 0x0f, 0xe1, 0x06, 0xf6, 0x21, 0x5e, 0xe8, 0x61, 0x2f, 0xbb, 0xe0, 0x0e,
+	0x44, 0xca, 0xee, 0x9f, 0x2f, 0x41, 0x5b, 0x48, 0x51, 0x42, 0x93, 0x87, 0x7b, 0x57, 0xdd, 0x43,
+	0xc3, 0x18, 0x1b, 0xdc, 0xff, 0x24, 0x2a, 0x40, 0x1c, 0x94, 0x69, 0x20, 0xb6, 0x43, 0x86, 0xaf,
+	0x0d, 0x5d, 0x4f, 0x0c, 0x8a, 0x0a, 0x49, 0x2d, 0x12, 0x3a, 0x22, 0x6c, 0xdf, 0xb0, 0x92, 0x34,
+	0x5e, 0x2d, 0x11, 0xd7, 0xa3, 0x6c, 0xbd, 0x2c, 0x1e, 0x17, 0x55, 0x4c, 0xe4, 0x8e, 0x56, 0x4e,
+	0x50, 0xcb, 0xe6, 0x51, 0xe5, 0x45, 0x24, 0xf3, 0x77, 0x0d, 0x98, 0x53, 0x3a, 0x46, 0x48, 0xfb,
+	0xc7, 0x20, 0x67, 0x1d, 0x3f, 0xce, 0xe2, 0x1a, 0x62, 0x49, 0x9f, 0x9e, 0xe9, 0x67, 0x1a, 0x33,
+	0x13, 0x1a, 0xe7, 0x92, 0x95, 0x12, 0x8d, 0x87, 0xf2, 0xb6, 0x9b, 0x02, 0xa1, 0xc0, 0x5e, 0x50,
+	0xfa, 0x2a, 0x61, 0xe1, 0xeb, 0xa7, 0x86, 0x31, 0xc7, 0x3e, 0xee, 0x0f, 0x13, 0xa6, 0x8a, 0x70,
+	0xec, 0xab, 0xa0, 0xf9, 0x2f, 0x4a, 0x30, 0xcf, 0x37, 0xfc, 0xc2, 0xd1, 0x92, 0x44, 0x32, 0x4c,
+	0x71, 0xdf, 0x07, 0x9f, 0xf9, 0xdb, 0xb7, 0x2c, 0x91, 0x26, 0xdf, 0xb9, 0xa1, 0x93, 0x22, 0xb9,
+	0xb3, 0x30, 0x61, 0xcc, 0xcb, 0x45, 0x63, 0x7e, 0xd5, 0x88, 0x16, 0x9c, 0xb1, 0x54, 0x8b, 0xcf,
+	0x58, 0x6e, 0x76, 0xa6, 0xf1, 0x3e, 0x34, 0x94, 0x01, 0x15, 0xee, 0xfd, 0xb9, 0xc4, 0xce, 0x61,
+	0x14, 0x1c, 0x22, 0x95, 0x0b, 0x5f, 0x20, 0x8c, 0xfa, 0xc1, 0x88, 0xe2, 0xdb, 0xa0, 0x7a, 0xbf,
+	0x09, 0x2d, 0x7a, 0x04, 0x90, 0x7e, 0x9b, 0x6f, 0x35, 0x7f, 0x40, 0xe6, 0x6a, 0x49, 0x17, 0xf7,
+	0x7e, 0x54, 0x29, 0x73, 0xa0, 0xb5, 0x45, 0xe9, 0x61, 0x8c, 0x1d, 0x71, 0x7a, 0x79, 0x18, 0xd3,
+	0x11, 0xda, 0x5d, 0xd8, 0x1e, 0x1e, 0x1f, 0x2b, 0x5f, 0x4c, 0xe4, 0xce, 0xd1, 0x3c, 0xa1, 0xa8,
+	0x88, 0x19, 0xbd, 0x88, 0xff, 0x5b, 0x82, 0x86, 0x52, 0x06, 0x59, 0x83, 0x6a, 0x7f, 0x1c, 0x9e,
+	0x4b, 0x07, 0xea, 0xdd, 0x34, 0x40, 0x42, 0xb2, 0xac, 0x6e, 0x20, 0x9d, 0xc5, 0xdf, 0x70, 0xd6,
+	0x1b, 0x4e, 0x6c, 0x0c, 0x6d, 0xc4, 0x87, 0xd9, 0x32, 0x93, 0x7b, 0xc6, 0xca, 0xc2, 0x8c, 0xd3,
+	0x79, 0xad, 0x71, 0x26, 0x41, 0x90, 0x1a, 0x4c, 0xde, 0xc1, 0xcd, 0x05, 0x1d, 0xc9, 0xe8, 0xee,
+	0xc5, 0x7c, 0x6d, 0xb1, 0xd3, 0x2c, 0xce, 0x84, 0x56, 0xe8, 0x79, 0xe0, 0x8d, 0x87, 0xd4, 0x16,
+	0x77, 0x47, 0x14, 0x29, 0x29, 0xa0, 0xa0, 0x32, 0x11, 0xa8, 0x33, 0xc0, 0xf0, 0xba, 0xa4, 0xbf,
+	0xf9, 0x41, 0x58, 0x31, 0xd1, 0x7c, 0x1b, 0xea, 0x49, 0x0f, 0xb1, 0x2b, 0x92, 0xd6, 0xfe, 0xc1,
+	0xbe, 0x75, 0xb4, 0xb3, 0xbf, 0xb7, 0x8e, 0xd1, 0x48, 0x35, 0xa8, 0x1c, 0x1e, 0xf5, 0x0e, 0xda,
+	0x86, 0xf9, 0xf7, 0x0c, 0x58, 0x38, 0xa4, 0xb1, 0x52, 0xd9, 0x3f, 0xb6, 0x69, 0xb8, 0x0a, 0xb5,
+	0x48, 0x94, 0x21, 0x02, 0xbb, 0x48, 0xbe, 0xab, 0xac, 0x84, 0x27, 0x95, 0xf7, 0x0e, 0x2c, 0x66,
+	0xab, 0x28, 0x24, 0xbe, 0x8b, 0x97, 0x7d, 0xe9, 0xb9, 0x4b, 0x2f, 0xb6, 0xa8, 0x74, 0x12, 0xcb,
+	0x15, 0xe2, 0x34, 0x89, 0x6f, 0x53, 0x45, 0xeb, 0x06, 0x4b, 0x84, 0x5a, 0xcf, 0xd2, 0xf5, 0xf5,
+	0x34, 0xff, 0x6a, 0x19, 0xe6, 0x92, 0xe2, 0x0f, 0xd0, 0x51, 0x16, 0x39, 0xde, 0x4d, 0x0a, 0xea,
+	0x64, 0x5c, 0x78, 0xe9, 0xee, 0x7b, 0x45, 0xde, 0x3c, 0x67, 0x4f, 0xd9, 0xb0, 0xde, 0x32, 0x2c,
+	0x15, 0x42, 0x0e, 0x31, 0xf2, 0xc3, 0x34, 0x12, 0x51, 0x85, 0x50, 0x70, 0xe4, 0x83, 0xc0, 0xf9,
+	0x55, 0xa8, 0x6c, 0x15, 0x13, 0x59, 0x08, 0xb9, 0x20, 0x64, 0x57, 0xa1, 0xb2, 0x55, 0x44, 0x42,
+	0x65, 0x80, 0xaf, 0x09, 0xeb, 0x65, 0xf0, 0xfd, 0x40, 0x9e, 0x80, 0xd3, 0x0a, 0x41, 0x35, 0x6f,
+	0xf1, 0x54, 0x44, 0x06, 0x66, 0x0e, 0xef, 0xd1, 0xc8, 0xbb, 0x14, 0x41, 0x1e, 0x3c, 0xc1, 0x6c,
+	0xb9, 0x57, 0xee, 0xc8, 0x0e, 0xa9, 0x13, 0x05, 0xbe, 0x78, 0x64, 0x56, 0x85, 0xcc, 0xff, 0x63,
+	0xc0, 0x72, 0x81, 0x50, 0x88, 0xd5, 0xf1, 0xd7, 0xd0, 0xe6, 0x39, 0x71, 0xc6, 0x5e, 0x6c, 0xcb,
+	0x01, 0xec, 0x18, 0x13, 0x07, 0x39, 0xc7, 0x4b, 0x76, 0x80, 0x24, 0x87, 0x50, 0x1c, 0x73, 0x93,
+	0x43, 0x88, 0xe5, 0xdc, 0x1a, 0x9b, 0x64, 0x54, 0xf0, 0x11, 0xf9, 0x00, 0xea, 0x23, 0x21, 0x2d,
+	0xf2, 0x18, 0xa2, 0x93, 0xd6, 0x41, 0x17, 0x27, 0x2b, 0x65, 0x55, 0x1e, 0x31, 0xaa, 0xa8, 0x8f,
+	0x18, 0x99, 0xff, 0xcb, 0x80, 0x85, 0x4d, 0x11, 0x65, 0x2a, 0xfc, 0xea, 0x62, 0x2a, 0xbf, 0x9f,
+	0x93, 0xc5, 0x09, 0xf6, 0xba, 0x3a, 0x5d, 0x3f, 0x82, 0x8e, 0xbc, 0x2d, 0x65, 0x1f, 0x87, 0x81,
+	0x33, 0xe8, 0xe3, 0x8e, 0x9a, 0x2f, 0x7e, 0x5c, 0x87, 0x4f, 0xa4, 0xe3, 0xb7, 0xc9, 0xc3, 0xaf,
+	0xd9, 0x6f, 0xb9, 0xbe, 0x9d, 0x48, 0x47, 0x05, 0x29, 0x0e, 0x13, 0xe5, 0x4d, 0xb7, 0x34, 0xc4,
+	0xa0, 0x80, 0x62, 0xfe, 0x6b, 0x03, 0x16, 0xb3, 0xcd, 0x16, 0x83, 0x7d, 0x55, 0x13, 0x8c, 0x5f,
+	0xa0, 0x09, 0xa5, 0xaf, 0xd5, 0x84, 0xf2, 0xc4, 0x26, 0xfc, 0xdc, 0x80, 0xce, 0x16, 0x0f, 0x8e,
+	0xc2, 0x70, 0x76, 0x37, 0x8a, 0x83, 0x30, 0x19, 0x3c, 0x0c, 0x14, 0x8e, 0x9d, 0x50, 0xb8, 0xc9,
+	0x0c, 0xf1, 0xe2, 0x41, 0x82, 0xa0, 0xdd, 0x42, 0xfd, 0x01, 0xa7, 0x72, 0x35, 0x92, 0xa4, 0x73,
+	0x0e, 0x1d, 0x71, 0x44, 0xa1, 0x62, 0x78, 0x44, 0x2d, 0x1d, 0x37, 0xf4, 0x9c, 0xed, 0x29, 0xf9,
+	0x3a, 0x97, 0x41, 0xcd, 0xdf, 0x2c, 0x41, 0x2b, 0xad, 0x24, 0x0b, 0x86, 0xd5, 0x77, 0x26, 0xc2,
+	0x17, 0x92, 0x00, 0x32, 0x0e, 0xc3, 0x76, 0xd1, 0x39, 0xa2, 0x9c, 0x52, 0x28, 0x28, 0xc6, 0x59,
+	0xc8, 0x54, 0x30, 0x8e, 0x95, 0x17, 0xb3, 0x54, 0x98, 0xdf, 0xd6, 0x8b, 0x31, 0x17, 0xee, 0x6a,
+	0x12, 0x29, 0x16, 0xf4, 0x3a, 0x8c, 0xd9, 0x97, 0x7c, 0x05, 0x95, 0x49, 0x8c, 0xd1, 0x94, 0x96,
+	0x55, 0x85, 0xfb, 0x36, 0xd4, 0x7d, 0x7f, 0x2d, 0x79, 0x27, 0x35, 0xb1, 0x82, 0x78, 0x8e, 0xe9,
+	0x55, 0xcb, 0x8a, 0xa5, 0x42, 0xd2, 0x4f, 0x8c, 0xc7, 0xbe, 0x43, 0x79, 0x2e, 0x5d, 0xb1, 0x34,
+	0xcc, 0xfc, 0x6b, 0x06, 0x2c, 0x17, 0x0c, 0xa3, 0x10, 0xc6, 0x4d, 0x98, 0x3b, 0x49, 0x88, 0xb2,
+	0xab, 0xf5, 0x57, 0x28, 0x32, 0xdd, 0x6b, 0xe5, 0x3f, 0x48, 0x5c, 0x5e, 0x7c, 0xf0, 0xb4, 0x5b,
+	0xb5, 0x79, 0x82, 0x79, 0x00, 0xdd, 0xde, 0x6b, 0x34, 0xf3, 0x37, 0xd4, 0xd7, 0xe5, 0xa5, 0x64,
+	0xad, 0xdd, 0x54, 0x2d, 0x28, 0x87, 0x53, 0x27, 0x30, 0xa3, 0xe5, 0xf5, 0xf5, 0x74, 0xcb, 0x8a,
+	0x18, 0x75, 0xfe, 0x3c, 0xbe, 0xbc, 0xdb, 0xab, 0x40, 0xe6, 0x39, 0xb4, 0x9e, 0x8f, 0xbd, 0xd8,
+	0x4d, 0x9f, 0xca, 0x27, 0xdf, 0x81, 0x46, 0x9a, 0x85, 0xec, 0xba, 0xc2, 0xa2, 0x54, 0x3e, 0x66,
+	0xac, 0x62, 0x4e, 0x76, 0xbe, 0xc4, 0x3c, 0x01, 0x1f, 0x3f, 0x49, 0x8b, 0xe4, 0x7d, 0x27, 0x0d,
+	0x8a, 0xdf, 0x32, 0x80, 0xa4, 0x34, 0xf9, 0x72, 0x3f, 0x79, 0x06, 0xf3, 0x78, 0x1a, 0xe9, 0x51,
+	0x35, 0x9f, 0x48, 0xf4, 0xc4, 0x82, 0x5e, 0x3d, 0xfe, 0x69, 0x64, 0x15, 0x7d, 0x81, 0x02, 0x52,
+	0x5c, 0xd1, 0x54, 0x40, 0x32, 0x5d, 0x52, 0xd4, 0x80, 0x4f, 0x60, 0x56, 0x2f, 0x0c, 0x43, 0x82,
+	0x32, 0x35, 0x53, 0xc3, 0x70, 0x74, 0xc9, 0xd0, 0x38, 0xf1, 0xfd, 0xe8, 0x8e, 0x45, 0x51, 0x8c,
+	0xa9, 0x52, 0xa8, 0x90, 0x9e, 0x8f, 0x73, 0xd9, 0x4e, 0x6e, 0x70, 0x72, 0xdd, 0x57, 0xb6, 0x75,
+	0x75, 0xe2, 0xa0, 0x6c, 0xdf, 0x2a, 0x68, 0x15, 0x5e, 0xf2, 0x15, 0xed, 0x5b, 0x82, 0x05, 0x51,
+	0x25, 0x59, 0x1d, 0x61, 0x0b, 0xde, 0x81, 0x65, 0xad, 0x50, 0x2d, 0x0c, 0xa1, 0x0b, 0x1d, 0xfe,
+	0x1e, 0xa3, 0xda, 0x0e, 0xf1, 0xe1, 0x5d, 0xe8, 0x1e, 0xc6, 0xe3, 0xfe, 0x2b, 0x7c, 0xe7, 0x38,
+	0x7f, 0x4f, 0xe2, 0xcf, 0x95, 0x60, 0x56, 0x27, 0xdf, 0xf0, 0xc5, 0x9b, 0x9b, 0xbd, 0x29, 0xf8,
+	0x9e, 0xbc, 0xf7, 0x5b, 0xd6, 0x4e, 0x8d, 0xf4, 0x12, 0x57, 0x0f, 0x91, 0x45, 0x5c, 0x0a, 0xc6,
+	0x67, 0x4b, 0xf8, 0xa9, 0x51, 0x25, 0xff, 0x5c, 0x1d, 0xa7, 0xa0, 0xed, 0xc4, 0x9f, 0x26, 0x17,
+	0x61, 0x01, 0x2c, 0x61, 0x7e, 0x00, 0x55, 0x96, 0x11, 0x7a, 0x2e, 0x2d, 0xbc, 0x3e, 0xb2, 0xd7,
+	0xdb, 0x38, 0x6a, 0xdf, 0x92, 0xc9, 0xcf, 0x7a, 0x16, 0x3b, 0x44, 0x6a, 0x41, 0x43, 0x7b, 0xaf,
+	0xea, 0xd1, 0x97, 0xd0, 0x50, 0x1e, 0xf4, 0x24, 0x4b, 0x30, 0xff, 0x72, 0xe7, 0x68, 0xaf, 0x77,
+	0x78, 0x68, 0x1f, 0xbc, 0x78, 0xfa, 0x69, 0xef, 0x07, 0xf6, 0xf6, 0xfa, 0xe1, 0x76, 0xfb, 0x16,
+	0xbe, 0x77, 0xb5, 0xd7, 0x3b, 0x3c, 0xea, 0x6d, 0x6a, 0xb8, 0x41, 0xee, 0x43, 0xf7, 0xc5, 0xde,
+	0x0b, 0xbc, 0xfb, 0x50, 0xf4, 0x5d, 0x89, 0xdc, 0x83, 0x65, 0x41, 0x2f, 0xf8, 0xbc, 0xfc, 0xe8,
+	0x08, 0x66, 0xf5, 0x4b, 0x41, 0xb8, 0xa1, 0x39, 0xfa, 0xc1, 0x41, 0xcf, 0x4e, 0x5d, 0xb3, 0x00,
+	0x53, 0x1b, 0xfb, 0xcf, 0x9f, 0xef, 0xa0, 0x5f, 0x76, 0x0e, 0x66, 0x76, 0xf6, 0x36, 0xf6, 0x9f,
+	0xe3, 0x6b, 0x5a, 0xd8, 0x29, 0xed, 0x12, 0x42, 0xfb, 0x2f, 0x8e, 0x9e, 0xed, 0x27, 0x50, 0xf9,
+	0xd1, 0x08, 0xe6, 0x72, 0xef, 0x5c, 0x91, 0x79, 0x68, 0xed, 0xbf, 0x38, 0xda, 0xd8, 0x7f, 0xae,
+	0xe6, 0xdd, 0x80, 0xe9, 0x8d, 0xdd, 0x75, 0x0c, 0x78, 0x69, 0x1b, 0x98, 0xc0, 0xd8, 0x17, 0x16,
+	0xf9, 0xa2, 0x3f, 0xd0, 0x55, 0xc6, 0xf3, 0x36, 0xfe, 0xae, 0x17, 0x7b, 0xbd, 0xab, 0x05, 0x8d,
+	0x97, 0xd6, 0xce, 0xd1, 0x51, 0x6f, 0xcf, 0xde, 0xdf, 0xda, 0x6a, 0x57, 0x1f, 0x7d, 0x0c, 0xed,
+	0xec, 0x81, 0x93, 0x76, 0x44, 0x77, 0xd5, 0x59, 0xde, 0xda, 0xcf, 0xca, 0x30, 0xcb, 0x2f, 0x71,
+	0xf0, 0x9f, 0x05, 0xa1, 0x21, 0x79, 0x0e, 0xd3, 0xe2, 0xf7, 0x65, 0x88, 0x9c, 0x75, 0xfa, 0x2f,
+	0xda, 0x74, 0x17, 0xb3, 0xb0, 0x90, 0xf8, 0xf9, 0xbf, 0xf0, 0xef, 0xff, 0xcb, 0xdf, 0x28, 0xcd,
+	0x90, 0xc6, 0xe3, 0xf3, 0xf7, 0x1e, 0x9f, 0x52, 0x3f, 0xc2, 0x3c, 0x7e, 0x1d, 0x20, 0xfd, 0xd5,
+	0x14, 0xd2, 0x49, 0x9c, 0x11, 0x99, 0x9f, 0x94, 0xe9, 0x2e, 0x17, 0x50, 0x44, 0xbe, 0xcb, 0x2c,
+	0xdf, 0x79, 0x73, 0x16, 0xf3, 0x75, 0x7d, 0x37, 0xe6, 0xbf, 0xa0, 0xf2, 0x91, 0xf1, 0x88, 0x0c,
+	0xa0, 0xa9, 0xfe, 0x9e, 0x09, 0x91, 0x01, 0x37, 0x05, 0xbf, 0xc8, 0xd2, 0xbd, 0x53, 0x48, 0x93,
+	0xd3, 0x9c, 0x95, 0xb1, 0x60, 0xb6, 0xb1, 0x8c, 0x31, 0xe3, 0x48, 0x4b, 0xf1, 0x60, 0x56, 0xff,
+	0xd9, 0x12, 0x72, 0x57, 0xd1, 0x47, 0xb9, 0x1f, 0x4d, 0xe9, 0xde, 0x9b, 0x40, 0x15, 0x65, 0xdd,
+	0x63, 0x65, 0x2d, 0x99, 0x04, 0xcb, 0xea, 0x33, 0x1e, 0xf9, 0xa3, 0x29, 0x1f, 0x19, 0x8f, 0xd6,
+	0x7e, 0xef, 0x5b, 0x50, 0x4f, 0xa2, 0x18, 0xc9, 0xe7, 0x30, 0xa3, 0xdd, 0xb2, 0x21, 0xb2, 0x19,
+	0x45, 0xd7, 0x75, 0xba, 0x77, 0x8b, 0x89, 0xa2, 0xe0, 0xfb, 0xac, 0xe0, 0x0e, 0x59, 0xc4, 0x82,
+	0xc5, 0x35, 0x95, 0xc7, 0xec, 0x3a, 0x25, 0x7f, 0xfb, 0xe5, 0x95, 0xa2, 0xe4, 0x79, 0x61, 0x77,
+	0xb3, 0x7a, 0x57, 0x2b, 0xed, 0xde, 0x04, 0xaa, 0xd4, 0x80, 0xac, 0xb8, 0x45, 0x72, 0x5b, 0x2d,
+	0x2e, 0x89, 0x35, 0xa3, 0xec, 0xc1, 0x23, 0xf5, 0xb7, 0x3b, 0xc8, 0xbd, 0x44, 0xb0, 0x8a, 0x7e,
+	0xd3, 0x23, 0x11, 0x91, 0xfc, 0xcf, 0x77, 0x98, 0x1d, 0x56, 0x14, 0x21, 0x6c, 0xf8, 0xd4, 0x1f,
+	0xe8, 0x20, 0xc7, 0xd0, 0x50, 0xde, 0xf1, 0x26, 0xcb, 0x13, 0xdf, 0x1c, 0xef, 0x76, 0x8b, 0x48,
+	0x45, 0x4d, 0x51, 0xf3, 0x7f, 0x8c, 0x36, 0xe0, 0x8f, 0xa0, 0x9e, 0xbc, 0xc7, 0x4c, 0x96, 0x94,
+	0x97, 0xba, 0xd5, 0x47, 0xa8, 0xbb, 0x9d, 0x3c, 0xa1, 0x48, 0xf8, 0xd4, 0xdc, 0x51, 0xf8, 0x5e,
+	0x42, 0x43, 0x79, 0x59, 0x39, 0x69, 0x40, 0xfe, 0x5d, 0xe7, 0x6e, 0xb7, 0x88, 0x24, 0x8a, 0x98,
+	0x63, 0x45, 0x34, 0x48, 0x9d, 0xc9, 0x37, 0x3e, 0xbc, 0x4c, 0x76, 0x61, 0x41, 0x2c, 0x49, 0xc7,
+	0xf4, 0xab, 0x0c, 0x43, 0xc1, 0x8f, 0xa2, 0x3c, 0x31, 0xc8, 0xc7, 0x50, 0x93, 0xef, 0x73, 0x93,
+	0xc5, 0xe2, 0xc7, 0xca, 0xbb, 0x4b, 0x39, 0x5c, 0x18, 0xb1, 0x3f, 0x00, 0x48, 0x1f, 0x6b, 0x4e,
+	0x94, 0x44, 0xee, 0x59, 0xe8, 0xee, 0x72, 0x01, 0x45, 0x34, 0x70, 0x91, 0x35, 0xb0, 0x4d, 0x98,
+	0x92, 0xf0, 0xe9, 0x85, 0x7c, 0xdb, 0xec, 0xc7, 0xd0, 0x50, 0xde, 0x6b, 0x4e, 0xba, 0x2f, 0xff,
+	0xd6, 0x73, 0xb7, 0x5b, 0x44, 0x92, 0x1e, 0x21, 0x96, 0xfb, 0x6d, 0xb3, 0x85, 0xb9, 0xe3, 0x7b,
+	0xcc, 0x43, 0xce, 0x80, 0x03, 0x74, 0x06, 0x33, 0xda, 0xa3, 0xcc, 0xc9, 0x0c, 0x2d, 0x7a, 0xf2,
+	0xb9, 0x7b, 0xb7, 0x98, 0xa8, 0xcb, 0x99, 0x39, 0x87, 0xe5, 0x9c, 0x33, 0x16, 0xa5, 0xa4, 0x1f,
+	0x42, 0x43, 0x79, 0x60, 0x39, 0x69, 0x4b, 0xfe, 0x2d, 0xe7, 0x6e, 0xb7, 0x88, 0x24, 0xca, 0xb8,
+	0xcd, 0xca, 0x98, 0x35, 0x99, 0x28, 0xb0, 0x57, 0xba, 0x30, 0xef, 0xcf, 0x61, 0x56, 0x7f, 0x72,
+	0x39, 0x99, 0xfb, 0x85, 0x8f, 0x37, 0x77, 0xef, 0x4d, 0xa0, 0xea, 0x22, 0xfd, 0x68, 0x3e, 0x29,
+	0xe4, 0xf1, 0x17, 0xe2, 0x1e, 0xc4, 0x97, 0xe4, 0xfb, 0x50, 0x4f, 0x9e, 0x4d, 0x23, 0x4b, 0x8a,
+	0xd4, 0xaa, 0x8f, 0xab, 0x75, 0x3b, 0x79, 0x42, 0x91, 0x30, 0xb3, 0xcc, 0xf9, 0xaa, 0xc5, 0x9e,
+	0x4f, 0x53, 0x56, 0x2d, 0xf5, 0x85, 0xb5, 0xee, 0x62, 0x16, 0x2e, 0x5e, 0xb5, 0x62, 0x17, 0xf3,
+	0xf0, 0xa1, 0x95, 0xb9, 0xfa, 0x9b, 0xcc, 0x8a, 0xe2, 0x97, 0x53, 0xba, 0xf7, 0xaf, 0xbe, 0x31,
+	0xac, 0x6b, 0x10, 0xa9, 0x04, 0x1f, 0xcb, 0x77, 0x6a, 0x7e, 0x03, 0x9a, 0xea, 0xd3, 0xb1, 0x44,
+	0x9d, 0xca, 0xd9, 0x92, 0xee, 0x14, 0xd2, 0xf4, 0xc1, 0x25, 0x4d, 0xb5, 0x18, 0xf2, 0x19, 0x2c,
+	0x26, 0x53, 0x5d, 0xbd, 0x76, 0x1a, 0x91, 0x07, 0x05, 0x97, 0x51, 0x55, 0x43, 0xb5, 0xbb, 0x3c,
+	0xf1, 0xb6, 0xea, 0x13, 0x03, 0x85, 0x46, 0x7f, 0x25, 0x32, 0x5d, 0x30, 0x8a, 0x1e, 0xc7, 0xec,
+	0xde, 0x9b, 0x40, 0xd5, 0x85, 0x86, 0xcc, 0x6b, 0x7d, 0xc4, 0x23, 0x1f, 0x49, 0x08, 0xed, 0xec,
+	0x0b, 0x8c, 0xe4, 0x7e, 0xf1, 0x3b, 0x8b, 0x49, 0x79, 0x0f, 0x26, 0xd2, 0xf5, 0xa5, 0x98, 0x2c,
+	0xe8, 0xa3, 0x22, 0xd8, 0xc9, 0x0f, 0xa1, 0xa5, 0xbc, 0xa8, 0x81, 0xef, 0x07, 0x26, 0x93, 0x2e,
+	0xff, 0xb4, 0x54, 0xb7, 0x68, 0xd3, 0x68, 0x2e, 0xb1, 0x12, 0xe6, 0x4c, 0x6d, 0x40, 0x70, 0xc2,
+	0x6d, 0x40, 0x43, 0xc9, 0xe3, 0xaa, 0x7c, 0x97, 0x14, 0x92, 0xfa, 0x32, 0xd2, 0x13, 0x83, 0x1c,
+	0x40, 0x4b, 0xfb, 0x65, 0x94, 0x20, 0xcc, 0x2e, 0xd9, 0xfa, 0x2f, 0xa6, 0x74, 0xef, 0x14, 0x53,
+	0x59, 0x41, 0x0f, 0x8d, 0x27, 0x06, 0xf9, 0x5b, 0xf8, 0x93, 0x28, 0xea, 0x6b, 0x1a, 0x5a, 0x0c,
+	0x73, 0xa6, 0x66, 0x1d, 0x95, 0xa6, 0x56, 0xcd, 0xb4, 0x58, 0xb3, 0x77, 0x1f, 0x7d, 0xa2, 0x75,
+	0xec, 0x17, 0xda, 0x5e, 0x66, 0x35, 0xfb, 0xf3, 0x28, 0x5f, 0x66, 0x19, 0xd4, 0x07, 0xbd, 0xbe,
+	0x7c, 0x62, 0x90, 0xdf, 0x31, 0x60, 0x56, 0x3f, 0xeb, 0x4f, 0x9a, 0x5b, 0x18, 0x55, 0xd0, 0xbd,
+	0x37, 0x81, 0x2a, 0x86, 0xff, 0x87, 0xac, 0x96, 0x47, 0x8f, 0x2c, 0xad, 0x96, 0xe2, 0x15, 0xd4,
+	0x5f, 0xac, 0xb6, 0xe4, 0x23, 0xfe, 0x7b, 0x5e, 0x32, 0x22, 0x87, 0xe4, 0x7f, 0x55, 0xaa, 0x3b,
+	0xaf, 0x61, 0xbc, 0x4e, 0x6c, 0x10, 0x7e, 0x0c, 0x2d, 0xe5, 0x5b, 0x26, 0x77, 0x37, 0xfd, 0xde,
+	0x7c, 0x93, 0xb5, 0xe9, 0xbe, 0xb9, 0xac, 0xb5, 0x29, 0x6b, 0x55, 0xac, 0x43, 0x43, 0xf9, 0x09,
+	0xa7, 0x74, 0x59, 0xcc, 0xfd, 0xac, 0xd3, 0xe4, 0x4a, 0x0e, 0xa1, 0xa5, 0xb0, 0x6b, 0x93, 0xe3,
+	0x86, 0xd9, 0x98, 0x8f, 0x58, 0x5d, 0xdf, 0x34, 0x1f, 0x4c, 0xac, 0xeb, 0x63, 0x76, 0x62, 0x8f,
+	0x35, 0x3e, 0x00, 0x48, 0xa3, 0xe7, 0x48, 0x26, 0x7a, 0x2b, 0x51, 0x53, 0xf9, 0x00, 0x3b, 0x7d,
+	0x06, 0xca, 0x20, 0x2f, 0xcc, 0xf1, 0x47, 0x5c, 0xe9, 0x0a, 0xfe, 0x48, 0x33, 0xad, 0xf4, 0x30,
+	0xb7, 0x6e, 0xb7, 0x88, 0x54, 0xa4, 0x72, 0x65, 0xfe, 0xe4, 0x05, 0xcc, 0xec, 0x06, 0xc1, 0xab,
+	0xf1, 0x48, 0xd6, 0x98, 0xe8, 0xc1, 0x34, 0x18, 0x8c, 0xd7, 0xcd, 0xb4, 0xc2, 0x5c, 0x61, 0x59,
+	0x75, 0x49, 0x47, 0xc9, 0xea, 0xf1, 0x17, 0x69, 0x74, 0xde, 0x97, 0xc4, 0x81, 0xb9, 0x44, 0x93,
+	0x27, 0x15, 0xef, 0xea, 0xd9, 0x68, 0xfa, 0x3b, 0x5b, 0x84, 0xb6, 0x07, 0x90, 0xb5, 0x7d, 0x1c,
+	0xc9, 0x3c, 0x99, 0x4e, 0x69, 0x6e, 0xd2, 0x3e, 0xbb, 0x0c, 0xcd, 0x22, 0x52, 0xe6, 0xd3, 0x8a,
+	0x27, 0xa1, 0x2c, 0xdd, 0x19, 0x0d, 0xd4, 0x57, 0xb7, 0x91, 0x73, 0x19, 0xd2, 0x9f, 0x3c, 0xfe,
+	0x42, 0xc4, 0xba, 0x7c, 0x29, 0x57, 0x37, 0xd1, 0x72, 0x7d, 0x75, 0xcb, 0x44, 0x0f, 0x75, 0xef,
+	0x14, 0xd2, 0x8a, 0xba, 0x5a, 0x06, 0x23, 0x11, 0x0f, 0xe6, 0x72, 0x01, 0x47, 0xc9, 0xc2, 0x36,
+	0x29, 0x4c, 0xa9, 0xbb, 0x32, 0x99, 0x41, 0x2f, 0xed, 0x91, 0x5e, 0xda, 0x21, 0xcc, 0x6c, 0x52,
+	0xde, 0x59, 0xfc, 0x22, 0x5a, 0xe6, 0x35, 0x0a, 0xf5, 0x9a, 0x5b, 0x77, 0xbe, 0x80, 0xa6, 0x9b,
+	0x2f, 0xec, 0x06, 0x18, 0xf9, 0x11, 0x34, 0x9e, 0xd1, 0x58, 0xde, 0x3c, 0x4b, 0x0c, 0xe8, 0xcc,
+	0x55, 0xb4, 0x6e, 0xc1, 0xc5, 0x35, 0x5d, 0x66, 0x58, 0x6e, 0x8f, 0xf1, 0x2a, 0x1b, 0x57, 0x4e,
+	0xb6, 0x3b, 0xf8, 0x92, 0xfc, 0x69, 0x96, 0x79, 0x72, 0xf5, 0x76, 0x51, 0xb9, 0x0d, 0xa3, 0x66,
+	0xde, 0xca, 0xe0, 0x45, 0x39, 0xfb, 0xc1, 0x80, 0x2a, 0x86, 0x9c, 0x0f, 0x0d, 0xe5, 0x9a, 0x7a,
+	0x32, 0x81, 0xf2, 0xaf, 0x1e, 0x74, 0xbb, 0x45, 0x24, 0xd1, 0xcf, 0x0f, 0x59, 0x39, 0x26, 0x59,
+	0x49, 0xcb, 0xe1, 0x37, 0xd9, 0xd3, 0x92, 0x1e, 0x7f, 0xe1, 0x0c, 0xe3, 0x2f, 0xc9, 0x4b, 0xf6,
+	0x6e, 0xae, 0x7a, 0xb3, 0x2e, 0xdd, 0x11, 0x64, 0x2f, 0xe1, 0x75, 0x49, 0x9e, 0xa4, 0xef, 0x12,
+	0x78, 0x51, 0xcc, 0xde, 0xfb, 0x0e, 0x00, 0xde, 0xda, 0xda, 0x74, 0xe8, 0x30, 0xf0, 0x53, 0x5d,
+	0x9b, 0xde, 0xeb, 0xea, 0xce, 0x6b, 0x98, 0xd8, 0xb7, 0x7c, 0x57, 0x5c, 0xee, 0x5a, 0xf7, 0x07,
+	0x88, 0x27, 0x53, 0x45, 0xbd, 0xf1, 0xd5, 0x25, 0x2a, 0x98, 0xac, 0xdc, 0x2f, 0x95, 0xdd, 0x97,
+	0x76, 0xaf, 0x51, 0xca, 0xe5, 0xc4, 0xcb, 0x4f, 0xdd, 0x6e, 0x11, 0x47, 0x92, 0xf1, 0x3a, 0x40,
+	0x1a, 0xac, 0x96, 0xec, 0xa5, 0x72, 0x71, 0x70, 0xdd, 0xe5, 0x02, 0x8a, 0x68, 0xd6, 0x01, 0xd4,
+	0xd3, 0xe8, 0xa7, 0xa5, 0xf4, 0xf0, 0x50, 0x8b, 0x95, 0xea, 0x76, 0xf2, 0x04, 0x31, 0xa0, 0x6d,
+	0xd6, 0xcb, 0x40, 0x6a, 0xd8, 0xcb, 0x2c, 0x00, 0xc8, 0x85, 0x79, 0x5e, 0xc1, 0xc4, 0x36, 0x62,
+	0x37, 0x72, 0x64, 0x4b, 0x0a, 0xe2, 0x75, 0xba, 0x77, 0x0a, 0x69, 0x45, 0x2e, 0x21, 0x14, 0x74,
+	0x7e, 0x1b, 0x08, 0xb5, 0xba, 0x0b, 0xb3, 0xfa, 0xb1, 0x7e, 0x62, 0x22, 0x14, 0x06, 0x24, 0x74,
+	0xef, 0x4d, 0xa0, 0x16, 0xed, 0xfc, 0xb0, 0x2d, 0x82, 0x01, 0x8b, 0xba, 0x80, 0xb9, 0xdc, 0x91,
+	0x30, 0x49, 0x6d, 0xce, 0xe2, 0x08, 0x82, 0xee, 0xca, 0x64, 0x06, 0x51, 0xe6, 0x03, 0x56, 0xe6,
+	0x32, 0x59, 0xca, 0x94, 0xf9, 0x78, 0xc4, 0x3f, 0x21, 0x21, 0xdc, 0xe6, 0xdf, 0xe8, 0x27, 0x94,
+	0xe9, 0x96, 0xad, 0xe8, 0xbc, 0xb6, 0x7b, 0x6f, 0x02, 0x55, 0xb7, 0x85, 0x3f, 0x32, 0x1e, 0x71,
+	0xcf, 0x94, 0x7c, 0x5a, 0x88, 0x77, 0x2d, 0x19, 0xc2, 0x5c, 0xee, 0x14, 0x2a, 0x69, 0xec, 0xa4,
+	0x63, 0xc6, 0xee, 0xca, 0x64, 0x06, 0x51, 0xec, 0x02, 0x2b, 0xb6, 0x65, 0x02, 0x96, 0x19, 0x5d,
+	0xb8, 0x71, 0xff, 0x0c, 0xfb, 0xf6, 0xb7, 0x0c, 0x98, 0x2f, 0x38, 0x64, 0x22, 0x6f, 0x48, 0x2f,
+	0xcd, 0xc4, 0x03, 0xa8, 0x6e, 0xe1, 0x19, 0x84, 0x79, 0xc8, 0xca, 0x79, 0x4e, 0x3e, 0xd5, 0x6c,
+	0x0d, 0xee, 0xfe, 0x17, 0xca, 0xf2, 0x4a, 0x3b, 0xaf, 0xd0, 0xc8, 0xfb, 0x09, 0x2c, 0xf1, 0x8a,
+	0xac, 0x7b, 0x5e, 0xe6, 0x7c, 0xe4, 0x7e, 0xee, 0x57, 0x99, 0xb5, 0x73, 0x9f, 0xee, 0xe4, 0x5f,
+	0x6d, 0x9e, 0xb0, 0x0f, 0xe2, 0x55, 0x25, 0x63, 0x68, 0x67, 0xcf, 0x1c, 0xc8, 0xe4, 0xbc, 0x92,
+	0x2d, 0xd0, 0xc4, 0x73, 0x8a, 0x5f, 0x66, 0x85, 0x3d, 0x30, 0xbb, 0x45, 0xfd, 0xc2, 0x5d, 0x10,
+	0x38, 0x1e, 0x7f, 0x36, 0x39, 0x20, 0xc9, 0xb4, 0xf3, 0x41, 0xfa, 0xc2, 0x56, 0xe1, 0x89, 0x4e,
+	0xf7, 0xae, 0xce, 0x90, 0x29, 0xfe, 0x2d, 0x56, 0xfc, 0x8a, 0x79, 0xa7, 0xa8, 0xf8, 0x90, 0x7f,
+	0xc2, 0x7d, 0x1f, 0x4b, 0x59, 0x7d, 0x29, 0x6b, 0xb0, 0x52, 0x34, 0xde, 0x13, 0x37, 0xb1, 0x99,
+	0xbe, 0xbe, 0xf5, 0xc4, 0x20, 0x9f, 0x41, 0x27, 0xc9, 0x5b, 0x3f, 0x22, 0x89, 0x12, 0x79, 0x9b,
+	0x7c, 0x96, 0xd3, 0x5d, 0x28, 0x64, 0x79, 0x62, 0x3c, 0x7d, 0xfb, 0x87, 0xbf, 0x7c, 0xea, 0xc6,
+	0x67, 0xe3, 0xe3, 0xd5, 0x7e, 0x30, 0x7c, 0xec, 0x49, 0x9f, 0xae, 0xb8, 0x2c, 0xfd, 0xd8, 0xf3,
+	0x07, 0x8f, 0xd9, 0x97, 0xc7, 0x53, 0xec, 0xc7, 0xe3, 0xdf, 0xff, 0x7f, 0x03, 0x00, 0xf1, 0xe9,
+	0xcb, 0xe1, 0x6e, 0x7e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -10033,6 +11872,15 @@ type LightningClient interface {
 	//ClosedChannels returns a description of all the closed channels that
 	//this node was a participant in.
 	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	//* lncli: `prunablechannels`
+	//PrunableChannels returns the fully resolved closed channels of which state
+	//beyond their close summary is still stored, along with how much space
+	//pruning that state would reclaim. That state consists of the forwarding
+	//packages of a channel and any arbitrator state left behind. Revocation
+	//state is already removed when a channel is closed, not by pruning. The
+	//database file itself doesn't shrink, but the reclaimed space is reused for
+	//new data.
+	PrunableChannels(ctx context.Context, in *PrunableChannelsRequest, opts ...grpc.CallOption) (*PrunableChannelsResponse, error)
 	//*
 	//OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	//call is meant to be consumed by clients to the REST proxy. As with all
@@ -10164,6 +12012,13 @@ type LightningClient interface {
 	//StopDaemon will send a shutdown request to the interrupt handler, triggering
 	//a graceful shutdown of the daemon.
 	StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	//* lncli: `drain`
+	//DrainAndStop puts the daemon into drain mode in preparation of a graceful
+	//shutdown. All channels are disabled on the network, new forwards and
+	//incoming channels are rejected, and the daemon waits for in-flight HTLCs
+	//to be resolved before shutting down. The progress is reported over the
+	//returned stream.
+	DrainAndStop(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (Lightning_DrainAndStopClient, error)
 	//*
 	//SubscribeChannelGraph launches a streaming RPC that allows the caller to
 	//receive notifications upon any changes to the channel graph topology from
@@ -10186,6 +12041,24 @@ type LightningClient interface {
 	//UpdateChannelPolicy allows the caller to update the fee schedule and
 	//channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
+	//* lncli: `setfeestrategy`
+	//SetFeeStrategy sets or clears the dynamic fee strategy for a particular
+	//channel, or the default strategy used for all channels without a strategy
+	//of their own. If the fee engine is active, it will periodically re-price
+	//each channel according to its strategy.
+	SetFeeStrategy(ctx context.Context, in *SetFeeStrategyRequest, opts ...grpc.CallOption) (*SetFeeStrategyResponse, error)
+	//* lncli: `previewfeeupdates`
+	//PreviewFeeUpdates returns the configured fee strategies along with the
+	//policy the fee engine would select for each channel, and whether it would
+	//apply it, without changing any channel policies.
+	PreviewFeeUpdates(ctx context.Context, in *PreviewFeeUpdatesRequest, opts ...grpc.CallOption) (*PreviewFeeUpdatesResponse, error)
+	//* lncli: `updatedeadlinepolicy`
+	//UpdateDeadlinePolicy sets the policy that decides when a particular
+	//channel is force closed in order to resolve its HTLCs on chain. HTLCs
+	//below the minimum chain HTLC value of the policy are failed back or
+	//written off instead, as going to chain for them would cost more than
+	//they are worth.
+	UpdateDeadlinePolicy(ctx context.Context, in *DeadlinePolicyRequest, opts ...grpc.CallOption) (*DeadlinePolicyResponse, error)
 	//* lncli: `fwdinghistory`
 	//ForwardingHistory allows the caller to query the htlcswitch for a record of
 	//all HTLCs forwarded within the target time range, and integer offset
@@ -10233,6 +12106,12 @@ type LightningClient interface {
 	//ups, but the updated set of encrypted multi-chan backups with the closed
 	//channel(s) removed.
 	SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error)
+	//*
+	//SubscribeStuckHtlcEvents creates a uni-directional stream from the server
+	//to the client over which the actions of the stuck HTLC monitor are sent.
+	//An event is sent each time a channel with stuck outgoing HTLCs moves to
+	//another stage. It fails if the stuck HTLC monitor isn't active.
+	SubscribeStuckHtlcEvents(ctx context.Context, in *StuckHtlcEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeStuckHtlcEventsClient, error)
 }
 
 type lightningClient struct {
@@ -10460,6 +12339,15 @@ func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannels
 	return out, nil
 }
 
+func (c *lightningClient) PrunableChannels(ctx context.Context, in *PrunableChannelsRequest, opts ...grpc.CallOption) (*PrunableChannelsResponse, error) {
+	out := new(PrunableChannelsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PrunableChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
 	out := new(ChannelPoint)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, opts...)
@@ -10793,8 +12681,40 @@ func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts
 	return out, nil
 }
 
+func (c *lightningClient) DrainAndStop(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (Lightning_DrainAndStopClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/DrainAndStop", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningDrainAndStopClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_DrainAndStopClient interface {
+	Recv() (*DrainUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningDrainAndStopClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningDrainAndStopClient) Recv() (*DrainUpdate, error) {
+	m := new(DrainUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10852,6 +12772,33 @@ func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpd
 	return out, nil
 }
 
+func (c *lightningClient) SetFeeStrategy(ctx context.Context, in *SetFeeStrategyRequest, opts ...grpc.CallOption) (*SetFeeStrategyResponse, error) {
+	out := new(SetFeeStrategyResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetFeeStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) PreviewFeeUpdates(ctx context.Context, in *PreviewFeeUpdatesRequest, opts ...grpc.CallOption) (*PreviewFeeUpdatesResponse, error) {
+	out := new(PreviewFeeUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PreviewFeeUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpdateDeadlinePolicy(ctx context.Context, in *DeadlinePolicyRequest, opts ...grpc.CallOption) (*DeadlinePolicyResponse, error) {
+	out := new(DeadlinePolicyResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpdateDeadlinePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
 	out := new(ForwardingHistoryResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, opts...)
@@ -10898,7 +12845,7 @@ func (c *lightningClient) RestoreChannelBackups(ctx context.Context, in *Restore
 }
 
 func (c *lightningClient) SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[10], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10929,6 +12876,38 @@ func (x *lightningSubscribeChannelBackupsClient) Recv() (*ChanBackupSnapshot, er
 	return m, nil
 }
 
+func (c *lightningClient) SubscribeStuckHtlcEvents(ctx context.Context, in *StuckHtlcEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeStuckHtlcEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[11], "/lnrpc.Lightning/SubscribeStuckHtlcEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeStuckHtlcEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeStuckHtlcEventsClient interface {
+	Recv() (*StuckHtlcEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeStuckHtlcEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeStuckHtlcEventsClient) Recv() (*StuckHtlcEvent, error) {
+	m := new(StuckHtlcEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // LightningServer is the server API for Lightning service.
 type LightningServer interface {
 	//* lncli: `walletbalance`
@@ -11022,6 +13001,15 @@ type LightningServer interface {
 	//ClosedChannels returns a description of all the closed channels that
 	//this node was a participant in.
 	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+	//* lncli: `prunablechannels`
+	//PrunableChannels returns the fully resolved closed channels of which state
+	//beyond their close summary is still stored, along with how much space
+	//pruning that state would reclaim. That state consists of the forwarding
+	//packages of a channel and any arbitrator state left behind. Revocation
+	//state is already removed when a channel is closed, not by pruning. The
+	//database file itself doesn't shrink, but the reclaimed space is reused for
+	//new data.
+	PrunableChannels(context.Context, *PrunableChannelsRequest) (*PrunableChannelsResponse, error)
 	//*
 	//OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	//call is meant to be consumed by clients to the REST proxy. As with all
@@ -11153,6 +13141,13 @@ type LightningServer interface {
 	//StopDaemon will send a shutdown request to the interrupt handler, triggering
 	//a graceful shutdown of the daemon.
 	StopDaemon(context.Context, *StopRequest) (*StopResponse, error)
+	//* lncli: `drain`
+	//DrainAndStop puts the daemon into drain mode in preparation of a graceful
+	//shutdown. All channels are disabled on the network, new forwards and
+	//incoming channels are rejected, and the daemon waits for in-flight HTLCs
+	//to be resolved before shutting down. The progress is reported over the
+	//returned stream.
+	DrainAndStop(*DrainRequest, Lightning_DrainAndStopServer) error
 	//*
 	//SubscribeChannelGraph launches a streaming RPC that allows the caller to
 	//receive notifications upon any changes to the channel graph topology from
@@ -11175,6 +13170,24 @@ type LightningServer interface {
 	//UpdateChannelPolicy allows the caller to update the fee schedule and
 	//channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error)
+	//* lncli: `setfeestrategy`
+	//SetFeeStrategy sets or clears the dynamic fee strategy for a particular
+	//channel, or the default strategy used for all channels without a strategy
+	//of their own. If the fee engine is active, it will periodically re-price
+	//each channel according to its strategy.
+	SetFeeStrategy(context.Context, *SetFeeStrategyRequest) (*SetFeeStrategyResponse, error)
+	//* lncli: `previewfeeupdates`
+	//PreviewFeeUpdates returns the configured fee strategies along with the
+	//policy the fee engine would select for each channel, and whether it would
+	//apply it, without changing any channel policies.
+	PreviewFeeUpdates(context.Context, *PreviewFeeUpdatesRequest) (*PreviewFeeUpdatesResponse, error)
+	//* lncli: `updatedeadlinepolicy`
+	//UpdateDeadlinePolicy sets the policy that decides when a particular
+	//channel is force closed in order to resolve its HTLCs on chain. HTLCs
+	//below the minimum chain HTLC value of the policy are failed back or
+	//written off instead, as going to chain for them would cost more than
+	//they are worth.
+	UpdateDeadlinePolicy(context.Context, *DeadlinePolicyRequest) (*DeadlinePolicyResponse, error)
 	//* lncli: `fwdinghistory`
 	//ForwardingHistory allows the caller to query the htlcswitch for a record of
 	//all HTLCs forwarded within the target time range, and integer offset
@@ -11222,6 +13235,12 @@ type LightningServer interface {
 	//ups, but the updated set of encrypted multi-chan backups with the closed
 	//channel(s) removed.
 	SubscribeChannelBackups(*ChannelBackupSubscription, Lightning_SubscribeChannelBackupsServer) error
+	//*
+	//SubscribeStuckHtlcEvents creates a uni-directional stream from the server
+	//to the client over which the actions of the stuck HTLC monitor are sent.
+	//An event is sent each time a channel with stuck outgoing HTLCs moves to
+	//another stage. It fails if the stuck HTLC monitor isn't active.
+	SubscribeStuckHtlcEvents(*StuckHtlcEventSubscription, Lightning_SubscribeStuckHtlcEventsServer) error
 }
 
 func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
@@ -11576,6 +13595,24 @@ func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_PrunableChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrunableChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).PrunableChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/PrunableChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).PrunableChannels(ctx, req.(*PrunableChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenChannelRequest)
 	if err := dec(in); err != nil {
@@ -12005,6 +14042,27 @@ func _Lightning_StopDaemon_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_DrainAndStop_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DrainRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).DrainAndStop(m, &lightningDrainAndStopServer{stream})
+}
+
+type Lightning_DrainAndStopServer interface {
+	Send(*DrainUpdate) error
+	grpc.ServerStream
+}
+
+type lightningDrainAndStopServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningDrainAndStopServer) Send(m *DrainUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_SubscribeChannelGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GraphTopologySubscription)
 	if err := stream.RecvMsg(m); err != nil {
@@ -12080,6 +14138,60 @@ func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SetFeeStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeeStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetFeeStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetFeeStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetFeeStrategy(ctx, req.(*SetFeeStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_PreviewFeeUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewFeeUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).PreviewFeeUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/PreviewFeeUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).PreviewFeeUpdates(ctx, req.(*PreviewFeeUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_UpdateDeadlinePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeadlinePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UpdateDeadlinePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpdateDeadlinePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpdateDeadlinePolicy(ctx, req.(*DeadlinePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ForwardingHistoryRequest)
 	if err := dec(in); err != nil {
@@ -12191,6 +14303,27 @@ func (x *lightningSubscribeChannelBackupsServer) Send(m *ChanBackupSnapshot) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_SubscribeStuckHtlcEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StuckHtlcEventSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeStuckHtlcEvents(m, &lightningSubscribeStuckHtlcEventsServer{stream})
+}
+
+type Lightning_SubscribeStuckHtlcEventsServer interface {
+	Send(*StuckHtlcEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeStuckHtlcEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeStuckHtlcEventsServer) Send(m *StuckHtlcEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Lightning_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lnrpc.Lightning",
 	HandlerType: (*LightningServer)(nil),
@@ -12263,6 +14396,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClosedChannels",
 			Handler:    _Lightning_ClosedChannels_Handler,
 		},
+		{
+			MethodName: "PrunableChannels",
+			Handler:    _Lightning_PrunableChannels_Handler,
+		},
 		{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
@@ -12339,6 +14476,18 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChannelPolicy",
 			Handler:    _Lightning_UpdateChannelPolicy_Handler,
 		},
+		{
+			MethodName: "SetFeeStrategy",
+			Handler:    _Lightning_SetFeeStrategy_Handler,
+		},
+		{
+			MethodName: "PreviewFeeUpdates",
+			Handler:    _Lightning_PreviewFeeUpdates_Handler,
+		},
+		{
+			MethodName: "UpdateDeadlinePolicy",
+			Handler:    _Lightning_UpdateDeadlinePolicy_Handler,
+		},
 		{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
@@ -12404,6 +14553,11 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeInvoices_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DrainAndStop",
+			Handler:       _Lightning_DrainAndStop_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeChannelGraph",
 			Handler:       _Lightning_SubscribeChannelGraph_Handler,
@@ -12414,6 +14568,11 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeChannelBackups_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeStuckHtlcEvents",
+			Handler:       _Lightning_SubscribeStuckHtlcEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
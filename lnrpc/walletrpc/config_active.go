@@ -3,6 +3,7 @@
 package walletrpc
 
 import (
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/macaroons"
@@ -44,7 +45,19 @@ type Config struct {
 	// sweeping inputs in batches back into the wallet.
 	Sweeper *sweep.UtxoSweeper
 
+	// Consolidator merges the UTXOs of the wallet through the Sweeper
+	// whenever fee rates are low.
+	Consolidator *sweep.Consolidator
+
 	// Chain is an interface that the WalletKit will use to determine state
 	// about the backing chain of the wallet.
 	Chain lnwallet.BlockChainIO
+
+	// CoinSelectionLocker allows the WalletKit to perform coin selection
+	// for PSBTs without racing against other coin selection attempts.
+	CoinSelectionLocker sweep.CoinSelectionLocker
+
+	// ChainParams are the parameters of the chain the wallet is active on,
+	// used to decode the addresses of PSBT output templates.
+	ChainParams *chaincfg.Params
 }
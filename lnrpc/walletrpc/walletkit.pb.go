@@ -126,6 +126,74 @@ func (WitnessType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6cc6942ac78249e5, []int{0}
 }
 
+type SweepOutcome int32
+
+const (
+	// The output is still being swept.
+	SweepOutcome_SWEEP_PENDING SweepOutcome = 0
+	// The output was swept by one of our sweep transactions.
+	SweepOutcome_SWEEP_SUCCEEDED SweepOutcome = 1
+	// The output was spent by a transaction that wasn't created by us.
+	SweepOutcome_SWEEP_REMOTE_SPEND SweepOutcome = 2
+	//
+	//The sweep of the output failed, for example because it exceeded the
+	//maximum number of broadcast attempts.
+	SweepOutcome_SWEEP_FAILED SweepOutcome = 3
+)
+
+var SweepOutcome_name = map[int32]string{
+	0: "SWEEP_PENDING",
+	1: "SWEEP_SUCCEEDED",
+	2: "SWEEP_REMOTE_SPEND",
+	3: "SWEEP_FAILED",
+}
+
+var SweepOutcome_value = map[string]int32{
+	"SWEEP_PENDING":      0,
+	"SWEEP_SUCCEEDED":    1,
+	"SWEEP_REMOTE_SPEND": 2,
+	"SWEEP_FAILED":       3,
+}
+
+func (x SweepOutcome) String() string {
+	return proto.EnumName(SweepOutcome_name, int32(x))
+}
+
+func (SweepOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{1}
+}
+
+type AddressType int32
+
+const (
+	AddressType_UNKNOWN                    AddressType = 0
+	AddressType_WITNESS_PUBKEY_HASH        AddressType = 1
+	AddressType_NESTED_WITNESS_PUBKEY_HASH AddressType = 2
+	AddressType_PUBKEY_HASH                AddressType = 3
+)
+
+var AddressType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "WITNESS_PUBKEY_HASH",
+	2: "NESTED_WITNESS_PUBKEY_HASH",
+	3: "PUBKEY_HASH",
+}
+
+var AddressType_value = map[string]int32{
+	"UNKNOWN":                    0,
+	"WITNESS_PUBKEY_HASH":        1,
+	"NESTED_WITNESS_PUBKEY_HASH": 2,
+	"PUBKEY_HASH":                3,
+}
+
+func (x AddressType) String() string {
+	return proto.EnumName(AddressType_name, int32(x))
+}
+
+func (AddressType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{2}
+}
+
 type KeyReq struct {
 	//*
 	//Is the key finger print of the root pubkey that this request is targeting.
@@ -181,6 +249,16 @@ func (m *KeyReq) GetKeyFamily() int32 {
 }
 
 type AddrRequest struct {
+	//
+	//The name of the account to retrieve the next address of. If empty, the
+	//default wallet account is used.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	//
+	//The type of address to derive. If unspecified, a p2wkh address is
+	//returned.
+	Type AddressType `protobuf:"varint,2,opt,name=type,proto3,enum=walletrpc.AddressType" json:"type,omitempty"`
+	// Whether a change address should be derived.
+	Change               bool     `protobuf:"varint,3,opt,name=change,proto3" json:"change,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -211,6 +289,27 @@ func (m *AddrRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddrRequest proto.InternalMessageInfo
 
+func (m *AddrRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AddrRequest) GetType() AddressType {
+	if m != nil {
+		return m.Type
+	}
+	return AddressType_UNKNOWN
+}
+
+func (m *AddrRequest) GetChange() bool {
+	if m != nil {
+		return m.Change
+	}
+	return false
+}
+
 type AddrResponse struct {
 	//*
 	//The address encoded using a bech32 format.
@@ -255,7 +354,10 @@ func (m *AddrResponse) GetAddr() string {
 type Transaction struct {
 	//*
 	//The raw serialized transaction.
-	TxHex                []byte   `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	TxHex []byte `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	//*
+	//An optional label to add to the transaction, limited to 500 characters.
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -293,6 +395,13 @@ func (m *Transaction) GetTxHex() []byte {
 	return nil
 }
 
+func (m *Transaction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type PublishResponse struct {
 	//*
 	//If blank, then no error occurred and the transaction was successfully
@@ -345,10 +454,12 @@ type SendOutputsRequest struct {
 	SatPerKw int64 `protobuf:"varint,1,opt,name=sat_per_kw,json=satPerKw,proto3" json:"sat_per_kw,omitempty"`
 	//*
 	//A slice of the outputs that should be created in the transaction produced.
-	Outputs              []*signrpc.TxOut `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Outputs []*signrpc.TxOut `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// An optional label for the transaction, limited to 500 characters.
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SendOutputsRequest) Reset()         { *m = SendOutputsRequest{} }
@@ -390,6 +501,13 @@ func (m *SendOutputsRequest) GetOutputs() []*signrpc.TxOut {
 	return nil
 }
 
+func (m *SendOutputsRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type SendOutputsResponse struct {
 	//*
 	//The serialized transaction sent out on the network.
@@ -476,10 +594,15 @@ type EstimateFeeResponse struct {
 	//*
 	//The amount of satoshis per kw that should be used in order to reach the
 	//confirmation target in the request.
-	SatPerKw             int64    `protobuf:"varint,1,opt,name=sat_per_kw,json=satPerKw,proto3" json:"sat_per_kw,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SatPerKw int64 `protobuf:"varint,1,opt,name=sat_per_kw,json=satPerKw,proto3" json:"sat_per_kw,omitempty"`
+	//*
+	//The estimates of the individual fee sources the fee rate has been derived
+	//from. This is only populated if lnd is configured to combine the estimates
+	//of several fee sources.
+	SourceEstimates      []*FeeSourceEstimate `protobuf:"bytes,2,rep,name=source_estimates,json=sourceEstimates,proto3" json:"source_estimates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
@@ -514,6 +637,84 @@ func (m *EstimateFeeResponse) GetSatPerKw() int64 {
 	return 0
 }
 
+func (m *EstimateFeeResponse) GetSourceEstimates() []*FeeSourceEstimate {
+	if m != nil {
+		return m.SourceEstimates
+	}
+	return nil
+}
+
+type FeeSourceEstimate struct {
+	// The name of the fee source.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The fee rate in sat/kw estimated by the source.
+	SatPerKw int64 `protobuf:"varint,2,opt,name=sat_per_kw,json=satPerKw,proto3" json:"sat_per_kw,omitempty"`
+	//*
+	//The error returned by the source, if any. The estimates of sources that
+	//failed are ignored.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	//*
+	//Whether the estimate deviated too much from the estimates of the other
+	//sources, and has therefore been ignored.
+	Outlier              bool     `protobuf:"varint,4,opt,name=outlier,proto3" json:"outlier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeSourceEstimate) Reset()         { *m = FeeSourceEstimate{} }
+func (m *FeeSourceEstimate) String() string { return proto.CompactTextString(m) }
+func (*FeeSourceEstimate) ProtoMessage()    {}
+func (*FeeSourceEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{9}
+}
+
+func (m *FeeSourceEstimate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeSourceEstimate.Unmarshal(m, b)
+}
+func (m *FeeSourceEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeSourceEstimate.Marshal(b, m, deterministic)
+}
+func (m *FeeSourceEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSourceEstimate.Merge(m, src)
+}
+func (m *FeeSourceEstimate) XXX_Size() int {
+	return xxx_messageInfo_FeeSourceEstimate.Size(m)
+}
+func (m *FeeSourceEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSourceEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSourceEstimate proto.InternalMessageInfo
+
+func (m *FeeSourceEstimate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeeSourceEstimate) GetSatPerKw() int64 {
+	if m != nil {
+		return m.SatPerKw
+	}
+	return 0
+}
+
+func (m *FeeSourceEstimate) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FeeSourceEstimate) GetOutlier() bool {
+	if m != nil {
+		return m.Outlier
+	}
+	return false
+}
+
 type PendingSweep struct {
 	// The outpoint of the output we're attempting to sweep.
 	Outpoint *lnrpc.OutPoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
@@ -531,7 +732,22 @@ type PendingSweep struct {
 	//
 	//The next height of the chain at which we'll attempt to broadcast the
 	//sweep transaction of the output.
-	NextBroadcastHeight  uint32   `protobuf:"varint,6,opt,name=next_broadcast_height,proto3" json:"next_broadcast_height,omitempty"`
+	NextBroadcastHeight uint32 `protobuf:"varint,6,opt,name=next_broadcast_height,proto3" json:"next_broadcast_height,omitempty"`
+	//
+	//The height by which the output should be swept. The fee rate of outputs
+	//with a deadline is raised every block until it reaches the rate allowed by
+	//their fee budget at this height. Zero if the output doesn't have a
+	//deadline.
+	DeadlineHeight int32 `protobuf:"varint,7,opt,name=deadline_height,proto3" json:"deadline_height,omitempty"`
+	//
+	//The maximum fee in satoshis the output is willing to pay if it has a
+	//deadline. Zero if the fee rate is only bounded by the maximum fee rate of
+	//the central batching engine.
+	BudgetSat int64 `protobuf:"varint,8,opt,name=budget_sat,proto3" json:"budget_sat,omitempty"`
+	// The fee rate, in sat/byte, we'd sweep the output with at this height.
+	CurrentSatPerByte uint32 `protobuf:"varint,9,opt,name=current_sat_per_byte,proto3" json:"current_sat_per_byte,omitempty"`
+	// The fee rate, in sat/byte, we'd sweep the output with at the next height.
+	NextSatPerByte       uint32   `protobuf:"varint,10,opt,name=next_sat_per_byte,proto3" json:"next_sat_per_byte,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -541,7 +757,7 @@ func (m *PendingSweep) Reset()         { *m = PendingSweep{} }
 func (m *PendingSweep) String() string { return proto.CompactTextString(m) }
 func (*PendingSweep) ProtoMessage()    {}
 func (*PendingSweep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cc6942ac78249e5, []int{9}
+	return fileDescriptor_6cc6942ac78249e5, []int{10}
 }
 
 func (m *PendingSweep) XXX_Unmarshal(b []byte) error {
@@ -604,6 +820,34 @@ func (m *PendingSweep) GetNextBroadcastHeight() uint32 {
 	return 0
 }
 
+func (m *PendingSweep) GetDeadlineHeight() int32 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *PendingSweep) GetBudgetSat() int64 {
+	if m != nil {
+		return m.BudgetSat
+	}
+	return 0
+}
+
+func (m *PendingSweep) GetCurrentSatPerByte() uint32 {
+	if m != nil {
+		return m.CurrentSatPerByte
+	}
+	return 0
+}
+
+func (m *PendingSweep) GetNextSatPerByte() uint32 {
+	if m != nil {
+		return m.NextSatPerByte
+	}
+	return 0
+}
+
 type PendingSweepsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -614,7 +858,7 @@ func (m *PendingSweepsRequest) Reset()         { *m = PendingSweepsRequest{} }
 func (m *PendingSweepsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingSweepsRequest) ProtoMessage()    {}
 func (*PendingSweepsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cc6942ac78249e5, []int{10}
+	return fileDescriptor_6cc6942ac78249e5, []int{11}
 }
 
 func (m *PendingSweepsRequest) XXX_Unmarshal(b []byte) error {
@@ -648,7 +892,7 @@ func (m *PendingSweepsResponse) Reset()         { *m = PendingSweepsResponse{} }
 func (m *PendingSweepsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingSweepsResponse) ProtoMessage()    {}
 func (*PendingSweepsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cc6942ac78249e5, []int{11}
+	return fileDescriptor_6cc6942ac78249e5, []int{12}
 }
 
 func (m *PendingSweepsResponse) XXX_Unmarshal(b []byte) error {
@@ -684,7 +928,24 @@ type BumpFeeRequest struct {
 	//
 	//The fee rate, expressed in sat/byte, that should be used to spend the input
 	//with.
-	SatPerByte           uint32   `protobuf:"varint,3,opt,name=sat_per_byte,proto3" json:"sat_per_byte,omitempty"`
+	SatPerByte uint32 `protobuf:"varint,3,opt,name=sat_per_byte,proto3" json:"sat_per_byte,omitempty"`
+	//
+	//Whether the fee of the unconfirmed transaction the outpoint belongs to
+	//should be bumped by replacing it (RBF) rather than by spending the outpoint
+	//with a child transaction (CPFP). Only transactions funded by the wallet
+	//that have a change output can be replaced.
+	Replace bool `protobuf:"varint,4,opt,name=replace,proto3" json:"replace,omitempty"`
+	//
+	//The height by which the input should be swept. If set, the fee rate
+	//determined by the target_conf or sat_per_byte fields is raised every block
+	//until it reaches the rate allowed by the budget at this height. Can't be
+	//used when replacing a transaction.
+	DeadlineHeight int32 `protobuf:"varint,5,opt,name=deadline_height,proto3" json:"deadline_height,omitempty"`
+	//
+	//The maximum fee in satoshis to pay for sweeping the input before its
+	//deadline. If not set, the fee rate is raised up to the maximum fee rate of
+	//the central batching engine.
+	BudgetSat            int64    `protobuf:"varint,6,opt,name=budget_sat,proto3" json:"budget_sat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -694,7 +955,7 @@ func (m *BumpFeeRequest) Reset()         { *m = BumpFeeRequest{} }
 func (m *BumpFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*BumpFeeRequest) ProtoMessage()    {}
 func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cc6942ac78249e5, []int{12}
+	return fileDescriptor_6cc6942ac78249e5, []int{13}
 }
 
 func (m *BumpFeeRequest) XXX_Unmarshal(b []byte) error {
@@ -736,7 +997,30 @@ func (m *BumpFeeRequest) GetSatPerByte() uint32 {
 	return 0
 }
 
+func (m *BumpFeeRequest) GetReplace() bool {
+	if m != nil {
+		return m.Replace
+	}
+	return false
+}
+
+func (m *BumpFeeRequest) GetDeadlineHeight() int32 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *BumpFeeRequest) GetBudgetSat() int64 {
+	if m != nil {
+		return m.BudgetSat
+	}
+	return 0
+}
+
 type BumpFeeResponse struct {
+	// The txid of the replacement transaction, if the transaction was replaced.
+	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -746,7 +1030,7 @@ func (m *BumpFeeResponse) Reset()         { *m = BumpFeeResponse{} }
 func (m *BumpFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*BumpFeeResponse) ProtoMessage()    {}
 func (*BumpFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cc6942ac78249e5, []int{13}
+	return fileDescriptor_6cc6942ac78249e5, []int{14}
 }
 
 func (m *BumpFeeResponse) XXX_Unmarshal(b []byte) error {
@@ -767,244 +1051,2178 @@ func (m *BumpFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BumpFeeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("walletrpc.WitnessType", WitnessType_name, WitnessType_value)
-	proto.RegisterType((*KeyReq)(nil), "walletrpc.KeyReq")
-	proto.RegisterType((*AddrRequest)(nil), "walletrpc.AddrRequest")
-	proto.RegisterType((*AddrResponse)(nil), "walletrpc.AddrResponse")
-	proto.RegisterType((*Transaction)(nil), "walletrpc.Transaction")
-	proto.RegisterType((*PublishResponse)(nil), "walletrpc.PublishResponse")
-	proto.RegisterType((*SendOutputsRequest)(nil), "walletrpc.SendOutputsRequest")
-	proto.RegisterType((*SendOutputsResponse)(nil), "walletrpc.SendOutputsResponse")
-	proto.RegisterType((*EstimateFeeRequest)(nil), "walletrpc.EstimateFeeRequest")
-	proto.RegisterType((*EstimateFeeResponse)(nil), "walletrpc.EstimateFeeResponse")
-	proto.RegisterType((*PendingSweep)(nil), "walletrpc.PendingSweep")
-	proto.RegisterType((*PendingSweepsRequest)(nil), "walletrpc.PendingSweepsRequest")
-	proto.RegisterType((*PendingSweepsResponse)(nil), "walletrpc.PendingSweepsResponse")
-	proto.RegisterType((*BumpFeeRequest)(nil), "walletrpc.BumpFeeRequest")
-	proto.RegisterType((*BumpFeeResponse)(nil), "walletrpc.BumpFeeResponse")
+func (m *BumpFeeResponse) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_6cc6942ac78249e5) }
+type SweepRecord struct {
+	// The outpoint of the output that was offered to be swept.
+	Outpoint *lnrpc.OutPoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The witness type of the output.
+	WitnessType WitnessType `protobuf:"varint,2,opt,name=witness_type,proto3,enum=walletrpc.WitnessType" json:"witness_type,omitempty"`
+	// The value of the output.
+	AmountSat uint32 `protobuf:"varint,3,opt,name=amount_sat,proto3" json:"amount_sat,omitempty"`
+	// The state of the sweep of the output.
+	Outcome SweepOutcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=walletrpc.SweepOutcome" json:"outcome,omitempty"`
+	//
+	//The txid of the last sweep transaction spending the output that we've
+	//broadcast, if any.
+	SweepTxid string `protobuf:"bytes,5,opt,name=sweep_txid,proto3" json:"sweep_txid,omitempty"`
+	// The txid of the transaction that spent the output, if it was spent.
+	SpendTxid string `protobuf:"bytes,6,opt,name=spend_txid,proto3" json:"spend_txid,omitempty"`
+	//
+	//The fee paid by our sweep transaction that spent the output, shared with
+	//the other outputs it spent. Only known if the output was swept
+	//successfully.
+	FeeSat int64 `protobuf:"varint,7,opt,name=fee_sat,proto3" json:"fee_sat,omitempty"`
+	// The fee rate of the last sweep transaction we've broadcast.
+	SatPerByte uint32 `protobuf:"varint,8,opt,name=sat_per_byte,proto3" json:"sat_per_byte,omitempty"`
+	// The number of broadcast attempts we've made to sweep the output.
+	BroadcastAttempts uint32 `protobuf:"varint,9,opt,name=broadcast_attempts,proto3" json:"broadcast_attempts,omitempty"`
+	// The height by which the output should be swept, zero if none.
+	DeadlineHeight       int32    `protobuf:"varint,10,opt,name=deadline_height,proto3" json:"deadline_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-var fileDescriptor_6cc6942ac78249e5 = []byte{
-	// 976 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xed, 0x6e, 0xe2, 0x46,
-	0x14, 0x2d, 0x21, 0x61, 0xc3, 0x05, 0x12, 0x67, 0x08, 0x89, 0x97, 0xcd, 0x6e, 0xa8, 0xfb, 0x21,
-	0xd4, 0x56, 0xa0, 0x66, 0xdb, 0xaa, 0x6a, 0x7f, 0xb4, 0x59, 0x70, 0x44, 0xc4, 0x87, 0xa9, 0xed,
-	0x6c, 0xba, 0x55, 0xa5, 0x91, 0x81, 0x59, 0xb0, 0x00, 0xdb, 0x3b, 0x1e, 0x0a, 0xfc, 0x6d, 0x9f,
-	0xa4, 0xaf, 0xd1, 0xa7, 0xab, 0x3c, 0xb6, 0xc9, 0x18, 0x92, 0x4a, 0xfd, 0x15, 0xe7, 0x9c, 0x73,
-	0xcf, 0xdc, 0xb9, 0x33, 0x73, 0x04, 0x3c, 0x5f, 0x5a, 0xb3, 0x19, 0x61, 0xd4, 0x1b, 0xd6, 0xc3,
-	0xaf, 0xa9, 0xcd, 0x6a, 0x1e, 0x75, 0x99, 0x8b, 0xb2, 0x1b, 0xaa, 0x9c, 0xa5, 0xde, 0x30, 0x44,
-	0xcb, 0xa7, 0xbe, 0x3d, 0x76, 0x02, 0x79, 0xf0, 0x97, 0xd0, 0x10, 0x55, 0x7e, 0x81, 0x4c, 0x9b,
-	0xac, 0x75, 0xf2, 0x01, 0x55, 0x41, 0x9a, 0x92, 0x35, 0x7e, 0x6f, 0x3b, 0x63, 0x42, 0xb1, 0x47,
-	0x6d, 0x87, 0xc9, 0xa9, 0x4a, 0xaa, 0x7a, 0xa0, 0x1f, 0x4d, 0xc9, 0xfa, 0x86, 0xc3, 0xfd, 0x00,
-	0x45, 0x2f, 0x01, 0xb8, 0xd2, 0x9a, 0xdb, 0xb3, 0xb5, 0xbc, 0xc7, 0x35, 0xd9, 0x40, 0xc3, 0x01,
-	0xa5, 0x00, 0xb9, 0xeb, 0xd1, 0x88, 0xea, 0xe4, 0xc3, 0x82, 0xf8, 0x4c, 0x51, 0x20, 0x1f, 0xfe,
-	0xeb, 0x7b, 0xae, 0xe3, 0x13, 0x84, 0x60, 0xdf, 0x1a, 0x8d, 0x28, 0xf7, 0xce, 0xea, 0xfc, 0x5b,
-	0xf9, 0x14, 0x72, 0x26, 0xb5, 0x1c, 0xdf, 0x1a, 0x32, 0xdb, 0x75, 0x50, 0x09, 0x32, 0x6c, 0x85,
-	0x27, 0x64, 0xc5, 0x45, 0x79, 0xfd, 0x80, 0xad, 0x5a, 0x64, 0xa5, 0x7c, 0x07, 0xc7, 0xfd, 0xc5,
-	0x60, 0x66, 0xfb, 0x93, 0x8d, 0xd9, 0x27, 0x50, 0xf0, 0x42, 0x08, 0x13, 0x4a, 0xdd, 0xd8, 0x35,
-	0x1f, 0x81, 0x6a, 0x80, 0x29, 0xbf, 0x03, 0x32, 0x88, 0x33, 0xd2, 0x16, 0xcc, 0x5b, 0x30, 0x3f,
-	0xea, 0x0b, 0x5d, 0x00, 0xf8, 0x16, 0xc3, 0x1e, 0xa1, 0x78, 0xba, 0xe4, 0x75, 0x69, 0xfd, 0xd0,
-	0xb7, 0x58, 0x9f, 0xd0, 0xf6, 0x12, 0x55, 0xe1, 0x99, 0x1b, 0xea, 0xe5, 0xbd, 0x4a, 0xba, 0x9a,
-	0xbb, 0x3a, 0xaa, 0x45, 0xf3, 0xab, 0x99, 0x2b, 0x6d, 0xc1, 0xf4, 0x98, 0x56, 0xbe, 0x82, 0x62,
-	0xc2, 0x3d, 0xea, 0xac, 0x04, 0x19, 0x6a, 0x2d, 0x31, 0xdb, 0xec, 0x81, 0x5a, 0x4b, 0x73, 0xa5,
-	0x7c, 0x0b, 0x48, 0xf5, 0x99, 0x3d, 0xb7, 0x18, 0xb9, 0x21, 0x24, 0xee, 0xe5, 0x12, 0x72, 0x43,
-	0xd7, 0x79, 0x8f, 0x99, 0x45, 0xc7, 0x24, 0x1e, 0x3b, 0x04, 0x90, 0xc9, 0x11, 0xe5, 0x35, 0x14,
-	0x13, 0x65, 0xd1, 0x22, 0xff, 0xb9, 0x07, 0xe5, 0xef, 0x3d, 0xc8, 0xf7, 0x89, 0x33, 0xb2, 0x9d,
-	0xb1, 0xb1, 0x24, 0xc4, 0x43, 0x5f, 0xc2, 0x61, 0xd0, 0xb5, 0x1b, 0x1f, 0x6d, 0xee, 0xea, 0xb8,
-	0x36, 0xe3, 0x7b, 0xd2, 0x16, 0xac, 0x1f, 0xc0, 0xfa, 0x46, 0x80, 0x7e, 0x80, 0xfc, 0xd2, 0x66,
-	0x0e, 0xf1, 0x7d, 0xcc, 0xd6, 0x1e, 0xe1, 0xe7, 0x7c, 0x74, 0x75, 0x56, 0xdb, 0x5c, 0xae, 0xda,
-	0x7d, 0x48, 0x9b, 0x6b, 0x8f, 0xe8, 0x09, 0x2d, 0x7a, 0x05, 0x60, 0xcd, 0xdd, 0x85, 0xc3, 0xb0,
-	0x6f, 0x31, 0x39, 0x5d, 0x49, 0x55, 0x0b, 0xba, 0x80, 0x20, 0x05, 0xf2, 0x71, 0xdf, 0x83, 0x35,
-	0x23, 0xf2, 0x3e, 0x57, 0x24, 0x30, 0x54, 0x03, 0x34, 0xa0, 0xae, 0x35, 0x1a, 0x5a, 0x3e, 0xc3,
-	0x16, 0x63, 0x64, 0xee, 0x31, 0x5f, 0x3e, 0xe0, 0xca, 0x47, 0x18, 0xf4, 0x0d, 0x94, 0x1c, 0xb2,
-	0x62, 0xf8, 0x81, 0x9a, 0x10, 0x7b, 0x3c, 0x61, 0x72, 0x86, 0x97, 0x3c, 0x4e, 0x2a, 0x67, 0x70,
-	0x2a, 0x8e, 0x28, 0xbe, 0x1d, 0xca, 0xaf, 0x50, 0xda, 0xc2, 0xa3, 0x91, 0xff, 0x04, 0x47, 0x5e,
-	0x48, 0x60, 0x9f, 0x33, 0x72, 0x8a, 0xdf, 0x8f, 0x73, 0x61, 0x30, 0x62, 0xa5, 0xbe, 0x25, 0x57,
-	0xfe, 0x4a, 0xc1, 0xd1, 0x9b, 0xc5, 0xdc, 0x13, 0x8e, 0xff, 0x7f, 0x9d, 0x4b, 0x05, 0x72, 0xe1,
-	0x35, 0xc1, 0xc1, 0xfd, 0xe0, 0xc7, 0x52, 0xd0, 0x45, 0x68, 0x67, 0xba, 0xe9, 0xdd, 0xe9, 0x2a,
-	0x27, 0x70, 0xbc, 0x69, 0x22, 0xdc, 0xd9, 0x17, 0x7f, 0xa6, 0x21, 0x27, 0x1c, 0x29, 0x2a, 0xc2,
-	0xf1, 0x5d, 0xaf, 0xdd, 0xd3, 0xee, 0x7b, 0xf8, 0xfe, 0xd6, 0xec, 0xa9, 0x86, 0x21, 0x7d, 0x84,
-	0x64, 0x38, 0x6d, 0x68, 0xdd, 0xee, 0xad, 0xd9, 0x55, 0x7b, 0x26, 0x36, 0x6f, 0xbb, 0x2a, 0xee,
-	0x68, 0x8d, 0xb6, 0x94, 0x42, 0xe7, 0x50, 0x14, 0x98, 0x9e, 0x86, 0x9b, 0x6a, 0xe7, 0xfa, 0x9d,
-	0xb4, 0x87, 0x4a, 0x70, 0x22, 0x10, 0xba, 0xfa, 0x56, 0x6b, 0xab, 0x52, 0x3a, 0xd0, 0xb7, 0xcc,
-	0x4e, 0x03, 0x6b, 0x37, 0x37, 0xaa, 0xae, 0x36, 0x63, 0x62, 0x3f, 0x58, 0x82, 0x13, 0xd7, 0x8d,
-	0x86, 0xda, 0x37, 0x1f, 0x98, 0x03, 0xf4, 0x19, 0x7c, 0x9c, 0x28, 0x09, 0x96, 0xd7, 0xee, 0x4c,
-	0x6c, 0xa8, 0x0d, 0xad, 0xd7, 0xc4, 0x1d, 0xf5, 0xad, 0xda, 0x91, 0x32, 0xe8, 0x73, 0x50, 0x92,
-	0x06, 0xc6, 0x5d, 0xa3, 0xa1, 0x1a, 0x46, 0x52, 0xf7, 0x0c, 0x5d, 0xc2, 0x8b, 0xad, 0x0e, 0xba,
-	0x9a, 0xa9, 0xc6, 0xae, 0xd2, 0x21, 0xaa, 0xc0, 0xc5, 0x76, 0x27, 0x5c, 0x11, 0xf9, 0x49, 0x59,
-	0x74, 0x01, 0x32, 0x57, 0x88, 0xce, 0x71, 0xbf, 0x80, 0x4e, 0x41, 0x8a, 0x26, 0x87, 0xdb, 0xea,
-	0x3b, 0xdc, 0xba, 0x36, 0x5a, 0x52, 0x0e, 0xbd, 0x80, 0xf3, 0x9e, 0x6a, 0x04, 0x76, 0x3b, 0x64,
-	0xfe, 0xea, 0x9f, 0x7d, 0xc8, 0xde, 0xf3, 0x8b, 0xd4, 0xb6, 0x83, 0x37, 0x58, 0x68, 0x12, 0x6a,
-	0xff, 0x41, 0x7a, 0x64, 0xc5, 0xda, 0x64, 0x8d, 0x4e, 0x84, 0x5b, 0x16, 0xe6, 0x76, 0xf9, 0x6c,
-	0x13, 0x4c, 0x6d, 0xb2, 0x6e, 0x12, 0x7f, 0x48, 0x6d, 0x8f, 0xb9, 0x14, 0x7d, 0x0f, 0xd9, 0xb0,
-	0x36, 0xa8, 0x2b, 0x8a, 0xa2, 0x8e, 0x3b, 0xb4, 0x98, 0x4b, 0x9f, 0xac, 0xfc, 0x11, 0x0e, 0x83,
-	0xf5, 0x82, 0xd4, 0x46, 0xe2, 0x7b, 0x17, 0x52, 0xbd, 0x7c, 0xbe, 0x83, 0x47, 0xef, 0xa3, 0x05,
-	0x28, 0x0a, 0x69, 0x31, 0xd1, 0x45, 0x1b, 0x01, 0x2f, 0x97, 0xc5, 0x57, 0xb3, 0x95, 0xed, 0x1d,
-	0xc8, 0x09, 0xc1, 0x8a, 0x5e, 0x0a, 0xd2, 0xdd, 0x38, 0x2f, 0xbf, 0x7a, 0x8a, 0x7e, 0x70, 0x13,
-	0x12, 0x34, 0xe1, 0xb6, 0x1b, 0xc8, 0x09, 0xb7, 0xc7, 0x82, 0x57, 0x87, 0x42, 0x22, 0x1e, 0xd0,
-	0xe5, 0x13, 0xcf, 0x7f, 0xd3, 0x5f, 0xe5, 0x69, 0x41, 0xe4, 0xf9, 0x33, 0x3c, 0x8b, 0x9e, 0x24,
-	0x7a, 0x2e, 0x88, 0x93, 0x59, 0x91, 0x98, 0xd8, 0xd6, 0x0b, 0x7e, 0xf3, 0xf5, 0x6f, 0xf5, 0xb1,
-	0xcd, 0x26, 0x8b, 0x41, 0x6d, 0xe8, 0xce, 0xeb, 0xb3, 0x20, 0xe0, 0x1c, 0xdb, 0x19, 0x3b, 0x84,
-	0x2d, 0x5d, 0x3a, 0xad, 0xcf, 0x9c, 0x51, 0x9d, 0xc7, 0x4a, 0x7d, 0x63, 0x31, 0xc8, 0xf0, 0x9f,
-	0x01, 0xaf, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xc7, 0x77, 0x11, 0x4f, 0x08, 0x00, 0x00,
+func (m *SweepRecord) Reset()         { *m = SweepRecord{} }
+func (m *SweepRecord) String() string { return proto.CompactTextString(m) }
+func (*SweepRecord) ProtoMessage()    {}
+func (*SweepRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{15}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *SweepRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SweepRecord.Unmarshal(m, b)
+}
+func (m *SweepRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SweepRecord.Marshal(b, m, deterministic)
+}
+func (m *SweepRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepRecord.Merge(m, src)
+}
+func (m *SweepRecord) XXX_Size() int {
+	return xxx_messageInfo_SweepRecord.Size(m)
+}
+func (m *SweepRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepRecord.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_SweepRecord proto.InternalMessageInfo
 
-// WalletKitClient is the client API for WalletKit service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WalletKitClient interface {
-	//*
-	//DeriveNextKey attempts to derive the *next* key within the key family
-	//(account in BIP43) specified. This method should return the next external
-	//child within this branch.
-	DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
-	//*
-	//DeriveKey attempts to derive an arbitrary key specified by the passed
-	//KeyLocator.
-	DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
-	//*
-	//NextAddr returns the next unused address within the wallet.
-	NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error)
-	//*
-	//PublishTransaction attempts to publish the passed transaction to the
-	//network. Once this returns without an error, the wallet will continually
-	//attempt to re-broadcast the transaction on start up, until it enters the
-	//chain.
-	PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error)
-	//*
-	//SendOutputs is similar to the existing sendmany call in Bitcoind, and
-	//allows the caller to create a transaction that sends to several outputs at
-	//once. This is ideal when wanting to batch create a set of transactions.
-	SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error)
-	//*
-	//EstimateFee attempts to query the internal fee estimator of the wallet to
-	//determine the fee (in sat/kw) to attach to a transaction in order to
-	//achieve the confirmation target.
-	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
-	//
-	//PendingSweeps returns lists of on-chain outputs that lnd is currently
-	//attempting to sweep within its central batching engine. Outputs with similar
-	//fee rates are batched together in order to sweep them within a single
-	//transaction.
-	//
-	//NOTE: Some of the fields within PendingSweepsRequest are not guaranteed to
-	//remain supported. This is an advanced API that depends on the internals of
-	//the UtxoSweeper, so things may change.
-	PendingSweeps(ctx context.Context, in *PendingSweepsRequest, opts ...grpc.CallOption) (*PendingSweepsResponse, error)
-	//
-	//BumpFee bumps the fee of an arbitrary input within a transaction. This RPC
-	//takes a different approach than bitcoind's bumpfee command. lnd has a
-	//central batching engine in which inputs with similar fee rates are batched
-	//together to save on transaction fees. Due to this, we cannot rely on
-	//bumping the fee on a specific transaction, since transactions can change at
-	//any point with the addition of new inputs. The list of inputs that
-	//currently exist within lnd's central batching engine can be retrieved
-	//through the PendingSweeps RPC.
-	//
-	//When bumping the fee of an input that currently exists within lnd's central
-	//batching engine, a higher fee transaction will be created that replaces the
-	//lower fee transaction through the Replace-By-Fee (RBF) policy. If it
-	//
-	//This RPC also serves useful when wanting to perform a Child-Pays-For-Parent
-	//(CPFP), where the child transaction pays for its parent's fee. This can be
-	//done by specifying an outpoint within the low fee transaction that is under
-	//the control of the wallet.
-	//
-	//The fee preference can be expressed either as a specific fee rate or a delta
-	//of blocks in which the output should be swept on-chain within. If a fee
+func (m *SweepRecord) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *SweepRecord) GetWitnessType() WitnessType {
+	if m != nil {
+		return m.WitnessType
+	}
+	return WitnessType_UNKNOWN_WITNESS
+}
+
+func (m *SweepRecord) GetAmountSat() uint32 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *SweepRecord) GetOutcome() SweepOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return SweepOutcome_SWEEP_PENDING
+}
+
+func (m *SweepRecord) GetSweepTxid() string {
+	if m != nil {
+		return m.SweepTxid
+	}
+	return ""
+}
+
+func (m *SweepRecord) GetSpendTxid() string {
+	if m != nil {
+		return m.SpendTxid
+	}
+	return ""
+}
+
+func (m *SweepRecord) GetFeeSat() int64 {
+	if m != nil {
+		return m.FeeSat
+	}
+	return 0
+}
+
+func (m *SweepRecord) GetSatPerByte() uint32 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *SweepRecord) GetBroadcastAttempts() uint32 {
+	if m != nil {
+		return m.BroadcastAttempts
+	}
+	return 0
+}
+
+func (m *SweepRecord) GetDeadlineHeight() int32 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+type ListSweepsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSweepsRequest) Reset()         { *m = ListSweepsRequest{} }
+func (m *ListSweepsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSweepsRequest) ProtoMessage()    {}
+func (*ListSweepsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{16}
+}
+
+func (m *ListSweepsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSweepsRequest.Unmarshal(m, b)
+}
+func (m *ListSweepsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSweepsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSweepsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSweepsRequest.Merge(m, src)
+}
+func (m *ListSweepsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSweepsRequest.Size(m)
+}
+func (m *ListSweepsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSweepsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSweepsRequest proto.InternalMessageInfo
+
+type ListSweepsResponse struct {
+	//
+	//The outputs that were offered to lnd's central batching engine, along
+	//with the outcome of their sweep.
+	Sweeps               []*SweepRecord `protobuf:"bytes,1,rep,name=sweeps,proto3" json:"sweeps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListSweepsResponse) Reset()         { *m = ListSweepsResponse{} }
+func (m *ListSweepsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSweepsResponse) ProtoMessage()    {}
+func (*ListSweepsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{17}
+}
+
+func (m *ListSweepsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSweepsResponse.Unmarshal(m, b)
+}
+func (m *ListSweepsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSweepsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSweepsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSweepsResponse.Merge(m, src)
+}
+func (m *ListSweepsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSweepsResponse.Size(m)
+}
+func (m *ListSweepsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSweepsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSweepsResponse proto.InternalMessageInfo
+
+func (m *ListSweepsResponse) GetSweeps() []*SweepRecord {
+	if m != nil {
+		return m.Sweeps
+	}
+	return nil
+}
+
+type LeaseOutputRequest struct {
+	//
+	//An ID of 32 random bytes that must be unique for each distinct application
+	//using this RPC which will be used to bound the output lease to.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifying outpoint of the output being leased.
+	Outpoint *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	//
+	//The duration, in seconds, of the lease. If not set, a default of 10 minutes
+	//is used.
+	ExpirationSeconds    uint64   `protobuf:"varint,3,opt,name=expiration_seconds,proto3" json:"expiration_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaseOutputRequest) Reset()         { *m = LeaseOutputRequest{} }
+func (m *LeaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputRequest) ProtoMessage()    {}
+func (*LeaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{18}
+}
+
+func (m *LeaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputRequest.Unmarshal(m, b)
+}
+func (m *LeaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (m *LeaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputRequest.Merge(m, src)
+}
+func (m *LeaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputRequest.Size(m)
+}
+func (m *LeaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseOutputRequest proto.InternalMessageInfo
+
+func (m *LeaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *LeaseOutputRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *LeaseOutputRequest) GetExpirationSeconds() uint64 {
+	if m != nil {
+		return m.ExpirationSeconds
+	}
+	return 0
+}
+
+type LeaseOutputResponse struct {
+	//
+	//The absolute expiration of the output lease represented as a unix
+	//timestamp.
+	Expiration           uint64   `protobuf:"varint,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaseOutputResponse) Reset()         { *m = LeaseOutputResponse{} }
+func (m *LeaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputResponse) ProtoMessage()    {}
+func (*LeaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{19}
+}
+
+func (m *LeaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputResponse.Unmarshal(m, b)
+}
+func (m *LeaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (m *LeaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputResponse.Merge(m, src)
+}
+func (m *LeaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputResponse.Size(m)
+}
+func (m *LeaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseOutputResponse proto.InternalMessageInfo
+
+func (m *LeaseOutputResponse) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+type ReleaseOutputRequest struct {
+	// The unique ID that was used to lease the output.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifying outpoint of the output being released.
+	Outpoint             *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReleaseOutputRequest) Reset()         { *m = ReleaseOutputRequest{} }
+func (m *ReleaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputRequest) ProtoMessage()    {}
+func (*ReleaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{20}
+}
+
+func (m *ReleaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputRequest.Unmarshal(m, b)
+}
+func (m *ReleaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputRequest.Merge(m, src)
+}
+func (m *ReleaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputRequest.Size(m)
+}
+func (m *ReleaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputRequest proto.InternalMessageInfo
+
+func (m *ReleaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReleaseOutputRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+type ReleaseOutputResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseOutputResponse) Reset()         { *m = ReleaseOutputResponse{} }
+func (m *ReleaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputResponse) ProtoMessage()    {}
+func (*ReleaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{21}
+}
+
+func (m *ReleaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputResponse.Unmarshal(m, b)
+}
+func (m *ReleaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (m *ReleaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputResponse.Merge(m, src)
+}
+func (m *ReleaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputResponse.Size(m)
+}
+func (m *ReleaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputResponse proto.InternalMessageInfo
+
+type ListLeasesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLeasesRequest) Reset()         { *m = ListLeasesRequest{} }
+func (m *ListLeasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLeasesRequest) ProtoMessage()    {}
+func (*ListLeasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{22}
+}
+
+func (m *ListLeasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLeasesRequest.Unmarshal(m, b)
+}
+func (m *ListLeasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLeasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLeasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLeasesRequest.Merge(m, src)
+}
+func (m *ListLeasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLeasesRequest.Size(m)
+}
+func (m *ListLeasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLeasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLeasesRequest proto.InternalMessageInfo
+
+type UtxoLease struct {
+	// A 32 byte random ID that identifies the lease.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifying outpoint of the leased output.
+	Outpoint *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	//
+	//The absolute expiration of the output lease represented as a unix
+	//timestamp.
+	Expiration           uint64   `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UtxoLease) Reset()         { *m = UtxoLease{} }
+func (m *UtxoLease) String() string { return proto.CompactTextString(m) }
+func (*UtxoLease) ProtoMessage()    {}
+func (*UtxoLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{23}
+}
+
+func (m *UtxoLease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoLease.Unmarshal(m, b)
+}
+func (m *UtxoLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoLease.Marshal(b, m, deterministic)
+}
+func (m *UtxoLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoLease.Merge(m, src)
+}
+func (m *UtxoLease) XXX_Size() int {
+	return xxx_messageInfo_UtxoLease.Size(m)
+}
+func (m *UtxoLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtxoLease proto.InternalMessageInfo
+
+func (m *UtxoLease) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *UtxoLease) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *UtxoLease) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+type ListLeasesResponse struct {
+	// The list of currently leased utxos.
+	LockedUtxos          []*UtxoLease `protobuf:"bytes,1,rep,name=locked_utxos,proto3" json:"locked_utxos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListLeasesResponse) Reset()         { *m = ListLeasesResponse{} }
+func (m *ListLeasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLeasesResponse) ProtoMessage()    {}
+func (*ListLeasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{24}
+}
+
+func (m *ListLeasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLeasesResponse.Unmarshal(m, b)
+}
+func (m *ListLeasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLeasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListLeasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLeasesResponse.Merge(m, src)
+}
+func (m *ListLeasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListLeasesResponse.Size(m)
+}
+func (m *ListLeasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLeasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLeasesResponse proto.InternalMessageInfo
+
+func (m *ListLeasesResponse) GetLockedUtxos() []*UtxoLease {
+	if m != nil {
+		return m.LockedUtxos
+	}
+	return nil
+}
+
+type ConsolidateUtxosRequest struct {
+	//
+	//If set, the consolidation is only planned and returned, without any of the
+	//wallet's utxos being spent.
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+	//
+	//If set, the utxos are consolidated even if the estimated fee rate exceeds
+	//the configured maximum or fewer utxos than the configured minimum are
+	//worth merging. At least two utxos are still required.
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsolidateUtxosRequest) Reset()         { *m = ConsolidateUtxosRequest{} }
+func (m *ConsolidateUtxosRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsolidateUtxosRequest) ProtoMessage()    {}
+func (*ConsolidateUtxosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{25}
+}
+
+func (m *ConsolidateUtxosRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsolidateUtxosRequest.Unmarshal(m, b)
+}
+func (m *ConsolidateUtxosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsolidateUtxosRequest.Marshal(b, m, deterministic)
+}
+func (m *ConsolidateUtxosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidateUtxosRequest.Merge(m, src)
+}
+func (m *ConsolidateUtxosRequest) XXX_Size() int {
+	return xxx_messageInfo_ConsolidateUtxosRequest.Size(m)
+}
+func (m *ConsolidateUtxosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidateUtxosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsolidateUtxosRequest proto.InternalMessageInfo
+
+func (m *ConsolidateUtxosRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ConsolidateUtxosRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type ConsolidationInput struct {
+	// The outpoint of the wallet utxo being merged.
+	Outpoint *lnrpc.OutPoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The value of the utxo in satoshis.
+	AmountSat int64 `protobuf:"varint,2,opt,name=amount_sat,proto3" json:"amount_sat,omitempty"`
+	// The address type of the utxo.
+	AddressType AddressType `protobuf:"varint,3,opt,name=address_type,proto3,enum=walletrpc.AddressType" json:"address_type,omitempty"`
+	// The number of confirmations of the utxo.
+	Confirmations        int64    `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsolidationInput) Reset()         { *m = ConsolidationInput{} }
+func (m *ConsolidationInput) String() string { return proto.CompactTextString(m) }
+func (*ConsolidationInput) ProtoMessage()    {}
+func (*ConsolidationInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{26}
+}
+
+func (m *ConsolidationInput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsolidationInput.Unmarshal(m, b)
+}
+func (m *ConsolidationInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsolidationInput.Marshal(b, m, deterministic)
+}
+func (m *ConsolidationInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidationInput.Merge(m, src)
+}
+func (m *ConsolidationInput) XXX_Size() int {
+	return xxx_messageInfo_ConsolidationInput.Size(m)
+}
+func (m *ConsolidationInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidationInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsolidationInput proto.InternalMessageInfo
+
+func (m *ConsolidationInput) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *ConsolidationInput) GetAmountSat() int64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *ConsolidationInput) GetAddressType() AddressType {
+	if m != nil {
+		return m.AddressType
+	}
+	return AddressType_UNKNOWN
+}
+
+func (m *ConsolidationInput) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+type ConsolidateUtxosResponse struct {
+	//
+	//The wallet utxos merged by the consolidation, ordered from smallest to
+	//largest.
+	Inputs []*ConsolidationInput `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// The fee rate, expressed in sat/kw, the consolidation is swept with.
+	SatPerKw int64 `protobuf:"varint,2,opt,name=sat_per_kw,proto3" json:"sat_per_kw,omitempty"`
+	// The total value of the merged utxos in satoshis.
+	TotalAmountSat int64 `protobuf:"varint,3,opt,name=total_amount_sat,proto3" json:"total_amount_sat,omitempty"`
+	//
+	//The estimated fee in satoshis of the consolidation if its inputs are swept
+	//within a single transaction.
+	FeeSat int64 `protobuf:"varint,4,opt,name=fee_sat,proto3" json:"fee_sat,omitempty"`
+	//
+	//The reason the consolidation wouldn't take place unless forced. Only set
+	//for dry runs.
+	SkipReason           string   `protobuf:"bytes,5,opt,name=skip_reason,proto3" json:"skip_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsolidateUtxosResponse) Reset()         { *m = ConsolidateUtxosResponse{} }
+func (m *ConsolidateUtxosResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsolidateUtxosResponse) ProtoMessage()    {}
+func (*ConsolidateUtxosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{27}
+}
+
+func (m *ConsolidateUtxosResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsolidateUtxosResponse.Unmarshal(m, b)
+}
+func (m *ConsolidateUtxosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsolidateUtxosResponse.Marshal(b, m, deterministic)
+}
+func (m *ConsolidateUtxosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidateUtxosResponse.Merge(m, src)
+}
+func (m *ConsolidateUtxosResponse) XXX_Size() int {
+	return xxx_messageInfo_ConsolidateUtxosResponse.Size(m)
+}
+func (m *ConsolidateUtxosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidateUtxosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsolidateUtxosResponse proto.InternalMessageInfo
+
+func (m *ConsolidateUtxosResponse) GetInputs() []*ConsolidationInput {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *ConsolidateUtxosResponse) GetSatPerKw() int64 {
+	if m != nil {
+		return m.SatPerKw
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosResponse) GetTotalAmountSat() int64 {
+	if m != nil {
+		return m.TotalAmountSat
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosResponse) GetFeeSat() int64 {
+	if m != nil {
+		return m.FeeSat
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosResponse) GetSkipReason() string {
+	if m != nil {
+		return m.SkipReason
+	}
+	return ""
+}
+
+type TxTemplate struct {
+	//
+	//An optional list of inputs to use. Every input must be an UTXO known to the
+	//wallet that has not been leased before. The sum of all inputs must be
+	//sufficiently greater than the sum of all outputs to pay a miner fee with
+	//the fee rate specified in the request.
+	//
+	//If no inputs are specified, coin selection will be performed instead and
+	//inputs of sufficient value will be added to the resulting PSBT.
+	Inputs []*lnrpc.OutPoint `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// A map of all addresses and the amounts to send to in the funded PSBT.
+	Outputs              map[string]uint64 `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TxTemplate) Reset()         { *m = TxTemplate{} }
+func (m *TxTemplate) String() string { return proto.CompactTextString(m) }
+func (*TxTemplate) ProtoMessage()    {}
+func (*TxTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{28}
+}
+
+func (m *TxTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxTemplate.Unmarshal(m, b)
+}
+func (m *TxTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxTemplate.Marshal(b, m, deterministic)
+}
+func (m *TxTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxTemplate.Merge(m, src)
+}
+func (m *TxTemplate) XXX_Size() int {
+	return xxx_messageInfo_TxTemplate.Size(m)
+}
+func (m *TxTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxTemplate proto.InternalMessageInfo
+
+func (m *TxTemplate) GetInputs() []*lnrpc.OutPoint {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *TxTemplate) GetOutputs() map[string]uint64 {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+type FundPsbtRequest struct {
+	// Types that are valid to be assigned to Template:
+	//	*FundPsbtRequest_Psbt
+	//	*FundPsbtRequest_Raw
+	Template isFundPsbtRequest_Template `protobuf_oneof:"template"`
+	// Types that are valid to be assigned to Fees:
+	//	*FundPsbtRequest_TargetConf
+	//	*FundPsbtRequest_SatPerVbyte
+	Fees                 isFundPsbtRequest_Fees `protobuf_oneof:"fees"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *FundPsbtRequest) Reset()         { *m = FundPsbtRequest{} }
+func (m *FundPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*FundPsbtRequest) ProtoMessage()    {}
+func (*FundPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{29}
+}
+
+func (m *FundPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundPsbtRequest.Unmarshal(m, b)
+}
+func (m *FundPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundPsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *FundPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundPsbtRequest.Merge(m, src)
+}
+func (m *FundPsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_FundPsbtRequest.Size(m)
+}
+func (m *FundPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundPsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundPsbtRequest proto.InternalMessageInfo
+
+type isFundPsbtRequest_Template interface {
+	isFundPsbtRequest_Template()
+}
+
+type FundPsbtRequest_Psbt struct {
+	Psbt []byte `protobuf:"bytes,1,opt,name=psbt,proto3,oneof"`
+}
+
+type FundPsbtRequest_Raw struct {
+	Raw *TxTemplate `protobuf:"bytes,2,opt,name=raw,proto3,oneof"`
+}
+
+func (*FundPsbtRequest_Psbt) isFundPsbtRequest_Template() {}
+
+func (*FundPsbtRequest_Raw) isFundPsbtRequest_Template() {}
+
+func (m *FundPsbtRequest) GetTemplate() isFundPsbtRequest_Template {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *FundPsbtRequest) GetPsbt() []byte {
+	if x, ok := m.GetTemplate().(*FundPsbtRequest_Psbt); ok {
+		return x.Psbt
+	}
+	return nil
+}
+
+func (m *FundPsbtRequest) GetRaw() *TxTemplate {
+	if x, ok := m.GetTemplate().(*FundPsbtRequest_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+type isFundPsbtRequest_Fees interface {
+	isFundPsbtRequest_Fees()
+}
+
+type FundPsbtRequest_TargetConf struct {
+	TargetConf uint32 `protobuf:"varint,3,opt,name=target_conf,proto3,oneof"`
+}
+
+type FundPsbtRequest_SatPerVbyte struct {
+	SatPerVbyte uint64 `protobuf:"varint,4,opt,name=sat_per_vbyte,proto3,oneof"`
+}
+
+func (*FundPsbtRequest_TargetConf) isFundPsbtRequest_Fees() {}
+
+func (*FundPsbtRequest_SatPerVbyte) isFundPsbtRequest_Fees() {}
+
+func (m *FundPsbtRequest) GetFees() isFundPsbtRequest_Fees {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *FundPsbtRequest) GetTargetConf() uint32 {
+	if x, ok := m.GetFees().(*FundPsbtRequest_TargetConf); ok {
+		return x.TargetConf
+	}
+	return 0
+}
+
+func (m *FundPsbtRequest) GetSatPerVbyte() uint64 {
+	if x, ok := m.GetFees().(*FundPsbtRequest_SatPerVbyte); ok {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*FundPsbtRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*FundPsbtRequest_Psbt)(nil),
+		(*FundPsbtRequest_Raw)(nil),
+		(*FundPsbtRequest_TargetConf)(nil),
+		(*FundPsbtRequest_SatPerVbyte)(nil),
+	}
+}
+
+type FundPsbtResponse struct {
+	// The funded but not yet signed PSBT packet.
+	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,proto3" json:"funded_psbt,omitempty"`
+	//
+	//The index of the added change output or -1 if no change was left over.
+	ChangeOutputIndex int32 `protobuf:"varint,2,opt,name=change_output_index,proto3" json:"change_output_index,omitempty"`
+	//
+	//The list of lease locks that were acquired for the inputs of the funded
+	//PSBT packet.
+	LockedUtxos          []*UtxoLease `protobuf:"bytes,3,rep,name=locked_utxos,proto3" json:"locked_utxos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FundPsbtResponse) Reset()         { *m = FundPsbtResponse{} }
+func (m *FundPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*FundPsbtResponse) ProtoMessage()    {}
+func (*FundPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{30}
+}
+
+func (m *FundPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundPsbtResponse.Unmarshal(m, b)
+}
+func (m *FundPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundPsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *FundPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundPsbtResponse.Merge(m, src)
+}
+func (m *FundPsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_FundPsbtResponse.Size(m)
+}
+func (m *FundPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundPsbtResponse proto.InternalMessageInfo
+
+func (m *FundPsbtResponse) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
+	}
+	return nil
+}
+
+func (m *FundPsbtResponse) GetChangeOutputIndex() int32 {
+	if m != nil {
+		return m.ChangeOutputIndex
+	}
+	return 0
+}
+
+func (m *FundPsbtResponse) GetLockedUtxos() []*UtxoLease {
+	if m != nil {
+		return m.LockedUtxos
+	}
+	return nil
+}
+
+type SignPsbtRequest struct {
+	// The PSBT that should be signed.
+	FundedPsbt           []byte   `protobuf:"bytes,1,opt,name=funded_psbt,proto3" json:"funded_psbt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignPsbtRequest) Reset()         { *m = SignPsbtRequest{} }
+func (m *SignPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*SignPsbtRequest) ProtoMessage()    {}
+func (*SignPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{31}
+}
+
+func (m *SignPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignPsbtRequest.Unmarshal(m, b)
+}
+func (m *SignPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignPsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *SignPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPsbtRequest.Merge(m, src)
+}
+func (m *SignPsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_SignPsbtRequest.Size(m)
+}
+func (m *SignPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPsbtRequest proto.InternalMessageInfo
+
+func (m *SignPsbtRequest) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
+	}
+	return nil
+}
+
+type SignPsbtResponse struct {
+	// The signed transaction in PSBT format.
+	SignedPsbt []byte `protobuf:"bytes,1,opt,name=signed_psbt,proto3" json:"signed_psbt,omitempty"`
+	// The indices of the inputs that were signed by the wallet.
+	SignedInputs         []uint32 `protobuf:"varint,2,rep,packed,name=signed_inputs,proto3" json:"signed_inputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignPsbtResponse) Reset()         { *m = SignPsbtResponse{} }
+func (m *SignPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*SignPsbtResponse) ProtoMessage()    {}
+func (*SignPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{32}
+}
+
+func (m *SignPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignPsbtResponse.Unmarshal(m, b)
+}
+func (m *SignPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignPsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *SignPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPsbtResponse.Merge(m, src)
+}
+func (m *SignPsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_SignPsbtResponse.Size(m)
+}
+func (m *SignPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPsbtResponse proto.InternalMessageInfo
+
+func (m *SignPsbtResponse) GetSignedPsbt() []byte {
+	if m != nil {
+		return m.SignedPsbt
+	}
+	return nil
+}
+
+func (m *SignPsbtResponse) GetSignedInputs() []uint32 {
+	if m != nil {
+		return m.SignedInputs
+	}
+	return nil
+}
+
+type FinalizePsbtRequest struct {
+	//
+	//A PSBT that should be signed and finalized. The PSBT must contain all
+	//required inputs, outputs, UTXO data and partial signatures of all other
+	//signers.
+	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,proto3" json:"funded_psbt,omitempty"`
+	//
+	//Whether the final transaction should be published to the network after it
+	//has been extracted.
+	Publish bool `protobuf:"varint,2,opt,name=publish,proto3" json:"publish,omitempty"`
+	//
+	//An optional label for the final transaction if it is published, limited
+	//to 500 characters.
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizePsbtRequest) Reset()         { *m = FinalizePsbtRequest{} }
+func (m *FinalizePsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizePsbtRequest) ProtoMessage()    {}
+func (*FinalizePsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{33}
+}
+
+func (m *FinalizePsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizePsbtRequest.Unmarshal(m, b)
+}
+func (m *FinalizePsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizePsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *FinalizePsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePsbtRequest.Merge(m, src)
+}
+func (m *FinalizePsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_FinalizePsbtRequest.Size(m)
+}
+func (m *FinalizePsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePsbtRequest proto.InternalMessageInfo
+
+func (m *FinalizePsbtRequest) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
+	}
+	return nil
+}
+
+func (m *FinalizePsbtRequest) GetPublish() bool {
+	if m != nil {
+		return m.Publish
+	}
+	return false
+}
+
+func (m *FinalizePsbtRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type FinalizePsbtResponse struct {
+	// The fully signed and finalized transaction in PSBT format.
+	SignedPsbt []byte `protobuf:"bytes,1,opt,name=signed_psbt,proto3" json:"signed_psbt,omitempty"`
+	// The fully signed and finalized transaction in the raw wire format.
+	RawFinalTx           []byte   `protobuf:"bytes,2,opt,name=raw_final_tx,proto3" json:"raw_final_tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizePsbtResponse) Reset()         { *m = FinalizePsbtResponse{} }
+func (m *FinalizePsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalizePsbtResponse) ProtoMessage()    {}
+func (*FinalizePsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{34}
+}
+
+func (m *FinalizePsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizePsbtResponse.Unmarshal(m, b)
+}
+func (m *FinalizePsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizePsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *FinalizePsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePsbtResponse.Merge(m, src)
+}
+func (m *FinalizePsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_FinalizePsbtResponse.Size(m)
+}
+func (m *FinalizePsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePsbtResponse proto.InternalMessageInfo
+
+func (m *FinalizePsbtResponse) GetSignedPsbt() []byte {
+	if m != nil {
+		return m.SignedPsbt
+	}
+	return nil
+}
+
+func (m *FinalizePsbtResponse) GetRawFinalTx() []byte {
+	if m != nil {
+		return m.RawFinalTx
+	}
+	return nil
+}
+
+type Account struct {
+	// The name used to identify the account.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of addresses the account supports.
+	AddressType AddressType `protobuf:"varint,2,opt,name=address_type,proto3,enum=walletrpc.AddressType" json:"address_type,omitempty"`
+	//
+	//The public key backing the account that all keys are derived from,
+	//represented as an extended key. This is only set for watch-only accounts.
+	ExtendedPublicKey string `protobuf:"bytes,3,opt,name=extended_public_key,proto3" json:"extended_public_key,omitempty"`
+	//
+	//The fingerprint of the root key from which the account public key was
+	//derived from. This is only set for watch-only accounts.
+	MasterKeyFingerprint []byte `protobuf:"bytes,4,opt,name=master_key_fingerprint,proto3" json:"master_key_fingerprint,omitempty"`
+	// The number of external addresses that have been derived so far.
+	ExternalKeyCount uint32 `protobuf:"varint,5,opt,name=external_key_count,proto3" json:"external_key_count,omitempty"`
+	// The number of internal (change) addresses derived so far.
+	InternalKeyCount uint32 `protobuf:"varint,6,opt,name=internal_key_count,proto3" json:"internal_key_count,omitempty"`
+	// Whether the wallet stores private keys for the account.
+	WatchOnly            bool     `protobuf:"varint,7,opt,name=watch_only,proto3" json:"watch_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{35}
+}
+
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account) GetAddressType() AddressType {
+	if m != nil {
+		return m.AddressType
+	}
+	return AddressType_UNKNOWN
+}
+
+func (m *Account) GetExtendedPublicKey() string {
+	if m != nil {
+		return m.ExtendedPublicKey
+	}
+	return ""
+}
+
+func (m *Account) GetMasterKeyFingerprint() []byte {
+	if m != nil {
+		return m.MasterKeyFingerprint
+	}
+	return nil
+}
+
+func (m *Account) GetExternalKeyCount() uint32 {
+	if m != nil {
+		return m.ExternalKeyCount
+	}
+	return 0
+}
+
+func (m *Account) GetInternalKeyCount() uint32 {
+	if m != nil {
+		return m.InternalKeyCount
+	}
+	return 0
+}
+
+func (m *Account) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
+type ListAccountsRequest struct {
+	// An optional filter to only return accounts matching this name.
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAccountsRequest) Reset()         { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()    {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{36}
+}
+
+func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsRequest.Unmarshal(m, b)
+}
+func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsRequest.Merge(m, src)
+}
+func (m *ListAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsRequest.Size(m)
+}
+func (m *ListAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
+
+func (m *ListAccountsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListAccountsResponse struct {
+	// The list of on-chain accounts of the wallet.
+	Accounts             []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
+func (m *ListAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsResponse) ProtoMessage()    {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{37}
+}
+
+func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsResponse.Unmarshal(m, b)
+}
+func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsResponse.Merge(m, src)
+}
+func (m *ListAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsResponse.Size(m)
+}
+func (m *ListAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetAccounts() []*Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	// The name to identify the new account with. It must be unique.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	//The type of addresses the account derives. This determines the BIP44,
+	//BIP49 or BIP84 purpose the account is derived under.
+	AddressType          AddressType `protobuf:"varint,2,opt,name=address_type,proto3,enum=walletrpc.AddressType" json:"address_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CreateAccountRequest) Reset()         { *m = CreateAccountRequest{} }
+func (m *CreateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAccountRequest) ProtoMessage()    {}
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{38}
+}
+
+func (m *CreateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAccountRequest.Unmarshal(m, b)
+}
+func (m *CreateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountRequest.Merge(m, src)
+}
+func (m *CreateAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAccountRequest.Size(m)
+}
+func (m *CreateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccountRequest proto.InternalMessageInfo
+
+func (m *CreateAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAccountRequest) GetAddressType() AddressType {
+	if m != nil {
+		return m.AddressType
+	}
+	return AddressType_UNKNOWN
+}
+
+type ImportAccountRequest struct {
+	// A name to identify the account with. It must be unique.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	//A public key that corresponds to a wallet account represented as an
+	//extended key. It must conform to a derivation path of the form
+	//m/purpose'/coin_type'/account'. SLIP-132 encoded keys (ypub, zpub, upub
+	//and vpub) imply the address type of the account.
+	ExtendedPublicKey string `protobuf:"bytes,2,opt,name=extended_public_key,proto3" json:"extended_public_key,omitempty"`
+	//
+	//The fingerprint of the root key (also known as the key with derivation
+	//path m/) from which the account public key was derived from.
+	MasterKeyFingerprint []byte `protobuf:"bytes,3,opt,name=master_key_fingerprint,proto3" json:"master_key_fingerprint,omitempty"`
+	//
+	//The type of addresses the account derives. This is only required if the
+	//address type can't be inferred from the extended key.
+	AddressType AddressType `protobuf:"varint,4,opt,name=address_type,proto3,enum=walletrpc.AddressType" json:"address_type,omitempty"`
+	//
+	//The height to start scanning the chain for funds of the account from. If
+	//zero, the birthday of the wallet is used.
+	BirthdayHeight       uint32   `protobuf:"varint,5,opt,name=birthday_height,proto3" json:"birthday_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportAccountRequest) Reset()         { *m = ImportAccountRequest{} }
+func (m *ImportAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportAccountRequest) ProtoMessage()    {}
+func (*ImportAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{39}
+}
+
+func (m *ImportAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportAccountRequest.Unmarshal(m, b)
+}
+func (m *ImportAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportAccountRequest.Merge(m, src)
+}
+func (m *ImportAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportAccountRequest.Size(m)
+}
+func (m *ImportAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportAccountRequest proto.InternalMessageInfo
+
+func (m *ImportAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportAccountRequest) GetExtendedPublicKey() string {
+	if m != nil {
+		return m.ExtendedPublicKey
+	}
+	return ""
+}
+
+func (m *ImportAccountRequest) GetMasterKeyFingerprint() []byte {
+	if m != nil {
+		return m.MasterKeyFingerprint
+	}
+	return nil
+}
+
+func (m *ImportAccountRequest) GetAddressType() AddressType {
+	if m != nil {
+		return m.AddressType
+	}
+	return AddressType_UNKNOWN
+}
+
+func (m *ImportAccountRequest) GetBirthdayHeight() uint32 {
+	if m != nil {
+		return m.BirthdayHeight
+	}
+	return 0
+}
+
+type ImportAccountResponse struct {
+	// The details of the imported account.
+	Account              *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportAccountResponse) Reset()         { *m = ImportAccountResponse{} }
+func (m *ImportAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportAccountResponse) ProtoMessage()    {}
+func (*ImportAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{40}
+}
+
+func (m *ImportAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportAccountResponse.Unmarshal(m, b)
+}
+func (m *ImportAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportAccountResponse.Merge(m, src)
+}
+func (m *ImportAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportAccountResponse.Size(m)
+}
+func (m *ImportAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportAccountResponse proto.InternalMessageInfo
+
+func (m *ImportAccountResponse) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+type LabelTransactionRequest struct {
+	// The txid of the transaction to label.
+	Txid []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The label to add to the transaction, limited to 500 characters.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Whether to overwrite the existing label, if it is present.
+	Overwrite            bool     `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelTransactionRequest) Reset()         { *m = LabelTransactionRequest{} }
+func (m *LabelTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*LabelTransactionRequest) ProtoMessage()    {}
+func (*LabelTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{41}
+}
+
+func (m *LabelTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelTransactionRequest.Unmarshal(m, b)
+}
+func (m *LabelTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *LabelTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelTransactionRequest.Merge(m, src)
+}
+func (m *LabelTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_LabelTransactionRequest.Size(m)
+}
+func (m *LabelTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelTransactionRequest proto.InternalMessageInfo
+
+func (m *LabelTransactionRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *LabelTransactionRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *LabelTransactionRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+type LabelTransactionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelTransactionResponse) Reset()         { *m = LabelTransactionResponse{} }
+func (m *LabelTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*LabelTransactionResponse) ProtoMessage()    {}
+func (*LabelTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{42}
+}
+
+func (m *LabelTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelTransactionResponse.Unmarshal(m, b)
+}
+func (m *LabelTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *LabelTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelTransactionResponse.Merge(m, src)
+}
+func (m *LabelTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_LabelTransactionResponse.Size(m)
+}
+func (m *LabelTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelTransactionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("walletrpc.WitnessType", WitnessType_name, WitnessType_value)
+	proto.RegisterEnum("walletrpc.SweepOutcome", SweepOutcome_name, SweepOutcome_value)
+	proto.RegisterEnum("walletrpc.AddressType", AddressType_name, AddressType_value)
+	proto.RegisterType((*KeyReq)(nil), "walletrpc.KeyReq")
+	proto.RegisterType((*AddrRequest)(nil), "walletrpc.AddrRequest")
+	proto.RegisterType((*AddrResponse)(nil), "walletrpc.AddrResponse")
+	proto.RegisterType((*Transaction)(nil), "walletrpc.Transaction")
+	proto.RegisterType((*PublishResponse)(nil), "walletrpc.PublishResponse")
+	proto.RegisterType((*SendOutputsRequest)(nil), "walletrpc.SendOutputsRequest")
+	proto.RegisterType((*SendOutputsResponse)(nil), "walletrpc.SendOutputsResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "walletrpc.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "walletrpc.EstimateFeeResponse")
+	proto.RegisterType((*FeeSourceEstimate)(nil), "walletrpc.FeeSourceEstimate")
+	proto.RegisterType((*PendingSweep)(nil), "walletrpc.PendingSweep")
+	proto.RegisterType((*PendingSweepsRequest)(nil), "walletrpc.PendingSweepsRequest")
+	proto.RegisterType((*PendingSweepsResponse)(nil), "walletrpc.PendingSweepsResponse")
+	proto.RegisterType((*BumpFeeRequest)(nil), "walletrpc.BumpFeeRequest")
+	proto.RegisterType((*BumpFeeResponse)(nil), "walletrpc.BumpFeeResponse")
+	proto.RegisterType((*SweepRecord)(nil), "walletrpc.SweepRecord")
+	proto.RegisterType((*ListSweepsRequest)(nil), "walletrpc.ListSweepsRequest")
+	proto.RegisterType((*ListSweepsResponse)(nil), "walletrpc.ListSweepsResponse")
+	proto.RegisterType((*LeaseOutputRequest)(nil), "walletrpc.LeaseOutputRequest")
+	proto.RegisterType((*LeaseOutputResponse)(nil), "walletrpc.LeaseOutputResponse")
+	proto.RegisterType((*ReleaseOutputRequest)(nil), "walletrpc.ReleaseOutputRequest")
+	proto.RegisterType((*ReleaseOutputResponse)(nil), "walletrpc.ReleaseOutputResponse")
+	proto.RegisterType((*ListLeasesRequest)(nil), "walletrpc.ListLeasesRequest")
+	proto.RegisterType((*UtxoLease)(nil), "walletrpc.UtxoLease")
+	proto.RegisterType((*ListLeasesResponse)(nil), "walletrpc.ListLeasesResponse")
+	proto.RegisterType((*ConsolidateUtxosRequest)(nil), "walletrpc.ConsolidateUtxosRequest")
+	proto.RegisterType((*ConsolidationInput)(nil), "walletrpc.ConsolidationInput")
+	proto.RegisterType((*ConsolidateUtxosResponse)(nil), "walletrpc.ConsolidateUtxosResponse")
+	proto.RegisterType((*TxTemplate)(nil), "walletrpc.TxTemplate")
+	proto.RegisterMapType((map[string]uint64)(nil), "walletrpc.TxTemplate.OutputsEntry")
+	proto.RegisterType((*FundPsbtRequest)(nil), "walletrpc.FundPsbtRequest")
+	proto.RegisterType((*FundPsbtResponse)(nil), "walletrpc.FundPsbtResponse")
+	proto.RegisterType((*SignPsbtRequest)(nil), "walletrpc.SignPsbtRequest")
+	proto.RegisterType((*SignPsbtResponse)(nil), "walletrpc.SignPsbtResponse")
+	proto.RegisterType((*FinalizePsbtRequest)(nil), "walletrpc.FinalizePsbtRequest")
+	proto.RegisterType((*FinalizePsbtResponse)(nil), "walletrpc.FinalizePsbtResponse")
+	proto.RegisterType((*Account)(nil), "walletrpc.Account")
+	proto.RegisterType((*ListAccountsRequest)(nil), "walletrpc.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "walletrpc.ListAccountsResponse")
+	proto.RegisterType((*CreateAccountRequest)(nil), "walletrpc.CreateAccountRequest")
+	proto.RegisterType((*ImportAccountRequest)(nil), "walletrpc.ImportAccountRequest")
+	proto.RegisterType((*ImportAccountResponse)(nil), "walletrpc.ImportAccountResponse")
+	proto.RegisterType((*LabelTransactionRequest)(nil), "walletrpc.LabelTransactionRequest")
+	proto.RegisterType((*LabelTransactionResponse)(nil), "walletrpc.LabelTransactionResponse")
+}
+
+func init() { proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_6cc6942ac78249e5) }
+
+var fileDescriptor_6cc6942ac78249e5 = []byte{
+	// 2383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x19, 0x5d, 0x73, 0xdb, 0xc6,
+	0xd1, 0xfc, 0x10, 0x45, 0x2e, 0x49, 0x09, 0x3a, 0x51, 0x12, 0xc3, 0xf8, 0x43, 0x45, 0x9a, 0x54,
+	0x71, 0x3d, 0x52, 0xe2, 0xd4, 0x19, 0x8f, 0xdb, 0x99, 0x56, 0x96, 0xa0, 0x48, 0x23, 0x99, 0x54,
+	0x41, 0x3a, 0x6e, 0x92, 0xce, 0xa0, 0x10, 0x71, 0xa2, 0x30, 0x22, 0x01, 0x04, 0x38, 0x9a, 0x64,
+	0xa7, 0x2f, 0xed, 0x2f, 0x69, 0x7f, 0x41, 0x67, 0xfa, 0x17, 0xf2, 0xdc, 0x1f, 0xd0, 0x5f, 0xd0,
+	0xf7, 0x3e, 0xf6, 0xa9, 0x73, 0x1f, 0x20, 0xef, 0x00, 0xd0, 0xb1, 0x9b, 0x3e, 0xf4, 0x49, 0xbc,
+	0xdd, 0xbd, 0xbd, 0xfd, 0xc6, 0xee, 0x0a, 0xde, 0x9b, 0xd8, 0xc3, 0x21, 0x26, 0x61, 0xd0, 0x3f,
+	0xe0, 0xbf, 0x6e, 0x5d, 0xb2, 0x1f, 0x84, 0x3e, 0xf1, 0x51, 0x65, 0x8e, 0x6a, 0x55, 0xc2, 0xa0,
+	0xcf, 0xa1, 0xad, 0x46, 0xe4, 0x0e, 0x3c, 0x4a, 0x4e, 0xff, 0xe2, 0x90, 0x43, 0xf5, 0x5f, 0x43,
+	0xe9, 0x1c, 0xcf, 0x4c, 0xfc, 0x2d, 0xda, 0x03, 0xed, 0x16, 0xcf, 0xac, 0x6b, 0xd7, 0x1b, 0xe0,
+	0xd0, 0x0a, 0x42, 0xd7, 0x23, 0xcd, 0xdc, 0x6e, 0x6e, 0x6f, 0xc5, 0x5c, 0xbb, 0xc5, 0xb3, 0x13,
+	0x06, 0xbe, 0xa4, 0x50, 0x74, 0x0f, 0x80, 0x51, 0xda, 0x23, 0x77, 0x38, 0x6b, 0xe6, 0x19, 0x4d,
+	0x85, 0xd2, 0x30, 0x80, 0x7e, 0x0b, 0xd5, 0x43, 0xc7, 0x09, 0x4d, 0xfc, 0xed, 0x18, 0x47, 0x04,
+	0x35, 0x61, 0xd5, 0xee, 0xf7, 0xfd, 0xb1, 0x60, 0x57, 0x31, 0xe3, 0x23, 0x7a, 0x08, 0x45, 0x32,
+	0x0b, 0x30, 0xe3, 0xb0, 0xf6, 0x78, 0x7b, 0x7f, 0x2e, 0xf6, 0x3e, 0xbd, 0x8f, 0xa3, 0xa8, 0x37,
+	0x0b, 0xb0, 0xc9, 0x68, 0xd0, 0x36, 0x94, 0xfa, 0x37, 0xb6, 0x37, 0xc0, 0xcd, 0xc2, 0x6e, 0x6e,
+	0xaf, 0x6c, 0x8a, 0x93, 0xae, 0x43, 0x8d, 0x3f, 0x16, 0x05, 0xbe, 0x17, 0x61, 0x84, 0xa0, 0x68,
+	0x3b, 0x4e, 0x28, 0x9e, 0x62, 0xbf, 0xf5, 0x67, 0x50, 0xed, 0x85, 0xb6, 0x17, 0xd9, 0x7d, 0xe2,
+	0xfa, 0x1e, 0xda, 0x82, 0x12, 0x99, 0x5a, 0x37, 0x78, 0xca, 0x88, 0x6a, 0xe6, 0x0a, 0x99, 0x9e,
+	0xe2, 0x29, 0x6a, 0xc0, 0xca, 0xd0, 0xbe, 0xc2, 0x43, 0x26, 0x4e, 0xc5, 0xe4, 0x07, 0xfd, 0x73,
+	0x58, 0xbf, 0x1c, 0x5f, 0x0d, 0xdd, 0xe8, 0x66, 0xfe, 0xc4, 0x07, 0x50, 0x0f, 0x38, 0xc8, 0xc2,
+	0x61, 0xe8, 0xc7, 0x6f, 0xd5, 0x04, 0xd0, 0xa0, 0x30, 0x3d, 0x04, 0xd4, 0xc5, 0x9e, 0xd3, 0x19,
+	0x93, 0x60, 0x4c, 0xa2, 0xd8, 0x16, 0x77, 0x01, 0x22, 0x9b, 0x58, 0x01, 0x0e, 0xad, 0xdb, 0x09,
+	0xbb, 0x57, 0x30, 0xcb, 0x91, 0x4d, 0x2e, 0x71, 0x78, 0x3e, 0x41, 0x7b, 0xb0, 0xea, 0x73, 0xfa,
+	0x66, 0x7e, 0xb7, 0xb0, 0x57, 0x7d, 0xbc, 0xb6, 0x2f, 0x7c, 0xb6, 0xdf, 0x9b, 0x76, 0xc6, 0xc4,
+	0x8c, 0xd1, 0x0b, 0x59, 0x0b, 0xb2, 0xac, 0x8f, 0x60, 0x53, 0x79, 0x53, 0xc8, 0xbb, 0x05, 0xa5,
+	0xd0, 0x9e, 0x58, 0x64, 0xae, 0x6f, 0x68, 0x4f, 0x7a, 0x53, 0xfd, 0x09, 0x20, 0x23, 0x22, 0xee,
+	0xc8, 0x26, 0xf8, 0x04, 0xe3, 0x58, 0xc2, 0x07, 0x50, 0xed, 0xfb, 0xde, 0xb5, 0x45, 0xec, 0x70,
+	0x80, 0xe3, 0x00, 0x00, 0x0a, 0xea, 0x31, 0x88, 0xfe, 0x07, 0xd8, 0x54, 0xae, 0x89, 0x47, 0xde,
+	0xac, 0xd9, 0x17, 0xa0, 0x45, 0xfe, 0x38, 0xec, 0x63, 0x0b, 0x8b, 0xbb, 0xb1, 0x8a, 0x77, 0x25,
+	0xaf, 0x9f, 0x60, 0xdc, 0x65, 0x54, 0xf1, 0x03, 0xe6, 0x7a, 0xa4, 0x9c, 0x23, 0x7d, 0x0c, 0x1b,
+	0x29, 0x2a, 0xea, 0x73, 0xcf, 0x1e, 0xe1, 0xd8, 0xe7, 0xf4, 0x77, 0x42, 0x9e, 0x7c, 0x42, 0x9e,
+	0x06, 0xac, 0x70, 0xd7, 0x09, 0xfb, 0xb1, 0x03, 0x8d, 0x54, 0x7f, 0x4c, 0x86, 0x2e, 0x0e, 0x9b,
+	0x45, 0x16, 0x64, 0xf1, 0x51, 0xff, 0x7b, 0x01, 0x6a, 0x97, 0xd8, 0x73, 0x5c, 0x6f, 0xd0, 0x9d,
+	0x60, 0x1c, 0xa0, 0x9f, 0x42, 0x99, 0xfa, 0xc2, 0x8f, 0x93, 0xa4, 0xfa, 0x78, 0x7d, 0x7f, 0xc8,
+	0x3c, 0xd5, 0x19, 0x93, 0x4b, 0x0a, 0x36, 0xe7, 0x04, 0xe8, 0x19, 0xd4, 0x26, 0x2e, 0xf1, 0x70,
+	0x14, 0x59, 0x4b, 0xe2, 0xfd, 0x15, 0x47, 0xb3, 0x78, 0x57, 0x68, 0xd1, 0x7d, 0x00, 0x7b, 0x44,
+	0xb3, 0xc5, 0x8a, 0x6c, 0xc2, 0xc4, 0xad, 0x9b, 0x12, 0x04, 0xe9, 0x50, 0x8b, 0xf5, 0xbc, 0x9a,
+	0x11, 0xcc, 0x04, 0xaf, 0x9b, 0x0a, 0x0c, 0xed, 0x03, 0xba, 0x0a, 0x7d, 0xdb, 0xe9, 0xdb, 0x11,
+	0xb1, 0x6c, 0x42, 0xf0, 0x28, 0x20, 0x51, 0x73, 0x85, 0x51, 0x66, 0x60, 0xd0, 0xcf, 0x60, 0xcb,
+	0xc3, 0x53, 0x62, 0x2d, 0x50, 0x37, 0xd8, 0x1d, 0xdc, 0x90, 0x66, 0x89, 0x5d, 0xc9, 0x46, 0xa2,
+	0x3d, 0x58, 0x77, 0xb0, 0xed, 0x0c, 0x5d, 0x0f, 0xc7, 0xf4, 0xab, 0x2c, 0x7a, 0x92, 0x60, 0xaa,
+	0xd3, 0xd5, 0xd8, 0x19, 0x60, 0xae, 0x53, 0x99, 0xf9, 0x46, 0x82, 0xa0, 0xc7, 0xd0, 0xe8, 0x8f,
+	0xc3, 0x10, 0x73, 0x15, 0x17, 0xba, 0x55, 0xd8, 0xf3, 0x99, 0x38, 0xf4, 0x08, 0x36, 0x98, 0x58,
+	0xca, 0x05, 0x60, 0x17, 0xd2, 0x08, 0x7d, 0x1b, 0x1a, 0xb2, 0x3b, 0xe3, 0xfc, 0xd4, 0x7f, 0x03,
+	0x5b, 0x09, 0xb8, 0x08, 0xef, 0x5f, 0xc2, 0x5a, 0xc0, 0x11, 0x56, 0xc4, 0x30, 0xcd, 0x1c, 0x0b,
+	0xdf, 0x1d, 0xc9, 0x89, 0xf2, 0x4d, 0x33, 0x41, 0xae, 0xff, 0x33, 0x07, 0x6b, 0xcf, 0xc7, 0xa3,
+	0x40, 0x4a, 0xb5, 0x77, 0x8a, 0xa1, 0x5d, 0xa8, 0xf2, 0x94, 0xb4, 0x68, 0x2e, 0xb2, 0x10, 0xaa,
+	0x9b, 0x32, 0x28, 0x15, 0x09, 0x85, 0x8c, 0x48, 0x68, 0xc2, 0x6a, 0x88, 0x83, 0xa1, 0xdd, 0xc7,
+	0x71, 0x84, 0x8b, 0x63, 0x96, 0xf7, 0x56, 0xde, 0xc6, 0x7b, 0xa5, 0xa4, 0xf7, 0xf4, 0x0f, 0x61,
+	0x7d, 0xae, 0xe8, 0xa2, 0x28, 0x93, 0xa9, 0xeb, 0xc4, 0x09, 0x4a, 0x7f, 0xeb, 0x7f, 0x29, 0x40,
+	0x95, 0x9b, 0x0a, 0xf7, 0xfd, 0xd0, 0xf9, 0xff, 0xc9, 0xa8, 0x4f, 0x59, 0x15, 0xe8, 0xfb, 0x23,
+	0x6e, 0xa3, 0x35, 0xc5, 0xc7, 0x4c, 0xe2, 0x0e, 0x47, 0x9b, 0x31, 0x1d, 0x65, 0xc9, 0xdc, 0x6c,
+	0x31, 0x2d, 0x57, 0x98, 0x96, 0x12, 0x84, 0xe1, 0x69, 0x3c, 0x70, 0x7c, 0x49, 0xe0, 0xe7, 0x10,
+	0xea, 0x96, 0x6b, 0x8c, 0x99, 0x3c, 0xab, 0xcc, 0x9e, 0xf1, 0x31, 0xe5, 0xd4, 0xf2, 0x5b, 0xa7,
+	0x77, 0x65, 0x69, 0x7a, 0x67, 0xb8, 0x1a, 0x32, 0x5d, 0xad, 0x6f, 0xc2, 0xc6, 0x85, 0x1b, 0x11,
+	0x35, 0x47, 0x8e, 0x01, 0xc9, 0x40, 0xe1, 0xe2, 0x7d, 0x28, 0x29, 0x89, 0xb1, 0x9d, 0x34, 0x1a,
+	0x77, 0xb3, 0x29, 0xa8, 0xf4, 0x3f, 0xe6, 0x00, 0x5d, 0x60, 0x3b, 0xc2, 0xfc, 0x6b, 0x15, 0xe7,
+	0xc4, 0x1a, 0xe4, 0x45, 0x9c, 0xd4, 0xcc, 0xbc, 0xab, 0x46, 0x45, 0xfe, 0xfb, 0xa2, 0x62, 0x1f,
+	0x10, 0x9e, 0x06, 0x6e, 0x68, 0xd3, 0xcf, 0xbc, 0x15, 0xe1, 0xbe, 0xef, 0x39, 0x11, 0xf3, 0x70,
+	0xd1, 0xcc, 0xc0, 0xe8, 0x4f, 0x60, 0x53, 0x11, 0x41, 0xa8, 0x72, 0x1f, 0x60, 0x41, 0xcc, 0x64,
+	0x29, 0x9a, 0x12, 0x44, 0xef, 0x42, 0xc3, 0xc4, 0xc3, 0xff, 0xad, 0xec, 0xfa, 0x0e, 0x6c, 0x25,
+	0x98, 0x72, 0x69, 0x62, 0x1f, 0x30, 0x41, 0xe7, 0x3e, 0xb8, 0x81, 0xca, 0x4b, 0x32, 0xf5, 0x19,
+	0xf0, 0x87, 0xd9, 0x4c, 0x55, 0xb6, 0x90, 0x52, 0xb6, 0xcd, 0xbd, 0x1d, 0x3f, 0x2f, 0x4c, 0xf4,
+	0x14, 0x6a, 0x43, 0xbf, 0x7f, 0x8b, 0x1d, 0x6b, 0x4c, 0xa6, 0x7e, 0xec, 0xf3, 0x86, 0xe4, 0xf3,
+	0xb9, 0x78, 0xa6, 0x42, 0xa9, 0x9f, 0xc1, 0xce, 0x91, 0xef, 0x45, 0xfe, 0xd0, 0x75, 0x6c, 0x82,
+	0x29, 0x55, 0x24, 0x35, 0x8a, 0x4e, 0x38, 0xb3, 0xc2, 0x31, 0x37, 0x7a, 0xd9, 0x8c, 0x8f, 0xf4,
+	0x73, 0x7d, 0xed, 0x87, 0x7d, 0x9e, 0xe7, 0x65, 0x93, 0x1f, 0xf4, 0xef, 0x72, 0x80, 0x16, 0xbc,
+	0x5c, 0xdf, 0x3b, 0xf3, 0x82, 0xf1, 0x3b, 0x96, 0x55, 0xb5, 0x18, 0xf0, 0x36, 0x41, 0x2e, 0x06,
+	0xcf, 0xa0, 0x66, 0xf3, 0x5e, 0x94, 0x17, 0x9a, 0xc2, 0x1b, 0x5b, 0x55, 0x85, 0x16, 0xfd, 0x18,
+	0xea, 0xb4, 0x30, 0xbb, 0xe1, 0x88, 0x49, 0x17, 0xb1, 0x72, 0x52, 0x30, 0x55, 0xa0, 0xfe, 0x8f,
+	0x1c, 0x34, 0xd3, 0x16, 0x11, 0x76, 0x7e, 0x02, 0x25, 0xd7, 0x63, 0x0d, 0x21, 0xb7, 0xf0, 0x3d,
+	0xe9, 0xe1, 0xb4, 0xea, 0xa6, 0x20, 0x66, 0xf5, 0x26, 0xd9, 0xfc, 0x48, 0x10, 0xf4, 0x10, 0x34,
+	0xe2, 0x13, 0x7b, 0x68, 0x25, 0x0a, 0x61, 0xc1, 0x4c, 0xc1, 0xe5, 0xda, 0x54, 0x54, 0x6b, 0xd3,
+	0x2e, 0x54, 0xa3, 0x5b, 0x37, 0xb0, 0x42, 0x6c, 0x47, 0xbe, 0x27, 0xca, 0x9e, 0x0c, 0xd2, 0xff,
+	0x9a, 0x03, 0xe8, 0x4d, 0x7b, 0x78, 0x14, 0x0c, 0x69, 0x9f, 0xf6, 0x93, 0x84, 0x36, 0x29, 0xbf,
+	0xc4, 0xf2, 0xff, 0x22, 0xd9, 0x08, 0xeb, 0x92, 0xde, 0x0b, 0x86, 0xfb, 0xa2, 0xd3, 0x35, 0x3c,
+	0x12, 0xce, 0xe6, 0xcd, 0x71, 0xeb, 0x19, 0xd4, 0x64, 0x04, 0xd2, 0xa0, 0x70, 0x8b, 0x67, 0xe2,
+	0xe3, 0x43, 0x7f, 0xd2, 0x78, 0x7a, 0x6d, 0x0f, 0xc7, 0x3c, 0x9e, 0x8a, 0x26, 0x3f, 0x3c, 0xcb,
+	0x3f, 0xcd, 0xe9, 0x7f, 0xcb, 0xc1, 0xfa, 0xc9, 0xd8, 0x73, 0x2e, 0xa3, 0xab, 0x79, 0x5e, 0x37,
+	0xa0, 0x18, 0x44, 0x57, 0x3c, 0x98, 0x6a, 0xa7, 0x77, 0x4c, 0x76, 0x42, 0x1f, 0x43, 0x21, 0xb4,
+	0x27, 0x22, 0xc1, 0xb6, 0x32, 0xe5, 0x3b, 0xbd, 0x63, 0x52, 0x1a, 0xa4, 0xab, 0xdf, 0x6e, 0xf6,
+	0xc9, 0x39, 0xcd, 0xa9, 0x5f, 0xef, 0x8f, 0xa0, 0x1e, 0x3b, 0xe8, 0xf5, 0xbc, 0x91, 0x2b, 0x9e,
+	0xe6, 0x4c, 0x15, 0xfc, 0x1c, 0xa0, 0x4c, 0x04, 0xfb, 0xe7, 0x25, 0x28, 0x5e, 0x63, 0x1c, 0xe9,
+	0x7f, 0xce, 0x81, 0xb6, 0x10, 0x5a, 0x84, 0xce, 0x2e, 0x54, 0xaf, 0xc7, 0x9e, 0x83, 0x1d, 0x6b,
+	0x21, 0xbc, 0x29, 0x83, 0xd0, 0x27, 0xb0, 0xc9, 0x87, 0x28, 0x8b, 0x5b, 0xce, 0x72, 0x3d, 0x07,
+	0x4f, 0xc5, 0x3c, 0x97, 0x85, 0x4a, 0xa5, 0x7d, 0xe1, 0xad, 0xd3, 0xfe, 0x33, 0x58, 0xef, 0xba,
+	0x03, 0x4f, 0x36, 0xeb, 0xf7, 0x0a, 0xa8, 0x7f, 0x0d, 0xda, 0xe2, 0xd2, 0x42, 0x2d, 0x36, 0xbf,
+	0xaa, 0xb7, 0x24, 0x10, 0x4d, 0x3b, 0x71, 0x14, 0xc1, 0x46, 0x43, 0xa8, 0x6e, 0xaa, 0x40, 0x7d,
+	0x00, 0x9b, 0x27, 0xae, 0x67, 0x0f, 0xdd, 0xdf, 0xe3, 0x77, 0x12, 0x8a, 0xe6, 0x83, 0x18, 0xf4,
+	0x44, 0x35, 0x8a, 0x8f, 0x4b, 0x86, 0xb2, 0xdf, 0x42, 0x43, 0x7d, 0xe8, 0xad, 0x15, 0xd1, 0xa1,
+	0x46, 0xe7, 0xb6, 0x6b, 0x7a, 0x9b, 0x4e, 0x6f, 0x79, 0x46, 0xa2, 0xc0, 0xf4, 0xef, 0xf2, 0xb0,
+	0x7a, 0x28, 0xc6, 0xe9, 0xac, 0x31, 0x28, 0x59, 0xbf, 0xf2, 0xef, 0x50, 0xbf, 0x3e, 0x81, 0x4d,
+	0x3c, 0x25, 0x98, 0xab, 0x4e, 0x75, 0xec, 0x5b, 0x34, 0x8f, 0xb8, 0x76, 0x59, 0x28, 0xf4, 0x39,
+	0x6c, 0x8f, 0xec, 0x88, 0xd0, 0x22, 0x33, 0xdf, 0x24, 0xf0, 0x45, 0x42, 0x91, 0xc9, 0xbe, 0x04,
+	0xcb, 0x3f, 0xdc, 0x04, 0x87, 0x54, 0x29, 0x8a, 0xe3, 0xdb, 0x02, 0x31, 0xa0, 0xa4, 0x31, 0x94,
+	0xde, 0xf5, 0x52, 0xf4, 0x7c, 0x3a, 0xc9, 0xc0, 0xd0, 0x7a, 0x38, 0xb1, 0x49, 0xff, 0xc6, 0xf2,
+	0xbd, 0xe1, 0x8c, 0xb5, 0x58, 0x65, 0x53, 0x82, 0xe8, 0x1f, 0xc3, 0x26, 0xfd, 0xc8, 0x09, 0x43,
+	0xce, 0x3f, 0x48, 0x19, 0x06, 0xd5, 0x4f, 0xa0, 0xa1, 0x92, 0xce, 0xfb, 0x9f, 0xb2, 0x58, 0x6b,
+	0xc4, 0xd5, 0x0d, 0xc9, 0x46, 0xe6, 0x28, 0x73, 0x4e, 0xa3, 0x5f, 0x43, 0xe3, 0x28, 0xc4, 0x36,
+	0xc1, 0x31, 0x6a, 0xf9, 0x9b, 0x3f, 0xc4, 0x89, 0xfa, 0xbf, 0x73, 0xd0, 0x38, 0x1b, 0x05, 0x7e,
+	0x48, 0xde, 0xe2, 0xa1, 0x25, 0x1e, 0xcf, 0xff, 0x37, 0x1e, 0x2f, 0xbc, 0xd1, 0xe3, 0x49, 0x95,
+	0x8a, 0xef, 0x10, 0x97, 0x7b, 0xb0, 0x7e, 0xe5, 0x86, 0xe4, 0xc6, 0xb1, 0x67, 0xf2, 0xa8, 0x52,
+	0x37, 0x93, 0x60, 0xdd, 0x80, 0xad, 0x84, 0xee, 0xc2, 0x5b, 0x8f, 0xd4, 0x9d, 0x54, 0xb6, 0xb3,
+	0x62, 0x12, 0xdd, 0x86, 0x9d, 0x0b, 0x9a, 0xcb, 0xd2, 0x12, 0x49, 0xb2, 0xe2, 0x7c, 0xb2, 0xa9,
+	0xf1, 0xc9, 0x26, 0x7b, 0x91, 0x84, 0xee, 0x42, 0xc5, 0x7f, 0x8d, 0xc3, 0x49, 0xe8, 0x92, 0x78,
+	0x87, 0xb5, 0x00, 0xe8, 0x2d, 0x68, 0xa6, 0x9f, 0xe0, 0xc2, 0x3e, 0xfc, 0x53, 0x01, 0xaa, 0xd2,
+	0x38, 0x83, 0x36, 0x61, 0xfd, 0x65, 0xfb, 0xbc, 0xdd, 0x79, 0xd5, 0xb6, 0x5e, 0x9d, 0xf5, 0xda,
+	0x46, 0xb7, 0xab, 0xdd, 0x41, 0x4d, 0x68, 0x1c, 0x75, 0x5e, 0xbc, 0x38, 0xeb, 0xbd, 0x30, 0xda,
+	0x3d, 0xab, 0x77, 0xf6, 0xc2, 0xb0, 0x2e, 0x3a, 0x47, 0xe7, 0x5a, 0x0e, 0xed, 0xc0, 0xa6, 0x84,
+	0x69, 0x77, 0xac, 0x63, 0xe3, 0xe2, 0xf0, 0x2b, 0x2d, 0x8f, 0xb6, 0x60, 0x43, 0x42, 0x98, 0xc6,
+	0x97, 0x9d, 0x73, 0x43, 0x2b, 0x50, 0xfa, 0xd3, 0xde, 0xc5, 0x91, 0xd5, 0x39, 0x39, 0x31, 0x4c,
+	0xe3, 0x38, 0x46, 0x14, 0xe9, 0x13, 0x0c, 0x71, 0x78, 0x74, 0x64, 0x5c, 0xf6, 0x16, 0x98, 0x15,
+	0xf4, 0x21, 0xfc, 0x48, 0xb9, 0x42, 0x9f, 0xef, 0xbc, 0xec, 0x59, 0x5d, 0xe3, 0xa8, 0xd3, 0x3e,
+	0xb6, 0x2e, 0x8c, 0x2f, 0x8d, 0x0b, 0xad, 0x84, 0x3e, 0x02, 0x5d, 0x65, 0xd0, 0x7d, 0x79, 0x74,
+	0x64, 0x74, 0xbb, 0x2a, 0xdd, 0x2a, 0x7a, 0x00, 0xef, 0x27, 0x24, 0x78, 0xd1, 0xe9, 0x19, 0x31,
+	0x57, 0xad, 0x8c, 0x76, 0xe1, 0x6e, 0x52, 0x12, 0x46, 0x21, 0xf8, 0x69, 0x15, 0x74, 0x17, 0x9a,
+	0x8c, 0x42, 0xe6, 0x1c, 0xcb, 0x0b, 0xa8, 0x01, 0x9a, 0xb0, 0x9c, 0x75, 0x6e, 0x7c, 0x65, 0x9d,
+	0x1e, 0x76, 0x4f, 0xb5, 0x2a, 0x7a, 0x1f, 0x76, 0xda, 0x46, 0x97, 0xb2, 0x4b, 0x21, 0x6b, 0x0f,
+	0x7f, 0x07, 0x35, 0x79, 0xf6, 0x43, 0x1b, 0x50, 0xef, 0xbe, 0x32, 0x8c, 0x4b, 0xeb, 0xd2, 0x68,
+	0x1f, 0x9f, 0xb5, 0xbf, 0xd0, 0xee, 0x50, 0xbf, 0x70, 0x10, 0x13, 0xc3, 0x38, 0x36, 0x8e, 0xb5,
+	0x1c, 0xda, 0x06, 0xc4, 0x81, 0xb1, 0x88, 0x94, 0x5e, 0xcb, 0x23, 0x0d, 0x6a, 0x1c, 0x7e, 0x72,
+	0x78, 0x76, 0x61, 0x1c, 0x6b, 0x85, 0x87, 0x0e, 0x5f, 0x9b, 0xc6, 0x5e, 0xae, 0xc2, 0xaa, 0xf0,
+	0xb2, 0x76, 0x87, 0xfa, 0x24, 0x96, 0xe9, 0xf2, 0xe5, 0xf3, 0xb9, 0x58, 0x39, 0x74, 0x1f, 0x5a,
+	0x09, 0x99, 0x65, 0x7c, 0x1e, 0xad, 0x43, 0x55, 0x06, 0x14, 0x1e, 0xff, 0xab, 0x0a, 0x95, 0x57,
+	0x2c, 0xd4, 0xcf, 0x5d, 0x9a, 0x86, 0xf5, 0x63, 0x1c, 0xba, 0xaf, 0x71, 0x1b, 0x4f, 0xc9, 0x39,
+	0x9e, 0xa1, 0x0d, 0x29, 0x0f, 0xf8, 0x5e, 0xb8, 0xb5, 0x3d, 0x5f, 0x42, 0x9e, 0xe3, 0xd9, 0x31,
+	0x8e, 0xfa, 0xa1, 0x1b, 0x10, 0x3f, 0x44, 0x4f, 0xa1, 0xc2, 0xef, 0xd2, 0x7b, 0x9b, 0x32, 0xd1,
+	0x85, 0xdf, 0xb7, 0x89, 0x1f, 0x2e, 0xbd, 0xf9, 0x73, 0x28, 0xd3, 0xf7, 0xa8, 0xb6, 0x28, 0x99,
+	0xf2, 0x22, 0xb1, 0x5a, 0x3b, 0x29, 0xb8, 0x48, 0xdd, 0x53, 0x40, 0x62, 0x21, 0x2b, 0xef, 0x74,
+	0x65, 0x36, 0x12, 0xbc, 0xd5, 0x92, 0xf7, 0x33, 0x89, 0x3d, 0xee, 0x05, 0x54, 0xa5, 0x75, 0x29,
+	0x92, 0x7b, 0xeb, 0xf4, 0xea, 0xb6, 0x75, 0x7f, 0x19, 0x7a, 0xc1, 0x4d, 0xda, 0x8b, 0x2a, 0xdc,
+	0xd2, 0x6b, 0x56, 0x85, 0x5b, 0xd6, 0x3a, 0xd5, 0x84, 0xba, 0xb2, 0x88, 0x42, 0x0f, 0x96, 0x2c,
+	0x9a, 0xe6, 0xf2, 0xed, 0x2e, 0x27, 0x10, 0x3c, 0x7f, 0x05, 0xab, 0x62, 0x31, 0x83, 0xde, 0x93,
+	0x88, 0xd5, 0xad, 0x94, 0x62, 0xb1, 0xe4, 0x1e, 0xe7, 0x0c, 0x60, 0x31, 0xfa, 0x23, 0x79, 0x75,
+	0x9b, 0x5a, 0x13, 0xb4, 0xee, 0x2d, 0xc1, 0x2e, 0xcc, 0x25, 0xcd, 0xde, 0x8a, 0xb9, 0xd2, 0x6b,
+	0x01, 0xc5, 0x5c, 0x59, 0x23, 0xbb, 0x09, 0x75, 0x65, 0x7a, 0x56, 0xcc, 0x95, 0x35, 0xac, 0x2b,
+	0xe6, 0xca, 0x1c, 0xbc, 0x63, 0x65, 0xf9, 0xe4, 0x9b, 0x52, 0x56, 0x99, 0xc7, 0x53, 0xca, 0x26,
+	0xc6, 0xe5, 0x6f, 0x40, 0x4b, 0x8e, 0x78, 0x48, 0xcf, 0x1c, 0xe5, 0x94, 0x89, 0xb8, 0xf5, 0xc1,
+	0x1b, 0x69, 0x04, 0xf3, 0x23, 0x28, 0xc7, 0xcd, 0x3f, 0x92, 0x9d, 0x97, 0x18, 0x63, 0x5a, 0xef,
+	0x67, 0xe2, 0x16, 0x4c, 0xe2, 0x56, 0x5b, 0x61, 0x92, 0x68, 0xda, 0x15, 0x26, 0xa9, 0xde, 0xbc,
+	0x03, 0x35, 0xb9, 0xd5, 0x45, 0xb2, 0xd7, 0x32, 0x9a, 0xed, 0xd6, 0x83, 0xa5, 0xf8, 0x05, 0x43,
+	0xb9, 0xd9, 0x52, 0x18, 0x66, 0x34, 0x6c, 0x0a, 0xc3, 0xcc, 0x2e, 0xed, 0x18, 0xea, 0x4a, 0xd7,
+	0xa5, 0xc4, 0x49, 0x56, 0x3f, 0xd6, 0xca, 0x68, 0x0c, 0x68, 0xb4, 0x29, 0x6d, 0x85, 0xc2, 0x25,
+	0xab, 0xd9, 0x52, 0xa2, 0x2d, 0xbb, 0x23, 0xf9, 0x06, 0xb4, 0x64, 0x03, 0xa0, 0x84, 0xc8, 0x92,
+	0x06, 0x44, 0x09, 0x91, 0x65, 0x1d, 0xc4, 0xf3, 0x4f, 0xbf, 0x3e, 0x18, 0xb8, 0xe4, 0x66, 0x7c,
+	0xb5, 0xdf, 0xf7, 0x47, 0x07, 0x43, 0xda, 0x1b, 0x79, 0xae, 0x37, 0xf0, 0x30, 0x99, 0xf8, 0xe1,
+	0xed, 0xc1, 0xd0, 0x73, 0x0e, 0xd8, 0x24, 0x7e, 0x30, 0xe7, 0x75, 0x55, 0x62, 0xff, 0x1e, 0xfc,
+	0xec, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x03, 0x97, 0x26, 0x30, 0x67, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WalletKitClient is the client API for WalletKit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletKitClient interface {
+	//*
+	//DeriveNextKey attempts to derive the *next* key within the key family
+	//(account in BIP43) specified. This method should return the next external
+	//child within this branch.
+	DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
+	//*
+	//DeriveKey attempts to derive an arbitrary key specified by the passed
+	//KeyLocator.
+	DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
+	//*
+	//NextAddr returns the next unused address within the wallet.
+	NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error)
+	//*
+	//PublishTransaction attempts to publish the passed transaction to the
+	//network. Once this returns without an error, the wallet will continually
+	//attempt to re-broadcast the transaction on start up, until it enters the
+	//chain.
+	PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error)
+	//*
+	//SendOutputs is similar to the existing sendmany call in Bitcoind, and
+	//allows the caller to create a transaction that sends to several outputs at
+	//once. This is ideal when wanting to batch create a set of transactions.
+	SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error)
+	//*
+	//EstimateFee attempts to query the internal fee estimator of the wallet to
+	//determine the fee (in sat/kw) to attach to a transaction in order to
+	//achieve the confirmation target.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	//
+	//PendingSweeps returns lists of on-chain outputs that lnd is currently
+	//attempting to sweep within its central batching engine. Outputs with similar
+	//fee rates are batched together in order to sweep them within a single
+	//transaction.
+	//
+	//NOTE: Some of the fields within PendingSweepsRequest are not guaranteed to
+	//remain supported. This is an advanced API that depends on the internals of
+	//the UtxoSweeper, so things may change.
+	PendingSweeps(ctx context.Context, in *PendingSweepsRequest, opts ...grpc.CallOption) (*PendingSweepsResponse, error)
+	//
+	//BumpFee bumps the fee of an arbitrary input within a transaction. This RPC
+	//takes a different approach than bitcoind's bumpfee command. lnd has a
+	//central batching engine in which inputs with similar fee rates are batched
+	//together to save on transaction fees. Due to this, we cannot rely on
+	//bumping the fee on a specific transaction, since transactions can change at
+	//any point with the addition of new inputs. The list of inputs that
+	//currently exist within lnd's central batching engine can be retrieved
+	//through the PendingSweeps RPC.
+	//
+	//When bumping the fee of an input that currently exists within lnd's central
+	//batching engine, a higher fee transaction will be created that replaces the
+	//lower fee transaction through the Replace-By-Fee (RBF) policy. If it
+	//
+	//This RPC also serves useful when wanting to perform a Child-Pays-For-Parent
+	//(CPFP), where the child transaction pays for its parent's fee. This can be
+	//done by specifying an outpoint within the low fee transaction that is under
+	//the control of the wallet.
+	//
+	//Unconfirmed transactions funded by the wallet, such as the ones created
+	//through SendCoins, can also be replaced with a higher fee transaction
+	//paying to the same outputs by setting the replace flag. The higher fee is
+	//deducted from the transaction's change output. Transactions that are
+	//replaced, or that have an output being spent by a CPFP, can't be bumped
+	//through the other method anymore.
+	//
+	//The fee preference can be expressed either as a specific fee rate or a delta
+	//of blocks in which the output should be swept on-chain within. If a fee
 	//preference is not explicitly specified, then an error is returned.
 	//
 	//Note that this RPC currently doesn't perform any validation checks on the
 	//fee preference being provided. For now, the responsibility of ensuring that
 	//the new fee preference is sufficient is delegated to the user.
 	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	//*
+	//ListSweeps lists all outputs that were offered to lnd's central batching
+	//engine, including the ones that are still pending. The sweeps of pending
+	//outputs are resumed across restarts. For each output, the outcome of its
+	//sweep is returned, along with the fee paid if it was swept successfully.
+	ListSweeps(ctx context.Context, in *ListSweepsRequest, opts ...grpc.CallOption) (*ListSweepsResponse, error)
+	//*
+	//LeaseOutput locks an output to the given ID, preventing it from being
+	//available for any future coin selection attempts, including those of
+	//SendCoins, SendMany, SendOutputs and channel funding. The absolute time of
+	//the lock's expiration is returned. The expiration of the lock can be
+	//extended by successive invocations of this RPC with the same ID. Leases
+	//persist across restarts. Outputs can be unlocked before their expiration
+	//through `ReleaseOutput`.
+	LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error)
+	//*
+	//ReleaseOutput unlocks an output, allowing it to be available for coin
+	//selection if it remains unspent. The ID should match the one used to
+	//originally lock the output.
+	ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error)
+	//*
+	//ListLeases lists all currently leased outputs, along with the ID and
+	//expiration of their lease.
+	ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error)
+	//*
+	//ConsolidateUtxos merges the smallest confirmed utxos of the wallet that are
+	//worth spending into a single output, by handing them off to lnd's central
+	//batching engine. Unless forced, the consolidation only takes place if the
+	//estimated fee rate is below the configured maximum and enough utxos are
+	//worth merging. The utxos are locked until they're swept or the sweep
+	//fails. A dry run returns the consolidation that would take place, along
+	//with the reason it would be skipped, without spending anything.
+	ConsolidateUtxos(ctx context.Context, in *ConsolidateUtxosRequest, opts ...grpc.CallOption) (*ConsolidateUtxosResponse, error)
+	//*
+	//FundPsbt creates a fully populated PSBT that contains enough inputs to fund
+	//the outputs specified in the template. There are two ways of specifying a
+	//template: Either by passing in a PSBT with at least one output declared or
+	//by passing in a raw TxTemplate message.
+	//
+	//If there are no inputs specified in the template, coin selection is
+	//performed automatically. If the template does contain any inputs, it is
+	//assumed that full coin selection happened externally and no additional
+	//inputs are added. If the specified inputs aren't enough to fund the outputs
+	//with the given fee rate, an error is returned.
+	//
+	//After either selecting or verifying the inputs, all input UTXOs are leased
+	//for 10 minutes to prevent them from being used in other coin selection
+	//attempts. If a change output is needed, it is appended as the last output
+	//of the packet.
+	//
+	//NOTE: If this method returns without an error, it is the caller's
+	//responsibility to either spend the leased UTXOs within 10 minutes or
+	//release them using the ReleaseOutput RPC.
+	FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error)
+	//*
+	//SignPsbt expects a partial transaction with all inputs and outputs fully
+	//declared and tries to sign all unsigned inputs that belong to the wallet.
+	//Inputs of other wallets are left untouched, which allows the packet to be
+	//passed on to other signers afterwards.
+	SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error)
+	//*
+	//FinalizePsbt expects a partial transaction with all inputs and outputs
+	//fully declared and tries to sign all inputs that belong to the wallet.
+	//Signatures of all other inputs must already be present in the packet. The
+	//packet is then finalized and the final transaction is extracted, and
+	//optionally published to the network.
+	FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error)
+	//*
+	//ListAccounts retrieves all accounts belonging to the wallet by default. A
+	//name filter can be provided to only return the account matching it.
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	//*
+	//CreateAccount derives a new named account from the wallet's seed. Funds
+	//sent to addresses of the account can be spent by the wallet, but they are
+	//never used for channel funding or coin selection of the default account.
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	//*
+	//ImportAccount imports an account backed by an account extended public key.
+	//The wallet tracks the funds received to addresses of the account starting
+	//at the given birthday height, but it is unable to sign for them. PSBTs
+	//spending from such an account must be signed externally.
+	ImportAccount(ctx context.Context, in *ImportAccountRequest, opts ...grpc.CallOption) (*ImportAccountResponse, error)
+	//*
+	//LabelTransaction adds a label to a transaction. If the transaction already
+	//has a label the call will fail unless the overwrite bool is set. This will
+	//overwrite the existing transaction label. Labels must not be empty, and
+	//cannot exceed 500 characters.
+	LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error)
+}
+
+type walletKitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletKitClient(cc *grpc.ClientConn) WalletKitClient {
+	return &walletKitClient{cc}
+}
+
+func (c *walletKitClient) DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
+	out := new(signrpc.KeyDescriptor)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveNextKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
+	out := new(signrpc.KeyDescriptor)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error) {
+	out := new(AddrResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/NextAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error) {
+	out := new(PublishResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PublishTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error) {
+	out := new(SendOutputsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SendOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) PendingSweeps(ctx context.Context, in *PendingSweepsRequest, opts ...grpc.CallOption) (*PendingSweepsResponse, error) {
+	out := new(PendingSweepsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PendingSweeps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type walletKitClient struct {
-	cc *grpc.ClientConn
+func (c *walletKitClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewWalletKitClient(cc *grpc.ClientConn) WalletKitClient {
-	return &walletKitClient{cc}
+func (c *walletKitClient) ListSweeps(ctx context.Context, in *ListSweepsRequest, opts ...grpc.CallOption) (*ListSweepsResponse, error) {
+	out := new(ListSweepsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ListSweeps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *walletKitClient) DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
-	out := new(signrpc.KeyDescriptor)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveNextKey", in, out, opts...)
+func (c *walletKitClient) LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error) {
+	out := new(LeaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/LeaseOutput", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *walletKitClient) DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
-	out := new(signrpc.KeyDescriptor)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveKey", in, out, opts...)
+func (c *walletKitClient) ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error) {
+	out := new(ReleaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ReleaseOutput", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *walletKitClient) NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error) {
-	out := new(AddrResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/NextAddr", in, out, opts...)
+func (c *walletKitClient) ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error) {
+	out := new(ListLeasesResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ListLeases", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *walletKitClient) PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error) {
-	out := new(PublishResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PublishTransaction", in, out, opts...)
+func (c *walletKitClient) ConsolidateUtxos(ctx context.Context, in *ConsolidateUtxosRequest, opts ...grpc.CallOption) (*ConsolidateUtxosResponse, error) {
+	out := new(ConsolidateUtxosResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ConsolidateUtxos", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *walletKitClient) SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error) {
-	out := new(SendOutputsResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SendOutputs", in, out, opts...)
+func (c *walletKitClient) FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error) {
+	out := new(FundPsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/FundPsbt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *walletKitClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
-	out := new(EstimateFeeResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/EstimateFee", in, out, opts...)
+func (c *walletKitClient) SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error) {
+	out := new(SignPsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SignPsbt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *walletKitClient) PendingSweeps(ctx context.Context, in *PendingSweepsRequest, opts ...grpc.CallOption) (*PendingSweepsResponse, error) {
-	out := new(PendingSweepsResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PendingSweeps", in, out, opts...)
+func (c *walletKitClient) FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error) {
+	out := new(FinalizePsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/FinalizePsbt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *walletKitClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
-	out := new(BumpFeeResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/BumpFee", in, out, opts...)
+func (c *walletKitClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ImportAccount(ctx context.Context, in *ImportAccountRequest, opts ...grpc.CallOption) (*ImportAccountResponse, error) {
+	out := new(ImportAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ImportAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error) {
+	out := new(LabelTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/LabelTransaction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1070,6 +3288,13 @@ type WalletKitServer interface {
 	//done by specifying an outpoint within the low fee transaction that is under
 	//the control of the wallet.
 	//
+	//Unconfirmed transactions funded by the wallet, such as the ones created
+	//through SendCoins, can also be replaced with a higher fee transaction
+	//paying to the same outputs by setting the replace flag. The higher fee is
+	//deducted from the transaction's change output. Transactions that are
+	//replaced, or that have an output being spent by a CPFP, can't be bumped
+	//through the other method anymore.
+	//
 	//The fee preference can be expressed either as a specific fee rate or a delta
 	//of blocks in which the output should be swept on-chain within. If a fee
 	//preference is not explicitly specified, then an error is returned.
@@ -1078,6 +3303,94 @@ type WalletKitServer interface {
 	//fee preference being provided. For now, the responsibility of ensuring that
 	//the new fee preference is sufficient is delegated to the user.
 	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	//*
+	//ListSweeps lists all outputs that were offered to lnd's central batching
+	//engine, including the ones that are still pending. The sweeps of pending
+	//outputs are resumed across restarts. For each output, the outcome of its
+	//sweep is returned, along with the fee paid if it was swept successfully.
+	ListSweeps(context.Context, *ListSweepsRequest) (*ListSweepsResponse, error)
+	//*
+	//LeaseOutput locks an output to the given ID, preventing it from being
+	//available for any future coin selection attempts, including those of
+	//SendCoins, SendMany, SendOutputs and channel funding. The absolute time of
+	//the lock's expiration is returned. The expiration of the lock can be
+	//extended by successive invocations of this RPC with the same ID. Leases
+	//persist across restarts. Outputs can be unlocked before their expiration
+	//through `ReleaseOutput`.
+	LeaseOutput(context.Context, *LeaseOutputRequest) (*LeaseOutputResponse, error)
+	//*
+	//ReleaseOutput unlocks an output, allowing it to be available for coin
+	//selection if it remains unspent. The ID should match the one used to
+	//originally lock the output.
+	ReleaseOutput(context.Context, *ReleaseOutputRequest) (*ReleaseOutputResponse, error)
+	//*
+	//ListLeases lists all currently leased outputs, along with the ID and
+	//expiration of their lease.
+	ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesResponse, error)
+	//*
+	//ConsolidateUtxos merges the smallest confirmed utxos of the wallet that are
+	//worth spending into a single output, by handing them off to lnd's central
+	//batching engine. Unless forced, the consolidation only takes place if the
+	//estimated fee rate is below the configured maximum and enough utxos are
+	//worth merging. The utxos are locked until they're swept or the sweep
+	//fails. A dry run returns the consolidation that would take place, along
+	//with the reason it would be skipped, without spending anything.
+	ConsolidateUtxos(context.Context, *ConsolidateUtxosRequest) (*ConsolidateUtxosResponse, error)
+	//*
+	//FundPsbt creates a fully populated PSBT that contains enough inputs to fund
+	//the outputs specified in the template. There are two ways of specifying a
+	//template: Either by passing in a PSBT with at least one output declared or
+	//by passing in a raw TxTemplate message.
+	//
+	//If there are no inputs specified in the template, coin selection is
+	//performed automatically. If the template does contain any inputs, it is
+	//assumed that full coin selection happened externally and no additional
+	//inputs are added. If the specified inputs aren't enough to fund the outputs
+	//with the given fee rate, an error is returned.
+	//
+	//After either selecting or verifying the inputs, all input UTXOs are leased
+	//for 10 minutes to prevent them from being used in other coin selection
+	//attempts. If a change output is needed, it is appended as the last output
+	//of the packet.
+	//
+	//NOTE: If this method returns without an error, it is the caller's
+	//responsibility to either spend the leased UTXOs within 10 minutes or
+	//release them using the ReleaseOutput RPC.
+	FundPsbt(context.Context, *FundPsbtRequest) (*FundPsbtResponse, error)
+	//*
+	//SignPsbt expects a partial transaction with all inputs and outputs fully
+	//declared and tries to sign all unsigned inputs that belong to the wallet.
+	//Inputs of other wallets are left untouched, which allows the packet to be
+	//passed on to other signers afterwards.
+	SignPsbt(context.Context, *SignPsbtRequest) (*SignPsbtResponse, error)
+	//*
+	//FinalizePsbt expects a partial transaction with all inputs and outputs
+	//fully declared and tries to sign all inputs that belong to the wallet.
+	//Signatures of all other inputs must already be present in the packet. The
+	//packet is then finalized and the final transaction is extracted, and
+	//optionally published to the network.
+	FinalizePsbt(context.Context, *FinalizePsbtRequest) (*FinalizePsbtResponse, error)
+	//*
+	//ListAccounts retrieves all accounts belonging to the wallet by default. A
+	//name filter can be provided to only return the account matching it.
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	//*
+	//CreateAccount derives a new named account from the wallet's seed. Funds
+	//sent to addresses of the account can be spent by the wallet, but they are
+	//never used for channel funding or coin selection of the default account.
+	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
+	//*
+	//ImportAccount imports an account backed by an account extended public key.
+	//The wallet tracks the funds received to addresses of the account starting
+	//at the given birthday height, but it is unable to sign for them. PSBTs
+	//spending from such an account must be signed externally.
+	ImportAccount(context.Context, *ImportAccountRequest) (*ImportAccountResponse, error)
+	//*
+	//LabelTransaction adds a label to a transaction. If the transaction already
+	//has a label the call will fail unless the overwrite bool is set. This will
+	//overwrite the existing transaction label. Labels must not be empty, and
+	//cannot exceed 500 characters.
+	LabelTransaction(context.Context, *LabelTransactionRequest) (*LabelTransactionResponse, error)
 }
 
 func RegisterWalletKitServer(s *grpc.Server, srv WalletKitServer) {
@@ -1228,6 +3541,222 @@ func _WalletKit_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_ListSweeps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSweepsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ListSweeps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ListSweeps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ListSweeps(ctx, req.(*ListSweepsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_LeaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).LeaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/LeaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).LeaseOutput(ctx, req.(*LeaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ReleaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ReleaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, req.(*ReleaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ListLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ListLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ListLeases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ListLeases(ctx, req.(*ListLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ConsolidateUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ConsolidateUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ConsolidateUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ConsolidateUtxos(ctx, req.(*ConsolidateUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_FundPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).FundPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/FundPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).FundPsbt(ctx, req.(*FundPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_SignPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).SignPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/SignPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).SignPsbt(ctx, req.(*SignPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_FinalizePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).FinalizePsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/FinalizePsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).FinalizePsbt(ctx, req.(*FinalizePsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ImportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ImportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ImportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ImportAccount(ctx, req.(*ImportAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_LabelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).LabelTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/LabelTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).LabelTransaction(ctx, req.(*LabelTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletKit_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletKit",
 	HandlerType: (*WalletKitServer)(nil),
@@ -1264,6 +3793,54 @@ var _WalletKit_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BumpFee",
 			Handler:    _WalletKit_BumpFee_Handler,
 		},
+		{
+			MethodName: "ListSweeps",
+			Handler:    _WalletKit_ListSweeps_Handler,
+		},
+		{
+			MethodName: "LeaseOutput",
+			Handler:    _WalletKit_LeaseOutput_Handler,
+		},
+		{
+			MethodName: "ReleaseOutput",
+			Handler:    _WalletKit_ReleaseOutput_Handler,
+		},
+		{
+			MethodName: "ListLeases",
+			Handler:    _WalletKit_ListLeases_Handler,
+		},
+		{
+			MethodName: "ConsolidateUtxos",
+			Handler:    _WalletKit_ConsolidateUtxos_Handler,
+		},
+		{
+			MethodName: "FundPsbt",
+			Handler:    _WalletKit_FundPsbt_Handler,
+		},
+		{
+			MethodName: "SignPsbt",
+			Handler:    _WalletKit_SignPsbt_Handler,
+		},
+		{
+			MethodName: "FinalizePsbt",
+			Handler:    _WalletKit_FinalizePsbt_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _WalletKit_ListAccounts_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _WalletKit_CreateAccount_Handler,
+		},
+		{
+			MethodName: "ImportAccount",
+			Handler:    _WalletKit_ImportAccount_Handler,
+		},
+		{
+			MethodName: "LabelTransaction",
+			Handler:    _WalletKit_LabelTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "walletrpc/walletkit.proto",
@@ -5,17 +5,25 @@ package walletrpc
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcutil/psbt"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
@@ -29,6 +37,10 @@ const (
 	// to register ourselves, and we also require that the main
 	// SubServerConfigDispatcher instance recognize as the name of our
 	subServerName = "WalletKitRPC"
+
+	// DefaultLockDuration is the default duration used to lease outputs
+	// if the caller doesn't specify one.
+	DefaultLockDuration = 10 * time.Minute
 )
 
 var (
@@ -87,12 +99,69 @@ var (
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/walletrpc.WalletKit/ListSweeps": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/walletrpc.WalletKit/LeaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ReleaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ListLeases": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/walletrpc.WalletKit/ConsolidateUtxos": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/FundPsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/SignPsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/FinalizePsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ListAccounts": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/walletrpc.WalletKit/CreateAccount": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ImportAccount": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/LabelTransaction": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultWalletKitMacFilename is the default name of the wallet kit
 	// macaroon that we expect to find via a file handle within the main
 	// configuration file in this package.
 	DefaultWalletKitMacFilename = "walletkit.macaroon"
+
+	// LndInternalLockID is the lock ID that is used to lease the inputs of
+	// PSBTs funded through the FundPsbt call.
+	LndInternalLockID = lnwallet.LockID{
+		0xed, 0xe1, 0x9a, 0x92, 0xed, 0x32, 0x1a, 0x47,
+		0x05, 0xf8, 0xa1, 0xcc, 0xcc, 0x1d, 0x4f, 0x61,
+		0x82, 0x54, 0x5d, 0x4b, 0xb4, 0xfa, 0xe0, 0x8b,
+		0xd5, 0x93, 0x78, 0x31, 0x65, 0x1b, 0xa8, 0x77,
+	}
 )
 
 // WalletKit is a sub-RPC server that exposes a tool kit which allows clients
@@ -237,7 +306,21 @@ func (w *WalletKit) DeriveKey(ctx context.Context,
 func (w *WalletKit) NextAddr(ctx context.Context,
 	req *AddrRequest) (*AddrResponse, error) {
 
-	addr, err := w.cfg.Wallet.NewAddress(lnwallet.WitnessPubKey, false)
+	account := lnwallet.DefaultAccountName
+	if req.Account != "" {
+		account = req.Account
+	}
+
+	addrType := lnwallet.WitnessPubKey
+	if req.Type != AddressType_UNKNOWN {
+		var err error
+		addrType, err = parseAddrType(req.Type)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	addr, err := w.cfg.Wallet.NewAddress(addrType, req.Change, account)
 	if err != nil {
 		return nil, err
 	}
@@ -261,13 +344,18 @@ func (w *WalletKit) PublishTransaction(ctx context.Context,
 			"publish")
 	}
 
+	label, err := labels.ValidateAPI(req.Label)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := &wire.MsgTx{}
 	txReader := bytes.NewReader(req.TxHex)
 	if err := tx.Deserialize(txReader); err != nil {
 		return nil, err
 	}
 
-	err := w.cfg.Wallet.PublishTransaction(tx)
+	err = w.cfg.Wallet.PublishTransaction(tx, label)
 	if err != nil {
 		return nil, err
 	}
@@ -289,6 +377,11 @@ func (w *WalletKit) SendOutputs(ctx context.Context,
 			"to create")
 	}
 
+	label, err := labels.ValidateAPI(req.Label)
+	if err != nil {
+		return nil, err
+	}
+
 	// Before we can request this transaction to be created, we'll need to
 	// amp the protos back into the format that the internal wallet will
 	// recognize.
@@ -303,7 +396,7 @@ func (w *WalletKit) SendOutputs(ctx context.Context,
 	// Now that we have the outputs mapped, we can request that the wallet
 	// attempt to create this transaction.
 	tx, err := w.cfg.Wallet.SendOutputs(
-		outputsToCreate, lnwallet.SatPerKWeight(req.SatPerKw),
+		outputsToCreate, lnwallet.SatPerKWeight(req.SatPerKw), label,
 	)
 	if err != nil {
 		return nil, err
@@ -333,15 +426,46 @@ func (w *WalletKit) EstimateFee(ctx context.Context,
 			"than 1")
 	}
 
-	satPerKw, err := w.cfg.FeeEstimator.EstimateFeePerKW(
+	// If the fee estimator combines the estimates of several sources,
+	// we'll include them within the response for debugging purposes.
+	reporter, ok := w.cfg.FeeEstimator.(lnwallet.FeeSourceReporter)
+	if !ok {
+		satPerKw, err := w.cfg.FeeEstimator.EstimateFeePerKW(
+			uint32(req.ConfTarget),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &EstimateFeeResponse{
+			SatPerKw: int64(satPerKw),
+		}, nil
+	}
+
+	satPerKw, estimates, err := reporter.SourceEstimates(
 		uint32(req.ConfTarget),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	rpcEstimates := make([]*FeeSourceEstimate, 0, len(estimates))
+	for _, estimate := range estimates {
+		rpcEstimate := &FeeSourceEstimate{
+			Name:     estimate.Name,
+			SatPerKw: int64(estimate.FeePerKW),
+			Outlier:  estimate.Outlier,
+		}
+		if estimate.Err != nil {
+			rpcEstimate.Error = estimate.Err.Error()
+		}
+
+		rpcEstimates = append(rpcEstimates, rpcEstimate)
+	}
+
 	return &EstimateFeeResponse{
-		SatPerKw: int64(satPerKw),
+		SatPerKw:        int64(satPerKw),
+		SourceEstimates: rpcEstimates,
 	}, nil
 }
 
@@ -363,36 +487,9 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 	// Convert them into their respective RPC format.
 	rpcPendingSweeps := make([]*PendingSweep, 0, len(pendingInputs))
 	for _, pendingInput := range pendingInputs {
-		var witnessType WitnessType
-		switch pendingInput.WitnessType {
-		case input.CommitmentTimeLock:
-			witnessType = WitnessType_COMMITMENT_TIME_LOCK
-		case input.CommitmentNoDelay:
-			witnessType = WitnessType_COMMITMENT_NO_DELAY
-		case input.CommitmentRevoke:
-			witnessType = WitnessType_COMMITMENT_REVOKE
-		case input.HtlcOfferedRevoke:
-			witnessType = WitnessType_HTLC_OFFERED_REVOKE
-		case input.HtlcAcceptedRevoke:
-			witnessType = WitnessType_HTLC_ACCEPTED_REVOKE
-		case input.HtlcOfferedTimeoutSecondLevel:
-			witnessType = WitnessType_HTLC_OFFERED_TIMEOUT_SECOND_LEVEL
-		case input.HtlcAcceptedSuccessSecondLevel:
-			witnessType = WitnessType_HTLC_ACCEPTED_SUCCESS_SECOND_LEVEL
-		case input.HtlcOfferedRemoteTimeout:
-			witnessType = WitnessType_HTLC_OFFERED_REMOTE_TIMEOUT
-		case input.HtlcAcceptedRemoteSuccess:
-			witnessType = WitnessType_HTLC_ACCEPTED_REMOTE_SUCCESS
-		case input.HtlcSecondLevelRevoke:
-			witnessType = WitnessType_HTLC_SECOND_LEVEL_REVOKE
-		case input.WitnessKeyHash:
-			witnessType = WitnessType_WITNESS_KEY_HASH
-		case input.NestedWitnessKeyHash:
-			witnessType = WitnessType_NESTED_WITNESS_KEY_HASH
-		default:
-			log.Warnf("Unhandled witness type %v for input %v",
-				pendingInput.WitnessType, pendingInput.OutPoint)
-		}
+		witnessType := marshallWitnessType(
+			pendingInput.WitnessType, pendingInput.OutPoint,
+		)
 
 		op := &lnrpc.OutPoint{
 			TxidBytes:   pendingInput.OutPoint.Hash[:],
@@ -402,6 +499,12 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 		satPerByte := uint32(pendingInput.LastFeeRate.FeePerKVByte() / 1000)
 		broadcastAttempts := uint32(pendingInput.BroadcastAttempts)
 		nextBroadcastHeight := uint32(pendingInput.NextBroadcastHeight)
+		currentSatPerByte := uint32(
+			pendingInput.CurrentFeeRate.FeePerKVByte() / 1000,
+		)
+		nextSatPerByte := uint32(
+			pendingInput.NextFeeRate.FeePerKVByte() / 1000,
+		)
 
 		rpcPendingSweeps = append(rpcPendingSweeps, &PendingSweep{
 			Outpoint:            op,
@@ -410,6 +513,10 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 			SatPerByte:          satPerByte,
 			BroadcastAttempts:   broadcastAttempts,
 			NextBroadcastHeight: nextBroadcastHeight,
+			DeadlineHeight:      pendingInput.DeadlineHeight,
+			BudgetSat:           int64(pendingInput.Budget),
+			CurrentSatPerByte:   currentSatPerByte,
+			NextSatPerByte:      nextSatPerByte,
 		})
 	}
 
@@ -418,51 +525,55 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 	}, nil
 }
 
-// unmarshallOutPoint converts an outpoint from its lnrpc type to its canonical
-// type.
-func unmarshallOutPoint(op *lnrpc.OutPoint) (*wire.OutPoint, error) {
-	if op == nil {
-		return nil, fmt.Errorf("empty outpoint provided")
-	}
-
-	var hash chainhash.Hash
-	switch {
-	case len(op.TxidBytes) == 0 && len(op.TxidStr) == 0:
-		fallthrough
-
-	case len(op.TxidBytes) != 0 && len(op.TxidStr) != 0:
-		return nil, fmt.Errorf("either TxidBytes or TxidStr must be " +
-			"specified, but not both")
-
-	// The hash was provided as raw bytes.
-	case len(op.TxidBytes) != 0:
-		copy(hash[:], op.TxidBytes)
+// marshallWitnessType converts the witness type of an input into its RPC
+// counterpart.
+func marshallWitnessType(witnessType input.WitnessType,
+	outpoint wire.OutPoint) WitnessType {
+
+	switch witnessType {
+	case input.CommitmentTimeLock:
+		return WitnessType_COMMITMENT_TIME_LOCK
+	case input.CommitmentNoDelay:
+		return WitnessType_COMMITMENT_NO_DELAY
+	case input.CommitmentRevoke:
+		return WitnessType_COMMITMENT_REVOKE
+	case input.HtlcOfferedRevoke:
+		return WitnessType_HTLC_OFFERED_REVOKE
+	case input.HtlcAcceptedRevoke:
+		return WitnessType_HTLC_ACCEPTED_REVOKE
+	case input.HtlcOfferedTimeoutSecondLevel:
+		return WitnessType_HTLC_OFFERED_TIMEOUT_SECOND_LEVEL
+	case input.HtlcAcceptedSuccessSecondLevel:
+		return WitnessType_HTLC_ACCEPTED_SUCCESS_SECOND_LEVEL
+	case input.HtlcOfferedRemoteTimeout:
+		return WitnessType_HTLC_OFFERED_REMOTE_TIMEOUT
+	case input.HtlcAcceptedRemoteSuccess:
+		return WitnessType_HTLC_ACCEPTED_REMOTE_SUCCESS
+	case input.HtlcSecondLevelRevoke:
+		return WitnessType_HTLC_SECOND_LEVEL_REVOKE
+	case input.WitnessKeyHash:
+		return WitnessType_WITNESS_KEY_HASH
+	case input.NestedWitnessKeyHash:
+		return WitnessType_NESTED_WITNESS_KEY_HASH
+	default:
+		log.Warnf("Unhandled witness type %v for input %v",
+			witnessType, outpoint)
 
-	// The hash was provided as a hex-encoded string.
-	case len(op.TxidStr) != 0:
-		h, err := chainhash.NewHashFromStr(op.TxidStr)
-		if err != nil {
-			return nil, err
-		}
-		hash = *h
+		return WitnessType_UNKNOWN_WITNESS
 	}
-
-	return &wire.OutPoint{
-		Hash:  hash,
-		Index: op.OutputIndex,
-	}, nil
 }
 
 // BumpFee allows bumping the fee rate of an arbitrary input. A fee preference
 // can be expressed either as a specific fee rate or a delta of blocks in which
 // the output should be swept on-chain within. If a fee preference is not
-// explicitly specified, then an error is returned. The status of the input
-// sweep can be checked through the PendingSweeps RPC.
+// explicitly specified, then an error is returned. A deadline and budget can
+// be added to the fee preference to have its fee rate raised every block. The
+// status of the input sweep can be checked through the PendingSweeps RPC.
 func (w *WalletKit) BumpFee(ctx context.Context,
 	in *BumpFeeRequest) (*BumpFeeResponse, error) {
 
 	// Parse the outpoint from the request.
-	op, err := unmarshallOutPoint(in.Outpoint)
+	op, err := lnrpc.UnmarshallOutPoint(in.Outpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -470,8 +581,25 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 	// Construct the request's fee preference.
 	satPerKw := lnwallet.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
 	feePreference := sweep.FeePreference{
-		ConfTarget: uint32(in.TargetConf),
-		FeeRate:    satPerKw,
+		ConfTarget:     uint32(in.TargetConf),
+		FeeRate:        satPerKw,
+		DeadlineHeight: in.DeadlineHeight,
+		Budget:         btcutil.Amount(in.BudgetSat),
+	}
+
+	if in.BudgetSat < 0 {
+		return nil, errors.New("budget must not be negative")
+	}
+
+	// If requested, we'll bump the fee of the transaction the outpoint
+	// belongs to by replacing it rather than by spending the outpoint.
+	if in.Replace {
+		if in.DeadlineHeight != 0 {
+			return nil, errors.New("a deadline can't be used " +
+				"when replacing a transaction")
+		}
+
+		return w.replaceTransaction(op.Hash, feePreference)
 	}
 
 	// We'll attempt to bump the fee of the input through the UtxoSweeper.
@@ -541,3 +669,757 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 
 	return &BumpFeeResponse{}, nil
 }
+
+// ListSweeps lists all outputs that were offered to the UtxoSweeper, including
+// the ones that are still pending, along with the outcome of their sweep.
+func (w *WalletKit) ListSweeps(ctx context.Context,
+	in *ListSweepsRequest) (*ListSweepsResponse, error) {
+
+	records, err := w.cfg.Sweeper.SweepHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSweeps := make([]*SweepRecord, 0, len(records))
+	for _, record := range records {
+		outpoint := *record.Input.OutPoint()
+
+		var outcome SweepOutcome
+		switch record.Outcome {
+		case sweep.SweepPending:
+			outcome = SweepOutcome_SWEEP_PENDING
+		case sweep.SweepSucceeded:
+			outcome = SweepOutcome_SWEEP_SUCCEEDED
+		case sweep.SweepRemoteSpend:
+			outcome = SweepOutcome_SWEEP_REMOTE_SPEND
+		case sweep.SweepFailed:
+			outcome = SweepOutcome_SWEEP_FAILED
+		default:
+			return nil, fmt.Errorf("unknown sweep outcome %v",
+				record.Outcome)
+		}
+
+		var sweepTxid, spendTxid string
+		if record.LastTxid != (chainhash.Hash{}) {
+			sweepTxid = record.LastTxid.String()
+		}
+		if record.SpendTxid != (chainhash.Hash{}) {
+			spendTxid = record.SpendTxid.String()
+		}
+
+		satPerByte := uint32(record.LastFeeRate.FeePerKVByte() / 1000)
+
+		rpcSweeps = append(rpcSweeps, &SweepRecord{
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   outpoint.Hash[:],
+				OutputIndex: outpoint.Index,
+			},
+			WitnessType: marshallWitnessType(
+				record.Input.WitnessType(), outpoint,
+			),
+			AmountSat: uint32(
+				record.Input.SignDesc().Output.Value,
+			),
+			Outcome:           outcome,
+			SweepTxid:         sweepTxid,
+			SpendTxid:         spendTxid,
+			FeeSat:            int64(record.Fee),
+			SatPerByte:        satPerByte,
+			BroadcastAttempts: uint32(record.PublishAttempts),
+			DeadlineHeight:    record.FeePreference.DeadlineHeight,
+		})
+	}
+
+	return &ListSweepsResponse{
+		Sweeps: rpcSweeps,
+	}, nil
+}
+
+// replaceTransaction bumps the fee of an unconfirmed transaction funded by the
+// wallet by replacing it (RBF) with one paying the given fee preference.
+func (w *WalletKit) replaceTransaction(hash chainhash.Hash,
+	feePreference sweep.FeePreference) (*BumpFeeResponse, error) {
+
+	feeRate, err := sweep.DetermineFeePerKw(
+		w.cfg.FeeEstimator, feePreference,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// An output of the transaction that is being swept by the UtxoSweeper,
+	// e.g. as part of a previous CPFP, would be invalidated by the
+	// replacement, so we refuse to replace the transaction in that case.
+	pendingInputs, err := w.cfg.Sweeper.PendingInputs()
+	if err != nil {
+		return nil, err
+	}
+	for op := range pendingInputs {
+		if op.Hash == hash {
+			return nil, fmt.Errorf("unable to replace "+
+				"transaction, its output %v is being swept",
+				op)
+		}
+	}
+
+	// The replacement spends the same inputs as the original, so we'll
+	// hold the coin selection lock to make sure no other transaction is
+	// funded with them in the meantime.
+	var replacement *wire.MsgTx
+	err = w.cfg.CoinSelectionLocker.WithCoinSelectLock(func() error {
+		var err error
+		replacement, err = w.cfg.Wallet.ReplaceTransaction(
+			hash, feeRate,
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BumpFeeResponse{
+		Txid: replacement.TxHash().String(),
+	}, nil
+}
+
+// unmarshallLockID converts a lease ID from its raw bytes to its canonical
+// type.
+func unmarshallLockID(id []byte) (lnwallet.LockID, error) {
+	var lockID lnwallet.LockID
+	if len(id) != len(lockID) {
+		return lockID, fmt.Errorf("invalid lease ID length: expected "+
+			"%v bytes, got %v", len(lockID), len(id))
+	}
+	copy(lockID[:], id)
+
+	return lockID, nil
+}
+
+// LeaseOutput locks an output to the given ID, preventing it from being
+// available for any future coin selection attempts. The absolute time of the
+// lock's expiration is returned. The expiration of the lock can be extended by
+// successive invocations of this call. Outputs can be unlocked before their
+// expiration through `ReleaseOutput`.
+func (w *WalletKit) LeaseOutput(ctx context.Context,
+	req *LeaseOutputRequest) (*LeaseOutputResponse, error) {
+
+	lockID, err := unmarshallLockID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := lnrpc.UnmarshallOutPoint(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := DefaultLockDuration
+	if req.ExpirationSeconds != 0 {
+		duration = time.Duration(req.ExpirationSeconds) * time.Second
+	}
+
+	expiration, err := w.cfg.Wallet.LeaseOutput(lockID, *op, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaseOutputResponse{
+		Expiration: uint64(expiration.Unix()),
+	}, nil
+}
+
+// ReleaseOutput unlocks an output, allowing it to be available for coin
+// selection if it remains unspent. The ID should match the one used to
+// originally lock the output.
+func (w *WalletKit) ReleaseOutput(ctx context.Context,
+	req *ReleaseOutputRequest) (*ReleaseOutputResponse, error) {
+
+	lockID, err := unmarshallLockID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := lnrpc.UnmarshallOutPoint(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.cfg.Wallet.ReleaseOutput(lockID, *op); err != nil {
+		return nil, err
+	}
+
+	return &ReleaseOutputResponse{}, nil
+}
+
+// ListLeases lists all currently leased outputs, along with the ID and
+// expiration of their lease.
+func (w *WalletKit) ListLeases(ctx context.Context,
+	req *ListLeasesRequest) (*ListLeasesResponse, error) {
+
+	leases, err := w.cfg.Wallet.ListLeases()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcLeases := make([]*UtxoLease, 0, len(leases))
+	for _, lease := range leases {
+		lockID := lease.LockID
+		txid := lease.OutPoint.Hash
+		rpcLeases = append(rpcLeases, &UtxoLease{
+			Id: lockID[:],
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   txid[:],
+				TxidStr:     txid.String(),
+				OutputIndex: lease.OutPoint.Index,
+			},
+			Expiration: uint64(lease.Expiration.Unix()),
+		})
+	}
+
+	return &ListLeasesResponse{
+		LockedUtxos: rpcLeases,
+	}, nil
+}
+
+// ConsolidateUtxos merges the smallest confirmed UTXOs of the wallet that are
+// worth spending into a single output through the UtxoSweeper. If a dry run is
+// requested, the consolidation is only planned and returned.
+func (w *WalletKit) ConsolidateUtxos(ctx context.Context,
+	req *ConsolidateUtxosRequest) (*ConsolidateUtxosResponse, error) {
+
+	var (
+		consolidation *sweep.Consolidation
+		err           error
+	)
+	if req.DryRun {
+		consolidation, err = w.cfg.Consolidator.Preview()
+	} else {
+		consolidation, err = w.cfg.Consolidator.Consolidate(req.Force)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rpcInputs := make([]*ConsolidationInput, 0, len(consolidation.Inputs))
+	for _, utxo := range consolidation.Inputs {
+		txid := utxo.OutPoint.Hash
+		rpcInputs = append(rpcInputs, &ConsolidationInput{
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   txid[:],
+				TxidStr:     txid.String(),
+				OutputIndex: utxo.OutPoint.Index,
+			},
+			AmountSat:     int64(utxo.Value),
+			AddressType:   marshalAddrType(utxo.AddressType),
+			Confirmations: utxo.Confirmations,
+		})
+	}
+
+	var skipReason string
+	if consolidation.Skipped != nil {
+		skipReason = consolidation.Skipped.Error()
+	}
+
+	return &ConsolidateUtxosResponse{
+		Inputs:         rpcInputs,
+		SatPerKw:       int64(consolidation.FeeRate),
+		TotalAmountSat: int64(consolidation.TotalAmount),
+		FeeSat:         int64(consolidation.Fee),
+		SkipReason:     skipReason,
+	}, nil
+}
+
+// FundPsbt creates a fully populated PSBT that contains enough inputs to fund
+// the outputs specified in the template. If the template doesn't contain any
+// inputs, coin selection is performed. All inputs of the resulting PSBT are
+// leased for DefaultLockDuration, after which they become available for coin
+// selection again unless spent or released earlier.
+func (w *WalletKit) FundPsbt(ctx context.Context,
+	req *FundPsbtRequest) (*FundPsbtResponse, error) {
+
+	var (
+		packet *psbt.Packet
+		err    error
+	)
+	switch {
+	case req.GetPsbt() != nil:
+		packet, err = psbt.NewFromRawBytes(
+			bytes.NewReader(req.GetPsbt()), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse PSBT: %v", err)
+		}
+
+	case req.GetRaw() != nil:
+		packet, err = w.psbtFromTemplate(req.GetRaw())
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("must specify either a PSBT or a raw " +
+			"transaction template")
+	}
+
+	var feePref sweep.FeePreference
+	switch {
+	case req.GetTargetConf() != 0:
+		feePref.ConfTarget = req.GetTargetConf()
+
+	case req.GetSatPerVbyte() != 0:
+		feePref.FeeRate = lnwallet.SatPerKVByte(
+			req.GetSatPerVbyte() * 1000,
+		).FeePerKWeight()
+
+	default:
+		return nil, fmt.Errorf("must specify either a target " +
+			"confirmation or a fee rate")
+	}
+	feeRate, err := sweep.DetermineFeePerKw(w.cfg.FeeEstimator, feePref)
+	if err != nil {
+		return nil, err
+	}
+
+	// Funding and leasing the inputs must happen atomically, otherwise a
+	// concurrent coin selection attempt could pick the very same inputs.
+	var (
+		changeIndex int32
+		leases      []*UtxoLease
+	)
+	err = w.cfg.CoinSelectionLocker.WithCoinSelectLock(func() error {
+		changeIndex, err = w.cfg.Wallet.FundPsbt(packet, feeRate)
+		if err != nil {
+			return fmt.Errorf("wallet couldn't fund PSBT: %v", err)
+		}
+
+		leases, err = w.leasePsbtInputs(packet)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("could not serialize PSBT: %v", err)
+	}
+
+	return &FundPsbtResponse{
+		FundedPsbt:        buf.Bytes(),
+		ChangeOutputIndex: changeIndex,
+		LockedUtxos:       leases,
+	}, nil
+}
+
+// psbtFromTemplate creates an unfunded PSBT from the inputs and outputs of the
+// given raw template.
+func (w *WalletKit) psbtFromTemplate(tpl *TxTemplate) (*psbt.Packet, error) {
+	if len(tpl.Outputs) == 0 {
+		return nil, fmt.Errorf("no outputs specified in template")
+	}
+
+	tx := wire.NewMsgTx(2)
+	for _, rpcOp := range tpl.Inputs {
+		op, err := lnrpc.UnmarshallOutPoint(rpcOp)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: *op})
+	}
+
+	for addrStr, amt := range tpl.Outputs {
+		addr, err := btcutil.DecodeAddress(addrStr, w.cfg.ChainParams)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing address %s: %v",
+				addrStr, err)
+		}
+		if !addr.IsForNet(w.cfg.ChainParams) {
+			return nil, fmt.Errorf("address %s is not valid for "+
+				"this network", addrStr)
+		}
+
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxOut(wire.NewTxOut(int64(amt), pkScript))
+	}
+
+	return psbt.NewFromUnsignedTx(tx)
+}
+
+// leasePsbtInputs leases all inputs of the given PSBT under the internal lock
+// ID. If any of the inputs can't be leased, including inputs that are already
+// leased under any ID, all leases acquired so far are released again and an
+// error is returned.
+func (w *WalletKit) leasePsbtInputs(packet *psbt.Packet) ([]*UtxoLease,
+	error) {
+
+	// Leasing an output that's already leased under the internal lock ID
+	// would silently extend that lease, so we'll explicitly refuse inputs
+	// that are held by a concurrent funding attempt as well.
+	existingLeases, err := w.cfg.Wallet.ListLeases()
+	if err != nil {
+		return nil, err
+	}
+	leased := make(map[wire.OutPoint]struct{}, len(existingLeases))
+	for _, lease := range existingLeases {
+		leased[lease.OutPoint] = struct{}{}
+	}
+
+	var (
+		leases    []*UtxoLease
+		leasedOps []wire.OutPoint
+	)
+	for _, txIn := range packet.UnsignedTx.TxIn {
+		op := txIn.PreviousOutPoint
+
+		var (
+			expiration time.Time
+			err        error
+		)
+		if _, ok := leased[op]; ok {
+			err = lnwallet.ErrOutputAlreadyLeased
+		} else {
+			expiration, err = w.cfg.Wallet.LeaseOutput(
+				LndInternalLockID, op, DefaultLockDuration,
+			)
+		}
+		if err != nil {
+			for _, leasedOp := range leasedOps {
+				err := w.cfg.Wallet.ReleaseOutput(
+					LndInternalLockID, leasedOp,
+				)
+				if err != nil {
+					log.Errorf("Unable to release lease "+
+						"on %v: %v", leasedOp, err)
+				}
+			}
+
+			return nil, fmt.Errorf("unable to lease input %v: %v",
+				op, err)
+		}
+
+		leasedOps = append(leasedOps, op)
+		leases = append(leases, &UtxoLease{
+			Id: LndInternalLockID[:],
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   op.Hash[:],
+				TxidStr:     op.Hash.String(),
+				OutputIndex: op.Index,
+			},
+			Expiration: uint64(expiration.Unix()),
+		})
+	}
+
+	return leases, nil
+}
+
+// SignPsbt expects a partial transaction with all inputs and outputs fully
+// declared and tries to sign all unsigned inputs that belong to the wallet.
+// Inputs of other wallets are left untouched.
+func (w *WalletKit) SignPsbt(ctx context.Context,
+	req *SignPsbtRequest) (*SignPsbtResponse, error) {
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse PSBT: %v", err)
+	}
+
+	signedInputs, err := w.cfg.Wallet.SignPsbt(packet)
+	if err != nil {
+		return nil, fmt.Errorf("error signing PSBT: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("could not serialize PSBT: %v", err)
+	}
+
+	return &SignPsbtResponse{
+		SignedPsbt:   buf.Bytes(),
+		SignedInputs: signedInputs,
+	}, nil
+}
+
+// FinalizePsbt expects a partial transaction with all inputs and outputs
+// fully declared and tries to sign all inputs that belong to the wallet. The
+// signatures of all other inputs must already be present in the packet. The
+// finalized transaction is extracted and optionally published.
+func (w *WalletKit) FinalizePsbt(ctx context.Context,
+	req *FinalizePsbtRequest) (*FinalizePsbtResponse, error) {
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse PSBT: %v", err)
+	}
+
+	if err := w.cfg.Wallet.FinalizePsbt(packet); err != nil {
+		return nil, fmt.Errorf("error finalizing PSBT: %v", err)
+	}
+
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract final TX: %v", err)
+	}
+
+	if req.Publish {
+		label, err := labels.ValidateAPI(req.Label)
+		if err != nil {
+			return nil, err
+		}
+
+		err = w.cfg.Wallet.PublishTransaction(finalTx, label)
+		if err != nil {
+			return nil, fmt.Errorf("unable to publish final TX: "+
+				"%v", err)
+		}
+	}
+
+	var finalPsbt, rawFinalTx bytes.Buffer
+	if err := packet.Serialize(&finalPsbt); err != nil {
+		return nil, fmt.Errorf("could not serialize PSBT: %v", err)
+	}
+	if err := finalTx.Serialize(&rawFinalTx); err != nil {
+		return nil, fmt.Errorf("could not serialize final TX: %v", err)
+	}
+
+	return &FinalizePsbtResponse{
+		SignedPsbt: finalPsbt.Bytes(),
+		RawFinalTx: rawFinalTx.Bytes(),
+	}, nil
+}
+
+// parseAddrType maps an RPC address type to the wallet's address type.
+func parseAddrType(addrType AddressType) (lnwallet.AddressType, error) {
+	switch addrType {
+	case AddressType_WITNESS_PUBKEY_HASH:
+		return lnwallet.WitnessPubKey, nil
+
+	case AddressType_NESTED_WITNESS_PUBKEY_HASH:
+		return lnwallet.NestedWitnessPubKey, nil
+
+	case AddressType_PUBKEY_HASH:
+		return lnwallet.PubKeyHash, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address type %v", addrType)
+	}
+}
+
+// marshalAddrType maps the wallet's address type to its RPC counterpart.
+func marshalAddrType(addrType lnwallet.AddressType) AddressType {
+	switch addrType {
+	case lnwallet.WitnessPubKey:
+		return AddressType_WITNESS_PUBKEY_HASH
+
+	case lnwallet.NestedWitnessPubKey:
+		return AddressType_NESTED_WITNESS_PUBKEY_HASH
+
+	case lnwallet.PubKeyHash:
+		return AddressType_PUBKEY_HASH
+
+	default:
+		return AddressType_UNKNOWN
+	}
+}
+
+// marshalAccount converts a wallet account into its RPC counterpart.
+func marshalAccount(account *lnwallet.Account) *Account {
+	rpcAccount := &Account{
+		Name:             account.Name,
+		AddressType:      marshalAddrType(account.AddressType),
+		ExternalKeyCount: account.ExternalKeyCount,
+		InternalKeyCount: account.InternalKeyCount,
+		WatchOnly:        account.WatchOnly,
+	}
+
+	if account.WatchOnly {
+		var fingerprint [4]byte
+		binary.LittleEndian.PutUint32(
+			fingerprint[:], account.MasterKeyFingerprint,
+		)
+
+		rpcAccount.ExtendedPublicKey = account.ExtendedPubKey
+		rpcAccount.MasterKeyFingerprint = fingerprint[:]
+	}
+
+	return rpcAccount
+}
+
+// ListAccounts retrieves all accounts belonging to the wallet by default. A
+// name filter can be provided to only return the account matching it.
+func (w *WalletKit) ListAccounts(ctx context.Context,
+	req *ListAccountsRequest) (*ListAccountsResponse, error) {
+
+	accounts, err := w.cfg.Wallet.ListAccounts(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcAccounts := make([]*Account, 0, len(accounts))
+	for _, account := range accounts {
+		rpcAccounts = append(rpcAccounts, marshalAccount(account))
+	}
+
+	return &ListAccountsResponse{Accounts: rpcAccounts}, nil
+}
+
+// CreateAccount derives a new named account from the wallet's seed.
+func (w *WalletKit) CreateAccount(ctx context.Context,
+	req *CreateAccountRequest) (*Account, error) {
+
+	addrType := lnwallet.WitnessPubKey
+	if req.AddressType != AddressType_UNKNOWN {
+		var err error
+		addrType, err = parseAddrType(req.AddressType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	account, err := w.cfg.Wallet.CreateAccount(req.Name, addrType)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalAccount(account), nil
+}
+
+// slip132Versions maps the version bytes of SLIP-132 encoded extended public
+// keys to the address type they imply.
+var slip132Versions = map[[4]byte]lnwallet.AddressType{
+	// ypub, mainnet p2wkh nested in p2sh.
+	{0x04, 0x9d, 0x7c, 0xb2}: lnwallet.NestedWitnessPubKey,
+
+	// zpub, mainnet p2wkh.
+	{0x04, 0xb2, 0x47, 0x46}: lnwallet.WitnessPubKey,
+
+	// upub, testnet p2wkh nested in p2sh.
+	{0x04, 0x4a, 0x52, 0x62}: lnwallet.NestedWitnessPubKey,
+
+	// vpub, testnet p2wkh.
+	{0x04, 0x5f, 0x1c, 0xf6}: lnwallet.WitnessPubKey,
+}
+
+// parseAccountKey parses an extended public key, which may be SLIP-132
+// encoded. If the encoding implies an address type, it is returned, otherwise
+// the given address type is. The returned key is re-encoded with the standard
+// version bytes of the given network.
+func parseAccountKey(key string, addrType AddressType,
+	net *chaincfg.Params) (*hdkeychain.ExtendedKey, lnwallet.AddressType,
+	error) {
+
+	extendedKey, err := hdkeychain.NewKeyFromString(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var version [4]byte
+	copy(version[:], base58.Decode(key))
+
+	impliedType, isSlip132 := slip132Versions[version]
+	if !isSlip132 {
+		if addrType == AddressType_UNKNOWN {
+			return nil, 0, fmt.Errorf("address type must be " +
+				"specified for extended keys that don't " +
+				"imply one")
+		}
+
+		t, err := parseAddrType(addrType)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return extendedKey, t, nil
+	}
+
+	if addrType != AddressType_UNKNOWN {
+		requested, err := parseAddrType(addrType)
+		if err != nil {
+			return nil, 0, err
+		}
+		if requested != impliedType {
+			return nil, 0, fmt.Errorf("address type %v doesn't "+
+				"match the encoding of the extended key",
+				addrType)
+		}
+	}
+
+	// The version bytes of the key only carry the address type, so we'll
+	// convert them to the standard ones of the network after making sure
+	// we're on the right one.
+	isMainNet := version[1] == 0x9d || version[1] == 0xb2
+	if isMainNet != (net.Net == chaincfg.MainNetParams.Net) {
+		return nil, 0, fmt.Errorf("extended key is not valid for %v",
+			net.Name)
+	}
+	extendedKey.SetNet(net)
+
+	return extendedKey, impliedType, nil
+}
+
+// ImportAccount imports an account backed by an account extended public key.
+// The wallet tracks funds received to addresses of the account, but is unable
+// to sign for them.
+func (w *WalletKit) ImportAccount(ctx context.Context,
+	req *ImportAccountRequest) (*ImportAccountResponse, error) {
+
+	accountKey, addrType, err := parseAccountKey(
+		req.ExtendedPublicKey, req.AddressType, w.cfg.ChainParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid extended public key: %v", err)
+	}
+
+	var fingerprint uint32
+	switch len(req.MasterKeyFingerprint) {
+	case 0:
+
+	case 4:
+		fingerprint = binary.LittleEndian.Uint32(
+			req.MasterKeyFingerprint,
+		)
+
+	default:
+		return nil, fmt.Errorf("master key fingerprint must be 4 " +
+			"bytes")
+	}
+
+	account, err := w.cfg.Wallet.ImportAccount(
+		req.Name, accountKey, fingerprint, addrType,
+		req.BirthdayHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportAccountResponse{Account: marshalAccount(account)}, nil
+}
+
+// LabelTransaction adds a label to a transaction. If the transaction already
+// has a label, the call fails unless the overwrite flag is set.
+func (w *WalletKit) LabelTransaction(ctx context.Context,
+	req *LabelTransactionRequest) (*LabelTransactionResponse, error) {
+
+	// Check that the label provided in non-zero.
+	if len(req.Label) == 0 {
+		return nil, labels.ErrEmptyLabel
+	}
+
+	hash, err := chainhash.NewHash(req.Txid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.cfg.Wallet.LabelTransaction(*hash, req.Label, req.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LabelTransactionResponse{}, nil
+}
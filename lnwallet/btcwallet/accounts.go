@@ -0,0 +1,371 @@
+package btcwallet
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	base "github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// accountScopes are the key scopes that accounts derived from the wallet's
+// seed can be created under, along with the type of addresses they derive.
+var accountScopes = []struct {
+	scope    waddrmgr.KeyScope
+	addrType lnwallet.AddressType
+}{
+	{waddrmgr.KeyScopeBIP0084, lnwallet.WitnessPubKey},
+	{waddrmgr.KeyScopeBIP0049Plus, lnwallet.NestedWitnessPubKey},
+	{waddrmgr.KeyScopeBIP0044, lnwallet.PubKeyHash},
+}
+
+// keyScopeForAddrType returns the key scope that addresses of the given type
+// are derived under.
+func keyScopeForAddrType(t lnwallet.AddressType) (waddrmgr.KeyScope, error) {
+	for _, s := range accountScopes {
+		if s.addrType == t {
+			return s.scope, nil
+		}
+	}
+
+	return waddrmgr.KeyScope{}, fmt.Errorf("unknown address type")
+}
+
+// accountNumber returns the number of the seed account with the given name
+// within the key scope of the given address type. The default account only
+// exists for the witness address types, as lnd is unable to spend legacy
+// outputs.
+func (b *BtcWallet) accountNumber(t lnwallet.AddressType,
+	name string) (waddrmgr.KeyScope, uint32, error) {
+
+	scope, err := keyScopeForAddrType(t)
+	if err != nil {
+		return scope, 0, err
+	}
+
+	if name == lnwallet.DefaultAccountName {
+		if t == lnwallet.PubKeyHash {
+			return scope, 0, lnwallet.ErrAccountNotFound
+		}
+
+		return scope, defaultAccount, nil
+	}
+
+	account, err := b.wallet.AccountNumber(scope, name)
+	switch {
+	case waddrmgr.IsError(err, waddrmgr.ErrAccountNotFound):
+		return scope, 0, lnwallet.ErrAccountNotFound
+
+	case err != nil:
+		return scope, 0, err
+	}
+
+	return scope, account, nil
+}
+
+// accountExists returns true if an account with the given name exists in any
+// of the key scopes, or as an imported watch-only account.
+//
+// NOTE: This method MUST be called with the watchOnlyMtx held.
+func (b *BtcWallet) accountExists(name string) (bool, error) {
+	if _, ok := b.watchOnly[name]; ok {
+		return true, nil
+	}
+
+	for _, s := range accountScopes {
+		_, err := b.wallet.AccountNumber(s.scope, name)
+		switch {
+		case waddrmgr.IsError(err, waddrmgr.ErrAccountNotFound):
+			continue
+
+		case err != nil:
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// validateAccountName ensures a new account can be created under the given
+// name.
+//
+// NOTE: This method MUST be called with the watchOnlyMtx held.
+func (b *BtcWallet) validateAccountName(name string) error {
+	switch name {
+	case "":
+		return fmt.Errorf("account name must not be empty")
+
+	case lnwallet.DefaultAccountName, waddrmgr.ImportedAddrAccountName:
+		return lnwallet.ErrAccountExists
+	}
+
+	exists, err := b.accountExists(name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return lnwallet.ErrAccountExists
+	}
+
+	return nil
+}
+
+// scriptAccount returns the name of the account the given output script
+// belongs to. False is returned if the script doesn't belong to any account
+// of the base wallet.
+func (b *BtcWallet) scriptAccount(pkScript []byte) (string, bool) {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		pkScript, b.netParams,
+	)
+	if err != nil || len(addrs) == 0 {
+		return "", false
+	}
+
+	var name string
+	err = walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		manager, account, err := b.wallet.Manager.AddrAccount(
+			addrmgrNs, addrs[0],
+		)
+		if err != nil {
+			return err
+		}
+
+		name, err = manager.AccountName(addrmgrNs, account)
+		return err
+	})
+	if err != nil {
+		return "", false
+	}
+
+	return name, true
+}
+
+// filterSummary reduces the inputs and outputs of a transaction summary to
+// those that belong to the given account. False is returned if none of them
+// do.
+func (b *BtcWallet) filterSummary(summary *base.TransactionSummary,
+	tx *wire.MsgTx, account string) bool {
+
+	var outputs []base.TransactionSummaryOutput
+	for _, output := range summary.MyOutputs {
+		pkScript := tx.TxOut[output.Index].PkScript
+		if name, ok := b.scriptAccount(pkScript); ok && name == account {
+			outputs = append(outputs, output)
+		}
+	}
+
+	var inputs []base.TransactionSummaryInput
+	for _, input := range summary.MyInputs {
+		prevOut := tx.TxIn[input.Index].PreviousOutPoint
+		prevTx, err := base.UnstableAPI(b.wallet).TxDetails(
+			&prevOut.Hash,
+		)
+		if err != nil || prevTx == nil {
+			continue
+		}
+
+		pkScript := prevTx.MsgTx.TxOut[prevOut.Index].PkScript
+		if name, ok := b.scriptAccount(pkScript); ok && name == account {
+			inputs = append(inputs, input)
+		}
+	}
+
+	summary.MyOutputs = outputs
+	summary.MyInputs = inputs
+
+	return len(outputs) != 0 || len(inputs) != 0
+}
+
+// ListAccounts returns all on-chain accounts of the wallet, or only the
+// account with the given name if it's not empty.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ListAccounts(name string) ([]*lnwallet.Account, error) {
+	var accounts []*lnwallet.Account
+	for _, s := range accountScopes {
+		result, err := b.wallet.Accounts(s.scope)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, account := range result.Accounts {
+			props := account.AccountProperties
+
+			// The imported account only holds individually imported
+			// keys, and the default BIP44 account isn't used by
+			// lnd, so we'll hide both of them.
+			switch {
+			case props.AccountNumber == waddrmgr.ImportedAddrAccount:
+				continue
+
+			case props.AccountNumber == defaultAccount &&
+				s.addrType == lnwallet.PubKeyHash:
+
+				continue
+
+			case name != "" && props.AccountName != name:
+				continue
+			}
+
+			accounts = append(accounts, &lnwallet.Account{
+				Name:             props.AccountName,
+				AddressType:      s.addrType,
+				ExternalKeyCount: props.ExternalKeyCount,
+				InternalKeyCount: props.InternalKeyCount,
+			})
+		}
+	}
+
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
+
+	for _, account := range b.watchOnly {
+		if name != "" && account.name != name {
+			continue
+		}
+
+		accounts = append(accounts, account.info())
+	}
+
+	return accounts, nil
+}
+
+// CreateAccount derives a new named account from the wallet's seed. The
+// address type determines the BIP44, BIP49 or BIP84 purpose the account is
+// derived under.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) CreateAccount(name string,
+	addrType lnwallet.AddressType) (*lnwallet.Account, error) {
+
+	scope, err := keyScopeForAddrType(addrType)
+	if err != nil {
+		return nil, err
+	}
+
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
+
+	if err := b.validateAccountName(name); err != nil {
+		return nil, err
+	}
+
+	account, err := b.wallet.NextAccount(scope, name)
+	if err != nil {
+		return nil, err
+	}
+	props, err := b.wallet.AccountProperties(scope, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnwallet.Account{
+		Name:             props.AccountName,
+		AddressType:      addrType,
+		ExternalKeyCount: props.ExternalKeyCount,
+		InternalKeyCount: props.InternalKeyCount,
+	}, nil
+}
+
+// ImportAccount imports a watch-only account from its extended public key at
+// the account level of its derivation path. The wallet will track the funds
+// of the account starting at the given birthday height, but is unable to
+// spend them. If the birthday height is zero, the birthday of the wallet is
+// used instead.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ImportAccount(name string,
+	accountPubKey *hdkeychain.ExtendedKey, masterKeyFingerprint uint32,
+	addrType lnwallet.AddressType,
+	birthdayHeight uint32) (*lnwallet.Account, error) {
+
+	switch {
+	case accountPubKey.IsPrivate():
+		return nil, fmt.Errorf("extended key must be a public key")
+
+	case accountPubKey.Depth() != 3:
+		return nil, fmt.Errorf("extended key must be at the account "+
+			"level of its derivation path (depth 3), got depth %d",
+			accountPubKey.Depth())
+
+	case !accountPubKey.IsForNet(b.netParams):
+		return nil, fmt.Errorf("extended key is not valid for %v",
+			b.netParams.Name)
+	}
+
+	if _, err := keyScopeForAddrType(addrType); err != nil {
+		return nil, err
+	}
+
+	if birthdayHeight == 0 {
+		err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+			addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+			birthdayBlock, _, err := b.wallet.Manager.BirthdayBlock(
+				addrmgrNs,
+			)
+			if err != nil {
+				return err
+			}
+
+			birthdayHeight = uint32(birthdayBlock.Height)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch wallet "+
+				"birthday: %v", err)
+		}
+	}
+
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
+
+	if err := b.validateAccountName(name); err != nil {
+		return nil, err
+	}
+
+	account, err := newWatchOnlyAccount(
+		name, accountPubKey, masterKeyFingerprint, addrType,
+		birthdayHeight, b.netParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.putWatchOnlyAccount(account); err != nil {
+		return nil, err
+	}
+	b.watchOnly[name] = account
+
+	// The account's transactions are found by scanning the chain from its
+	// birthday in the background.
+	b.signalWatchOnlySync()
+
+	return account.info(), nil
+}
+
+// newAccountAddress returns the next external or internal address of the
+// given seed account.
+func (b *BtcWallet) newAccountAddress(t lnwallet.AddressType, change bool,
+	account string) (btcutil.Address, error) {
+
+	scope, accountNum, err := b.accountNumber(t, account)
+	if err != nil {
+		return nil, err
+	}
+
+	if change {
+		return b.wallet.NewChangeAddress(accountNum, scope)
+	}
+
+	return b.wallet.NewAddress(accountNum, scope)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/btcsuite/btcwallet/chain"
 	"github.com/lightninglabs/neutrino"
 	"github.com/lightninglabs/neutrino/headerfs"
+	"github.com/lightningnetwork/lnd/electrum"
+	"github.com/lightningnetwork/lnd/esplora"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
@@ -122,6 +124,61 @@ func (b *BtcWallet) GetUtxo(op *wire.OutPoint, pkScript []byte,
 			PkScript: pkScript,
 		}, nil
 
+	case *electrum.ChainClient:
+		utxos, err := backend.ListUnspent(pkScript)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, utxo := range utxos {
+			if utxo.OutPoint != *op {
+				continue
+			}
+
+			return &wire.TxOut{
+				Value:    int64(utxo.Value),
+				PkScript: pkScript,
+			}, nil
+		}
+
+		// The output isn't part of the script's unspent outputs, so
+		// we'll consult its history to determine whether it existed
+		// at all.
+		history, err := backend.ScriptHistory(pkScript)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range history {
+			if item.TxHash == op.Hash {
+				return nil, ErrOutputSpent
+			}
+		}
+
+		return nil, ErrOutputNotFound
+
+	case *esplora.ChainClient:
+		outSpend, err := backend.GetOutSpend(op)
+		switch {
+		case err == esplora.ErrNotFound:
+			return nil, ErrOutputNotFound
+
+		case err != nil:
+			return nil, err
+
+		case outSpend.Spent:
+			return nil, ErrOutputSpent
+		}
+
+		tx, err := backend.GetTransaction(&op.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if op.Index >= uint32(len(tx.TxOut)) {
+			return nil, ErrOutputNotFound
+		}
+
+		return tx.TxOut[op.Index], nil
+
 	default:
 		return nil, fmt.Errorf("unknown backend")
 	}
@@ -142,6 +199,73 @@ func (b *BtcWallet) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
 	return b.chain.GetBlockHash(blockHeight)
 }
 
+// GetBlockTransaction returns the transaction found at the given index within
+// the block at the given height of the main chain.
+//
+// This method is a part of the lnwallet.BlockTxFetcher interface.
+func (b *BtcWallet) GetBlockTransaction(blockHeight,
+	txIndex uint32) (*wire.MsgTx, error) {
+
+	// Electrum servers don't serve full blocks, so we'll look up the
+	// transaction by its position instead, verifying its inclusion in the
+	// block.
+	if backend, ok := b.chain.(*electrum.ChainClient); ok {
+		txHash, err := backend.TxIDFromPos(int32(blockHeight), txIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		tx, _, pos, err := backend.GetConfirmedTransaction(
+			txHash, int32(blockHeight),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if pos != txIndex {
+			return nil, fmt.Errorf("transaction %v found at index "+
+				"%d instead of %d", txHash, pos, txIndex)
+		}
+
+		return tx, nil
+	}
+
+	// Esplora servers are able to serve full blocks, but we can avoid
+	// fetching the whole block by looking up the transaction by its
+	// position.
+	if backend, ok := b.chain.(*esplora.ChainClient); ok {
+		blockHash, err := backend.GetBlockHash(int64(blockHeight))
+		if err != nil {
+			return nil, err
+		}
+		txHash, err := backend.GetBlockTxID(blockHash, txIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		return backend.GetTransaction(txHash)
+	}
+
+	blockHash, err := b.chain.GetBlockHash(int64(blockHeight))
+	if err != nil {
+		return nil, err
+	}
+	block, err := b.chain.GetBlock(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if txIndex >= uint32(len(block.Transactions)) {
+		return nil, fmt.Errorf("tx_index=#%v is out of range "+
+			"(max_index=%v)", txIndex, len(block.Transactions)-1)
+	}
+
+	return block.Transactions[txIndex], nil
+}
+
+// A compile time check to ensure that BtcWallet implements the BlockTxFetcher
+// interface.
+var _ lnwallet.BlockTxFetcher = (*BtcWallet)(nil)
+
 // A compile time check to ensure that BtcWallet implements the BlockChainIO
 // interface.
 var _ lnwallet.WalletController = (*BtcWallet)(nil)
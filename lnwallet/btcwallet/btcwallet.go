@@ -3,13 +3,13 @@ package btcwallet
 import (
 	"bytes"
 	"encoding/hex"
-	"fmt"
 	"math"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -20,6 +20,7 @@ import (
 	"github.com/btcsuite/btcwallet/wallet/txrules"
 	"github.com/btcsuite/btcwallet/walletdb"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
@@ -32,6 +33,10 @@ var (
 	// stored within the top-level waleltdb buckets of btcwallet.
 	waddrmgrNamespaceKey = []byte("waddrmgr")
 
+	// wtxmgrNamespaceKey is the namespace key that the wtxmgr state is
+	// stored within the top-level walletdb buckets of btcwallet.
+	wtxmgrNamespaceKey = []byte("wtxmgr")
+
 	// lightningAddrSchema is the scope addr schema for all keys that we
 	// derive. We'll treat them all as p2wkh addresses, as atm we must
 	// specify a particular type.
@@ -58,6 +63,34 @@ type BtcWallet struct {
 	netParams *chaincfg.Params
 
 	chainKeyScope waddrmgr.KeyScope
+
+	// leases is the set of outputs that are currently leased, keyed by
+	// outpoint. All of them are also locked within the base wallet.
+	leases map[wire.OutPoint]*lnwallet.LeasedOutput
+
+	// leaseMtx guards the set of leases.
+	leaseMtx sync.Mutex
+
+	// watchOnly is the set of imported watch-only accounts, keyed by
+	// their name.
+	watchOnly map[string]*watchOnlyAccount
+
+	// watchOnlyMtx guards the set of watch-only accounts and their state.
+	// It's also held while creating new accounts to ensure the uniqueness
+	// of account names.
+	watchOnlyMtx sync.Mutex
+
+	// watchOnlySyncSignal is signalled whenever the watch-only accounts
+	// should be synced with the chain in the background.
+	watchOnlySyncSignal chan struct{}
+
+	// pendingWatchOnlyTxns are the unconfirmed transactions that yet have
+	// to be matched against the watch-only accounts.
+	pendingWatchOnlyTxns []*wire.MsgTx
+	pendingWatchOnlyMtx  sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
 }
 
 // A compile time check to ensure that BtcWallet implements the
@@ -126,6 +159,11 @@ func New(cfg Config) (*BtcWallet, error) {
 		chain:         cfg.ChainSource,
 		netParams:     cfg.NetParams,
 		chainKeyScope: chainKeyScope,
+		leases:        make(map[wire.OutPoint]*lnwallet.LeasedOutput),
+		watchOnly:     make(map[string]*watchOnlyAccount),
+		quit:          make(chan struct{}),
+
+		watchOnlySyncSignal: make(chan struct{}, 1),
 	}, nil
 }
 
@@ -176,6 +214,21 @@ func (b *BtcWallet) Start() error {
 		}
 	}
 
+	// Restore all output leases that survived the restart, such that the
+	// leased outputs remain unavailable for coin selection.
+	if err := b.loadLeases(); err != nil {
+		return err
+	}
+
+	b.wg.Add(1)
+	go b.leasePruner()
+
+	// Load all imported watch-only accounts. Their transactions are synced
+	// in the background once the wallet is started.
+	if err := b.loadWatchOnlyAccounts(); err != nil {
+		return err
+	}
+
 	// Establish an RPC connection in addition to starting the goroutines
 	// in the underlying wallet.
 	if err := b.chain.Start(); err != nil {
@@ -189,6 +242,17 @@ func (b *BtcWallet) Start() error {
 	// current main chain.
 	b.wallet.SynchronizeRPC(b.chain)
 
+	// Keep the watch-only accounts in sync with the chain, driven by the
+	// block and transaction notifications of the base wallet, starting
+	// with an initial sync.
+	txClient := b.wallet.NtfnServer.TransactionNotifications()
+
+	b.wg.Add(2)
+	go b.watchOnlyNtfnHandler(txClient)
+	go b.watchOnlySyncer()
+
+	b.signalWatchOnlySync()
+
 	return nil
 }
 
@@ -197,6 +261,9 @@ func (b *BtcWallet) Start() error {
 //
 // This is a part of the WalletController interface.
 func (b *BtcWallet) Stop() error {
+	close(b.quit)
+	b.wg.Wait()
+
 	b.wallet.Stop()
 
 	b.wallet.WaitForShutdown()
@@ -209,13 +276,18 @@ func (b *BtcWallet) Stop() error {
 // ConfirmedBalance returns the sum of all the wallet's unspent outputs that
 // have at least confs confirmations. If confs is set to zero, then all unspent
 // outputs, including those currently in the mempool will be included in the
-// final sum.
+// final sum. The account filter restricts the sum to a single account, while
+// an empty filter includes all accounts.
 //
 // This is a part of the WalletController interface.
-func (b *BtcWallet) ConfirmedBalance(confs int32) (btcutil.Amount, error) {
+func (b *BtcWallet) ConfirmedBalance(confs int32,
+	accountFilter string) (btcutil.Amount, error) {
+
 	var balance btcutil.Amount
 
-	witnessOutputs, err := b.ListUnspentWitness(confs, math.MaxInt32)
+	witnessOutputs, err := b.ListUnspentWitness(
+		confs, math.MaxInt32, accountFilter,
+	)
 	if err != nil {
 		return 0, err
 	}
@@ -230,26 +302,34 @@ func (b *BtcWallet) ConfirmedBalance(confs int32) (btcutil.Amount, error) {
 // NewAddress returns the next external or internal address for the wallet
 // dictated by the value of the `change` parameter. If change is true, then an
 // internal address will be returned, otherwise an external address should be
-// returned.
+// returned. The address is derived from the given account.
 //
 // This is a part of the WalletController interface.
-func (b *BtcWallet) NewAddress(t lnwallet.AddressType, change bool) (btcutil.Address, error) {
-	var keyScope waddrmgr.KeyScope
+func (b *BtcWallet) NewAddress(t lnwallet.AddressType, change bool,
+	account string) (btcutil.Address, error) {
+
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
 
-	switch t {
-	case lnwallet.WitnessPubKey:
-		keyScope = waddrmgr.KeyScopeBIP0084
-	case lnwallet.NestedWitnessPubKey:
-		keyScope = waddrmgr.KeyScopeBIP0049Plus
-	default:
-		return nil, fmt.Errorf("unknown address type")
+	watchOnly, ok := b.watchOnly[account]
+	if !ok {
+		return b.newAccountAddress(t, change, account)
 	}
 
-	if change {
-		return b.wallet.NewChangeAddress(defaultAccount, keyScope)
+	if t != watchOnly.addrType {
+		return nil, lnwallet.ErrAccountNotFound
 	}
 
-	return b.wallet.NewAddress(defaultAccount, keyScope)
+	addr, err := watchOnly.nextAddress(change)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handing out an address might extend the set of watched addresses,
+	// which the chain backend needs to be made aware of.
+	b.signalWatchOnlySync()
+
+	return addr, b.putWatchOnlyAccount(watchOnly)
 }
 
 // LastUnusedAddress returns the last *unused* address known by the wallet. An
@@ -258,21 +338,35 @@ func (b *BtcWallet) NewAddress(t lnwallet.AddressType, change bool) (btcutil.Add
 // worry about "address inflation" caused by continual refreshing. Similar to
 // NewAddress it can derive a specified address type, and also optionally a
 // change address.
-func (b *BtcWallet) LastUnusedAddress(addrType lnwallet.AddressType) (
-	btcutil.Address, error) {
+func (b *BtcWallet) LastUnusedAddress(addrType lnwallet.AddressType,
+	account string) (btcutil.Address, error) {
+
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
+
+	watchOnly, ok := b.watchOnly[account]
+	if !ok {
+		keyScope, accountNum, err := b.accountNumber(addrType, account)
+		if err != nil {
+			return nil, err
+		}
+
+		return b.wallet.CurrentAddress(accountNum, keyScope)
+	}
 
-	var keyScope waddrmgr.KeyScope
+	if addrType != watchOnly.addrType {
+		return nil, lnwallet.ErrAccountNotFound
+	}
 
-	switch addrType {
-	case lnwallet.WitnessPubKey:
-		keyScope = waddrmgr.KeyScopeBIP0084
-	case lnwallet.NestedWitnessPubKey:
-		keyScope = waddrmgr.KeyScopeBIP0049Plus
-	default:
-		return nil, fmt.Errorf("unknown address type")
+	// Whether the last address is still unused is determined based on the
+	// payments to the account that are known as of its last sync.
+	addr, err := watchOnly.lastUnusedAddress()
+	if err != nil {
+		return nil, err
 	}
+	b.signalWatchOnlySync()
 
-	return b.wallet.CurrentAddress(defaultAccount, keyScope)
+	return addr, b.putWatchOnlyAccount(watchOnly)
 }
 
 // IsOurAddress checks if the passed address belongs to this wallet
@@ -285,21 +379,45 @@ func (b *BtcWallet) IsOurAddress(a btcutil.Address) bool {
 
 // SendOutputs funds, signs, and broadcasts a Bitcoin transaction paying out to
 // the specified outputs. In the case the wallet has insufficient funds, or the
-// outputs are non-standard, a non-nil error will be returned.
+// outputs are non-standard, a non-nil error will be returned. If the label is
+// non-empty, it is stored for the transaction. The transaction signals
+// replaceability, such that its fee can be bumped if it gets stuck.
 //
 // This is a part of the WalletController interface.
 func (b *BtcWallet) SendOutputs(outputs []*wire.TxOut,
-	feeRate lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+	feeRate lnwallet.SatPerKWeight, label string) (*wire.MsgTx, error) {
 
-	// Convert our fee rate from sat/kw to sat/kb since it's required by
-	// SendOutputs.
-	feeSatPerKB := btcutil.Amount(feeRate.FeePerKVByte())
+	if len(label) > labels.MaxLabelLength {
+		return nil, labels.ErrLabelTooLong
+	}
 
-	// Sanity check outputs.
-	if len(outputs) < 1 {
-		return nil, lnwallet.ErrNoOutputs
+	// We fund the transaction ourselves rather than letting the base
+	// wallet send it, such that its inputs signal replaceability before
+	// they're signed. This allows its fee to be bumped through
+	// ReplaceTransaction later on.
+	authoredTx, err := b.CreateSimpleTx(outputs, feeRate, false)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := authoredTx.Tx
+	prevOuts := make([]*wire.TxOut, len(tx.TxIn))
+	for i, txIn := range tx.TxIn {
+		txIn.Sequence = mempool.MaxRBFSequence
+		prevOuts[i] = &wire.TxOut{
+			Value:    int64(authoredTx.PrevInputValues[i]),
+			PkScript: authoredTx.PrevScripts[i],
+		}
+	}
+	if err := b.signWalletInputs(tx, prevOuts); err != nil {
+		return nil, err
+	}
+
+	if err := b.PublishTransaction(tx, label); err != nil {
+		return nil, err
 	}
-	return b.wallet.SendOutputs(outputs, defaultAccount, 1, feeSatPerKB)
+
+	return tx, nil
 }
 
 // CreateSimpleTx creates a Bitcoin transaction paying to the specified
@@ -338,6 +456,11 @@ func (b *BtcWallet) CreateSimpleTx(outputs []*wire.TxOut,
 		}
 	}
 
+	// Release any expired leases so their outputs can be selected.
+	if err := b.pruneLeases(); err != nil {
+		return nil, err
+	}
+
 	return b.wallet.CreateSimpleTx(defaultAccount, outputs, 1, feeSatPerKB, dryRun)
 }
 
@@ -352,19 +475,33 @@ func (b *BtcWallet) LockOutpoint(o wire.OutPoint) {
 }
 
 // UnlockOutpoint unlocks a previously locked output, marking it eligible for
-// coin selection.
+// coin selection. Leased outputs remain locked until their lease is released
+// or expires.
 //
 // This is a part of the WalletController interface.
 func (b *BtcWallet) UnlockOutpoint(o wire.OutPoint) {
+	if b.isLeased(o) {
+		return
+	}
+
 	b.wallet.UnlockOutpoint(o)
 }
 
 // ListUnspentWitness returns a slice of all the unspent outputs the wallet
-// controls which pay to witness programs either directly or indirectly.
+// controls which pay to witness programs either directly or indirectly. Unless
+// the account filter is set to the default account, legacy p2pkh outputs are
+// returned as well. Confirmed outputs of watch-only accounts are included if
+// they match the account filter.
 //
 // This is a part of the WalletController interface.
-func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32) (
-	[]*lnwallet.Utxo, error) {
+func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32,
+	accountFilter string) ([]*lnwallet.Utxo, error) {
+
+	// Release any expired leases so their outputs are returned.
+	if err := b.pruneLeases(); err != nil {
+		return nil, err
+	}
+
 	// First, grab all the unfiltered currently unspent outputs.
 	unspentOutputs, err := b.wallet.ListUnspent(minConfs, maxConfs, nil)
 	if err != nil {
@@ -375,23 +512,35 @@ func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 	// which are p2wkh outputs or a p2wsh output nested within a p2sh output.
 	witnessOutputs := make([]*lnwallet.Utxo, 0, len(unspentOutputs))
 	for _, output := range unspentOutputs {
+		if accountFilter != "" && output.Account != accountFilter {
+			continue
+		}
+
 		pkScript, err := hex.DecodeString(output.ScriptPubKey)
 		if err != nil {
 			return nil, err
 		}
 
 		addressType := lnwallet.UnknownAddressType
-		if txscript.IsPayToWitnessPubKeyHash(pkScript) {
+		switch {
+		case txscript.IsPayToWitnessPubKeyHash(pkScript):
 			addressType = lnwallet.WitnessPubKey
-		} else if txscript.IsPayToScriptHash(pkScript) {
-			// TODO(roasbeef): This assumes all p2sh outputs returned by the
-			// wallet are nested p2pkh. We can't check the redeem script because
-			// the btcwallet service does not include it.
+
+		// TODO(roasbeef): This assumes all p2sh outputs returned by the
+		// wallet are nested p2pkh. We can't check the redeem script
+		// because the btcwallet service does not include it.
+		case txscript.IsPayToScriptHash(pkScript):
 			addressType = lnwallet.NestedWitnessPubKey
+
+		// Legacy outputs can't be spent by lnd, so they're only
+		// returned for accounts other than the default one.
+		case accountFilter != lnwallet.DefaultAccountName &&
+			txscript.GetScriptClass(pkScript) == txscript.PubKeyHashTy:
+
+			addressType = lnwallet.PubKeyHash
 		}
 
-		if addressType == lnwallet.WitnessPubKey ||
-			addressType == lnwallet.NestedWitnessPubKey {
+		if addressType != lnwallet.UnknownAddressType {
 
 			txid, err := chainhash.NewHashFromStr(output.TxID)
 			if err != nil {
@@ -420,15 +569,38 @@ func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 
 	}
 
-	return witnessOutputs, nil
+	// Finally, we'll add the outputs of all matching watch-only accounts,
+	// as of their last sync.
+	if accountFilter == lnwallet.DefaultAccountName {
+		return witnessOutputs, nil
+	}
+
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
+
+	watchOnlyOutputs, err := b.watchOnlyUnspent(
+		minConfs, maxConfs, accountFilter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(witnessOutputs, watchOnlyOutputs...), nil
 }
 
 // PublishTransaction performs cursory validation (dust checks, etc), then
 // finally broadcasts the passed transaction to the Bitcoin network. If
 // publishing the transaction fails, an error describing the reason is returned
 // (currently ErrDoubleSpend). If the transaction is already published to the
-// network (either in the mempool or chain) no error will be returned.
-func (b *BtcWallet) PublishTransaction(tx *wire.MsgTx) error {
+// network (either in the mempool or chain) no error will be returned. If the
+// label is non-empty and the transaction isn't labelled yet, the label is
+// stored for the transaction. Failing to store the label doesn't result in an
+// error, as the transaction has been broadcast at that point.
+func (b *BtcWallet) PublishTransaction(tx *wire.MsgTx, label string) error {
+	if len(label) > labels.MaxLabelLength {
+		return labels.ErrLabelTooLong
+	}
+
 	if err := b.wallet.PublishTransaction(tx); err != nil {
 
 		// If we failed to publish the transaction, check whether we
@@ -441,8 +613,8 @@ func (b *BtcWallet) PublishTransaction(tx *wire.MsgTx) error {
 			return lnwallet.ErrDoubleSpend
 
 		// If the wallet reports a replacement error, return
-		// ErrDoubleSpend, as we currently are never attempting to
-		// replace transactions.
+		// ErrDoubleSpend, as transactions are only ever replaced
+		// through ReplaceTransaction.
 		case *base.ErrReplacement:
 			return lnwallet.ErrDoubleSpend
 
@@ -450,6 +622,9 @@ func (b *BtcWallet) PublishTransaction(tx *wire.MsgTx) error {
 			return err
 		}
 	}
+
+	b.labelPublished(tx.TxHash(), label)
+
 	return nil
 }
 
@@ -568,10 +743,14 @@ func unminedTransactionsToDetail(
 }
 
 // ListTransactionDetails returns a list of all transactions which are
-// relevant to the wallet.
+// relevant to the wallet. The account filter restricts the list to the
+// transactions of a single account, while an empty filter includes those of
+// all accounts, including the confirmed transactions of watch-only accounts.
 //
 // This is a part of the WalletController interface.
-func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, error) {
+func (b *BtcWallet) ListTransactionDetails(accountFilter string) (
+	[]*lnwallet.TransactionDetail, error) {
+
 	// Grab the best block the wallet knows of, we'll use this to calculate
 	// # of confirmations shortly below.
 	bestBlock := b.wallet.Manager.SyncedTo()
@@ -587,6 +766,15 @@ func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, err
 		return nil, err
 	}
 
+	// If we're only interested in a single account, we'll reduce the
+	// transactions to those that credit or debit that account.
+	if accountFilter != "" {
+		err := b.filterTransactions(txns, accountFilter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	txDetails := make([]*lnwallet.TransactionDetail, 0,
 		len(txns.MinedTransactions)+len(txns.UnminedTransactions))
 
@@ -612,7 +800,92 @@ func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, err
 		txDetails = append(txDetails, detail)
 	}
 
-	return txDetails, nil
+	if accountFilter == lnwallet.DefaultAccountName {
+		return txDetails, b.addLabels(txDetails)
+	}
+
+	// Add the transactions of all matching watch-only accounts. If one of
+	// them is also relevant to the base wallet, its value is merged into
+	// the existing entry.
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
+
+	watchOnlyDetails, err := b.watchOnlyTransactions(accountFilter)
+	if err != nil {
+		return nil, err
+	}
+	for _, detail := range txDetails {
+		watchOnlyDetail, ok := watchOnlyDetails[detail.Hash]
+		if !ok {
+			continue
+		}
+
+		detail.Value += watchOnlyDetail.Value
+		delete(watchOnlyDetails, detail.Hash)
+	}
+	for _, detail := range watchOnlyDetails {
+		txDetails = append(txDetails, detail)
+	}
+
+	return txDetails, b.addLabels(txDetails)
+}
+
+// addLabels sets the label of all labelled transactions in the given list.
+func (b *BtcWallet) addLabels(txDetails []*lnwallet.TransactionDetail) error {
+	txLabels, err := b.fetchLabels()
+	if err != nil {
+		return err
+	}
+
+	for _, detail := range txDetails {
+		detail.Label = txLabels[detail.Hash]
+	}
+
+	return nil
+}
+
+// filterTransactions reduces the transactions returned by the base wallet to
+// those that credit or debit the given account.
+func (b *BtcWallet) filterTransactions(txns *base.GetTransactionsResult,
+	account string) error {
+
+	filter := func(summaries []base.TransactionSummary) (
+		[]base.TransactionSummary, error) {
+
+		var filtered []base.TransactionSummary
+		for _, summary := range summaries {
+			tx := &wire.MsgTx{}
+			err := tx.Deserialize(bytes.NewReader(summary.Transaction))
+			if err != nil {
+				return nil, err
+			}
+
+			if b.filterSummary(&summary, tx, account) {
+				filtered = append(filtered, summary)
+			}
+		}
+
+		return filtered, nil
+	}
+
+	var (
+		mined []base.Block
+		err   error
+	)
+	for _, block := range txns.MinedTransactions {
+		block.Transactions, err = filter(block.Transactions)
+		if err != nil {
+			return err
+		}
+
+		if len(block.Transactions) != 0 {
+			mined = append(mined, block)
+		}
+	}
+	txns.MinedTransactions = mined
+
+	txns.UnminedTransactions, err = filter(txns.UnminedTransactions)
+	return err
 }
 
 // txSubscriptionClient encapsulates the transaction notification client from
@@ -0,0 +1,128 @@
+package btcwallet
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	base "github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// labelsBucketKey is the top-level bucket within the wallet database that
+// stores all transaction labels, keyed by txid.
+var labelsBucketKey = []byte("lnd-tx-labels")
+
+// putLabel stores the label of the given transaction. If the transaction is
+// already labelled, ErrTxLabelExists is returned unless overwrite is set.
+func (b *BtcWallet) putLabel(hash chainhash.Hash, label string,
+	overwrite bool) error {
+
+	return walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		bucket := tx.ReadWriteBucket(labelsBucketKey)
+		if bucket == nil {
+			var err error
+			bucket, err = tx.CreateTopLevelBucket(labelsBucketKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !overwrite && bucket.Get(hash[:]) != nil {
+			return lnwallet.ErrTxLabelExists
+		}
+
+		return bucket.Put(hash[:], []byte(label))
+	})
+}
+
+// fetchLabels returns the labels of all labelled transactions.
+func (b *BtcWallet) fetchLabels() (map[chainhash.Hash]string, error) {
+	txLabels := make(map[chainhash.Hash]string)
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		bucket := tx.ReadBucket(labelsBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			hash, err := chainhash.NewHash(k)
+			if err != nil {
+				return err
+			}
+
+			txLabels[*hash] = string(v)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txLabels, nil
+}
+
+// labelPublished stores the label of a transaction the wallet published.
+// Existing labels are left untouched, so a transaction that is re-published
+// keeps the label it was given first, or by the user. As the transaction has
+// already been broadcast, failing to store its label is only logged.
+func (b *BtcWallet) labelPublished(hash chainhash.Hash, label string) {
+	if label == "" {
+		return
+	}
+
+	err := b.putLabel(hash, label, false)
+	if err != nil && err != lnwallet.ErrTxLabelExists {
+		log.Errorf("Unable to label published transaction %v: %v",
+			hash, err)
+	}
+}
+
+// isKnownTx returns true if the transaction is relevant to the base wallet or
+// to any of the watch-only accounts.
+func (b *BtcWallet) isKnownTx(hash chainhash.Hash) (bool, error) {
+	details, err := base.UnstableAPI(b.wallet).TxDetails(&hash)
+	if err != nil {
+		return false, err
+	}
+	if details != nil {
+		return true, nil
+	}
+
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
+
+	for _, account := range b.watchOnly {
+		if _, ok := account.txns[hash]; ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// LabelTransaction adds a label to a transaction known to the wallet. If the
+// transaction already has a label, ErrTxLabelExists is returned unless
+// overwrite is set.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) LabelTransaction(hash chainhash.Hash, label string,
+	overwrite bool) error {
+
+	switch {
+	case len(label) == 0:
+		return labels.ErrEmptyLabel
+
+	case len(label) > labels.MaxLabelLength:
+		return labels.ErrLabelTooLong
+	}
+
+	known, err := b.isKnownTx(hash)
+	if err != nil {
+		return err
+	}
+	if !known {
+		return lnwallet.ErrUnknownTxid
+	}
+
+	return b.putLabel(hash, label, overwrite)
+}
@@ -0,0 +1,288 @@
+package btcwallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+var (
+	// leasesBucketKey is the top-level bucket within the wallet database
+	// that stores all output leases, keyed by outpoint.
+	leasesBucketKey = []byte("lnd-output-leases")
+
+	// byteOrder is the byte order used to serialize lease expiries.
+	byteOrder = binary.BigEndian
+)
+
+const (
+	// leaseValueSize is the size of a serialized lease value: the 32-byte
+	// lock ID followed by the 8-byte unix expiry.
+	leaseValueSize = 32 + 8
+
+	// leasePruneInterval is the interval at which expired leases are
+	// released in the background.
+	leasePruneInterval = time.Minute
+)
+
+// serializeOutPoint returns the key a lease on the given outpoint is stored
+// under.
+func serializeOutPoint(op wire.OutPoint) []byte {
+	var k [36]byte
+	copy(k[:32], op.Hash[:])
+	byteOrder.PutUint32(k[32:], op.Index)
+
+	return k[:]
+}
+
+// deserializeLease reconstructs a lease from its database key and value.
+func deserializeLease(k, v []byte) (*lnwallet.LeasedOutput, error) {
+	if len(k) != 36 || len(v) != leaseValueSize {
+		return nil, fmt.Errorf("invalid lease record")
+	}
+
+	lease := &lnwallet.LeasedOutput{}
+	copy(lease.OutPoint.Hash[:], k[:32])
+	lease.OutPoint.Index = byteOrder.Uint32(k[32:])
+	copy(lease.LockID[:], v[:32])
+	lease.Expiration = time.Unix(int64(byteOrder.Uint64(v[32:])), 0)
+
+	return lease, nil
+}
+
+// loadLeases reads all persisted leases from the wallet database into
+// memory, locking the outputs of all leases that haven't expired yet. Expired
+// leases are removed from the database.
+func (b *BtcWallet) loadLeases() error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	var leases []*lnwallet.LeasedOutput
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		bucket := tx.ReadWriteBucket(leasesBucketKey)
+		if bucket == nil {
+			var err error
+			bucket, err = tx.CreateTopLevelBucket(leasesBucketKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			lease, err := deserializeLease(k, v)
+			if err != nil {
+				return err
+			}
+
+			leases = append(leases, lease)
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, lease := range leases {
+		if !lease.Expiration.After(now) {
+			if err := b.deleteLease(lease.OutPoint); err != nil {
+				return err
+			}
+			continue
+		}
+
+		b.leases[lease.OutPoint] = lease
+		b.wallet.LockOutpoint(lease.OutPoint)
+	}
+
+	return nil
+}
+
+// deleteLease removes the lease on the given outpoint from the wallet
+// database.
+func (b *BtcWallet) deleteLease(op wire.OutPoint) error {
+	return walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		bucket := tx.ReadWriteBucket(leasesBucketKey)
+		return bucket.Delete(serializeOutPoint(op))
+	})
+}
+
+// releaseExpiredLeases releases all leases that have expired, making their
+// outputs eligible for coin selection once again.
+//
+// NOTE: This method MUST be called with the leaseMtx held.
+func (b *BtcWallet) releaseExpiredLeases() error {
+	now := time.Now()
+	for op, lease := range b.leases {
+		if lease.Expiration.After(now) {
+			continue
+		}
+
+		if err := b.deleteLease(op); err != nil {
+			return err
+		}
+
+		delete(b.leases, op)
+		b.wallet.UnlockOutpoint(op)
+	}
+
+	return nil
+}
+
+// pruneLeases is a helper that releases all expired leases while holding the
+// leaseMtx.
+func (b *BtcWallet) pruneLeases() error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	return b.releaseExpiredLeases()
+}
+
+// leasePruner periodically releases all expired leases, such that their
+// outputs become eligible for coin selection again even if the set of leases
+// isn't queried in the meantime.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *BtcWallet) leasePruner() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(leasePruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := b.pruneLeases(); err != nil {
+				log.Errorf("Unable to prune expired leases: %v",
+					err)
+			}
+
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// isLeased returns true if the given outpoint is currently leased.
+func (b *BtcWallet) isLeased(op wire.OutPoint) bool {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	_, ok := b.leases[op]
+	return ok
+}
+
+// LeaseOutput locks an output of the wallet under the given lock ID until the
+// passed duration has elapsed, preventing it from being used for coin
+// selection. Leases persist across restarts. Leasing an output again with the
+// same lock ID extends the lease. The time at which the lease expires is
+// returned.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) LeaseOutput(id lnwallet.LockID, op wire.OutPoint,
+	duration time.Duration) (time.Time, error) {
+
+	// Only outputs that are under the control of the wallet can be leased.
+	if _, err := b.FetchInputInfo(&op); err != nil {
+		return time.Time{}, err
+	}
+
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	if err := b.releaseExpiredLeases(); err != nil {
+		return time.Time{}, err
+	}
+
+	// An output can't be leased if it's held by a different lease, or if
+	// it's currently locked by one of our own coin selection attempts.
+	lease, ok := b.leases[op]
+	switch {
+	case ok && lease.LockID != id:
+		return time.Time{}, lnwallet.ErrOutputAlreadyLeased
+
+	case !ok && b.wallet.LockedOutpoint(op):
+		return time.Time{}, lnwallet.ErrOutputAlreadyLeased
+	}
+
+	lease = &lnwallet.LeasedOutput{
+		LockID:     id,
+		OutPoint:   op,
+		Expiration: time.Now().Add(duration),
+	}
+	if err := b.putLease(lease); err != nil {
+		return time.Time{}, err
+	}
+
+	b.leases[op] = lease
+	b.wallet.LockOutpoint(op)
+
+	return lease.Expiration, nil
+}
+
+// putLease adds or replaces the given lease within the wallet database.
+func (b *BtcWallet) putLease(lease *lnwallet.LeasedOutput) error {
+	var v bytes.Buffer
+	v.Write(lease.LockID[:])
+	var expiry [8]byte
+	byteOrder.PutUint64(expiry[:], uint64(lease.Expiration.Unix()))
+	v.Write(expiry[:])
+
+	return walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		bucket := tx.ReadWriteBucket(leasesBucketKey)
+		return bucket.Put(serializeOutPoint(lease.OutPoint), v.Bytes())
+	})
+}
+
+// ReleaseOutput releases the lease on an output, making it eligible for coin
+// selection once again. The lock ID must match the one the output was leased
+// with.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ReleaseOutput(id lnwallet.LockID, op wire.OutPoint) error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	lease, ok := b.leases[op]
+	switch {
+	case !ok:
+		return lnwallet.ErrOutputNotLeased
+
+	case lease.LockID != id:
+		return lnwallet.ErrOutputUnlockNotAllowed
+	}
+
+	if err := b.deleteLease(op); err != nil {
+		return err
+	}
+
+	delete(b.leases, op)
+	b.wallet.UnlockOutpoint(op)
+
+	return nil
+}
+
+// ListLeases returns all outputs that are currently leased.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ListLeases() ([]*lnwallet.LeasedOutput, error) {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	if err := b.releaseExpiredLeases(); err != nil {
+		return nil, err
+	}
+
+	leases := make([]*lnwallet.LeasedOutput, 0, len(b.leases))
+	for _, lease := range b.leases {
+		leaseCopy := *lease
+		leases = append(leases, &leaseCopy)
+	}
+
+	return leases, nil
+}
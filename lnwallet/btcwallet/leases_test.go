@@ -0,0 +1,113 @@
+package btcwallet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// newTestWallet opens the wallet within the given directory, creating it if it
+// doesn't exist yet. The wallet isn't started, as no chain backend is
+// available.
+func newTestWallet(t *testing.T, dir string) *BtcWallet {
+	t.Helper()
+
+	w, err := New(Config{
+		DataDir:     dir,
+		PrivatePass: []byte("private"),
+		HdSeed:      make([]byte, 32),
+		NetParams:   &chaincfg.RegressionNetParams,
+	})
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+
+	return w
+}
+
+// TestLeasesSurviveRestart asserts that output leases are persisted in the
+// wallet database, such that the leased outputs remain locked after a
+// restart, while leases that expired in the meantime are released.
+func TestLeasesSurviveRestart(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "btcwallet-leases")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newTestWallet(t, dir)
+	if err := w.loadLeases(); err != nil {
+		t.Fatalf("unable to load leases: %v", err)
+	}
+
+	active := &lnwallet.LeasedOutput{
+		LockID: lnwallet.LockID{1},
+		OutPoint: wire.OutPoint{
+			Hash: chainhash.Hash{1},
+		},
+		Expiration: time.Now().Add(time.Hour),
+	}
+	expired := &lnwallet.LeasedOutput{
+		LockID: lnwallet.LockID{2},
+		OutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{2},
+			Index: 1,
+		},
+		Expiration: time.Now().Add(-time.Hour),
+	}
+	for _, lease := range []*lnwallet.LeasedOutput{active, expired} {
+		if err := w.putLease(lease); err != nil {
+			t.Fatalf("unable to store lease: %v", err)
+		}
+	}
+
+	// Simulate a restart by closing the database and opening the wallet
+	// once again.
+	if err := w.db.Close(); err != nil {
+		t.Fatalf("unable to close wallet db: %v", err)
+	}
+	w = newTestWallet(t, dir)
+	defer w.db.Close()
+
+	if err := w.loadLeases(); err != nil {
+		t.Fatalf("unable to load leases: %v", err)
+	}
+
+	// Only the active lease should have been restored, and its output
+	// should be locked once again.
+	leases, err := w.ListLeases()
+	if err != nil {
+		t.Fatalf("unable to list leases: %v", err)
+	}
+	if len(leases) != 1 {
+		t.Fatalf("expected 1 lease, got %v", len(leases))
+	}
+	lease := leases[0]
+	if lease.OutPoint != active.OutPoint || lease.LockID != active.LockID ||
+		lease.Expiration.Unix() != active.Expiration.Unix() {
+
+		t.Fatalf("unexpected lease: %v", lease)
+	}
+	if !w.wallet.LockedOutpoint(active.OutPoint) {
+		t.Fatalf("expected leased output to be locked")
+	}
+	if w.wallet.LockedOutpoint(expired.OutPoint) {
+		t.Fatalf("expected expired lease to be released")
+	}
+
+	// The expired lease should also have been removed from the database.
+	if err := w.loadLeases(); err != nil {
+		t.Fatalf("unable to load leases: %v", err)
+	}
+	if _, ok := w.leases[expired.OutPoint]; ok {
+		t.Fatalf("expected expired lease to be removed")
+	}
+}
@@ -0,0 +1,271 @@
+package btcwallet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/psbt"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// FundPsbt adds inputs and, if required, a change output to the passed PSBT,
+// such that its outputs are paid for at the given fee rate. If the packet
+// doesn't have any inputs yet, coin selection is performed. Otherwise, all of
+// its inputs must belong to the wallet and are used as is. The index of the
+// change output is returned, or -1 if no change output was added.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) FundPsbt(packet *psbt.Packet,
+	feeRate lnwallet.SatPerKWeight) (int32, error) {
+
+	tx := packet.UnsignedTx
+	if len(tx.TxOut) == 0 {
+		return -1, lnwallet.ErrNoOutputs
+	}
+
+	var (
+		prevOuts  []*wire.TxOut
+		changeOut *wire.TxOut
+	)
+	switch {
+	// Without any inputs, we'll let the wallet perform coin selection for
+	// us. Leased outputs won't be selected. We only use the selected
+	// inputs and change output of the resulting transaction, such that the
+	// original outputs keep their position within the packet.
+	case len(tx.TxIn) == 0:
+		authoredTx, err := b.CreateSimpleTx(tx.TxOut, feeRate, false)
+		if err != nil {
+			return -1, err
+		}
+
+		for i, txIn := range authoredTx.Tx.TxIn {
+			tx.AddTxIn(&wire.TxIn{
+				PreviousOutPoint: txIn.PreviousOutPoint,
+				Sequence:         txIn.Sequence,
+			})
+			packet.Inputs = append(packet.Inputs, psbt.PInput{})
+
+			prevOuts = append(prevOuts, &wire.TxOut{
+				Value:    int64(authoredTx.PrevInputValues[i]),
+				PkScript: authoredTx.PrevScripts[i],
+			})
+		}
+
+		if authoredTx.ChangeIndex >= 0 {
+			changeOut = authoredTx.Tx.TxOut[authoredTx.ChangeIndex]
+		}
+
+	// Otherwise, we'll only add a change output if the given inputs carry
+	// enough value to warrant one.
+	default:
+		var (
+			weightEstimate input.TxWeightEstimator
+			totalIn        btcutil.Amount
+			totalOut       btcutil.Amount
+		)
+		for _, txIn := range tx.TxIn {
+			utxo, err := b.FetchInputInfo(&txIn.PreviousOutPoint)
+			if err != nil {
+				return -1, fmt.Errorf("unable to fetch input "+
+					"%v: %v", txIn.PreviousOutPoint, err)
+			}
+
+			switch utxo.AddressType {
+			case lnwallet.WitnessPubKey:
+				weightEstimate.AddP2WKHInput()
+
+			case lnwallet.NestedWitnessPubKey:
+				weightEstimate.AddNestedP2WKHInput()
+
+			default:
+				return -1, fmt.Errorf("unsupported address "+
+					"type of input %v",
+					txIn.PreviousOutPoint)
+			}
+
+			totalIn += utxo.Value
+			prevOuts = append(prevOuts, &wire.TxOut{
+				Value:    int64(utxo.Value),
+				PkScript: utxo.PkScript,
+			})
+		}
+
+		for _, txOut := range tx.TxOut {
+			weightEstimate.AddTxOutput(txOut)
+			totalOut += btcutil.Amount(txOut.Value)
+		}
+		weightEstimate.AddP2WKHOutput()
+
+		fee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+		if totalIn < totalOut+fee {
+			return -1, fmt.Errorf("insufficient funds in inputs: "+
+				"have %v, need %v", totalIn, totalOut+fee)
+		}
+
+		changeAmt := totalIn - totalOut - fee
+		if changeAmt >= lnwallet.DefaultDustLimit() {
+			changeAddr, err := b.NewAddress(
+				lnwallet.WitnessPubKey, true,
+				lnwallet.DefaultAccountName,
+			)
+			if err != nil {
+				return -1, err
+			}
+			changeScript, err := txscript.PayToAddrScript(changeAddr)
+			if err != nil {
+				return -1, err
+			}
+
+			changeOut = &wire.TxOut{
+				Value:    int64(changeAmt),
+				PkScript: changeScript,
+			}
+		}
+	}
+
+	// Attach the output each input spends, such that signers are able to
+	// verify the amounts they sign for.
+	for i := range packet.Inputs {
+		if packet.Inputs[i].WitnessUtxo == nil {
+			packet.Inputs[i].WitnessUtxo = prevOuts[i]
+		}
+	}
+
+	if changeOut == nil {
+		return -1, nil
+	}
+
+	tx.AddTxOut(changeOut)
+	packet.Outputs = append(packet.Outputs, psbt.POutput{})
+
+	return int32(len(tx.TxOut) - 1), nil
+}
+
+// SignPsbt adds a partial signature to every input of the passed PSBT that
+// belongs to the wallet and hasn't been signed or finalized yet. Inputs that
+// don't belong to the wallet are left untouched. The indices of the inputs
+// that were signed are returned.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) SignPsbt(packet *psbt.Packet) ([]uint32, error) {
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := packet.UnsignedTx
+	sigHashes := txscript.NewTxSigHashes(tx)
+
+	var signedInputs []uint32
+	for i, txIn := range tx.TxIn {
+		pInput := &packet.Inputs[i]
+
+		// Inputs that were already finalized don't need our signature.
+		if len(pInput.FinalScriptSig) != 0 ||
+			len(pInput.FinalScriptWitness) != 0 {
+
+			continue
+		}
+
+		utxo, err := b.FetchInputInfo(&txIn.PreviousOutPoint)
+		switch {
+		case err == lnwallet.ErrNotMine:
+			continue
+
+		case err != nil:
+			return nil, err
+		}
+
+		// We only ever sign with SIGHASH_ALL.
+		if pInput.SighashType != 0 &&
+			pInput.SighashType != txscript.SigHashAll {
+
+			return nil, fmt.Errorf("unsupported sighash type %v "+
+				"for input %d", pInput.SighashType, i)
+		}
+
+		prevOut := &wire.TxOut{
+			Value:    int64(utxo.Value),
+			PkScript: utxo.PkScript,
+		}
+		if pInput.WitnessUtxo == nil {
+			err := updater.AddInWitnessUtxo(prevOut, i)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		signDesc := &input.SignDescriptor{
+			Output:     prevOut,
+			HashType:   txscript.SigHashAll,
+			SigHashes:  sigHashes,
+			InputIndex: i,
+		}
+		inputScript, err := b.ComputeInputScript(tx, signDesc)
+		if err != nil {
+			return nil, err
+		}
+
+		// The witness of the p2wkh and np2wkh outputs of the wallet
+		// consists of the signature followed by the public key.
+		sig, pubKey := inputScript.Witness[0], inputScript.Witness[1]
+
+		// Skip the input if it already carries our signature.
+		var signed bool
+		for _, partialSig := range pInput.PartialSigs {
+			if bytes.Equal(partialSig.PubKey, pubKey) {
+				signed = true
+			}
+		}
+		if signed {
+			continue
+		}
+
+		// For nested outputs, the sigScript pushes the redeem script,
+		// which we'll need to add to the packet as well.
+		var redeemScript []byte
+		if len(inputScript.SigScript) != 0 {
+			pushes, err := txscript.PushedData(
+				inputScript.SigScript,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if len(pushes) != 1 {
+				return nil, fmt.Errorf("invalid sigScript "+
+					"for input %d", i)
+			}
+			redeemScript = pushes[0]
+		}
+
+		outcome, err := updater.Sign(i, sig, pubKey, redeemScript, nil)
+		if err != nil {
+			return nil, err
+		}
+		if outcome != psbt.SignSuccesful {
+			return nil, fmt.Errorf("unable to sign input %d", i)
+		}
+
+		signedInputs = append(signedInputs, uint32(i))
+	}
+
+	return signedInputs, nil
+}
+
+// FinalizePsbt signs all inputs of the passed PSBT that belong to the wallet,
+// and then finalizes all of its inputs. An error is returned if any of the
+// inputs can't be finalized, for example because they lack the signatures of
+// other parties.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) FinalizePsbt(packet *psbt.Packet) error {
+	if _, err := b.SignPsbt(packet); err != nil {
+		return err
+	}
+
+	return psbt.MaybeFinalizeAll(packet)
+}
@@ -0,0 +1,261 @@
+package btcwallet
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	base "github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// replacementsBucketKey is the top-level bucket within the wallet database
+// that tracks the transactions replaced through ReplaceTransaction. It maps
+// the txid of each replaced transaction to the txid of its replacement.
+var replacementsBucketKey = []byte("lnd-tx-replacements")
+
+// fetchReplacement returns the txid of the transaction that replaced the
+// given one, or nil if it was never replaced.
+func (b *BtcWallet) fetchReplacement(
+	hash chainhash.Hash) (*chainhash.Hash, error) {
+
+	var replacement *chainhash.Hash
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		bucket := tx.ReadBucket(replacementsBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		v := bucket.Get(hash[:])
+		if v == nil {
+			return nil
+		}
+
+		var err error
+		replacement, err = chainhash.NewHash(v)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return replacement, nil
+}
+
+// signalsReplacement returns true if any of the inputs of the transaction
+// signals replaceability as defined by BIP 125.
+func signalsReplacement(tx *wire.MsgTx) bool {
+	for _, txIn := range tx.TxIn {
+		if txIn.Sequence <= mempool.MaxRBFSequence {
+			return true
+		}
+	}
+
+	return false
+}
+
+// signWalletInputs signs all inputs of the transaction, each of which must
+// spend the corresponding previous output controlled by the wallet.
+func (b *BtcWallet) signWalletInputs(tx *wire.MsgTx,
+	prevOuts []*wire.TxOut) error {
+
+	sigHashes := txscript.NewTxSigHashes(tx)
+	for i, txIn := range tx.TxIn {
+		signDesc := &input.SignDescriptor{
+			Output:     prevOuts[i],
+			HashType:   txscript.SigHashAll,
+			SigHashes:  sigHashes,
+			InputIndex: i,
+		}
+		inputScript, err := b.ComputeInputScript(tx, signDesc)
+		if err != nil {
+			return err
+		}
+
+		txIn.SignatureScript = inputScript.SigScript
+		txIn.Witness = inputScript.Witness
+	}
+
+	return nil
+}
+
+// ReplaceTransaction bumps the fee of an unconfirmed transaction that was
+// funded by the wallet to the given fee rate by replacing it (BIP 125). The
+// replacement spends the same inputs and pays to the same outputs, with the
+// higher fee being deducted from its change output. Once broadcast, the
+// replaced transaction is removed from the wallet, such that its outputs can
+// no longer be spent.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ReplaceTransaction(hash chainhash.Hash,
+	feeRate lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+
+	// A transaction that was already replaced is no longer part of the
+	// wallet, so we point the caller to its replacement instead.
+	replacement, err := b.fetchReplacement(hash)
+	if err != nil {
+		return nil, err
+	}
+	if replacement != nil {
+		return nil, fmt.Errorf("transaction %v was already replaced "+
+			"by %v", hash, replacement)
+	}
+
+	details, err := base.UnstableAPI(b.wallet).TxDetails(&hash)
+	switch {
+	case err != nil:
+		return nil, err
+
+	case details == nil:
+		return nil, lnwallet.ErrUnknownTxid
+
+	case details.Block.Height != -1:
+		return nil, lnwallet.ErrTxConfirmed
+	}
+
+	origTx := &details.MsgTx
+	if !signalsReplacement(origTx) {
+		return nil, lnwallet.ErrTxNotReplaceable
+	}
+
+	// We can only re-sign the transaction if all of its inputs are ours.
+	if len(details.Debits) != len(origTx.TxIn) {
+		return nil, fmt.Errorf("not all inputs of transaction %v "+
+			"belong to the wallet", hash)
+	}
+
+	// The higher fee is paid from the change output. If any of the
+	// transaction's outputs were already spent by another unconfirmed
+	// transaction, we refuse to replace it, as that would evict the
+	// spending transaction as well.
+	changeIndex := -1
+	for _, credit := range details.Credits {
+		if credit.Spent {
+			return nil, lnwallet.ErrTxHasDescendants
+		}
+
+		if credit.Change && changeIndex == -1 {
+			changeIndex = int(credit.Index)
+		}
+	}
+	if changeIndex == -1 {
+		return nil, lnwallet.ErrNoChangeOutput
+	}
+
+	var (
+		weightEstimate input.TxWeightEstimator
+		prevOuts       = make([]*wire.TxOut, len(origTx.TxIn))
+		totalIn        btcutil.Amount
+		totalOut       btcutil.Amount
+	)
+	for i, txIn := range origTx.TxIn {
+		utxo, err := b.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch input %v: %v",
+				txIn.PreviousOutPoint, err)
+		}
+
+		switch utxo.AddressType {
+		case lnwallet.WitnessPubKey:
+			weightEstimate.AddP2WKHInput()
+
+		case lnwallet.NestedWitnessPubKey:
+			weightEstimate.AddNestedP2WKHInput()
+
+		default:
+			return nil, fmt.Errorf("unsupported address type of "+
+				"input %v", txIn.PreviousOutPoint)
+		}
+
+		totalIn += utxo.Value
+		prevOuts[i] = &wire.TxOut{
+			Value:    int64(utxo.Value),
+			PkScript: utxo.PkScript,
+		}
+	}
+	for _, txOut := range origTx.TxOut {
+		weightEstimate.AddTxOutput(txOut)
+		totalOut += btcutil.Amount(txOut.Value)
+	}
+
+	// BIP 125 requires the replacement to pay for its own relay on top of
+	// the absolute fee of the transaction it replaces.
+	weight := int64(weightEstimate.Weight())
+	oldFee := totalIn - totalOut
+	newFee := feeRate.FeeForWeight(weight)
+	minFee := oldFee + lnwallet.FeePerKwFloor.FeeForWeight(weight)
+	if newFee < minFee {
+		return nil, fmt.Errorf("fee rate of %v is too low to replace "+
+			"transaction %v, its replacement must pay a fee of "+
+			"at least %v", feeRate, hash, minFee)
+	}
+
+	changeOut := origTx.TxOut[changeIndex]
+	changeAmt := btcutil.Amount(changeOut.Value) - (newFee - oldFee)
+	if changeAmt < lnwallet.DefaultDustLimit() {
+		return nil, fmt.Errorf("change output of transaction %v is "+
+			"too small to pay a fee of %v", hash, newFee)
+	}
+
+	replacementTx := origTx.Copy()
+	replacementTx.TxOut[changeIndex].Value = int64(changeAmt)
+	if err := b.signWalletInputs(replacementTx, prevOuts); err != nil {
+		return nil, err
+	}
+
+	if err := b.wallet.PublishTransaction(replacementTx); err != nil {
+		return nil, err
+	}
+
+	// Now that the replacement was accepted, we'll remove the original
+	// transaction from the wallet to make sure neither its inputs nor its
+	// change are ever spent again, and record the replacement so that
+	// further attempts to bump the original are refused.
+	replacementHash := replacementTx.TxHash()
+	err = walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		bucket := tx.ReadWriteBucket(replacementsBucketKey)
+		if bucket == nil {
+			var err error
+			bucket, err = tx.CreateTopLevelBucket(
+				replacementsBucketKey,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		err := bucket.Put(hash[:], replacementHash[:])
+		if err != nil {
+			return err
+		}
+
+		rec, err := wtxmgr.NewTxRecordFromMsgTx(
+			origTx, details.Received,
+		)
+		if err != nil {
+			return err
+		}
+
+		txmgrNs := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return b.wallet.TxStore.RemoveUnminedTx(txmgrNs, rec)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The replacement inherits the label of the original transaction. As
+	// it has already been broadcast, failing to do so isn't fatal.
+	txLabels, err := b.fetchLabels()
+	if err != nil {
+		log.Errorf("Unable to fetch label of %v: %v", hash, err)
+	} else {
+		b.labelPublished(replacementHash, txLabels[hash])
+	}
+
+	return replacementTx, nil
+}
@@ -0,0 +1,1156 @@
+package btcwallet
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	base "github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+const (
+	// watchOnlyLookahead is the number of addresses beyond the last used
+	// one of each branch that are watched for incoming payments.
+	watchOnlyLookahead = 20
+
+	// watchOnlyScanBatch is the maximum number of blocks that are scanned
+	// for the transactions of a watch-only account at once.
+	watchOnlyScanBatch = 1000
+
+	// externalBranch and internalBranch are the BIP44 branches of an
+	// account's external and internal (change) addresses.
+	externalBranch = 0
+	internalBranch = 1
+
+	// unconfirmedHeight is the block height of watch-only transactions
+	// that haven't confirmed yet.
+	unconfirmedHeight = -1
+)
+
+var (
+	// watchOnlyBucketKey is the top-level bucket within the wallet database
+	// that stores all imported watch-only accounts, each within a nested
+	// bucket keyed by the account name.
+	watchOnlyBucketKey = []byte("lnd-watch-only-accounts")
+
+	// watchOnlyInfoKey is the key within an account's bucket that stores
+	// the account's key and sync state.
+	watchOnlyInfoKey = []byte("info")
+
+	// watchOnlyTxnsKey is the nested bucket within an account's bucket
+	// that stores all transactions relevant to the account, keyed by
+	// their hash.
+	watchOnlyTxnsKey = []byte("txns")
+)
+
+// watchOnlyTx is a transaction that is relevant to a watch-only account. For
+// unconfirmed transactions, the block height is unconfirmedHeight and the
+// timestamp is the time the transaction was first seen.
+type watchOnlyTx struct {
+	tx          *wire.MsgTx
+	blockHeight int32
+	blockHash   chainhash.Hash
+	timestamp   time.Time
+}
+
+// confirmed returns true if the transaction has been included in a block.
+func (t *watchOnlyTx) confirmed() bool {
+	return t.blockHeight != unconfirmedHeight
+}
+
+// addrIndex locates an address within the derivation path of its account.
+type addrIndex struct {
+	branch uint32
+	index  uint32
+}
+
+// watchOnlyAccount is an account imported from an extended public key. The
+// wallet is unable to sign for its outputs, so its transactions are tracked
+// separately from those of the base wallet by scanning the chain for the
+// account's addresses.
+type watchOnlyAccount struct {
+	name                 string
+	accountKey           *hdkeychain.ExtendedKey
+	masterKeyFingerprint uint32
+	addrType             lnwallet.AddressType
+	birthdayHeight       uint32
+
+	// nextIndex is the index of the next unused address of each branch.
+	// An address is considered used once it was either handed out or
+	// received a payment.
+	nextIndex [2]uint32
+
+	// syncedHeight and syncedHash denote the block up to which the chain
+	// has been scanned for the account's transactions.
+	syncedHeight int32
+	syncedHash   chainhash.Hash
+
+	// addrs are the derived addresses of each branch, and scripts maps
+	// their output scripts back to their derivation index.
+	addrs   [2][]btcutil.Address
+	scripts map[string]addrIndex
+
+	txns map[chainhash.Hash]*watchOnlyTx
+
+	// dirtyTxns is the set of transactions that were added or removed
+	// since the account was last persisted. If clearedTxns is set, all
+	// persisted transactions are removed before writing the dirty ones.
+	dirtyTxns   map[chainhash.Hash]struct{}
+	clearedTxns bool
+
+	netParams *chaincfg.Params
+}
+
+// newWatchOnlyAccount creates a new watch-only account that hasn't been
+// synced yet.
+func newWatchOnlyAccount(name string, accountKey *hdkeychain.ExtendedKey,
+	masterKeyFingerprint uint32, addrType lnwallet.AddressType,
+	birthdayHeight uint32, netParams *chaincfg.Params) (*watchOnlyAccount,
+	error) {
+
+	account := &watchOnlyAccount{
+		name:                 name,
+		accountKey:           accountKey,
+		masterKeyFingerprint: masterKeyFingerprint,
+		addrType:             addrType,
+		birthdayHeight:       birthdayHeight,
+		syncedHeight:         int32(birthdayHeight) - 1,
+		scripts:              make(map[string]addrIndex),
+		txns:                 make(map[chainhash.Hash]*watchOnlyTx),
+		dirtyTxns:            make(map[chainhash.Hash]struct{}),
+		netParams:            netParams,
+	}
+
+	if err := account.deriveLookahead(); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// info returns the public description of the account.
+func (a *watchOnlyAccount) info() *lnwallet.Account {
+	return &lnwallet.Account{
+		Name:                 a.name,
+		AddressType:          a.addrType,
+		WatchOnly:            true,
+		ExtendedPubKey:       a.accountKey.String(),
+		MasterKeyFingerprint: a.masterKeyFingerprint,
+		ExternalKeyCount:     a.nextIndex[externalBranch],
+		InternalKeyCount:     a.nextIndex[internalBranch],
+	}
+}
+
+// addTx adds or replaces a transaction of the account.
+func (a *watchOnlyAccount) addTx(txn *watchOnlyTx) {
+	hash := txn.tx.TxHash()
+	a.txns[hash] = txn
+	a.dirtyTxns[hash] = struct{}{}
+}
+
+// removeTx removes a transaction from the account.
+func (a *watchOnlyAccount) removeTx(hash chainhash.Hash) {
+	delete(a.txns, hash)
+	a.dirtyTxns[hash] = struct{}{}
+}
+
+// clearTxns removes all transactions from the account.
+func (a *watchOnlyAccount) clearTxns() {
+	a.txns = make(map[chainhash.Hash]*watchOnlyTx)
+	a.dirtyTxns = make(map[chainhash.Hash]struct{})
+	a.clearedTxns = true
+}
+
+// numAddrs returns the number of addresses derived for the account.
+func (a *watchOnlyAccount) numAddrs() int {
+	return len(a.addrs[externalBranch]) + len(a.addrs[internalBranch])
+}
+
+// allAddrs returns all addresses derived for the account.
+func (a *watchOnlyAccount) allAddrs() []btcutil.Address {
+	addrs := make([]btcutil.Address, 0, a.numAddrs())
+	addrs = append(addrs, a.addrs[externalBranch]...)
+	return append(addrs, a.addrs[internalBranch]...)
+}
+
+// deriveAddr derives the address at the given index of the given branch.
+func (a *watchOnlyAccount) deriveAddr(branch,
+	index uint32) (btcutil.Address, error) {
+
+	branchKey, err := a.accountKey.Child(branch)
+	if err != nil {
+		return nil, err
+	}
+	key, err := branchKey.Child(index)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := key.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+	pubKeyHash := btcutil.Hash160(pubKey.SerializeCompressed())
+
+	switch a.addrType {
+	case lnwallet.WitnessPubKey:
+		return btcutil.NewAddressWitnessPubKeyHash(
+			pubKeyHash, a.netParams,
+		)
+
+	case lnwallet.NestedWitnessPubKey:
+		witnessAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+			pubKeyHash, a.netParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+		witnessProgram, err := txscript.PayToAddrScript(witnessAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		return btcutil.NewAddressScriptHash(
+			witnessProgram, a.netParams,
+		)
+
+	case lnwallet.PubKeyHash:
+		return btcutil.NewAddressPubKeyHash(pubKeyHash, a.netParams)
+
+	default:
+		return nil, fmt.Errorf("unknown address type %v", a.addrType)
+	}
+}
+
+// deriveLookahead derives all addresses of both branches up to the lookahead
+// window beyond the next unused address.
+func (a *watchOnlyAccount) deriveLookahead() error {
+	for branch := uint32(externalBranch); branch <= internalBranch; branch++ {
+		target := a.nextIndex[branch] + watchOnlyLookahead
+		for index := uint32(len(a.addrs[branch])); index < target; index++ {
+			addr, err := a.deriveAddr(branch, index)
+			if err != nil {
+				return err
+			}
+			pkScript, err := txscript.PayToAddrScript(addr)
+			if err != nil {
+				return err
+			}
+
+			a.addrs[branch] = append(a.addrs[branch], addr)
+			a.scripts[string(pkScript)] = addrIndex{
+				branch: branch,
+				index:  index,
+			}
+		}
+	}
+
+	return nil
+}
+
+// markUsed advances the next unused index of the address's branch past the
+// given address.
+func (a *watchOnlyAccount) markUsed(idx addrIndex) {
+	if idx.index >= a.nextIndex[idx.branch] {
+		a.nextIndex[idx.branch] = idx.index + 1
+	}
+}
+
+// nextAddress hands out the next unused address of the external or internal
+// branch.
+func (a *watchOnlyAccount) nextAddress(change bool) (btcutil.Address, error) {
+	branch := uint32(externalBranch)
+	if change {
+		branch = internalBranch
+	}
+
+	a.markUsed(addrIndex{branch: branch, index: a.nextIndex[branch]})
+	if err := a.deriveLookahead(); err != nil {
+		return nil, err
+	}
+
+	return a.addrs[branch][a.nextIndex[branch]-1], nil
+}
+
+// lastUnusedAddress returns the most recently handed out external address if
+// it hasn't received any payments yet, and a new address otherwise.
+func (a *watchOnlyAccount) lastUnusedAddress() (btcutil.Address, error) {
+	next := a.nextIndex[externalBranch]
+	if next == 0 {
+		return a.nextAddress(false)
+	}
+
+	last := a.addrs[externalBranch][next-1]
+	pkScript, err := txscript.PayToAddrScript(last)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, txn := range a.txns {
+		for _, txOut := range txn.tx.TxOut {
+			if bytes.Equal(txOut.PkScript, pkScript) {
+				return a.nextAddress(false)
+			}
+		}
+	}
+
+	return last, nil
+}
+
+// unspentOutputs returns all outputs of the account's transactions that
+// haven't been spent by any other of them. If confirmedOnly is set, only
+// confirmed transactions are taken into account, both as the source and the
+// spender of outputs.
+func (a *watchOnlyAccount) unspentOutputs(
+	confirmedOnly bool) map[wire.OutPoint]*watchOnlyTx {
+
+	spent := make(map[wire.OutPoint]struct{})
+	for _, txn := range a.txns {
+		if confirmedOnly && !txn.confirmed() {
+			continue
+		}
+
+		for _, txIn := range txn.tx.TxIn {
+			spent[txIn.PreviousOutPoint] = struct{}{}
+		}
+	}
+
+	unspent := make(map[wire.OutPoint]*watchOnlyTx)
+	for hash, txn := range a.txns {
+		if confirmedOnly && !txn.confirmed() {
+			continue
+		}
+
+		for i, txOut := range txn.tx.TxOut {
+			if _, ok := a.scripts[string(txOut.PkScript)]; !ok {
+				continue
+			}
+
+			op := wire.OutPoint{Hash: hash, Index: uint32(i)}
+			if _, ok := spent[op]; ok {
+				continue
+			}
+
+			unspent[op] = txn
+		}
+	}
+
+	return unspent
+}
+
+// balanceDelta returns the amount by which the given transaction changed the
+// balance of the account.
+func (a *watchOnlyAccount) balanceDelta(tx *wire.MsgTx) btcutil.Amount {
+	var delta btcutil.Amount
+	for _, txIn := range tx.TxIn {
+		prevOut := txIn.PreviousOutPoint
+		prevTx, ok := a.txns[prevOut.Hash]
+		if !ok || int(prevOut.Index) >= len(prevTx.tx.TxOut) {
+			continue
+		}
+
+		txOut := prevTx.tx.TxOut[prevOut.Index]
+		if _, ok := a.scripts[string(txOut.PkScript)]; ok {
+			delta -= btcutil.Amount(txOut.Value)
+		}
+	}
+	for _, txOut := range tx.TxOut {
+		if _, ok := a.scripts[string(txOut.PkScript)]; ok {
+			delta += btcutil.Amount(txOut.Value)
+		}
+	}
+
+	return delta
+}
+
+// addUnconfirmedTx adds the given unconfirmed transaction to the account if it
+// pays to one of the account's addresses or spends one of its outputs. True is
+// returned if the transaction was added.
+func (a *watchOnlyAccount) addUnconfirmedTx(tx *wire.MsgTx,
+	received time.Time) (bool, error) {
+
+	// Transactions that are already known, possibly as confirmed ones,
+	// are left untouched.
+	if _, ok := a.txns[tx.TxHash()]; ok {
+		return false, nil
+	}
+
+	var relevant bool
+	for _, txIn := range tx.TxIn {
+		prevOut := txIn.PreviousOutPoint
+		prevTx, ok := a.txns[prevOut.Hash]
+		if !ok || int(prevOut.Index) >= len(prevTx.tx.TxOut) {
+			continue
+		}
+
+		pkScript := prevTx.tx.TxOut[prevOut.Index].PkScript
+		if _, ok := a.scripts[string(pkScript)]; ok {
+			relevant = true
+		}
+	}
+	for _, txOut := range tx.TxOut {
+		if idx, ok := a.scripts[string(txOut.PkScript)]; ok {
+			a.markUsed(idx)
+			relevant = true
+		}
+	}
+	if !relevant {
+		return false, nil
+	}
+
+	a.addTx(&watchOnlyTx{
+		tx:          tx,
+		blockHeight: unconfirmedHeight,
+		timestamp:   received,
+	})
+
+	return true, a.deriveLookahead()
+}
+
+// pruneConflicts removes all unconfirmed transactions that spend an output
+// that has already been spent by a confirmed transaction, as they can never
+// confirm anymore.
+func (a *watchOnlyAccount) pruneConflicts() {
+	spent := make(map[wire.OutPoint]struct{})
+	for _, txn := range a.txns {
+		if !txn.confirmed() {
+			continue
+		}
+
+		for _, txIn := range txn.tx.TxIn {
+			spent[txIn.PreviousOutPoint] = struct{}{}
+		}
+	}
+
+	for hash, txn := range a.txns {
+		if txn.confirmed() {
+			continue
+		}
+
+		for _, txIn := range txn.tx.TxIn {
+			if _, ok := spent[txIn.PreviousOutPoint]; ok {
+				a.removeTx(hash)
+				break
+			}
+		}
+	}
+}
+
+// filterRequest creates a request to scan the given blocks for the account's
+// addresses and spends of its confirmed unspent outputs. Outputs that are only
+// spent by unconfirmed transactions are still watched, such that we notice
+// once the spend confirms.
+func (a *watchOnlyAccount) filterRequest(
+	blocks []wtxmgr.BlockMeta) *chain.FilterBlocksRequest {
+
+	scope, _ := keyScopeForAddrType(a.addrType)
+
+	req := &chain.FilterBlocksRequest{
+		Blocks:           blocks,
+		ExternalAddrs:    make(map[waddrmgr.ScopedIndex]btcutil.Address),
+		InternalAddrs:    make(map[waddrmgr.ScopedIndex]btcutil.Address),
+		WatchedOutPoints: make(map[wire.OutPoint]btcutil.Address),
+	}
+	for index, addr := range a.addrs[externalBranch] {
+		scopedIndex := waddrmgr.ScopedIndex{
+			Scope: scope,
+			Index: uint32(index),
+		}
+		req.ExternalAddrs[scopedIndex] = addr
+	}
+	for index, addr := range a.addrs[internalBranch] {
+		scopedIndex := waddrmgr.ScopedIndex{
+			Scope: scope,
+			Index: uint32(index),
+		}
+		req.InternalAddrs[scopedIndex] = addr
+	}
+	for op, txn := range a.unspentOutputs(true) {
+		pkScript := txn.tx.TxOut[op.Index].PkScript
+		idx := a.scripts[string(pkScript)]
+		req.WatchedOutPoints[op] = a.addrs[idx.branch][idx.index]
+	}
+
+	return req
+}
+
+// syncWatchOnlyAccount scans all blocks between the account's synced height
+// and the current best block for transactions relevant to the account. If the
+// block the account was synced to has been reorged out of the main chain, the
+// account is rescanned from its birthday. The watchOnlyMtx is only held while
+// accessing the account's state, such that the account can still be queried
+// while the chain is scanned.
+//
+// NOTE: This method MUST NOT be called with the watchOnlyMtx held, and MUST
+// only be called by the watchOnlySyncer.
+func (b *BtcWallet) syncWatchOnlyAccount(a *watchOnlyAccount) error {
+	_, bestHeight, err := b.chain.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	// The sync state of the account is only modified by the syncer, so
+	// we're able to read it once upfront.
+	b.watchOnlyMtx.Lock()
+	syncedHeight, syncedHash := a.syncedHeight, a.syncedHash
+	b.watchOnlyMtx.Unlock()
+
+	if syncedHeight >= int32(a.birthdayHeight) {
+		hash, err := b.chain.GetBlockHash(int64(syncedHeight))
+		if err != nil || *hash != syncedHash {
+			syncedHeight = int32(a.birthdayHeight) - 1
+
+			b.watchOnlyMtx.Lock()
+			a.syncedHeight = syncedHeight
+			a.syncedHash = chainhash.Hash{}
+			a.clearTxns()
+			err := b.putWatchOnlyAccount(a)
+			b.watchOnlyMtx.Unlock()
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	start := syncedHeight + 1
+	for start <= bestHeight {
+		select {
+		case <-b.quit:
+			return nil
+		default:
+		}
+
+		end := start + watchOnlyScanBatch - 1
+		if end > bestHeight {
+			end = bestHeight
+		}
+
+		blocks := make([]wtxmgr.BlockMeta, 0, end-start+1)
+		for height := start; height <= end; height++ {
+			hash, err := b.chain.GetBlockHash(int64(height))
+			if err != nil {
+				return err
+			}
+
+			blocks = append(blocks, wtxmgr.BlockMeta{
+				Block: wtxmgr.Block{
+					Hash:   *hash,
+					Height: height,
+				},
+			})
+		}
+
+		b.watchOnlyMtx.Lock()
+		req := a.filterRequest(blocks)
+		numAddrs := a.numAddrs()
+		b.watchOnlyMtx.Unlock()
+
+		resp, err := b.chain.FilterBlocks(req)
+		if err != nil {
+			return err
+		}
+
+		var header *wire.BlockHeader
+		if resp != nil {
+			header, err = b.chain.GetBlockHeader(&resp.BlockMeta.Hash)
+			if err != nil {
+				return err
+			}
+		}
+
+		start, err = b.applyWatchOnlyScan(a, blocks, resp, header, numAddrs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyWatchOnlyScan applies the result of scanning the given blocks to the
+// account and persists the new state. The height of the next block to scan is
+// returned. If any addresses were derived since the scan request was created,
+// either because they were handed out in the meantime or because the scan
+// found payments to the account, the scanned blocks might contain payments to
+// addresses that weren't watched. In that case, they're scanned once more.
+func (b *BtcWallet) applyWatchOnlyScan(a *watchOnlyAccount,
+	blocks []wtxmgr.BlockMeta, resp *chain.FilterBlocksResponse,
+	header *wire.BlockHeader, numAddrs int) (int32, error) {
+
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
+
+	// None of the blocks were relevant, so we can move on to the next
+	// batch, unless new addresses were derived in the meantime.
+	if resp == nil {
+		if a.numAddrs() != numAddrs {
+			return blocks[0].Block.Height, nil
+		}
+
+		last := blocks[len(blocks)-1].Block
+		a.syncedHeight, a.syncedHash = last.Height, last.Hash
+
+		return last.Height + 1, b.putWatchOnlyAccount(a)
+	}
+
+	for _, tx := range resp.RelevantTxns {
+		a.addTx(&watchOnlyTx{
+			tx:          tx,
+			blockHeight: resp.BlockMeta.Height,
+			blockHash:   resp.BlockMeta.Hash,
+			timestamp:   header.Timestamp,
+		})
+	}
+
+	// Any address that received a payment is now used, which might require
+	// us to watch additional addresses.
+	for _, indexes := range resp.FoundExternalAddrs {
+		for index := range indexes {
+			a.markUsed(addrIndex{
+				branch: externalBranch,
+				index:  index,
+			})
+		}
+	}
+	for _, indexes := range resp.FoundInternalAddrs {
+		for index := range indexes {
+			a.markUsed(addrIndex{
+				branch: internalBranch,
+				index:  index,
+			})
+		}
+	}
+	if err := a.deriveLookahead(); err != nil {
+		return 0, err
+	}
+
+	// The relevant block might also pay to one of the newly derived
+	// addresses, so it's scanned once more.
+	next := resp.BlockMeta.Height
+	if a.numAddrs() == numAddrs {
+		a.syncedHeight = resp.BlockMeta.Height
+		a.syncedHash = resp.BlockMeta.Hash
+		next++
+	}
+
+	// Newly confirmed transactions might conflict with unconfirmed ones
+	// that will therefore never confirm.
+	a.pruneConflicts()
+
+	return next, b.putWatchOnlyAccount(a)
+}
+
+// syncWatchOnlyAccounts syncs all watch-only accounts with the chain, and
+// ensures that the chain backend notifies us about unconfirmed transactions
+// paying to any of their addresses.
+//
+// NOTE: This method MUST only be called by the watchOnlySyncer.
+func (b *BtcWallet) syncWatchOnlyAccounts() error {
+	b.watchOnlyMtx.Lock()
+	accounts := make([]*watchOnlyAccount, 0, len(b.watchOnly))
+	for _, account := range b.watchOnly {
+		accounts = append(accounts, account)
+	}
+	b.watchOnlyMtx.Unlock()
+
+	for _, account := range accounts {
+		if err := b.syncWatchOnlyAccount(account); err != nil {
+			return fmt.Errorf("unable to sync watch-only account "+
+				"%v: %v", account.name, err)
+		}
+
+		b.watchOnlyMtx.Lock()
+		addrs := account.allAddrs()
+		b.watchOnlyMtx.Unlock()
+
+		if err := b.chain.NotifyReceived(addrs); err != nil {
+			return fmt.Errorf("unable to watch addresses of "+
+				"account %v: %v", account.name, err)
+		}
+	}
+
+	return nil
+}
+
+// addUnconfirmedWatchOnlyTxns adds the given unconfirmed transactions to all
+// watch-only accounts they're relevant to.
+//
+// NOTE: This method MUST only be called by the watchOnlySyncer.
+func (b *BtcWallet) addUnconfirmedWatchOnlyTxns(txns []*wire.MsgTx) error {
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
+
+	now := time.Now()
+	for _, account := range b.watchOnly {
+		var added bool
+		for _, tx := range txns {
+			ok, err := account.addUnconfirmedTx(tx, now)
+			if err != nil {
+				return err
+			}
+			added = added || ok
+		}
+
+		if !added {
+			continue
+		}
+
+		if err := b.putWatchOnlyAccount(account); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// signalWatchOnlySync requests the watch-only accounts to be synced with the
+// chain, without blocking the caller.
+func (b *BtcWallet) signalWatchOnlySync() {
+	select {
+	case b.watchOnlySyncSignal <- struct{}{}:
+	default:
+	}
+}
+
+// watchOnlyNtfnHandler receives the transaction notifications of the base
+// wallet. Every connected or disconnected block triggers a sync of the
+// watch-only accounts, while unconfirmed transactions are queued to be matched
+// against them. As the notifications are delivered synchronously, they're
+// handed off to the watchOnlySyncer without blocking.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *BtcWallet) watchOnlyNtfnHandler(
+	client base.TransactionNotificationsClient) {
+
+	defer b.wg.Done()
+	defer client.Done()
+
+	for {
+		select {
+		case ntfn, ok := <-client.C:
+			if !ok {
+				return
+			}
+
+			var unconfirmed []*wire.MsgTx
+			for _, summary := range ntfn.UnminedTransactions {
+				tx := &wire.MsgTx{}
+				err := tx.Deserialize(
+					bytes.NewReader(summary.Transaction),
+				)
+				if err != nil {
+					log.Errorf("Unable to decode unconfirmed "+
+						"tx %v: %v", summary.Hash, err)
+					continue
+				}
+
+				unconfirmed = append(unconfirmed, tx)
+			}
+
+			if len(unconfirmed) > 0 {
+				b.pendingWatchOnlyMtx.Lock()
+				b.pendingWatchOnlyTxns = append(
+					b.pendingWatchOnlyTxns, unconfirmed...,
+				)
+				b.pendingWatchOnlyMtx.Unlock()
+			}
+
+			if len(unconfirmed) > 0 || len(ntfn.AttachedBlocks) > 0 ||
+				len(ntfn.DetachedBlocks) > 0 {
+
+				b.signalWatchOnlySync()
+			}
+
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// watchOnlySyncer keeps all watch-only accounts in sync with the chain in the
+// background, whenever it's signalled to do so.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *BtcWallet) watchOnlySyncer() {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case <-b.watchOnlySyncSignal:
+			b.pendingWatchOnlyMtx.Lock()
+			unconfirmed := b.pendingWatchOnlyTxns
+			b.pendingWatchOnlyTxns = nil
+			b.pendingWatchOnlyMtx.Unlock()
+
+			// We'll sync the accounts first, such that transactions
+			// spending outputs that only just confirmed are matched.
+			if err := b.syncWatchOnlyAccounts(); err != nil {
+				log.Errorf("Unable to sync watch-only "+
+					"accounts: %v", err)
+			}
+
+			err := b.addUnconfirmedWatchOnlyTxns(unconfirmed)
+			if err != nil {
+				log.Errorf("Unable to add unconfirmed "+
+					"watch-only transactions: %v", err)
+			}
+
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// numConfs returns the number of confirmations of the given transaction of the
+// account, based on the height the account is synced to.
+func (a *watchOnlyAccount) numConfs(txn *watchOnlyTx) int32 {
+	if !txn.confirmed() {
+		return 0
+	}
+
+	// A transaction might be found in a block beyond the synced height
+	// while the block is being rescanned.
+	confs := a.syncedHeight - txn.blockHeight + 1
+	if confs < 1 {
+		confs = 1
+	}
+
+	return confs
+}
+
+// watchOnlyUnspent returns the unspent outputs of all watch-only accounts
+// matching the given account filter, as of their last sync.
+//
+// NOTE: This method MUST be called with the watchOnlyMtx held.
+func (b *BtcWallet) watchOnlyUnspent(minConfs, maxConfs int32,
+	accountFilter string) ([]*lnwallet.Utxo, error) {
+
+	var utxos []*lnwallet.Utxo
+	for name, account := range b.watchOnly {
+		if accountFilter != "" && name != accountFilter {
+			continue
+		}
+
+		for op, txn := range account.unspentOutputs(false) {
+			confs := account.numConfs(txn)
+			if confs < minConfs || confs > maxConfs {
+				continue
+			}
+
+			txOut := txn.tx.TxOut[op.Index]
+			utxos = append(utxos, &lnwallet.Utxo{
+				AddressType:   account.addrType,
+				Value:         btcutil.Amount(txOut.Value),
+				Confirmations: int64(confs),
+				PkScript:      txOut.PkScript,
+				OutPoint:      op,
+			})
+		}
+	}
+
+	return utxos, nil
+}
+
+// watchOnlyTransactions returns the transactions of all watch-only accounts
+// matching the given account filter as of their last sync, keyed by their
+// hash. The value of each transaction reflects the balance change of the
+// watch-only accounts.
+//
+// NOTE: This method MUST be called with the watchOnlyMtx held.
+func (b *BtcWallet) watchOnlyTransactions(
+	accountFilter string) (map[chainhash.Hash]*lnwallet.TransactionDetail,
+	error) {
+
+	details := make(map[chainhash.Hash]*lnwallet.TransactionDetail)
+	for name, account := range b.watchOnly {
+		if accountFilter != "" && name != accountFilter {
+			continue
+		}
+
+		for hash, txn := range account.txns {
+			if detail, ok := details[hash]; ok {
+				detail.Value += account.balanceDelta(txn.tx)
+				continue
+			}
+
+			var destAddresses []btcutil.Address
+			for _, txOut := range txn.tx.TxOut {
+				_, outAddresses, _, err :=
+					txscript.ExtractPkScriptAddrs(
+						txOut.PkScript, b.netParams,
+					)
+				if err != nil {
+					return nil, err
+				}
+
+				destAddresses = append(
+					destAddresses, outAddresses...,
+				)
+			}
+
+			var rawTx bytes.Buffer
+			if err := txn.tx.Serialize(&rawTx); err != nil {
+				return nil, err
+			}
+
+			detail := &lnwallet.TransactionDetail{
+				Hash:             hash,
+				Value:            account.balanceDelta(txn.tx),
+				NumConfirmations: account.numConfs(txn),
+				Timestamp:        txn.timestamp.Unix(),
+				DestAddresses:    destAddresses,
+				RawTx:            rawTx.Bytes(),
+			}
+			if txn.confirmed() {
+				blockHash := txn.blockHash
+				detail.BlockHash = &blockHash
+				detail.BlockHeight = txn.blockHeight
+			}
+
+			details[hash] = detail
+		}
+	}
+
+	return details, nil
+}
+
+// serializeWatchOnlyInfo serializes the key and sync state of a watch-only
+// account.
+func serializeWatchOnlyInfo(w io.Writer, a *watchOnlyAccount) error {
+	var buf [4]byte
+
+	if _, err := w.Write([]byte{byte(a.addrType)}); err != nil {
+		return err
+	}
+	for _, v := range []uint32{
+		a.masterKeyFingerprint, a.birthdayHeight,
+		a.nextIndex[externalBranch], a.nextIndex[internalBranch],
+		uint32(a.syncedHeight),
+	} {
+		byteOrder.PutUint32(buf[:], v)
+		if _, err := w.Write(buf[:]); err != nil {
+			return err
+		}
+	}
+	if _, err := w.Write(a.syncedHash[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write([]byte(a.accountKey.String()))
+	return err
+}
+
+// deserializeWatchOnlyInfo reconstructs a watch-only account from its
+// serialized key and sync state.
+func deserializeWatchOnlyInfo(name string, v []byte,
+	netParams *chaincfg.Params) (*watchOnlyAccount, error) {
+
+	const headerSize = 1 + 5*4 + 32
+	if len(v) < headerSize {
+		return nil, fmt.Errorf("invalid watch-only account record")
+	}
+
+	accountKey, err := hdkeychain.NewKeyFromString(string(v[headerSize:]))
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := newWatchOnlyAccount(
+		name, accountKey, byteOrder.Uint32(v[1:5]),
+		lnwallet.AddressType(v[0]), byteOrder.Uint32(v[5:9]),
+		netParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	account.nextIndex[externalBranch] = byteOrder.Uint32(v[9:13])
+	account.nextIndex[internalBranch] = byteOrder.Uint32(v[13:17])
+	account.syncedHeight = int32(byteOrder.Uint32(v[17:21]))
+	copy(account.syncedHash[:], v[21:headerSize])
+
+	if err := account.deriveLookahead(); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// serializeWatchOnlyTx serializes a transaction of a watch-only account along
+// with the block it confirmed in.
+func serializeWatchOnlyTx(w io.Writer, txn *watchOnlyTx) error {
+	var buf [8]byte
+
+	byteOrder.PutUint32(buf[:4], uint32(txn.blockHeight))
+	if _, err := w.Write(buf[:4]); err != nil {
+		return err
+	}
+	if _, err := w.Write(txn.blockHash[:]); err != nil {
+		return err
+	}
+	byteOrder.PutUint64(buf[:], uint64(txn.timestamp.Unix()))
+	if _, err := w.Write(buf[:]); err != nil {
+		return err
+	}
+
+	return txn.tx.Serialize(w)
+}
+
+// deserializeWatchOnlyTx reconstructs a transaction of a watch-only account.
+func deserializeWatchOnlyTx(v []byte) (*watchOnlyTx, error) {
+	const headerSize = 4 + 32 + 8
+	if len(v) < headerSize {
+		return nil, fmt.Errorf("invalid watch-only transaction record")
+	}
+
+	txn := &watchOnlyTx{
+		tx:          &wire.MsgTx{},
+		blockHeight: int32(byteOrder.Uint32(v[:4])),
+		timestamp: time.Unix(
+			int64(byteOrder.Uint64(v[36:headerSize])), 0,
+		),
+	}
+	copy(txn.blockHash[:], v[4:36])
+
+	err := txn.tx.Deserialize(bytes.NewReader(v[headerSize:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return txn, nil
+}
+
+// putWatchOnlyAccount persists the state of a watch-only account. Only the
+// transactions that changed since the account was last persisted are written.
+func (b *BtcWallet) putWatchOnlyAccount(a *watchOnlyAccount) error {
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		accounts := tx.ReadWriteBucket(watchOnlyBucketKey)
+		bucket, err := accounts.CreateBucketIfNotExists(
+			[]byte(a.name),
+		)
+		if err != nil {
+			return err
+		}
+
+		var info bytes.Buffer
+		if err := serializeWatchOnlyInfo(&info, a); err != nil {
+			return err
+		}
+		if err := bucket.Put(watchOnlyInfoKey, info.Bytes()); err != nil {
+			return err
+		}
+
+		// If the account is rescanned after a reorg, all previously
+		// stored transactions are removed.
+		if a.clearedTxns &&
+			bucket.NestedReadWriteBucket(watchOnlyTxnsKey) != nil {
+
+			err := bucket.DeleteNestedBucket(watchOnlyTxnsKey)
+			if err != nil {
+				return err
+			}
+		}
+		txns, err := bucket.CreateBucketIfNotExists(watchOnlyTxnsKey)
+		if err != nil {
+			return err
+		}
+
+		for hash := range a.dirtyTxns {
+			txn, ok := a.txns[hash]
+			if !ok {
+				if err := txns.Delete(hash[:]); err != nil {
+					return err
+				}
+				continue
+			}
+
+			var v bytes.Buffer
+			if err := serializeWatchOnlyTx(&v, txn); err != nil {
+				return err
+			}
+
+			if err := txns.Put(hash[:], v.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	a.dirtyTxns = make(map[chainhash.Hash]struct{})
+	a.clearedTxns = false
+
+	return nil
+}
+
+// loadWatchOnlyAccounts reads all imported watch-only accounts from the
+// wallet database into memory.
+func (b *BtcWallet) loadWatchOnlyAccounts() error {
+	b.watchOnlyMtx.Lock()
+	defer b.watchOnlyMtx.Unlock()
+
+	return walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		accounts := tx.ReadWriteBucket(watchOnlyBucketKey)
+		if accounts == nil {
+			var err error
+			accounts, err = tx.CreateTopLevelBucket(
+				watchOnlyBucketKey,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return accounts.ForEach(func(k, _ []byte) error {
+			bucket := accounts.NestedReadWriteBucket(k)
+			if bucket == nil {
+				return nil
+			}
+
+			account, err := deserializeWatchOnlyInfo(
+				string(k), bucket.Get(watchOnlyInfoKey),
+				b.netParams,
+			)
+			if err != nil {
+				return err
+			}
+
+			txns := bucket.NestedReadWriteBucket(watchOnlyTxnsKey)
+			if txns != nil {
+				err := txns.ForEach(func(k, v []byte) error {
+					txn, err := deserializeWatchOnlyTx(v)
+					if err != nil {
+						return err
+					}
+
+					var hash chainhash.Hash
+					copy(hash[:], k)
+					account.txns[hash] = txn
+
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			b.watchOnly[account.name] = account
+			return nil
+		})
+	})
+}
@@ -0,0 +1,191 @@
+package btcwallet
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// newTestWatchOnlyAccount creates a watch-only account from a fixed seed.
+func newTestWatchOnlyAccount(t *testing.T) *watchOnlyAccount {
+	t.Helper()
+
+	params := &chaincfg.RegressionNetParams
+	seed := make([]byte, hdkeychain.RecommendedSeedLen)
+	master, err := hdkeychain.NewMaster(seed, params)
+	if err != nil {
+		t.Fatalf("unable to create master key: %v", err)
+	}
+	accountKey, err := master.Neuter()
+	if err != nil {
+		t.Fatalf("unable to neuter key: %v", err)
+	}
+
+	account, err := newWatchOnlyAccount(
+		"cold", accountKey, 0, lnwallet.WitnessPubKey, 100, params,
+	)
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+
+	return account
+}
+
+// TestWatchOnlyUnconfirmed asserts that unconfirmed transactions paying to a
+// watch-only account are tracked and persisted, and that they're removed
+// once they conflict with a confirmed transaction.
+func TestWatchOnlyUnconfirmed(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "btcwallet-watchonly")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newTestWallet(t, dir)
+	if err := w.loadWatchOnlyAccounts(); err != nil {
+		t.Fatalf("unable to load accounts: %v", err)
+	}
+
+	account := newTestWatchOnlyAccount(t)
+	w.watchOnly[account.name] = account
+	if err := w.putWatchOnlyAccount(account); err != nil {
+		t.Fatalf("unable to store account: %v", err)
+	}
+
+	pkScript, err := txscript.PayToAddrScript(account.addrs[0][0])
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+
+	// We'll receive a payment to the account, along with an unrelated
+	// transaction that should be ignored.
+	payment := wire.NewMsgTx(2)
+	payment.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+	})
+	payment.AddTxOut(wire.NewTxOut(100000, pkScript))
+
+	unrelated := wire.NewMsgTx(2)
+	unrelated.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{2}},
+	})
+	unrelated.AddTxOut(wire.NewTxOut(100000, []byte{txscript.OP_TRUE}))
+
+	err = w.addUnconfirmedWatchOnlyTxns(
+		[]*wire.MsgTx{payment, unrelated},
+	)
+	if err != nil {
+		t.Fatalf("unable to add unconfirmed txns: %v", err)
+	}
+
+	assertUnspent := func(minConfs int32, expected int) {
+		t.Helper()
+
+		utxos, err := w.watchOnlyUnspent(minConfs, math.MaxInt32, "")
+		if err != nil {
+			t.Fatalf("unable to list unspent: %v", err)
+		}
+		if len(utxos) != expected {
+			t.Fatalf("expected %v utxos, got %v", expected,
+				len(utxos))
+		}
+	}
+	assertUnspent(0, 1)
+	assertUnspent(1, 0)
+
+	if account.nextIndex[externalBranch] != 1 {
+		t.Fatalf("expected address to be marked as used")
+	}
+	if len(account.dirtyTxns) != 0 {
+		t.Fatalf("expected all txns to be persisted")
+	}
+
+	// The unconfirmed payment should survive a restart.
+	if err := w.db.Close(); err != nil {
+		t.Fatalf("unable to close wallet db: %v", err)
+	}
+	w = newTestWallet(t, dir)
+	defer w.db.Close()
+
+	if err := w.loadWatchOnlyAccounts(); err != nil {
+		t.Fatalf("unable to load accounts: %v", err)
+	}
+	account = w.watchOnly[account.name]
+	if len(account.txns) != 1 {
+		t.Fatalf("expected 1 txn, got %v", len(account.txns))
+	}
+	txn := account.txns[payment.TxHash()]
+	if txn == nil || txn.confirmed() {
+		t.Fatalf("expected unconfirmed payment to be restored")
+	}
+	assertUnspent(0, 1)
+
+	// Once the payment confirms, we'll spend its output in an unconfirmed
+	// transaction that's then replaced by a confirmed one.
+	account.addTx(&watchOnlyTx{
+		tx:          payment,
+		blockHeight: 100,
+		timestamp:   time.Now(),
+	})
+	account.syncedHeight = 100
+
+	paymentOutPoint := wire.OutPoint{Hash: payment.TxHash()}
+	spend := wire.NewMsgTx(2)
+	spend.AddTxIn(&wire.TxIn{PreviousOutPoint: paymentOutPoint})
+	spend.AddTxOut(wire.NewTxOut(90000, []byte{txscript.OP_TRUE}))
+
+	err = w.addUnconfirmedWatchOnlyTxns([]*wire.MsgTx{spend})
+	if err != nil {
+		t.Fatalf("unable to add unconfirmed txns: %v", err)
+	}
+	assertUnspent(0, 0)
+
+	// The spent output should still be watched on-chain, as the spend
+	// hasn't confirmed yet.
+	req := account.filterRequest(nil)
+	if _, ok := req.WatchedOutPoints[paymentOutPoint]; !ok {
+		t.Fatalf("expected output spent by unconfirmed tx to be " +
+			"watched")
+	}
+
+	replacement := wire.NewMsgTx(2)
+	replacement.AddTxIn(&wire.TxIn{PreviousOutPoint: paymentOutPoint})
+	replacement.AddTxOut(wire.NewTxOut(80000, []byte{txscript.OP_TRUE}))
+	account.addTx(&watchOnlyTx{
+		tx:          replacement,
+		blockHeight: 101,
+		timestamp:   time.Now(),
+	})
+	account.pruneConflicts()
+
+	if _, ok := account.txns[spend.TxHash()]; ok {
+		t.Fatalf("expected conflicting unconfirmed tx to be removed")
+	}
+	if err := w.putWatchOnlyAccount(account); err != nil {
+		t.Fatalf("unable to store account: %v", err)
+	}
+
+	// Finally, the removal should be persisted as well.
+	if err := w.loadWatchOnlyAccounts(); err != nil {
+		t.Fatalf("unable to load accounts: %v", err)
+	}
+	account = w.watchOnly[account.name]
+	if _, ok := account.txns[spend.TxHash()]; ok {
+		t.Fatalf("expected conflicting unconfirmed tx to be removed " +
+			"from disk")
+	}
+	if len(account.txns) != 2 {
+		t.Fatalf("expected 2 txns, got %v", len(account.txns))
+	}
+}
@@ -59,6 +59,12 @@ var (
 	ErrBelowChanReserve = fmt.Errorf("commitment transaction dips peer " +
 		"below chan reserve")
 
+	// ErrDustExposureExceeded is returned when a proposed HTLC or fee
+	// update would push the total value of trimmed HTLCs on either
+	// commitment beyond the channel's maximum dust exposure.
+	ErrDustExposureExceeded = fmt.Errorf("commitment transaction exceeds " +
+		"max dust exposure")
+
 	// ErrBelowMinHTLC is returned when a proposed HTLC has a value that
 	// is below the minimum HTLC value constraint for either us or our
 	// peer depending on which flags are set.
@@ -272,6 +278,16 @@ type PaymentDescriptor struct {
 	// switch.
 	OpenCircuitKey *channeldb.CircuitKey
 
+	// ExceedsDustExposure is set for Add HTLCs received from the remote
+	// party that pushed the total value of trimmed HTLCs on either
+	// commitment beyond the channel's maximum dust exposure. Such HTLCs
+	// should be failed back rather than forwarded.
+	//
+	// NOTE: This field is only populated for payment descriptors in the
+	// *remote* update log, and is not persisted. Adds that are restored
+	// from disk should use RemoteAddExceedsDustExposure instead.
+	ExceedsDustExposure bool
+
 	// ClosedCircuitKey references the incoming Chan/HTLC ID of the Add HTLC
 	// that opened the circuit.
 	//
@@ -1379,12 +1395,30 @@ type LightningChannel struct {
 	// channel.
 	RemoteFundingKey *btcec.PublicKey
 
+	// maxDustExposure is the maximum total value of trimmed HTLCs we
+	// allow on either commitment. Trimmed HTLCs don't get an output of
+	// their own, so their value is burned to miners if the channel is
+	// force closed. A value of zero disables the limit.
+	maxDustExposure lnwire.MilliSatoshi
+
 	// log is a channel-specific logging instance.
 	log btclog.Logger
 
 	sync.RWMutex
 }
 
+// ChannelOpt is a functional option that can be used to modify the default
+// behavior of a LightningChannel.
+type ChannelOpt func(*LightningChannel)
+
+// WithMaxDustExposure sets the maximum total value of trimmed HTLCs the
+// channel allows on either commitment. A value of zero disables the limit.
+func WithMaxDustExposure(maxDust lnwire.MilliSatoshi) ChannelOpt {
+	return func(lc *LightningChannel) {
+		lc.maxDustExposure = maxDust
+	}
+}
+
 // NewLightningChannel creates a new, active payment channel given an
 // implementation of the chain notifier, channel database, and the current
 // settled channel state. Throughout state transitions, then channel will
@@ -1392,7 +1426,7 @@ type LightningChannel struct {
 // manner.
 func NewLightningChannel(signer input.Signer,
 	state *channeldb.OpenChannel,
-	sigPool *SigPool) (*LightningChannel, error) {
+	sigPool *SigPool, opts ...ChannelOpt) (*LightningChannel, error) {
 
 	localCommit := state.LocalCommitment
 	remoteCommit := state.RemoteCommitment
@@ -1426,6 +1460,10 @@ func NewLightningChannel(signer input.Signer,
 		log:               build.NewPrefixLog(logPrefix, walletLog),
 	}
 
+	for _, opt := range opts {
+		opt(lc)
+	}
+
 	// With the main channel struct reconstructed, we'll now restore the
 	// commitment state in memory and also the update logs themselves.
 	err := lc.restoreCommitState(&localCommit, &remoteCommit)
@@ -1919,8 +1957,15 @@ type BreachRetribution struct {
 	// BreachTransaction is the transaction which breached the channel
 	// contract by spending from the funding multi-sig with a revoked
 	// commitment transaction.
+	//
+	// NOTE: This is nil if the retribution was created before the revoked
+	// state was broadcast, as the revocation log doesn't retain the full
+	// commitment transaction.
 	BreachTransaction *wire.MsgTx
 
+	// BreachTxHash is the txid of the revoked commitment transaction.
+	BreachTxHash chainhash.Hash
+
 	// BreachHeight records the block height confirming the breach
 	// transaction, used as a height hint when registering for
 	// confirmations.
@@ -1934,10 +1979,6 @@ type BreachRetribution struct {
 	// RevokedStateNum is the revoked state number which was broadcast.
 	RevokedStateNum uint64
 
-	// PendingHTLCs is a slice of the HTLCs which were pending at this
-	// point within the channel's history transcript.
-	PendingHTLCs []channeldb.HTLC
-
 	// LocalOutputSignDesc is a SignDescriptor which is capable of
 	// generating the signature necessary to sweep the output within the
 	// BreachTransaction that pays directly us.
@@ -1979,18 +2020,27 @@ type BreachRetribution struct {
 
 // NewBreachRetribution creates a new fully populated BreachRetribution for the
 // passed channel, at a particular revoked state number, and one which targets
-// the passed commitment transaction.
+// the passed commitment transaction. The spending transaction may be nil if the
+// revoked state hasn't been broadcast, e.g. when backing it up to a tower.
 func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
-	breachHeight uint32) (*BreachRetribution, error) {
+	breachHeight uint32, spendTx *wire.MsgTx) (*BreachRetribution, error) {
 
-	// Query the on-disk revocation log for the snapshot which was recorded
-	// at this particular state num.
-	revokedSnapshot, err := chanState.FindPreviousState(stateNum)
+	// Query the on-disk revocation log for the compact snapshot which was
+	// recorded at this particular state num.
+	revokedLog, err := chanState.FindPreviousState(stateNum)
 	if err != nil {
 		return nil, err
 	}
 
-	commitHash := revokedSnapshot.CommitTx.TxHash()
+	commitHash := revokedLog.CommitTxHash
+
+	// If we know the transaction that was broadcast, make sure it's the
+	// revoked commitment we're about to build the retribution for.
+	if spendTx != nil && spendTx.TxHash() != commitHash {
+		return nil, fmt.Errorf("spending tx %v doesn't match revoked "+
+			"commitment %v for state %v", spendTx.TxHash(),
+			commitHash, stateNum)
+	}
 
 	// With the state number broadcast known, we can now derive/restore the
 	// proper revocation preimage necessary to sweep the remote party's
@@ -2030,38 +2080,31 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		return nil, err
 	}
 
-	// In order to fully populate the breach retribution struct, we'll need
-	// to find the exact index of the local+remote commitment outputs.
+	// The revocation log recorded the exact index of the local+remote
+	// commitment outputs, which we'll use to fully populate the breach
+	// retribution struct.
 	localOutpoint := wire.OutPoint{
 		Hash: commitHash,
 	}
 	remoteOutpoint := wire.OutPoint{
 		Hash: commitHash,
 	}
-	for i, txOut := range revokedSnapshot.CommitTx.TxOut {
-		switch {
-		case bytes.Equal(txOut.PkScript, localPkScript):
-			localOutpoint.Index = uint32(i)
-		case bytes.Equal(txOut.PkScript, remoteWitnessHash):
-			remoteOutpoint.Index = uint32(i)
-		}
-	}
 
 	// Conditionally instantiate a sign descriptor for each of the
-	// commitment outputs. If either is considered dust using the remote
+	// commitment outputs. If either was trimmed as dust using the remote
 	// party's dust limit, the respective sign descriptor will be nil.
 	var (
 		localSignDesc  *input.SignDescriptor
 		remoteSignDesc *input.SignDescriptor
 	)
 
-	// Compute the local and remote balances in satoshis.
-	localAmt := revokedSnapshot.LocalBalance.ToSatoshis()
-	remoteAmt := revokedSnapshot.RemoteBalance.ToSatoshis()
+	localAmt := revokedLog.OurBalance
+	remoteAmt := revokedLog.TheirBalance
 
-	// If the local balance exceeds the remote party's dust limit,
-	// instantiate the local sign descriptor.
-	if localAmt >= chanState.RemoteChanCfg.DustLimit {
+	// If the local output is present, instantiate the local sign
+	// descriptor.
+	if revokedLog.OurOutputIndex != channeldb.OutputIndexEmpty {
+		localOutpoint.Index = uint32(revokedLog.OurOutputIndex)
 		localSignDesc = &input.SignDescriptor{
 			SingleTweak:   keyRing.LocalCommitKeyTweak,
 			KeyDesc:       chanState.LocalChanCfg.PaymentBasePoint,
@@ -2080,9 +2123,10 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		}
 	}
 
-	// Similarly, if the remote balance exceeds the remote party's dust
-	// limit, assemble the remote sign descriptor.
-	if remoteAmt >= chanState.RemoteChanCfg.DustLimit {
+	// Similarly, if the remote output is present, assemble the remote
+	// sign descriptor.
+	if revokedLog.TheirOutputIndex != channeldb.OutputIndexEmpty {
+		remoteOutpoint.Index = uint32(revokedLog.TheirOutputIndex)
 		remoteSignDesc = &input.SignDescriptor{
 			KeyDesc:       chanState.LocalChanCfg.RevocationBasePoint,
 			DoubleTweak:   commitmentSecret,
@@ -2097,24 +2141,17 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 
 	// With the commitment outputs located, we'll now generate all the
 	// retribution structs for each of the HTLC transactions active on the
-	// remote commitment transaction.
-	htlcRetributions := make([]HtlcRetribution, 0, len(revokedSnapshot.Htlcs))
-	for _, htlc := range revokedSnapshot.Htlcs {
+	// remote commitment transaction. The revocation log only retains the
+	// HTLCs that weren't dust, so each of them has an output to sweep.
+	htlcRetributions := make(
+		[]HtlcRetribution, 0, len(revokedLog.HTLCEntries),
+	)
+	for _, htlc := range revokedLog.HTLCEntries {
 		var (
 			htlcWitnessScript []byte
 			err               error
 		)
 
-		// If the HTLC is dust, then we'll skip it as it doesn't have
-		// an output on the commitment transaction.
-		if htlcIsDust(
-			htlc.Incoming, false,
-			SatPerKWeight(revokedSnapshot.FeePerKw),
-			htlc.Amt.ToSatoshis(), chanState.RemoteChanCfg.DustLimit,
-		) {
-			continue
-		}
-
 		// We'll generate the original second level witness script now,
 		// as we'll need it if we're revoking an HTLC output on the
 		// remote commitment transaction, and *they* go to the second
@@ -2164,7 +2201,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 				WitnessScript: htlcWitnessScript,
 				Output: &wire.TxOut{
 					PkScript: htlcPkScript,
-					Value:    int64(htlc.Amt.ToSatoshis()),
+					Value:    int64(htlc.Amt),
 				},
 				HashType: txscript.SigHashAll,
 			},
@@ -2182,10 +2219,10 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 	// swiftly bring justice to the cheating remote party.
 	return &BreachRetribution{
 		ChainHash:            chanState.ChainHash,
-		BreachTransaction:    revokedSnapshot.CommitTx,
+		BreachTransaction:    spendTx,
+		BreachTxHash:         commitHash,
 		BreachHeight:         breachHeight,
 		RevokedStateNum:      stateNum,
-		PendingHTLCs:         revokedSnapshot.Htlcs,
 		LocalOutpoint:        localOutpoint,
 		LocalOutputSignDesc:  localSignDesc,
 		RemoteOutpoint:       remoteOutpoint,
@@ -4531,6 +4568,12 @@ func (lc *LightningChannel) AddHTLC(htlc *lnwire.UpdateAddHTLC,
 		return 0, err
 	}
 
+	// We also won't add the HTLC if it would push our dust exposure beyond
+	// the configured maximum.
+	if lc.exceedsDustExposure(pd, nil) {
+		return 0, ErrDustExposureExceeded
+	}
+
 	lc.localUpdateLog.appendHtlc(pd)
 
 	return pd.HtlcIndex, nil
@@ -4558,6 +4601,17 @@ func (lc *LightningChannel) ReceiveHTLC(htlc *lnwire.UpdateAddHTLC) (uint64, err
 		OnionBlob: htlc.OnionBlob[:],
 	}
 
+	// The remote party is free to add an HTLC that exceeds our dust
+	// exposure, so we can't refuse it at this point. Instead, we'll mark
+	// it such that it will be failed back once it's locked in.
+	if lc.exceedsDustExposure(nil, pd) {
+		lc.log.Debugf("Received HTLC(%x) with index %v exceeds max dust "+
+			"exposure of %v", pd.RHash[:], pd.HtlcIndex,
+			lc.maxDustExposure)
+
+		pd.ExceedsDustExposure = true
+	}
+
 	lc.remoteUpdateLog.appendHtlc(pd)
 
 	return pd.HtlcIndex, nil
@@ -6050,6 +6104,13 @@ func (lc *LightningChannel) UpdateFee(feePerKw SatPerKWeight) error {
 		EntryType: FeeUpdate,
 	}
 
+	// A higher fee rate causes more HTLCs to be trimmed, so we'll make
+	// sure the new fee rate doesn't push our dust exposure beyond the
+	// configured maximum.
+	if lc.exceedsDustExposure(pd, nil) {
+		return ErrDustExposureExceeded
+	}
+
 	lc.localUpdateLog.appendUpdate(pd)
 
 	return nil
@@ -6074,11 +6135,146 @@ func (lc *LightningChannel) ReceiveUpdateFee(feePerKw SatPerKWeight) error {
 		EntryType: FeeUpdate,
 	}
 
+	// A higher fee rate causes more HTLCs to be trimmed. We can't refuse
+	// a fee update without failing the channel, so we'll accept it even if
+	// it pushes our dust exposure beyond the configured maximum. Any new
+	// HTLCs that further increase the exposure will be failed back.
+	if lc.exceedsDustExposure(nil, pd) {
+		lc.log.Warnf("Fee update to %v sat/kw pushes dust exposure "+
+			"beyond max of %v", int64(feePerKw), lc.maxDustExposure)
+	}
+
 	lc.remoteUpdateLog.appendUpdate(pd)
 
 	return nil
 }
 
+// exceedsDustExposure returns true if adding the passed updates to the local
+// and remote update logs, respectively, would increase the total value of
+// trimmed HTLCs on either commitment beyond the maximum dust exposure. All
+// pending updates are taken into account, including those that haven't been
+// committed yet. Updates that don't increase the dust exposure are always
+// allowed, even if the channel is already beyond the maximum.
+//
+// NOTE: This method MUST be called with the channel's lock held.
+func (lc *LightningChannel) exceedsDustExposure(ourUpdate,
+	theirUpdate *PaymentDescriptor) bool {
+
+	if lc.maxDustExposure == 0 {
+		return false
+	}
+
+	for _, remoteChain := range []bool{false, true} {
+		view := lc.fetchHTLCView(
+			lc.remoteUpdateLog.logIndex, lc.localUpdateLog.logIndex,
+		)
+		curDust := lc.dustExposure(view, remoteChain)
+
+		if ourUpdate != nil {
+			view.ourUpdates = append(view.ourUpdates, ourUpdate)
+		}
+		if theirUpdate != nil {
+			view.theirUpdates = append(view.theirUpdates, theirUpdate)
+		}
+		newDust := lc.dustExposure(view, remoteChain)
+
+		if newDust > lc.maxDustExposure && newDust > curDust {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemoteAddExceedsDustExposure returns true if the passed Add HTLC, which
+// must already be locked in, is trimmed on a commitment whose total dust
+// exposure is beyond the channel's maximum. Only HTLCs the remote party added
+// up to and including the passed HTLC are taken into account, matching the
+// state at the time it was received. This allows callers to recompute the
+// ExceedsDustExposure flag for Adds that were restored from disk, e.g. when
+// replaying a forwarding package.
+func (lc *LightningChannel) RemoteAddExceedsDustExposure(
+	htlc *PaymentDescriptor) bool {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if lc.maxDustExposure == 0 {
+		return false
+	}
+
+	for _, remoteChain := range []bool{false, true} {
+		dustLimit := lc.localChanCfg.DustLimit
+		if remoteChain {
+			dustLimit = lc.remoteChanCfg.DustLimit
+		}
+
+		view := lc.fetchHTLCView(
+			lc.remoteUpdateLog.logIndex, lc.localUpdateLog.logIndex,
+		)
+
+		// Leave out any HTLCs the remote party added after this one,
+		// as they didn't contribute to the exposure at the time.
+		theirUpdates := view.theirUpdates[:0]
+		for _, pd := range view.theirUpdates {
+			if pd.EntryType == Add && pd.HtlcIndex > htlc.HtlcIndex {
+				continue
+			}
+			theirUpdates = append(theirUpdates, pd)
+		}
+		view.theirUpdates = theirUpdates
+
+		_, _, _, filteredView := lc.computeView(view, remoteChain, false)
+
+		if !htlcIsDust(!remoteChain, !remoteChain, filteredView.feePerKw,
+			htlc.Amount.ToSatoshis(), dustLimit) {
+
+			continue
+		}
+
+		if lc.dustExposure(view, remoteChain) > lc.maxDustExposure {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dustExposure returns the total value of the HTLCs that would be trimmed from
+// the local or remote commitment after applying the updates within the passed
+// view.
+func (lc *LightningChannel) dustExposure(view *htlcView,
+	remoteChain bool) lnwire.MilliSatoshi {
+
+	dustLimit := lc.localChanCfg.DustLimit
+	if remoteChain {
+		dustLimit = lc.remoteChanCfg.DustLimit
+	}
+
+	// We'll evaluate the view without mutating any state, leaving us with
+	// the HTLCs that remain active and the resulting fee rate.
+	_, _, _, filteredView := lc.computeView(view, remoteChain, false)
+	feePerKw := filteredView.feePerKw
+
+	var dust lnwire.MilliSatoshi
+	for _, htlc := range filteredView.ourUpdates {
+		if htlcIsDust(remoteChain, !remoteChain, feePerKw,
+			htlc.Amount.ToSatoshis(), dustLimit) {
+
+			dust += htlc.Amount
+		}
+	}
+	for _, htlc := range filteredView.theirUpdates {
+		if htlcIsDust(!remoteChain, !remoteChain, feePerKw,
+			htlc.Amount.ToSatoshis(), dustLimit) {
+
+			dust += htlc.Amount
+		}
+	}
+
+	return dust
+}
+
 // generateRevocation generates the revocation message for a given height.
 func (lc *LightningChannel) generateRevocation(height uint64) (*lnwire.RevokeAndAck,
 	error) {
@@ -5772,7 +5772,7 @@ func TestNewBreachRetributionSkipsDustHtlcs(t *testing.T) {
 	// At this point, we'll now simulate a contract breach by Bob using the
 	// NewBreachRetribution method.
 	breachRet, err := NewBreachRetribution(
-		aliceChannel.channelState, revokedStateNum, 100,
+		aliceChannel.channelState, revokedStateNum, 100, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to create breach retribution: %v", err)
@@ -5786,6 +5786,87 @@ func TestNewBreachRetributionSkipsDustHtlcs(t *testing.T) {
 	}
 }
 
+// TestNewBreachRetributionSpendTx asserts that a breach retribution can be
+// created without the transaction spending the funding output, as done when
+// backing up revoked states to a watchtower, and that the spending transaction
+// must match the revoked commitment if it is given.
+func TestNewBreachRetributionSpendTx(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(true)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll capture Bob's current commitment as known to Alice, which will
+	// be revoked once Alice adds an HTLC and the state transition
+	// completes.
+	remoteCommit := aliceChannel.channelState.RemoteCommitment
+	revokedTx := remoteCommit.CommitTx
+	currentStateNum := remoteCommit.CommitHeight
+
+	htlcAmt := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	htlc, _ := createHTLC(0, htlcAmt)
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("unable to recv htlc: %v", err)
+	}
+	if err := ForceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state transition: %v", err)
+	}
+
+	// Without the spending transaction, the retribution should still
+	// target the revoked commitment, as done by the link for tower
+	// backups.
+	state := aliceChannel.State()
+	revokedStateNum := state.RemoteCommitment.CommitHeight - 1
+	if revokedStateNum != currentStateNum {
+		t.Fatalf("expected revoked state %v, got %v", currentStateNum,
+			revokedStateNum)
+	}
+
+	retribution, err := NewBreachRetribution(
+		state, revokedStateNum, 0, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to create breach retribution: %v", err)
+	}
+	if retribution.BreachTransaction != nil {
+		t.Fatalf("expected no breach transaction")
+	}
+	if retribution.BreachTxHash != revokedTx.TxHash() {
+		t.Fatalf("expected breach txid %v, got %v", revokedTx.TxHash(),
+			retribution.BreachTxHash)
+	}
+
+	// Given the revoked commitment, the retribution should be the same,
+	// besides the breach transaction being set.
+	txRetribution, err := NewBreachRetribution(
+		state, revokedStateNum, 0, revokedTx,
+	)
+	if err != nil {
+		t.Fatalf("unable to create breach retribution: %v", err)
+	}
+	if txRetribution.BreachTransaction != revokedTx {
+		t.Fatalf("expected breach transaction to be set")
+	}
+	txRetribution.BreachTransaction = nil
+	if !reflect.DeepEqual(retribution, txRetribution) {
+		t.Fatalf("retribution mismatch: expected %v, got %v",
+			spew.Sdump(retribution), spew.Sdump(txRetribution))
+	}
+
+	// A transaction other than the revoked commitment should be rejected.
+	currentTx := state.RemoteCommitment.CommitTx
+	_, err = NewBreachRetribution(state, revokedStateNum, 0, currentTx)
+	if err == nil {
+		t.Fatalf("expected mismatching spend tx to be rejected")
+	}
+}
+
 // compareHtlcs compares two PaymentDescriptors.
 func compareHtlcs(htlc1, htlc2 *PaymentDescriptor) error {
 	if htlc1.LogIndex != htlc2.LogIndex {
@@ -6638,3 +6719,126 @@ func TestChannelMaxFeeRate(t *testing.T) {
 	assertMaxFeeRate(0.000001, 690)
 	assertMaxFeeRate(0.0000001, FeePerKwFloor)
 }
+
+// TestMaxDustExposure asserts that HTLCs and fee updates that would push the
+// total value of trimmed HTLCs beyond the channel's maximum dust exposure are
+// rejected, or flagged if they were added by the remote party.
+func TestMaxDustExposure(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(true)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Both HTLCs below are trimmed from Bob's commitment, as they're
+	// below his dust limit.
+	aliceChannel.maxDustExposure = lnwire.NewMSatFromSatoshis(2500)
+	bobChannel.maxDustExposure = lnwire.NewMSatFromSatoshis(1500)
+
+	htlcAmt := lnwire.NewMSatFromSatoshis(1000)
+	for i := 0; i < 2; i++ {
+		htlc, _ := createHTLC(i, htlcAmt)
+		if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+			t.Fatalf("unable to add htlc: %v", err)
+		}
+		if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+			t.Fatalf("unable to recv htlc: %v", err)
+		}
+	}
+
+	// Only the second HTLC pushed Bob's dust exposure beyond his limit,
+	// so it should be the only one that's flagged.
+	for i, expected := range []bool{false, true} {
+		pd := bobChannel.remoteUpdateLog.lookupHtlc(uint64(i))
+		if pd.ExceedsDustExposure != expected {
+			t.Fatalf("expected htlc %v to have ExceedsDustExposure=%v",
+				i, expected)
+		}
+	}
+
+	// The flag isn't persisted, so once both HTLCs are locked in and Bob
+	// restarts, he should arrive at the same result by recomputing it.
+	if err := ForceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state transition: %v", err)
+	}
+	bobChannel, err = restartChannel(bobChannel)
+	if err != nil {
+		t.Fatalf("unable to restart bob: %v", err)
+	}
+	bobChannel.maxDustExposure = lnwire.NewMSatFromSatoshis(1500)
+
+	for i, expected := range []bool{false, true} {
+		pd := bobChannel.remoteUpdateLog.lookupHtlc(uint64(i))
+		if pd.ExceedsDustExposure {
+			t.Fatalf("expected restored htlc %v to not be flagged", i)
+		}
+		if bobChannel.RemoteAddExceedsDustExposure(pd) != expected {
+			t.Fatalf("expected htlc %v to exceed dust exposure: %v",
+				i, expected)
+		}
+	}
+
+	// A third dust HTLC would push Alice's dust exposure beyond her
+	// limit, so she should refuse to add it.
+	htlc, _ := createHTLC(2, htlcAmt)
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	if err != ErrDustExposureExceeded {
+		t.Fatalf("expected ErrDustExposureExceeded, got %v", err)
+	}
+
+	// HTLCs that aren't trimmed don't count towards the dust exposure.
+	htlc, _ = createHTLC(
+		3, lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin),
+	)
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("unable to add htlc: %v", err)
+	}
+}
+
+// TestMaxDustExposureFeeUpdate asserts that we refuse to send a fee update
+// that would cause HTLCs to be trimmed beyond the channel's maximum dust
+// exposure, while still accepting such an update from the remote party.
+func TestMaxDustExposureFeeUpdate(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(true)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	aliceChannel.maxDustExposure = lnwire.NewMSatFromSatoshis(10000)
+	bobChannel.maxDustExposure = lnwire.NewMSatFromSatoshis(10000)
+
+	// Add an HTLC that isn't trimmed at the current fee rate.
+	htlc, _ := createHTLC(0, lnwire.NewMSatFromSatoshis(20000))
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("unable to recv htlc: %v", err)
+	}
+
+	// At this fee rate, the HTLC would be trimmed from both commitments,
+	// exceeding the limit on either side. Alice should refuse to send
+	// the update, but Bob can't refuse it without failing the channel.
+	highFee := SatPerKWeight(50000)
+	if err := aliceChannel.UpdateFee(highFee); err != ErrDustExposureExceeded {
+		t.Fatalf("expected ErrDustExposureExceeded, got %v", err)
+	}
+	if err := bobChannel.ReceiveUpdateFee(highFee); err != nil {
+		t.Fatalf("unable to recv fee update: %v", err)
+	}
+
+	// A more modest fee rate doesn't trim the HTLC, so it should be
+	// accepted by both parties.
+	lowFee := SatPerKWeight(7000)
+	if err := aliceChannel.UpdateFee(lowFee); err != nil {
+		t.Fatalf("unable to update fee: %v", err)
+	}
+	if err := bobChannel.ReceiveUpdateFee(lowFee); err != nil {
+		t.Fatalf("unable to recv fee update: %v", err)
+	}
+}
@@ -4,15 +4,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	prand "math/rand"
 	"net"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/electrum"
+	"github.com/lightningnetwork/lnd/esplora"
 )
 
 const (
@@ -38,6 +42,12 @@ const (
 	// maxFeeUpdateTimeout represents the maximum interval in which a
 	// WebAPIFeeEstimator will request fresh fees from its API.
 	maxFeeUpdateTimeout = 20 * time.Minute
+
+	// maxFeeCacheAge is the maximum age of the fees cached by a
+	// WebAPIFeeEstimator. Once the API has failed to serve fresh fees for
+	// this long, the cached fees are considered stale and no longer
+	// returned, allowing callers to fall back to another source.
+	maxFeeCacheAge = 3 * maxFeeUpdateTimeout
 )
 
 // SatPerKVByte represents a fee rate in sat/kb.
@@ -495,6 +505,225 @@ func (b *BitcoindFeeEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight,
 // FeeEstimator interface.
 var _ FeeEstimator = (*BitcoindFeeEstimator)(nil)
 
+// ElectrumFeeEstimator is an implementation of the FeeEstimator interface
+// backed by an Electrum server. This implementation will proxy any fee
+// estimation requests to the server, which in turn proxies them to its
+// backing bitcoind node.
+type ElectrumFeeEstimator struct {
+	// fallbackFeePerKW is the fallback fee rate in sat/kw that is returned
+	// if the fee estimator does not yet have enough data to actually
+	// produce fee estimates.
+	fallbackFeePerKW SatPerKWeight
+
+	// minFeePerKW is the minimum fee, in sat/kw, that we should enforce.
+	// This will be used as the default fee rate for a transaction when the
+	// estimated fee rate is too low to allow the transaction to propagate
+	// through the network.
+	minFeePerKW SatPerKWeight
+
+	client *electrum.Client
+}
+
+// NewElectrumFeeEstimator creates a new ElectrumFeeEstimator given an Electrum
+// client, and also a fall back fee rate. The fallback fee rate is used in the
+// occasion that the server is unable to estimate fees. The client is shared
+// and is expected to be started and stopped by the caller.
+func NewElectrumFeeEstimator(client *electrum.Client,
+	fallBackFeeRate SatPerKWeight) *ElectrumFeeEstimator {
+
+	return &ElectrumFeeEstimator{
+		fallbackFeePerKW: fallBackFeeRate,
+		client:           client,
+	}
+}
+
+// Start signals the FeeEstimator to start any processes or goroutines
+// it needs to perform its duty.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *ElectrumFeeEstimator) Start() error {
+	// We'll query the server for its minimum relay fee, which is
+	// expressed in sat/kb, so we'll manually convert it to our desired
+	// sat/kw rate.
+	relayFee, err := e.client.RelayFee()
+	if err != nil {
+		return err
+	}
+	minRelayFeePerKw := SatPerKVByte(relayFee).FeePerKWeight()
+
+	// By default, we'll use the server's minimum relay fee as the minimum
+	// fee rate we'll propose for transacations. However, if this happens
+	// to be lower than our fee floor, we'll enforce that instead.
+	e.minFeePerKW = minRelayFeePerKw
+	if e.minFeePerKW < FeePerKwFloor {
+		e.minFeePerKW = FeePerKwFloor
+	}
+
+	walletLog.Debugf("Using minimum fee rate of %v sat/kw",
+		int64(e.minFeePerKW))
+
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used
+// by the fee estimator.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *ElectrumFeeEstimator) Stop() error {
+	return nil
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *ElectrumFeeEstimator) EstimateFeePerKW(
+	numBlocks uint32) (SatPerKWeight, error) {
+
+	satPerKB, err := e.client.EstimateFee(numBlocks)
+	switch {
+	// If the server doesn't have enough data, or returns an error, then
+	// to return a proper value, then we'll return the default fall back
+	// fee rate.
+	case err != nil:
+		walletLog.Errorf("unable to query estimator: %v", err)
+		fallthrough
+
+	case satPerKB <= 0:
+		return e.fallbackFeePerKW, nil
+	}
+
+	// Since we use fee rates in sat/kw internally, we'll convert the
+	// estimated fee rate from its sat/kb representation to sat/kw, and
+	// enforce our fee floor.
+	satPerKw := SatPerKVByte(satPerKB).FeePerKWeight()
+	if satPerKw < e.minFeePerKW {
+		walletLog.Debugf("Estimated fee rate of %v sat/kw is too low, "+
+			"using fee floor of %v sat/kw instead", satPerKw,
+			e.minFeePerKW)
+		satPerKw = e.minFeePerKW
+	}
+
+	walletLog.Debugf("Returning %v sat/kw for conf target of %v",
+		int64(satPerKw), numBlocks)
+
+	return satPerKw, nil
+}
+
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *ElectrumFeeEstimator) RelayFeePerKW() SatPerKWeight {
+	return e.minFeePerKW
+}
+
+// A compile-time assertion to ensure that ElectrumFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*ElectrumFeeEstimator)(nil)
+
+// EsploraFeeEstimator is an implementation of the FeeEstimator interface
+// backed by an Esplora server. The server's fee estimates are fetched for
+// every estimation request, as they're served for a sparse set of
+// confirmation targets at once.
+type EsploraFeeEstimator struct {
+	// fallbackFeePerKW is the fallback fee rate in sat/kw that is returned
+	// if the server is unable to produce fee estimates.
+	fallbackFeePerKW SatPerKWeight
+
+	client *esplora.Client
+}
+
+// NewEsploraFeeEstimator creates a new EsploraFeeEstimator given an Esplora
+// client, and also a fall back fee rate. The fallback fee rate is used in the
+// occasion that the server is unable to estimate fees. The client is shared
+// and is expected to be started and stopped by the caller.
+func NewEsploraFeeEstimator(client *esplora.Client,
+	fallBackFeeRate SatPerKWeight) *EsploraFeeEstimator {
+
+	return &EsploraFeeEstimator{
+		fallbackFeePerKW: fallBackFeeRate,
+		client:           client,
+	}
+}
+
+// Start signals the FeeEstimator to start any processes or goroutines
+// it needs to perform its duty.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *EsploraFeeEstimator) Start() error {
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used
+// by the fee estimator.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *EsploraFeeEstimator) Stop() error {
+	return nil
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *EsploraFeeEstimator) EstimateFeePerKW(
+	numBlocks uint32) (SatPerKWeight, error) {
+
+	if numBlocks > maxBlockTarget {
+		numBlocks = maxBlockTarget
+	} else if numBlocks < minBlockTarget {
+		return 0, fmt.Errorf("conf target of %v is too low, minimum "+
+			"accepted is %v", numBlocks, minBlockTarget)
+	}
+
+	estimates, err := e.client.FeeEstimates()
+	if err != nil {
+		walletLog.Errorf("unable to query estimator: %v", err)
+		return e.fallbackFeePerKW, nil
+	}
+
+	// The server only provides estimates for a subset of confirmation
+	// targets, so we'll use the estimate of the closest target below the
+	// requested one, as it'll confirm within the requested target.
+	var satPerKB btcutil.Amount
+	for target := numBlocks; target >= minBlockTarget; target-- {
+		if estimate, ok := estimates[target]; ok {
+			satPerKB = estimate
+			break
+		}
+	}
+	if satPerKB <= 0 {
+		return e.fallbackFeePerKW, nil
+	}
+
+	// Since we use fee rates in sat/kw internally, we'll convert the
+	// estimated fee rate from its sat/kb representation to sat/kw, and
+	// enforce our fee floor.
+	satPerKw := SatPerKVByte(satPerKB).FeePerKWeight()
+	if satPerKw < FeePerKwFloor {
+		satPerKw = FeePerKwFloor
+	}
+
+	walletLog.Debugf("Returning %v sat/kw for conf target of %v",
+		int64(satPerKw), numBlocks)
+
+	return satPerKw, nil
+}
+
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed. As Esplora servers don't expose the relay fee of their backing
+// node, our fee floor is used.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *EsploraFeeEstimator) RelayFeePerKW() SatPerKWeight {
+	return FeePerKwFloor
+}
+
+// A compile-time assertion to ensure that EsploraFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*EsploraFeeEstimator)(nil)
+
 // WebAPIFeeSource is an interface allows the WebAPIFeeEstimator to query an
 // arbitrary HTTP-based fee estimator. Each new set/network will gain an
 // implementation of this interface in order to allow the WebAPIFeeEstimator to
@@ -573,6 +802,10 @@ type WebAPIFeeEstimator struct {
 	feesMtx          sync.Mutex
 	feeByBlockTarget map[uint32]uint32
 
+	// lastUpdate is the time at which the cached fees were last
+	// successfully refreshed from the API.
+	lastUpdate time.Time
+
 	// defaultFeePerKw is a fallback value that we'll use if we're unable
 	// to query the API for any reason.
 	defaultFeePerKw SatPerKWeight
@@ -678,11 +911,20 @@ func (w *WebAPIFeeEstimator) randomFeeUpdateTimeout() time.Duration {
 
 // getCachedFee takes in a target for the number of blocks until an initial
 // confirmation and returns an estimated fee (if one was returned by the API). If
-// the fee was not previously cached, we cache it here.
+// the fee was not previously cached, we cache it here. An error is returned if
+// the API hasn't served fresh fees within the maximum cache age.
 func (w *WebAPIFeeEstimator) getCachedFee(numBlocks uint32) (uint32, error) {
 	w.feesMtx.Lock()
 	defer w.feesMtx.Unlock()
 
+	if !w.lastUpdate.IsZero() {
+		age := time.Since(w.lastUpdate)
+		if age > maxFeeCacheAge {
+			return 0, fmt.Errorf("web API fee estimates are "+
+				"stale, last updated %v ago", age)
+		}
+	}
+
 	// Search our cached fees for the desired block target. If the target is
 	// not cached, then attempt to extrapolate it from the next lowest target
 	// that *is* cached. If we successfully extrapolate, then cache the
@@ -742,6 +984,7 @@ func (w *WebAPIFeeEstimator) updateFeeEstimates() {
 
 	w.feesMtx.Lock()
 	w.feeByBlockTarget = feesByBlockTarget
+	w.lastUpdate = time.Now()
 	w.feesMtx.Unlock()
 }
 
@@ -762,3 +1005,305 @@ func (w *WebAPIFeeEstimator) feeUpdateManager() {
 // A compile-time assertion to ensure that WebAPIFeeEstimator implements the
 // FeeEstimator interface.
 var _ FeeEstimator = (*WebAPIFeeEstimator)(nil)
+
+const (
+	// DefaultFeePercentile is the default percentile of the estimates of
+	// its sources a CompositeFeeEstimator returns, i.e. their median.
+	DefaultFeePercentile = 0.5
+
+	// DefaultFeeMaxDeviation is the default factor by which the estimate
+	// of a source may deviate from the median of all estimates before a
+	// CompositeFeeEstimator discards it as an outlier.
+	DefaultFeeMaxDeviation = 3.0
+
+	// DefaultFeeMinSources is the default number of sane estimates a
+	// CompositeFeeEstimator requires in order to aggregate them.
+	DefaultFeeMinSources = 2
+
+	// minOutlierSources is the number of estimates required to detect
+	// outliers among them. With fewer estimates, their median isn't
+	// meaningful enough to tell which of them is off.
+	minOutlierSources = 3
+)
+
+// FeeSource is a named fee estimator queried by a CompositeFeeEstimator.
+type FeeSource struct {
+	// Name identifies the source within the estimates reported by the
+	// CompositeFeeEstimator.
+	Name string
+
+	// Estimator is the fee estimator backing the source.
+	Estimator FeeEstimator
+}
+
+// FeeSourceEstimate is the estimate a single source returned to a
+// CompositeFeeEstimator.
+type FeeSourceEstimate struct {
+	// Name is the name of the source.
+	Name string
+
+	// FeePerKW is the fee rate estimated by the source.
+	FeePerKW SatPerKWeight
+
+	// Err is the error returned by the source, if any. The estimates of
+	// sources that failed are ignored.
+	Err error
+
+	// Outlier is true if the estimate deviated too much from the
+	// estimates of the other sources and has therefore been ignored.
+	Outlier bool
+}
+
+// FeeSourceReporter is an optional interface a FeeEstimator may satisfy if it
+// aggregates the estimates of several sources. It allows the estimates of the
+// individual sources to be inspected for debugging purposes.
+type FeeSourceReporter interface {
+	// SourceEstimates returns the estimate for the given confirmation
+	// target along with the estimates of the individual sources it has
+	// been derived from.
+	SourceEstimates(numBlocks uint32) (SatPerKWeight, []FeeSourceEstimate,
+		error)
+}
+
+// CompositeFeeEstimatorConfig houses the parameters of a
+// CompositeFeeEstimator.
+type CompositeFeeEstimatorConfig struct {
+	// Sources are the fee estimators queried for every estimate, in order
+	// of preference.
+	Sources []FeeSource
+
+	// Percentile is the percentile of the sane estimates returned, within
+	// [0, 1]. A percentile of 0.5 returns their median.
+	Percentile float64
+
+	// MaxDeviation is the factor by which an estimate may deviate from
+	// the median of all estimates before it's discarded as an outlier. A
+	// value of zero disables outlier detection.
+	MaxDeviation float64
+
+	// MinSources is the number of sane estimates required in order to
+	// aggregate them. If fewer sources return one, the estimate of the
+	// first one in order of preference is used instead.
+	MinSources int
+
+	// MinFeePerKW is the lowest fee rate returned. If it's below
+	// FeePerKwFloor, the latter is used instead.
+	MinFeePerKW SatPerKWeight
+
+	// MaxFeePerKW is the highest fee rate returned. A value of zero
+	// disables the ceiling.
+	MaxFeePerKW SatPerKWeight
+}
+
+// CompositeFeeEstimator is an implementation of the FeeEstimator interface
+// which queries several sources for every estimate. Sources that fail or
+// return estimates that deviate too much from the others are ignored, while
+// a percentile of the remaining estimates is returned, clamped to the
+// configured bounds.
+type CompositeFeeEstimator struct {
+	cfg CompositeFeeEstimatorConfig
+}
+
+// NewCompositeFeeEstimator creates a new CompositeFeeEstimator querying the
+// sources of the given config.
+func NewCompositeFeeEstimator(
+	cfg CompositeFeeEstimatorConfig) *CompositeFeeEstimator {
+
+	if cfg.MinSources < 1 {
+		cfg.MinSources = 1
+	}
+	if cfg.MinFeePerKW < FeePerKwFloor {
+		cfg.MinFeePerKW = FeePerKwFloor
+	}
+
+	return &CompositeFeeEstimator{
+		cfg: cfg,
+	}
+}
+
+// Start signals the FeeEstimator to start any processes or goroutines
+// it needs to perform its duty. All sources are started in order.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (c *CompositeFeeEstimator) Start() error {
+	for i, source := range c.cfg.Sources {
+		if err := source.Estimator.Start(); err != nil {
+			for _, started := range c.cfg.Sources[:i] {
+				started.Estimator.Stop()
+			}
+
+			return fmt.Errorf("unable to start fee source %v: %v",
+				source.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used
+// by the fee estimator. All sources are stopped.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (c *CompositeFeeEstimator) Stop() error {
+	var firstErr error
+	for _, source := range c.cfg.Sources {
+		err := source.Estimator.Stop()
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (c *CompositeFeeEstimator) EstimateFeePerKW(
+	numBlocks uint32) (SatPerKWeight, error) {
+
+	feePerKW, _, err := c.SourceEstimates(numBlocks)
+	return feePerKW, err
+}
+
+// SourceEstimates returns the estimate for the given confirmation target
+// along with the estimates of the individual sources it has been derived
+// from.
+//
+// NOTE: This method is part of the FeeSourceReporter interface.
+func (c *CompositeFeeEstimator) SourceEstimates(numBlocks uint32) (
+	SatPerKWeight, []FeeSourceEstimate, error) {
+
+	estimates := make([]FeeSourceEstimate, 0, len(c.cfg.Sources))
+	var feeRates []SatPerKWeight
+	for _, source := range c.cfg.Sources {
+		feePerKW, err := source.Estimator.EstimateFeePerKW(numBlocks)
+		if err == nil && feePerKW <= 0 {
+			err = fmt.Errorf("invalid fee rate %v", feePerKW)
+		}
+		if err != nil {
+			walletLog.Debugf("Fee source %v failed to estimate fee "+
+				"for conf target of %v: %v", source.Name,
+				numBlocks, err)
+		} else {
+			feeRates = append(feeRates, feePerKW)
+		}
+
+		estimates = append(estimates, FeeSourceEstimate{
+			Name:     source.Name,
+			FeePerKW: feePerKW,
+			Err:      err,
+		})
+	}
+
+	if len(feeRates) == 0 {
+		return 0, estimates, fmt.Errorf("no fee source was able to "+
+			"estimate fee for conf target of %v", numBlocks)
+	}
+
+	// With enough estimates at hand, we'll discard those that deviate too
+	// much from their median, as they're likely garbage.
+	if len(feeRates) >= minOutlierSources && c.cfg.MaxDeviation > 0 {
+		median := float64(feeRatePercentile(feeRates, 0.5))
+		maxDeviation := c.cfg.MaxDeviation
+
+		feeRates = feeRates[:0]
+		for i := range estimates {
+			estimate := &estimates[i]
+			if estimate.Err != nil {
+				continue
+			}
+
+			feePerKW := float64(estimate.FeePerKW)
+			if feePerKW > median*maxDeviation ||
+				feePerKW*maxDeviation < median {
+
+				walletLog.Debugf("Ignoring outlier fee "+
+					"estimate of %v from fee source %v",
+					estimate.FeePerKW, estimate.Name)
+
+				estimate.Outlier = true
+				continue
+			}
+
+			feeRates = append(feeRates, estimate.FeePerKW)
+		}
+	}
+
+	// If too few sources returned a sane estimate, we'll fall back to the
+	// first of them in order of preference. Otherwise, we'll use the
+	// configured percentile of them.
+	var feePerKW SatPerKWeight
+	if len(feeRates) < c.cfg.MinSources {
+		for _, estimate := range estimates {
+			if estimate.Err == nil && !estimate.Outlier {
+				feePerKW = estimate.FeePerKW
+				break
+			}
+		}
+	} else {
+		feePerKW = feeRatePercentile(feeRates, c.cfg.Percentile)
+	}
+
+	// Finally, we'll clamp the estimate to the configured bounds.
+	switch {
+	case feePerKW < c.cfg.MinFeePerKW:
+		feePerKW = c.cfg.MinFeePerKW
+
+	case c.cfg.MaxFeePerKW != 0 && feePerKW > c.cfg.MaxFeePerKW:
+		walletLog.Warnf("Clamping fee estimate of %v for conf target "+
+			"of %v to maximum fee rate of %v", feePerKW,
+			numBlocks, c.cfg.MaxFeePerKW)
+
+		feePerKW = c.cfg.MaxFeePerKW
+	}
+
+	walletLog.Debugf("Composite fee estimator returning %v for conf "+
+		"target of %v", feePerKW, numBlocks)
+
+	return feePerKW, estimates, nil
+}
+
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed, which is the highest one among the sources.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (c *CompositeFeeEstimator) RelayFeePerKW() SatPerKWeight {
+	relayFee := FeePerKwFloor
+	for _, source := range c.cfg.Sources {
+		sourceRelayFee := source.Estimator.RelayFeePerKW()
+		if sourceRelayFee > relayFee {
+			relayFee = sourceRelayFee
+		}
+	}
+
+	return relayFee
+}
+
+// feeRatePercentile returns the given percentile of the fee rates,
+// interpolating linearly between the two closest ones.
+func feeRatePercentile(feeRates []SatPerKWeight,
+	percentile float64) SatPerKWeight {
+
+	sorted := make([]SatPerKWeight, len(feeRates))
+	copy(sorted, feeRates)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	rank := percentile * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	weight := rank - float64(lower)
+
+	return SatPerKWeight(math.Round(
+		float64(sorted[lower])*(1-weight) +
+			float64(sorted[upper])*weight,
+	))
+}
+
+// A compile-time assertion to ensure that CompositeFeeEstimator implements
+// the FeeEstimator and FeeSourceReporter interfaces.
+var _ FeeEstimator = (*CompositeFeeEstimator)(nil)
+var _ FeeSourceReporter = (*CompositeFeeEstimator)(nil)
@@ -0,0 +1,56 @@
+package lnwallet
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestWebAPIFeeEstimatorStaleCache asserts that the WebAPIFeeEstimator stops
+// returning its cached fees once they're older than the maximum cache age.
+func TestWebAPIFeeEstimatorStaleCache(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(
+				`{"fee_by_block_target": {"2": 20000}}`,
+			))
+		},
+	))
+	defer server.Close()
+
+	estimator := NewWebAPIFeeEstimator(
+		SparseConfFeeSource{URL: server.URL}, 10,
+	)
+	estimator.updateFeeEstimates()
+
+	// The freshly fetched fees should be returned.
+	expected := SatPerKVByte(20000).FeePerKWeight()
+	fee, err := estimator.EstimateFeePerKW(6)
+	if err != nil {
+		t.Fatalf("unable to estimate fee: %v", err)
+	}
+	if fee != expected {
+		t.Fatalf("expected fee of %v, got %v", expected, fee)
+	}
+
+	// Once the cache is older than its maximum age, an error should be
+	// returned instead.
+	estimator.feesMtx.Lock()
+	estimator.lastUpdate = time.Now().Add(-maxFeeCacheAge - time.Minute)
+	estimator.feesMtx.Unlock()
+
+	_, err = estimator.EstimateFeePerKW(6)
+	if err == nil || !strings.Contains(err.Error(), "stale") {
+		t.Fatalf("expected stale fee error, got: %v", err)
+	}
+
+	// A successful update should make the estimator usable again.
+	estimator.updateFeeEstimates()
+	if _, err := estimator.EstimateFeePerKW(6); err != nil {
+		t.Fatalf("unable to estimate fee: %v", err)
+	}
+}
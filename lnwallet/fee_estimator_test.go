@@ -3,13 +3,20 @@ package lnwallet_test
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
 
+	"github.com/lightningnetwork/lnd/esplora"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
@@ -234,3 +241,286 @@ func TestWebAPIFeeEstimator(t *testing.T) {
 		})
 	}
 }
+
+// TestEsploraFeeEstimator checks that the EsploraFeeEstimator returns fee
+// rates as expected.
+func TestEsploraFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	const fallbackFee = lnwallet.SatPerKWeight(12345)
+
+	feeFloor := uint32(lnwallet.FeePerKwFloor.FeePerKVByte())
+	testCases := []struct {
+		name   string
+		target uint32
+		est    uint32
+		err    string
+	}{
+		{"target_below_min", 1, 0, "too low, minimum"},
+		{"exact_target", 2, 20500, ""},
+		{"target_rounded_down", 5, 12000, ""},
+		{"target_w_too-low_fee", 200, feeFloor, ""},
+		{"target_above_max", 2000, feeFloor, ""},
+	}
+
+	// The server expresses its estimates in sat/vbyte.
+	var serverDown int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if atomic.LoadInt32(&serverDown) == 1 ||
+				r.URL.Path != "/fee-estimates" {
+
+				http.NotFound(w, r)
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"1": 30.2, "2": 20.5, ` +
+				`"3": 12.0, "144": 0.5}`))
+		},
+	))
+	defer server.Close()
+
+	client := esplora.NewClient(&esplora.ClientConfig{URL: server.URL})
+	estimator := lnwallet.NewEsploraFeeEstimator(client, fallbackFee)
+	if err := estimator.Start(); err != nil {
+		t.Fatalf("unable to start fee estimator, got: %v", err)
+	}
+	defer estimator.Stop()
+
+	for _, tc := range testCases {
+		est, err := estimator.EstimateFeePerKW(tc.target)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("%v: expected fee estimation to fail, "+
+					"instead got: %v", tc.name, err)
+			}
+			continue
+		}
+
+		exp := lnwallet.SatPerKVByte(tc.est).FeePerKWeight()
+		if err != nil {
+			t.Fatalf("%v: unable to estimate fee for %v block "+
+				"target, got: %v", tc.name, tc.target, err)
+		}
+		if est != exp {
+			t.Fatalf("%v: expected fee estimate of %v, got %v",
+				tc.name, exp, est)
+		}
+	}
+
+	// If the server is unable to serve its estimates, the fallback fee
+	// rate should be returned.
+	atomic.StoreInt32(&serverDown, 1)
+	est, err := estimator.EstimateFeePerKW(6)
+	if err != nil {
+		t.Fatalf("unable to estimate fee: %v", err)
+	}
+	if est != fallbackFee {
+		t.Fatalf("expected fallback fee of %v, got %v", fallbackFee,
+			est)
+	}
+}
+
+// mockFeeSource is a fee estimator returning a fixed estimate or error.
+type mockFeeSource struct {
+	feePerKW lnwallet.SatPerKWeight
+	err      error
+}
+
+func (m *mockFeeSource) EstimateFeePerKW(
+	numBlocks uint32) (lnwallet.SatPerKWeight, error) {
+
+	return m.feePerKW, m.err
+}
+
+func (m *mockFeeSource) RelayFeePerKW() lnwallet.SatPerKWeight {
+	return lnwallet.FeePerKwFloor
+}
+
+func (m *mockFeeSource) Start() error {
+	return nil
+}
+
+func (m *mockFeeSource) Stop() error {
+	return nil
+}
+
+// TestCompositeFeeEstimator ensures the composite fee estimator discards
+// failing sources and outliers, aggregates the remaining estimates and clamps
+// the result to the configured bounds.
+func TestCompositeFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	errSource := errors.New("source failed")
+
+	testCases := []struct {
+		name        string
+		sources     []*mockFeeSource
+		percentile  float64
+		minSources  int
+		maxFeePerKW lnwallet.SatPerKWeight
+
+		expectedFee      lnwallet.SatPerKWeight
+		expectedOutliers []bool
+		expectErr        bool
+	}{
+		{
+			name: "median of odd number of sources",
+			sources: []*mockFeeSource{
+				{feePerKW: 3000},
+				{feePerKW: 1000},
+				{feePerKW: 2000},
+			},
+			percentile:       0.5,
+			expectedFee:      2000,
+			expectedOutliers: []bool{false, false, false},
+		},
+		{
+			name: "median of even number of sources",
+			sources: []*mockFeeSource{
+				{feePerKW: 1000},
+				{feePerKW: 2000},
+			},
+			percentile:       0.5,
+			expectedFee:      1500,
+			expectedOutliers: []bool{false, false},
+		},
+		{
+			name: "percentile",
+			sources: []*mockFeeSource{
+				{feePerKW: 1000},
+				{feePerKW: 2000},
+				{feePerKW: 1500},
+			},
+			percentile:       1,
+			expectedFee:      2000,
+			expectedOutliers: []bool{false, false, false},
+		},
+		{
+			name: "outliers discarded",
+			sources: []*mockFeeSource{
+				{feePerKW: 100000},
+				{feePerKW: 2000},
+				{feePerKW: 2200},
+				{feePerKW: 300},
+				{feePerKW: 2400},
+			},
+			percentile:       0.5,
+			expectedFee:      2200,
+			expectedOutliers: []bool{true, false, false, true, false},
+		},
+		{
+			name: "failing sources ignored",
+			sources: []*mockFeeSource{
+				{err: errSource},
+				{feePerKW: 2000},
+				{feePerKW: -1},
+				{feePerKW: 3000},
+			},
+			percentile:       0.5,
+			expectedFee:      2500,
+			expectedOutliers: []bool{false, false, false, false},
+		},
+		{
+			name: "fall back to first sane source",
+			sources: []*mockFeeSource{
+				{err: errSource},
+				{feePerKW: 3000},
+				{feePerKW: 2000},
+			},
+			percentile:       0.5,
+			minSources:       3,
+			expectedFee:      3000,
+			expectedOutliers: []bool{false, false, false},
+		},
+		{
+			name: "all sources failing",
+			sources: []*mockFeeSource{
+				{err: errSource},
+				{err: errSource},
+			},
+			percentile: 0.5,
+			expectErr:  true,
+		},
+		{
+			name: "clamped to floor",
+			sources: []*mockFeeSource{
+				{feePerKW: 100},
+			},
+			percentile:       0.5,
+			expectedFee:      lnwallet.FeePerKwFloor,
+			expectedOutliers: []bool{false},
+		},
+		{
+			name: "clamped to ceiling",
+			sources: []*mockFeeSource{
+				{feePerKW: 50000},
+			},
+			percentile:       0.5,
+			maxFeePerKW:      10000,
+			expectedFee:      10000,
+			expectedOutliers: []bool{false},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			sources := make([]lnwallet.FeeSource, 0, len(test.sources))
+			for i, source := range test.sources {
+				sources = append(sources, lnwallet.FeeSource{
+					Name:      fmt.Sprintf("source %d", i),
+					Estimator: source,
+				})
+			}
+
+			estimator := lnwallet.NewCompositeFeeEstimator(
+				lnwallet.CompositeFeeEstimatorConfig{
+					Sources:      sources,
+					Percentile:   test.percentile,
+					MaxDeviation: 3,
+					MinSources:   test.minSources,
+					MaxFeePerKW:  test.maxFeePerKW,
+				},
+			)
+
+			feePerKW, estimates, err := estimator.SourceEstimates(6)
+			if test.expectErr {
+				if err == nil {
+					t.Fatal("expected estimate to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unable to estimate fee: %v", err)
+			}
+
+			if feePerKW != test.expectedFee {
+				t.Fatalf("expected fee rate %v, got %v",
+					test.expectedFee, feePerKW)
+			}
+
+			if len(estimates) != len(test.sources) {
+				t.Fatalf("expected %d source estimates, got %d",
+					len(test.sources), len(estimates))
+			}
+			for i, estimate := range estimates {
+				source := test.sources[i]
+				if estimate.Name != sources[i].Name ||
+					estimate.FeePerKW != source.feePerKW {
+
+					t.Fatalf("unexpected estimate %d: %v",
+						i, spew.Sdump(estimate))
+				}
+				if estimate.Outlier != test.expectedOutliers[i] {
+					t.Fatalf("expected outlier status of "+
+						"estimate %d to be %v", i,
+						test.expectedOutliers[i])
+				}
+			}
+		})
+	}
+}
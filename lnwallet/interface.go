@@ -4,11 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcutil/psbt"
 	"github.com/btcsuite/btcwallet/wallet/txauthor"
 )
 
@@ -27,6 +30,25 @@ const (
 	// NestedWitnessPubKey represents a p2sh output which is itself a
 	// nested p2wkh output.
 	NestedWitnessPubKey
+
+	// PubKeyHash represents a legacy p2pkh address. Addresses of this type
+	// can only be derived from BIP44 accounts, and outputs paying to them
+	// can't be used to fund channels.
+	PubKeyHash
+
+	// TODO: add a TaprootPubkey type for BIP86 p2tr addresses. This is
+	// deferred until lnd moves to a taproot aware btcd, btcutil and
+	// btcwallet: the current versions lack BIP340 signatures, BIP341
+	// sighashes and bech32m, and btcwallet wouldn't detect outputs paying
+	// to witness v1 programs, so funds sent to such addresses would be
+	// neither visible nor spendable.
+)
+
+const (
+	// DefaultAccountName is the name of the account lnd uses for all of
+	// its own on-chain operations, such as channel funding and sweeping.
+	// It spans the default BIP49 and BIP84 accounts of the wallet.
+	DefaultAccountName = "default"
 )
 
 var (
@@ -46,12 +68,95 @@ var (
 	// ErrNotMine is an error denoting that a WalletController instance is
 	// unable to spend a specified output.
 	ErrNotMine = errors.New("the passed output doesn't belong to the wallet")
+
+	// ErrOutputAlreadyLeased is returned when attempting to lease an
+	// output that is already leased under a different lease ID, or that is
+	// currently locked by one of lnd's own coin selection attempts.
+	ErrOutputAlreadyLeased = errors.New("output already leased")
+
+	// ErrOutputNotLeased is returned when attempting to release an output
+	// that isn't currently leased.
+	ErrOutputNotLeased = errors.New("output not leased")
+
+	// ErrOutputUnlockNotAllowed is returned when attempting to release an
+	// output using a lease ID that doesn't match the one it was leased
+	// with.
+	ErrOutputUnlockNotAllowed = errors.New("output lease ID mismatch")
+
+	// ErrAccountNotFound is returned when referencing an on-chain account
+	// that doesn't exist, or that doesn't support the requested address
+	// type.
+	ErrAccountNotFound = errors.New("account not found")
+
+	// ErrAccountExists is returned when attempting to create or import an
+	// on-chain account under a name that is already taken.
+	ErrAccountExists = errors.New("account already exists")
+
+	// ErrTxLabelExists is returned when attempting to label a transaction
+	// that already has a label without requesting it to be overwritten.
+	ErrTxLabelExists = errors.New("transaction already labelled")
+
+	// ErrUnknownTxid is returned when attempting to label a transaction
+	// that isn't known to the wallet.
+	ErrUnknownTxid = errors.New("unknown txid")
+
+	// ErrTxConfirmed is returned when attempting to replace a transaction
+	// that already confirmed.
+	ErrTxConfirmed = errors.New("transaction already confirmed")
+
+	// ErrTxNotReplaceable is returned when attempting to replace a
+	// transaction that doesn't signal replaceability through BIP 125.
+	ErrTxNotReplaceable = errors.New("transaction doesn't signal " +
+		"replaceability")
+
+	// ErrTxHasDescendants is returned when attempting to replace a
+	// transaction whose outputs are already spent by other unconfirmed
+	// transactions of the wallet, as the replacement would evict them.
+	ErrTxHasDescendants = errors.New("transaction has unconfirmed " +
+		"descendants")
+
+	// ErrNoChangeOutput is returned when attempting to replace a
+	// transaction without a change output to pay the higher fee from.
+	ErrNoChangeOutput = errors.New("transaction has no change output " +
+		"to pay the higher fee from")
 )
 
 // ErrNoOutputs is returned if we try to create a transaction with no outputs
 // or send coins to a set of outputs that is empty.
 var ErrNoOutputs = errors.New("no outputs")
 
+// Account describes an on-chain account of the wallet. Accounts are either
+// derived from the wallet's seed following BIP44, BIP49 or BIP84, or imported
+// as watch-only accounts from an extended public key.
+type Account struct {
+	// Name is the unique name of the account.
+	Name string
+
+	// AddressType is the type of addresses derived from the account.
+	AddressType AddressType
+
+	// WatchOnly indicates whether the account was imported from an
+	// extended public key, in which case the wallet is unable to spend its
+	// funds.
+	WatchOnly bool
+
+	// ExtendedPubKey is the account's extended public key. It's only known
+	// for imported accounts.
+	ExtendedPubKey string
+
+	// MasterKeyFingerprint is the fingerprint of the master key the
+	// account was derived from. It's only known for imported accounts.
+	MasterKeyFingerprint uint32
+
+	// ExternalKeyCount is the number of external addresses that have been
+	// derived from the account.
+	ExternalKeyCount uint32
+
+	// InternalKeyCount is the number of internal (change) addresses that
+	// have been derived from the account.
+	InternalKeyCount uint32
+}
+
 // Utxo is an unspent output denoted by its outpoint, and output value of the
 // original output.
 type Utxo struct {
@@ -62,6 +167,23 @@ type Utxo struct {
 	wire.OutPoint
 }
 
+// LockID is an identifier chosen by the caller that leases an output. Only
+// the holder of the lock ID is able to release the lease before it expires.
+type LockID [32]byte
+
+// LeasedOutput is an output of the wallet that has been leased, rendering it
+// unusable for coin selection until the lease either expires or is released.
+type LeasedOutput struct {
+	// LockID is the ID the output was leased with.
+	LockID LockID
+
+	// OutPoint is the leased output.
+	OutPoint wire.OutPoint
+
+	// Expiration is the time at which the lease expires.
+	Expiration time.Time
+}
+
 // TransactionDetail describes a transaction with either inputs which belong to
 // the wallet, or has outputs that pay to the wallet.
 type TransactionDetail struct {
@@ -101,6 +223,9 @@ type TransactionDetail struct {
 
 	// RawTx returns the raw serialized transaction.
 	RawTx []byte
+
+	// Label is an optional transaction label.
+	Label string
 }
 
 // TransactionSubscription is an interface which describes an object capable of
@@ -140,28 +265,35 @@ type WalletController interface {
 	// ConfirmedBalance returns the sum of all the wallet's unspent outputs
 	// that have at least confs confirmations. If confs is set to zero,
 	// then all unspent outputs, including those currently in the mempool
-	// will be included in the final sum.
+	// will be included in the final sum. The account filter restricts the
+	// sum to the outputs of a single account. An empty filter includes
+	// the outputs of all accounts.
 	//
 	// NOTE: Only witness outputs should be included in the computation of
-	// the total spendable balance of the wallet. We require this as only
-	// witness inputs can be used for funding channels.
-	ConfirmedBalance(confs int32) (btcutil.Amount, error)
+	// the total spendable balance of the default account. We require this
+	// as only witness inputs can be used for funding channels.
+	ConfirmedBalance(confs int32, accountFilter string) (btcutil.Amount,
+		error)
 
 	// NewAddress returns the next external or internal address for the
 	// wallet dictated by the value of the `change` parameter. If change is
 	// true, then an internal address should be used, otherwise an external
 	// address should be returned. The type of address returned is dictated
 	// by the wallet's capabilities, and may be of type: p2sh, p2wkh,
-	// p2wsh, etc.
-	NewAddress(addrType AddressType, change bool) (btcutil.Address, error)
+	// p2wsh, etc. The address is derived from the given account, which
+	// must support the requested address type.
+	NewAddress(addrType AddressType, change bool,
+		account string) (btcutil.Address, error)
 
 	// LastUnusedAddress returns the last *unused* address known by the
 	// wallet. An address is unused if it hasn't received any payments.
 	// This can be useful in UIs in order to continually show the
 	// "freshest" address without having to worry about "address inflation"
 	// caused by continual refreshing. Similar to NewAddress it can derive
-	// a specified address type. By default, this is a non-change address.
-	LastUnusedAddress(addrType AddressType) (btcutil.Address, error)
+	// a specified address type from the given account. By default, this
+	// is a non-change address.
+	LastUnusedAddress(addrType AddressType,
+		account string) (btcutil.Address, error)
 
 	// IsOurAddress checks if the passed address belongs to this wallet
 	IsOurAddress(a btcutil.Address) bool
@@ -170,9 +302,10 @@ type WalletController interface {
 	// out to the specified outputs. In the case the wallet has insufficient
 	// funds, or the outputs are non-standard, an error should be returned.
 	// This method also takes the target fee expressed in sat/kw that should
-	// be used when crafting the transaction.
-	SendOutputs(outputs []*wire.TxOut,
-		feeRate SatPerKWeight) (*wire.MsgTx, error)
+	// be used when crafting the transaction, and an optional label to
+	// store for the transaction.
+	SendOutputs(outputs []*wire.TxOut, feeRate SatPerKWeight,
+		label string) (*wire.MsgTx, error)
 
 	// CreateSimpleTx creates a Bitcoin transaction paying to the specified
 	// outputs. The transaction is not broadcasted to the network. In the
@@ -193,12 +326,40 @@ type WalletController interface {
 	// needs in order to be returned by this method. Passing -1 as
 	// 'minconfirms' indicates that even unconfirmed outputs should be
 	// returned. Using MaxInt32 as 'maxconfirms' implies returning all
-	// outputs with at least 'minconfirms'.
-	ListUnspentWitness(minconfirms, maxconfirms int32) ([]*Utxo, error)
+	// outputs with at least 'minconfirms'. The account filter restricts
+	// the outputs to those of a single account. An empty filter returns
+	// the outputs of all accounts.
+	//
+	// NOTE: Legacy p2pkh outputs of BIP44 accounts are returned unless the
+	// filter is set to the default account, as they can't be used for
+	// channel funding.
+	ListUnspentWitness(minconfirms, maxconfirms int32,
+		accountFilter string) ([]*Utxo, error)
 
 	// ListTransactionDetails returns a list of all transactions which are
-	// relevant to the wallet.
-	ListTransactionDetails() ([]*TransactionDetail, error)
+	// relevant to the wallet. The account filter restricts the list to
+	// the transactions that credit or debit a single account, in which
+	// case the value of each transaction only reflects that account. An
+	// empty filter returns the transactions of all accounts.
+	ListTransactionDetails(accountFilter string) ([]*TransactionDetail,
+		error)
+
+	// ListAccounts returns all on-chain accounts of the wallet, or only
+	// the account with the given name if it's not empty.
+	ListAccounts(name string) ([]*Account, error)
+
+	// CreateAccount derives a new named account from the wallet's seed.
+	// The address type determines the BIP44, BIP49 or BIP84 purpose the
+	// account is derived under.
+	CreateAccount(name string, addrType AddressType) (*Account, error)
+
+	// ImportAccount imports a watch-only account from its extended public
+	// key at the account level of its derivation path. The wallet will
+	// track the funds of the account starting at the given birthday
+	// height, but is unable to spend them.
+	ImportAccount(name string, accountPubKey *hdkeychain.ExtendedKey,
+		masterKeyFingerprint uint32, addrType AddressType,
+		birthdayHeight uint32) (*Account, error)
 
 	// LockOutpoint marks an outpoint as locked meaning it will no longer
 	// be deemed as eligible for coin selection. Locking outputs are
@@ -210,14 +371,68 @@ type WalletController interface {
 	// eligible for coin selection.
 	UnlockOutpoint(o wire.OutPoint)
 
+	// LeaseOutput locks an output of the wallet under the given lock ID
+	// until the passed duration has elapsed, preventing it from being used
+	// for coin selection. Leases persist across restarts. Leasing an
+	// output again with the same lock ID extends the lease. The time at
+	// which the lease expires is returned. If the output is already leased
+	// under a different lock ID, ErrOutputAlreadyLeased is returned.
+	LeaseOutput(id LockID, op wire.OutPoint,
+		duration time.Duration) (time.Time, error)
+
+	// ReleaseOutput releases the lease on an output, making it eligible
+	// for coin selection once again. The lock ID must match the one the
+	// output was leased with.
+	ReleaseOutput(id LockID, op wire.OutPoint) error
+
+	// ListLeases returns all outputs that are currently leased.
+	ListLeases() ([]*LeasedOutput, error)
+
+	// FundPsbt adds inputs and, if required, a change output to the
+	// passed PSBT, such that its outputs are paid for at the given fee
+	// rate. If the packet doesn't have any inputs yet, coin selection is
+	// performed. Otherwise, all of its inputs must belong to the wallet
+	// and are used as is. The index of the change output is returned, or
+	// -1 if no change output was added.
+	FundPsbt(packet *psbt.Packet, feeRate SatPerKWeight) (int32, error)
+
+	// SignPsbt adds a partial signature to every input of the passed PSBT
+	// that belongs to the wallet and hasn't been signed or finalized yet.
+	// Inputs that don't belong to the wallet are left untouched. The
+	// indices of the inputs that were signed are returned.
+	SignPsbt(packet *psbt.Packet) ([]uint32, error)
+
+	// FinalizePsbt signs all inputs of the passed PSBT that belong to the
+	// wallet, and then finalizes all of its inputs. An error is returned
+	// if any of the inputs can't be finalized, for example because they
+	// lack the signatures of other parties.
+	FinalizePsbt(packet *psbt.Packet) error
+
 	// PublishTransaction performs cursory validation (dust checks, etc),
 	// then finally broadcasts the passed transaction to the Bitcoin network.
 	// If the transaction is rejected because it is conflicting with an
 	// already known transaction, ErrDoubleSpend is returned. If the
 	// transaction is already known (published already), no error will be
 	// returned. Other error returned depends on the currently active chain
-	// backend.
-	PublishTransaction(tx *wire.MsgTx) error
+	// backend. If the label is non-empty, it is stored for the transaction.
+	// Failing to store the label after broadcasting doesn't result in an
+	// error.
+	PublishTransaction(tx *wire.MsgTx, label string) error
+
+	// LabelTransaction adds a label to a transaction known to the wallet.
+	// If the transaction already has a label, ErrTxLabelExists is returned
+	// unless overwrite is set.
+	LabelTransaction(hash chainhash.Hash, label string,
+		overwrite bool) error
+
+	// ReplaceTransaction bumps the fee of an unconfirmed transaction that
+	// was funded by the wallet to the given fee rate by replacing it
+	// (BIP 125). The replacement spends the same inputs and pays to the
+	// same outputs, with the higher fee being deducted from its change
+	// output. Once broadcast, the replaced transaction is removed from the
+	// wallet, such that its outputs can no longer be spent.
+	ReplaceTransaction(hash chainhash.Hash,
+		feeRate SatPerKWeight) (*wire.MsgTx, error)
 
 	// SubscribeTransactions returns a TransactionSubscription client which
 	// is capable of receiving async notifications as new transactions
@@ -282,6 +497,16 @@ type BlockChainIO interface {
 	GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error)
 }
 
+// BlockTxFetcher is an optional interface a BlockChainIO implementation may
+// satisfy if it's able to fetch a single transaction by its location within
+// the main chain. This allows backends which don't serve full blocks, such as
+// Electrum servers, to look up channel funding transactions.
+type BlockTxFetcher interface {
+	// GetBlockTransaction returns the transaction found at the given
+	// index within the block at the given height of the main chain.
+	GetBlockTransaction(blockHeight, txIndex uint32) (*wire.MsgTx, error)
+}
+
 // MessageSigner represents an abstract object capable of signing arbitrary
 // messages. The capabilities of this interface are used to sign announcements
 // to the network, or just arbitrary messages that leverage the wallet's keys
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"math/rand"
 	"net"
 	"os"
@@ -27,6 +28,8 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcutil/psbt"
 	"github.com/btcsuite/btcwallet/chain"
 	"github.com/btcsuite/btcwallet/walletdb"
 	_ "github.com/btcsuite/btcwallet/walletdb/bdb"
@@ -38,6 +41,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -94,7 +98,9 @@ var (
 func assertProperBalance(t *testing.T, lw *lnwallet.LightningWallet,
 	numConfirms int32, amount float64) {
 
-	balance, err := lw.ConfirmedBalance(numConfirms)
+	balance, err := lw.ConfirmedBalance(
+		numConfirms, lnwallet.DefaultAccountName,
+	)
 	if err != nil {
 		t.Fatalf("unable to query for balance: %v", err)
 	}
@@ -150,7 +156,7 @@ func newPkScript(t *testing.T, w *lnwallet.LightningWallet,
 
 	t.Helper()
 
-	addr, err := w.NewAddress(addrType, false)
+	addr, err := w.NewAddress(addrType, false, lnwallet.DefaultAccountName)
 	if err != nil {
 		t.Fatalf("unable to create new address: %v", err)
 	}
@@ -170,7 +176,7 @@ func sendCoins(t *testing.T, miner *rpctest.Harness,
 
 	t.Helper()
 
-	tx, err := sender.SendOutputs([]*wire.TxOut{output}, 2500)
+	tx, err := sender.SendOutputs([]*wire.TxOut{output}, 2500, "")
 	if err != nil {
 		t.Fatalf("unable to send transaction: %v", err)
 	}
@@ -203,7 +209,9 @@ func assertTxInWallet(t *testing.T, w *lnwallet.LightningWallet,
 	// We'll fetch all of our transaction and go through each one until
 	// finding the expected transaction with its expected confirmation
 	// status.
-	txs, err := w.ListTransactionDetails()
+	txs, err := w.ListTransactionDetails(
+		lnwallet.DefaultAccountName,
+	)
 	if err != nil {
 		t.Fatalf("unable to retrieve transactions: %v", err)
 	}
@@ -243,7 +251,9 @@ func loadTestCredits(miner *rpctest.Harness, w *lnwallet.LightningWallet,
 	if err != nil {
 		return fmt.Errorf("unable to create amt: %v", err)
 	}
-	expectedBalance, err := w.ConfirmedBalance(1)
+	expectedBalance, err := w.ConfirmedBalance(
+		1, lnwallet.DefaultAccountName,
+	)
 	if err != nil {
 		return err
 	}
@@ -251,7 +261,9 @@ func loadTestCredits(miner *rpctest.Harness, w *lnwallet.LightningWallet,
 	addrs := make([]btcutil.Address, 0, numOutputs)
 	for i := 0; i < numOutputs; i++ {
 		// Grab a fresh address from the wallet to house this output.
-		walletAddr, err := w.NewAddress(lnwallet.WitnessPubKey, false)
+		walletAddr, err := w.NewAddress(
+			lnwallet.WitnessPubKey, false, lnwallet.DefaultAccountName,
+		)
 		if err != nil {
 			return err
 		}
@@ -286,7 +298,9 @@ func loadTestCredits(miner *rpctest.Harness, w *lnwallet.LightningWallet,
 	timeout := time.After(30 * time.Second)
 
 	for range ticker.C {
-		balance, err := w.ConfirmedBalance(1)
+		balance, err := w.ConfirmedBalance(
+			1, lnwallet.DefaultAccountName,
+		)
 		if err != nil {
 			return err
 		}
@@ -526,7 +540,7 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 	}
 
 	// Let Alice publish the funding transaction.
-	if err := alice.PublishTransaction(fundingTx); err != nil {
+	if err := alice.PublishTransaction(fundingTx, ""); err != nil {
 		t.Fatalf("unable to publish funding tx: %v", err)
 	}
 
@@ -995,7 +1009,7 @@ func testSingleFunderReservationWorkflow(miner *rpctest.Harness,
 	}
 
 	// Let Alice publish the funding transaction.
-	if err := alice.PublishTransaction(fundingTx); err != nil {
+	if err := alice.PublishTransaction(fundingTx, ""); err != nil {
 		t.Fatalf("unable to publish funding tx: %v", err)
 	}
 
@@ -1034,7 +1048,9 @@ func testListTransactionDetails(miner *rpctest.Harness,
 	const outputAmt = btcutil.SatoshiPerBitcoin
 	txids := make(map[chainhash.Hash]struct{})
 	for i := 0; i < numTxns; i++ {
-		addr, err := alice.NewAddress(lnwallet.WitnessPubKey, false)
+		addr, err := alice.NewAddress(
+			lnwallet.WitnessPubKey, false, lnwallet.DefaultAccountName,
+		)
 		if err != nil {
 			t.Fatalf("unable to create new address: %v", err)
 		}
@@ -1066,7 +1082,9 @@ func testListTransactionDetails(miner *rpctest.Harness,
 	if err != nil {
 		t.Fatalf("Couldn't sync Alice's wallet: %v", err)
 	}
-	txDetails, err := alice.ListTransactionDetails()
+	txDetails, err := alice.ListTransactionDetails(
+		lnwallet.DefaultAccountName,
+	)
 	if err != nil {
 		t.Fatalf("unable to fetch tx details: %v", err)
 	}
@@ -1154,7 +1172,7 @@ func testListTransactionDetails(miner *rpctest.Harness,
 		t.Fatalf("unable to make output script: %v", err)
 	}
 	burnOutput := wire.NewTxOut(outputAmt, outputScript)
-	burnTX, err := alice.SendOutputs([]*wire.TxOut{burnOutput}, 2500)
+	burnTX, err := alice.SendOutputs([]*wire.TxOut{burnOutput}, 2500, "")
 	if err != nil {
 		t.Fatalf("unable to create burn tx: %v", err)
 	}
@@ -1175,7 +1193,9 @@ func testListTransactionDetails(miner *rpctest.Harness,
 	// We should be able to find the transaction above in the set of
 	// returned transactions, and it should have a confirmation of -1,
 	// indicating that it's not yet mined.
-	txDetails, err = alice.ListTransactionDetails()
+	txDetails, err = alice.ListTransactionDetails(
+		lnwallet.DefaultAccountName,
+	)
 	if err != nil {
 		t.Fatalf("unable to fetch tx details: %v", err)
 	}
@@ -1223,7 +1243,9 @@ func testListTransactionDetails(miner *rpctest.Harness,
 	if err != nil {
 		t.Fatalf("Couldn't sync Alice's wallet: %v", err)
 	}
-	txDetails, err = alice.ListTransactionDetails()
+	txDetails, err = alice.ListTransactionDetails(
+		lnwallet.DefaultAccountName,
+	)
 	if err != nil {
 		t.Fatalf("unable to fetch tx details: %v", err)
 	}
@@ -1307,7 +1329,9 @@ func testTransactionSubscriptions(miner *rpctest.Harness,
 	// Next, fetch a fresh address from the wallet, create 3 new outputs
 	// with the pkScript.
 	for i := 0; i < numTxns; i++ {
-		addr, err := alice.NewAddress(lnwallet.WitnessPubKey, false)
+		addr, err := alice.NewAddress(
+			lnwallet.WitnessPubKey, false, lnwallet.DefaultAccountName,
+		)
 		if err != nil {
 			t.Fatalf("unable to create new address: %v", err)
 		}
@@ -1391,7 +1415,7 @@ func testTransactionSubscriptions(miner *rpctest.Harness,
 		t.Fatalf("unable to make output script: %v", err)
 	}
 	burnOutput := wire.NewTxOut(outputAmt, outputScript)
-	tx, err := alice.SendOutputs([]*wire.TxOut{burnOutput}, 2500)
+	tx, err := alice.SendOutputs([]*wire.TxOut{burnOutput}, 2500, "")
 	if err != nil {
 		t.Fatalf("unable to create burn tx: %v", err)
 	}
@@ -1582,7 +1606,7 @@ func newTx(t *testing.T, r *rpctest.Harness, pubKey *btcec.PublicKey,
 		Value:    btcutil.SatoshiPerBitcoin,
 		PkScript: keyScript,
 	}
-	tx, err := alice.SendOutputs([]*wire.TxOut{newOutput}, 2500)
+	tx, err := alice.SendOutputs([]*wire.TxOut{newOutput}, 2500, "")
 	if err != nil {
 		t.Fatalf("unable to create output: %v", err)
 	}
@@ -1622,7 +1646,7 @@ func testPublishTransaction(r *rpctest.Harness,
 	tx1 := newTx(t, r, keyDesc.PubKey, alice, false)
 
 	// Publish the transaction.
-	if err := alice.PublishTransaction(tx1); err != nil {
+	if err := alice.PublishTransaction(tx1, ""); err != nil {
 		t.Fatalf("unable to publish: %v", err)
 	}
 
@@ -1634,7 +1658,7 @@ func testPublishTransaction(r *rpctest.Harness,
 
 	// Publish the exact same transaction again. This should not return an
 	// error, even though the transaction is already in the mempool.
-	if err := alice.PublishTransaction(tx1); err != nil {
+	if err := alice.PublishTransaction(tx1, ""); err != nil {
 		t.Fatalf("unable to publish: %v", err)
 	}
 
@@ -1653,7 +1677,7 @@ func testPublishTransaction(r *rpctest.Harness,
 	tx2 := newTx(t, r, keyDesc.PubKey, alice, false)
 
 	// Publish this tx.
-	if err := alice.PublishTransaction(tx2); err != nil {
+	if err := alice.PublishTransaction(tx2, ""); err != nil {
 		t.Fatalf("unable to publish: %v", err)
 	}
 
@@ -1664,7 +1688,7 @@ func testPublishTransaction(r *rpctest.Harness,
 
 	// Publish the transaction again. It is already mined, and we don't
 	// expect this to return an error.
-	if err := alice.PublishTransaction(tx2); err != nil {
+	if err := alice.PublishTransaction(tx2, ""); err != nil {
 		t.Fatalf("unable to publish: %v", err)
 	}
 
@@ -1680,7 +1704,7 @@ func testPublishTransaction(r *rpctest.Harness,
 		// transaction. Create a new tx and publish it. This is the
 		// output we'll try to double spend.
 		tx3 = newTx(t, r, keyDesc.PubKey, alice, false)
-		if err := alice.PublishTransaction(tx3); err != nil {
+		if err := alice.PublishTransaction(tx3, ""); err != nil {
 			t.Fatalf("unable to publish: %v", err)
 		}
 
@@ -1700,7 +1724,7 @@ func testPublishTransaction(r *rpctest.Harness,
 		}
 
 		// This should be accepted into the mempool.
-		if err := alice.PublishTransaction(tx4); err != nil {
+		if err := alice.PublishTransaction(tx4, ""); err != nil {
 			t.Fatalf("unable to publish: %v", err)
 		}
 
@@ -1734,7 +1758,7 @@ func testPublishTransaction(r *rpctest.Harness,
 			t.Fatal(err)
 		}
 
-		err = alice.PublishTransaction(tx5)
+		err = alice.PublishTransaction(tx5, "")
 		if err != lnwallet.ErrDoubleSpend {
 			t.Fatalf("expected ErrDoubleSpend, got: %v", err)
 		}
@@ -1762,7 +1786,7 @@ func testPublishTransaction(r *rpctest.Harness,
 			expErr = nil
 			tx3Spend = tx6
 		}
-		err = alice.PublishTransaction(tx6)
+		err = alice.PublishTransaction(tx6, "")
 		if err != expErr {
 			t.Fatalf("expected ErrDoubleSpend, got: %v", err)
 		}
@@ -1797,7 +1821,7 @@ func testPublishTransaction(r *rpctest.Harness,
 		}
 
 		// Expect rejection.
-		err = alice.PublishTransaction(tx7)
+		err = alice.PublishTransaction(tx7, "")
 		if err != lnwallet.ErrDoubleSpend {
 			t.Fatalf("expected ErrDoubleSpend, got: %v", err)
 		}
@@ -1865,7 +1889,7 @@ func testSignOutputUsingTweaks(r *rpctest.Harness,
 			Value:    btcutil.SatoshiPerBitcoin,
 			PkScript: keyScript,
 		}
-		tx, err := alice.SendOutputs([]*wire.TxOut{newOutput}, 2500)
+		tx, err := alice.SendOutputs([]*wire.TxOut{newOutput}, 2500, "")
 		if err != nil {
 			t.Fatalf("unable to create output: %v", err)
 		}
@@ -1987,7 +2011,7 @@ func testReorgWalletBalance(r *rpctest.Harness, w *lnwallet.LightningWallet,
 		Value:    1e8,
 		PkScript: script,
 	}
-	tx, err := w.SendOutputs([]*wire.TxOut{output}, 2500)
+	tx, err := w.SendOutputs([]*wire.TxOut{output}, 2500, "")
 	if err != nil {
 		t.Fatalf("unable to send outputs: %v", err)
 	}
@@ -2008,7 +2032,7 @@ func testReorgWalletBalance(r *rpctest.Harness, w *lnwallet.LightningWallet,
 	}
 
 	// Get the original balance.
-	origBalance, err := w.ConfirmedBalance(1)
+	origBalance, err := w.ConfirmedBalance(1, lnwallet.DefaultAccountName)
 	if err != nil {
 		t.Fatalf("unable to query for balance: %v", err)
 	}
@@ -2024,7 +2048,7 @@ func testReorgWalletBalance(r *rpctest.Harness, w *lnwallet.LightningWallet,
 		t.Fatalf("unable to set up mining node: %v", err)
 	}
 	defer r2.TearDown()
-	newBalance, err := w.ConfirmedBalance(1)
+	newBalance, err := w.ConfirmedBalance(1, lnwallet.DefaultAccountName)
 	if err != nil {
 		t.Fatalf("unable to query for balance: %v", err)
 	}
@@ -2116,7 +2140,7 @@ func testReorgWalletBalance(r *rpctest.Harness, w *lnwallet.LightningWallet,
 	}
 
 	// Now we check that the wallet balance stays the same.
-	newBalance, err = w.ConfirmedBalance(1)
+	newBalance, err = w.ConfirmedBalance(1, lnwallet.DefaultAccountName)
 	if err != nil {
 		t.Fatalf("unable to query for balance: %v", err)
 	}
@@ -2139,7 +2163,9 @@ func testChangeOutputSpendConfirmation(r *rpctest.Harness,
 	// Assuming a balance of 80 BTC and a transaction fee of 2500 sat/kw,
 	// we'll craft the following transaction so that Alice doesn't have any
 	// UTXOs left.
-	aliceBalance, err := alice.ConfirmedBalance(0)
+	aliceBalance, err := alice.ConfirmedBalance(
+		0, lnwallet.DefaultAccountName,
+	)
 	if err != nil {
 		t.Fatalf("unable to retrieve alice's balance: %v", err)
 	}
@@ -2164,7 +2190,9 @@ func testChangeOutputSpendConfirmation(r *rpctest.Harness,
 
 	// With the transaction sent and confirmed, Alice's balance should now
 	// be 0.
-	aliceBalance, err = alice.ConfirmedBalance(0)
+	aliceBalance, err = alice.ConfirmedBalance(
+		0, lnwallet.DefaultAccountName,
+	)
 	if err != nil {
 		t.Fatalf("unable to retrieve alice's balance: %v", err)
 	}
@@ -2226,11 +2254,15 @@ func testLastUnusedAddr(miner *rpctest.Harness,
 		lnwallet.WitnessPubKey, lnwallet.NestedWitnessPubKey,
 	}
 	for _, addrType := range addrTypes {
-		addr1, err := alice.LastUnusedAddress(addrType)
+		addr1, err := alice.LastUnusedAddress(
+			addrType, lnwallet.DefaultAccountName,
+		)
 		if err != nil {
 			t.Fatalf("unable to get addr: %v", err)
 		}
-		addr2, err := alice.LastUnusedAddress(addrType)
+		addr2, err := alice.LastUnusedAddress(
+			addrType, lnwallet.DefaultAccountName,
+		)
 		if err != nil {
 			t.Fatalf("unable to get addr: %v", err)
 		}
@@ -2256,7 +2288,9 @@ func testLastUnusedAddr(miner *rpctest.Harness,
 
 		// If we make a new address, then it should be brand new, as
 		// the prior address has been used.
-		addr3, err := alice.LastUnusedAddress(addrType)
+		addr3, err := alice.LastUnusedAddress(
+			addrType, lnwallet.DefaultAccountName,
+		)
 		if err != nil {
 			t.Fatalf("unable to get addr: %v", err)
 		}
@@ -2368,7 +2402,7 @@ func testCreateSimpleTx(r *rpctest.Harness, w *lnwallet.LightningWallet,
 		// _very_ similar to the one we just created being sent. The
 		// only difference is that the dry run tx is not signed, and
 		// that the change output position might be different.
-		tx, sendErr := w.SendOutputs(outputs, feeRate)
+		tx, sendErr := w.SendOutputs(outputs, feeRate, "")
 		switch {
 		case test.valid && sendErr != nil:
 			t.Fatalf("got unexpected error when sending tx: %v",
@@ -2584,6 +2618,382 @@ var walletTests = []walletTestCase{
 		name: "test sign create account",
 		test: testSignOutputCreateAccount,
 	},
+	{
+		name: "lease outputs",
+		test: testLeaseOutputs,
+	},
+	{
+		name: "psbt fund sign finalize",
+		test: testPsbtFundSignFinalize,
+	},
+	{
+		name: "wallet accounts",
+		test: testWalletAccounts,
+	},
+	{
+		name: "transaction labels",
+		test: testTransactionLabels,
+	},
+	{
+		name: "replace transaction",
+		test: testReplaceTransaction,
+	},
+}
+
+// testLeaseOutputs tests that leased outputs are excluded from coin selection
+// until their lease is released, and that only the holder of the lease can
+// release it.
+func testLeaseOutputs(r *rpctest.Harness, w *lnwallet.LightningWallet,
+	_ *lnwallet.LightningWallet, t *testing.T) {
+
+	// Confirm any transactions left unconfirmed by the previous tests,
+	// otherwise they'd confirm while loading the test credits and throw
+	// off the balance we expect.
+	if _, err := r.Node.Generate(1); err != nil {
+		t.Fatalf("unable to generate block: %v", err)
+	}
+	if err := waitForWalletSync(r, w); err != nil {
+		t.Fatalf("unable to sync wallet: %v", err)
+	}
+
+	// Send some money from the miner to the wallet.
+	err := loadTestCredits(r, w, 20, 4)
+	if err != nil {
+		t.Fatalf("unable to send money to lnwallet: %v", err)
+	}
+
+	utxos, err := w.ListUnspentWitness(
+		1, math.MaxInt32, lnwallet.DefaultAccountName,
+	)
+	if err != nil {
+		t.Fatalf("unable to list utxos: %v", err)
+	}
+	if len(utxos) == 0 {
+		t.Fatalf("expected wallet to have utxos")
+	}
+	leased := utxos[0].OutPoint
+
+	lockID := lnwallet.LockID{1}
+	otherID := lnwallet.LockID{2}
+
+	expiration, err := w.LeaseOutput(lockID, leased, time.Hour)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	if !expiration.After(time.Now()) {
+		t.Fatalf("lease expiration %v not in the future", expiration)
+	}
+
+	// The output can't be leased under a different ID.
+	_, err = w.LeaseOutput(otherID, leased, time.Hour)
+	if err != lnwallet.ErrOutputAlreadyLeased {
+		t.Fatalf("expected ErrOutputAlreadyLeased, got %v", err)
+	}
+
+	leases, err := w.ListLeases()
+	if err != nil {
+		t.Fatalf("unable to list leases: %v", err)
+	}
+	if len(leases) != 1 || leases[0].OutPoint != leased ||
+		leases[0].LockID != lockID {
+
+		t.Fatalf("unexpected leases: %v", spew.Sdump(leases))
+	}
+
+	// The leased output should no longer be available for coin selection.
+	assertUnspent := func(expected bool) {
+		t.Helper()
+
+		utxos, err := w.ListUnspentWitness(
+			1, math.MaxInt32, lnwallet.DefaultAccountName,
+		)
+		if err != nil {
+			t.Fatalf("unable to list utxos: %v", err)
+		}
+
+		var found bool
+		for _, utxo := range utxos {
+			if utxo.OutPoint == leased {
+				found = true
+			}
+		}
+		if found != expected {
+			t.Fatalf("expected leased output to be listed: %v, "+
+				"was listed: %v", expected, found)
+		}
+	}
+	assertUnspent(false)
+
+	// Only the holder of the lease can release it.
+	err = w.ReleaseOutput(otherID, leased)
+	if err != lnwallet.ErrOutputUnlockNotAllowed {
+		t.Fatalf("expected ErrOutputUnlockNotAllowed, got %v", err)
+	}
+	if err := w.ReleaseOutput(lockID, leased); err != nil {
+		t.Fatalf("unable to release output: %v", err)
+	}
+	assertUnspent(true)
+
+	err = w.ReleaseOutput(lockID, leased)
+	if err != lnwallet.ErrOutputNotLeased {
+		t.Fatalf("expected ErrOutputNotLeased, got %v", err)
+	}
+}
+
+// testPsbtFundSignFinalize tests that the wallet is able to fund a PSBT
+// through coin selection, and then sign and finalize it such that the
+// extracted transaction is accepted by the network.
+func testPsbtFundSignFinalize(r *rpctest.Harness, w *lnwallet.LightningWallet,
+	_ *lnwallet.LightningWallet, t *testing.T) {
+
+	// Send some money from the miner to the wallet.
+	err := loadTestCredits(r, w, 20, 4)
+	if err != nil {
+		t.Fatalf("unable to send money to lnwallet: %v", err)
+	}
+
+	minerAddr, err := r.NewAddress()
+	if err != nil {
+		t.Fatalf("unable to generate address: %v", err)
+	}
+	minerScript, err := txscript.PayToAddrScript(minerAddr)
+	if err != nil {
+		t.Fatalf("unable to create pkscript: %v", err)
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxOut(wire.NewTxOut(btcutil.SatoshiPerBitcoin, minerScript))
+	packet, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		t.Fatalf("unable to create psbt: %v", err)
+	}
+
+	feeRate := lnwallet.SatPerKWeight(2500)
+	changeIndex, err := w.FundPsbt(packet, feeRate)
+	if err != nil {
+		t.Fatalf("unable to fund psbt: %v", err)
+	}
+	if len(packet.UnsignedTx.TxIn) == 0 {
+		t.Fatalf("expected psbt to be funded with inputs")
+	}
+	if changeIndex != int32(len(packet.UnsignedTx.TxOut)-1) {
+		t.Fatalf("expected change as last output, got index %d",
+			changeIndex)
+	}
+
+	// All inputs belong to the wallet, so every one of them should be
+	// signed.
+	signedInputs, err := w.SignPsbt(packet)
+	if err != nil {
+		t.Fatalf("unable to sign psbt: %v", err)
+	}
+	if len(signedInputs) != len(packet.UnsignedTx.TxIn) {
+		t.Fatalf("expected %d signed inputs, got %d",
+			len(packet.UnsignedTx.TxIn), len(signedInputs))
+	}
+
+	// Signing again shouldn't add any further signatures.
+	signedInputs, err = w.SignPsbt(packet)
+	if err != nil {
+		t.Fatalf("unable to sign psbt: %v", err)
+	}
+	if len(signedInputs) != 0 {
+		t.Fatalf("expected no inputs to be signed again, got %v",
+			signedInputs)
+	}
+
+	if err := w.FinalizePsbt(packet); err != nil {
+		t.Fatalf("unable to finalize psbt: %v", err)
+	}
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		t.Fatalf("unable to extract final tx: %v", err)
+	}
+
+	if err := w.PublishTransaction(finalTx, ""); err != nil {
+		t.Fatalf("unable to publish final tx: %v", err)
+	}
+	mineAndAssertTxInBlock(t, r, finalTx.TxHash())
+}
+
+// testWalletAccounts tests that funds received by named seed accounts and
+// imported watch-only accounts are tracked separately from the default
+// account.
+func testWalletAccounts(r *rpctest.Harness, w *lnwallet.LightningWallet,
+	_ *lnwallet.LightningWallet, t *testing.T) {
+
+	// Confirm any transactions left unconfirmed by the previous tests, so
+	// the balance of the default account stays put during this test.
+	if _, err := r.Node.Generate(1); err != nil {
+		t.Fatalf("unable to generate block: %v", err)
+	}
+	if err := waitForWalletSync(r, w); err != nil {
+		t.Fatalf("unable to sync wallet: %v", err)
+	}
+
+	origBalance, err := w.ConfirmedBalance(1, lnwallet.DefaultAccountName)
+	if err != nil {
+		t.Fatalf("unable to query balance: %v", err)
+	}
+
+	// Create a new account from the wallet's seed. Its name must be
+	// unique.
+	const seedAccount = "savings"
+	account, err := w.CreateAccount(seedAccount, lnwallet.WitnessPubKey)
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+	if account.Name != seedAccount || account.WatchOnly {
+		t.Fatalf("unexpected account: %v", spew.Sdump(account))
+	}
+	_, err = w.CreateAccount(seedAccount, lnwallet.WitnessPubKey)
+	if err != lnwallet.ErrAccountExists {
+		t.Fatalf("expected ErrAccountExists, got %v", err)
+	}
+
+	// Import a watch-only account from the account level extended public
+	// key of an unrelated seed.
+	seed := bytes.Repeat([]byte{0x01}, hdkeychain.RecommendedSeedLen)
+	rootKey, err := hdkeychain.NewMaster(seed, netParams)
+	if err != nil {
+		t.Fatalf("unable to create root key: %v", err)
+	}
+	accountKey := rootKey
+	path := []uint32{
+		hdkeychain.HardenedKeyStart + 84,
+		hdkeychain.HardenedKeyStart + netParams.HDCoinType,
+		hdkeychain.HardenedKeyStart,
+	}
+	for _, index := range path {
+		accountKey, err = accountKey.Child(index)
+		if err != nil {
+			t.Fatalf("unable to derive account key: %v", err)
+		}
+	}
+	accountPubKey, err := accountKey.Neuter()
+	if err != nil {
+		t.Fatalf("unable to neuter account key: %v", err)
+	}
+
+	_, bestHeight, err := r.Node.GetBestBlock()
+	if err != nil {
+		t.Fatalf("unable to get best block: %v", err)
+	}
+
+	const watchOnlyAccount = "cold"
+	_, err = w.ImportAccount(
+		watchOnlyAccount, accountPubKey, 0, lnwallet.WitnessPubKey,
+		uint32(bestHeight),
+	)
+	if err != nil {
+		t.Fatalf("unable to import account: %v", err)
+	}
+
+	// The first external address of the watch-only account must match the
+	// one derived from the private key.
+	watchOnlyAddr, err := w.NewAddress(
+		lnwallet.WitnessPubKey, false, watchOnlyAccount,
+	)
+	if err != nil {
+		t.Fatalf("unable to get watch-only address: %v", err)
+	}
+	childKey, err := accountKey.Child(0)
+	if err != nil {
+		t.Fatalf("unable to derive key: %v", err)
+	}
+	childKey, err = childKey.Child(0)
+	if err != nil {
+		t.Fatalf("unable to derive key: %v", err)
+	}
+	childPubKey, err := childKey.ECPubKey()
+	if err != nil {
+		t.Fatalf("unable to derive key: %v", err)
+	}
+	expectedAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(childPubKey.SerializeCompressed()), netParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	if watchOnlyAddr.String() != expectedAddr.String() {
+		t.Fatalf("expected watch-only address %v, got %v",
+			expectedAddr, watchOnlyAddr)
+	}
+
+	seedAddr, err := w.NewAddress(
+		lnwallet.WitnessPubKey, false, seedAccount,
+	)
+	if err != nil {
+		t.Fatalf("unable to get account address: %v", err)
+	}
+
+	// Fund both accounts from the miner and wait for the funds to
+	// confirm.
+	const amt = btcutil.SatoshiPerBitcoin
+	for _, addr := range []btcutil.Address{seedAddr, watchOnlyAddr} {
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatalf("unable to create pkscript: %v", err)
+		}
+		_, err = r.SendOutputs(
+			[]*wire.TxOut{wire.NewTxOut(amt, script)}, 2500,
+		)
+		if err != nil {
+			t.Fatalf("unable to send coins: %v", err)
+		}
+	}
+	if _, err := r.Node.Generate(1); err != nil {
+		t.Fatalf("unable to generate block: %v", err)
+	}
+	if err := waitForWalletSync(r, w); err != nil {
+		t.Fatalf("unable to sync wallet: %v", err)
+	}
+
+	for _, name := range []string{seedAccount, watchOnlyAccount} {
+		err := wait.NoError(func() error {
+			balance, err := w.ConfirmedBalance(1, name)
+			if err != nil {
+				return err
+			}
+			if balance != amt {
+				return fmt.Errorf("expected balance %v, got %v",
+					btcutil.Amount(amt), balance)
+			}
+
+			return nil
+		}, 10*time.Second)
+		if err != nil {
+			t.Fatalf("account %v: %v", name, err)
+		}
+
+		utxos, err := w.ListUnspentWitness(1, math.MaxInt32, name)
+		if err != nil {
+			t.Fatalf("unable to list unspent: %v", err)
+		}
+		if len(utxos) != 1 {
+			t.Fatalf("expected 1 utxo for account %v, got %d",
+				name, len(utxos))
+		}
+	}
+
+	// None of the funds must show up in the default account, as they're
+	// not available for coin selection.
+	balance, err := w.ConfirmedBalance(1, lnwallet.DefaultAccountName)
+	if err != nil {
+		t.Fatalf("unable to query balance: %v", err)
+	}
+	if balance != origBalance {
+		t.Fatalf("expected default balance %v, got %v", origBalance,
+			balance)
+	}
+
+	accounts, err := w.ListAccounts(watchOnlyAccount)
+	if err != nil {
+		t.Fatalf("unable to list accounts: %v", err)
+	}
+	if len(accounts) != 1 || !accounts[0].WatchOnly {
+		t.Fatalf("unexpected accounts: %v", spew.Sdump(accounts))
+	}
 }
 
 func clearWalletStates(a, b *lnwallet.LightningWallet) error {
@@ -3012,3 +3422,176 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 		}
 	}
 }
+
+// assertTxLabel asserts that the wallet reports the expected label for the
+// given transaction.
+func assertTxLabel(t *testing.T, w *lnwallet.LightningWallet,
+	txHash chainhash.Hash, expected string) {
+
+	t.Helper()
+
+	txs, err := w.ListTransactionDetails(lnwallet.DefaultAccountName)
+	if err != nil {
+		t.Fatalf("unable to retrieve transactions: %v", err)
+	}
+	for _, tx := range txs {
+		if tx.Hash != txHash {
+			continue
+		}
+		if tx.Label != expected {
+			t.Fatalf("expected label %q, got %q", expected,
+				tx.Label)
+		}
+		return
+	}
+
+	t.Fatalf("transaction %v not found in wallet", txHash)
+}
+
+// testTransactionLabels tests that labels given when publishing a transaction
+// are persisted, and that they can only be replaced when explicitly asked to.
+func testTransactionLabels(r *rpctest.Harness, w *lnwallet.LightningWallet,
+	_ *lnwallet.LightningWallet, t *testing.T) {
+
+	minerAddr, err := r.NewAddress()
+	if err != nil {
+		t.Fatalf("unable to generate address: %v", err)
+	}
+	outputScript, err := txscript.PayToAddrScript(minerAddr)
+	if err != nil {
+		t.Fatalf("unable to make output script: %v", err)
+	}
+	output := wire.NewTxOut(btcutil.SatoshiPerBitcoin/10, outputScript)
+
+	const initialLabel = "withdrawal"
+	tx, err := w.SendOutputs([]*wire.TxOut{output}, 2500, initialLabel)
+	if err != nil {
+		t.Fatalf("unable to send transaction: %v", err)
+	}
+	txHash := tx.TxHash()
+
+	mineAndAssertTxInBlock(t, r, txHash)
+	if err := waitForWalletSync(r, w); err != nil {
+		t.Fatalf("unable to sync wallet: %v", err)
+	}
+	assertTxLabel(t, w, txHash, initialLabel)
+
+	// An existing label must not be replaced unless overwrite is set.
+	const newLabel = "withdrawal to exchange"
+	err = w.LabelTransaction(txHash, newLabel, false)
+	if err != lnwallet.ErrTxLabelExists {
+		t.Fatalf("expected ErrTxLabelExists, got: %v", err)
+	}
+	assertTxLabel(t, w, txHash, initialLabel)
+
+	if err := w.LabelTransaction(txHash, newLabel, true); err != nil {
+		t.Fatalf("unable to overwrite label: %v", err)
+	}
+	assertTxLabel(t, w, txHash, newLabel)
+
+	// Transactions unknown to the wallet can't be labelled.
+	var unknownHash chainhash.Hash
+	err = w.LabelTransaction(unknownHash, newLabel, false)
+	if err != lnwallet.ErrUnknownTxid {
+		t.Fatalf("expected ErrUnknownTxid, got: %v", err)
+	}
+}
+
+// testReplaceTransaction tests that the fee of an unconfirmed transaction sent
+// by the wallet can be bumped by replacing it, and that the replaced
+// transaction is no longer considered by the wallet afterwards.
+func testReplaceTransaction(r *rpctest.Harness, w *lnwallet.LightningWallet,
+	_ *lnwallet.LightningWallet, t *testing.T) {
+
+	// Confirm any transactions left unconfirmed by the previous tests, so
+	// that the transaction we send below has no unconfirmed ancestors.
+	if _, err := r.Node.Generate(1); err != nil {
+		t.Fatalf("unable to generate block: %v", err)
+	}
+	if err := waitForWalletSync(r, w); err != nil {
+		t.Fatalf("unable to sync wallet: %v", err)
+	}
+
+	minerAddr, err := r.NewAddress()
+	if err != nil {
+		t.Fatalf("unable to generate address: %v", err)
+	}
+	outputScript, err := txscript.PayToAddrScript(minerAddr)
+	if err != nil {
+		t.Fatalf("unable to make output script: %v", err)
+	}
+	output := wire.NewTxOut(btcutil.SatoshiPerBitcoin/10, outputScript)
+
+	const label = "stuck payment"
+	tx, err := w.SendOutputs([]*wire.TxOut{output}, 2500, label)
+	if err != nil {
+		t.Fatalf("unable to send transaction: %v", err)
+	}
+	txHash := tx.TxHash()
+	if err := waitForMempoolTx(r, &txHash); err != nil {
+		t.Fatalf("tx not relayed to miner: %v", err)
+	}
+
+	// A replacement paying the same fee rate doesn't pay for its own
+	// relay, so it must be rejected.
+	if _, err := w.ReplaceTransaction(txHash, 2500); err == nil {
+		t.Fatalf("expected replacement at same fee rate to fail")
+	}
+
+	replacement, err := w.ReplaceTransaction(txHash, 2500*4)
+	if err != nil {
+		t.Fatalf("unable to replace transaction: %v", err)
+	}
+	replacementHash := replacement.TxHash()
+	if err := waitForMempoolTx(r, &replacementHash); err != nil {
+		t.Fatalf("replacement not relayed to miner: %v", err)
+	}
+
+	// The replacement must pay to the same outputs as the original.
+	if len(replacement.TxOut) != len(tx.TxOut) {
+		t.Fatalf("expected %v outputs, got %v", len(tx.TxOut),
+			len(replacement.TxOut))
+	}
+	var paysOutput bool
+	for _, txOut := range replacement.TxOut {
+		if bytes.Equal(txOut.PkScript, outputScript) &&
+			txOut.Value == output.Value {
+
+			paysOutput = true
+		}
+	}
+	if !paysOutput {
+		t.Fatalf("replacement doesn't pay to original output")
+	}
+
+	// The original transaction was already replaced, so it can't be
+	// replaced again.
+	if _, err := w.ReplaceTransaction(txHash, 2500*8); err == nil {
+		t.Fatalf("expected replacing the original again to fail")
+	}
+
+	mineAndAssertTxInBlock(t, r, replacementHash)
+	if err := waitForWalletSync(r, w); err != nil {
+		t.Fatalf("unable to sync wallet: %v", err)
+	}
+
+	// The wallet should only know of the replacement, which inherits the
+	// label of the original transaction.
+	txs, err := w.ListTransactionDetails(lnwallet.DefaultAccountName)
+	if err != nil {
+		t.Fatalf("unable to retrieve transactions: %v", err)
+	}
+	for _, txDetail := range txs {
+		if txDetail.Hash == txHash {
+			t.Fatalf("replaced transaction %v still in wallet",
+				txHash)
+		}
+	}
+	assertTxInWallet(t, w, replacementHash, true)
+	assertTxLabel(t, w, replacementHash, label)
+
+	_, err = w.ReplaceTransaction(replacementHash, 2500*8)
+	if err != lnwallet.ErrTxConfirmed {
+		t.Fatalf("expected ErrTxConfirmed, got: %v", err)
+	}
+}
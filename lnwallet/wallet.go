@@ -351,6 +351,14 @@ func (l *LightningWallet) LockedOutpoints() []*wire.OutPoint {
 	return outPoints
 }
 
+// ListUnspentWitnessFromDefaultAccount returns all unspent witness outputs of
+// the default account that have between minConfs and maxConfs confirmations.
+func (l *LightningWallet) ListUnspentWitnessFromDefaultAccount(minConfs,
+	maxConfs int32) ([]*Utxo, error) {
+
+	return l.ListUnspentWitness(minConfs, maxConfs, DefaultAccountName)
+}
+
 // ResetReservations reset the volatile wallet state which tracks all currently
 // active reservations.
 func (l *LightningWallet) ResetReservations() {
@@ -1338,7 +1346,9 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 
 	// Find all unlocked unspent witness outputs that satisfy the minimum
 	// number of confirmations required.
-	coins, err := l.ListUnspentWitness(minConfs, math.MaxInt32)
+	coins, err := l.ListUnspentWitness(
+		minConfs, math.MaxInt32, DefaultAccountName,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1380,7 +1390,9 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	// creation of dust.
 	var changeOutputs []*wire.TxOut
 	if changeAmt != 0 && changeAmt > DefaultDustLimit() {
-		changeAddr, err := l.NewAddress(WitnessPubKey, true)
+		changeAddr, err := l.NewAddress(
+			WitnessPubKey, true, DefaultAccountName,
+		)
 		if err != nil {
 			return nil, err
 		}
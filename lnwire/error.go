@@ -23,6 +23,11 @@ const (
 	// FundingOpen request for a channel that is above their current
 	// soft-limit.
 	ErrChanTooLarge FundingError = 3
+
+	// ErrShuttingDown is returned by a remote peer that receives a
+	// FundingOpen request while it is draining in preparation of a
+	// shutdown.
+	ErrShuttingDown FundingError = 4
 )
 
 // String returns a human readable version of the target FundingError.
@@ -34,6 +39,8 @@ func (e FundingError) String() string {
 		return "Synchronizing blockchain"
 	case ErrChanTooLarge:
 		return "channel too large"
+	case ErrShuttingDown:
+		return "node is shutting down"
 	default:
 		return "unknown error"
 	}
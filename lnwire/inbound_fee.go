@@ -0,0 +1,201 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// InboundFeeType is the TLV type of the record within a ChannelUpdate's extra
+// opaque data that carries the inbound fee of the channel. It is odd, so nodes
+// that don't understand it can safely ignore it.
+const InboundFeeType tlv.Type = 55555
+
+// Fee is a fee schema that, as opposed to the regular fee fields of a
+// ChannelUpdate, may be negative. It is used to express the inbound fee that a
+// node charges for HTLCs that arrive over a particular channel. A negative fee
+// is a discount on the fee charged for the outgoing channel.
+type Fee struct {
+	// BaseFee is the base fee in milli-satoshi.
+	BaseFee int32
+
+	// FeeRate is the fee rate in millionths of the HTLC amount.
+	FeeRate int32
+}
+
+// CalcFee computes the, possibly negative, fee charged for an HTLC of the
+// given amount.
+func (f Fee) CalcFee(amt MilliSatoshi) int64 {
+	return int64(f.BaseFee) + int64(f.FeeRate)*int64(amt)/1000000
+}
+
+// TotalFee returns the total fee a node charges for forwarding an HTLC of the
+// given amount, given the fee of its outgoing channel and this inbound fee of
+// its incoming channel. The inbound fee is computed over the amount the node
+// would receive without it. Nodes treat a negative total fee as zero, so the
+// returned fee is never negative.
+func (f Fee) TotalFee(outgoingFee, amt MilliSatoshi) MilliSatoshi {
+	totalFee := int64(outgoingFee) + f.CalcFee(amt+outgoingFee)
+	if totalFee < 0 {
+		return 0
+	}
+
+	return MilliSatoshi(totalFee)
+}
+
+// String returns a human readable representation of the fee.
+func (f Fee) String() string {
+	return fmt.Sprintf("base_fee=%v, fee_rate=%v", f.BaseFee, f.FeeRate)
+}
+
+// Record returns a TLV record that can be used to encode or decode the fee.
+func (f *Fee) Record() tlv.Record {
+	return tlv.MakeStaticRecord(InboundFeeType, f, 8, encodeFee, decodeFee)
+}
+
+// encodeFee is a tlv.Encoder for the Fee type.
+func encodeFee(w io.Writer, val interface{}, buf *[8]byte) error {
+	if f, ok := val.(*Fee); ok {
+		err := tlv.EUint32T(w, uint32(f.BaseFee), buf)
+		if err != nil {
+			return err
+		}
+
+		return tlv.EUint32T(w, uint32(f.FeeRate), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.Fee")
+}
+
+// decodeFee is a tlv.Decoder for the Fee type.
+func decodeFee(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if f, ok := val.(*Fee); ok && l == 8 {
+		var baseFee, feeRate uint32
+		if err := tlv.DUint32(r, &baseFee, buf, 4); err != nil {
+			return err
+		}
+		if err := tlv.DUint32(r, &feeRate, buf, 4); err != nil {
+			return err
+		}
+
+		f.BaseFee = int32(baseFee)
+		f.FeeRate = int32(feeRate)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.Fee", l, 8)
+}
+
+// ParseInboundFee extracts the inbound fee from the extra opaque data of a
+// ChannelUpdate. The boolean return value indicates whether the data carried
+// an inbound fee at all. An error is returned if the extra data isn't a valid
+// TLV stream.
+func ParseInboundFee(extraData []byte) (Fee, bool, error) {
+	var fee Fee
+	if len(extraData) == 0 {
+		return fee, false, nil
+	}
+
+	stream, err := tlv.NewStream(fee.Record())
+	if err != nil {
+		return fee, false, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(
+		bytes.NewReader(extraData),
+	)
+	if err != nil {
+		return Fee{}, false, err
+	}
+
+	_, ok := parsedTypes[InboundFeeType]
+
+	return fee, ok, nil
+}
+
+// SetInboundFee returns a copy of the given extra opaque data with its inbound
+// fee record replaced by the passed fee. If fee is nil, the record is removed.
+// All other records are preserved. An error is returned if the existing extra
+// data isn't a valid TLV stream.
+func SetInboundFee(extraData []byte, fee *Fee) ([]byte, error) {
+	tlvMap, err := parseTLVMap(extraData)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(tlvMap, uint64(InboundFeeType))
+	if fee != nil {
+		var b bytes.Buffer
+		record := fee.Record()
+		if err := record.Encode(&b); err != nil {
+			return nil, err
+		}
+		tlvMap[uint64(InboundFeeType)] = b.Bytes()
+	}
+
+	if len(tlvMap) == 0 {
+		return nil, nil
+	}
+
+	records, err := tlv.MapToRecords(tlvMap)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// parseTLVMap parses a canonically encoded TLV stream into a map of raw
+// records keyed by their type.
+func parseTLVMap(data []byte) (map[uint64][]byte, error) {
+	var (
+		r      = bytes.NewReader(data)
+		buf    [8]byte
+		tlvMap = make(map[uint64][]byte)
+		first  = true
+		last   uint64
+	)
+	for r.Len() > 0 {
+		typ, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, err
+		}
+
+		// Types must be strictly increasing for the stream to be
+		// canonical.
+		if !first && typ <= last {
+			return nil, tlv.ErrStreamNotCanonical
+		}
+		first = false
+		last = typ
+
+		length, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(r.Len()) {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		value := make([]byte, length)
+		if _, err := io.ReadFull(r, value); err != nil {
+			return nil, err
+		}
+		tlvMap[typ] = value
+	}
+
+	return tlvMap, nil
+}
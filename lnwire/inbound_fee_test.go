@@ -0,0 +1,106 @@
+package lnwire
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestInboundFeeExtraData asserts that an inbound fee can be added to,
+// extracted from and removed from a ChannelUpdate's extra opaque data without
+// disturbing any other records.
+func TestInboundFeeExtraData(t *testing.T) {
+	t.Parallel()
+
+	// Without any extra data, there is no inbound fee.
+	_, ok, err := ParseInboundFee(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("expected no inbound fee")
+	}
+
+	// Start with a stream that holds two unknown records, one on either
+	// side of the inbound fee type.
+	extraData := []byte{
+		0x01, 0x01, 0xaa,
+		0xfe, 0x00, 0x01, 0x00, 0x01, 0x01, 0xbb,
+	}
+
+	fee := Fee{BaseFee: -1000, FeeRate: -200}
+	withFee, err := SetInboundFee(extraData, &fee)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, ok, err := ParseInboundFee(withFee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || parsed != fee {
+		t.Fatalf("expected inbound fee %v, got %v (found=%v)", fee,
+			parsed, ok)
+	}
+
+	// Replacing the fee must not duplicate the record.
+	fee = Fee{BaseFee: 5, FeeRate: 10}
+	withFee, err = SetInboundFee(withFee, &fee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, _, err = ParseInboundFee(withFee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed != fee {
+		t.Fatalf("expected inbound fee %v, got %v", fee, parsed)
+	}
+
+	// Removing the fee again should yield the original stream.
+	withoutFee, err := SetInboundFee(withFee, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(withoutFee, extraData) {
+		t.Fatalf("expected extra data %x, got %x", extraData,
+			withoutFee)
+	}
+
+	// Extra data that isn't a valid TLV stream can't be modified.
+	_, err = SetInboundFee([]byte{0x02, 0x01, 0xaa, 0x01, 0x00}, &fee)
+	if err == nil {
+		t.Fatal("expected error for non-canonical stream")
+	}
+}
+
+// TestInboundFeeCalcFee asserts that the inbound fee may be negative.
+func TestInboundFeeCalcFee(t *testing.T) {
+	t.Parallel()
+
+	fee := Fee{BaseFee: -1000, FeeRate: -100}
+	if got := fee.CalcFee(1000000); got != -1100 {
+		t.Fatalf("expected fee -1100, got %v", got)
+	}
+
+	fee = Fee{BaseFee: 1000, FeeRate: 100}
+	if got := fee.CalcFee(1000000); got != 1100 {
+		t.Fatalf("expected fee 1100, got %v", got)
+	}
+}
+
+// TestInboundFeeTotalFee asserts that the inbound fee is computed over the
+// amount including the outgoing fee, and that a negative inbound fee can at
+// most cancel out the outgoing fee.
+func TestInboundFeeTotalFee(t *testing.T) {
+	t.Parallel()
+
+	fee := Fee{BaseFee: 1000, FeeRate: 100000}
+	if got := fee.TotalFee(10000, 90000); got != 21000 {
+		t.Fatalf("expected total fee 21000, got %v", got)
+	}
+
+	fee = Fee{BaseFee: -1000, FeeRate: -100000}
+	if got := fee.TotalFee(10000, 90000); got != 0 {
+		t.Fatalf("expected total fee 0, got %v", got)
+	}
+}
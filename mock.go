@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -11,6 +12,8 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcutil/psbt"
 	"github.com/btcsuite/btcwallet/wallet/txauthor"
 
 	"github.com/lightningnetwork/lnd/chainntnfs"
@@ -86,6 +89,7 @@ func (m *mockSigner) ComputeInputScript(tx *wire.MsgTx,
 
 type mockNotfier struct {
 	confChannel chan *chainntnfs.TxConfirmation
+	epochChan   chan *chainntnfs.BlockEpoch
 }
 
 func (m *mockNotfier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
@@ -96,8 +100,13 @@ func (m *mockNotfier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 }
 func (m *mockNotfier) RegisterBlockEpochNtfn(
 	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+	epochChan := m.epochChan
+	if epochChan == nil {
+		epochChan = make(chan *chainntnfs.BlockEpoch)
+	}
+
 	return &chainntnfs.BlockEpochEvent{
-		Epochs: make(chan *chainntnfs.BlockEpoch),
+		Epochs: epochChan,
 		Cancel: func() {},
 	}, nil
 }
@@ -130,6 +139,7 @@ func makeMockSpendNotifier() *mockSpendNotifier {
 	return &mockSpendNotifier{
 		mockNotfier: &mockNotfier{
 			confChannel: make(chan *chainntnfs.TxConfirmation),
+			epochChan:   make(chan *chainntnfs.BlockEpoch),
 		},
 		spendMap: make(map[wire.OutPoint][]chan *chainntnfs.SpendDetail),
 		spends:   make(map[wire.OutPoint]*chainntnfs.SpendDetail),
@@ -250,19 +260,21 @@ func (*mockWalletController) FetchInputInfo(
 	}
 	return utxo, nil
 }
-func (*mockWalletController) ConfirmedBalance(confs int32) (btcutil.Amount, error) {
+func (*mockWalletController) ConfirmedBalance(confs int32,
+	_ string) (btcutil.Amount, error) {
+
 	return 0, nil
 }
 
 // NewAddress is called to get new addresses for delivery, change etc.
 func (m *mockWalletController) NewAddress(addrType lnwallet.AddressType,
-	change bool) (btcutil.Address, error) {
+	change bool, _ string) (btcutil.Address, error) {
 	addr, _ := btcutil.NewAddressPubKey(
 		m.rootKey.PubKey().SerializeCompressed(), &chaincfg.MainNetParams)
 	return addr, nil
 }
-func (*mockWalletController) LastUnusedAddress(addrType lnwallet.AddressType) (
-	btcutil.Address, error) {
+func (*mockWalletController) LastUnusedAddress(addrType lnwallet.AddressType,
+	_ string) (btcutil.Address, error) {
 	return nil, nil
 }
 
@@ -271,7 +283,7 @@ func (*mockWalletController) IsOurAddress(a btcutil.Address) bool {
 }
 
 func (*mockWalletController) SendOutputs(outputs []*wire.TxOut,
-	_ lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+	_ lnwallet.SatPerKWeight, _ string) (*wire.MsgTx, error) {
 
 	return nil, nil
 }
@@ -285,7 +297,7 @@ func (*mockWalletController) CreateSimpleTx(outputs []*wire.TxOut,
 // ListUnspentWitness is called by the wallet when doing coin selection. We just
 // need one unspent for the funding transaction.
 func (m *mockWalletController) ListUnspentWitness(minconfirms,
-	maxconfirms int32) ([]*lnwallet.Utxo, error) {
+	maxconfirms int32, _ string) ([]*lnwallet.Utxo, error) {
 
 	// If the mock already has a list of utxos, return it.
 	if m.utxos != nil {
@@ -307,15 +319,67 @@ func (m *mockWalletController) ListUnspentWitness(minconfirms,
 	ret = append(ret, utxo)
 	return ret, nil
 }
-func (*mockWalletController) ListTransactionDetails() ([]*lnwallet.TransactionDetail, error) {
+func (*mockWalletController) ListTransactionDetails(_ string) (
+	[]*lnwallet.TransactionDetail, error) {
+
+	return nil, nil
+}
+func (*mockWalletController) ListAccounts(string) ([]*lnwallet.Account,
+	error) {
+
+	return nil, nil
+}
+func (*mockWalletController) CreateAccount(string,
+	lnwallet.AddressType) (*lnwallet.Account, error) {
+
+	return nil, nil
+}
+func (*mockWalletController) ImportAccount(string, *hdkeychain.ExtendedKey,
+	uint32, lnwallet.AddressType, uint32) (*lnwallet.Account, error) {
+
 	return nil, nil
 }
 func (*mockWalletController) LockOutpoint(o wire.OutPoint)   {}
 func (*mockWalletController) UnlockOutpoint(o wire.OutPoint) {}
-func (m *mockWalletController) PublishTransaction(tx *wire.MsgTx) error {
+func (*mockWalletController) LeaseOutput(lnwallet.LockID, wire.OutPoint,
+	time.Duration) (time.Time, error) {
+
+	return time.Now(), nil
+}
+func (*mockWalletController) ReleaseOutput(lnwallet.LockID, wire.OutPoint) error {
+	return nil
+}
+func (*mockWalletController) ListLeases() ([]*lnwallet.LeasedOutput, error) {
+	return nil, nil
+}
+func (*mockWalletController) FundPsbt(*psbt.Packet,
+	lnwallet.SatPerKWeight) (int32, error) {
+
+	return 0, nil
+}
+func (*mockWalletController) SignPsbt(*psbt.Packet) ([]uint32, error) {
+	return nil, nil
+}
+func (*mockWalletController) FinalizePsbt(*psbt.Packet) error {
+	return nil
+}
+func (m *mockWalletController) PublishTransaction(tx *wire.MsgTx,
+	_ string) error {
+
 	m.publishedTransactions <- tx
 	return nil
 }
+func (*mockWalletController) LabelTransaction(chainhash.Hash, string,
+	bool) error {
+
+	return nil
+}
+
+func (*mockWalletController) ReplaceTransaction(chainhash.Hash,
+	lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+
+	return nil, nil
+}
 func (*mockWalletController) SubscribeTransactions() (lnwallet.TransactionSubscription, error) {
 	return nil, nil
 }
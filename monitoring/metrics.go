@@ -0,0 +1,8 @@
+package monitoring
+
+// Counter is a metric that represents a single, monotonically increasing
+// value.
+type Counter interface {
+	// Inc increments the counter by one.
+	Inc()
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
@@ -43,3 +44,16 @@ func ExportPrometheusMetrics(grpcServer *grpc.Server, cfg lncfg.Prometheus) erro
 
 	return nil
 }
+
+// NewCounter creates a counter with the given name and help text, and
+// registers it such that it's exported along with the gRPC metrics.
+func NewCounter(name, help string) Counter {
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "lnd",
+		Name:      name,
+		Help:      help,
+	})
+	prometheus.MustRegister(counter)
+
+	return counter
+}
@@ -29,6 +29,12 @@ var (
 	// the time of the request.
 	ErrEnableInactiveChan = errors.New("unable to enable channel which " +
 		"is not currently active")
+
+	// ErrEnableManuallyDisabledChan signals that an automatic request to
+	// enable a channel could not be completed because the channel was
+	// manually disabled.
+	ErrEnableManuallyDisabledChan = errors.New("channel is manually " +
+		"disabled")
 )
 
 // ChanStatusConfig holds parameters and resources required by the
@@ -57,6 +63,9 @@ type ChanStatusConfig struct {
 	// Graph stores the channel info and policies for channels in DB.
 	Graph ChannelGraph
 
+	// Store persists the set of channels that were manually disabled.
+	Store StatusStore
+
 	// ChanEnableTimeout is the duration a peer's connect must remain stable
 	// before attempting to reenable the channel.
 	//
@@ -97,6 +106,12 @@ type ChanStatusManager struct {
 	// loop.
 	chanStates channelStates
 
+	// manuallyDisabled is the set of channels that were manually disabled
+	// and must not be reenabled automatically. It mirrors the contents of
+	// the StatusStore. Access to the map is serialized by the
+	// statusManager's event loop.
+	manuallyDisabled map[wire.OutPoint]struct{}
+
 	// enableRequests pipes external requests to enable a channel into the
 	// primary event loop.
 	enableRequests chan statusRequest
@@ -105,6 +120,10 @@ type ChanStatusManager struct {
 	// primary event loop.
 	disableRequests chan statusRequest
 
+	// autoRequests pipes external requests to restore automatic control
+	// over a channel's status into the primary event loop.
+	autoRequests chan statusRequest
+
 	// statusSampleTicker fires at the interval prescribed by
 	// ChanStatusSampleInterval to check if channels in chanStates have
 	// become inactive.
@@ -148,6 +167,7 @@ func NewChanStatusManager(cfg *ChanStatusConfig) (*ChanStatusManager, error) {
 		statusSampleTicker: time.NewTicker(cfg.ChanStatusSampleInterval),
 		enableRequests:     make(chan statusRequest),
 		disableRequests:    make(chan statusRequest),
+		autoRequests:       make(chan statusRequest),
 		quit:               make(chan struct{}),
 	}, nil
 }
@@ -162,6 +182,10 @@ func (m *ChanStatusManager) Start() error {
 }
 
 func (m *ChanStatusManager) start() error {
+	if err := m.loadManuallyDisabled(); err != nil {
+		return err
+	}
+
 	channels, err := m.fetchChannels()
 	if err != nil {
 		return err
@@ -193,6 +217,45 @@ func (m *ChanStatusManager) start() error {
 	return nil
 }
 
+// loadManuallyDisabled populates the set of manually disabled channels from
+// the StatusStore. Entries for channels that are no longer open are removed
+// from the store.
+func (m *ChanStatusManager) loadManuallyDisabled() error {
+	disabled, err := m.cfg.Store.FetchManuallyDisabled()
+	if err != nil {
+		return err
+	}
+
+	allChannels, err := m.cfg.DB.FetchAllOpenChannels()
+	if err != nil {
+		return err
+	}
+
+	openChans := make(map[wire.OutPoint]struct{}, len(allChannels))
+	for _, c := range allChannels {
+		openChans[c.FundingOutpoint] = struct{}{}
+	}
+
+	for outpoint := range disabled {
+		if _, ok := openChans[outpoint]; ok {
+			continue
+		}
+
+		log.Debugf("Removing closed channel(%v) from set of manually "+
+			"disabled channels", outpoint)
+
+		err := m.cfg.Store.SetManuallyDisabled(outpoint, false)
+		if err != nil {
+			return err
+		}
+		delete(disabled, outpoint)
+	}
+
+	m.manuallyDisabled = disabled
+
+	return nil
+}
+
 // Stop safely shuts down the ChanStatusManager.
 func (m *ChanStatusManager) Stop() error {
 	m.stopped.Do(func() {
@@ -209,39 +272,62 @@ func (m *ChanStatusManager) Stop() error {
 // channel is found to be disabled, a new announcement will be signed with the
 // disabled bit cleared and broadcast to the network.
 //
+// If the channel was manually disabled, only a manual request will enable it,
+// otherwise ErrEnableManuallyDisabledChan is returned.
+//
 // NOTE: RequestEnable should only be called after a stable connection with the
 // channel's peer has lasted at least the ChanEnableTimeout. Failure to do so
 // may result in behavior that deviates from the expected behavior of the state
 // machine.
-func (m *ChanStatusManager) RequestEnable(outpoint wire.OutPoint) error {
-	return m.submitRequest(m.enableRequests, outpoint)
+func (m *ChanStatusManager) RequestEnable(outpoint wire.OutPoint,
+	manual bool) error {
+
+	return m.submitRequest(m.enableRequests, outpoint, manual)
 }
 
 // RequestDisable submits a request to immediately disable a channel identified
 // by the provided outpoint. If the channel is already disabled, no action will
 // be taken. Otherwise, a new announcement will be signed with the disabled bit
 // set and broadcast to the network.
-func (m *ChanStatusManager) RequestDisable(outpoint wire.OutPoint) error {
-	return m.submitRequest(m.disableRequests, outpoint)
+//
+// A manual request additionally marks the channel as manually disabled, which
+// persists across restarts and prevents the channel from being reenabled
+// automatically.
+func (m *ChanStatusManager) RequestDisable(outpoint wire.OutPoint,
+	manual bool) error {
+
+	return m.submitRequest(m.disableRequests, outpoint, manual)
+}
+
+// RequestAuto submits a request to hand control over the status of a channel
+// back to the ChanStatusManager. If the channel was manually disabled, it is
+// reenabled right away if it is active, otherwise it will be reenabled the
+// next time its peer establishes a stable connection. For channels that
+// weren't manually disabled, no action will be taken.
+func (m *ChanStatusManager) RequestAuto(outpoint wire.OutPoint) error {
+	return m.submitRequest(m.autoRequests, outpoint, true)
 }
 
 // statusRequest is passed to the statusManager to request a change in status
 // for a particular channel point.  The exact action is governed by passing the
-// request through one of the enableRequests or disableRequests channels.
+// request through one of the enableRequests, disableRequests or autoRequests
+// channels.
 type statusRequest struct {
 	outpoint wire.OutPoint
+	manual   bool
 	errChan  chan error
 }
 
 // submitRequest sends a request for either enabling or disabling a particular
 // outpoint and awaits an error response. The request type is dictated by the
-// reqChan passed in, which can be either of the enableRequests or
-// disableRequests channels.
+// reqChan passed in, which can be either of the enableRequests,
+// disableRequests or autoRequests channels.
 func (m *ChanStatusManager) submitRequest(reqChan chan statusRequest,
-	outpoint wire.OutPoint) error {
+	outpoint wire.OutPoint, manual bool) error {
 
 	req := statusRequest{
 		outpoint: outpoint,
+		manual:   manual,
 		errChan:  make(chan error, 1),
 	}
 
@@ -275,11 +361,20 @@ func (m *ChanStatusManager) statusManager() {
 
 		// Process any requests to mark channel as enabled.
 		case req := <-m.enableRequests:
-			req.errChan <- m.processEnableRequest(req.outpoint)
+			req.errChan <- m.processEnableRequest(
+				req.outpoint, req.manual,
+			)
 
 		// Process any requests to mark channel as disabled.
 		case req := <-m.disableRequests:
-			req.errChan <- m.processDisableRequest(req.outpoint)
+			req.errChan <- m.processDisableRequest(
+				req.outpoint, req.manual,
+			)
+
+		// Process any requests to restore automatic control over a
+		// channel's status.
+		case req := <-m.autoRequests:
+			req.errChan <- m.processAutoRequest(req.outpoint)
 
 		// Use long-polling to detect when channels become inactive.
 		case <-m.statusSampleTicker.C:
@@ -306,13 +401,21 @@ func (m *ChanStatusManager) statusManager() {
 // ChanStatusEnabled. If the channel is not active at the time of the request,
 // ErrEnableInactiveChan will be returned. An update will be broadcast only if
 // the channel is currently disabled, otherwise no update will be sent on the
-// network.
-func (m *ChanStatusManager) processEnableRequest(outpoint wire.OutPoint) error {
+// network. Manually disabled channels can only be enabled by a manual request.
+func (m *ChanStatusManager) processEnableRequest(outpoint wire.OutPoint,
+	manual bool) error {
+
 	curState, err := m.getOrInitChanStatus(outpoint)
 	if err != nil {
 		return err
 	}
 
+	// Automatic requests must not override the user's decision to keep
+	// the channel disabled.
+	if curState.Status == ChanStatusManuallyDisabled && !manual {
+		return ErrEnableManuallyDisabledChan
+	}
+
 	// Quickly check to see if the requested channel is active within the
 	// htlcswitch and return an error if it isn't.
 	chanID := lnwire.NewChanIDFromOutPoint(&outpoint)
@@ -340,6 +443,25 @@ func (m *ChanStatusManager) processEnableRequest(outpoint wire.OutPoint) error {
 		if err != nil {
 			return err
 		}
+
+	// The channel was manually disabled, so we'll first clear the
+	// persisted flag before announcing it as enabled. This way a failure
+	// in between leaves the channel disabled under automatic control,
+	// rather than enabled on the network while marked as manually
+	// disabled.
+	case ChanStatusManuallyDisabled:
+		if err := m.clearManuallyDisabled(outpoint); err != nil {
+			return err
+		}
+		m.chanStates.markDisabled(outpoint)
+
+		log.Infof("Announcing channel(%v) enabled [requested]",
+			outpoint)
+
+		err := m.signAndSendNextUpdate(outpoint, false)
+		if err != nil {
+			return err
+		}
 	}
 
 	m.chanStates.markEnabled(outpoint)
@@ -349,10 +471,12 @@ func (m *ChanStatusManager) processEnableRequest(outpoint wire.OutPoint) error {
 
 // processDisableRequest attempts to disable the given outpoint. If the method
 // returns nil, the status of the channel in chanStates will be
-// ChanStatusDisabled. An update will only be sent if the channel has a status
-// other than ChanStatusEnabled, otherwise no update will be sent on the
-// network.
-func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint) error {
+// ChanStatusDisabled, or ChanStatusManuallyDisabled for manual requests. An
+// update will only be sent if the channel is currently enabled or
+// pending-disabled, otherwise no update will be sent on the network.
+func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint,
+	manual bool) error {
+
 	curState, err := m.getOrInitChanStatus(outpoint)
 	if err != nil {
 		return err
@@ -360,9 +484,12 @@ func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint) error
 
 	switch curState.Status {
 
-	// Channel is already disabled, nothing to do.
-	case ChanStatusDisabled:
-		return nil
+	// Channel is already disabled, nothing to do other than recording a
+	// manual request.
+	case ChanStatusDisabled, ChanStatusManuallyDisabled:
+		if !manual {
+			return nil
+		}
 
 	// We'll sign a new update disabling the channel if the current status
 	// is enabled or pending-inactive.
@@ -376,6 +503,24 @@ func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint) error
 		}
 	}
 
+	// A manual disable is only persisted once the disabling update has
+	// been sent, such that a channel marked as manually disabled is never
+	// announced as enabled.
+	if manual {
+		if curState.Status == ChanStatusManuallyDisabled {
+			return nil
+		}
+
+		err := m.cfg.Store.SetManuallyDisabled(outpoint, true)
+		if err != nil {
+			return err
+		}
+		m.manuallyDisabled[outpoint] = struct{}{}
+		m.chanStates.markManuallyDisabled(outpoint)
+
+		return nil
+	}
+
 	// If the disable was requested via the manager's public interface, we
 	// will remove the output from our map of channel states. Typically this
 	// signals that the channel is being closed, so this frees up the space
@@ -387,6 +532,60 @@ func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint) error
 	return nil
 }
 
+// processAutoRequest hands control over the status of the given outpoint back
+// to the ChanStatusManager. If the channel was manually disabled, it is
+// reenabled immediately if it is active. Otherwise, it remains disabled until
+// the next request to enable it.
+func (m *ChanStatusManager) processAutoRequest(outpoint wire.OutPoint) error {
+	curState, err := m.getOrInitChanStatus(outpoint)
+	if err != nil {
+		return err
+	}
+
+	// If the channel wasn't manually disabled, it is already under
+	// automatic control.
+	if curState.Status != ChanStatusManuallyDisabled {
+		return nil
+	}
+
+	if err := m.clearManuallyDisabled(outpoint); err != nil {
+		return err
+	}
+	m.chanStates.markDisabled(outpoint)
+
+	// If the link is still down, the channel will be reenabled once the
+	// peer reconnects.
+	chanID := lnwire.NewChanIDFromOutPoint(&outpoint)
+	if !m.cfg.IsChannelActive(chanID) {
+		return nil
+	}
+
+	// Otherwise, there won't be another request to enable the channel
+	// until the peer reconnects, so we'll enable it right away.
+	log.Infof("Announcing channel(%v) enabled [auto]", outpoint)
+
+	err = m.signAndSendNextUpdate(outpoint, false)
+	if err != nil {
+		return err
+	}
+
+	m.chanStates.markEnabled(outpoint)
+
+	return nil
+}
+
+// clearManuallyDisabled removes the outpoint from the set of manually disabled
+// channels.
+func (m *ChanStatusManager) clearManuallyDisabled(outpoint wire.OutPoint) error {
+	err := m.cfg.Store.SetManuallyDisabled(outpoint, false)
+	if err != nil {
+		return err
+	}
+	delete(m.manuallyDisabled, outpoint)
+
+	return nil
+}
+
 // markPendingInactiveChannels performs a sweep of the database's active
 // channels and determines which, if any, should have a disable announcement
 // scheduled. Once an active channel is determined to be pending-inactive, one
@@ -554,8 +753,9 @@ func (m *ChanStatusManager) fetchLastChanUpdateByOutPoint(op wire.OutPoint) (
 // loadInitialChanState determines the initial ChannelState for a particular
 // outpoint. The initial ChanStatus for a given outpoint will either be
 // ChanStatusEnabled or ChanStatusDisabled, determined by inspecting the bits on
-// the most recent announcement. An error is returned if the latest update could
-// not be retrieved.
+// the most recent announcement, or ChanStatusManuallyDisabled if the channel
+// was manually disabled. An error is returned if the latest update could not
+// be retrieved.
 func (m *ChanStatusManager) loadInitialChanState(
 	outpoint *wire.OutPoint) (ChannelState, error) {
 
@@ -567,9 +767,15 @@ func (m *ChanStatusManager) loadInitialChanState(
 	// Determine the channel's starting status by inspecting the disable bit
 	// on last announcement we sent out.
 	var initialStatus ChanStatus
-	if lastUpdate.ChannelFlags&lnwire.ChanUpdateDisabled == 0 {
+	_, manuallyDisabled := m.manuallyDisabled[*outpoint]
+	switch {
+	case manuallyDisabled:
+		initialStatus = ChanStatusManuallyDisabled
+
+	case lastUpdate.ChannelFlags&lnwire.ChanUpdateDisabled == 0:
 		initialStatus = ChanStatusEnabled
-	} else {
+
+	default:
 		initialStatus = ChanStatusDisabled
 	}
 
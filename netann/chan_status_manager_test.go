@@ -302,6 +302,44 @@ func (s *mockSwitch) SetStatus(chanID lnwire.ChannelID, active bool) {
 	s.isActive[chanID] = active
 }
 
+type mockStore struct {
+	mu       sync.Mutex
+	disabled map[wire.OutPoint]struct{}
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{
+		disabled: make(map[wire.OutPoint]struct{}),
+	}
+}
+
+func (s *mockStore) FetchManuallyDisabled() (map[wire.OutPoint]struct{},
+	error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	disabled := make(map[wire.OutPoint]struct{}, len(s.disabled))
+	for op := range s.disabled {
+		disabled[op] = struct{}{}
+	}
+
+	return disabled, nil
+}
+
+func (s *mockStore) SetManuallyDisabled(op wire.OutPoint, disabled bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if disabled {
+		s.disabled[op] = struct{}{}
+	} else {
+		delete(s.disabled, op)
+	}
+
+	return nil
+}
+
 func newManagerCfg(t *testing.T, numChannels int,
 	startEnabled bool) (*netann.ChanStatusConfig, *mockGraph, *mockSwitch) {
 
@@ -327,6 +365,7 @@ func newManagerCfg(t *testing.T, numChannels int,
 		ApplyChannelUpdate:       graph.ApplyChannelUpdate,
 		DB:                       graph,
 		Graph:                    graph,
+		Store:                    newMockStore(),
 	}
 
 	return cfg, graph, htlcSwitch
@@ -338,6 +377,7 @@ type testHarness struct {
 	graph              *mockGraph
 	htlcSwitch         *mockSwitch
 	mgr                *netann.ChanStatusManager
+	cfg                *netann.ChanStatusConfig
 	ourPubKey          *btcec.PublicKey
 	safeDisableTimeout time.Duration
 }
@@ -367,6 +407,7 @@ func newHarness(t *testing.T, numChannels int,
 		graph:              graph,
 		htlcSwitch:         htlcSwitch,
 		mgr:                mgr,
+		cfg:                cfg,
 		ourPubKey:          cfg.OurPubKey,
 		safeDisableTimeout: (3 * cfg.ChanDisableTimeout) / 2, // 1.5x
 	}
@@ -381,6 +422,27 @@ func newHarness(t *testing.T, numChannels int,
 	return h
 }
 
+// restart stops the harness's ChanStatusManager, and replaces it with a new
+// one using the same configuration, simulating a restart of the daemon.
+func (h *testHarness) restart() {
+	h.t.Helper()
+
+	if err := h.mgr.Stop(); err != nil {
+		h.t.Fatalf("unable to stop chan status manager: %v", err)
+	}
+
+	mgr, err := netann.NewChanStatusManager(h.cfg)
+	if err != nil {
+		h.t.Fatalf("unable to create chan status manager: %v", err)
+	}
+
+	if err := mgr.Start(); err != nil {
+		h.t.Fatalf("unable to start chan status manager: %v", err)
+	}
+
+	h.mgr = mgr
+}
+
 // markActive updates the active status of the passed channels within the mock
 // switch to active.
 func (h *testHarness) markActive(channels []*channeldb.OpenChannel) {
@@ -428,23 +490,72 @@ func (h *testHarness) assertDisables(channels []*channeldb.OpenChannel, expErr e
 func (h *testHarness) assertEnable(outpoint wire.OutPoint, expErr error) {
 	h.t.Helper()
 
-	err := h.mgr.RequestEnable(outpoint)
+	err := h.mgr.RequestEnable(outpoint, false)
 	if err != expErr {
 		h.t.Fatalf("expected enable error: %v, got %v", expErr, err)
 	}
 }
 
+// assertManualEnables requests manual enables for all of the passed channels,
+// and asserts that the errors returned from RequestEnable matches expErr.
+func (h *testHarness) assertManualEnables(channels []*channeldb.OpenChannel,
+	expErr error) {
+
+	h.t.Helper()
+
+	for _, channel := range channels {
+		err := h.mgr.RequestEnable(channel.FundingOutpoint, true)
+		if err != expErr {
+			h.t.Fatalf("expected manual enable error: %v, got %v",
+				expErr, err)
+		}
+	}
+}
+
 // assertDisable requests a disable for the given outpoint, and asserts that the
 // returned error matches expErr.
 func (h *testHarness) assertDisable(outpoint wire.OutPoint, expErr error) {
 	h.t.Helper()
 
-	err := h.mgr.RequestDisable(outpoint)
+	err := h.mgr.RequestDisable(outpoint, false)
 	if err != expErr {
 		h.t.Fatalf("expected disable error: %v, got %v", expErr, err)
 	}
 }
 
+// assertManualDisables requests manual disables for all of the passed
+// channels, and asserts that the errors returned from RequestDisable matches
+// expErr.
+func (h *testHarness) assertManualDisables(channels []*channeldb.OpenChannel,
+	expErr error) {
+
+	h.t.Helper()
+
+	for _, channel := range channels {
+		err := h.mgr.RequestDisable(channel.FundingOutpoint, true)
+		if err != expErr {
+			h.t.Fatalf("expected manual disable error: %v, got %v",
+				expErr, err)
+		}
+	}
+}
+
+// assertAutos requests automatic control for all of the passed channels, and
+// asserts that the errors returned from RequestAuto matches expErr.
+func (h *testHarness) assertAutos(channels []*channeldb.OpenChannel,
+	expErr error) {
+
+	h.t.Helper()
+
+	for _, channel := range channels {
+		err := h.mgr.RequestAuto(channel.FundingOutpoint)
+		if err != expErr {
+			h.t.Fatalf("expected auto error: %v, got %v", expErr,
+				err)
+		}
+	}
+}
+
 // assertNoUpdates waits for the specified duration, and asserts that no updates
 // are announced on the network.
 func (h *testHarness) assertNoUpdates(duration time.Duration) {
@@ -791,6 +902,126 @@ var stateMachineTests = []stateMachineTest{
 			h.assertNoUpdates(h.safeDisableTimeout)
 		},
 	},
+	{
+		name:         "manual disable prevents enable",
+		startActive:  true,
+		startEnabled: true,
+		fn: func(h testHarness) {
+			// Manually disable all channels, which should be
+			// announced right away.
+			h.assertManualDisables(h.graph.chans(), nil)
+			h.assertUpdates(
+				h.graph.chans(), false, h.safeDisableTimeout,
+			)
+
+			// An automatic disable, as issued when closing a
+			// channel, must not lift the manual disable.
+			h.assertDisables(h.graph.chans(), nil)
+
+			// Requests to enable the channels, as issued when a
+			// peer reconnects, should now be rejected.
+			h.assertEnables(
+				h.graph.chans(),
+				netann.ErrEnableManuallyDisabledChan,
+			)
+			h.assertNoUpdates(h.safeDisableTimeout)
+		},
+	},
+	{
+		name:         "manual disable persists across restart",
+		startActive:  true,
+		startEnabled: true,
+		fn: func(h testHarness) {
+			h.assertManualDisables(h.graph.chans(), nil)
+			h.assertUpdates(
+				h.graph.chans(), false, h.safeDisableTimeout,
+			)
+
+			// After restarting, the channels should still be
+			// considered manually disabled.
+			h.restart()
+			defer h.mgr.Stop()
+
+			h.assertEnables(
+				h.graph.chans(),
+				netann.ErrEnableManuallyDisabledChan,
+			)
+
+			// Manually enabling them again should succeed, after
+			// which they're under automatic control again.
+			h.assertManualEnables(h.graph.chans(), nil)
+			h.assertUpdates(
+				h.graph.chans(), true, h.safeDisableTimeout,
+			)
+			h.assertEnables(h.graph.chans(), nil)
+			h.assertNoUpdates(h.safeDisableTimeout)
+		},
+	},
+	{
+		name:         "manual enable inactive channel",
+		startActive:  false,
+		startEnabled: false,
+		fn: func(h testHarness) {
+			// Manually disabling already disabled channels
+			// shouldn't result in any updates.
+			h.assertManualDisables(h.graph.chans(), nil)
+			h.assertNoUpdates(h.safeDisableTimeout)
+
+			// The channels can't be enabled while inactive, even
+			// if requested manually.
+			h.assertManualEnables(
+				h.graph.chans(), netann.ErrEnableInactiveChan,
+			)
+			h.assertNoUpdates(h.safeDisableTimeout)
+		},
+	},
+	{
+		name:         "auto reenables active channels",
+		startActive:  true,
+		startEnabled: true,
+		fn: func(h testHarness) {
+			h.assertManualDisables(h.graph.chans(), nil)
+			h.assertUpdates(
+				h.graph.chans(), false, h.safeDisableTimeout,
+			)
+
+			// Handing control back to the manager should enable
+			// the active channels right away.
+			h.assertAutos(h.graph.chans(), nil)
+			h.assertUpdates(
+				h.graph.chans(), true, h.safeDisableTimeout,
+			)
+
+			// A second request is a no-op.
+			h.assertAutos(h.graph.chans(), nil)
+			h.assertNoUpdates(h.safeDisableTimeout)
+		},
+	},
+	{
+		name:         "auto waits for inactive channels",
+		startActive:  true,
+		startEnabled: true,
+		fn: func(h testHarness) {
+			h.assertManualDisables(h.graph.chans(), nil)
+			h.assertUpdates(
+				h.graph.chans(), false, h.safeDisableTimeout,
+			)
+
+			// If the channels are inactive when control is handed
+			// back, they should remain disabled.
+			h.markInactive(h.graph.chans())
+			h.assertAutos(h.graph.chans(), nil)
+			h.assertNoUpdates(h.safeDisableTimeout)
+
+			// Once the peers reconnect, the channels should be
+			// enabled as usual.
+			h.markActive(h.graph.chans())
+			h.assertEnables(h.graph.chans(), nil)
+			h.assertUpdates(
+				h.graph.chans(), true, h.safeDisableTimeout,
+			)
+		},
+	},
 }
 
 // TestChanStatusManagerStateMachine tests the possible state transitions that
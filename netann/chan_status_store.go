@@ -0,0 +1,90 @@
+package netann
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+var (
+	// manuallyDisabledBucketKey is the top-level bucket that holds the set
+	// of channels that were manually disabled, keyed by channel point.
+	manuallyDisabledBucketKey = []byte("chan-status-manually-disabled")
+)
+
+// ChanStatusStore is a bbolt backed implementation of the StatusStore
+// interface.
+type ChanStatusStore struct {
+	db *bbolt.DB
+}
+
+// A compile-time check to ensure ChanStatusStore implements StatusStore.
+var _ StatusStore = (*ChanStatusStore)(nil)
+
+// NewChanStatusStore creates a new ChanStatusStore backed by the given
+// database, creating the required buckets if they don't exist yet.
+func NewChanStatusStore(db *bbolt.DB) (*ChanStatusStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(manuallyDisabledBucketKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChanStatusStore{db: db}, nil
+}
+
+// FetchManuallyDisabled returns the set of channels that are currently
+// marked as manually disabled.
+//
+// NOTE: Part of the StatusStore interface.
+func (s *ChanStatusStore) FetchManuallyDisabled() (
+	map[wire.OutPoint]struct{}, error) {
+
+	disabled := make(map[wire.OutPoint]struct{})
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(manuallyDisabledBucketKey)
+
+		return bucket.ForEach(func(k, _ []byte) error {
+			var op wire.OutPoint
+			err := channeldb.ReadElement(bytes.NewReader(k), &op)
+			if err != nil {
+				return err
+			}
+
+			disabled[op] = struct{}{}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return disabled, nil
+}
+
+// SetManuallyDisabled adds the channel to, or removes it from, the set of
+// manually disabled channels.
+//
+// NOTE: Part of the StatusStore interface.
+func (s *ChanStatusStore) SetManuallyDisabled(op wire.OutPoint,
+	disabled bool) error {
+
+	var k bytes.Buffer
+	if err := channeldb.WriteElement(&k, op); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(manuallyDisabledBucketKey)
+
+		if !disabled {
+			return bucket.Delete(k.Bytes())
+		}
+
+		return bucket.Put(k.Bytes(), nil)
+	})
+}
@@ -26,6 +26,13 @@ const (
 	// ChanStatusDisabled indicates that the channel's last announcement has
 	// the disabled bit set.
 	ChanStatusDisabled
+
+	// ChanStatusManuallyDisabled indicates that the channel's last
+	// announcement has the disabled bit set, and that the channel was
+	// disabled at the request of the user. Channels in this state won't be
+	// reenabled automatically until control is explicitly handed back to
+	// the ChanStatusManager.
+	ChanStatusManuallyDisabled
 )
 
 // ChannelState describes the ChanStatusManager's view of a channel, and
@@ -63,6 +70,14 @@ func (s *channelStates) markDisabled(outpoint wire.OutPoint) {
 	}
 }
 
+// markManuallyDisabled creates a channelState using
+// ChanStatusManuallyDisabled.
+func (s *channelStates) markManuallyDisabled(outpoint wire.OutPoint) {
+	(*s)[outpoint] = ChannelState{
+		Status: ChanStatusManuallyDisabled,
+	}
+}
+
 // markPendingDisabled creates a channelState using ChanStatusPendingDisabled
 // and sets the ChannelState's SendDisableTime to sendDisableTime.
 func (s *channelStates) markPendingDisabled(outpoint wire.OutPoint,
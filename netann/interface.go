@@ -21,3 +21,15 @@ type ChannelGraph interface {
 	FetchChannelEdgesByOutpoint(*wire.OutPoint) (*channeldb.ChannelEdgeInfo,
 		*channeldb.ChannelEdgePolicy, *channeldb.ChannelEdgePolicy, error)
 }
+
+// StatusStore persists the set of channels that were manually disabled, such
+// that the ChanStatusManager doesn't reenable them after a restart.
+type StatusStore interface {
+	// FetchManuallyDisabled returns the set of channels that are currently
+	// marked as manually disabled.
+	FetchManuallyDisabled() (map[wire.OutPoint]struct{}, error)
+
+	// SetManuallyDisabled adds the channel to, or removes it from, the set
+	// of manually disabled channels.
+	SetManuallyDisabled(op wire.OutPoint, disabled bool) error
+}
@@ -0,0 +1,492 @@
+package lnd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sweep"
+)
+
+var (
+	// utxnChainPrefix is the prefix of the root-level, chain-segmented
+	// bucket that the former utxo nursery stored its state in.
+	utxnChainPrefix = []byte("utxn")
+
+	// utxnChannelIndexKey is the key of the bucket within the nursery
+	// root bucket that contains a bucket of tracked outputs for each
+	// channel.
+	utxnChannelIndexKey = []byte("channel-index")
+
+	// utxnCribPrefix is the state prefix of nursery outputs whose
+	// second-level timeout transaction is yet to be broadcast.
+	utxnCribPrefix = []byte("crib")
+
+	// utxnGradPrefix is the state prefix of nursery outputs that have been
+	// swept back into the wallet.
+	utxnGradPrefix = []byte("grad")
+
+	// errNurseryRecoveryShuttingDown is returned when the recovery of a
+	// nursery output is interrupted by shutdown.
+	errNurseryRecoveryShuttingDown = errors.New("nursery recovery " +
+		"shutting down")
+)
+
+// nurseryOutputConfTarget is the confirmation target used to sweep the
+// outputs recovered from the former utxo nursery.
+const nurseryOutputConfTarget = 6
+
+// nurseryOutput is an output the former utxo nursery was still incubating for
+// a channel that is no longer pending close. As no contract resolver is left
+// to take it over, it's handed to the sweeper once it has matured.
+type nurseryOutput struct {
+	// outpoint is the time locked output to be swept.
+	outpoint wire.OutPoint
+
+	// witnessType and signDesc describe how the output is spent.
+	witnessType input.StandardWitnessType
+	signDesc    input.SignDescriptor
+
+	// blocksToMaturity is the relative time lock of the output, which
+	// expires this many blocks after its confirmation.
+	blocksToMaturity uint32
+
+	// absoluteMaturity is the absolute time lock of the output, if any.
+	absoluteMaturity uint32
+
+	// confHeight is the height at which the output confirmed. It's zero
+	// if the nursery hadn't seen the output confirm yet.
+	confHeight uint32
+
+	// timeoutTx is the second-level timeout transaction creating the
+	// output, which is yet to be broadcast once it expires at the expiry
+	// height. It's only set for outgoing HTLCs on our own commitment.
+	timeoutTx *wire.MsgTx
+	expiry    uint32
+
+	// shortChanID and heightHint are taken from the close summary of the
+	// channel the output belongs to.
+	shortChanID lnwire.ShortChannelID
+	heightHint  uint32
+}
+
+// nurseryRootKey returns the key of the nursery root bucket for the given
+// chain.
+func nurseryRootKey(chainHash *chainhash.Hash) []byte {
+	var rootKey bytes.Buffer
+	rootKey.Write(utxnChainPrefix)
+	rootKey.Write(chainHash[:])
+
+	return rootKey.Bytes()
+}
+
+// migrateNurseryStore removes the state of the former utxo nursery for the
+// given chain from the database. The time locked outputs of force closed
+// channels are now swept directly by the contract resolvers, which pick up
+// any output that was still incubating once their channel arbitrator is
+// started. Therefore, the nursery state can be dropped as long as every
+// channel it tracks is still pending close, or has no outputs left to sweep.
+//
+// If the nursery tracks unswept outputs of a channel that isn't pending close
+// anymore, no resolver is left to take them over. In that case the nursery
+// state is kept in place and the outputs are returned, so they can be handed
+// to the sweeper by recoverNurseryOutputs, which removes the nursery state
+// once it's done. Outputs of channels without a close summary are scanned for
+// from a height derived from the output itself. Those for which no such height
+// is known are left in the nursery state, which is then kept, and the returned
+// bool is true.
+func migrateNurseryStore(db *channeldb.DB, chainHash *chainhash.Hash,
+	maxCltvExpiry uint32) ([]*nurseryOutput, bool, error) {
+
+	// The channel arbitrators of channels that are still pending close
+	// will resume their resolvers, and with that the sweep of any output
+	// the nursery was incubating. The summaries of fully closed channels
+	// are used to recover the outputs left behind.
+	summaries, err := db.FetchClosedChannels(false)
+	if err != nil {
+		return nil, false, err
+	}
+	closed := make(map[wire.OutPoint]*channeldb.ChannelCloseSummary)
+	for _, summary := range summaries {
+		closed[summary.ChanPoint] = summary
+	}
+
+	var (
+		orphans []*nurseryOutput
+		skipped bool
+	)
+	err = db.Update(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(nurseryRootKey(chainHash))
+		if rootBucket == nil {
+			return nil
+		}
+
+		chanIndex := rootBucket.Bucket(utxnChannelIndexKey)
+		if chanIndex != nil {
+			err := chanIndex.ForEach(func(chanBytes, _ []byte) error {
+				var chanPoint wire.OutPoint
+				err := readOutpoint(
+					bytes.NewReader(chanBytes), &chanPoint,
+				)
+				if err != nil {
+					return err
+				}
+
+				summary, ok := closed[chanPoint]
+				if ok && summary.IsPending {
+					return nil
+				}
+
+				chanBucket := chanIndex.Bucket(chanBytes)
+				if chanBucket == nil {
+					return nil
+				}
+				return chanBucket.ForEach(func(k, v []byte) error {
+					if bytes.HasPrefix(k, utxnGradPrefix) {
+						return nil
+					}
+
+					output, err := decodeNurseryOutput(k, v)
+					if err != nil {
+						return err
+					}
+
+					ok := prepareNurseryOrphan(
+						output, &chanPoint, summary,
+						maxCltvExpiry,
+					)
+					if !ok {
+						skipped = true
+						return nil
+					}
+
+					orphans = append(orphans, output)
+					return nil
+				})
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(orphans) > 0 || skipped {
+			return nil
+		}
+
+		srvrLog.Infof("Removing utxo nursery state, time locked " +
+			"outputs are swept by the contract resolvers")
+
+		return tx.DeleteBucket(nurseryRootKey(chainHash))
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return orphans, skipped, nil
+}
+
+// prepareNurseryOrphan sets the short channel ID and the height hint of an
+// orphaned nursery output of the given channel. The close summary is nil if
+// the channel has none. False is returned if the output can't be recovered, as
+// no height to scan for its confirmation from is known.
+func prepareNurseryOrphan(output *nurseryOutput, chanPoint *wire.OutPoint,
+	summary *channeldb.ChannelCloseSummary, maxCltvExpiry uint32) bool {
+
+	if summary != nil {
+		output.shortChanID = summary.ShortChanID
+		output.heightHint = summary.CloseHeight
+		return true
+	}
+
+	// Without a close summary, we'll have to bound the confirmation height
+	// of the output ourselves, rather than scanning from genesis.
+	heightHint, ok := nurseryHeightHint(output, maxCltvExpiry)
+	if !ok {
+		srvrLog.Warnf("Unable to recover utxo nursery output %v of "+
+			"ChannelPoint(%v): confirmation height unknown",
+			output.outpoint, chanPoint)
+		return false
+	}
+	output.heightHint = heightHint
+
+	return true
+}
+
+// nurseryHeightHint returns the height from which to scan for the
+// confirmation of a nursery output whose channel has no close summary. False
+// is returned if no lower bound of the confirmation height is known.
+func nurseryHeightHint(output *nurseryOutput,
+	maxCltvExpiry uint32) (uint32, bool) {
+
+	switch {
+	// The nursery already saw the output confirm.
+	case output.confHeight != 0:
+		return output.confHeight, true
+
+	// The second-level timeout transaction can't confirm before it
+	// expires.
+	case output.timeoutTx != nil:
+		return output.expiry - 1, true
+
+	// The commitment transaction carrying an HTLC can't have confirmed
+	// before the HTLC was offered, which was at most maxCltvExpiry blocks
+	// before it expires.
+	case output.absoluteMaturity != 0:
+		if output.absoluteMaturity <= maxCltvExpiry {
+			return 0, true
+		}
+		return output.absoluteMaturity - maxCltvExpiry, true
+
+	default:
+		return 0, false
+	}
+}
+
+// removeNurseryStore removes the state of the former utxo nursery for the
+// given chain from the database.
+func removeNurseryStore(db *channeldb.DB, chainHash *chainhash.Hash) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		err := tx.DeleteBucket(nurseryRootKey(chainHash))
+		if err != nil && err != bbolt.ErrBucketNotFound {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// decodeNurseryOutput decodes an output stored by the former utxo nursery
+// under the given key. Every key is made up of a four byte state prefix,
+// followed by the outpoint. Outputs whose second-level timeout transaction
+// is yet to be broadcast are prefixed by its expiry and the transaction
+// itself.
+func decodeNurseryOutput(k, v []byte) (*nurseryOutput, error) {
+	if len(k) < 4 {
+		return nil, fmt.Errorf("invalid nursery output key %x", k)
+	}
+
+	var (
+		output nurseryOutput
+		r      = bytes.NewReader(v)
+	)
+	if bytes.HasPrefix(k, utxnCribPrefix) {
+		err := binary.Read(r, byteOrder, &output.expiry)
+		if err != nil {
+			return nil, err
+		}
+
+		output.timeoutTx = &wire.MsgTx{}
+		if err := output.timeoutTx.Deserialize(r); err != nil {
+			return nil, err
+		}
+	}
+
+	// The amount and the channel point are known from elsewhere.
+	var (
+		amt         uint64
+		chanPoint   wire.OutPoint
+		isHtlc      bool
+		witnessType uint16
+	)
+	if err := binary.Read(r, byteOrder, &amt); err != nil {
+		return nil, err
+	}
+	if err := readOutpoint(r, &output.outpoint); err != nil {
+		return nil, err
+	}
+	if err := readOutpoint(r, &chanPoint); err != nil {
+		return nil, err
+	}
+	for _, field := range []interface{}{
+		&isHtlc,
+		&output.blocksToMaturity,
+		&output.absoluteMaturity,
+		&output.confHeight,
+		&witnessType,
+	} {
+		if err := binary.Read(r, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+	output.witnessType = input.StandardWitnessType(witnessType)
+
+	if err := input.ReadSignDescriptor(r, &output.signDesc); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// recoverNurseryOutputs hands the given outputs of the former utxo nursery to
+// the sweeper once they've matured. After all of them have been handed off,
+// the nursery state is removed, as the sweeper persists the outputs itself,
+// unless keepState is set because it holds outputs that couldn't be
+// recovered. If we're shut down before, the recovery is resumed on the next
+// start.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *server) recoverNurseryOutputs(outputs []*nurseryOutput,
+	keepState bool) {
+
+	defer s.wg.Done()
+
+	srvrLog.Infof("Recovering %v outputs of the former utxo nursery",
+		len(outputs))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(outputs))
+	for _, output := range outputs {
+		wg.Add(1)
+		go func(output *nurseryOutput) {
+			defer wg.Done()
+			errs <- s.recoverNurseryOutput(output)
+		}(output)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		switch {
+		case err == errNurseryRecoveryShuttingDown:
+			return
+
+		case err != nil:
+			srvrLog.Errorf("Unable to recover utxo nursery "+
+				"output: %v", err)
+			return
+		}
+	}
+
+	if keepState {
+		srvrLog.Infof("Recovered %v outputs of the former utxo "+
+			"nursery, keeping the state of the remaining ones",
+			len(outputs))
+		return
+	}
+
+	err := removeNurseryStore(s.chanDB, activeNetParams.GenesisHash)
+	if err != nil {
+		srvrLog.Errorf("Unable to remove utxo nursery state: %v", err)
+		return
+	}
+
+	srvrLog.Infof("Recovered all outputs of the former utxo nursery")
+}
+
+// recoverNurseryOutput waits for a nursery output to mature, broadcasting its
+// second-level timeout transaction first if needed, and then hands it to the
+// sweeper.
+func (s *server) recoverNurseryOutput(output *nurseryOutput) error {
+	confHeight := output.confHeight
+	heightHint := output.heightHint
+
+	// The second-level timeout transaction can be broadcast one block
+	// before it expires, as it's then valid for inclusion in the next
+	// block.
+	if output.timeoutTx != nil {
+		err := s.waitForNurseryHeight(output.expiry - 1)
+		if err != nil {
+			return err
+		}
+
+		srvrLog.Infof("Publishing second-level timeout tx %v of utxo "+
+			"nursery output %v", output.timeoutTx.TxHash(),
+			output.outpoint)
+
+		label := labels.MakeLabel(
+			labels.LabelTypeChannelClose, &output.shortChanID,
+		)
+		err = s.cc.wallet.PublishTransaction(output.timeoutTx, label)
+		if err != nil && err != lnwallet.ErrDoubleSpend {
+			return err
+		}
+
+		confHeight = 0
+		if heightHint < output.expiry-1 {
+			heightHint = output.expiry - 1
+		}
+	}
+
+	// The relative time lock of the output only starts once the
+	// transaction creating it has confirmed.
+	if confHeight == 0 {
+		confEvent, err := s.cc.chainNotifier.RegisterConfirmationsNtfn(
+			&output.outpoint.Hash, output.signDesc.Output.PkScript,
+			1, heightHint,
+		)
+		if err != nil {
+			return err
+		}
+		defer confEvent.Cancel()
+
+		select {
+		case conf, ok := <-confEvent.Confirmed:
+			if !ok {
+				return errNurseryRecoveryShuttingDown
+			}
+			confHeight = conf.BlockHeight
+
+		case <-s.quit:
+			return errNurseryRecoveryShuttingDown
+		}
+	}
+
+	maturityHeight := confHeight + output.blocksToMaturity
+	if output.absoluteMaturity != 0 {
+		maturityHeight = output.absoluteMaturity
+	}
+	if maturityHeight > 0 {
+		err := s.waitForNurseryHeight(maturityHeight - 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	srvrLog.Infof("Offering utxo nursery output %v to the sweeper",
+		output.outpoint)
+
+	inp := input.NewCsvInput(
+		&output.outpoint, output.witnessType, &output.signDesc,
+		confHeight, output.blocksToMaturity,
+	)
+	_, err := s.sweeper.SweepInput(
+		inp, sweep.FeePreference{ConfTarget: nurseryOutputConfTarget},
+	)
+
+	return err
+}
+
+// waitForNurseryHeight blocks until the given block height has been reached.
+func (s *server) waitForNurseryHeight(height uint32) error {
+	blockEpochs, err := s.cc.chainNotifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return errNurseryRecoveryShuttingDown
+			}
+
+			if uint32(epoch.Height) >= height {
+				return nil
+			}
+
+		case <-s.quit:
+			return errNurseryRecoveryShuttingDown
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package lnd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+// encodeNurseryOutput encodes an output the way the former utxo nursery
+// stored it, prefixed by its timeout transaction if it has one.
+func encodeNurseryOutput(t *testing.T, output *nurseryOutput,
+	chanPoint *wire.OutPoint) []byte {
+
+	var b bytes.Buffer
+	if output.timeoutTx != nil {
+		err := binary.Write(&b, byteOrder, output.expiry)
+		if err != nil {
+			t.Fatalf("unable to write expiry: %v", err)
+		}
+		if err := output.timeoutTx.Serialize(&b); err != nil {
+			t.Fatalf("unable to write timeout tx: %v", err)
+		}
+	}
+
+	err := binary.Write(&b, byteOrder, uint64(output.signDesc.Output.Value))
+	if err != nil {
+		t.Fatalf("unable to write amount: %v", err)
+	}
+	if err := writeOutpoint(&b, &output.outpoint); err != nil {
+		t.Fatalf("unable to write outpoint: %v", err)
+	}
+	if err := writeOutpoint(&b, chanPoint); err != nil {
+		t.Fatalf("unable to write chan point: %v", err)
+	}
+	for _, field := range []interface{}{
+		output.timeoutTx != nil,
+		output.blocksToMaturity,
+		output.absoluteMaturity,
+		output.confHeight,
+		uint16(output.witnessType),
+	} {
+		if err := binary.Write(&b, byteOrder, field); err != nil {
+			t.Fatalf("unable to write field: %v", err)
+		}
+	}
+	if err := input.WriteSignDescriptor(&b, &output.signDesc); err != nil {
+		t.Fatalf("unable to write sign descriptor: %v", err)
+	}
+
+	return b.Bytes()
+}
+
+// TestMigrateNurseryStore asserts that the state of the utxo nursery is only
+// removed if no unswept outputs of channels without a resolver are left, and
+// that any such outputs are returned for recovery. As the channel has no close
+// summary, the height to scan for the confirmation of an output from must be
+// derived from the output itself, or the output is skipped.
+func TestMigrateNurseryStore(t *testing.T) {
+	t.Parallel()
+
+	const maxCltvExpiry = 2016
+
+	chainHash := activeNetParams.GenesisHash
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	chanPoint := wire.OutPoint{Index: 1}
+	signDesc := input.SignDescriptor{
+		WitnessScript: []byte{0x01, 0x02},
+		Output: &wire.TxOut{
+			Value:    100000,
+			PkScript: []byte{0x00, 0x20, 0x03},
+		},
+		HashType: 1,
+	}
+	signDesc.KeyDesc.PubKey = privKey.PubKey()
+
+	timeoutTx := wire.NewMsgTx(2)
+	timeoutTx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{
+		Index: 3,
+	}})
+	timeoutTx.AddTxOut(signDesc.Output)
+
+	testCases := []struct {
+		name        string
+		statePrefix []byte
+		output      *nurseryOutput
+		removed     bool
+		skipped     bool
+	}{
+		{
+			name:        "graduated output",
+			statePrefix: utxnGradPrefix,
+			output: &nurseryOutput{
+				outpoint:    wire.OutPoint{Index: 2},
+				witnessType: input.CommitmentTimeLock,
+				signDesc:    signDesc,
+			},
+			removed: true,
+		},
+		{
+			name:        "kindergarten output",
+			statePrefix: []byte("kndr"),
+			output: &nurseryOutput{
+				outpoint:         wire.OutPoint{Index: 2},
+				witnessType:      input.CommitmentTimeLock,
+				signDesc:         signDesc,
+				blocksToMaturity: 144,
+				confHeight:       500,
+				heightHint:       500,
+			},
+			removed: false,
+		},
+		{
+			name:        "unconfirmed kindergarten output",
+			statePrefix: []byte("kndr"),
+			output: &nurseryOutput{
+				outpoint:         wire.OutPoint{Index: 2},
+				witnessType:      input.CommitmentTimeLock,
+				signDesc:         signDesc,
+				blocksToMaturity: 144,
+			},
+			removed: false,
+			skipped: true,
+		},
+		{
+			name:        "unconfirmed htlc output",
+			statePrefix: []byte("kndr"),
+			output: &nurseryOutput{
+				outpoint:         wire.OutPoint{Index: 2},
+				witnessType:      input.HtlcOfferedRemoteTimeout,
+				signDesc:         signDesc,
+				absoluteMaturity: 3000,
+				heightHint:       3000 - maxCltvExpiry,
+			},
+			removed: false,
+		},
+		{
+			name:        "crib output",
+			statePrefix: utxnCribPrefix,
+			output: &nurseryOutput{
+				outpoint: wire.OutPoint{
+					Hash: timeoutTx.TxHash(),
+				},
+				witnessType:      input.HtlcOfferedTimeoutSecondLevel,
+				signDesc:         signDesc,
+				blocksToMaturity: 144,
+				timeoutTx:        timeoutTx,
+				expiry:           600,
+				heightHint:       599,
+			},
+			removed: false,
+		},
+	}
+
+	for _, test := range testCases {
+		tempDir, err := ioutil.TempDir("", "channeldb")
+		if err != nil {
+			t.Fatalf("unable to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		db, err := channeldb.Open(tempDir)
+		if err != nil {
+			t.Fatalf("unable to open db: %v", err)
+		}
+		defer db.Close()
+
+		// Store a single output of a channel that is not pending
+		// close in the nursery.
+		err = db.Update(func(tx *bbolt.Tx) error {
+			root, err := tx.CreateBucket(nurseryRootKey(chainHash))
+			if err != nil {
+				return err
+			}
+			chanIndex, err := root.CreateBucket(utxnChannelIndexKey)
+			if err != nil {
+				return err
+			}
+
+			var chanKey bytes.Buffer
+			if err := writeOutpoint(&chanKey, &chanPoint); err != nil {
+				return err
+			}
+			chanBucket, err := chanIndex.CreateBucket(chanKey.Bytes())
+			if err != nil {
+				return err
+			}
+
+			var outputKey bytes.Buffer
+			outputKey.Write(test.statePrefix)
+			err = writeOutpoint(&outputKey, &test.output.outpoint)
+			if err != nil {
+				return err
+			}
+
+			return chanBucket.Put(
+				outputKey.Bytes(),
+				encodeNurseryOutput(t, test.output, &chanPoint),
+			)
+		})
+		if err != nil {
+			t.Fatalf("%v: unable to store nursery output: %v",
+				test.name, err)
+		}
+
+		orphans, skipped, err := migrateNurseryStore(
+			db, chainHash, maxCltvExpiry,
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to migrate: %v", test.name, err)
+		}
+		if skipped != test.skipped {
+			t.Fatalf("%v: expected skipped=%v, got %v", test.name,
+				test.skipped, skipped)
+		}
+
+		var removed bool
+		err = db.View(func(tx *bbolt.Tx) error {
+			removed = tx.Bucket(nurseryRootKey(chainHash)) == nil
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("%v: unable to read db: %v", test.name, err)
+		}
+
+		if removed != test.removed {
+			t.Fatalf("%v: expected nursery removed=%v, got %v",
+				test.name, test.removed, removed)
+		}
+
+		// Unless the nursery state was removed or the output skipped,
+		// the orphaned output must be returned for recovery.
+		if removed || skipped {
+			if len(orphans) != 0 {
+				t.Fatalf("%v: expected no orphans, got %v",
+					test.name, len(orphans))
+			}
+			continue
+		}
+		if len(orphans) != 1 {
+			t.Fatalf("%v: expected 1 orphan, got %v", test.name,
+				len(orphans))
+		}
+
+		orphan := orphans[0]
+		if orphan.timeoutTx != nil &&
+			orphan.timeoutTx.TxHash() != timeoutTx.TxHash() {
+
+			t.Fatalf("%v: timeout tx mismatch", test.name)
+		}
+		orphan.timeoutTx = test.output.timeoutTx
+
+		orphan.signDesc.KeyDesc.PubKey = nil
+		test.output.signDesc.KeyDesc.PubKey = nil
+		if !reflect.DeepEqual(orphan, test.output) {
+			t.Fatalf("%v: expected orphan %v, got %v", test.name,
+				test.output, orphan)
+		}
+
+		// Once the orphans have been recovered, the nursery state is
+		// removed.
+		if err := removeNurseryStore(db, chainHash); err != nil {
+			t.Fatalf("%v: unable to remove nursery: %v", test.name,
+				err)
+		}
+		orphans, _, err = migrateNurseryStore(
+			db, chainHash, maxCltvExpiry,
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to migrate: %v", test.name, err)
+		}
+		if len(orphans) != 0 {
+			t.Fatalf("%v: expected no orphans after removal, got %v",
+				test.name, len(orphans))
+		}
+	}
+}
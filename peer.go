@@ -15,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/connmgr"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/lightningnetwork/lnd/brontide"
@@ -426,6 +427,15 @@ func (p *peer) QuitSignal() <-chan struct{} {
 	return p.quit
 }
 
+// maxDustExposureOpt returns the channel option that applies the configured
+// maximum dust exposure to a channel.
+func maxDustExposureOpt() lnwallet.ChannelOpt {
+	maxDust := lnwire.NewMSatFromSatoshis(
+		btcutil.Amount(cfg.MaxDustExposure),
+	)
+	return lnwallet.WithMaxDustExposure(maxDust)
+}
+
 // loadActiveChannels creates indexes within the peer for tracking all active
 // channels returned by the database. It returns a slice of channel reestablish
 // messages that should be sent to the peer immediately, in case we have borked
@@ -440,6 +450,7 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) (
 	for _, dbChan := range chans {
 		lnChan, err := lnwallet.NewLightningChannel(
 			p.server.cc.signer, dbChan, p.server.sigPool,
+			maxDustExposureOpt(),
 		)
 		if err != nil {
 			return nil, err
@@ -524,12 +535,19 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		// routing policy into a forwarding policy.
 		var forwardingPolicy *htlcswitch.ForwardingPolicy
 		if selfPolicy != nil {
+			inboundFee, err := selfPolicy.InboundFee()
+			if err != nil {
+				peerLog.Warnf("Unable to parse inbound fee "+
+					"for channel %v: %v", chanPoint, err)
+			}
+
 			forwardingPolicy = &htlcswitch.ForwardingPolicy{
 				MinHTLC:       selfPolicy.MinHTLC,
 				MaxHTLC:       selfPolicy.MaxHTLC,
 				BaseFee:       selfPolicy.FeeBaseMSat,
 				FeeRate:       selfPolicy.FeeProportionalMillionths,
 				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
+				InboundFee:    inboundFee,
 			}
 		} else {
 			peerLog.Warnf("Unable to find our forwarding policy "+
@@ -629,6 +647,7 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		MaxFeeAllocation:        cfg.MaxChannelFeeAllocation,
 		NotifyActiveChannel:     p.server.channelNotifier.NotifyActiveChannelEvent,
 		NotifyInactiveChannel:   p.server.channelNotifier.NotifyInactiveChannelEvent,
+		NotifyDustRejection:     p.server.htlcSwitch.RecordDustRejection,
 	}
 
 	link := htlcswitch.NewChannelLink(linkCfg, lnChan)
@@ -1732,7 +1751,7 @@ func (p *peer) ChannelSnapshots() []*channeldb.ChannelSnapshot {
 // the case of a cooperative channel close negotiation.
 func (p *peer) genDeliveryScript() ([]byte, error) {
 	deliveryAddr, err := p.server.cc.wallet.NewAddress(
-		lnwallet.WitnessPubKey, false,
+		lnwallet.WitnessPubKey, false, lnwallet.DefaultAccountName,
 	)
 	if err != nil {
 		return nil, err
@@ -1804,6 +1823,7 @@ out:
 			// easily according to its channel ID.
 			lnChan, err := lnwallet.NewLightningChannel(
 				p.server.cc.signer, newChan, p.server.sigPool,
+				maxDustExposureOpt(),
 			)
 			if err != nil {
 				p.activeChanMtx.Unlock()
@@ -1999,6 +2019,12 @@ out:
 // gossip level by broadcasting a new ChannelUpdate with the disabled bit unset.
 // No message will be sent if the channel is already enabled.
 func (p *peer) reenableActiveChannels() {
+	// If the server is draining in preparation of a shutdown, our channels
+	// must stay disabled.
+	if p.server.IsDraining() {
+		return
+	}
+
 	// First, filter all known channels with this peer for ones that are
 	// both public and not pending.
 	var activePublicChans []wire.OutPoint
@@ -2029,7 +2055,7 @@ func (p *peer) reenableActiveChannels() {
 	// disabled bit to false and send out a new ChannelUpdate. If this
 	// channel is already active, the update won't be sent.
 	for _, chanPoint := range activePublicChans {
-		err := p.server.chanStatusMgr.RequestEnable(chanPoint)
+		err := p.server.chanStatusMgr.RequestEnable(chanPoint, false)
 		if err != nil {
 			srvrLog.Errorf("Unable to enable channel %v: %v",
 				chanPoint, err)
@@ -2094,8 +2120,12 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 				channel:           channel,
 				unregisterChannel: p.server.htlcSwitch.RemoveLink,
 				broadcastTx:       p.server.cc.wallet.PublishTransaction,
-				disableChannel:    p.server.chanStatusMgr.RequestDisable,
-				quit:              p.quit,
+				disableChannel: func(op wire.OutPoint) error {
+					return p.server.chanStatusMgr.RequestDisable(
+						op, false,
+					)
+				},
+				quit: p.quit,
 			},
 			deliveryAddr,
 			feePerKw,
@@ -2154,8 +2184,12 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 				channel:           channel,
 				unregisterChannel: p.server.htlcSwitch.RemoveLink,
 				broadcastTx:       p.server.cc.wallet.PublishTransaction,
-				disableChannel:    p.server.chanStatusMgr.RequestDisable,
-				quit:              p.quit,
+				disableChannel: func(op wire.OutPoint) error {
+					return p.server.chanStatusMgr.RequestDisable(
+						op, false,
+					)
+				},
+				quit: p.quit,
 			},
 			deliveryAddr,
 			req.TargetFeePerKw,
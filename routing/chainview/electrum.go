@@ -0,0 +1,615 @@
+package chainview
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/electrum"
+)
+
+// reorgSafetyLimit is the number of recent blocks whose hashes we keep track
+// of in order to locate the fork point upon a reorg.
+const reorgSafetyLimit = 100
+
+// electrumFilterUpdate is an update to the chain filter of an
+// ElectrumFilteredChainView. As Electrum servers index transactions by their
+// output scripts, we'll need to know the script of each watched outpoint.
+type electrumFilterUpdate struct {
+	newOutputs   []channeldb.EdgePoint
+	updateHeight uint32
+}
+
+// ElectrumFilteredChainView is an implementation of the FilteredChainView
+// interface which is backed by an Electrum server.
+//
+// As Electrum servers don't serve full blocks, we subscribe to the output
+// scripts of all watched outpoints, and only inspect the transactions of the
+// scripts the server notifies us about when a new block is connected.
+type ElectrumFilteredChainView struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	client *electrum.Client
+
+	sub *electrum.Subscription
+
+	// bestHeight and bestHash describe the latest block added to the
+	// blockQueue. They're only accessed by the chainFilterer goroutine.
+	bestHeight int32
+	bestHash   chainhash.Hash
+
+	// blocks contains the hashes of the most recent blocks added to the
+	// blockQueue, allowing us to locate the fork point upon a reorg.
+	blocks map[int32]chainhash.Hash
+
+	// blockEventQueue is the ordered queue used to keep the order
+	// of connected and disconnected blocks sent to the reader of the
+	// chainView.
+	blockQueue *blockEventQueue
+
+	// filterUpdates is a channel in which updates to the utxo filter
+	// attached to this instance are sent over.
+	filterUpdates chan electrumFilterUpdate
+
+	// chainFilter is the set of utxo's that we're currently watching
+	// spends for within the chain, along with the scripts they pay to.
+	chainFilter map[wire.OutPoint][]byte
+
+	// scripts contains the output scripts of all watched outpoints,
+	// indexed by their scripthash.
+	scripts map[string][]byte
+
+	// dirtyScripts is the set of scripthashes the server notified us
+	// about since we last inspected their history.
+	dirtyScripts map[string]struct{}
+
+	// historyCache caches the history of the scripts we've queried since
+	// the last tip change.
+	historyCache map[string][]electrum.HistoryItem
+
+	// filterBlockReqs is a channel in which requests to filter select
+	// blocks will be sent over.
+	filterBlockReqs chan *filterBlockReq
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile time check to ensure ElectrumFilteredChainView implements the
+// chainview.FilteredChainView.
+var _ FilteredChainView = (*ElectrumFilteredChainView)(nil)
+
+// NewElectrumFilteredChainView creates a new instance of a FilteredChainView
+// backed by the given Electrum client. The client is shared and is expected
+// to be started and stopped by the caller.
+func NewElectrumFilteredChainView(
+	client *electrum.Client) *ElectrumFilteredChainView {
+
+	return &ElectrumFilteredChainView{
+		client:          client,
+		blocks:          make(map[int32]chainhash.Hash),
+		blockQueue:      newBlockEventQueue(),
+		filterUpdates:   make(chan electrumFilterUpdate),
+		chainFilter:     make(map[wire.OutPoint][]byte),
+		scripts:         make(map[string][]byte),
+		dirtyScripts:    make(map[string]struct{}),
+		historyCache:    make(map[string][]electrum.HistoryItem),
+		filterBlockReqs: make(chan *filterBlockReq),
+		quit:            make(chan struct{}),
+	}
+}
+
+// Start starts all goroutines necessary for normal operation.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *ElectrumFilteredChainView) Start() error {
+	// Already started?
+	if atomic.AddInt32(&e.started, 1) != 1 {
+		return nil
+	}
+
+	log.Infof("FilteredChainView starting")
+
+	e.sub = e.client.Subscribe()
+
+	bestHash, bestHeight, err := e.client.GetBestBlock()
+	if err != nil {
+		e.sub.Cancel()
+		return err
+	}
+	e.bestHeight = bestHeight
+	e.bestHash = *bestHash
+	e.blocks[bestHeight] = *bestHash
+
+	e.blockQueue.Start()
+
+	e.wg.Add(1)
+	go e.chainFilterer()
+
+	return nil
+}
+
+// Stop stops all goroutines which we launched by the prior call to the Start
+// method.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *ElectrumFilteredChainView) Stop() error {
+	// Already shutting down?
+	if atomic.AddInt32(&e.stopped, 1) != 1 {
+		return nil
+	}
+
+	log.Infof("FilteredChainView stopping")
+
+	close(e.quit)
+	e.wg.Wait()
+
+	e.sub.Cancel()
+	e.blockQueue.Stop()
+
+	return nil
+}
+
+// chainFilterer is the primary goroutine which: listens for new blocks coming
+// and dispatches the relevant FilteredBlock notifications, updates the filter
+// due to requests by callers, and finally is able to preform targeted block
+// filtration.
+func (e *ElectrumFilteredChainView) chainFilterer() {
+	defer e.wg.Done()
+
+	var (
+		pendingTip *electrum.HeaderNotification
+		settled    <-chan time.Time
+	)
+	for {
+		select {
+		case ntfn, ok := <-e.sub.Notifications:
+			if !ok {
+				return
+			}
+
+			switch n := ntfn.(type) {
+			// The server announced a new tip, which we'll process
+			// once it had the chance to notify us of the scripts
+			// the new blocks touched, as only those can contain
+			// spends of our watched outpoints.
+			case *electrum.HeaderNotification:
+				pendingTip = n
+				if settled == nil {
+					settled = time.After(
+						electrum.ScriptHashSettleDelay,
+					)
+				}
+
+			case *electrum.ScriptHashNotification:
+				if _, ok := e.scripts[n.ScriptHash]; !ok {
+					continue
+				}
+				e.dirtyScripts[n.ScriptHash] = struct{}{}
+				delete(e.historyCache, n.ScriptHash)
+			}
+
+		case <-settled:
+			settled = nil
+
+			if err := e.handleNewTip(pendingTip); err != nil {
+				log.Errorf("Unable to process new tip %v at "+
+					"height %d: %v",
+					pendingTip.Header.BlockHash(),
+					pendingTip.Height, err)
+			}
+			pendingTip = nil
+
+		// The caller has just sent an update to the current chain
+		// filter, so we'll apply the update, possibly rewinding our
+		// state partially.
+		case update := <-e.filterUpdates:
+			if err := e.updateFilter(update); err != nil {
+				log.Errorf("Unable to update filter: %v", err)
+			}
+
+		// We've received a new request to manually filter a block.
+		case req := <-e.filterBlockReqs:
+			height, err := e.client.GetBlockHeight(req.blockHash)
+			if err != nil {
+				req.err <- err
+				req.resp <- nil
+				continue
+			}
+
+			// As we're unable to tell which of our scripts have
+			// been touched in arbitrary blocks, we'll need to
+			// consult the history of all of them.
+			block, err := e.filterBlock(
+				height, req.blockHash, e.allScripts(),
+			)
+			req.err <- err
+			req.resp <- block
+
+		case <-e.quit:
+			return
+		}
+	}
+}
+
+// handleNewTip brings our view of the chain up to date with the tip announced
+// by the server, dispatching the blocks that were disconnected and connected
+// in the process.
+func (e *ElectrumFilteredChainView) handleNewTip(
+	tip *electrum.HeaderNotification) error {
+
+	tipHash := tip.Header.BlockHash()
+	if tipHash == e.bestHash {
+		return nil
+	}
+
+	// The histories we've cached may no longer reflect the server's
+	// chain.
+	e.historyCache = make(map[string][]electrum.HistoryItem)
+
+	// If the server is behind us, e.g. after having reconnected, we'll
+	// wait for it to catch up, unless the tip it announces has been
+	// reorged out of our chain.
+	if hash, ok := e.blocks[tip.Height]; ok && hash == tipHash {
+		return nil
+	}
+
+	// We'll walk back our chain until we find a block that's also part of
+	// the server's. If we're unable to walk back any further, as we've
+	// never seen the previous block, we'll assume it's the fork point.
+	forkHeight := e.bestHeight
+	forkHash := e.bestHash
+	for forkHeight > 0 {
+		if forkHeight < tip.Height {
+			chainHash, err := e.client.GetBlockHash(
+				int64(forkHeight),
+			)
+			if err != nil {
+				return err
+			}
+			if *chainHash == forkHash {
+				break
+			}
+		}
+
+		prevHash, ok := e.blocks[forkHeight-1]
+		if !ok {
+			header, err := e.client.GetBlockHeader(&forkHash)
+			if err != nil {
+				forkHeight--
+				break
+			}
+			prevHash = header.PrevBlock
+		}
+
+		forkHeight--
+		forkHash = prevHash
+	}
+
+	for e.bestHeight > forkHeight {
+		height := e.bestHeight
+
+		log.Debugf("got disconnected block at height %d: %v", height,
+			e.bestHash)
+
+		e.blockQueue.Add(&blockEvent{
+			eventType: disconnected,
+			block: &FilteredBlock{
+				Hash:   e.bestHash,
+				Height: uint32(height),
+			},
+		})
+		delete(e.blocks, height)
+
+		prevHash, ok := e.blocks[height-1]
+		if !ok {
+			hash, err := e.client.GetBlockHash(int64(height - 1))
+			if err != nil {
+				return err
+			}
+			prevHash = *hash
+		}
+		e.bestHeight = height - 1
+		e.bestHash = prevHash
+	}
+
+	// Only the scripts the server notified us about can have been spent
+	// from within the new blocks.
+	scripts := make([][]byte, 0, len(e.dirtyScripts))
+	for scriptHash := range e.dirtyScripts {
+		scripts = append(scripts, e.scripts[scriptHash])
+	}
+
+	for height := forkHeight + 1; height <= tip.Height; height++ {
+		hash := &tipHash
+		if height != tip.Height {
+			var err error
+			hash, err = e.client.GetBlockHash(int64(height))
+			if err != nil {
+				return err
+			}
+		}
+
+		block, err := e.filterBlock(height, hash, scripts)
+		if err != nil {
+			return err
+		}
+
+		e.blockQueue.Add(&blockEvent{
+			eventType: connected,
+			block:     block,
+		})
+
+		e.bestHeight = height
+		e.bestHash = *hash
+		e.blocks[height] = *hash
+		delete(e.blocks, height-reorgSafetyLimit)
+	}
+
+	// Scripts without any unconfirmed transactions are now fully
+	// accounted for. We'll keep inspecting the others, as the
+	// transactions could confirm without the script's status changing.
+	for scriptHash := range e.dirtyScripts {
+		history, ok := e.historyCache[scriptHash]
+		if !ok {
+			continue
+		}
+
+		pending := false
+		for _, item := range history {
+			if item.Height <= 0 {
+				pending = true
+				break
+			}
+		}
+		if !pending {
+			delete(e.dirtyScripts, scriptHash)
+		}
+	}
+
+	return nil
+}
+
+// filterBlock returns the filtered block found at the given height, which
+// contains the transactions spending any of our watched outpoints. Only the
+// history of the given scripts is consulted. Any spent outpoints are removed
+// from the chain filter.
+func (e *ElectrumFilteredChainView) filterBlock(height int32,
+	hash *chainhash.Hash, scripts [][]byte) (*FilteredBlock, error) {
+
+	// First, we'll gather the transactions within the block that touched
+	// any of the given scripts.
+	txHashes := make(map[chainhash.Hash]struct{})
+	for _, pkScript := range scripts {
+		history, err := e.history(pkScript)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range history {
+			if item.Height == height {
+				txHashes[item.TxHash] = struct{}{}
+			}
+		}
+	}
+
+	// We'll then fetch each of them, only keeping those which spend any
+	// of our watched outpoints, in the order they appear in the block.
+	type positionedTx struct {
+		tx  *wire.MsgTx
+		pos uint32
+	}
+	var spends []positionedTx
+	for txHash := range txHashes {
+		txHash := txHash
+		tx, _, pos, err := e.client.GetConfirmedTransaction(
+			&txHash, height,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		isSpend := false
+		for _, txIn := range tx.TxIn {
+			prevOp := txIn.PreviousOutPoint
+			if _, ok := e.chainFilter[prevOp]; !ok {
+				continue
+			}
+
+			delete(e.chainFilter, prevOp)
+			isSpend = true
+		}
+
+		if isSpend {
+			spends = append(spends, positionedTx{tx, pos})
+		}
+	}
+	sort.Slice(spends, func(i, j int) bool {
+		return spends[i].pos < spends[j].pos
+	})
+
+	block := &FilteredBlock{
+		Hash:         *hash,
+		Height:       uint32(height),
+		Transactions: make([]*wire.MsgTx, 0, len(spends)),
+	}
+	for _, spend := range spends {
+		block.Transactions = append(block.Transactions, spend.tx)
+	}
+
+	return block, nil
+}
+
+// updateFilter adds the given outputs to our chain filter, subscribing to
+// their scripts. If the update height is below our best height, we'll rescan
+// the blocks following it for spends of the new outputs, only dispatching
+// those which contain any.
+func (e *ElectrumFilteredChainView) updateFilter(
+	update electrumFilterUpdate) error {
+
+	log.Tracef("Updating chain filter with new UTXO's: %v",
+		update.newOutputs)
+
+	newScripts := make(map[string][]byte)
+	for _, output := range update.newOutputs {
+		e.chainFilter[output.OutPoint] = output.FundingPkScript
+
+		scriptHash := electrum.ScriptHash(output.FundingPkScript)
+		newScripts[scriptHash] = output.FundingPkScript
+		if _, ok := e.scripts[scriptHash]; ok {
+			continue
+		}
+
+		_, _, err := e.client.SubscribeScriptHash(
+			output.FundingPkScript,
+		)
+		if err != nil {
+			return err
+		}
+		e.scripts[scriptHash] = output.FundingPkScript
+	}
+
+	// If the update height matches our best known height, then we don't
+	// need to do any rewinding.
+	if int32(update.updateHeight) >= e.bestHeight {
+		return nil
+	}
+
+	// Otherwise, we'll rewind the state to ensure the caller doesn't miss
+	// any relevant notifications. Starting from the height _after_ the
+	// update height, we'll walk forwards, only dispatching the blocks
+	// spending any of the new outputs.
+	scripts := make([][]byte, 0, len(newScripts))
+	for _, pkScript := range newScripts {
+		scripts = append(scripts, pkScript)
+	}
+
+	startHeight := int32(update.updateHeight) + 1
+	for height := startHeight; height <= e.bestHeight; height++ {
+		hash, ok := e.blocks[height]
+		if !ok {
+			blockHash, err := e.client.GetBlockHash(int64(height))
+			if err != nil {
+				return err
+			}
+			hash = *blockHash
+		}
+
+		block, err := e.filterBlock(height, &hash, scripts)
+		if err != nil {
+			return err
+		}
+		if len(block.Transactions) == 0 {
+			continue
+		}
+
+		e.blockQueue.Add(&blockEvent{
+			eventType: connected,
+			block:     block,
+		})
+	}
+
+	return nil
+}
+
+// history returns the history of the given script, which is cached until the
+// next tip change or until the server notifies us the script's status
+// changed.
+func (e *ElectrumFilteredChainView) history(
+	pkScript []byte) ([]electrum.HistoryItem, error) {
+
+	scriptHash := electrum.ScriptHash(pkScript)
+	if history, ok := e.historyCache[scriptHash]; ok {
+		return history, nil
+	}
+
+	history, err := e.client.ScriptHistory(pkScript)
+	if err != nil {
+		return nil, err
+	}
+	e.historyCache[scriptHash] = history
+
+	return history, nil
+}
+
+// allScripts returns the output scripts of all watched outpoints.
+func (e *ElectrumFilteredChainView) allScripts() [][]byte {
+	scripts := make([][]byte, 0, len(e.scripts))
+	for _, pkScript := range e.scripts {
+		scripts = append(scripts, pkScript)
+	}
+
+	return scripts
+}
+
+// FilterBlock takes a block hash, and returns a FilteredBlocks which is the
+// result of applying the current registered UTXO sub-set on the block
+// corresponding to that block hash. If any watched UTXO's are spent by the
+// selected block, then the internal chainFilter will also be updated.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *ElectrumFilteredChainView) FilterBlock(
+	blockHash *chainhash.Hash) (*FilteredBlock, error) {
+
+	req := &filterBlockReq{
+		blockHash: blockHash,
+		resp:      make(chan *FilteredBlock, 1),
+		err:       make(chan error, 1),
+	}
+
+	select {
+	case e.filterBlockReqs <- req:
+	case <-e.quit:
+		return nil, fmt.Errorf("FilteredChainView shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// UpdateFilter updates the UTXO filter which is to be consulted when creating
+// FilteredBlocks to be sent to subscribed clients. This method is cumulative
+// meaning repeated calls to this method should _expand_ the size of the UTXO
+// sub-set currently being watched.  If the set updateHeight is _lower_ than
+// the best known height of the implementation, then the state should be
+// rewound to ensure all relevant notifications are dispatched.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *ElectrumFilteredChainView) UpdateFilter(ops []channeldb.EdgePoint,
+	updateHeight uint32) error {
+
+	select {
+	case e.filterUpdates <- electrumFilterUpdate{
+		newOutputs:   ops,
+		updateHeight: updateHeight,
+	}:
+		return nil
+
+	case <-e.quit:
+		return fmt.Errorf("chain filter shutting down")
+	}
+}
+
+// FilteredBlocks returns the channel that filtered blocks are to be sent over.
+// Each time a block is connected to the end of a main chain, and appropriate
+// FilteredBlock which contains the transactions which mutate our watched UTXO
+// set is to be returned.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *ElectrumFilteredChainView) FilteredBlocks() <-chan *FilteredBlock {
+	return e.blockQueue.newBlocks
+}
+
+// DisconnectedBlocks returns a receive only channel which will be sent upon
+// with the empty filtered blocks of blocks which are disconnected from the
+// main chain in the case of a re-org.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *ElectrumFilteredChainView) DisconnectedBlocks() <-chan *FilteredBlock {
+	return e.blockQueue.staleBlocks
+}
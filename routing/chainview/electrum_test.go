@@ -0,0 +1,40 @@
+// +build dev
+
+package chainview
+
+import (
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightningnetwork/lnd/electrum"
+)
+
+func init() {
+	interfaceImpls = append(interfaceImpls, struct {
+		name          string
+		chainViewInit chainViewInitFunc
+	}{
+		name: "electrum",
+		chainViewInit: func(config rpcclient.ConnConfig, _ string) (func(), FilteredChainView, error) {
+			server, err := electrum.NewTestServer(&config)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			client := electrum.NewClient(&electrum.ClientConfig{
+				Server: server.Addr(),
+			})
+			if err := client.Start(); err != nil {
+				server.Stop()
+				return nil, nil, err
+			}
+
+			cleanUp := func() {
+				client.Stop()
+				server.Stop()
+			}
+
+			chainView := NewElectrumFilteredChainView(client)
+
+			return cleanUp, chainView, nil
+		},
+	})
+}
@@ -0,0 +1,441 @@
+package chainview
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/esplora"
+)
+
+// EsploraFilteredChainView is an implementation of the FilteredChainView
+// interface which is backed by an Esplora server.
+//
+// As the server is polled for new blocks, we may learn about several blocks
+// at once, in which case all of them are fetched and filtered in order.
+type EsploraFilteredChainView struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	client *esplora.Client
+
+	sub *esplora.Subscription
+
+	// bestHeight and bestHash describe the latest block added to the
+	// blockQueue. They're only accessed by the chainFilterer goroutine.
+	bestHeight int32
+	bestHash   chainhash.Hash
+
+	// blocks contains the hashes of the most recent blocks added to the
+	// blockQueue, allowing us to locate the fork point upon a reorg.
+	blocks map[int32]chainhash.Hash
+
+	// blockEventQueue is the ordered queue used to keep the order
+	// of connected and disconnected blocks sent to the reader of the
+	// chainView.
+	blockQueue *blockEventQueue
+
+	// filterUpdates is a channel in which updates to the utxo filter
+	// attached to this instance are sent over.
+	filterUpdates chan filterUpdate
+
+	// chainFilter is the set of utxo's that we're currently watching
+	// spends for within the chain. It's only accessed by the
+	// chainFilterer goroutine.
+	chainFilter map[wire.OutPoint]struct{}
+
+	// filterBlockReqs is a channel in which requests to filter select
+	// blocks will be sent over.
+	filterBlockReqs chan *filterBlockReq
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile time check to ensure EsploraFilteredChainView implements the
+// chainview.FilteredChainView.
+var _ FilteredChainView = (*EsploraFilteredChainView)(nil)
+
+// NewEsploraFilteredChainView creates a new instance of a FilteredChainView
+// backed by the given Esplora client. The client is shared and is expected to
+// be started and stopped by the caller.
+func NewEsploraFilteredChainView(
+	client *esplora.Client) *EsploraFilteredChainView {
+
+	return &EsploraFilteredChainView{
+		client:          client,
+		blocks:          make(map[int32]chainhash.Hash),
+		blockQueue:      newBlockEventQueue(),
+		filterUpdates:   make(chan filterUpdate),
+		chainFilter:     make(map[wire.OutPoint]struct{}),
+		filterBlockReqs: make(chan *filterBlockReq),
+		quit:            make(chan struct{}),
+	}
+}
+
+// Start starts all goroutines necessary for normal operation.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *EsploraFilteredChainView) Start() error {
+	// Already started?
+	if atomic.AddInt32(&e.started, 1) != 1 {
+		return nil
+	}
+
+	log.Infof("FilteredChainView starting")
+
+	e.sub = e.client.Subscribe()
+
+	bestHash, bestHeight, err := e.client.GetBestBlock()
+	if err != nil {
+		e.sub.Cancel()
+		return err
+	}
+	e.bestHeight = bestHeight
+	e.bestHash = *bestHash
+	e.blocks[bestHeight] = *bestHash
+
+	e.blockQueue.Start()
+
+	e.wg.Add(1)
+	go e.chainFilterer()
+
+	return nil
+}
+
+// Stop stops all goroutines which we launched by the prior call to the Start
+// method.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *EsploraFilteredChainView) Stop() error {
+	// Already shutting down?
+	if atomic.AddInt32(&e.stopped, 1) != 1 {
+		return nil
+	}
+
+	log.Infof("FilteredChainView stopping")
+
+	close(e.quit)
+	e.wg.Wait()
+
+	e.sub.Cancel()
+	e.blockQueue.Stop()
+
+	return nil
+}
+
+// chainFilterer is the primary goroutine which: listens for new blocks coming
+// and dispatches the relevant FilteredBlock notifications, updates the filter
+// due to requests by callers, and finally is able to preform targeted block
+// filtration.
+func (e *EsploraFilteredChainView) chainFilterer() {
+	defer e.wg.Done()
+
+	for {
+		select {
+		case ntfn, ok := <-e.sub.Notifications:
+			if !ok {
+				return
+			}
+
+			tip, ok := ntfn.(*esplora.TipNotification)
+			if !ok {
+				continue
+			}
+
+			if err := e.handleNewTip(tip); err != nil {
+				log.Errorf("Unable to process new tip %v at "+
+					"height %d: %v", tip.Header.BlockHash(),
+					tip.Height, err)
+			}
+
+		// The caller has just sent an update to the current chain
+		// filter, so we'll apply the update, possibly rewinding our
+		// state partially.
+		case update := <-e.filterUpdates:
+			if err := e.updateFilter(update); err != nil {
+				log.Errorf("Unable to update filter: %v", err)
+			}
+
+		// We've received a new request to manually filter a block.
+		case req := <-e.filterBlockReqs:
+			height, err := e.client.GetBlockHeight(req.blockHash)
+			if err != nil {
+				req.err <- err
+				req.resp <- nil
+				continue
+			}
+
+			block, err := e.filterBlock(height, req.blockHash)
+			req.err <- err
+			req.resp <- block
+
+		case <-e.quit:
+			return
+		}
+	}
+}
+
+// handleNewTip brings our view of the chain up to date with the tip announced
+// by the server, dispatching the blocks that were disconnected and connected
+// in the process.
+func (e *EsploraFilteredChainView) handleNewTip(
+	tip *esplora.TipNotification) error {
+
+	tipHash := tip.Header.BlockHash()
+	if tipHash == e.bestHash {
+		return nil
+	}
+
+	// If the server is behind us, e.g. when load balanced across several
+	// backends, we'll wait for it to catch up, unless the tip it
+	// announces has been reorged out of our chain.
+	if hash, ok := e.blocks[tip.Height]; ok && hash == tipHash {
+		return nil
+	}
+
+	// We'll walk back our chain until we find a block that's also part of
+	// the server's. If we're unable to walk back any further, as we've
+	// never seen the previous block, we'll assume it's the fork point.
+	forkHeight := e.bestHeight
+	forkHash := e.bestHash
+	for forkHeight > 0 {
+		if forkHeight < tip.Height {
+			chainHash, err := e.client.GetBlockHash(
+				int64(forkHeight),
+			)
+			if err != nil {
+				return err
+			}
+			if *chainHash == forkHash {
+				break
+			}
+		}
+
+		prevHash, ok := e.blocks[forkHeight-1]
+		if !ok {
+			header, err := e.client.GetBlockHeader(&forkHash)
+			if err != nil {
+				forkHeight--
+				break
+			}
+			prevHash = header.PrevBlock
+		}
+
+		forkHeight--
+		forkHash = prevHash
+	}
+
+	for e.bestHeight > forkHeight {
+		height := e.bestHeight
+
+		log.Debugf("got disconnected block at height %d: %v", height,
+			e.bestHash)
+
+		e.blockQueue.Add(&blockEvent{
+			eventType: disconnected,
+			block: &FilteredBlock{
+				Hash:   e.bestHash,
+				Height: uint32(height),
+			},
+		})
+		delete(e.blocks, height)
+
+		prevHash, ok := e.blocks[height-1]
+		if !ok {
+			hash, err := e.client.GetBlockHash(int64(height - 1))
+			if err != nil {
+				return err
+			}
+			prevHash = *hash
+		}
+		e.bestHeight = height - 1
+		e.bestHash = prevHash
+	}
+
+	for height := forkHeight + 1; height <= tip.Height; height++ {
+		hash := &tipHash
+		if height != tip.Height {
+			var err error
+			hash, err = e.client.GetBlockHash(int64(height))
+			if err != nil {
+				return err
+			}
+		}
+
+		block, err := e.filterBlock(height, hash)
+		if err != nil {
+			return err
+		}
+
+		e.blockQueue.Add(&blockEvent{
+			eventType: connected,
+			block:     block,
+		})
+
+		e.bestHeight = height
+		e.bestHash = *hash
+		e.blocks[height] = *hash
+		delete(e.blocks, height-reorgSafetyLimit)
+	}
+
+	return nil
+}
+
+// filterBlock fetches the block with the given hash, and returns the
+// filtered block containing the transactions spending any of our watched
+// outpoints. Any spent outpoints are removed from the chain filter.
+func (e *EsploraFilteredChainView) filterBlock(height int32,
+	hash *chainhash.Hash) (*FilteredBlock, error) {
+
+	rawBlock, err := e.client.GetBlock(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &FilteredBlock{
+		Hash:   *hash,
+		Height: uint32(height),
+	}
+	for _, tx := range rawBlock.Transactions {
+		isSpend := false
+		for _, txIn := range tx.TxIn {
+			prevOp := txIn.PreviousOutPoint
+			if _, ok := e.chainFilter[prevOp]; !ok {
+				continue
+			}
+
+			delete(e.chainFilter, prevOp)
+			isSpend = true
+		}
+
+		if isSpend {
+			block.Transactions = append(block.Transactions, tx)
+		}
+	}
+
+	return block, nil
+}
+
+// updateFilter adds the given outputs to our chain filter. If the update
+// height is below our best height, we'll rescan the blocks following it for
+// spends of the new outputs, only dispatching those which contain any.
+func (e *EsploraFilteredChainView) updateFilter(update filterUpdate) error {
+	log.Tracef("Updating chain filter with new UTXO's: %v",
+		update.newUtxos)
+
+	for _, newOp := range update.newUtxos {
+		e.chainFilter[newOp] = struct{}{}
+	}
+
+	// If the update height matches our best known height, then we don't
+	// need to do any rewinding.
+	if int32(update.updateHeight) >= e.bestHeight {
+		return nil
+	}
+
+	// Otherwise, we'll rewind the state to ensure the caller doesn't miss
+	// any relevant notifications. Starting from the height _after_ the
+	// update height, we'll walk forwards, only dispatching the blocks
+	// spending any of the new outputs.
+	startHeight := int32(update.updateHeight) + 1
+	for height := startHeight; height <= e.bestHeight; height++ {
+		hash, ok := e.blocks[height]
+		if !ok {
+			blockHash, err := e.client.GetBlockHash(int64(height))
+			if err != nil {
+				return err
+			}
+			hash = *blockHash
+		}
+
+		block, err := e.filterBlock(height, &hash)
+		if err != nil {
+			return err
+		}
+		if len(block.Transactions) == 0 {
+			continue
+		}
+
+		e.blockQueue.Add(&blockEvent{
+			eventType: connected,
+			block:     block,
+		})
+	}
+
+	return nil
+}
+
+// FilterBlock takes a block hash, and returns a FilteredBlocks which is the
+// result of applying the current registered UTXO sub-set on the block
+// corresponding to that block hash. If any watched UTXO's are spent by the
+// selected block, then the internal chainFilter will also be updated.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *EsploraFilteredChainView) FilterBlock(
+	blockHash *chainhash.Hash) (*FilteredBlock, error) {
+
+	req := &filterBlockReq{
+		blockHash: blockHash,
+		resp:      make(chan *FilteredBlock, 1),
+		err:       make(chan error, 1),
+	}
+
+	select {
+	case e.filterBlockReqs <- req:
+	case <-e.quit:
+		return nil, fmt.Errorf("FilteredChainView shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// UpdateFilter updates the UTXO filter which is to be consulted when creating
+// FilteredBlocks to be sent to subscribed clients. This method is cumulative
+// meaning repeated calls to this method should _expand_ the size of the UTXO
+// sub-set currently being watched.  If the set updateHeight is _lower_ than
+// the best known height of the implementation, then the state should be
+// rewound to ensure all relevant notifications are dispatched.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *EsploraFilteredChainView) UpdateFilter(ops []channeldb.EdgePoint,
+	updateHeight uint32) error {
+
+	newUtxos := make([]wire.OutPoint, len(ops))
+	for i, op := range ops {
+		newUtxos[i] = op.OutPoint
+	}
+
+	select {
+	case e.filterUpdates <- filterUpdate{
+		newUtxos:     newUtxos,
+		updateHeight: updateHeight,
+	}:
+		return nil
+
+	case <-e.quit:
+		return fmt.Errorf("chain filter shutting down")
+	}
+}
+
+// FilteredBlocks returns the channel that filtered blocks are to be sent over.
+// Each time a block is connected to the end of a main chain, and appropriate
+// FilteredBlock which contains the transactions which mutate our watched UTXO
+// set is to be returned.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *EsploraFilteredChainView) FilteredBlocks() <-chan *FilteredBlock {
+	return e.blockQueue.newBlocks
+}
+
+// DisconnectedBlocks returns a receive only channel which will be sent upon
+// with the empty filtered blocks of blocks which are disconnected from the
+// main chain in the case of a re-org.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (e *EsploraFilteredChainView) DisconnectedBlocks() <-chan *FilteredBlock {
+	return e.blockQueue.staleBlocks
+}
@@ -0,0 +1,43 @@
+// +build dev
+
+package chainview
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightningnetwork/lnd/esplora"
+)
+
+func init() {
+	interfaceImpls = append(interfaceImpls, struct {
+		name          string
+		chainViewInit chainViewInitFunc
+	}{
+		name: "esplora",
+		chainViewInit: func(config rpcclient.ConnConfig, _ string) (func(), FilteredChainView, error) {
+			server, err := esplora.NewTestServer(&config)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			client := esplora.NewClient(&esplora.ClientConfig{
+				URL:          server.URL(),
+				PollInterval: 100 * time.Millisecond,
+			})
+			if err := client.Start(); err != nil {
+				server.Stop()
+				return nil, nil, err
+			}
+
+			cleanUp := func() {
+				client.Stop()
+				server.Stop()
+			}
+
+			chainView := NewEsploraFilteredChainView(client)
+
+			return cleanUp, chainView, nil
+		},
+	})
+}
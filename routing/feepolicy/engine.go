@@ -0,0 +1,482 @@
+package feepolicy
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultUpdateInterval is the default interval at which the engine
+	// re-prices all channels.
+	DefaultUpdateInterval = time.Hour
+
+	// DefaultMinUpdateInterval is the default minimum time that must pass
+	// since a channel's last policy update before the engine will update
+	// it again.
+	DefaultMinUpdateInterval = 6 * time.Hour
+
+	// DefaultMinFeeRateDelta is the default minimum change of a channel's
+	// fee rate, in millionths, required before the engine will announce
+	// a new policy.
+	DefaultMinFeeRateDelta = 10
+
+	// DefaultVolumeWindow is the default period of forwarding history the
+	// engine takes into account.
+	DefaultVolumeWindow = 24 * time.Hour
+
+	// forwardingQueryBatch is the maximum number of forwarding events
+	// requested from the forwarding log at once.
+	forwardingQueryBatch = 1000
+)
+
+// Reasons reported in a Proposal for why a channel won't be updated.
+const (
+	// SkipNoStrategy is reported for channels that have neither a
+	// strategy of their own nor a default strategy.
+	SkipNoStrategy = "no strategy"
+
+	// SkipUnchanged is reported for channels whose policy already matches
+	// the strategy's result.
+	SkipUnchanged = "policy unchanged"
+
+	// SkipBelowDelta is reported for channels whose fee rate would change
+	// by less than the configured minimum delta.
+	SkipBelowDelta = "fee rate change below minimum delta"
+
+	// SkipRateLimited is reported for channels whose policy was updated
+	// too recently.
+	SkipRateLimited = "updated too recently"
+)
+
+var (
+	// ErrEngineShuttingDown is returned when a fee update is requested
+	// while the engine is shutting down.
+	ErrEngineShuttingDown = errors.New("fee engine shutting down")
+)
+
+// Config houses the parameters and resources the fee engine requires.
+type Config struct {
+	// Store persists the configured strategies.
+	Store *Store
+
+	// FetchAllOpenChannels returns all of our open channels, which are
+	// used to determine the local balance of each channel.
+	FetchAllOpenChannels func() ([]*channeldb.OpenChannel, error)
+
+	// ForAllOutgoingChannels iterates over all our local channels along
+	// with our current outgoing policy for each of them.
+	ForAllOutgoingChannels func(cb func(*channeldb.ChannelEdgeInfo,
+		*channeldb.ChannelEdgePolicy) error) error
+
+	// QueryForwardingLog queries the forwarding log for a time slice of
+	// forwarding events.
+	QueryForwardingLog func(channeldb.ForwardingEventQuery) (
+		channeldb.ForwardingLogTimeSlice, error)
+
+	// UpdatePolicy applies a new policy to the given channels. This is
+	// expected to persist the policy, update the active links and
+	// broadcast the change to the network.
+	UpdatePolicy func(routing.ChannelPolicy, ...wire.OutPoint) error
+
+	// Ticker fires whenever the engine should re-price all channels.
+	Ticker ticker.Ticker
+
+	// MinUpdateInterval is the minimum time that must have passed since a
+	// channel's policy was last updated before the engine updates it
+	// again. This bounds the amount of gossip the engine generates.
+	MinUpdateInterval time.Duration
+
+	// MinFeeRateDelta is the minimum change of a channel's fee rate, in
+	// millionths, for which the engine will announce a new policy if the
+	// base fee stays the same.
+	MinFeeRateDelta uint32
+
+	// VolumeWindow is the period of forwarding history used to determine
+	// each channel's recent outgoing volume.
+	VolumeWindow time.Duration
+
+	// Now returns the current time. It can be overridden in tests.
+	Now func() time.Time
+}
+
+// Proposal describes the policy the engine computed for a single channel,
+// and whether it would be applied.
+type Proposal struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// LocalRatio is the share of the channel capacity that is currently
+	// on our side, in the range [0, 1].
+	LocalRatio float64
+
+	// Volume is the amount forwarded out of the channel during the
+	// volume window.
+	Volume lnwire.MilliSatoshi
+
+	// CurrentBaseFee is the base fee currently advertised for the
+	// channel.
+	CurrentBaseFee lnwire.MilliSatoshi
+
+	// CurrentFeeRate is the fee rate, in millionths, currently advertised
+	// for the channel.
+	CurrentFeeRate uint32
+
+	// NewBaseFee is the base fee the strategy selected for the channel.
+	NewBaseFee lnwire.MilliSatoshi
+
+	// NewFeeRate is the fee rate, in millionths, the strategy selected
+	// for the channel.
+	NewFeeRate uint32
+
+	// TimeLockDelta is the channel's current time lock delta, which the
+	// engine leaves untouched.
+	TimeLockDelta uint32
+
+	// SkipReason is empty if the engine would apply the new policy.
+	// Otherwise it describes why the channel is left as is.
+	SkipReason string
+}
+
+// Apply returns true if the engine would apply the proposed policy.
+func (p *Proposal) Apply() bool {
+	return p.SkipReason == ""
+}
+
+// Engine periodically re-prices our channels according to the strategies
+// configured for them. New policies are applied through the same path as
+// manual policy updates, and are rate limited per channel so that the engine
+// doesn't flood the network with channel updates.
+type Engine struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// updateMtx serializes fee update rounds, so that a dry-run never
+	// interleaves with an update round that is being applied.
+	updateMtx sync.Mutex
+
+	// strategyMtx guards defaultStrategy and strategies.
+	strategyMtx sync.RWMutex
+
+	// defaultStrategy is used for all channels without a strategy of
+	// their own. It is nil if no default strategy is configured.
+	defaultStrategy *Strategy
+
+	// strategies contains the channel specific strategies.
+	strategies map[wire.OutPoint]*Strategy
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new fee engine, loading any previously configured strategies
+// from the store.
+func New(cfg *Config) (*Engine, error) {
+	defaultStrategy, strategies, err := cfg.Store.FetchStrategies()
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	return &Engine{
+		cfg:             cfg,
+		defaultStrategy: defaultStrategy,
+		strategies:      strategies,
+		quit:            make(chan struct{}),
+	}, nil
+}
+
+// Start launches the engine's periodic fee updates.
+func (e *Engine) Start() error {
+	e.started.Do(func() {
+		log.Infof("Dynamic fee engine starting")
+
+		e.cfg.Ticker.Resume()
+
+		e.wg.Add(1)
+		go e.updateLoop()
+	})
+
+	return nil
+}
+
+// Stop halts the engine's periodic fee updates.
+func (e *Engine) Stop() error {
+	e.stopped.Do(func() {
+		log.Infof("Dynamic fee engine shutting down")
+
+		close(e.quit)
+		e.wg.Wait()
+
+		e.cfg.Ticker.Stop()
+	})
+
+	return nil
+}
+
+// SetStrategy sets the strategy for the given channel, or the default
+// strategy if chanPoint is nil. A nil strategy removes the existing one. The
+// new strategy is taken into account from the next update round on.
+func (e *Engine) SetStrategy(chanPoint *wire.OutPoint,
+	strategy *Strategy) error {
+
+	if strategy != nil {
+		if err := strategy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	e.strategyMtx.Lock()
+	defer e.strategyMtx.Unlock()
+
+	if err := e.cfg.Store.SetStrategy(chanPoint, strategy); err != nil {
+		return err
+	}
+
+	switch {
+	case chanPoint == nil:
+		e.defaultStrategy = strategy
+
+	case strategy == nil:
+		delete(e.strategies, *chanPoint)
+
+	default:
+		e.strategies[*chanPoint] = strategy
+	}
+
+	return nil
+}
+
+// Strategies returns the default strategy, which is nil if none is set,
+// along with a copy of the set of channel specific strategies.
+func (e *Engine) Strategies() (*Strategy, map[wire.OutPoint]*Strategy) {
+	e.strategyMtx.RLock()
+	defer e.strategyMtx.RUnlock()
+
+	strategies := make(map[wire.OutPoint]*Strategy, len(e.strategies))
+	for chanPoint, strategy := range e.strategies {
+		strategies[chanPoint] = strategy
+	}
+
+	return e.defaultStrategy, strategies
+}
+
+// DryRun computes the policy the engine would select for each of our
+// channels without applying any of them.
+func (e *Engine) DryRun() ([]*Proposal, error) {
+	e.updateMtx.Lock()
+	defer e.updateMtx.Unlock()
+
+	return e.proposals()
+}
+
+// updateLoop re-prices all channels every time the ticker fires.
+//
+// NOTE: This MUST be run as a goroutine.
+func (e *Engine) updateLoop() {
+	defer e.wg.Done()
+
+	for {
+		select {
+		case <-e.cfg.Ticker.Ticks():
+			if err := e.updateFees(); err != nil {
+				log.Errorf("Unable to update channel fees: %v",
+					err)
+			}
+
+		case <-e.quit:
+			return
+		}
+	}
+}
+
+// updateFees computes a new policy for every channel and applies those that
+// pass the engine's rate limits.
+func (e *Engine) updateFees() error {
+	e.updateMtx.Lock()
+	defer e.updateMtx.Unlock()
+
+	proposals, err := e.proposals()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range proposals {
+		if !p.Apply() {
+			log.Tracef("Not updating fees of ChannelPoint(%v): %v",
+				p.ChanPoint, p.SkipReason)
+			continue
+		}
+
+		select {
+		case <-e.quit:
+			return ErrEngineShuttingDown
+		default:
+		}
+
+		log.Infof("Updating fees of ChannelPoint(%v) with local "+
+			"ratio %.2f and volume %v: base_fee=%v->%v, "+
+			"fee_rate=%v->%v", p.ChanPoint, p.LocalRatio, p.Volume,
+			p.CurrentBaseFee, p.NewBaseFee, p.CurrentFeeRate,
+			p.NewFeeRate)
+
+		// A zero max HTLC leaves the channel's current max HTLC as
+		// is, so we only touch the fees.
+		policy := routing.ChannelPolicy{
+			FeeSchema: routing.FeeSchema{
+				BaseFee: p.NewBaseFee,
+				FeeRate: p.NewFeeRate,
+			},
+			TimeLockDelta: p.TimeLockDelta,
+		}
+		if err := e.cfg.UpdatePolicy(policy, p.ChanPoint); err != nil {
+			log.Errorf("Unable to update fees of "+
+				"ChannelPoint(%v): %v", p.ChanPoint, err)
+		}
+	}
+
+	return nil
+}
+
+// proposals computes the policy the engine would select for each channel
+// that has a current outgoing policy.
+func (e *Engine) proposals() ([]*Proposal, error) {
+	now := e.cfg.Now()
+
+	channels, err := e.cfg.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+	openChans := make(map[wire.OutPoint]*channeldb.OpenChannel)
+	for _, c := range channels {
+		openChans[c.FundingOutpoint] = c
+	}
+
+	volumes, err := e.outgoingVolumes(now)
+	if err != nil {
+		return nil, err
+	}
+
+	e.strategyMtx.RLock()
+	defer e.strategyMtx.RUnlock()
+
+	var proposals []*Proposal
+	err = e.cfg.ForAllOutgoingChannels(func(info *channeldb.ChannelEdgeInfo,
+		edge *channeldb.ChannelEdgePolicy) error {
+
+		// Skip channels that are in the graph but not open anymore,
+		// as is the case while they're being closed.
+		channel, ok := openChans[info.ChannelPoint]
+		if !ok || edge == nil {
+			return nil
+		}
+
+		chanID := channel.ShortChanID()
+		p := &Proposal{
+			ChanPoint:      info.ChannelPoint,
+			ChanID:         chanID,
+			Volume:         volumes[chanID],
+			CurrentBaseFee: edge.FeeBaseMSat,
+			CurrentFeeRate: uint32(edge.FeeProportionalMillionths),
+			NewBaseFee:     edge.FeeBaseMSat,
+			NewFeeRate:     uint32(edge.FeeProportionalMillionths),
+			TimeLockDelta:  uint32(edge.TimeLockDelta),
+		}
+		proposals = append(proposals, p)
+
+		capacity := lnwire.NewMSatFromSatoshis(channel.Capacity)
+		if capacity != 0 {
+			localBalance := channel.LocalCommitment.LocalBalance
+			p.LocalRatio = float64(localBalance) / float64(capacity)
+		}
+
+		strategy, ok := e.strategies[info.ChannelPoint]
+		if !ok {
+			strategy = e.defaultStrategy
+		}
+		if strategy == nil {
+			p.SkipReason = SkipNoStrategy
+			return nil
+		}
+
+		p.NewBaseFee = strategy.BaseFee
+		p.NewFeeRate = strategy.FeeRate(p.LocalRatio, p.Volume)
+
+		p.SkipReason = e.skipReason(p, edge.LastUpdate, now)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proposals, nil
+}
+
+// skipReason applies the engine's rate limits to the proposal, returning a
+// non-empty reason if it shouldn't be applied.
+func (e *Engine) skipReason(p *Proposal, lastUpdate, now time.Time) string {
+	var delta uint32
+	if p.NewFeeRate > p.CurrentFeeRate {
+		delta = p.NewFeeRate - p.CurrentFeeRate
+	} else {
+		delta = p.CurrentFeeRate - p.NewFeeRate
+	}
+
+	switch {
+	case p.NewBaseFee == p.CurrentBaseFee && delta == 0:
+		return SkipUnchanged
+
+	case p.NewBaseFee == p.CurrentBaseFee && delta < e.cfg.MinFeeRateDelta:
+		return SkipBelowDelta
+
+	case now.Sub(lastUpdate) < e.cfg.MinUpdateInterval:
+		return SkipRateLimited
+	}
+
+	return ""
+}
+
+// outgoingVolumes sums the amounts forwarded out of each channel during the
+// volume window ending at now.
+func (e *Engine) outgoingVolumes(now time.Time) (
+	map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error) {
+
+	volumes := make(map[lnwire.ShortChannelID]lnwire.MilliSatoshi)
+
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    now.Add(-e.cfg.VolumeWindow),
+		EndTime:      now,
+		NumMaxEvents: forwardingQueryBatch,
+	}
+	for {
+		timeSlice, err := e.cfg.QueryForwardingLog(query)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(timeSlice.ForwardingEvents) == 0 {
+			return volumes, nil
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			volumes[event.OutgoingChanID] += event.AmtOut
+		}
+
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+}
@@ -0,0 +1,392 @@
+package feepolicy
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+var (
+	testTime = time.Unix(1000000, 0)
+
+	testChanPoint1 = wire.OutPoint{Hash: chainhash.Hash{1}, Index: 0}
+	testChanPoint2 = wire.OutPoint{Hash: chainhash.Hash{2}, Index: 1}
+
+	testChanID1 = lnwire.NewShortChanIDFromInt(1)
+	testChanID2 = lnwire.NewShortChanIDFromInt(2)
+)
+
+// newTestStore creates a strategy store backed by a temporary database.
+func newTestStore(t *testing.T) (*Store, func()) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "feepolicy")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := bbolt.Open(filepath.Join(dir, "test.db"), 0600, nil)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	cleanUp := func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+
+	store, err := NewStore(db)
+	if err != nil {
+		cleanUp()
+		t.Fatal(err)
+	}
+
+	return store, cleanUp
+}
+
+// testChannel is a channel known to the test engine.
+type testChannel struct {
+	chanPoint    wire.OutPoint
+	chanID       lnwire.ShortChannelID
+	localBalance lnwire.MilliSatoshi
+	policy       *channeldb.ChannelEdgePolicy
+}
+
+// engineHarness bundles a fee engine with mocked dependencies.
+type engineHarness struct {
+	t        *testing.T
+	engine   *Engine
+	channels []*testChannel
+	events   []channeldb.ForwardingEvent
+	updates  map[wire.OutPoint]routing.ChannelPolicy
+	cleanUp  func()
+}
+
+func newEngineHarness(t *testing.T, channels []*testChannel) *engineHarness {
+	store, cleanUp := newTestStore(t)
+
+	h := &engineHarness{
+		t:        t,
+		channels: channels,
+		updates:  make(map[wire.OutPoint]routing.ChannelPolicy),
+		cleanUp:  cleanUp,
+	}
+
+	engine, err := New(&Config{
+		Store: store,
+		FetchAllOpenChannels: func() ([]*channeldb.OpenChannel, error) {
+			var chans []*channeldb.OpenChannel
+			for _, c := range h.channels {
+				chans = append(chans, &channeldb.OpenChannel{
+					FundingOutpoint: c.chanPoint,
+					ShortChannelID:  c.chanID,
+					Capacity:        btcutil.Amount(1000),
+					LocalCommitment: channeldb.ChannelCommitment{
+						LocalBalance: c.localBalance,
+					},
+				})
+			}
+			return chans, nil
+		},
+		ForAllOutgoingChannels: func(cb func(*channeldb.ChannelEdgeInfo,
+			*channeldb.ChannelEdgePolicy) error) error {
+
+			for _, c := range h.channels {
+				info := &channeldb.ChannelEdgeInfo{
+					ChannelPoint: c.chanPoint,
+				}
+				if err := cb(info, c.policy); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		QueryForwardingLog: func(q channeldb.ForwardingEventQuery) (
+			channeldb.ForwardingLogTimeSlice, error) {
+
+			slice := channeldb.ForwardingLogTimeSlice{
+				ForwardingEventQuery: q,
+			}
+			if q.IndexOffset == 0 {
+				slice.ForwardingEvents = h.events
+				slice.LastIndexOffset = uint32(len(h.events))
+			}
+			return slice, nil
+		},
+		UpdatePolicy: func(p routing.ChannelPolicy,
+			chanPoints ...wire.OutPoint) error {
+
+			for _, chanPoint := range chanPoints {
+				h.updates[chanPoint] = p
+			}
+			return nil
+		},
+		Ticker:            ticker.NewForce(time.Hour),
+		MinUpdateInterval: time.Hour,
+		MinFeeRateDelta:   10,
+		VolumeWindow:      24 * time.Hour,
+		Now: func() time.Time {
+			return testTime
+		},
+	})
+	if err != nil {
+		cleanUp()
+		t.Fatal(err)
+	}
+	h.engine = engine
+
+	return h
+}
+
+// TestEngineProposals asserts that the engine applies strategies and its
+// rate limits when computing proposals.
+func TestEngineProposals(t *testing.T) {
+	t.Parallel()
+
+	channels := []*testChannel{
+		{
+			chanPoint:    testChanPoint1,
+			chanID:       testChanID1,
+			localBalance: 250000,
+			policy: &channeldb.ChannelEdgePolicy{
+				FeeBaseMSat:               1000,
+				FeeProportionalMillionths: 1,
+				TimeLockDelta:             40,
+				LastUpdate:                testTime.Add(-2 * time.Hour),
+			},
+		},
+		{
+			chanPoint:    testChanPoint2,
+			chanID:       testChanID2,
+			localBalance: 750000,
+			policy: &channeldb.ChannelEdgePolicy{
+				FeeBaseMSat:               1000,
+				FeeProportionalMillionths: 1,
+				TimeLockDelta:             144,
+				LastUpdate:                testTime.Add(-2 * time.Hour),
+			},
+		},
+	}
+
+	h := newEngineHarness(t, channels)
+	defer h.cleanUp()
+
+	// Without any strategies, no channel should be touched.
+	proposals, err := h.engine.DryRun()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proposals) != 2 {
+		t.Fatalf("expected 2 proposals, got %v", len(proposals))
+	}
+	for _, p := range proposals {
+		if p.SkipReason != SkipNoStrategy {
+			t.Fatalf("expected skip reason %q, got %q",
+				SkipNoStrategy, p.SkipReason)
+		}
+	}
+
+	// Set a default strategy, along with a channel specific one for the
+	// second channel.
+	err = h.engine.SetStrategy(nil, &Strategy{
+		BaseFee:    500,
+		MinFeeRate: 100,
+		MaxFeeRate: 1100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.engine.SetStrategy(&testChanPoint2, &Strategy{
+		BaseFee:    1000,
+		MinFeeRate: 0,
+		MaxFeeRate: 12,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proposals, err = h.engine.DryRun()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first channel is priced by the default strategy.
+	p := proposals[0]
+	if p.LocalRatio != 0.25 {
+		t.Fatalf("expected local ratio 0.25, got %v", p.LocalRatio)
+	}
+	if p.NewBaseFee != 500 || p.NewFeeRate != 850 {
+		t.Fatalf("unexpected policy: base_fee=%v, fee_rate=%v",
+			p.NewBaseFee, p.NewFeeRate)
+	}
+	if !p.Apply() {
+		t.Fatalf("expected proposal to be applied: %v", p.SkipReason)
+	}
+
+	// The second channel's fee rate only changes by 2, which is below the
+	// minimum delta.
+	p = proposals[1]
+	if p.NewFeeRate != 3 {
+		t.Fatalf("unexpected fee rate: %v", p.NewFeeRate)
+	}
+	if p.SkipReason != SkipBelowDelta {
+		t.Fatalf("expected skip reason %q, got %q", SkipBelowDelta,
+			p.SkipReason)
+	}
+
+	// A dry-run must never apply any policy.
+	if len(h.updates) != 0 {
+		t.Fatalf("expected no updates, got %v", len(h.updates))
+	}
+
+	// Now run an actual update round, which should only touch the first
+	// channel and leave its time lock delta unchanged.
+	if err := h.engine.updateFees(); err != nil {
+		t.Fatal(err)
+	}
+	expectedUpdates := map[wire.OutPoint]routing.ChannelPolicy{
+		testChanPoint1: {
+			FeeSchema: routing.FeeSchema{
+				BaseFee: 500,
+				FeeRate: 850,
+			},
+			TimeLockDelta: 40,
+		},
+	}
+	if !reflect.DeepEqual(h.updates, expectedUpdates) {
+		t.Fatalf("unexpected updates: %v", h.updates)
+	}
+
+	// If the first channel was updated recently, the engine should hold
+	// off until the min update interval has passed.
+	channels[0].policy.LastUpdate = testTime.Add(-time.Minute)
+	proposals, err = h.engine.DryRun()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proposals[0].SkipReason != SkipRateLimited {
+		t.Fatalf("expected skip reason %q, got %q", SkipRateLimited,
+			proposals[0].SkipReason)
+	}
+}
+
+// TestEngineVolume asserts that the forwarding volume of each channel is
+// taken into account.
+func TestEngineVolume(t *testing.T) {
+	t.Parallel()
+
+	channels := []*testChannel{
+		{
+			chanPoint:    testChanPoint1,
+			chanID:       testChanID1,
+			localBalance: 500000,
+			policy:       &channeldb.ChannelEdgePolicy{},
+		},
+		{
+			chanPoint:    testChanPoint2,
+			chanID:       testChanID2,
+			localBalance: 500000,
+			policy:       &channeldb.ChannelEdgePolicy{},
+		},
+	}
+
+	h := newEngineHarness(t, channels)
+	defer h.cleanUp()
+
+	h.events = []channeldb.ForwardingEvent{
+		{OutgoingChanID: testChanID1, AmtOut: 600},
+		{OutgoingChanID: testChanID1, AmtOut: 400},
+		{OutgoingChanID: testChanID2, AmtOut: 100},
+	}
+
+	err := h.engine.SetStrategy(nil, &Strategy{
+		MinFeeRate:          100,
+		MaxFeeRate:          1100,
+		VolumeTarget:        1000,
+		VolumeAdjustPercent: 50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proposals, err := h.engine.DryRun()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if proposals[0].Volume != 1000 || proposals[0].NewFeeRate != 900 {
+		t.Fatalf("unexpected proposal for busy channel: volume=%v, "+
+			"fee_rate=%v", proposals[0].Volume,
+			proposals[0].NewFeeRate)
+	}
+	if proposals[1].Volume != 100 || proposals[1].NewFeeRate != 300 {
+		t.Fatalf("unexpected proposal for idle channel: volume=%v, "+
+			"fee_rate=%v", proposals[1].Volume,
+			proposals[1].NewFeeRate)
+	}
+}
+
+// TestStrategyPersistence asserts that strategies survive a restart of the
+// engine, and that removed strategies stay removed.
+func TestStrategyPersistence(t *testing.T) {
+	t.Parallel()
+
+	store, cleanUp := newTestStore(t)
+	defer cleanUp()
+
+	defaultStrategy := &Strategy{
+		BaseFee:    1,
+		MinFeeRate: 2,
+		MaxFeeRate: 3,
+	}
+	chanStrategy := &Strategy{
+		Curve:      CurveStep,
+		MaxFeeRate: 100,
+		Steps: []Step{
+			{MaxLocalPercent: 10, FeeRate: 100},
+			{MaxLocalPercent: 100, FeeRate: 50},
+		},
+		VolumeTarget:        5,
+		VolumeAdjustPercent: 6,
+	}
+
+	if err := store.SetStrategy(nil, defaultStrategy); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetStrategy(&testChanPoint1, chanStrategy); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetStrategy(&testChanPoint2, chanStrategy); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetStrategy(&testChanPoint2, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	fetchedDefault, fetched, err := store.FetchStrategies()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fetchedDefault, defaultStrategy) {
+		t.Fatalf("unexpected default strategy: %v", fetchedDefault)
+	}
+	expected := map[wire.OutPoint]*Strategy{
+		testChanPoint1: chanStrategy,
+	}
+	if !reflect.DeepEqual(fetched, expected) {
+		t.Fatalf("unexpected strategies: %v", fetched)
+	}
+}
@@ -0,0 +1,199 @@
+package feepolicy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// strategyBucketKey is the top-level bucket that holds the per-channel
+	// strategies, keyed by channel point.
+	strategyBucketKey = []byte("fee-policy-strategies")
+
+	// defaultStrategyKey is the key within the strategy bucket under which
+	// the strategy applied to all channels without a strategy of their
+	// own is stored. It can't collide with a channel point key, as those
+	// are always 36 bytes long.
+	defaultStrategyKey = []byte("default")
+
+	// byteOrder is the byte order used for all integers in the store.
+	byteOrder = binary.BigEndian
+)
+
+// Store persists the strategies configured for the fee engine, so they
+// survive restarts.
+type Store struct {
+	db *bbolt.DB
+}
+
+// NewStore creates a new strategy store backed by the given database,
+// creating the required buckets if they don't exist yet.
+func NewStore(db *bbolt.DB) (*Store, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(strategyBucketKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{db: db}, nil
+}
+
+// SetStrategy stores the strategy for the given channel. If chanPoint is nil,
+// the default strategy is set instead. A nil strategy removes any existing
+// entry.
+func (s *Store) SetStrategy(chanPoint *wire.OutPoint,
+	strategy *Strategy) error {
+
+	key := defaultStrategyKey
+	if chanPoint != nil {
+		var b bytes.Buffer
+		if err := writeOutpoint(&b, chanPoint); err != nil {
+			return err
+		}
+		key = b.Bytes()
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(strategyBucketKey)
+
+		if strategy == nil {
+			return bucket.Delete(key)
+		}
+
+		var b bytes.Buffer
+		if err := serializeStrategy(&b, strategy); err != nil {
+			return err
+		}
+
+		return bucket.Put(key, b.Bytes())
+	})
+}
+
+// FetchStrategies returns the default strategy, which is nil if none is set,
+// along with all channel specific strategies.
+func (s *Store) FetchStrategies() (*Strategy,
+	map[wire.OutPoint]*Strategy, error) {
+
+	var (
+		defaultStrategy *Strategy
+		strategies      map[wire.OutPoint]*Strategy
+	)
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		strategies = make(map[wire.OutPoint]*Strategy)
+		bucket := tx.Bucket(strategyBucketKey)
+
+		return bucket.ForEach(func(k, v []byte) error {
+			strategy, err := deserializeStrategy(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			if bytes.Equal(k, defaultStrategyKey) {
+				defaultStrategy = strategy
+				return nil
+			}
+
+			var chanPoint wire.OutPoint
+			err = readOutpoint(bytes.NewReader(k), &chanPoint)
+			if err != nil {
+				return err
+			}
+			strategies[chanPoint] = strategy
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return defaultStrategy, strategies, nil
+}
+
+// writeOutpoint serializes an outpoint as its 32 byte txid followed by its
+// four byte output index.
+func writeOutpoint(w io.Writer, o *wire.OutPoint) error {
+	if _, err := w.Write(o.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Write(w, byteOrder, o.Index)
+}
+
+// readOutpoint deserializes an outpoint written by writeOutpoint.
+func readOutpoint(r io.Reader, o *wire.OutPoint) error {
+	if _, err := io.ReadFull(r, o.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Read(r, byteOrder, &o.Index)
+}
+
+// strategyHeader is the fixed size portion of a serialized strategy, which is
+// followed by NumSteps serialized steps.
+type strategyHeader struct {
+	Curve               CurveType
+	BaseFee             uint64
+	MinFeeRate          uint32
+	MaxFeeRate          uint32
+	VolumeTarget        uint64
+	VolumeAdjustPercent uint32
+	NumSteps            uint16
+}
+
+// serializeStrategy writes the strategy to w.
+func serializeStrategy(w io.Writer, s *Strategy) error {
+	err := binary.Write(w, byteOrder, &strategyHeader{
+		Curve:               s.Curve,
+		BaseFee:             uint64(s.BaseFee),
+		MinFeeRate:          s.MinFeeRate,
+		MaxFeeRate:          s.MaxFeeRate,
+		VolumeTarget:        uint64(s.VolumeTarget),
+		VolumeAdjustPercent: s.VolumeAdjustPercent,
+		NumSteps:            uint16(len(s.Steps)),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, step := range s.Steps {
+		if err := binary.Write(w, byteOrder, step); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeStrategy reads a strategy written by serializeStrategy from r.
+func deserializeStrategy(r io.Reader) (*Strategy, error) {
+	var header strategyHeader
+	if err := binary.Read(r, byteOrder, &header); err != nil {
+		return nil, err
+	}
+
+	s := &Strategy{
+		Curve:               header.Curve,
+		BaseFee:             lnwire.MilliSatoshi(header.BaseFee),
+		MinFeeRate:          header.MinFeeRate,
+		MaxFeeRate:          header.MaxFeeRate,
+		VolumeTarget:        lnwire.MilliSatoshi(header.VolumeTarget),
+		VolumeAdjustPercent: header.VolumeAdjustPercent,
+	}
+
+	if header.NumSteps > 0 {
+		s.Steps = make([]Step, header.NumSteps)
+		if err := binary.Read(r, byteOrder, s.Steps); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,203 @@
+package feepolicy
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// CurveType identifies the function used to derive a channel's proportional
+// fee rate from the share of its capacity that is currently on our side.
+type CurveType uint8
+
+const (
+	// CurveProportional scales the fee rate linearly between MaxFeeRate,
+	// for a channel that has no local balance left, and MinFeeRate, for a
+	// channel whose entire capacity is on our side.
+	CurveProportional CurveType = 0
+
+	// CurveStep selects the fee rate of the first step whose local balance
+	// threshold is at or above the channel's current local balance ratio.
+	CurveStep CurveType = 1
+)
+
+// String returns a human readable name for the curve type.
+func (c CurveType) String() string {
+	switch c {
+	case CurveProportional:
+		return "proportional"
+	case CurveStep:
+		return "step"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(c))
+	}
+}
+
+// maxPercent is the largest percentage accepted for step thresholds and
+// volume adjustments.
+const maxPercent = 100
+
+var (
+	// ErrNoSteps is returned when a step strategy is defined without any
+	// steps.
+	ErrNoSteps = errors.New("step curve requires at least one step")
+
+	// ErrInvalidFeeRange is returned when the minimum fee rate of a
+	// strategy exceeds its maximum fee rate.
+	ErrInvalidFeeRange = errors.New("min fee rate must not exceed max " +
+		"fee rate")
+)
+
+// Step is a single segment of a step curve. A channel whose local balance
+// ratio is at or below MaxLocalPercent, and above the threshold of the
+// previous step, is assigned FeeRate.
+type Step struct {
+	// MaxLocalPercent is the inclusive upper bound of the local balance,
+	// expressed as a percentage of the channel capacity, for which this
+	// step applies.
+	MaxLocalPercent uint32
+
+	// FeeRate is the proportional fee rate, in millionths, charged while
+	// this step applies.
+	FeeRate uint32
+}
+
+// Strategy describes how the fee engine should price a channel. The base fee
+// is static, while the proportional fee rate is derived from the channel's
+// local balance ratio using the configured curve and then optionally nudged
+// by recent forwarding volume.
+type Strategy struct {
+	// Curve selects the function that maps the local balance ratio to a
+	// fee rate.
+	Curve CurveType
+
+	// BaseFee is the base fee that is set on every channel priced by this
+	// strategy.
+	BaseFee lnwire.MilliSatoshi
+
+	// MinFeeRate is the lowest proportional fee rate, in millionths, the
+	// strategy will ever set.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the highest proportional fee rate, in millionths, the
+	// strategy will ever set.
+	MaxFeeRate uint32
+
+	// Steps are the segments of a step curve, ordered by increasing
+	// MaxLocalPercent. They are only used with CurveStep.
+	Steps []Step
+
+	// VolumeTarget is the amount we'd like to forward out of the channel
+	// during the engine's volume window. If zero, forwarding volume is
+	// not taken into account.
+	VolumeTarget lnwire.MilliSatoshi
+
+	// VolumeAdjustPercent is the percentage by which the curve's fee rate
+	// is raised if the channel forwarded at least VolumeTarget during the
+	// volume window, or lowered if it forwarded less than that.
+	VolumeAdjustPercent uint32
+}
+
+// Validate ensures that the strategy is well formed.
+func (s *Strategy) Validate() error {
+	if s.MinFeeRate > s.MaxFeeRate {
+		return ErrInvalidFeeRange
+	}
+
+	if s.VolumeAdjustPercent > maxPercent {
+		return fmt.Errorf("volume adjustment of %v%% exceeds %v%%",
+			s.VolumeAdjustPercent, maxPercent)
+	}
+
+	switch s.Curve {
+	case CurveProportional:
+		return nil
+
+	case CurveStep:
+		if len(s.Steps) == 0 {
+			return ErrNoSteps
+		}
+
+		var prev uint32
+		for i, step := range s.Steps {
+			if step.MaxLocalPercent > maxPercent {
+				return fmt.Errorf("step %d threshold of %v%% "+
+					"exceeds %v%%", i, step.MaxLocalPercent,
+					maxPercent)
+			}
+			if i > 0 && step.MaxLocalPercent <= prev {
+				return fmt.Errorf("step %d threshold of %v%% "+
+					"is not above previous threshold of "+
+					"%v%%", i, step.MaxLocalPercent, prev)
+			}
+			if step.FeeRate < s.MinFeeRate ||
+				step.FeeRate > s.MaxFeeRate {
+
+				return fmt.Errorf("step %d fee rate of %v is "+
+					"outside of [%v, %v]", i, step.FeeRate,
+					s.MinFeeRate, s.MaxFeeRate)
+			}
+			prev = step.MaxLocalPercent
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown curve type: %v", s.Curve)
+	}
+}
+
+// FeeRate computes the proportional fee rate, in millionths, for a channel
+// with the given local balance ratio (in the range [0, 1]) that forwarded the
+// given amount out during the engine's volume window. The result is always
+// clamped to [MinFeeRate, MaxFeeRate].
+func (s *Strategy) FeeRate(localRatio float64,
+	volume lnwire.MilliSatoshi) uint32 {
+
+	switch {
+	case localRatio < 0:
+		localRatio = 0
+	case localRatio > 1:
+		localRatio = 1
+	}
+
+	var rate float64
+	switch s.Curve {
+	case CurveStep:
+		// If the steps don't run all the way up to 100%, the last step
+		// also covers all ratios above its threshold.
+		rate = float64(s.Steps[len(s.Steps)-1].FeeRate)
+		localPercent := localRatio * maxPercent
+		for _, step := range s.Steps {
+			if localPercent <= float64(step.MaxLocalPercent) {
+				rate = float64(step.FeeRate)
+				break
+			}
+		}
+
+	default:
+		span := float64(s.MaxFeeRate - s.MinFeeRate)
+		rate = float64(s.MaxFeeRate) - span*localRatio
+	}
+
+	// If a volume target is set, we'll reward channels that are in demand
+	// with a higher rate and make idle ones cheaper.
+	if s.VolumeTarget != 0 && s.VolumeAdjustPercent != 0 {
+		adjust := float64(s.VolumeAdjustPercent) / maxPercent
+		if volume >= s.VolumeTarget {
+			rate *= 1 + adjust
+		} else {
+			rate *= 1 - adjust
+		}
+	}
+
+	switch {
+	case rate < float64(s.MinFeeRate):
+		return s.MinFeeRate
+	case rate > float64(s.MaxFeeRate):
+		return s.MaxFeeRate
+	}
+
+	return uint32(rate + 0.5)
+}
@@ -0,0 +1,214 @@
+package feepolicy
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestStrategyFeeRate asserts that the fee rate computed by the different
+// curves matches our expectations.
+func TestStrategyFeeRate(t *testing.T) {
+	t.Parallel()
+
+	proportional := &Strategy{
+		Curve:      CurveProportional,
+		MinFeeRate: 100,
+		MaxFeeRate: 1100,
+	}
+
+	step := &Strategy{
+		Curve:      CurveStep,
+		MinFeeRate: 10,
+		MaxFeeRate: 1000,
+		Steps: []Step{
+			{MaxLocalPercent: 20, FeeRate: 1000},
+			{MaxLocalPercent: 50, FeeRate: 500},
+			{MaxLocalPercent: 80, FeeRate: 100},
+		},
+	}
+
+	volume := &Strategy{
+		Curve:               CurveProportional,
+		MinFeeRate:          100,
+		MaxFeeRate:          1100,
+		VolumeTarget:        1000,
+		VolumeAdjustPercent: 50,
+	}
+
+	testCases := []struct {
+		name       string
+		strategy   *Strategy
+		localRatio float64
+		volume     lnwire.MilliSatoshi
+		expected   uint32
+	}{
+		{
+			name:       "proportional depleted",
+			strategy:   proportional,
+			localRatio: 0,
+			expected:   1100,
+		},
+		{
+			name:       "proportional balanced",
+			strategy:   proportional,
+			localRatio: 0.5,
+			expected:   600,
+		},
+		{
+			name:       "proportional full",
+			strategy:   proportional,
+			localRatio: 1,
+			expected:   100,
+		},
+		{
+			name:       "proportional ratio out of range",
+			strategy:   proportional,
+			localRatio: 1.5,
+			expected:   100,
+		},
+		{
+			name:       "step lowest",
+			strategy:   step,
+			localRatio: 0.1,
+			expected:   1000,
+		},
+		{
+			name:       "step on threshold",
+			strategy:   step,
+			localRatio: 0.5,
+			expected:   500,
+		},
+		{
+			name:       "step above all thresholds",
+			strategy:   step,
+			localRatio: 0.9,
+			expected:   100,
+		},
+		{
+			name:       "volume target met",
+			strategy:   volume,
+			localRatio: 0.5,
+			volume:     1000,
+			expected:   900,
+		},
+		{
+			name:       "volume target missed",
+			strategy:   volume,
+			localRatio: 0.5,
+			volume:     999,
+			expected:   300,
+		},
+		{
+			name:       "volume adjustment clamped",
+			strategy:   volume,
+			localRatio: 0,
+			volume:     2000,
+			expected:   1100,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			rate := test.strategy.FeeRate(test.localRatio, test.volume)
+			if rate != test.expected {
+				t.Fatalf("expected fee rate %v, got %v",
+					test.expected, rate)
+			}
+		})
+	}
+}
+
+// TestStrategyValidate asserts that malformed strategies are rejected.
+func TestStrategyValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		strategy *Strategy
+		valid    bool
+	}{
+		{
+			name: "valid proportional",
+			strategy: &Strategy{
+				MinFeeRate: 1,
+				MaxFeeRate: 10,
+			},
+			valid: true,
+		},
+		{
+			name: "inverted fee range",
+			strategy: &Strategy{
+				MinFeeRate: 10,
+				MaxFeeRate: 1,
+			},
+		},
+		{
+			name: "volume adjustment too large",
+			strategy: &Strategy{
+				VolumeAdjustPercent: 101,
+			},
+		},
+		{
+			name: "step without steps",
+			strategy: &Strategy{
+				Curve: CurveStep,
+			},
+		},
+		{
+			name: "valid step",
+			strategy: &Strategy{
+				Curve:      CurveStep,
+				MaxFeeRate: 10,
+				Steps: []Step{
+					{MaxLocalPercent: 50, FeeRate: 10},
+					{MaxLocalPercent: 100, FeeRate: 0},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "unordered steps",
+			strategy: &Strategy{
+				Curve:      CurveStep,
+				MaxFeeRate: 10,
+				Steps: []Step{
+					{MaxLocalPercent: 50, FeeRate: 10},
+					{MaxLocalPercent: 50, FeeRate: 0},
+				},
+			},
+		},
+		{
+			name: "step fee rate out of range",
+			strategy: &Strategy{
+				Curve:      CurveStep,
+				MaxFeeRate: 10,
+				Steps: []Step{
+					{MaxLocalPercent: 50, FeeRate: 11},
+				},
+			},
+		},
+		{
+			name: "unknown curve",
+			strategy: &Strategy{
+				Curve: 2,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := test.strategy.Validate()
+			if test.valid && err != nil {
+				t.Fatalf("expected valid strategy, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Fatal("expected invalid strategy")
+			}
+		})
+	}
+}
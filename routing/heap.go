@@ -20,9 +20,16 @@ type nodeWithDist struct {
 
 	// amountToReceive is the amount that should be received by this node.
 	// Either as final payment to the final node or as an intermediate
-	// amount that includes also the fees for subsequent hops.
+	// amount that includes also the fees for subsequent hops. It doesn't
+	// include the inbound fee this node charges, as that depends on the
+	// channel it is reached through.
 	amountToReceive lnwire.MilliSatoshi
 
+	// outgoingFee is the fee this node charges for its outgoing channel
+	// towards the destination. A negative inbound fee can at most cancel
+	// it out.
+	outgoingFee lnwire.MilliSatoshi
+
 	// incomingCltv is the expected cltv value for the incoming htlc of this
 	// node. This value does not include the final cltv.
 	incomingCltv uint32
@@ -82,6 +82,17 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 			return nil
 		}
 
+		// The inbound fee is carried in the edge's extra opaque data.
+		// If it can't be decoded, we'll skip this channel rather than
+		// failing the update of all other channels.
+		inboundFee, err := edge.InboundFee()
+		if err != nil {
+			log.Errorf("Unable to decode inbound fee of channel "+
+				"%v, skipping policy update: %v",
+				info.ChannelPoint, err)
+			return nil
+		}
+
 		// Add updated edge to list of edges to send to gossiper.
 		edgesToUpdate = append(edgesToUpdate, discovery.EdgeWithInfo{
 			Info: info,
@@ -95,6 +106,7 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 			TimeLockDelta: uint32(edge.TimeLockDelta),
 			MinHTLC:       edge.MinHTLC,
 			MaxHTLC:       edge.MaxHTLC,
+			InboundFee:    inboundFee,
 		}
 
 		return nil
@@ -131,6 +143,20 @@ func (r *Manager) updateEdge(chanPoint wire.OutPoint,
 	)
 	edge.TimeLockDelta = uint16(newSchema.TimeLockDelta)
 
+	// Update the inbound fee if one was specified. It is stored in the
+	// edge's extra opaque data, so it becomes part of the signed channel
+	// update.
+	if newSchema.InboundFee != nil {
+		extraData, err := lnwire.SetInboundFee(
+			edge.ExtraOpaqueData, newSchema.InboundFee,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to set inbound fee for "+
+				"channel %v: %v", chanPoint, err)
+		}
+		edge.ExtraOpaqueData = extraData
+	}
+
 	// Retrieve negotiated channel htlc amt limits.
 	amtMin, amtMax, err := r.getHtlcAmtLimits(chanPoint)
 	if err != nil {
@@ -32,6 +32,10 @@ func TestManager(t *testing.T) {
 		},
 		TimeLockDelta: 80,
 		MaxHTLC:       5000,
+		InboundFee: &lnwire.Fee{
+			BaseFee: -10,
+			FeeRate: -20,
+		},
 	}
 
 	currentPolicy := channeldb.ChannelEdgePolicy{
@@ -59,6 +63,9 @@ func TestManager(t *testing.T) {
 		if policy.MaxHTLC != newPolicy.MaxHTLC {
 			t.Fatal("unexpected max htlc")
 		}
+		if policy.InboundFee != *newPolicy.InboundFee {
+			t.Fatal("unexpected inbound fee")
+		}
 	}
 
 	propagateChanPolicyUpdate := func(
@@ -84,6 +91,13 @@ func TestManager(t *testing.T) {
 		if policy.MaxHTLC != newPolicy.MaxHTLC {
 			t.Fatal("unexpected max htlc")
 		}
+		inboundFee, err := policy.InboundFee()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if inboundFee != *newPolicy.InboundFee {
+			t.Fatal("unexpected inbound fee")
+		}
 
 		return nil
 	}
@@ -135,11 +149,12 @@ func TestManager(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	// If no max htlc is specified, the max htlc value should be kept
-	// unchanged.
+	// If no max htlc or inbound fee is specified, their values should be
+	// kept unchanged.
 	currentPolicy.MaxHTLC = newPolicy.MaxHTLC
 	noMaxHtlcPolicy := newPolicy
 	noMaxHtlcPolicy.MaxHTLC = 0
+	noMaxHtlcPolicy.InboundFee = nil
 
 	err = manager.UpdatePolicy(noMaxHtlcPolicy)
 	if err != nil {
@@ -41,7 +41,7 @@ const (
 // pathFinder defines the interface of a path finding algorithm.
 type pathFinder = func(g *graphParams, r *RestrictParams,
 	cfg *PathFindingConfig, source, target route.Vertex,
-	amt lnwire.MilliSatoshi) ([]*channeldb.ChannelEdgePolicy, error)
+	amt lnwire.MilliSatoshi) ([]*pathEdge, error)
 
 var (
 	// DefaultPaymentAttemptPenalty is the virtual cost in path finding weight
@@ -59,6 +59,17 @@ var (
 	DefaultAprioriHopProbability = float64(0.6)
 )
 
+// pathEdge is a channel policy along a path, annotated with the inbound fee
+// that the node the policy leads to charges for HTLCs arriving over the
+// channel.
+type pathEdge struct {
+	*channeldb.ChannelEdgePolicy
+
+	// inboundFee is the inbound fee charged by the node at the end of the
+	// edge. It is taken from that node's own policy for the channel.
+	inboundFee lnwire.Fee
+}
+
 // edgePolicyWithSource is a helper struct to keep track of the source node
 // of a channel edge. ChannelEdgePolicy only contains to destination node
 // of the edge.
@@ -75,7 +86,7 @@ type edgePolicyWithSource struct {
 // NOTE: The passed slice of ChannelHops MUST be sorted in forward order: from
 // the source to the target node of the path finding attempt.
 func newRoute(amtToSend lnwire.MilliSatoshi, sourceVertex route.Vertex,
-	pathEdges []*channeldb.ChannelEdgePolicy, currentHeight uint32,
+	pathEdges []*pathEdge, currentHeight uint32,
 	finalCLTVDelta uint16,
 	finalDestRecords []tlv.Record) (*route.Route, error) {
 
@@ -120,8 +131,12 @@ func newRoute(amtToSend lnwire.MilliSatoshi, sourceVertex route.Vertex,
 			// based on the amount that this hop needs to forward
 			// and its policy for the outgoing channel. This policy
 			// is stored as part of the incoming channel of
-			// the next hop.
-			fee = pathEdges[i+1].ComputeFee(amtToForward)
+			// the next hop. On top of that, the hop may charge an
+			// inbound fee for the channel the htlc arrives on.
+			fee = edge.inboundFee.TotalFee(
+				pathEdges[i+1].ComputeFee(amtToForward),
+				amtToForward,
+			)
 		}
 
 		// If this is the last hop, then for verification purposes, the
@@ -295,7 +310,7 @@ type PathFindingConfig struct {
 // to forward at every node against the available bandwidth.
 func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	source, target route.Vertex, amt lnwire.MilliSatoshi) (
-	[]*channeldb.ChannelEdgePolicy, error) {
+	[]*pathEdge, error) {
 
 	// Pathfinding can be a significant portion of the total payment
 	// latency, especially on low-powered devices. Log several metrics to
@@ -408,12 +423,14 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// We'll use this map as a series of "next" hop pointers. So to get
 	// from `Vertex` to the target node, we'll take the edge that it's
 	// mapped to within `next`.
-	next := make(map[route.Vertex]*channeldb.ChannelEdgePolicy)
+	next := make(map[route.Vertex]*pathEdge)
 
 	// processEdge is a helper closure that will be used to make sure edges
-	// satisfy our specific requirements.
+	// satisfy our specific requirements. The inbound fee is the fee that
+	// toNode charges for htlcs that arrive over the edge.
 	processEdge := func(fromVertex route.Vertex, bandwidth lnwire.MilliSatoshi,
-		edge *channeldb.ChannelEdgePolicy, toNode route.Vertex) {
+		edge *channeldb.ChannelEdgePolicy, toNode route.Vertex,
+		inboundFee lnwire.Fee) {
 
 		edgesExpanded++
 
@@ -439,9 +456,19 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		}
 
 		// Calculate amount that the candidate node would have to sent
-		// out.
+		// out. This includes the inbound fee that toNode charges for
+		// htlcs arriving over this edge. The target node doesn't charge
+		// any fees.
 		toNodeDist := distance[toNode]
 		amountToSend := toNodeDist.amountToReceive
+		if toNode != target {
+			amtToForward := toNodeDist.amountToReceive -
+				toNodeDist.outgoingFee
+
+			amountToSend = amtToForward + inboundFee.TotalFee(
+				toNodeDist.outgoingFee, amtToForward,
+			)
+		}
 
 		// Request the success probability for this edge.
 		edgeProbability := r.ProbabilitySource(
@@ -504,7 +531,8 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// the distance map needs to receive from a (to be found)
 		// previous node in the route. That previous node will need to
 		// pay the amount that this node forwards plus the fee it
-		// charges.
+		// charges, excluding the inbound fee that depends on the
+		// channel over which the node will be reached.
 		amountToReceive := amountToSend + fee
 
 		// Check if accumulated fees would exceed fee limit when this
@@ -529,8 +557,17 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// By adding fromVertex in the route, there will be an extra
 		// weight composed of the fee that this node will charge and
 		// the amount that will be locked for timeLockDelta blocks in
-		// the HTLC that is handed out to fromVertex.
-		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
+		// the HTLC that is handed out to fromVertex. The fee includes
+		// the inbound fee of toNode, which may be a discount. Because
+		// path finding doesn't support negative edge weights, the
+		// combined fee is never taken to be negative.
+		signedFee := int64(fee) + int64(amountToSend) -
+			int64(toNodeDist.amountToReceive)
+		var weightFee lnwire.MilliSatoshi
+		if signedFee > 0 {
+			weightFee = lnwire.MilliSatoshi(signedFee)
+		}
+		weight := edgeWeight(amountToReceive, weightFee, timeLockDelta)
 
 		// Compute the tentative weight to this new channel/edge
 		// which is the weight from our toNode to the target node
@@ -568,11 +605,15 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			weight:          tempWeight,
 			node:            fromVertex,
 			amountToReceive: amountToReceive,
+			outgoingFee:     fee,
 			incomingCltv:    incomingCltv,
 			probability:     probability,
 		}
 
-		next[fromVertex] = edge
+		next[fromVertex] = &pathEdge{
+			ChannelEdgePolicy: edge,
+			inboundFee:        inboundFee,
+		}
 
 		// Either push distance[fromVertex] onto the heap if the node
 		// represented by fromVertex is not already on the heap OR adjust
@@ -602,8 +643,8 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			break
 		}
 
-		cb := func(_ *bbolt.Tx, edgeInfo *channeldb.ChannelEdgeInfo, _,
-			inEdge *channeldb.ChannelEdgePolicy) error {
+		cb := func(_ *bbolt.Tx, edgeInfo *channeldb.ChannelEdgeInfo,
+			outEdge, inEdge *channeldb.ChannelEdgePolicy) error {
 
 			// If there is no edge policy for this candidate
 			// node, skip. Note that we are searching backwards
@@ -640,9 +681,27 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 				return err
 			}
 
+			// The inbound fee the pivot charges for htlcs arriving
+			// over this channel is part of its own policy. If it
+			// can't be parsed, we assume there is none.
+			var inboundFee lnwire.Fee
+			if outEdge != nil {
+				inboundFee, err = outEdge.InboundFee()
+				if err != nil {
+					log.Debugf("Invalid inbound fee for "+
+						"channel %v: %v",
+						outEdge.ChannelID, err)
+
+					inboundFee = lnwire.Fee{}
+				}
+			}
+
 			// Check if this candidate node is better than what we
 			// already have.
-			processEdge(route.Vertex(chanSource), edgeBandwidth, inEdge, pivot)
+			processEdge(
+				route.Vertex(chanSource), edgeBandwidth, inEdge,
+				pivot, inboundFee,
+			)
 			return nil
 		}
 
@@ -662,7 +721,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		bandWidth := partialPath.amountToReceive
 		for _, reverseEdge := range additionalEdgesWithSrc[pivot] {
 			processEdge(reverseEdge.sourceNode, bandWidth,
-				reverseEdge.edge, pivot)
+				reverseEdge.edge, pivot, lnwire.Fee{})
 		}
 	}
 
@@ -675,7 +734,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 	// Use the nextHop map to unravel the forward path from source to
 	// target.
-	pathEdges := make([]*channeldb.ChannelEdgePolicy, 0, len(next))
+	pathEdges := make([]*pathEdge, 0, len(next))
 	currentNode := source
 	for currentNode != target { // TODO(roasbeef): assumes no cycles
 		// Determine the next hop forward using the next map.
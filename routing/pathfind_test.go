@@ -318,6 +318,7 @@ type testChannelPolicy struct {
 	LastUpdate  time.Time
 	Disabled    bool
 	Direction   bool
+	InboundFee  lnwire.Fee
 }
 
 type testChannelEnd struct {
@@ -534,6 +535,14 @@ func createTestGraphFromChannels(testChannels []*testChannel, source string) (
 				FeeBaseMSat:               node1.FeeBaseMsat,
 				FeeProportionalMillionths: node1.FeeRate,
 			}
+			if node1.InboundFee != (lnwire.Fee{}) {
+				edgePolicy.ExtraOpaqueData, err = lnwire.SetInboundFee(
+					nil, &node1.InboundFee,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
 			if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
 				return nil, err
 			}
@@ -563,6 +572,14 @@ func createTestGraphFromChannels(testChannels []*testChannel, source string) (
 				FeeBaseMSat:               node2.FeeBaseMsat,
 				FeeProportionalMillionths: node2.FeeRate,
 			}
+			if node2.InboundFee != (lnwire.Fee{}) {
+				edgePolicy.ExtraOpaqueData, err = lnwire.SetInboundFee(
+					nil, &node2.InboundFee,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
 			if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
 				return nil, err
 			}
@@ -998,27 +1015,41 @@ func TestNewRoute(t *testing.T) {
 	createHop := func(baseFee lnwire.MilliSatoshi,
 		feeRate lnwire.MilliSatoshi,
 		bandwidth lnwire.MilliSatoshi,
-		timeLockDelta uint16) *channeldb.ChannelEdgePolicy {
-
-		return &channeldb.ChannelEdgePolicy{
-			Node: &channeldb.LightningNode{
-				Features: lnwire.NewFeatureVector(
-					nil, nil,
-				),
+		timeLockDelta uint16) *pathEdge {
+
+		return &pathEdge{
+			ChannelEdgePolicy: &channeldb.ChannelEdgePolicy{
+				Node: &channeldb.LightningNode{
+					Features: lnwire.NewFeatureVector(
+						nil, nil,
+					),
+				},
+				FeeProportionalMillionths: feeRate,
+				FeeBaseMSat:               baseFee,
+				TimeLockDelta:             timeLockDelta,
 			},
-			FeeProportionalMillionths: feeRate,
-			FeeBaseMSat:               baseFee,
-			TimeLockDelta:             timeLockDelta,
 		}
 	}
 
+	// withInboundFee sets the inbound fee charged by the node the hop
+	// leads to.
+	withInboundFee := func(hop *pathEdge, baseFee,
+		feeRate int32) *pathEdge {
+
+		hop.inboundFee = lnwire.Fee{
+			BaseFee: baseFee,
+			FeeRate: feeRate,
+		}
+		return hop
+	}
+
 	testCases := []struct {
 		// name identifies the test case in the test output.
 		name string
 
 		// hops is the list of hops (the route) that gets passed into
 		// the call to newRoute.
-		hops []*channeldb.ChannelEdgePolicy
+		hops []*pathEdge
 
 		// paymentAmount is the amount that is send into the route
 		// indicated by hops.
@@ -1057,7 +1088,7 @@ func TestNewRoute(t *testing.T) {
 			// For a single hop payment, no fees are expected to be paid.
 			name:          "single hop",
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*pathEdge{
 				createHop(100, 1000, 1000000, 10),
 			},
 			expectedFees:          []lnwire.MilliSatoshi{0},
@@ -1070,7 +1101,7 @@ func TestNewRoute(t *testing.T) {
 			// a fee to receive the payment.
 			name:          "two hop",
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*pathEdge{
 				createHop(0, 1000, 1000000, 10),
 				createHop(30, 1000, 1000000, 5),
 			},
@@ -1086,7 +1117,7 @@ func TestNewRoute(t *testing.T) {
 			// gets rounded down to 1.
 			name:          "three hop",
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*pathEdge{
 				createHop(0, 10, 1000000, 10),
 				createHop(0, 10, 1000000, 5),
 				createHop(0, 10, 1000000, 3),
@@ -1101,7 +1132,7 @@ func TestNewRoute(t *testing.T) {
 			// because of the increase amount to forward.
 			name:          "three hop with fee carry over",
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*pathEdge{
 				createHop(0, 10000, 1000000, 10),
 				createHop(0, 10000, 1000000, 5),
 				createHop(0, 10000, 1000000, 3),
@@ -1116,7 +1147,7 @@ func TestNewRoute(t *testing.T) {
 			// effect.
 			name:          "three hop with minimal fees for carry over",
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*pathEdge{
 				createHop(0, 10000, 1000000, 10),
 
 				// First hop charges 0.1% so the second hop fee
@@ -1131,6 +1162,43 @@ func TestNewRoute(t *testing.T) {
 			expectedTotalAmount:   101101,
 			expectedTimeLocks:     []uint32{4, 1, 1},
 			expectedTotalTimeLock: 9,
+		}, {
+			// A three hop payment where the first hop grants an
+			// inbound discount and the second hop charges an
+			// inbound fee on top of its regular fee.
+			name:          "three hop with inbound fees",
+			paymentAmount: 100000,
+			hops: []*pathEdge{
+				withInboundFee(
+					createHop(0, 0, 1000000, 10), -500, 0,
+				),
+				withInboundFee(
+					createHop(1000, 0, 1000000, 5), 200, 0,
+				),
+				createHop(1000, 0, 1000000, 3),
+			},
+			expectedFees:          []lnwire.MilliSatoshi{500, 1200, 0},
+			expectedTotalAmount:   101700,
+			expectedTimeLocks:     []uint32{4, 1, 1},
+			expectedTotalTimeLock: 9,
+		}, {
+			// An inbound discount that exceeds the regular fee of a
+			// hop can't result in a negative fee.
+			name:          "three hop with inbound discount exceeding fee",
+			paymentAmount: 100000,
+			hops: []*pathEdge{
+				withInboundFee(
+					createHop(0, 0, 1000000, 10), -2000, 0,
+				),
+				withInboundFee(
+					createHop(1000, 0, 1000000, 5), 200, 0,
+				),
+				createHop(1000, 0, 1000000, 3),
+			},
+			expectedFees:          []lnwire.MilliSatoshi{0, 1200, 0},
+			expectedTotalAmount:   101200,
+			expectedTimeLocks:     []uint32{4, 1, 1},
+			expectedTotalTimeLock: 9,
 		}}
 
 	for _, testCase := range testCases {
@@ -1824,7 +1892,7 @@ func TestPathFindSpecExample(t *testing.T) {
 }
 
 func assertExpectedPath(t *testing.T, aliasMap map[string]route.Vertex,
-	path []*channeldb.ChannelEdgePolicy, nodeAliases ...string) {
+	path []*pathEdge, nodeAliases ...string) {
 
 	if len(path) != len(nodeAliases) {
 		t.Fatal("number of hops and number of aliases do not match")
@@ -2044,6 +2112,142 @@ func testCltvLimit(t *testing.T, limit uint32, expectedChannel uint64) {
 	}
 }
 
+// TestInboundFees asserts that path finding takes into account the inbound
+// fees that nodes charge for the channels htlcs arrive on.
+func TestInboundFees(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		inboundFee    lnwire.Fee
+		expectedChan  uint64
+		expectedTotal lnwire.MilliSatoshi
+	}{
+		{
+			// Without an inbound fee, the path through a is the
+			// cheapest.
+			name:          "no inbound fee",
+			expectedChan:  1,
+			expectedTotal: 110000,
+		},
+		{
+			// A discount by b makes the path through c and b
+			// cheaper.
+			name:          "inbound discount",
+			inboundFee:    lnwire.Fee{BaseFee: -5000},
+			expectedChan:  2,
+			expectedTotal: 106000,
+		},
+		{
+			// The discount can at most cancel out the fee of b.
+			name:          "inbound discount exceeding fee",
+			inboundFee:    lnwire.Fee{BaseFee: -8000},
+			expectedChan:  2,
+			expectedTotal: 105000,
+		},
+		{
+			// An inbound surcharge by b makes the path through c
+			// and b more expensive.
+			name:          "inbound surcharge",
+			inboundFee:    lnwire.Fee{BaseFee: 5000},
+			expectedChan:  1,
+			expectedTotal: 110000,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			testInboundFees(
+				t, testCase.inboundFee, testCase.expectedChan,
+				testCase.expectedTotal,
+			)
+		})
+	}
+}
+
+func testInboundFees(t *testing.T, inboundFee lnwire.Fee,
+	expectedChannel uint64, expectedTotal lnwire.MilliSatoshi) {
+
+	t.Parallel()
+
+	// Set up a test graph with two possible paths to the target. The path
+	// through a charges 10000 msat. The path through c and b charges
+	// 11000 msat, minus the inbound fee b charges for the channel with c.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  10,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:      10,
+			FeeBaseMsat: 10000,
+			MinHTLC:     1,
+		}),
+		symmetricTestChannel("roasbeef", "c", 100000, &testChannelPolicy{
+			Expiry:  10,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("c", "b", 100000, &testChannelPolicy{
+			Expiry:      5,
+			FeeBaseMsat: 5000,
+			MinHTLC:     1,
+			InboundFee:  inboundFee,
+		}),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:      5,
+			FeeBaseMsat: 6000,
+			MinHTLC:     1,
+		}),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(
+		testChannels, "roasbeef",
+	)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := testGraphInstance.aliasMap["target"]
+
+	path, err := findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		noRestrictions, testPathFindingConfig,
+		sourceVertex, target, paymentAmt,
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+
+	route, err := newRoute(
+		paymentAmt, sourceVertex, path, 100, 1, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to create route: %v", err)
+	}
+
+	if route.Hops[0].ChannelID != expectedChannel {
+		t.Fatalf("expected route to pass through channel %v, "+
+			"but channel %v was selected instead", expectedChannel,
+			route.Hops[0].ChannelID)
+	}
+	if route.TotalAmount != expectedTotal {
+		t.Fatalf("expected total amount %v, got %v", expectedTotal,
+			route.TotalAmount)
+	}
+}
+
 // TestProbabilityRouting asserts that path finding not only takes into account
 // fees but also success probability.
 func TestProbabilityRouting(t *testing.T) {
@@ -15,8 +15,7 @@ func TestRequestRoute(t *testing.T) {
 
 	findPath := func(g *graphParams, r *RestrictParams,
 		cfg *PathFindingConfig, source, target route.Vertex,
-		amt lnwire.MilliSatoshi) ([]*channeldb.ChannelEdgePolicy,
-		error) {
+		amt lnwire.MilliSatoshi) ([]*pathEdge, error) {
 
 		// We expect find path to receive a cltv limit excluding the
 		// final cltv delta (including the block padding).
@@ -24,12 +23,14 @@ func TestRequestRoute(t *testing.T) {
 			t.Fatal("wrong cltv limit")
 		}
 
-		path := []*channeldb.ChannelEdgePolicy{
+		path := []*pathEdge{
 			{
-				Node: &channeldb.LightningNode{
-					Features: lnwire.NewFeatureVector(
-						nil, nil,
-					),
+				ChannelEdgePolicy: &channeldb.ChannelEdgePolicy{
+					Node: &channeldb.LightningNode{
+						Features: lnwire.NewFeatureVector(
+							nil, nil,
+						),
+					},
 				},
 			},
 		}
@@ -224,6 +224,10 @@ type ChannelPolicy struct {
 	// MaxHTLC is the maximum HTLC size including fees we are allowed to
 	// forward over this channel.
 	MaxHTLC lnwire.MilliSatoshi
+
+	// InboundFee is the fee charged for HTLCs that arrive over this
+	// channel. If nil, the current inbound fee is kept unchanged.
+	InboundFee *lnwire.Fee
 }
 
 // Config defines the configuration for the ChannelRouter. ALL elements within
@@ -1351,12 +1355,17 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 
 // fetchFundingTx returns the funding transaction identified by the passed
 // short channel ID.
-//
-// TODO(roasbeef): replace with call to GetBlockTransaction? (would allow to
-// later use getblocktxn)
 func (r *ChannelRouter) fetchFundingTx(
 	chanID *lnwire.ShortChannelID) (*wire.MsgTx, error) {
 
+	// If the backend is able to look up the transaction directly, we'll
+	// avoid fetching the whole block.
+	if fetcher, ok := r.cfg.Chain.(lnwallet.BlockTxFetcher); ok {
+		return fetcher.GetBlockTransaction(
+			chanID.BlockHeight, chanID.TxIndex,
+		)
+	}
+
 	// First fetch the block hash by the block number encoded, then use
 	// that hash to fetch the block itself.
 	blockNum := int64(chanID.BlockHeight)
@@ -2376,7 +2385,7 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 
 	// Allocate a list that will contain the selected channels for this
 	// route.
-	edges := make([]*channeldb.ChannelEdgePolicy, len(hops))
+	edges := make([]*pathEdge, len(hops))
 
 	// Keep a running amount and the maximum for this route.
 	amts := runningAmounts{
@@ -2411,14 +2420,14 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 		// Iterate over candidate channels to select the channel
 		// to use for the final route.
 		var (
-			bestEdge      *channeldb.ChannelEdgePolicy
+			bestEdge      *pathEdge
 			bestAmts      *runningAmounts
 			bestBandwidth lnwire.MilliSatoshi
 		)
 
 		cb := func(tx *bbolt.Tx,
 			edgeInfo *channeldb.ChannelEdgeInfo,
-			_, inEdge *channeldb.ChannelEdgePolicy) error {
+			outEdge, inEdge *channeldb.ChannelEdgePolicy) error {
 
 			chanID := edgeInfo.ChannelID
 
@@ -2492,9 +2501,25 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 				}
 			}
 
+			// The inbound fee that toNode charges for this channel
+			// is part of its own policy.
+			var inboundFee lnwire.Fee
+			if outEdge != nil {
+				inboundFee, err = outEdge.InboundFee()
+				if err != nil {
+					log.Debugf("Invalid inbound fee for "+
+						"channel %v: %v", chanID, err)
+
+					inboundFee = lnwire.Fee{}
+				}
+			}
+
 			// If we get here, the current edge is better. Replace
 			// the best.
-			bestEdge = inEdge
+			bestEdge = &pathEdge{
+				ChannelEdgePolicy: inEdge,
+				inboundFee:        inboundFee,
+			}
 			bestAmts = &newAmts
 			bestBandwidth = bandwidth
 
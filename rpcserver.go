@@ -39,10 +39,12 @@ import (
 	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
@@ -52,6 +54,7 @@ import (
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/monitoring"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/feepolicy"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -69,6 +72,14 @@ const (
 	// permitted.
 	maxLtcPaymentMSat = lnwire.MilliSatoshi(math.MaxUint32) *
 		btcToLtcConversionRate
+
+	// defaultDrainTimeout is the default duration DrainAndStop waits for
+	// in-flight HTLCs to be resolved before shutting down.
+	defaultDrainTimeout = 10 * time.Minute
+
+	// drainPollInterval is the interval at which DrainAndStop checks for
+	// in-flight HTLCs.
+	drainPollInterval = time.Second
 )
 
 var (
@@ -273,6 +284,10 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/PrunableChannels": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -337,6 +352,10 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "info",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/DrainAndStop": {{
+			Entity: "info",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/SubscribeChannelGraph": {{
 			Entity: "info",
 			Action: "read",
@@ -365,6 +384,18 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SetFeeStrategy": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/PreviewFeeUpdates": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/UpdateDeadlinePolicy": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ForwardingHistory": {{
 			Entity: "offchain",
 			Action: "read",
@@ -389,6 +420,10 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SubscribeStuckHtlcEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ChannelAcceptor": {{
 			Entity: "onchain",
 			Action: "write",
@@ -519,8 +554,9 @@ func newRPCServer(s *server, macService *macaroons.Service,
 	err = subServerCgs.PopulateDependencies(
 		s.cc, networkDir, macService, atpl, invoiceRegistry,
 		s.htlcSwitch, activeNetParams.Params, s.chanRouter,
-		routerBackend, s.nodeSigner, s.chanDB, s.sweeper, tower,
-		s.towerClient, cfg.net.ResolveTCPAddr,
+		routerBackend, s.chanStatusMgr, s.nodeSigner, s.chanDB,
+		s.sweeper, s.consolidator, tower, s.towerClient,
+		cfg.net.ResolveTCPAddr,
 	)
 	if err != nil {
 		return nil, err
@@ -774,23 +810,92 @@ func addrPairsToOutputs(addrPairs map[string]int64) ([]*wire.TxOut, error) {
 	return outputs, nil
 }
 
+// unmarshallOutPoints converts the outpoints of a send request to their
+// canonical type.
+func unmarshallOutPoints(rpcOutPoints []*lnrpc.OutPoint) ([]wire.OutPoint,
+	error) {
+
+	outpoints := make([]wire.OutPoint, 0, len(rpcOutPoints))
+	for _, rpcOutPoint := range rpcOutPoints {
+		op, err := lnrpc.UnmarshallOutPoint(rpcOutPoint)
+		if err != nil {
+			return nil, err
+		}
+		outpoints = append(outpoints, *op)
+	}
+
+	return outpoints, nil
+}
+
 // sendCoinsOnChain makes an on-chain transaction in or to send coins to one or
 // more addresses specified in the passed payment map. The payment map maps an
-// address to a specified output value to be sent to that address.
+// address to a specified output value to be sent to that address. If a set of
+// outpoints is given, the transaction will spend exactly those outputs instead
+// of performing coin selection. The transaction is stored with the given
+// label.
 func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
-	feeRate lnwallet.SatPerKWeight) (*chainhash.Hash, error) {
+	feeRate lnwallet.SatPerKWeight, outpoints []wire.OutPoint,
+	label string) (*chainhash.Hash, error) {
 
 	outputs, err := addrPairsToOutputs(paymentMap)
 	if err != nil {
 		return nil, err
 	}
 
-	tx, err := r.server.cc.wallet.SendOutputs(outputs, feeRate)
+	wallet := r.server.cc.wallet
+
+	// If the caller didn't select any outputs, we'll use the wallet's coin
+	// selection synchronization method to ensure that no coin selection
+	// (funding, sweep alls, other sends) can proceed while we instruct the
+	// wallet to send this transaction.
+	if len(outpoints) == 0 {
+		var tx *wire.MsgTx
+		err := wallet.WithCoinSelectLock(func() error {
+			var err error
+			tx, err = wallet.SendOutputs(outputs, feeRate, label)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		txHash := tx.TxHash()
+		return &txHash, nil
+	}
+
+	// Otherwise, we'll craft a transaction that spends exactly the
+	// selected outputs, sending any remaining value back to a fresh change
+	// address. The address is only created once the selected outputs are
+	// known to cover the payment.
+	changeAddr := func() (btcutil.Address, error) {
+		return wallet.NewAddress(
+			lnwallet.WitnessPubKey, true,
+			lnwallet.DefaultAccountName,
+		)
+	}
+
+	_, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	spendPkg, err := sweep.CraftSpendTx(
+		feeRate, uint32(bestHeight), outputs, changeAddr, outpoints,
+		wallet, wallet, wallet.WalletController, r.server.cc.signer,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	txHash := tx.TxHash()
+	err = wallet.PublishTransaction(spendPkg.SweepTx, label)
+	if err != nil {
+		spendPkg.CancelSweepAttempt()
+
+		return nil, fmt.Errorf("unable to broadcast transaction: %v",
+			err)
+	}
+
+	txHash := spendPkg.SweepTx.TxHash()
 	return &txHash, nil
 }
 
@@ -822,7 +927,9 @@ func (r *rpcServer) ListUnspent(ctx context.Context,
 
 	// With our arguments validated, we'll query the internal wallet for
 	// the set of UTXOs that match our query.
-	utxos, err := r.server.cc.wallet.ListUnspentWitness(minConfs, maxConfs)
+	utxos, err := r.server.cc.wallet.ListUnspentWitness(
+		minConfs, maxConfs, in.Account,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1001,6 +1108,16 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		return nil, fmt.Errorf("cannot send coins to pubkeys")
 	}
 
+	outpoints, err := unmarshallOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	label, err := labels.ValidateAPI(in.Label)
+	if err != nil {
+		return nil, err
+	}
+
 	var txid *chainhash.Hash
 
 	wallet := r.server.cc.wallet
@@ -1023,13 +1140,15 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		}
 
 		// With the sweeper instance created, we can now generate a
-		// transaction that will sweep ALL outputs from the wallet in a
-		// single transaction. This will be generated in a concurrent
-		// safe manner, so no need to worry about locking.
+		// transaction that will sweep ALL outputs from the wallet, or
+		// only the selected ones, in a single transaction. This will
+		// be generated in a concurrent safe manner, so no need to
+		// worry about locking.
 		sweepTxPkg, err := sweep.CraftSweepAllTx(
-			feePerKw, uint32(bestHeight), targetAddr, wallet,
-			wallet.WalletController, wallet.WalletController,
-			r.server.cc.feeEstimator, r.server.cc.signer,
+			feePerKw, uint32(bestHeight), targetAddr, outpoints,
+			wallet, wallet, wallet.WalletController,
+			r.server.cc.feeEstimator,
+			r.server.cc.signer,
 		)
 		if err != nil {
 			return nil, err
@@ -1041,7 +1160,7 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		// As our sweep transaction was created, successfully, we'll
 		// now attempt to publish it, cancelling the sweep pkg to
 		// return all outputs if it fails.
-		err = wallet.PublishTransaction(sweepTxPkg.SweepTx)
+		err = wallet.PublishTransaction(sweepTxPkg.SweepTx, label)
 		if err != nil {
 			sweepTxPkg.CancelSweepAttempt()
 
@@ -1053,21 +1172,12 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		txid = &sweepTXID
 	} else {
 
-		// We'll now construct out payment map, and use the wallet's
-		// coin selection synchronization method to ensure that no coin
-		// selection (funding, sweep alls, other sends) can proceed
-		// while we instruct the wallet to send this transaction.
+		// We'll now construct out payment map, and instruct the wallet
+		// to send this transaction.
 		paymentMap := map[string]int64{targetAddr.String(): in.Amount}
-		err := wallet.WithCoinSelectLock(func() error {
-			newTXID, err := r.sendCoinsOnChain(paymentMap, feePerKw)
-			if err != nil {
-				return err
-			}
-
-			txid = newTXID
-
-			return nil
-		})
+		txid, err = r.sendCoinsOnChain(
+			paymentMap, feePerKw, outpoints, label,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -1099,24 +1209,22 @@ func (r *rpcServer) SendMany(ctx context.Context,
 	rpcsLog.Infof("[sendmany] outputs=%v, sat/kw=%v",
 		spew.Sdump(in.AddrToAmount), int64(feePerKw))
 
-	var txid *chainhash.Hash
-
-	// We'll attempt to send to the target set of outputs, ensuring that we
-	// synchronize with any other ongoing coin selection attempts which
-	// happen to also be concurrently executing.
-	wallet := r.server.cc.wallet
-	err = wallet.WithCoinSelectLock(func() error {
-		sendManyTXID, err := r.sendCoinsOnChain(
-			in.AddrToAmount, feePerKw,
-		)
-		if err != nil {
-			return err
-		}
+	outpoints, err := unmarshallOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
 
-		txid = sendManyTXID
+	label, err := labels.ValidateAPI(in.Label)
+	if err != nil {
+		return nil, err
+	}
 
-		return nil
-	})
+	// We'll attempt to send to the target set of outputs, which ensures
+	// that we synchronize with any other ongoing coin selection attempts
+	// which happen to also be concurrently executing.
+	txid, err := r.sendCoinsOnChain(
+		in.AddrToAmount, feePerKw, outpoints, label,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1130,6 +1238,13 @@ func (r *rpcServer) SendMany(ctx context.Context,
 func (r *rpcServer) NewAddress(ctx context.Context,
 	in *lnrpc.NewAddressRequest) (*lnrpc.NewAddressResponse, error) {
 
+	// Addresses are generated for the default account unless the caller
+	// asked for a specific one.
+	account := lnwallet.DefaultAccountName
+	if in.Account != "" {
+		account = in.Account
+	}
+
 	// Translate the gRPC proto address type to the wallet controller's
 	// available address types.
 	var (
@@ -1139,7 +1254,7 @@ func (r *rpcServer) NewAddress(ctx context.Context,
 	switch in.Type {
 	case lnrpc.AddressType_WITNESS_PUBKEY_HASH:
 		addr, err = r.server.cc.wallet.NewAddress(
-			lnwallet.WitnessPubKey, false,
+			lnwallet.WitnessPubKey, false, account,
 		)
 		if err != nil {
 			return nil, err
@@ -1147,7 +1262,7 @@ func (r *rpcServer) NewAddress(ctx context.Context,
 
 	case lnrpc.AddressType_NESTED_PUBKEY_HASH:
 		addr, err = r.server.cc.wallet.NewAddress(
-			lnwallet.NestedWitnessPubKey, false,
+			lnwallet.NestedWitnessPubKey, false, account,
 		)
 		if err != nil {
 			return nil, err
@@ -1155,7 +1270,7 @@ func (r *rpcServer) NewAddress(ctx context.Context,
 
 	case lnrpc.AddressType_UNUSED_WITNESS_PUBKEY_HASH:
 		addr, err = r.server.cc.wallet.LastUnusedAddress(
-			lnwallet.WitnessPubKey,
+			lnwallet.WitnessPubKey, account,
 		)
 		if err != nil {
 			return nil, err
@@ -1163,14 +1278,15 @@ func (r *rpcServer) NewAddress(ctx context.Context,
 
 	case lnrpc.AddressType_UNUSED_NESTED_PUBKEY_HASH:
 		addr, err = r.server.cc.wallet.LastUnusedAddress(
-			lnwallet.NestedWitnessPubKey,
+			lnwallet.NestedWitnessPubKey, account,
 		)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	rpcsLog.Debugf("[newaddress] type=%v addr=%v", in.Type, addr.String())
+	rpcsLog.Debugf("[newaddress] account=%v type=%v addr=%v", account,
+		in.Type, addr.String())
 	return &lnrpc.NewAddressResponse{Address: addr.String()}, nil
 }
 
@@ -2165,7 +2281,7 @@ func (r *rpcServer) WalletBalance(ctx context.Context,
 	in *lnrpc.WalletBalanceRequest) (*lnrpc.WalletBalanceResponse, error) {
 
 	// Get total balance, from txs that have >= 0 confirmations.
-	totalBal, err := r.server.cc.wallet.ConfirmedBalance(0)
+	totalBal, err := r.server.cc.wallet.ConfirmedBalance(0, in.Account)
 	if err != nil {
 		return nil, err
 	}
@@ -2173,7 +2289,7 @@ func (r *rpcServer) WalletBalance(ctx context.Context,
 	// Get confirmed balance, from txs that have >= 1 confirmations.
 	// TODO(halseth): get both unconfirmed and confirmed balance in one
 	// call, as this is racy.
-	confirmedBal, err := r.server.cc.wallet.ConfirmedBalance(1)
+	confirmedBal, err := r.server.cc.wallet.ConfirmedBalance(1, in.Account)
 	if err != nil {
 		return nil, err
 	}
@@ -2324,7 +2440,7 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			resp.TotalLimboBalance += channel.LocalBalance
 
 		// If the channel was force closed, then we'll need to query
-		// the utxoNursery for additional information.
+		// the contract resolvers for additional information.
 		// TODO(halseth): distinguish remote and local case?
 		case channeldb.LocalForceClose, channeldb.RemoteForceClose:
 			forceClose := &lnrpc.PendingChannelsResponse_ForceClosedChannel{
@@ -2332,19 +2448,7 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				ClosingTxid: closeTXID,
 			}
 
-			// Fetch reports from both nursery and resolvers. At the
-			// moment this is not an atomic snapshot. This is
-			// planned to be resolved when the nursery is removed
-			// and channel arbitrator will be the single source for
-			// these kind of reports.
-			err := r.nurseryPopulateForceCloseResp(
-				&chanPoint, currentHeight, forceClose,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			err = r.arbitratorPopulateForceCloseResp(
+			err := r.arbitratorPopulateForceCloseResp(
 				&chanPoint, currentHeight, forceClose,
 			)
 			if err != nil {
@@ -2357,6 +2461,21 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				resp.PendingForceClosingChannels,
 				forceClose,
 			)
+
+		// If the channel was breached, then we'll query the breach
+		// arbiter for the progress of sweeping the revoked outputs.
+		case channeldb.BreachClose:
+			breached := &lnrpc.PendingChannelsResponse_BreachedChannel{
+				Channel:    channel,
+				BreachTxid: closeTXID,
+			}
+			r.breachArbiterPopulateResp(&chanPoint, breached)
+
+			resp.TotalLimboBalance += breached.LimboBalance
+
+			resp.PendingBreachedChannels = append(
+				resp.PendingBreachedChannels, breached,
+			)
 		}
 	}
 
@@ -2412,9 +2531,35 @@ func (r *rpcServer) arbitratorPopulateForceCloseResp(chanPoint *wire.OutPoint,
 	}
 	reports := arbitrator.Report()
 
+	// The balance recovered by resolvers that have been resolved is taken
+	// from their persisted reports, as only the unresolved ones are
+	// reported.
+	recovered, err := arbitrator.RecoveredBalance()
+	if err != nil {
+		return err
+	}
+	forceClose.RecoveredBalance = int64(recovered)
+
 	for _, report := range reports {
+		forceClose.LimboBalance += int64(report.LimboBalance)
+
+		// The commitment output paying to us is reported on the
+		// channel itself, along with the height at which it can be
+		// swept once the commitment transaction has confirmed.
+		if report.Type == contractcourt.ReportOutputUnencumbered {
+			forceClose.MaturityHeight = report.MaturityHeight
+			if forceClose.MaturityHeight != 0 {
+				forceClose.BlocksTilMaturity =
+					int32(forceClose.MaturityHeight) -
+						currentHeight
+			}
+
+			continue
+		}
+
+		incoming := report.Type == contractcourt.ReportOutputIncomingHtlc
 		htlc := &lnrpc.PendingHTLC{
-			Incoming:       report.Incoming,
+			Incoming:       incoming,
 			Amount:         int64(report.Amount),
 			Outpoint:       report.Outpoint.String(),
 			MaturityHeight: report.MaturityHeight,
@@ -2426,72 +2571,155 @@ func (r *rpcServer) arbitratorPopulateForceCloseResp(chanPoint *wire.OutPoint,
 				int32(htlc.MaturityHeight) - currentHeight
 		}
 
-		forceClose.LimboBalance += int64(report.LimboBalance)
-		forceClose.RecoveredBalance += int64(report.RecoveredBalance)
-
 		forceClose.PendingHtlcs = append(forceClose.PendingHtlcs, htlc)
 	}
 
+	resolutions, err := r.rpcResolutions(*chanPoint)
+	if err != nil {
+		return err
+	}
+	forceClose.Resolutions = resolutions
+
 	return nil
 }
 
-// nurseryPopulateForceCloseResp populates the pending channels response
-// message with contract resolution information from utxonursery.
-func (r *rpcServer) nurseryPopulateForceCloseResp(chanPoint *wire.OutPoint,
-	currentHeight int32,
-	forceClose *lnrpc.PendingChannelsResponse_ForceClosedChannel) error {
+// breachArbiterPopulateResp populates the pending channels response message
+// with the progress of sweeping the revoked outputs of a breached channel.
+func (r *rpcServer) breachArbiterPopulateResp(chanPoint *wire.OutPoint,
+	breached *lnrpc.PendingChannelsResponse_BreachedChannel) {
 
-	// Query for the maturity state for this force closed channel. If we
-	// didn't have any time-locked outputs, then the nursery may not know of
-	// the contract.
-	nurseryInfo, err := r.server.utxoNursery.NurseryReport(chanPoint)
-	if err == ErrContractNotFound {
-		return nil
+	// The report is only available once the breach arbiter started
+	// handling the breach.
+	report := r.server.breachArbiter.breachReport(chanPoint)
+	if report == nil {
+		return
+	}
+
+	breached.BreachHeight = report.confHeight
+	breached.LimboBalance = int64(report.limboBalance())
+	breached.RecoveredBalance = int64(report.recoveredBalance())
+
+	for _, output := range report.outputs {
+		justice := &lnrpc.PendingChannelsResponse_JusticeOutput{
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   output.outpoint.Hash[:],
+				TxidStr:     output.outpoint.Hash.String(),
+				OutputIndex: output.outpoint.Index,
+			},
+			AmountSat:      uint64(output.amount),
+			DeadlineHeight: output.deadlineHeight,
+		}
+
+		switch output.witnessType {
+		case input.CommitmentNoDelay, input.CommitSpendNoDelayTweakless,
+			input.CommitmentRevoke:
+
+			justice.ResolutionType = lnrpc.ResolutionType_COMMIT
+
+		case input.HtlcAcceptedRevoke:
+			justice.ResolutionType =
+				lnrpc.ResolutionType_INCOMING_HTLC
+
+		case input.HtlcOfferedRevoke:
+			justice.ResolutionType =
+				lnrpc.ResolutionType_OUTGOING_HTLC
+		}
+
+		switch output.state {
+		case justicePending, justiceFailed:
+			justice.State =
+				lnrpc.PendingChannelsResponse_JusticeOutput_PENDING
+
+		case justiceSecondLevel:
+			justice.State =
+				lnrpc.PendingChannelsResponse_JusticeOutput_SECOND_LEVEL
+
+		case justiceSwept:
+			justice.State =
+				lnrpc.PendingChannelsResponse_JusticeOutput_SWEPT
+
+		case justiceLost:
+			justice.State =
+				lnrpc.PendingChannelsResponse_JusticeOutput_LOST
+		}
+
+		if output.spendTxid != (chainhash.Hash{}) {
+			justice.SweepTxid = output.spendTxid.String()
+		}
+
+		breached.JusticeOutputs = append(
+			breached.JusticeOutputs, justice,
+		)
 	}
+}
+
+// rpcResolutions returns the resolutions that have been recorded so far for
+// the outputs of the force closed channel identified by the passed outpoint.
+func (r *rpcServer) rpcResolutions(
+	chanPoint wire.OutPoint) ([]*lnrpc.Resolution, error) {
+
+	reports, err := r.server.chainArb.ResolverReports(chanPoint)
 	if err != nil {
-		return fmt.Errorf("unable to obtain "+
-			"nursery report for ChannelPoint(%v): %v",
-			chanPoint, err)
-	}
-
-	// If the nursery knows of this channel, then we can populate
-	// information detailing exactly how much funds are time locked and also
-	// the height in which we can ultimately sweep the funds into the
-	// wallet.
-	forceClose.LimboBalance = int64(nurseryInfo.limboBalance)
-	forceClose.RecoveredBalance = int64(nurseryInfo.recoveredBalance)
-	forceClose.MaturityHeight = nurseryInfo.maturityHeight
-
-	// If the transaction has been confirmed, then we can compute how many
-	// blocks it has left.
-	if forceClose.MaturityHeight != 0 {
-		forceClose.BlocksTilMaturity =
-			int32(forceClose.MaturityHeight) -
-				currentHeight
-	}
-
-	for _, htlcReport := range nurseryInfo.htlcs {
-		// TODO(conner) set incoming flag appropriately after handling
-		// incoming incubation
-		htlc := &lnrpc.PendingHTLC{
-			Incoming:       false,
-			Amount:         int64(htlcReport.amount),
-			Outpoint:       htlcReport.outpoint.String(),
-			MaturityHeight: htlcReport.maturityHeight,
-			Stage:          htlcReport.stage,
+		return nil, err
+	}
+
+	resolutions := make([]*lnrpc.Resolution, 0, len(reports))
+	for _, report := range reports {
+		resolution := &lnrpc.Resolution{
+			AmountSat: uint64(report.Amount),
 		}
 
-		if htlc.MaturityHeight != 0 {
-			htlc.BlocksTilMaturity =
-				int32(htlc.MaturityHeight) -
-					currentHeight
+		// Written off HTLCs never made it to chain, so they're
+		// identified by their payment hash and index instead.
+		if htlc := report.WrittenOffHtlc; htlc != nil {
+			resolution.PaymentHash = htlc.PaymentHash[:]
+			resolution.HtlcIndex = htlc.HtlcIndex
+		} else {
+			resolution.Outpoint = &lnrpc.OutPoint{
+				TxidBytes:   report.OutPoint.Hash[:],
+				TxidStr:     report.OutPoint.Hash.String(),
+				OutputIndex: report.OutPoint.Index,
+			}
+		}
+
+		switch report.ResolverType {
+		case contractcourt.ResolverTypeCommit:
+			resolution.ResolutionType = lnrpc.ResolutionType_COMMIT
+
+		case contractcourt.ResolverTypeIncomingHtlc:
+			resolution.ResolutionType =
+				lnrpc.ResolutionType_INCOMING_HTLC
+
+		case contractcourt.ResolverTypeOutgoingHtlc:
+			resolution.ResolutionType =
+				lnrpc.ResolutionType_OUTGOING_HTLC
+		}
+
+		switch report.ResolverOutcome {
+		case contractcourt.ResolverOutcomeClaimed:
+			resolution.Outcome = lnrpc.ResolutionOutcome_CLAIMED
+
+		case contractcourt.ResolverOutcomeTimeout:
+			resolution.Outcome = lnrpc.ResolutionOutcome_TIMEOUT
+
+		case contractcourt.ResolverOutcomeAbandoned:
+			resolution.Outcome = lnrpc.ResolutionOutcome_ABANDONED
+
+		case contractcourt.ResolverOutcomeBreached:
+			resolution.Outcome = lnrpc.ResolutionOutcome_BREACHED
+
+		case contractcourt.ResolverOutcomeWrittenOff:
+			resolution.Outcome = lnrpc.ResolutionOutcome_WRITTEN_OFF
 		}
 
-		forceClose.PendingHtlcs = append(forceClose.PendingHtlcs,
-			htlc)
+		if report.SpendTxID != nil {
+			resolution.SweepTxid = report.SpendTxID.String()
+		}
+
+		resolutions = append(resolutions, resolution)
 	}
 
-	return nil
+	return resolutions, nil
 }
 
 // ClosedChannels returns a list of all the channels have been closed.
@@ -2552,12 +2780,67 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 		}
 
 		channel := createRPCClosedChannel(dbChannel)
+
+		// Force closed channels also carry the resolutions of the
+		// outputs of their commitment transaction.
+		switch dbChannel.CloseType {
+		case channeldb.LocalForceClose, channeldb.RemoteForceClose:
+			resolutions, err := r.rpcResolutions(
+				dbChannel.ChanPoint,
+			)
+			if err != nil {
+				return nil, err
+			}
+			channel.Resolutions = resolutions
+		}
+
 		resp.Channels = append(resp.Channels, channel)
 	}
 
 	return resp, nil
 }
 
+// PrunableChannels returns the fully resolved closed channels of which state
+// beyond their close summary is still stored, along with the number of bytes
+// pruning that state would reclaim.
+func (r *rpcServer) PrunableChannels(ctx context.Context,
+	in *lnrpc.PrunableChannelsRequest) (*lnrpc.PrunableChannelsResponse,
+	error) {
+
+	channels, err := r.server.chainArb.PrunableChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	_, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].CloseHeight < channels[j].CloseHeight
+	})
+
+	resp := &lnrpc.PrunableChannelsResponse{}
+	for _, channel := range channels {
+		resp.Channels = append(resp.Channels, &lnrpc.PrunableChannel{
+			ChannelPoint: channel.ChanPoint.String(),
+			CloseHeight:  channel.CloseHeight,
+			PruneHeight:  channel.PruneHeight,
+			SizeBytes:    channel.Size,
+		})
+
+		resp.TotalBytes += channel.Size
+		if channel.PruneHeight != 0 &&
+			channel.PruneHeight <= uint32(bestHeight) {
+
+			resp.ReclaimableBytes += channel.Size
+		}
+	}
+
+	return resp, nil
+}
+
 // ListChannels returns a description of all the open channels that this node
 // is a participant in.
 func (r *rpcServer) ListChannels(ctx context.Context,
@@ -3684,10 +3967,12 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 // GetTransactions returns a list of describing all the known transactions
 // relevant to the wallet.
 func (r *rpcServer) GetTransactions(ctx context.Context,
-	_ *lnrpc.GetTransactionsRequest) (*lnrpc.TransactionDetails, error) {
+	req *lnrpc.GetTransactionsRequest) (*lnrpc.TransactionDetails, error) {
 
 	// TODO(roasbeef): add pagination support
-	transactions, err := r.server.cc.wallet.ListTransactionDetails()
+	transactions, err := r.server.cc.wallet.ListTransactionDetails(
+		req.Account,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -3718,6 +4003,7 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 			TotalFees:        tx.TotalFees,
 			DestAddresses:    destAddresses,
 			RawTxHex:         hex.EncodeToString(tx.RawTx),
+			Label:            tx.Label,
 		}
 	}
 
@@ -4110,6 +4396,104 @@ func (r *rpcServer) StopDaemon(ctx context.Context,
 	return &lnrpc.StopResponse{}, nil
 }
 
+// DrainAndStop puts the daemon into drain mode in preparation of a graceful
+// shutdown. Once all in-flight HTLCs have been resolved, or the timeout
+// expires, a shutdown is requested. Progress is reported over the stream as
+// the number of in-flight HTLCs changes.
+func (r *rpcServer) DrainAndStop(req *lnrpc.DrainRequest,
+	updateStream lnrpc.Lightning_DrainAndStopServer) error {
+
+	timeout := defaultDrainTimeout
+	if req.TimeoutSeconds != 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+
+	rpcsLog.Infof("[drainandstop] draining node, timeout=%v", timeout)
+
+	numDisabled, err := r.server.Drain()
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	timeoutChan := time.After(timeout)
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	// Once draining has started, the shutdown must go through even if the
+	// client goes away, so we'll only stop reporting progress if sending
+	// an update fails.
+	var (
+		streamErr    error
+		lastNumHtlcs = -1
+	)
+	sendDrainUpdate := func(state lnrpc.DrainUpdate_DrainState,
+		numHtlcs int) {
+
+		if streamErr != nil {
+			return
+		}
+
+		var remaining uint32
+		if left := time.Until(deadline); left > 0 {
+			remaining = uint32(left.Seconds())
+		}
+
+		streamErr = updateStream.Send(&lnrpc.DrainUpdate{
+			State:            state,
+			DisabledChannels: uint32(numDisabled),
+			PendingHtlcs:     uint32(numHtlcs),
+			SecondsRemaining: remaining,
+		})
+		if streamErr != nil {
+			rpcsLog.Warnf("[drainandstop] unable to send update: %v",
+				streamErr)
+		}
+	}
+
+out:
+	for {
+		numHtlcs, err := r.server.numPendingHtlcs()
+		if err != nil {
+			return err
+		}
+
+		if numHtlcs == 0 {
+			sendDrainUpdate(lnrpc.DrainUpdate_DRAINED, numHtlcs)
+			break
+		}
+
+		if numHtlcs != lastNumHtlcs {
+			rpcsLog.Infof("[drainandstop] waiting for %v "+
+				"in-flight HTLCs", numHtlcs)
+
+			sendDrainUpdate(lnrpc.DrainUpdate_DRAINING, numHtlcs)
+			lastNumHtlcs = numHtlcs
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-timeoutChan:
+			rpcsLog.Warnf("[drainandstop] timed out with %v "+
+				"in-flight HTLCs", numHtlcs)
+
+			sendDrainUpdate(lnrpc.DrainUpdate_TIMED_OUT, numHtlcs)
+			break out
+
+		case <-r.quit:
+			return nil
+		}
+	}
+
+	rpcsLog.Infof("[drainandstop] drain complete, shutting down")
+
+	signal.RequestShutdown()
+
+	return nil
+}
+
 // SubscribeChannelGraph launches a streaming RPC that allows the caller to
 // receive notifications upon any changes the channel graph topology from the
 // review of the responding node. Events notified include: new nodes coming
@@ -4464,12 +4848,20 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		feeRateFixedPoint := edgePolicy.FeeProportionalMillionths
 		feeRate := float64(feeRateFixedPoint) / float64(feeBase)
 
+		inboundFee, err := edgePolicy.InboundFee()
+		if err != nil {
+			return fmt.Errorf("invalid inbound fee for channel "+
+				"%v: %v", chanInfo.ChannelID, err)
+		}
+
 		// TODO(roasbeef): also add stats for revenue for each channel
 		feeReports = append(feeReports, &lnrpc.ChannelFeeReport{
-			ChanPoint:   chanInfo.ChannelPoint.String(),
-			BaseFeeMsat: int64(edgePolicy.FeeBaseMSat),
-			FeePerMil:   int64(feeRateFixedPoint),
-			FeeRate:     feeRate,
+			ChanPoint:          chanInfo.ChannelPoint.String(),
+			BaseFeeMsat:        int64(edgePolicy.FeeBaseMSat),
+			FeePerMil:          int64(feeRateFixedPoint),
+			FeeRate:            feeRate,
+			InboundBaseFeeMsat: inboundFee.BaseFee,
+			InboundFeePerMil:   inboundFee.FeeRate,
 		})
 
 		return nil
@@ -4635,9 +5027,18 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		MaxHTLC:       lnwire.MilliSatoshi(req.MaxHtlcMsat),
 	}
 
+	// The inbound fee is only updated if it was specified.
+	if req.InboundFee != nil {
+		chanPolicy.InboundFee = &lnwire.Fee{
+			BaseFee: req.InboundFee.BaseFeeMsat,
+			FeeRate: req.InboundFee.FeePerMil,
+		}
+	}
+
 	rpcsLog.Debugf("[updatechanpolicy] updating channel policy base_fee=%v, "+
-		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, targets=%v",
-		req.BaseFeeMsat, req.FeeRate, feeRateFixed, req.TimeLockDelta,
+		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, "+
+		"inbound_fee=%v, targets=%v", req.BaseFeeMsat, req.FeeRate,
+		feeRateFixed, req.TimeLockDelta, req.InboundFee,
 		spew.Sdump(targetChans))
 
 	// With the scope resolved, we'll now send this to the local channel
@@ -4650,6 +5051,207 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 	return &lnrpc.PolicyUpdateResponse{}, nil
 }
 
+// SetFeeStrategy sets or clears the dynamic fee strategy of a particular
+// channel, or the default strategy used for all other channels.
+func (r *rpcServer) SetFeeStrategy(ctx context.Context,
+	req *lnrpc.SetFeeStrategyRequest) (*lnrpc.SetFeeStrategyResponse, error) {
+
+	var chanPoint *wire.OutPoint
+	switch scope := req.Scope.(type) {
+	// If the request is targeting the default strategy, we'll leave the
+	// channel point unset.
+	case *lnrpc.SetFeeStrategyRequest_Global:
+
+	// Otherwise, we're targeting an individual channel by its channel
+	// point.
+	case *lnrpc.SetFeeStrategyRequest_ChanPoint:
+		txid, err := GetChanPointFundingTxid(scope.ChanPoint)
+		if err != nil {
+			return nil, err
+		}
+		chanPoint = &wire.OutPoint{
+			Hash:  *txid,
+			Index: scope.ChanPoint.OutputIndex,
+		}
+	default:
+		return nil, fmt.Errorf("unknown scope: %v", scope)
+	}
+
+	var strategy *feepolicy.Strategy
+	if req.Strategy != nil {
+		strategy = unmarshallFeeStrategy(req.Strategy)
+	}
+
+	rpcsLog.Debugf("[setfeestrategy] chan_point=%v, strategy=%v",
+		chanPoint, spew.Sdump(strategy))
+
+	err := r.server.feeEngine.SetStrategy(chanPoint, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SetFeeStrategyResponse{}, nil
+}
+
+// PreviewFeeUpdates returns the configured fee strategies along with the
+// policies the fee engine would select for each channel, without applying
+// them.
+func (r *rpcServer) PreviewFeeUpdates(ctx context.Context,
+	req *lnrpc.PreviewFeeUpdatesRequest) (*lnrpc.PreviewFeeUpdatesResponse,
+	error) {
+
+	// Flush any pending forwarding events first, so the volumes reflect
+	// all forwards up until now.
+	if err := r.server.htlcSwitch.FlushForwardingEvents(); err != nil {
+		return nil, fmt.Errorf("unable to flush forwarding "+
+			"events: %v", err)
+	}
+
+	proposals, err := r.server.feeEngine.DryRun()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.PreviewFeeUpdatesResponse{
+		Active: cfg.FeePolicy.Active,
+	}
+
+	defaultStrategy, strategies := r.server.feeEngine.Strategies()
+	if defaultStrategy != nil {
+		resp.DefaultStrategy = marshallFeeStrategy(defaultStrategy)
+	}
+	for chanPoint, strategy := range strategies {
+		resp.ChannelStrategies = append(
+			resp.ChannelStrategies, &lnrpc.ChannelFeeStrategy{
+				ChanPoint: chanPoint.String(),
+				Strategy:  marshallFeeStrategy(strategy),
+			},
+		)
+	}
+
+	for _, p := range proposals {
+		resp.Proposals = append(resp.Proposals, &lnrpc.FeeUpdateProposal{
+			ChanPoint:          p.ChanPoint.String(),
+			ChanId:             p.ChanID.ToUint64(),
+			LocalRatio:         p.LocalRatio,
+			VolumeMsat:         uint64(p.Volume),
+			CurrentBaseFeeMsat: int64(p.CurrentBaseFee),
+			CurrentFeePerMil:   int64(p.CurrentFeeRate),
+			NewBaseFeeMsat:     int64(p.NewBaseFee),
+			NewFeePerMil:       int64(p.NewFeeRate),
+			Apply:              p.Apply(),
+			SkipReason:         p.SkipReason,
+		})
+	}
+
+	return resp, nil
+}
+
+// UpdateDeadlinePolicy sets the policy that decides when the arbitrator of a
+// channel goes to chain in order to resolve its HTLCs.
+func (r *rpcServer) UpdateDeadlinePolicy(ctx context.Context,
+	req *lnrpc.DeadlinePolicyRequest) (*lnrpc.DeadlinePolicyResponse, error) {
+
+	if req.ChanPoint == nil {
+		return nil, fmt.Errorf("chan_point must be set")
+	}
+	if req.MinChainHtlcSat < 0 {
+		return nil, fmt.Errorf("min_chain_htlc_sat must not be negative")
+	}
+
+	txid, err := GetChanPointFundingTxid(req.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.OutPoint{
+		Hash:  *txid,
+		Index: req.ChanPoint.OutputIndex,
+	}
+
+	policy := contractcourt.DeadlinePolicy{
+		IncomingBroadcastDelta: req.IncomingBroadcastDelta,
+		OutgoingBroadcastDelta: req.OutgoingBroadcastDelta,
+		MinChainHtlcValue:      btcutil.Amount(req.MinChainHtlcSat),
+	}
+
+	rpcsLog.Debugf("[updatedeadlinepolicy] chan_point=%v, policy=%v",
+		chanPoint, spew.Sdump(policy))
+
+	err = r.server.chainArb.UpdateDeadlinePolicy(chanPoint, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll return the policy as it's now in effect, with the global
+	// broadcast deltas filled in for those that weren't set.
+	arbitrator, err := r.server.chainArb.GetChannelArbitrator(chanPoint)
+	if err != nil {
+		return nil, err
+	}
+	policy = arbitrator.DeadlinePolicy()
+
+	return &lnrpc.DeadlinePolicyResponse{
+		IncomingBroadcastDelta: policy.IncomingBroadcastDelta,
+		OutgoingBroadcastDelta: policy.OutgoingBroadcastDelta,
+		MinChainHtlcSat:        int64(policy.MinChainHtlcValue),
+	}, nil
+}
+
+// unmarshallFeeStrategy converts an RPC fee strategy into its feepolicy
+// counterpart.
+func unmarshallFeeStrategy(s *lnrpc.FeeStrategy) *feepolicy.Strategy {
+	strategy := &feepolicy.Strategy{
+		BaseFee:             lnwire.MilliSatoshi(s.BaseFeeMsat),
+		MinFeeRate:          s.MinFeePerMil,
+		MaxFeeRate:          s.MaxFeePerMil,
+		VolumeTarget:        lnwire.MilliSatoshi(s.VolumeTargetMsat),
+		VolumeAdjustPercent: s.VolumeAdjustPercent,
+	}
+
+	switch s.Curve {
+	case lnrpc.FeeStrategy_STEP:
+		strategy.Curve = feepolicy.CurveStep
+	default:
+		strategy.Curve = feepolicy.CurveProportional
+	}
+
+	for _, step := range s.Steps {
+		strategy.Steps = append(strategy.Steps, feepolicy.Step{
+			MaxLocalPercent: step.MaxLocalPercent,
+			FeeRate:         step.FeePerMil,
+		})
+	}
+
+	return strategy
+}
+
+// marshallFeeStrategy converts a feepolicy strategy into its RPC counterpart.
+func marshallFeeStrategy(s *feepolicy.Strategy) *lnrpc.FeeStrategy {
+	strategy := &lnrpc.FeeStrategy{
+		BaseFeeMsat:         int64(s.BaseFee),
+		MinFeePerMil:        s.MinFeeRate,
+		MaxFeePerMil:        s.MaxFeeRate,
+		VolumeTargetMsat:    uint64(s.VolumeTarget),
+		VolumeAdjustPercent: s.VolumeAdjustPercent,
+	}
+
+	switch s.Curve {
+	case feepolicy.CurveStep:
+		strategy.Curve = lnrpc.FeeStrategy_STEP
+	default:
+		strategy.Curve = lnrpc.FeeStrategy_PROPORTIONAL
+	}
+
+	for _, step := range s.Steps {
+		strategy.Steps = append(strategy.Steps, &lnrpc.FeeStrategyStep{
+			MaxLocalPercent: step.MaxLocalPercent,
+			FeePerMil:       step.FeeRate,
+		})
+	}
+
+	return strategy
+}
+
 // ForwardingHistory allows the caller to query the htlcswitch for a record of
 // all HTLC's forwarded within the target time range, and integer offset within
 // that time range. If no time-range is specified, then the first chunk of the
@@ -5079,6 +5681,77 @@ func (r *rpcServer) SubscribeChannelBackups(req *lnrpc.ChannelBackupSubscription
 	}
 }
 
+// SubscribeStuckHtlcEvents returns a uni-directional stream (server -> client)
+// for notifying the client each time a channel with stuck outgoing HTLCs moves
+// to another stage of the stuck HTLC monitor's escalation.
+func (r *rpcServer) SubscribeStuckHtlcEvents(
+	req *lnrpc.StuckHtlcEventSubscription,
+	updateStream lnrpc.Lightning_SubscribeStuckHtlcEventsServer) error {
+
+	if !cfg.StuckHtlc.Active() {
+		return fmt.Errorf("stuck htlc monitor is not active")
+	}
+
+	eventSub, err := r.server.stuckHtlcMonitor.SubscribeEvents()
+	if err != nil {
+		return err
+	}
+	defer eventSub.Cancel()
+
+	for {
+		select {
+		case e := <-eventSub.Updates():
+			event, ok := e.(*htlcswitch.StuckHtlcEvent)
+			if !ok {
+				return fmt.Errorf("unexpected stuck htlc event "+
+					"type: %T", e)
+			}
+
+			var stage lnrpc.StuckHtlcEvent_Stage
+			switch event.Stage {
+			case htlcswitch.StuckHtlcReconnect:
+				stage = lnrpc.StuckHtlcEvent_RECONNECT
+			case htlcswitch.StuckHtlcRecovered:
+				stage = lnrpc.StuckHtlcEvent_RECOVERED
+			case htlcswitch.StuckHtlcForceClose:
+				stage = lnrpc.StuckHtlcEvent_FORCE_CLOSE
+			default:
+				return fmt.Errorf("unknown stuck htlc stage: "+
+					"%v", event.Stage)
+			}
+
+			update := &lnrpc.StuckHtlcEvent{
+				ChannelPoint: event.ChanPoint.String(),
+				RemotePubkey: hex.EncodeToString(
+					event.Peer.SerializeCompressed(),
+				),
+				Stage: stage,
+			}
+			for _, htlc := range event.Htlcs {
+				rHash := htlc.RHash
+				update.Htlcs = append(update.Htlcs, &lnrpc.HTLC{
+					Incoming: htlc.Incoming,
+					Amount: int64(
+						htlc.Amt.ToSatoshis(),
+					),
+					HashLock:         rHash[:],
+					ExpirationHeight: htlc.RefundTimeout,
+				})
+			}
+			if event.Err != nil {
+				update.Error = event.Err.Error()
+			}
+
+			if err := updateStream.Send(update); err != nil {
+				return err
+			}
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
 // chanAcceptInfo is used in the ChannelAcceptor bidirectional stream and
 // encapsulates the request information sent from the RPCAcceptor to the
 // RPCServer.
@@ -49,6 +49,7 @@ import (
 	"github.com/lightningnetwork/lnd/peernotifier"
 	"github.com/lightningnetwork/lnd/pool"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/feepolicy"
 	"github.com/lightningnetwork/lnd/routing/localchans"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/sweep"
@@ -118,6 +119,7 @@ func (e *errPeerAlreadyConnected) Error() string {
 type server struct {
 	active   int32 // atomic
 	stopping int32 // atomic
+	draining int32 // atomic
 
 	start sync.Once
 	stop  sync.Once
@@ -207,10 +209,24 @@ type server struct {
 
 	localChanMgr *localchans.Manager
 
-	utxoNursery *utxoNursery
+	feeEngine *feepolicy.Engine
 
 	sweeper *sweep.UtxoSweeper
 
+	consolidator *sweep.Consolidator
+
+	// nurseryOrphans are the outputs left behind by the former utxo
+	// nursery for channels that are no longer pending close. They're
+	// handed to the sweeper on start up.
+	nurseryOrphans []*nurseryOutput
+
+	// keepNurseryState is set if the former utxo nursery holds outputs
+	// that can't be recovered automatically, in which case its state is
+	// kept after the orphans have been recovered.
+	keepNurseryState bool
+
+	stuckHtlcMonitor *htlcswitch.StuckHtlcMonitor
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *hop.OnionProcessor
@@ -448,6 +464,11 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		return nil, err
 	}
 
+	chanStatusStore, err := netann.NewChanStatusStore(chanDB.DB)
+	if err != nil {
+		return nil, err
+	}
+
 	chanStatusMgrCfg := &netann.ChanStatusConfig{
 		ChanStatusSampleInterval: cfg.ChanStatusSampleInterval,
 		ChanEnableTimeout:        cfg.ChanEnableTimeout,
@@ -458,6 +479,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		ApplyChannelUpdate:       s.applyChannelUpdate,
 		DB:                       chanDB,
 		Graph:                    chanDB.ChannelGraph(),
+		Store:                    chanStatusStore,
 	}
 
 	chanStatusMgr, err := netann.NewChanStatusManager(chanStatusMgrCfg)
@@ -754,9 +776,37 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		FetchChannel:              s.chanDB.FetchChannel,
 	}
 
-	utxnStore, err := newNurseryStore(activeNetParams.GenesisHash, chanDB)
+	feePolicyStore, err := feepolicy.NewStore(chanDB.DB)
 	if err != nil {
-		srvrLog.Errorf("unable to create nursery store: %v", err)
+		srvrLog.Errorf("unable to create fee policy store: %v", err)
+		return nil, err
+	}
+
+	s.feeEngine, err = feepolicy.New(&feepolicy.Config{
+		Store:                  feePolicyStore,
+		FetchAllOpenChannels:   s.chanDB.FetchAllOpenChannels,
+		ForAllOutgoingChannels: s.chanRouter.ForAllOutgoingChannels,
+		QueryForwardingLog:     s.chanDB.ForwardingLog().Query,
+		UpdatePolicy:           s.localChanMgr.UpdatePolicy,
+		Ticker:                 ticker.New(cfg.FeePolicy.Interval),
+		MinUpdateInterval:      cfg.FeePolicy.MinUpdateInterval,
+		MinFeeRateDelta:        cfg.FeePolicy.MinFeeRateDelta,
+		VolumeWindow:           cfg.FeePolicy.VolumeWindow,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Time locked outputs of force closed channels are swept by the
+	// contract resolvers, so we'll clean up any state left behind by the
+	// former utxo nursery.
+	// Outputs of channels that are no longer pending close are recovered
+	// through the sweeper once it has been started.
+	s.nurseryOrphans, s.keepNurseryState, err = migrateNurseryStore(
+		chanDB, activeNetParams.GenesisHash, cfg.MaxOutgoingCltvExpiry,
+	)
+	if err != nil {
+		srvrLog.Errorf("unable to migrate nursery store: %v", err)
 		return nil, err
 	}
 
@@ -771,10 +821,16 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		return nil, err
 	}
 
+	feeFunction, err := sweep.FeeFunctionFromName(cfg.Sweeper.FeeFunction)
+	if err != nil {
+		return nil, err
+	}
+
 	s.sweeper = sweep.New(&sweep.UtxoSweeperConfig{
 		FeeEstimator:       cc.feeEstimator,
 		GenSweepScript:     newSweepPkScriptGen(cc.wallet),
 		Signer:             cc.wallet.Cfg.Signer,
+		OutputLeaser:       cc.wallet.WalletController,
 		PublishTransaction: cc.wallet.PublishTransaction,
 		NewBatchTimer: func() <-chan time.Time {
 			return time.NewTimer(sweep.DefaultBatchWindowDuration).C
@@ -786,17 +842,24 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		NextAttemptDeltaFunc: sweep.DefaultNextAttemptDeltaFunc,
 		MaxFeeRate:           sweep.DefaultMaxFeeRate,
 		FeeRateBucketSize:    sweep.DefaultFeeRateBucketSize,
+		FeeFunction:          feeFunction,
+		DeadlineBucketSize:   cfg.Sweeper.DeadlineBucketSize,
 	})
 
-	s.utxoNursery = newUtxoNursery(&NurseryConfig{
-		ChainIO:             cc.chainIO,
-		ConfDepth:           1,
-		FetchClosedChannels: chanDB.FetchClosedChannels,
-		FetchClosedChannel:  chanDB.FetchClosedChannel,
-		Notifier:            cc.chainNotifier,
-		PublishTransaction:  cc.wallet.PublishTransaction,
-		Store:               utxnStore,
-		SweepInput:          s.sweeper.SweepInput,
+	s.consolidator = sweep.NewConsolidator(&sweep.ConsolidatorConfig{
+		FeeEstimator: cc.feeEstimator,
+		ConfTarget:   cfg.Consolidation.ConfTarget,
+		MaxFeeRate: lnwallet.SatPerKVByte(
+			cfg.Consolidation.MaxFeeRate * 1000,
+		).FeePerKWeight(),
+		MinInputs:        cfg.Consolidation.MinInputs,
+		MaxInputs:        cfg.Consolidation.MaxInputs,
+		AutoConsolidate:  cfg.Consolidation.Active,
+		CoinSelectLocker: cc.wallet,
+		UtxoSource:       cc.wallet,
+		OutputLeaser:     cc.wallet.WalletController,
+		SweepInput:       s.sweeper.SweepInput,
+		Notifier:         cc.chainNotifier,
 	})
 
 	// Construct a closure that wraps the htlcswitch's CloseLink method.
@@ -826,28 +889,6 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 			}
 			return nil
 		},
-		IncubateOutputs: func(chanPoint wire.OutPoint,
-			commitRes *lnwallet.CommitOutputResolution,
-			outHtlcRes *lnwallet.OutgoingHtlcResolution,
-			inHtlcRes *lnwallet.IncomingHtlcResolution,
-			broadcastHeight uint32) error {
-
-			var (
-				inRes  []lnwallet.IncomingHtlcResolution
-				outRes []lnwallet.OutgoingHtlcResolution
-			)
-			if inHtlcRes != nil {
-				inRes = append(inRes, *inHtlcRes)
-			}
-			if outHtlcRes != nil {
-				outRes = append(outRes, *outHtlcRes)
-			}
-
-			return s.utxoNursery.IncubateOutputs(
-				chanPoint, commitRes, outRes, inRes,
-				broadcastHeight,
-			)
-		},
 		PreimageDB:   s.witnessBeacon,
 		Notifier:     cc.chainNotifier,
 		Signer:       cc.wallet.Cfg.Signer,
@@ -882,24 +923,38 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 				return ErrServerShuttingDown
 			}
 		},
-		DisableChannel:      s.chanStatusMgr.RequestDisable,
+		DisableChannel: func(op wire.OutPoint) error {
+			return s.chanStatusMgr.RequestDisable(op, false)
+		},
 		Sweeper:             s.sweeper,
 		Registry:            s.invoices,
 		NotifyClosedChannel: s.channelNotifier.NotifyClosedChannelEvent,
+		PruneWindow:         cfg.ResolvedChanPruneWindow,
 	}, chanDB)
 
 	s.breachArbiter = newBreachArbiter(&BreachConfig{
-		CloseLink:          closeLink,
-		DB:                 chanDB,
-		Estimator:          s.cc.feeEstimator,
-		GenSweepScript:     newSweepPkScriptGen(cc.wallet),
-		Notifier:           cc.chainNotifier,
-		PublishTransaction: cc.wallet.PublishTransaction,
-		ContractBreaches:   contractBreaches,
-		Signer:             cc.wallet.Cfg.Signer,
-		Store:              newRetributionStore(chanDB),
+		CloseLink:        closeLink,
+		DB:               chanDB,
+		Notifier:         cc.chainNotifier,
+		ContractBreaches: contractBreaches,
+		SweepInput:       s.sweeper.SweepInputInGroup,
+		Store:            newRetributionStore(chanDB),
 	})
 
+	s.stuckHtlcMonitor = htlcswitch.NewStuckHtlcMonitor(
+		&htlcswitch.StuckHtlcMonitorConfig{
+			MaxPendingTime:       cfg.StuckHtlc.MaxPendingTime,
+			MaxPendingBlocks:     cfg.StuckHtlc.MaxPendingBlocks,
+			ReconnectGrace:       cfg.StuckHtlc.ReconnectGrace,
+			DB:                   chanDB,
+			FetchAllOpenChannels: chanDB.FetchAllOpenChannels,
+			BestHeight:           s.htlcSwitch.BestHeight,
+			Reconnect:            s.reconnectPeer,
+			ForceClose:           s.forceCloseChannel,
+			Ticker:               ticker.New(cfg.StuckHtlc.Interval),
+		},
+	)
+
 	// Select the configuration and furnding parameters for Bitcoin or
 	// Litecoin, depending on the primary registered chain.
 	primaryChain := registeredChains.PrimaryChain()
@@ -1083,6 +1138,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		MinChanSize:            btcutil.Amount(cfg.MinChanSize),
 		MaxPendingChannels:     cfg.MaxPendingChannels,
 		RejectPush:             cfg.RejectPush,
+		IsDraining:             s.IsDraining,
 		NotifyOpenChannelEvent: s.channelNotifier.NotifyOpenChannelEvent,
 		OpenChannelPredicate:   chanPredicate,
 	})
@@ -1236,10 +1292,16 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
-		if err := s.utxoNursery.Start(); err != nil {
+		if err := s.consolidator.Start(); err != nil {
 			startErr = err
 			return
 		}
+		if len(s.nurseryOrphans) > 0 {
+			s.wg.Add(1)
+			go s.recoverNurseryOutputs(
+				s.nurseryOrphans, s.keepNurseryState,
+			)
+		}
 		if err := s.chainArb.Start(); err != nil {
 			startErr = err
 			return
@@ -1269,6 +1331,23 @@ func (s *server) Start() error {
 			return
 		}
 
+		// The fee engine is only started if it's active. Strategies can
+		// still be configured and previewed while it isn't running.
+		if cfg.FeePolicy.Active {
+			if err := s.feeEngine.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		// The stuck HTLC monitor only runs if a threshold is set.
+		if cfg.StuckHtlc.Active() {
+			if err := s.stuckHtlcMonitor.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		// Before we start the connMgr, we'll check to see if we have
 		// any backups to recover. We do this now as we want to ensure
 		// that have all the information we need to handle channel
@@ -1365,15 +1444,21 @@ func (s *server) Stop() error {
 		}
 
 		// Shutdown the wallet, funding manager, and the rpc server.
+		if cfg.FeePolicy.Active {
+			s.feeEngine.Stop()
+		}
+		if cfg.StuckHtlc.Active() {
+			s.stuckHtlcMonitor.Stop()
+		}
 		s.chanStatusMgr.Stop()
 		s.cc.chainNotifier.Stop()
 		s.chanRouter.Stop()
 		s.htlcSwitch.Stop()
 		s.sphinx.Stop()
-		s.utxoNursery.Stop()
 		s.breachArbiter.Stop()
 		s.authGossiper.Stop()
 		s.chainArb.Stop()
+		s.consolidator.Stop()
 		s.sweeper.Stop()
 		s.channelNotifier.Stop()
 		s.peerNotifier.Stop()
@@ -1416,6 +1501,87 @@ func (s *server) Stopped() bool {
 	return atomic.LoadInt32(&s.stopping) != 0
 }
 
+// Drain puts the server into drain mode in preparation of a shutdown. All of
+// our public channels are disabled on the network, the switch stops forwarding
+// new HTLCs and any incoming channels are rejected. HTLCs that are already in
+// flight are resolved as usual. The number of channels for which a disable was
+// requested is returned. Calling Drain more than once is safe.
+func (s *server) Drain() (int, error) {
+	if atomic.CompareAndSwapInt32(&s.draining, 0, 1) {
+		srvrLog.Infof("Server draining in preparation of shutdown")
+	}
+
+	s.htlcSwitch.Drain()
+
+	channels, err := s.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return 0, err
+	}
+
+	var numDisabled int
+	for _, c := range channels {
+		isPublic := c.ChannelFlags&lnwire.FFAnnounceChannel != 0
+		if !isPublic || c.IsPending {
+			continue
+		}
+
+		// We'll continue on failure, as the channel may be in the
+		// process of closing, in which case its edge is already gone.
+		err := s.chanStatusMgr.RequestDisable(c.FundingOutpoint, false)
+		if err != nil {
+			srvrLog.Warnf("Unable to disable channel %v: %v",
+				c.FundingOutpoint, err)
+			continue
+		}
+		numDisabled++
+	}
+
+	return numDisabled, nil
+}
+
+// IsDraining returns true if the server is draining in preparation of a
+// shutdown.
+func (s *server) IsDraining() bool {
+	return atomic.LoadInt32(&s.draining) != 0
+}
+
+// numPendingHtlcs returns the number of HTLCs that are locked in on any of our
+// open channels.
+func (s *server) numPendingHtlcs() (int, error) {
+	channels, err := s.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return 0, err
+	}
+
+	// An HTLC is usually present on both commitments, so we'll index them
+	// by their direction and index to only count them once.
+	type htlcKey struct {
+		incoming bool
+		index    uint64
+	}
+
+	var numHtlcs int
+	for _, c := range channels {
+		htlcs := make(map[htlcKey]struct{})
+		commitments := []channeldb.ChannelCommitment{
+			c.LocalCommitment, c.RemoteCommitment,
+		}
+		for _, commitment := range commitments {
+			for _, htlc := range commitment.Htlcs {
+				key := htlcKey{
+					incoming: htlc.Incoming,
+					index:    htlc.HtlcIndex,
+				}
+				htlcs[key] = struct{}{}
+			}
+		}
+
+		numHtlcs += len(htlcs)
+	}
+
+	return numHtlcs, nil
+}
+
 // configurePortForwarding attempts to set up port forwarding for the different
 // ports that the server will be listening on.
 //
@@ -3198,6 +3364,53 @@ func (s *server) DisconnectPeer(pubKey *btcec.PublicKey) error {
 	return nil
 }
 
+// reconnectPeer tears down the connection to the given peer in order to have
+// all channels with it re-established from scratch through
+// channel_reestablish. As we have channels with the peer, it is a persistent
+// peer and the connection is re-established as soon as the peer terminates.
+// If the peer isn't connected, we'll attempt to connect to its advertised
+// address right away instead of waiting for the connection backoff.
+func (s *server) reconnectPeer(pubKey *btcec.PublicKey) error {
+	if peer, err := s.FindPeer(pubKey); err == nil {
+		peer.Disconnect(errors.New("reconnecting to recover stuck " +
+			"HTLCs"))
+		return nil
+	}
+
+	addr, err := s.fetchNodeAdvertisedAddr(pubKey)
+	if err != nil {
+		return err
+	}
+
+	return s.ConnectToPeer(&lnwire.NetAddress{
+		IdentityKey: pubKey,
+		Address:     addr,
+		ChainNet:    activeNetParams.Net,
+	}, false)
+}
+
+// forceCloseChannel force closes the given channel through the chain
+// arbitrator, after making sure the switch no longer forwards HTLCs over it.
+func (s *server) forceCloseChannel(channel *channeldb.OpenChannel) error {
+	chanPoint := channel.FundingOutpoint
+	if peer, err := s.FindPeer(channel.IdentityPub); err == nil {
+		peer.WipeChannel(&chanPoint)
+	} else {
+		chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+		s.htlcSwitch.RemoveLink(chanID)
+	}
+
+	closingTx, err := s.chainArb.ForceCloseContract(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	srvrLog.Infof("Force closed ChannelPoint(%v) with txid %v",
+		chanPoint, closingTx.TxHash())
+
+	return nil
+}
+
 // OpenChannel sends a request to the server to open a channel to the specified
 // peer identified by nodeKey with the passed channel funding parameters.
 //
@@ -3373,7 +3586,10 @@ func newSweepPkScriptGen(
 	wallet lnwallet.WalletController) func() ([]byte, error) {
 
 	return func() ([]byte, error) {
-		sweepAddr, err := wallet.NewAddress(lnwallet.WitnessPubKey, false)
+		sweepAddr, err := wallet.NewAddress(
+			lnwallet.WitnessPubKey, false,
+			lnwallet.DefaultAccountName,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -87,9 +87,11 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 	activeNetParams *chaincfg.Params,
 	chanRouter *routing.ChannelRouter,
 	routerBackend *routerrpc.RouterBackend,
+	chanStatusMgr *netann.ChanStatusManager,
 	nodeSigner *netann.NodeSigner,
 	chanDB *channeldb.DB,
 	sweeper *sweep.UtxoSweeper,
+	consolidator *sweep.Consolidator,
 	tower *watchtower.Standalone,
 	towerClient wtclient.Client,
 	tcpResolver lncfg.TCPResolver) error {
@@ -152,9 +154,18 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("Sweeper").Set(
 				reflect.ValueOf(sweeper),
 			)
+			subCfgValue.FieldByName("Consolidator").Set(
+				reflect.ValueOf(consolidator),
+			)
 			subCfgValue.FieldByName("Chain").Set(
 				reflect.ValueOf(cc.chainIO),
 			)
+			subCfgValue.FieldByName("CoinSelectionLocker").Set(
+				reflect.ValueOf(cc.wallet),
+			)
+			subCfgValue.FieldByName("ChainParams").Set(
+				reflect.ValueOf(activeNetParams),
+			)
 
 		case *autopilotrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -226,6 +237,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("RouterBackend").Set(
 				reflect.ValueOf(routerBackend),
 			)
+			subCfgValue.FieldByName("ChanStatusMgr").Set(
+				reflect.ValueOf(chanStatusMgr),
+			)
 
 		case *watchtowerrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
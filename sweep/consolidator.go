@@ -0,0 +1,480 @@
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+const (
+	// DefaultConsolidationConfTarget is the default confirmation target
+	// used to estimate the fee rate of consolidations.
+	DefaultConsolidationConfTarget = 144
+
+	// DefaultConsolidationMaxFeeRate is the default fee rate below which
+	// wallet UTXOs are consolidated, equivalent to 2 sat/vbyte.
+	DefaultConsolidationMaxFeeRate = lnwallet.SatPerKWeight(500)
+
+	// DefaultConsolidationMinInputs is the default number of wallet UTXOs
+	// required for a consolidation to take place.
+	DefaultConsolidationMinInputs = 20
+
+	// DefaultConsolidationMaxInputs is the default maximum number of
+	// wallet UTXOs merged by a single consolidation.
+	DefaultConsolidationMaxInputs = 100
+
+	// walletInputLeaseDuration is the duration for which wallet UTXOs are
+	// leased while they're being swept. It's chosen to outlast the
+	// attempts of the UtxoSweeper, such that the lease only expires if it
+	// isn't released after a sweep for some reason.
+	walletInputLeaseDuration = 14 * 24 * time.Hour
+)
+
+var (
+	// WalletInputLockID is the lock ID under which wallet UTXOs are leased
+	// while they're being swept by the UtxoSweeper.
+	WalletInputLockID = lnwallet.LockID{
+		0x52, 0x1a, 0x31, 0xe1, 0x44, 0x45, 0xb4, 0xb9,
+		0x13, 0xe4, 0x5d, 0xa9, 0xc6, 0x38, 0x0b, 0xe3,
+		0x39, 0xd1, 0xb1, 0xfe, 0xf0, 0x68, 0x08, 0xc2,
+		0x4a, 0x2b, 0x8c, 0x77, 0x7d, 0x64, 0x15, 0x07,
+	}
+
+	// ErrConsolidationFeeTooHigh is returned when a consolidation is
+	// attempted while the estimated fee rate exceeds the configured
+	// maximum.
+	ErrConsolidationFeeTooHigh = errors.New("fee rate too high for " +
+		"consolidation")
+
+	// ErrTooFewConsolidationInputs is returned when a consolidation is
+	// attempted while the wallet doesn't have enough UTXOs worth merging.
+	ErrTooFewConsolidationInputs = errors.New("too few utxos to " +
+		"consolidate")
+
+	// ErrConsolidatorShuttingDown is returned when a consolidation is
+	// requested while the Consolidator is shutting down.
+	ErrConsolidatorShuttingDown = errors.New("consolidator shutting down")
+)
+
+// ConsolidatorConfig contains the dependencies and parameters of the
+// Consolidator.
+type ConsolidatorConfig struct {
+	// FeeEstimator is used to determine whether the current fee rate is
+	// low enough for a consolidation to take place.
+	FeeEstimator lnwallet.FeeEstimator
+
+	// ConfTarget is the confirmation target used to estimate the fee rate
+	// of consolidations.
+	ConfTarget uint32
+
+	// MaxFeeRate is the highest estimated fee rate at which wallet UTXOs
+	// are consolidated.
+	MaxFeeRate lnwallet.SatPerKWeight
+
+	// MinInputs is the number of wallet UTXOs required for a
+	// consolidation to take place.
+	MinInputs int
+
+	// MaxInputs is the maximum number of wallet UTXOs merged by a single
+	// consolidation. The smallest UTXOs are merged first.
+	MaxInputs int
+
+	// AutoConsolidate determines whether wallet UTXOs are consolidated
+	// automatically whenever a new block arrives and the above conditions
+	// are met. Consolidations can be triggered manually regardless.
+	AutoConsolidate bool
+
+	// CoinSelectLocker is used to ensure that no coin selection takes
+	// place while we select the UTXOs to consolidate.
+	CoinSelectLocker CoinSelectionLocker
+
+	// UtxoSource is the source of the wallet UTXOs to consolidate. Leased
+	// and otherwise locked outputs aren't returned by it, so they're never
+	// consolidated.
+	UtxoSource UtxoSource
+
+	// OutputLeaser is used to lease the UTXOs being consolidated under
+	// WalletInputLockID, such that they aren't selected for other
+	// transactions in the meantime, even across restarts.
+	OutputLeaser OutputLeaser
+
+	// SweepInput hands an input off to the UtxoSweeper, which will sweep
+	// it back into the wallet along with the other inputs of the
+	// consolidation.
+	SweepInput func(input.Input, FeePreference) (chan Result, error)
+
+	// Notifier is used to consolidate wallet UTXOs on every new block if
+	// AutoConsolidate is set.
+	Notifier chainntnfs.ChainNotifier
+}
+
+// Consolidation describes the merging of a set of wallet UTXOs into a single
+// output by the UtxoSweeper.
+type Consolidation struct {
+	// Inputs are the wallet UTXOs to be merged, smallest first.
+	Inputs []*lnwallet.Utxo
+
+	// FeeRate is the estimated fee rate the consolidation pays.
+	FeeRate lnwallet.SatPerKWeight
+
+	// TotalAmount is the total value of the inputs.
+	TotalAmount btcutil.Amount
+
+	// Fee is the estimated fee paid by the consolidation, in case the
+	// inputs are swept within a transaction of their own.
+	Fee btcutil.Amount
+
+	// Skipped is the reason the consolidation wouldn't take place, if
+	// any. It's only set by Preview, as Consolidate returns it as an error
+	// instead.
+	Skipped error
+}
+
+// Consolidator merges the UTXOs of the wallet whenever fee rates are low, such
+// that future transactions spending them, e.g. channel opens, become cheaper.
+// The actual merging is left to the UtxoSweeper, which sweeps the wallet
+// UTXOs back into the wallet.
+type Consolidator struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *ConsolidatorConfig
+
+	// consolidateMtx serializes consolidations, such that concurrent ones
+	// don't select the same UTXOs. It's also held while quit is closed,
+	// such that no goroutine is added to wg once Stop waits for it.
+	consolidateMtx sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewConsolidator creates a new Consolidator from the given config.
+func NewConsolidator(cfg *ConsolidatorConfig) *Consolidator {
+	return &Consolidator{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the Consolidator. If automatic consolidation is enabled, it'll
+// attempt to consolidate the wallet's UTXOs on every new block.
+func (c *Consolidator) Start() error {
+	if !atomic.CompareAndSwapUint32(&c.started, 0, 1) {
+		return nil
+	}
+
+	log.Debugf("Consolidator starting")
+
+	if !c.cfg.AutoConsolidate {
+		return nil
+	}
+
+	blockEpochs, err := c.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+
+	c.wg.Add(1)
+	go c.consolidateOnBlocks(blockEpochs)
+
+	return nil
+}
+
+// Stop stops the Consolidator. Consolidations handed off to the UtxoSweeper
+// are left to it.
+func (c *Consolidator) Stop() error {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Debugf("Consolidator shutting down")
+
+	c.consolidateMtx.Lock()
+	close(c.quit)
+	c.consolidateMtx.Unlock()
+
+	c.wg.Wait()
+
+	return nil
+}
+
+// consolidateOnBlocks attempts to consolidate the wallet's UTXOs whenever a
+// new block arrives.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Consolidator) consolidateOnBlocks(
+	blockEpochs *chainntnfs.BlockEpochEvent) {
+
+	defer c.wg.Done()
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case _, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			consolidation, err := c.Consolidate(false)
+			switch {
+			case err == ErrConsolidationFeeTooHigh,
+				err == ErrTooFewConsolidationInputs,
+				err == ErrConsolidatorShuttingDown:
+
+				log.Tracef("Skipping consolidation: %v", err)
+
+			case err != nil:
+				log.Errorf("Unable to consolidate wallet "+
+					"utxos: %v", err)
+
+			default:
+				log.Infof("Consolidating %d wallet utxos "+
+					"worth %v at fee rate %v",
+					len(consolidation.Inputs),
+					consolidation.TotalAmount,
+					consolidation.FeeRate)
+			}
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// Preview returns the consolidation that would take place if one was
+// triggered now, along with the reason it would be skipped, if any. No UTXOs
+// are locked or swept.
+func (c *Consolidator) Preview() (*Consolidation, error) {
+	consolidation, err := c.planConsolidation()
+	if err != nil {
+		return nil, err
+	}
+
+	consolidation.Skipped = c.checkConsolidation(consolidation)
+
+	return consolidation, nil
+}
+
+// Consolidate merges the smallest UTXOs of the wallet by handing them off to
+// the UtxoSweeper. Unless forced, ErrConsolidationFeeTooHigh is returned if
+// the estimated fee rate exceeds the configured maximum, and
+// ErrTooFewConsolidationInputs if there are too few UTXOs worth merging. Even
+// if forced, at least two UTXOs are required.
+func (c *Consolidator) Consolidate(force bool) (*Consolidation, error) {
+	c.consolidateMtx.Lock()
+	defer c.consolidateMtx.Unlock()
+
+	select {
+	case <-c.quit:
+		return nil, ErrConsolidatorShuttingDown
+	default:
+	}
+
+	// We'll select and lease the UTXOs to merge while holding the coin
+	// selection lock, such that they can't be selected for any other
+	// transaction in the meantime. As leases are persisted, the UTXOs
+	// remain unavailable while the sweeper resumes sweeping them after a
+	// restart.
+	var consolidation *Consolidation
+	err := c.cfg.CoinSelectLocker.WithCoinSelectLock(func() error {
+		var err error
+		consolidation, err = c.planConsolidation()
+		if err != nil {
+			return err
+		}
+
+		// A forced consolidation ignores the configured conditions,
+		// though merging less than two UTXOs wouldn't achieve much.
+		switch {
+		case force && len(consolidation.Inputs) < 2:
+			return ErrTooFewConsolidationInputs
+
+		case !force:
+			if err := c.checkConsolidation(consolidation); err != nil {
+				return err
+			}
+		}
+
+		for i, utxo := range consolidation.Inputs {
+			_, err := c.cfg.OutputLeaser.LeaseOutput(
+				WalletInputLockID, utxo.OutPoint,
+				walletInputLeaseDuration,
+			)
+			if err != nil {
+				c.releaseUtxos(consolidation.Inputs[:i])
+
+				return fmt.Errorf("unable to lease utxo %v: %v",
+					utxo.OutPoint, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := makeWalletInputs(consolidation.Inputs)
+	if err != nil {
+		c.releaseUtxos(consolidation.Inputs)
+		return nil, err
+	}
+
+	// With the UTXOs leased, we'll hand them off to the sweeper at the fee
+	// rate we've estimated, such that they're batched into a single
+	// transaction.
+	feePref := FeePreference{
+		FeeRate: consolidation.FeeRate,
+	}
+	for i, inp := range inputs {
+		resultChan, err := c.cfg.SweepInput(inp, feePref)
+		if err != nil {
+			// The inputs that have already been handed off will
+			// be released by the sweeper once it's done with them.
+			c.releaseUtxos(consolidation.Inputs[i:])
+
+			return nil, fmt.Errorf("unable to sweep utxo %v: %v",
+				inp.OutPoint(), err)
+		}
+
+		c.wg.Add(1)
+		go c.waitForSweep(*inp.OutPoint(), resultChan)
+	}
+
+	return consolidation, nil
+}
+
+// waitForSweep waits for the UtxoSweeper to sweep the given UTXO and logs the
+// outcome. The UtxoSweeper releases the lease of the UTXO once it's done with
+// it, also if it resumed sweeping the UTXO after a restart.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Consolidator) waitForSweep(op wire.OutPoint,
+	resultChan chan Result) {
+
+	defer c.wg.Done()
+
+	select {
+	case result := <-resultChan:
+		if result.Err != nil {
+			log.Warnf("Unable to consolidate wallet utxo %v: %v",
+				op, result.Err)
+			return
+		}
+
+		log.Debugf("Consolidated wallet utxo %v", op)
+
+	case <-c.quit:
+	}
+}
+
+// releaseUtxos releases the leases of the given wallet UTXOs that weren't
+// handed off to the UtxoSweeper.
+func (c *Consolidator) releaseUtxos(utxos []*lnwallet.Utxo) {
+	for _, utxo := range utxos {
+		err := c.cfg.OutputLeaser.ReleaseOutput(
+			WalletInputLockID, utxo.OutPoint,
+		)
+		if err != nil {
+			log.Warnf("Unable to release wallet utxo %v: %v",
+				utxo.OutPoint, err)
+		}
+	}
+}
+
+// planConsolidation selects the wallet UTXOs to merge at the current fee
+// rate. Only confirmed UTXOs that are worth more than the fee required to
+// spend them are selected, smallest first.
+func (c *Consolidator) planConsolidation() (*Consolidation, error) {
+	feeRate, err := c.cfg.FeeEstimator.EstimateFeePerKW(c.cfg.ConfTarget)
+	if err != nil {
+		return nil, fmt.Errorf("unable to estimate fee rate: %v", err)
+	}
+
+	utxos, err := c.cfg.UtxoSource.ListUnspentWitnessFromDefaultAccount(
+		1, math.MaxInt32,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(utxos, func(i, j int) bool {
+		return utxos[i].Value < utxos[j].Value
+	})
+
+	consolidation := &Consolidation{
+		FeeRate: feeRate,
+	}
+
+	// The transaction merging the UTXOs pays to a single output of the
+	// wallet.
+	var weightEstimate input.TxWeightEstimator
+	weightEstimate.AddP2WKHOutput()
+
+	for _, utxo := range utxos {
+		if len(consolidation.Inputs) == c.cfg.MaxInputs {
+			break
+		}
+
+		inputs, err := makeWalletInputs([]*lnwallet.Utxo{utxo})
+		if err != nil {
+			// UTXOs of an unknown type can't be swept, so we'll
+			// skip them.
+			continue
+		}
+
+		// Skip UTXOs that cost more to spend than they're worth.
+		var inputEstimate input.TxWeightEstimator
+		_, _, err = inputs[0].WitnessType().AddWeightEstimation(
+			&inputEstimate,
+		)
+		if err != nil {
+			return nil, err
+		}
+		var emptyEstimate input.TxWeightEstimator
+		inputWeight := inputEstimate.Weight() - emptyEstimate.Weight()
+		if utxo.Value <= feeRate.FeeForWeight(int64(inputWeight)) {
+			continue
+		}
+
+		_, _, err = inputs[0].WitnessType().AddWeightEstimation(
+			&weightEstimate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		consolidation.Inputs = append(consolidation.Inputs, utxo)
+		consolidation.TotalAmount += utxo.Value
+	}
+
+	consolidation.Fee = feeRate.FeeForWeight(
+		int64(weightEstimate.Weight()),
+	)
+
+	return consolidation, nil
+}
+
+// checkConsolidation returns the reason the given consolidation shouldn't
+// take place, if any.
+func (c *Consolidator) checkConsolidation(consolidation *Consolidation) error {
+	if consolidation.FeeRate > c.cfg.MaxFeeRate {
+		return ErrConsolidationFeeTooHigh
+	}
+	if len(consolidation.Inputs) < c.cfg.MinInputs {
+		return ErrTooFewConsolidationInputs
+	}
+
+	return nil
+}
@@ -0,0 +1,352 @@
+package sweep
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// mockOutputLeaser is a thread safe OutputLeaser which also acts as the
+// UtxoSource of the leased outputs, listing only those that aren't leased.
+type mockOutputLeaser struct {
+	mtx    sync.Mutex
+	utxos  []*lnwallet.Utxo
+	leased map[wire.OutPoint]lnwallet.LockID
+}
+
+func newMockOutputLeaser(utxos []*lnwallet.Utxo) *mockOutputLeaser {
+	return &mockOutputLeaser{
+		utxos:  utxos,
+		leased: make(map[wire.OutPoint]lnwallet.LockID),
+	}
+}
+
+func (m *mockOutputLeaser) LeaseOutput(id lnwallet.LockID, o wire.OutPoint,
+	duration time.Duration) (time.Time, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if leaseID, ok := m.leased[o]; ok && leaseID != id {
+		return time.Time{}, lnwallet.ErrOutputAlreadyLeased
+	}
+	m.leased[o] = id
+
+	return time.Now().Add(duration), nil
+}
+
+func (m *mockOutputLeaser) ReleaseOutput(id lnwallet.LockID,
+	o wire.OutPoint) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	leaseID, ok := m.leased[o]
+	switch {
+	case !ok:
+		return lnwallet.ErrOutputNotLeased
+
+	case leaseID != id:
+		return lnwallet.ErrOutputUnlockNotAllowed
+	}
+	delete(m.leased, o)
+
+	return nil
+}
+
+func (m *mockOutputLeaser) ListUnspentWitnessFromDefaultAccount(
+	minConfs, maxConfs int32) ([]*lnwallet.Utxo, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	var utxos []*lnwallet.Utxo
+	for _, utxo := range m.utxos {
+		if _, ok := m.leased[utxo.OutPoint]; ok {
+			continue
+		}
+		utxos = append(utxos, utxo)
+	}
+
+	return utxos, nil
+}
+
+func (m *mockOutputLeaser) isLeased(o wire.OutPoint) bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.leased[o] == WalletInputLockID
+}
+
+// expireLeases drops all leases, as if they expired.
+func (m *mockOutputLeaser) expireLeases() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.leased = make(map[wire.OutPoint]lnwallet.LockID)
+}
+
+// consolidatorTestContext houses a Consolidator along with its mocked
+// dependencies.
+type consolidatorTestContext struct {
+	t *testing.T
+
+	consolidator *Consolidator
+	feeEstimator *mockFeeEstimator
+	leaser       *mockOutputLeaser
+
+	sweptInputs chan input.Input
+	feePrefs    chan FeePreference
+	results     map[wire.OutPoint]chan Result
+}
+
+func newConsolidatorTestContext(t *testing.T,
+	utxos []*lnwallet.Utxo) *consolidatorTestContext {
+
+	ctx := &consolidatorTestContext{
+		t:            t,
+		feeEstimator: newMockFeeEstimator(250, 253),
+		leaser:       newMockOutputLeaser(utxos),
+		sweptInputs:  make(chan input.Input, len(utxos)),
+		feePrefs:     make(chan FeePreference, len(utxos)),
+		results:      make(map[wire.OutPoint]chan Result),
+	}
+
+	ctx.consolidator = NewConsolidator(&ConsolidatorConfig{
+		FeeEstimator:     ctx.feeEstimator,
+		ConfTarget:       DefaultConsolidationConfTarget,
+		MaxFeeRate:       500,
+		MinInputs:        3,
+		MaxInputs:        4,
+		CoinSelectLocker: &mockCoinSelectionLocker{},
+		UtxoSource:       ctx.leaser,
+		OutputLeaser:     ctx.leaser,
+		SweepInput: func(inp input.Input,
+			feePref FeePreference) (chan Result, error) {
+
+			resultChan := make(chan Result, 1)
+			ctx.results[*inp.OutPoint()] = resultChan
+			ctx.sweptInputs <- inp
+			ctx.feePrefs <- feePref
+
+			return resultChan, nil
+		},
+	})
+	if err := ctx.consolidator.Start(); err != nil {
+		t.Fatalf("unable to start consolidator: %v", err)
+	}
+
+	return ctx
+}
+
+// assertSwept asserts that exactly the given UTXOs have been handed off to the
+// sweeper at the given fee rate, and that they've been leased.
+func (ctx *consolidatorTestContext) assertSwept(utxos []*lnwallet.Utxo,
+	feeRate lnwallet.SatPerKWeight) {
+
+	ctx.t.Helper()
+
+	for _, utxo := range utxos {
+		inp := <-ctx.sweptInputs
+		feePref := <-ctx.feePrefs
+
+		if *inp.OutPoint() != utxo.OutPoint {
+			ctx.t.Fatalf("expected utxo %v to be swept, got %v",
+				utxo.OutPoint, inp.OutPoint())
+		}
+		if feePref.FeeRate != feeRate {
+			ctx.t.Fatalf("expected fee rate %v, got %v", feeRate,
+				feePref.FeeRate)
+		}
+		if !ctx.leaser.isLeased(utxo.OutPoint) {
+			ctx.t.Fatalf("expected utxo %v to be leased",
+				utxo.OutPoint)
+		}
+		if !isWalletInput(inp) {
+			ctx.t.Fatalf("expected utxo %v to be swept as "+
+				"wallet input", utxo.OutPoint)
+		}
+	}
+
+	select {
+	case inp := <-ctx.sweptInputs:
+		ctx.t.Fatalf("unexpected utxo %v swept", inp.OutPoint())
+	default:
+	}
+}
+
+// makeConsolidationUtxos creates p2wkh wallet UTXOs of the given values.
+func makeConsolidationUtxos(values ...btcutil.Amount) []*lnwallet.Utxo {
+	utxos := make([]*lnwallet.Utxo, 0, len(values))
+	for i, value := range values {
+		utxos = append(utxos, &lnwallet.Utxo{
+			AddressType: lnwallet.WitnessPubKey,
+			PkScript:    sweepScript,
+			Value:       value,
+			OutPoint: wire.OutPoint{
+				Index: uint32(i),
+			},
+		})
+	}
+
+	return utxos
+}
+
+// TestConsolidatorPreview ensures a consolidation selects the smallest UTXOs
+// worth spending, up to the maximum number of inputs, and reports why it would
+// be skipped.
+func TestConsolidatorPreview(t *testing.T) {
+	t.Parallel()
+
+	utxos := makeConsolidationUtxos(5000, 50, 3000, 1000, 4000, 2000)
+	ctx := newConsolidatorTestContext(t, utxos)
+	defer ctx.consolidator.Stop()
+
+	// The dust UTXO costs more to spend than it's worth, while the largest
+	// one exceeds the maximum number of inputs.
+	consolidation, err := ctx.consolidator.Preview()
+	if err != nil {
+		t.Fatalf("unable to preview consolidation: %v", err)
+	}
+	if consolidation.Skipped != nil {
+		t.Fatalf("unexpected skip reason: %v", consolidation.Skipped)
+	}
+
+	expectedInputs := []btcutil.Amount{1000, 2000, 3000, 4000}
+	if len(consolidation.Inputs) != len(expectedInputs) {
+		t.Fatalf("expected %d inputs, got %d", len(expectedInputs),
+			len(consolidation.Inputs))
+	}
+	for i, utxo := range consolidation.Inputs {
+		if utxo.Value != expectedInputs[i] {
+			t.Fatalf("expected input %d to be worth %v, got %v",
+				i, expectedInputs[i], utxo.Value)
+		}
+	}
+	if consolidation.TotalAmount != 10000 {
+		t.Fatalf("expected total amount of 10000, got %v",
+			consolidation.TotalAmount)
+	}
+	if consolidation.FeeRate != 250 || consolidation.Fee == 0 {
+		t.Fatalf("unexpected fee rate %v and fee %v",
+			consolidation.FeeRate, consolidation.Fee)
+	}
+
+	// Once the fee rate exceeds the maximum, the consolidation should be
+	// skipped. Previewing it must not sweep anything.
+	ctx.feeEstimator.updateFees(1000, 253)
+
+	consolidation, err = ctx.consolidator.Preview()
+	if err != nil {
+		t.Fatalf("unable to preview consolidation: %v", err)
+	}
+	if consolidation.Skipped != ErrConsolidationFeeTooHigh {
+		t.Fatalf("expected fee rate to be too high, got %v",
+			consolidation.Skipped)
+	}
+
+	ctx.assertSwept(nil, 0)
+}
+
+// TestConsolidatorConsolidate ensures consolidations respect the configured
+// conditions unless forced, and that the UTXOs handed off to the sweeper are
+// leased.
+func TestConsolidatorConsolidate(t *testing.T) {
+	t.Parallel()
+
+	utxos := makeConsolidationUtxos(1000, 2000, 3000, 4000, 5000)
+	ctx := newConsolidatorTestContext(t, utxos)
+	defer ctx.consolidator.Stop()
+
+	// A high fee rate should prevent the consolidation, unless forced.
+	ctx.feeEstimator.updateFees(1000, 253)
+
+	_, err := ctx.consolidator.Consolidate(false)
+	if err != ErrConsolidationFeeTooHigh {
+		t.Fatalf("expected fee rate to be too high, got %v", err)
+	}
+	ctx.assertSwept(nil, 0)
+
+	consolidation, err := ctx.consolidator.Consolidate(true)
+	if err != nil {
+		t.Fatalf("unable to force consolidation: %v", err)
+	}
+	ctx.assertSwept(consolidation.Inputs, 1000)
+	if len(consolidation.Inputs) != 4 {
+		t.Fatalf("expected 4 inputs, got %d",
+			len(consolidation.Inputs))
+	}
+
+	// The leased UTXOs should no longer be available for coin selection.
+	unspent, err := ctx.leaser.ListUnspentWitnessFromDefaultAccount(
+		1, math.MaxInt32,
+	)
+	if err != nil {
+		t.Fatalf("unable to list unspent: %v", err)
+	}
+	if len(unspent) != 1 || unspent[0].OutPoint != utxos[4].OutPoint {
+		t.Fatalf("expected only utxo %v to be unspent, got %v",
+			utxos[4].OutPoint, unspent)
+	}
+
+	// Releasing the UTXOs is left to the sweeper, so they should remain
+	// leased regardless of the outcome of their sweep, also once the
+	// consolidator is stopped.
+	ctx.results[consolidation.Inputs[0].OutPoint] <- Result{
+		Err: ErrTooManyAttempts,
+	}
+	ctx.results[consolidation.Inputs[1].OutPoint] <- Result{
+		Tx: &wire.MsgTx{},
+	}
+	if err := ctx.consolidator.Stop(); err != nil {
+		t.Fatalf("unable to stop consolidator: %v", err)
+	}
+	for _, utxo := range consolidation.Inputs {
+		if !ctx.leaser.isLeased(utxo.OutPoint) {
+			t.Fatalf("expected utxo %v to remain leased",
+				utxo.OutPoint)
+		}
+	}
+
+	// Consolidations requested after the consolidator has been stopped
+	// should be refused.
+	_, err = ctx.consolidator.Consolidate(true)
+	if err != ErrConsolidatorShuttingDown {
+		t.Fatalf("expected consolidator to be shutting down, got %v",
+			err)
+	}
+}
+
+// TestConsolidatorTooFewInputs ensures no consolidation takes place if the
+// wallet doesn't have enough UTXOs worth merging.
+func TestConsolidatorTooFewInputs(t *testing.T) {
+	t.Parallel()
+
+	utxos := makeConsolidationUtxos(1000, 50, 2000)
+	ctx := newConsolidatorTestContext(t, utxos)
+	defer ctx.consolidator.Stop()
+
+	_, err := ctx.consolidator.Consolidate(false)
+	if err != ErrTooFewConsolidationInputs {
+		t.Fatalf("expected too few inputs, got %v", err)
+	}
+	ctx.assertSwept(nil, 0)
+
+	// Forcing the consolidation should merge the two UTXOs worth
+	// spending.
+	consolidation, err := ctx.consolidator.Consolidate(true)
+	if err != nil {
+		t.Fatalf("unable to force consolidation: %v", err)
+	}
+	ctx.assertSwept(consolidation.Inputs, 250)
+	if len(consolidation.Inputs) != 2 {
+		t.Fatalf("expected 2 inputs, got %d",
+			len(consolidation.Inputs))
+	}
+}
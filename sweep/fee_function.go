@@ -0,0 +1,75 @@
+package sweep
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+const (
+	// DefaultDeadlineBucketSize is the default number of blocks spanned by
+	// a deadline bucket. Inputs with deadlines within the same bucket are
+	// considered compatible and may be swept within the same transaction.
+	DefaultDeadlineBucketSize = 6
+)
+
+// FeeFunction determines the fee rate of an input that must be swept before
+// its deadline. The fee rate moves from the starting rate, determined by the
+// input's fee preference, to the ending rate, determined by its budget, as the
+// deadline approaches. The progress is the fraction of blocks between the
+// input being offered to the UtxoSweeper and its deadline that have passed,
+// within [0, 1].
+type FeeFunction func(startRate, endRate lnwallet.SatPerKWeight,
+	progress float64) lnwallet.SatPerKWeight
+
+// LinearFeeFunction raises the fee rate by the same amount every block.
+func LinearFeeFunction(startRate, endRate lnwallet.SatPerKWeight,
+	progress float64) lnwallet.SatPerKWeight {
+
+	delta := float64(endRate-startRate) * progress
+	return startRate + lnwallet.SatPerKWeight(delta)
+}
+
+// ExponentialFeeFunction raises the fee rate by the same factor every block,
+// keeping it low for most of the time until the deadline and spending most of
+// the budget in the final blocks.
+func ExponentialFeeFunction(startRate, endRate lnwallet.SatPerKWeight,
+	progress float64) lnwallet.SatPerKWeight {
+
+	if startRate <= 0 {
+		return LinearFeeFunction(startRate, endRate, progress)
+	}
+
+	factor := math.Pow(float64(endRate)/float64(startRate), progress)
+	return lnwallet.SatPerKWeight(float64(startRate) * factor)
+}
+
+// FeeFunctionFromName returns the fee function with the given name, which is
+// either "linear" or "exponential".
+func FeeFunctionFromName(name string) (FeeFunction, error) {
+	switch name {
+	case "linear":
+		return LinearFeeFunction, nil
+
+	case "exponential":
+		return ExponentialFeeFunction, nil
+
+	default:
+		return nil, fmt.Errorf("unknown fee function %q", name)
+	}
+}
+
+// deadlineProgress returns the fraction of blocks between the start height and
+// the deadline height that have passed at the given height, within [0, 1].
+func deadlineProgress(startHeight, deadlineHeight, height int32) float64 {
+	if height >= deadlineHeight || deadlineHeight <= startHeight {
+		return 1
+	}
+	if height <= startHeight {
+		return 0
+	}
+
+	return float64(height-startHeight) /
+		float64(deadlineHeight-startHeight)
+}
@@ -0,0 +1,81 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestFeeFunctions ensures the fee functions move from the starting to the
+// ending fee rate along their curve as the deadline approaches.
+func TestFeeFunctions(t *testing.T) {
+	t.Parallel()
+
+	const (
+		startHeight    = 100
+		deadlineHeight = 104
+	)
+
+	tests := []struct {
+		name        string
+		feeFunction FeeFunction
+		startRate   lnwallet.SatPerKWeight
+		endRate     lnwallet.SatPerKWeight
+
+		// expectedRates are the fee rates expected at the heights
+		// from startHeight up to one block past deadlineHeight.
+		expectedRates []lnwallet.SatPerKWeight
+	}{
+		{
+			name:        "linear",
+			feeFunction: LinearFeeFunction,
+			startRate:   1000,
+			endRate:     5000,
+			expectedRates: []lnwallet.SatPerKWeight{
+				1000, 2000, 3000, 4000, 5000, 5000,
+			},
+		},
+		{
+			name:        "exponential",
+			feeFunction: ExponentialFeeFunction,
+			startRate:   1000,
+			endRate:     16000,
+			expectedRates: []lnwallet.SatPerKWeight{
+				1000, 2000, 4000, 8000, 16000, 16000,
+			},
+		},
+		{
+			name:        "constant",
+			feeFunction: ExponentialFeeFunction,
+			startRate:   1000,
+			endRate:     1000,
+			expectedRates: []lnwallet.SatPerKWeight{
+				1000, 1000, 1000, 1000, 1000, 1000,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			for i, expected := range test.expectedRates {
+				height := int32(startHeight + i)
+				progress := deadlineProgress(
+					startHeight, deadlineHeight, height,
+				)
+				feeRate := test.feeFunction(
+					test.startRate, test.endRate, progress,
+				)
+
+				// The exponential curve is subject to floating
+				// point rounding.
+				diff := feeRate - expected
+				if diff < -1 || diff > 1 {
+					t.Fatalf("expected fee rate %v at "+
+						"height %v, got %v", expected,
+						height, feeRate)
+				}
+			}
+		})
+	}
+}
@@ -5,10 +5,14 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
 var (
@@ -27,6 +31,13 @@ var (
 	// maps: txHash -> empty slice
 	txHashesBucketKey = []byte("sweeper-tx-hashes")
 
+	// sweepRecordsBucketKey is the key that points to a bucket containing
+	// the records of all inputs offered to the sweeper, both pending and
+	// completed ones.
+	//
+	// maps: outpoint -> serialized_sweep_record
+	sweepRecordsBucketKey = []byte("sweeper-records")
+
 	// utxnChainPrefix is the bucket prefix for nursery buckets.
 	utxnChainPrefix = []byte("utxn")
 
@@ -39,8 +50,99 @@ var (
 	utxnFinalizedKndrTxnKey = []byte("finalized-kndr-txn")
 
 	byteOrder = binary.BigEndian
+
+	// ErrUnsupportedWitnessType is returned when attempting to persist an
+	// input with a witness type that can't be serialized.
+	ErrUnsupportedWitnessType = errors.New("unsupported witness type")
+)
+
+// SweepOutcome describes the state of the sweep of an input.
+type SweepOutcome uint8
+
+const (
+	// SweepPending indicates the input is still being swept.
+	SweepPending SweepOutcome = iota
+
+	// SweepSucceeded indicates the input was swept by one of our
+	// transactions.
+	SweepSucceeded
+
+	// SweepRemoteSpend indicates the input was spent by a transaction of
+	// the remote party.
+	SweepRemoteSpend
+
+	// SweepFailed indicates we gave up on sweeping the input.
+	SweepFailed
 )
 
+// String returns a human-readable string of the outcome.
+func (o SweepOutcome) String() string {
+	switch o {
+	case SweepPending:
+		return "pending"
+
+	case SweepSucceeded:
+		return "succeeded"
+
+	case SweepRemoteSpend:
+		return "remote spend"
+
+	case SweepFailed:
+		return "failed"
+
+	default:
+		return "unknown"
+	}
+}
+
+// SweepRecord describes an input offered to the UtxoSweeper along with the
+// state of its sweep. Records of pending inputs allow the UtxoSweeper to
+// resume sweeping them after a restart, while those of completed sweeps make
+// up the sweep history.
+type SweepRecord struct {
+	// Input is the input being swept.
+	Input input.Input
+
+	// FeePreference is the current fee preference of the input.
+	FeePreference FeePreference
+
+	// StartHeight is the height from which the fee rate of an input with
+	// a deadline is raised.
+	StartHeight int32
+
+	// PublishAttempts is the number of sweep transactions spending the
+	// input we've published.
+	PublishAttempts int
+
+	// MinPublishHeight is the height from which the input may be swept
+	// again.
+	MinPublishHeight int32
+
+	// LastFeeRate is the fee rate of the last sweep transaction spending
+	// the input we've published.
+	LastFeeRate lnwallet.SatPerKWeight
+
+	// LastTxid is the hash of the last sweep transaction spending the
+	// input we've published.
+	LastTxid chainhash.Hash
+
+	// Outcome is the state of the sweep.
+	Outcome SweepOutcome
+
+	// SpendTxid is the hash of the transaction that spent the input, if
+	// any.
+	SpendTxid chainhash.Hash
+
+	// Fee is the fee paid by our sweep transaction that spent the input,
+	// shared with the other inputs it spent. It's only known if the
+	// input was swept successfully.
+	Fee btcutil.Amount
+
+	// Group is the sweep group of the input. Only inputs of the same group
+	// are swept together.
+	Group uint64
+}
+
 // SweeperStore stores published txes.
 type SweeperStore interface {
 	// IsOurTx determines whether a tx is published by us, based on its
@@ -53,6 +155,14 @@ type SweeperStore interface {
 	// GetLastPublishedTx returns the last tx that we called NotifyPublishTx
 	// for.
 	GetLastPublishedTx() (*wire.MsgTx, error)
+
+	// PutSweepRecord stores the record of an input, replacing any
+	// previous record of it.
+	PutSweepRecord(*SweepRecord) error
+
+	// FetchSweepRecords returns the records of all inputs that were
+	// offered to the sweeper.
+	FetchSweepRecords() ([]*SweepRecord, error)
 }
 
 type sweeperStore struct {
@@ -71,6 +181,11 @@ func NewSweeperStore(db *bbolt.DB, chainHash *chainhash.Hash) (
 			return err
 		}
 
+		_, err = tx.CreateBucketIfNotExists(sweepRecordsBucketKey)
+		if err != nil {
+			return err
+		}
+
 		if tx.Bucket(txHashesBucketKey) != nil {
 			return nil
 		}
@@ -242,5 +357,264 @@ func (s *sweeperStore) IsOurTx(hash chainhash.Hash) (bool, error) {
 	return ours, nil
 }
 
+// PutSweepRecord stores the record of an input, replacing any previous record
+// of it.
+func (s *sweeperStore) PutSweepRecord(record *SweepRecord) error {
+	var key bytes.Buffer
+	if err := writeOutpoint(&key, record.Input.OutPoint()); err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := serializeSweepRecord(&b, record); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		recordsBucket := tx.Bucket(sweepRecordsBucketKey)
+		if recordsBucket == nil {
+			return errors.New("sweep records bucket does not " +
+				"exist")
+		}
+
+		return recordsBucket.Put(key.Bytes(), b.Bytes())
+	})
+}
+
+// FetchSweepRecords returns the records of all inputs that were offered to
+// the sweeper.
+func (s *sweeperStore) FetchSweepRecords() ([]*SweepRecord, error) {
+	var records []*SweepRecord
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		recordsBucket := tx.Bucket(sweepRecordsBucketKey)
+		if recordsBucket == nil {
+			return errors.New("sweep records bucket does not " +
+				"exist")
+		}
+
+		return recordsBucket.ForEach(func(k, v []byte) error {
+			var outpoint wire.OutPoint
+			err := readOutpoint(bytes.NewReader(k), &outpoint)
+			if err != nil {
+				return err
+			}
+
+			record, err := deserializeSweepRecord(
+				bytes.NewReader(v), &outpoint,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to deserialize "+
+					"record of %v: %v", outpoint, err)
+			}
+
+			records = append(records, record)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// writeOutpoint serializes an outpoint as its hash followed by its index.
+func writeOutpoint(w io.Writer, o *wire.OutPoint) error {
+	if _, err := w.Write(o.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Write(w, byteOrder, o.Index)
+}
+
+// readOutpoint deserializes an outpoint written by writeOutpoint.
+func readOutpoint(r io.Reader, o *wire.OutPoint) error {
+	if _, err := io.ReadFull(r, o.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Read(r, byteOrder, &o.Index)
+}
+
+// serializeSweepRecord serializes a sweep record, except for the outpoint of
+// its input which is used as its key. Only inputs with a standard witness type
+// can be serialized.
+func serializeSweepRecord(w io.Writer, record *SweepRecord) error {
+	inp := record.Input
+	witnessType, ok := inp.WitnessType().(input.StandardWitnessType)
+	if !ok {
+		return ErrUnsupportedWitnessType
+	}
+
+	// Inputs that require a pre-image to be spent need it to be stored
+	// along with them.
+	var preimage []byte
+	if htlcInput, ok := inp.(*input.HtlcSucceedInput); ok {
+		preimage = htlcInput.Preimage()
+	}
+
+	err := binary.Write(w, byteOrder, uint16(witnessType))
+	if err != nil {
+		return err
+	}
+	if err := input.WriteSignDescriptor(w, inp.SignDesc()); err != nil {
+		return err
+	}
+	if err := wire.WriteVarBytes(w, 0, preimage); err != nil {
+		return err
+	}
+
+	feePref := record.FeePreference
+	for _, v := range []interface{}{
+		inp.HeightHint(),
+		inp.BlocksToMaturity(),
+		feePref.ConfTarget,
+		int64(feePref.FeeRate),
+		feePref.DeadlineHeight,
+		int64(feePref.Budget),
+		record.StartHeight,
+		uint32(record.PublishAttempts),
+		record.MinPublishHeight,
+		int64(record.LastFeeRate),
+		record.LastTxid,
+		record.Outcome,
+		record.SpendTxid,
+		int64(record.Fee),
+		record.Group,
+		isWalletInput(inp),
+	} {
+		if err := binary.Write(w, byteOrder, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeSweepRecord deserializes a sweep record of the input with the
+// given outpoint.
+func deserializeSweepRecord(r io.Reader,
+	outpoint *wire.OutPoint) (*SweepRecord, error) {
+
+	var witnessType uint16
+	if err := binary.Read(r, byteOrder, &witnessType); err != nil {
+		return nil, err
+	}
+
+	var signDesc input.SignDescriptor
+	if err := input.ReadSignDescriptor(r, &signDesc); err != nil {
+		return nil, err
+	}
+
+	preimage, err := wire.ReadVarBytes(r, 0, 32, "preimage")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		record                       SweepRecord
+		heightHint, blocksToMaturity uint32
+		feeRate, budget, lastFeeRate int64
+		fee                          int64
+		publishAttempts              uint32
+	)
+	for _, v := range []interface{}{
+		&heightHint,
+		&blocksToMaturity,
+		&record.FeePreference.ConfTarget,
+		&feeRate,
+		&record.FeePreference.DeadlineHeight,
+		&budget,
+		&record.StartHeight,
+		&publishAttempts,
+		&record.MinPublishHeight,
+		&lastFeeRate,
+		&record.LastTxid,
+		&record.Outcome,
+		&record.SpendTxid,
+		&fee,
+	} {
+		if err := binary.Read(r, byteOrder, v); err != nil {
+			return nil, err
+		}
+	}
+
+	// Records stored before inputs could be assigned to a sweep group end
+	// here, their inputs belong to the default group.
+	err = binary.Read(r, byteOrder, &record.Group)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	// Similarly, the inputs of records stored before wallet inputs were
+	// marked as such are restored as regular inputs.
+	var walletUtxo bool
+	err = binary.Read(r, byteOrder, &walletUtxo)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	record.FeePreference.FeeRate = lnwallet.SatPerKWeight(feeRate)
+	record.FeePreference.Budget = btcutil.Amount(budget)
+	record.PublishAttempts = int(publishAttempts)
+	record.LastFeeRate = lnwallet.SatPerKWeight(lastFeeRate)
+	record.Fee = btcutil.Amount(fee)
+
+	// Inputs with a pre-image are restored as such, wallet inputs are
+	// marked as spending a UTXO of the wallet, while all others are spent
+	// according to their witness type alone.
+	switch {
+	case len(preimage) > 0:
+		htlcInput := input.MakeHtlcSucceedInput(
+			outpoint, &signDesc, preimage, heightHint,
+		)
+		record.Input = &htlcInput
+
+	case walletUtxo:
+		record.Input = &walletInput{
+			BaseInput: input.MakeBaseInput(
+				outpoint,
+				input.StandardWitnessType(witnessType),
+				&signDesc, heightHint,
+			),
+		}
+
+	default:
+		record.Input = &persistedInput{
+			BaseInput: input.MakeBaseInput(
+				outpoint,
+				input.StandardWitnessType(witnessType),
+				&signDesc, heightHint,
+			),
+			blocksToMaturity: blocksToMaturity,
+		}
+	}
+
+	return &record, nil
+}
+
+// isWalletInput returns true if the input spends a UTXO of the wallet.
+func isWalletInput(inp input.Input) bool {
+	_, ok := inp.(*walletInput)
+	return ok
+}
+
+// persistedInput is an input restored from the SweeperStore. It's spent
+// according to its witness type, and retains the relative time lock it was
+// originally offered with.
+type persistedInput struct {
+	input.BaseInput
+
+	blocksToMaturity uint32
+}
+
+// BlocksToMaturity returns the relative timelock, as a number of blocks, that
+// must be built on top of the confirmation height before the output can be
+// spent.
+func (p *persistedInput) BlocksToMaturity() uint32 {
+	return p.blocksToMaturity
+}
+
 // Compile-time constraint to ensure sweeperStore implements SweeperStore.
 var _ SweeperStore = (*sweeperStore)(nil)
@@ -1,6 +1,8 @@
 package sweep
 
 import (
+	"sync"
+
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 )
@@ -8,26 +10,35 @@ import (
 // MockSweeperStore is a mock implementation of sweeper store. This type is
 // exported, because it is currently used in nursery tests too.
 type MockSweeperStore struct {
+	mtx     sync.Mutex
 	lastTx  *wire.MsgTx
 	ourTxes map[chainhash.Hash]struct{}
+	records map[wire.OutPoint]SweepRecord
 }
 
 // NewMockSweeperStore returns a new instance.
 func NewMockSweeperStore() *MockSweeperStore {
 	return &MockSweeperStore{
 		ourTxes: make(map[chainhash.Hash]struct{}),
+		records: make(map[wire.OutPoint]SweepRecord),
 	}
 }
 
 // IsOurTx determines whether a tx is published by us, based on its
 // hash.
 func (s *MockSweeperStore) IsOurTx(hash chainhash.Hash) (bool, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
 	_, ok := s.ourTxes[hash]
 	return ok, nil
 }
 
 // NotifyPublishTx signals that we are about to publish a tx.
 func (s *MockSweeperStore) NotifyPublishTx(tx *wire.MsgTx) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
 	txHash := tx.TxHash()
 	s.ourTxes[txHash] = struct{}{}
 	s.lastTx = tx
@@ -38,8 +49,37 @@ func (s *MockSweeperStore) NotifyPublishTx(tx *wire.MsgTx) error {
 // GetLastPublishedTx returns the last tx that we called NotifyPublishTx
 // for.
 func (s *MockSweeperStore) GetLastPublishedTx() (*wire.MsgTx, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
 	return s.lastTx, nil
 }
 
+// PutSweepRecord stores the record of an input, replacing any previous record
+// of it.
+func (s *MockSweeperStore) PutSweepRecord(record *SweepRecord) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.records[*record.Input.OutPoint()] = *record
+
+	return nil
+}
+
+// FetchSweepRecords returns the records of all inputs that were offered to
+// the sweeper.
+func (s *MockSweeperStore) FetchSweepRecords() ([]*SweepRecord, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	records := make([]*SweepRecord, 0, len(s.records))
+	for _, record := range s.records {
+		record := record
+		records = append(records, &record)
+	}
+
+	return records, nil
+}
+
 // Compile-time constraint to ensure MockSweeperStore implements SweeperStore.
 var _ SweeperStore = (*MockSweeperStore)(nil)
@@ -1,6 +1,7 @@
 package sweep
 
 import (
+	"bytes"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -8,6 +9,8 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
 )
 
 // makeTestDB creates a new instance of the ChannelDB for testing purposes. A
@@ -151,3 +154,226 @@ func testStore(t *testing.T, createStore func() (SweeperStore, error)) {
 		t.Fatal("expected tx to be not ours")
 	}
 }
+
+// TestStoreSweepRecords asserts that the store persists the records of swept
+// inputs and is able to retrieve them again.
+func TestStoreSweepRecords(t *testing.T) {
+	t.Run("bolt", func(t *testing.T) {
+		cdb, cleanUp, err := makeTestDB()
+		if err != nil {
+			t.Fatalf("unable to open channel db: %v", err)
+		}
+		defer cleanUp()
+
+		testStoreSweepRecords(t, func() (SweeperStore, error) {
+			var chain chainhash.Hash
+			return NewSweeperStore(cdb.DB, &chain)
+		})
+	})
+	t.Run("mock", func(t *testing.T) {
+		store := NewMockSweeperStore()
+
+		testStoreSweepRecords(t, func() (SweeperStore, error) {
+			return store, nil
+		})
+	})
+}
+
+func testStoreSweepRecords(t *testing.T,
+	createStore func() (SweeperStore, error)) {
+
+	store, err := createStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Initially we expect the store not to have any records.
+	records, err := store.FetchSweepRecords()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 0 {
+		t.Fatalf("expected no records, got %v", len(records))
+	}
+
+	signDesc := &input.SignDescriptor{
+		Output: &wire.TxOut{
+			Value:    10000,
+			PkScript: []byte{0x00, 0x14},
+		},
+		KeyDesc: keychain.KeyDescriptor{
+			PubKey: testPubKey,
+		},
+	}
+
+	baseInput := input.NewBaseInput(
+		&wire.OutPoint{Index: 1}, input.CommitmentTimeLock, signDesc,
+		100,
+	)
+	htlcInput := input.MakeHtlcSucceedInput(
+		&wire.OutPoint{Index: 2}, signDesc, []byte{1, 2, 3}, 101,
+	)
+	walletUtxo := &walletInput{
+		BaseInput: input.MakeBaseInput(
+			&wire.OutPoint{Index: 3}, input.WitnessKeyHash,
+			signDesc, 102,
+		),
+	}
+
+	pendingRecord := &SweepRecord{
+		Input: baseInput,
+		FeePreference: FeePreference{
+			ConfTarget:     6,
+			DeadlineHeight: 120,
+			Budget:         5000,
+		},
+		StartHeight:      100,
+		PublishAttempts:  2,
+		MinPublishHeight: 103,
+		LastFeeRate:      2500,
+		LastTxid:         chainhash.Hash{1},
+		Outcome:          SweepPending,
+	}
+	sweptRecord := &SweepRecord{
+		Input:           &htlcInput,
+		FeePreference:   FeePreference{FeeRate: 1000},
+		StartHeight:     101,
+		PublishAttempts: 1,
+		LastFeeRate:     1000,
+		LastTxid:        chainhash.Hash{2},
+		Outcome:         SweepSucceeded,
+		SpendTxid:       chainhash.Hash{2},
+		Fee:             300,
+	}
+	walletRecord := &SweepRecord{
+		Input:         walletUtxo,
+		FeePreference: FeePreference{FeeRate: 500},
+		StartHeight:   102,
+		Outcome:       SweepPending,
+	}
+
+	// Store the first record twice, to assert that the latest version of
+	// it replaces the previous one.
+	if err := store.PutSweepRecord(pendingRecord); err != nil {
+		t.Fatal(err)
+	}
+	pendingRecord.PublishAttempts = 3
+	if err := store.PutSweepRecord(pendingRecord); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.PutSweepRecord(sweptRecord); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.PutSweepRecord(walletRecord); err != nil {
+		t.Fatal(err)
+	}
+
+	// Recreate the sweeper store and assert that all records are
+	// retrieved.
+	store, err = createStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records, err = store.FetchSweepRecords()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %v", len(records))
+	}
+
+	for _, record := range records {
+		var expected *SweepRecord
+		switch *record.Input.OutPoint() {
+		case *baseInput.OutPoint():
+			expected = pendingRecord
+		case *htlcInput.OutPoint():
+			expected = sweptRecord
+		case *walletUtxo.OutPoint():
+			expected = walletRecord
+		default:
+			t.Fatalf("unexpected record for %v",
+				record.Input.OutPoint())
+		}
+
+		assertSweepRecord(t, expected, record)
+	}
+}
+
+// assertSweepRecord asserts that the given record matches the expected one.
+func assertSweepRecord(t *testing.T, expected, record *SweepRecord) {
+	t.Helper()
+
+	if record.Input.WitnessType() != expected.Input.WitnessType() {
+		t.Fatalf("expected witness type %v, got %v",
+			expected.Input.WitnessType(),
+			record.Input.WitnessType())
+	}
+	if record.Input.HeightHint() != expected.Input.HeightHint() {
+		t.Fatalf("expected height hint %v, got %v",
+			expected.Input.HeightHint(), record.Input.HeightHint())
+	}
+	if record.Input.BlocksToMaturity() !=
+		expected.Input.BlocksToMaturity() {
+
+		t.Fatalf("expected blocks to maturity %v, got %v",
+			expected.Input.BlocksToMaturity(),
+			record.Input.BlocksToMaturity())
+	}
+
+	if isWalletInput(record.Input) != isWalletInput(expected.Input) {
+		t.Fatalf("expected wallet input %v, got %v",
+			isWalletInput(expected.Input),
+			isWalletInput(record.Input))
+	}
+
+	signDesc := record.Input.SignDesc()
+	expectedSignDesc := expected.Input.SignDesc()
+	if signDesc.Output.Value != expectedSignDesc.Output.Value ||
+		!bytes.Equal(signDesc.Output.PkScript,
+			expectedSignDesc.Output.PkScript) {
+
+		t.Fatalf("expected output %v, got %v",
+			expectedSignDesc.Output, signDesc.Output)
+	}
+
+	if expectedHtlc, ok := expected.Input.(*input.HtlcSucceedInput); ok {
+		htlc, ok := record.Input.(*input.HtlcSucceedInput)
+		if !ok {
+			t.Fatalf("expected htlc succeed input, got %T",
+				record.Input)
+		}
+		if !bytes.Equal(htlc.Preimage(), expectedHtlc.Preimage()) {
+			t.Fatalf("expected preimage %x, got %x",
+				expectedHtlc.Preimage(), htlc.Preimage())
+		}
+	}
+
+	if record.FeePreference != expected.FeePreference {
+		t.Fatalf("expected fee preference %v, got %v",
+			expected.FeePreference, record.FeePreference)
+	}
+	if record.StartHeight != expected.StartHeight ||
+		record.PublishAttempts != expected.PublishAttempts ||
+		record.MinPublishHeight != expected.MinPublishHeight {
+
+		t.Fatalf("expected record %v, got %v", expected, record)
+	}
+	if record.LastFeeRate != expected.LastFeeRate ||
+		record.LastTxid != expected.LastTxid {
+
+		t.Fatalf("expected last tx %v at %v, got %v at %v",
+			expected.LastTxid, expected.LastFeeRate,
+			record.LastTxid, record.LastFeeRate)
+	}
+	if record.Outcome != expected.Outcome ||
+		record.SpendTxid != expected.SpendTxid ||
+		record.Fee != expected.Fee {
+
+		t.Fatalf("expected outcome %v by %v with fee %v, got %v by "+
+			"%v with fee %v", expected.Outcome, expected.SpendTxid,
+			expected.Fee, record.Outcome, record.SpendTxid,
+			record.Fee)
+	}
+}
@@ -10,11 +10,13 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
@@ -61,6 +63,10 @@ var (
 	DefaultMaxSweepAttempts = 10
 )
 
+// DefaultSweepGroup is the sweep group of inputs that are offered without one.
+// Inputs of this group may be batched with any other input of the group.
+const DefaultSweepGroup uint64 = 0
+
 // pendingInput is created when an input reaches the main loop for the first
 // time. It tracks all relevant state that is needed for sweeping.
 type pendingInput struct {
@@ -93,6 +99,19 @@ type pendingInput struct {
 	// lastFeeRate is the most recent fee rate used for this input within a
 	// transaction broadcast to the network.
 	lastFeeRate lnwallet.SatPerKWeight
+
+	// startHeight is the height at which the input was offered to the
+	// UtxoSweeper, or at which its fee preference was last bumped. If the
+	// input has a deadline, its fee rate is raised from this height on.
+	startHeight int32
+
+	// lastTxid is the hash of the most recent transaction spending this
+	// input that was broadcast to the network.
+	lastTxid chainhash.Hash
+
+	// group is the sweep group of the input. Inputs of different groups
+	// are never swept in the same transaction.
+	group uint64
 }
 
 // pendingInputs is a type alias for a set of pending inputs.
@@ -105,6 +124,14 @@ type inputCluster struct {
 	inputs       pendingInputs
 }
 
+// clusterKey identifies the cluster of an input, which groups inputs of the
+// same sweep group with similar fee rates and compatible deadlines together.
+type clusterKey struct {
+	feeRateBucket  lnwallet.SatPerKWeight
+	deadlineBucket int32
+	group          uint64
+}
+
 // pendingSweepsReq is an internal message we'll use to represent an external
 // caller's intent to retrieve all of the pending inputs the UtxoSweeper is
 // attempting to sweep.
@@ -135,6 +162,23 @@ type PendingInput struct {
 	// NextBroadcastHeight is the next height of the chain at which we'll
 	// attempt to broadcast a transaction sweeping the input.
 	NextBroadcastHeight uint32
+
+	// DeadlineHeight is the height by which the input should be confirmed,
+	// or zero if it doesn't have a deadline.
+	DeadlineHeight int32
+
+	// Budget is the maximum fee the input is willing to pay if it has a
+	// deadline.
+	Budget btcutil.Amount
+
+	// CurrentFeeRate is the fee rate the input would be swept with at the
+	// current height.
+	CurrentFeeRate lnwallet.SatPerKWeight
+
+	// NextFeeRate is the fee rate the input would be swept with at the
+	// next height. It only differs from CurrentFeeRate for inputs with a
+	// deadline.
+	NextFeeRate lnwallet.SatPerKWeight
 }
 
 // bumpFeeReq is an internal message we'll use to represent an external caller's
@@ -200,8 +244,9 @@ type UtxoSweeperConfig struct {
 	FeeEstimator lnwallet.FeeEstimator
 
 	// PublishTransaction facilitates the process of broadcasting a signed
-	// transaction to the appropriate network.
-	PublishTransaction func(*wire.MsgTx) error
+	// transaction to the appropriate network, storing the given label for
+	// it.
+	PublishTransaction func(*wire.MsgTx, string) error
 
 	// NewBatchTimer creates a channel that will be sent on when a certain
 	// time window has passed. During this time window, new inputs can still
@@ -219,6 +264,11 @@ type UtxoSweeperConfig struct {
 	// time the incubated outputs need to be spent.
 	Signer input.Signer
 
+	// OutputLeaser is used to renew the leases of wallet UTXOs when their
+	// sweep is resumed after a restart, and to release them once they've
+	// been swept or we've given up on them.
+	OutputLeaser OutputLeaser
+
 	// MaxInputsPerTx specifies the default maximum number of inputs allowed
 	// in a single sweep tx. If more need to be swept, multiple txes are
 	// created and published.
@@ -248,6 +298,16 @@ type UtxoSweeperConfig struct {
 	//   #1: min = 1 sat/vbyte, max = 10 sat/vbyte
 	//   #2: min = 11 sat/vbyte, max = 20 sat/vbyte...
 	FeeRateBucketSize int
+
+	// FeeFunction determines how the fee rate of inputs with a deadline is
+	// raised from the rate of their fee preference to the rate allowed by
+	// their budget as the deadline approaches.
+	FeeFunction FeeFunction
+
+	// DeadlineBucketSize is the number of blocks spanned by a deadline
+	// bucket. Only inputs with deadlines within the same bucket are swept
+	// within the same transaction.
+	DeadlineBucketSize int32
 }
 
 // Result is the struct that is pushed through the result channel. Callers can
@@ -268,6 +328,7 @@ type Result struct {
 type sweepInputMessage struct {
 	input         input.Input
 	feePreference FeePreference
+	group         uint64
 	resultChan    chan Result
 }
 
@@ -310,7 +371,11 @@ func (s *UtxoSweeper) Start() error {
 
 		// Error can be ignored. Because we are starting up, there are
 		// no pending inputs to update based on the publish result.
-		err := s.cfg.PublishTransaction(lastTx)
+		err := s.cfg.PublishTransaction(
+			lastTx, labels.MakeLabel(
+				labels.LabelTypeSweepTransaction, nil,
+			),
+		)
 		if err != nil && err != lnwallet.ErrDoubleSpend {
 			log.Errorf("last tx publish: %v", err)
 		}
@@ -370,6 +435,18 @@ func (s *UtxoSweeper) Stop() error {
 func (s *UtxoSweeper) SweepInput(input input.Input,
 	feePreference FeePreference) (chan Result, error) {
 
+	return s.SweepInputInGroup(input, feePreference, DefaultSweepGroup)
+}
+
+// SweepInputInGroup sweeps an input back into the wallet like SweepInput, but
+// only batches it with other inputs of the given sweep group. This allows a
+// client to keep inputs apart that shouldn't depend on each other, as an input
+// that is spent by a third party invalidates the whole transaction it was
+// included in. Offering an input that is already pending again moves it to the
+// given group.
+func (s *UtxoSweeper) SweepInputInGroup(input input.Input,
+	feePreference FeePreference, group uint64) (chan Result, error) {
+
 	if input == nil || input.OutPoint() == nil || input.SignDesc() == nil {
 		return nil, errors.New("nil input received")
 	}
@@ -380,13 +457,15 @@ func (s *UtxoSweeper) SweepInput(input input.Input,
 	}
 
 	log.Infof("Sweep request received: out_point=%v, witness_type=%v, "+
-		"time_lock=%v, amount=%v, fee_preference=%v", input.OutPoint(),
-		input.WitnessType(), input.BlocksToMaturity(),
-		btcutil.Amount(input.SignDesc().Output.Value), feePreference)
+		"time_lock=%v, amount=%v, fee_preference=%v, group=%v",
+		input.OutPoint(), input.WitnessType(), input.BlocksToMaturity(),
+		btcutil.Amount(input.SignDesc().Output.Value), feePreference,
+		group)
 
 	sweeperInput := &sweepInputMessage{
 		input:         input,
 		feePreference: feePreference,
+		group:         group,
 		resultChan:    make(chan Result, 1),
 	}
 
@@ -442,6 +521,13 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 		return
 	}
 
+	// Resume sweeping the inputs that were pending before we were last
+	// shut down. Clients re-offering them will be notified of the outcome
+	// as usual.
+	if err := s.restorePendingInputs(bestHeight); err != nil {
+		log.Errorf("Unable to restore pending inputs: %v", err)
+	}
+
 	for {
 		select {
 		// A new inputs is offered to the sweeper. We check to see if we
@@ -459,6 +545,13 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 				pendInput.listeners = append(
 					pendInput.listeners, input.resultChan,
 				)
+
+				// The input may have been restored into the
+				// default group, or be moved by its client.
+				if pendInput.group != input.group {
+					pendInput.group = input.group
+					s.persistInput(pendInput)
+				}
 				continue
 			}
 
@@ -471,8 +564,11 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 				input:            input.input,
 				minPublishHeight: bestHeight,
 				feePreference:    input.feePreference,
+				startHeight:      bestHeight,
+				group:            input.group,
 			}
 			s.pendingInputs[outpoint] = pendInput
+			s.persistInput(pendInput)
 
 			// Start watching for spend of this input, either by us
 			// or the remote party.
@@ -483,7 +579,9 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 			)
 			if err != nil {
 				err := fmt.Errorf("wait for spend: %v", err)
-				s.signalAndRemove(&outpoint, Result{Err: err})
+				s.signalAndRemove(
+					&outpoint, Result{Err: err}, 0,
+				)
 				continue
 			}
 			pendInput.ntfnRegCancel = cancel
@@ -520,6 +618,13 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 				}), isOurTx,
 			)
 
+			// Determine the fee of our sweep tx before we remove
+			// its inputs, as it'll be recorded for each of them.
+			var fee btcutil.Amount
+			if isOurTx {
+				fee = s.sweepTxFee(spend.SpendingTx)
+			}
+
 			// Signal sweep results for inputs in this confirmed
 			// tx.
 			for _, txIn := range spend.SpendingTx.TxIn {
@@ -545,7 +650,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 				s.signalAndRemove(&outpoint, Result{
 					Tx:  spend.SpendingTx,
 					Err: err,
-				})
+				}, fee)
 			}
 
 			// Now that an input of ours is spent, we can try to
@@ -557,7 +662,9 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 		// A new external request has been received to retrieve all of
 		// the inputs we're currently attempting to sweep.
 		case req := <-s.pendingSweepsReqs:
-			req.respChan <- s.handlePendingSweepsReq(req)
+			req.respChan <- s.handlePendingSweepsReq(
+				req, bestHeight,
+			)
 
 		// A new external request has been received to bump the fee rate
 		// of a given input.
@@ -582,7 +689,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 			// this to ensure any inputs which have had their fee
 			// rate bumped are broadcast first in order enforce the
 			// RBF policy.
-			inputClusters := s.clusterBySweepFeeRate()
+			inputClusters := s.clusterBySweepFeeRate(bestHeight)
 			sort.Slice(inputClusters, func(i, j int) bool {
 				return inputClusters[i].sweepFeeRate >
 					inputClusters[j].sweepFeeRate
@@ -645,28 +752,115 @@ func (s *UtxoSweeper) bucketForFeeRate(
 	)
 }
 
+// feeRateForInput returns the fee rate the given input should be swept with at
+// the given height. For inputs with a deadline, the fee rate of their fee
+// preference is raised along the fee function towards the rate allowed by
+// their budget.
+func (s *UtxoSweeper) feeRateForInput(pi *pendingInput,
+	height int32) (lnwallet.SatPerKWeight, error) {
+
+	feeRate, err := s.feeRateForPreference(pi.feePreference)
+	if err != nil {
+		return 0, err
+	}
+
+	deadline := pi.feePreference.DeadlineHeight
+	if deadline == 0 {
+		return feeRate, nil
+	}
+
+	// The fee rate is capped by the input's budget, although we'll never
+	// go below the relay fee rate as the input couldn't be swept at all.
+	maxFeeRate := s.budgetFeeRate(pi)
+	if maxFeeRate <= feeRate {
+		return maxFeeRate, nil
+	}
+
+	progress := deadlineProgress(pi.startHeight, deadline, height)
+	feeRate = s.cfg.FeeFunction(feeRate, maxFeeRate, progress)
+	if feeRate > maxFeeRate {
+		feeRate = maxFeeRate
+	}
+
+	return feeRate, nil
+}
+
+// budgetFeeRate returns the highest fee rate the given input can be swept with
+// without exceeding its budget, bounded by the fee rates of the UtxoSweeper.
+// The budget is spread over the weight of a transaction sweeping the input on
+// its own.
+func (s *UtxoSweeper) budgetFeeRate(pi *pendingInput) lnwallet.SatPerKWeight {
+	budget := pi.feePreference.Budget
+	if budget == 0 {
+		return s.cfg.MaxFeeRate
+	}
+
+	_, weight, _, _ := getWeightEstimate([]input.Input{pi.input})
+	if weight == 0 {
+		return s.cfg.MaxFeeRate
+	}
+
+	feeRate := lnwallet.SatPerKWeight(int64(budget) * 1000 / weight)
+	switch {
+	case feeRate < s.relayFeeRate:
+		return s.relayFeeRate
+
+	case feeRate > s.cfg.MaxFeeRate:
+		return s.cfg.MaxFeeRate
+	}
+
+	return feeRate
+}
+
+// deadlineBucket determines the bucket of a deadline. Inputs within the same
+// bucket have compatible deadlines and may be swept together. Inputs without a
+// deadline are all placed in the zero bucket.
+func (s *UtxoSweeper) deadlineBucket(deadline int32) int32 {
+	if deadline == 0 {
+		return 0
+	}
+
+	bucketSize := s.cfg.DeadlineBucketSize
+	if bucketSize <= 0 {
+		bucketSize = 1
+	}
+
+	return deadline/bucketSize + 1
+}
+
 // clusterBySweepFeeRate takes the set of pending inputs within the UtxoSweeper
-// and clusters those together with similar fee rates. Each cluster contains a
-// sweep fee rate, which is determined by calculating the average fee rate of
-// all inputs within that cluster.
-func (s *UtxoSweeper) clusterBySweepFeeRate() []inputCluster {
-	bucketInputs := make(map[lnwallet.SatPerKWeight]pendingInputs)
+// and clusters those together with similar fee rates and compatible deadlines.
+// Each cluster contains a sweep fee rate, which is determined by calculating
+// the average fee rate of all inputs within that cluster. Clusters of inputs
+// with a deadline use the highest fee rate of their inputs instead, to ensure
+// none of them falls behind on its way to the deadline.
+func (s *UtxoSweeper) clusterBySweepFeeRate(
+	currentHeight int32) []inputCluster {
+
+	bucketInputs := make(map[clusterKey]pendingInputs)
 	inputFeeRates := make(map[wire.OutPoint]lnwallet.SatPerKWeight)
 
-	// First, we'll group together all inputs with similar fee rates. This
-	// is done by determining the fee rate bucket they should belong in.
+	// First, we'll group together all inputs with similar fee rates and
+	// compatible deadlines. This is done by determining the fee rate and
+	// deadline buckets they should belong in.
 	for op, input := range s.pendingInputs {
-		feeRate, err := s.feeRateForPreference(input.feePreference)
+		feeRate, err := s.feeRateForInput(input, currentHeight)
 		if err != nil {
 			log.Warnf("Skipping input %v: %v", op, err)
 			continue
 		}
-		bucket := s.bucketForFeeRate(feeRate)
+		key := clusterKey{
+			feeRateBucket: s.bucketForFeeRate(feeRate),
+			deadlineBucket: s.deadlineBucket(
+				input.feePreference.DeadlineHeight,
+			),
+			group: input.group,
+		}
 
-		inputs, ok := bucketInputs[bucket]
+		inputs, ok := bucketInputs[key]
 		if !ok {
 			inputs = make(pendingInputs)
-			bucketInputs[bucket] = inputs
+			bucketInputs[key] = inputs
 		}
 
 		input.lastFeeRate = feeRate
@@ -675,14 +869,24 @@ func (s *UtxoSweeper) clusterBySweepFeeRate() []inputCluster {
 	}
 
 	// We'll then determine the sweep fee rate for each set of inputs by
-	// calculating the average fee rate of the inputs within each set.
+	// calculating the average fee rate of the inputs within each set, or
+	// the highest one if they have a deadline.
 	inputClusters := make([]inputCluster, 0, len(bucketInputs))
-	for _, inputs := range bucketInputs {
+	for key, inputs := range bucketInputs {
 		var sweepFeeRate lnwallet.SatPerKWeight
 		for op := range inputs {
-			sweepFeeRate += inputFeeRates[op]
+			feeRate := inputFeeRates[op]
+			switch {
+			case key.deadlineBucket == 0:
+				sweepFeeRate += feeRate
+
+			case feeRate > sweepFeeRate:
+				sweepFeeRate = feeRate
+			}
+		}
+		if key.deadlineBucket == 0 {
+			sweepFeeRate /= lnwallet.SatPerKWeight(len(inputs))
 		}
-		sweepFeeRate /= lnwallet.SatPerKWeight(len(inputs))
 		inputClusters = append(inputClusters, inputCluster{
 			sweepFeeRate: sweepFeeRate,
 			inputs:       inputs,
@@ -704,7 +908,7 @@ func (s *UtxoSweeper) scheduleSweep(currentHeight int32) error {
 
 	// We'll only start our timer once we have inputs we're able to sweep.
 	startTimer := false
-	for _, cluster := range s.clusterBySweepFeeRate() {
+	for _, cluster := range s.clusterBySweepFeeRate(currentHeight) {
 		// Examine pending inputs and try to construct lists of inputs.
 		inputLists, err := s.getInputLists(cluster, currentHeight)
 		if err != nil {
@@ -735,12 +939,40 @@ func (s *UtxoSweeper) scheduleSweep(currentHeight int32) error {
 
 // signalAndRemove notifies the listeners of the final result of the input
 // sweep. It cancels any pending spend notification and removes the input from
-// the list of pending inputs. When this function returns, the sweeper has
-// completely forgotten about the input.
-func (s *UtxoSweeper) signalAndRemove(outpoint *wire.OutPoint, result Result) {
+// the list of pending inputs. The outcome is recorded in the sweep history,
+// along with the fee paid by our sweep tx if known. When this function
+// returns, the sweeper has completely forgotten about the input.
+func (s *UtxoSweeper) signalAndRemove(outpoint *wire.OutPoint, result Result,
+	fee btcutil.Amount) {
+
 	pendInput := s.pendingInputs[*outpoint]
 	listeners := pendInput.listeners
 
+	record := s.sweepRecord(pendInput)
+	switch result.Err {
+	case nil:
+		record.Outcome = SweepSucceeded
+		record.Fee = fee
+
+	case ErrRemoteSpend:
+		record.Outcome = SweepRemoteSpend
+
+	default:
+		record.Outcome = SweepFailed
+	}
+	if result.Tx != nil {
+		record.SpendTxid = result.Tx.TxHash()
+	}
+	if err := s.cfg.Store.PutSweepRecord(record); err != nil {
+		log.Errorf("Unable to record outcome of %v: %v", outpoint, err)
+	}
+
+	// A wallet UTXO is no longer of concern to us, so its lease is
+	// released, making it available again if it wasn't spent.
+	if isWalletInput(pendInput.input) {
+		s.releaseWalletInput(*outpoint)
+	}
+
 	if result.Err == nil {
 		log.Debugf("Dispatching sweep success for %v to %v listeners",
 			outpoint, len(listeners),
@@ -877,7 +1109,9 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate lnwallet.SatPerKWeight,
 		}),
 	)
 
-	err = s.cfg.PublishTransaction(tx)
+	err = s.cfg.PublishTransaction(
+		tx, labels.MakeLabel(labels.LabelTypeSweepTransaction, nil),
+	)
 
 	// In case of an unexpected error, don't try to recover.
 	if err != nil && err != lnwallet.ErrDoubleSpend {
@@ -906,29 +1140,195 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate lnwallet.SatPerKWeight,
 		// We don't care what the result of the publish call was. Even
 		// if it is published successfully, it can still be that it
 		// needs to be retried. Call NextAttemptDeltaFunc to calculate
-		// when to resweep this input.
-		nextAttemptDelta := s.cfg.NextAttemptDeltaFunc(
-			pi.publishAttempts,
-		)
+		// when to resweep this input. Inputs with a deadline are
+		// resweeped every block instead, so that their transaction is
+		// replaced as soon as their fee rate is raised.
+		deadline := pi.feePreference.DeadlineHeight
+		nextAttemptDelta := int32(1)
+		if deadline == 0 {
+			nextAttemptDelta = s.cfg.NextAttemptDeltaFunc(
+				pi.publishAttempts,
+			)
+		}
 
 		pi.minPublishHeight = currentHeight + nextAttemptDelta
 
+		pi.lastTxid = tx.TxHash()
+
 		log.Debugf("Rescheduling input %v after %v attempts at "+
 			"height %v (delta %v)", input.PreviousOutPoint,
 			pi.publishAttempts, pi.minPublishHeight,
 			nextAttemptDelta)
 
-		if pi.publishAttempts >= s.cfg.MaxSweepAttempts {
+		// As inputs with a deadline are resweeped every block, we'll
+		// only give up on them once they've been attempted for the
+		// maximum number of blocks past their deadline.
+		giveUp := pi.publishAttempts >= s.cfg.MaxSweepAttempts
+		if deadline != 0 {
+			lastHeight := deadline + int32(s.cfg.MaxSweepAttempts)
+			giveUp = currentHeight >= lastHeight
+		}
+
+		if giveUp {
 			// Signal result channels sweep result.
 			s.signalAndRemove(&input.PreviousOutPoint, Result{
 				Err: ErrTooManyAttempts,
-			})
+			}, 0)
+			continue
 		}
+
+		s.persistInput(pi)
 	}
 
 	return nil
 }
 
+// sweepRecord returns the record of a pending input to be persisted.
+func (s *UtxoSweeper) sweepRecord(pi *pendingInput) *SweepRecord {
+	return &SweepRecord{
+		Input:            pi.input,
+		FeePreference:    pi.feePreference,
+		StartHeight:      pi.startHeight,
+		PublishAttempts:  pi.publishAttempts,
+		MinPublishHeight: pi.minPublishHeight,
+		LastFeeRate:      pi.lastFeeRate,
+		LastTxid:         pi.lastTxid,
+		Outcome:          SweepPending,
+		Group:            pi.group,
+	}
+}
+
+// persistInput stores the current state of a pending input, allowing us to
+// resume sweeping it after a restart. Inputs that can't be persisted are
+// still swept, but need to be re-offered after a restart.
+func (s *UtxoSweeper) persistInput(pi *pendingInput) {
+	err := s.cfg.Store.PutSweepRecord(s.sweepRecord(pi))
+	if err != nil {
+		log.Warnf("Unable to persist input %v: %v",
+			pi.input.OutPoint(), err)
+	}
+}
+
+// restorePendingInputs loads the inputs that were still pending when we were
+// last shut down from the store, and resumes sweeping them.
+func (s *UtxoSweeper) restorePendingInputs(bestHeight int32) error {
+	records, err := s.cfg.Store.FetchSweepRecords()
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if record.Outcome != SweepPending {
+			continue
+		}
+
+		outpoint := *record.Input.OutPoint()
+
+		// A wallet UTXO must be leased before we resume sweeping it,
+		// as its lease may have expired while we were offline. If it
+		// has been leased by someone else in the meantime, we'll leave
+		// it to them.
+		if isWalletInput(record.Input) {
+			err := s.leaseWalletInput(outpoint)
+			switch {
+			case err == lnwallet.ErrOutputAlreadyLeased:
+				log.Warnf("Wallet input %v leased elsewhere, "+
+					"not resuming its sweep", outpoint)
+
+				record.Outcome = SweepFailed
+				err := s.cfg.Store.PutSweepRecord(record)
+				if err != nil {
+					log.Errorf("Unable to record outcome "+
+						"of %v: %v", outpoint, err)
+				}
+				continue
+
+			// The UTXO may be unknown to the wallet if it has
+			// been spent while we were offline, which we'll learn
+			// about through its spend notification.
+			case err != nil:
+				log.Warnf("Unable to lease wallet input %v: %v",
+					outpoint, err)
+			}
+		}
+
+		pendInput := &pendingInput{
+			input:            record.Input,
+			minPublishHeight: record.MinPublishHeight,
+			publishAttempts:  record.PublishAttempts,
+			feePreference:    record.FeePreference,
+			lastFeeRate:      record.LastFeeRate,
+			startHeight:      record.StartHeight,
+			lastTxid:         record.LastTxid,
+			group:            record.Group,
+		}
+		s.pendingInputs[outpoint] = pendInput
+
+		log.Infof("Restored pending input %v: attempts=%v, "+
+			"fee_preference=%v", outpoint, record.PublishAttempts,
+			record.FeePreference)
+
+		// Watch for the input to be spent, which may already have
+		// happened while we were offline.
+		cancel, err := s.waitForSpend(
+			outpoint, record.Input.SignDesc().Output.PkScript,
+			record.Input.HeightHint(),
+		)
+		if err != nil {
+			err := fmt.Errorf("wait for spend: %v", err)
+			s.signalAndRemove(
+				&outpoint, Result{Err: err}, 0,
+			)
+			continue
+		}
+		pendInput.ntfnRegCancel = cancel
+	}
+
+	return s.scheduleSweep(bestHeight)
+}
+
+// leaseWalletInput leases a wallet UTXO being swept, or renews its lease.
+func (s *UtxoSweeper) leaseWalletInput(op wire.OutPoint) error {
+	_, err := s.cfg.OutputLeaser.LeaseOutput(
+		WalletInputLockID, op, walletInputLeaseDuration,
+	)
+	return err
+}
+
+// releaseWalletInput releases the lease of a wallet UTXO we're done sweeping.
+func (s *UtxoSweeper) releaseWalletInput(op wire.OutPoint) {
+	err := s.cfg.OutputLeaser.ReleaseOutput(WalletInputLockID, op)
+	if err != nil && err != lnwallet.ErrOutputNotLeased {
+		log.Warnf("Unable to release wallet input %v: %v", op, err)
+	}
+}
+
+// sweepTxFee returns the fee paid by one of our sweep txes, which only spends
+// pending inputs. Zero is returned if it spends inputs we no longer know of.
+func (s *UtxoSweeper) sweepTxFee(tx *wire.MsgTx) btcutil.Amount {
+	var inputAmt int64
+	for _, txIn := range tx.TxIn {
+		pi, ok := s.pendingInputs[txIn.PreviousOutPoint]
+		if !ok {
+			return 0
+		}
+		inputAmt += pi.input.SignDesc().Output.Value
+	}
+
+	var outputAmt int64
+	for _, txOut := range tx.TxOut {
+		outputAmt += txOut.Value
+	}
+
+	return btcutil.Amount(inputAmt - outputAmt)
+}
+
+// SweepHistory returns the records of all inputs offered to the UtxoSweeper,
+// including the outcome of completed sweeps.
+func (s *UtxoSweeper) SweepHistory() ([]*SweepRecord, error) {
+	return s.cfg.Store.FetchSweepRecords()
+}
+
 // waitForSpend registers a spend notification with the chain notifier. It
 // returns a cancel function that can be used to cancel the registration.
 func (s *UtxoSweeper) waitForSpend(outpoint wire.OutPoint,
@@ -992,14 +1392,33 @@ func (s *UtxoSweeper) PendingInputs() (map[wire.OutPoint]*PendingInput, error) {
 
 // handlePendingSweepsReq handles a request to retrieve all pending inputs the
 // UtxoSweeper is attempting to sweep.
-func (s *UtxoSweeper) handlePendingSweepsReq(
-	req *pendingSweepsReq) map[wire.OutPoint]*PendingInput {
+func (s *UtxoSweeper) handlePendingSweepsReq(req *pendingSweepsReq,
+	bestHeight int32) map[wire.OutPoint]*PendingInput {
 
 	pendingInputs := make(map[wire.OutPoint]*PendingInput, len(s.pendingInputs))
 	for _, pendingInput := range s.pendingInputs {
 		// Only the exported fields are set, as we expect the response
 		// to only be consumed externally.
 		op := *pendingInput.input.OutPoint()
+		feePref := pendingInput.feePreference
+
+		// The fee rates may not be determined if the fee estimator
+		// fails, in which case we'll report them as zero.
+		currentFeeRate, err := s.feeRateForInput(
+			pendingInput, bestHeight,
+		)
+		if err != nil {
+			log.Warnf("Unable to determine fee rate of %v: %v",
+				op, err)
+		}
+		nextFeeRate, err := s.feeRateForInput(
+			pendingInput, bestHeight+1,
+		)
+		if err != nil {
+			log.Warnf("Unable to determine fee rate of %v: %v",
+				op, err)
+		}
+
 		pendingInputs[op] = &PendingInput{
 			OutPoint:    op,
 			WitnessType: pendingInput.input.WitnessType(),
@@ -1009,6 +1428,10 @@ func (s *UtxoSweeper) handlePendingSweepsReq(
 			LastFeeRate:         pendingInput.lastFeeRate,
 			BroadcastAttempts:   pendingInput.publishAttempts,
 			NextBroadcastHeight: uint32(pendingInput.minPublishHeight),
+			DeadlineHeight:      feePref.DeadlineHeight,
+			Budget:              feePref.Budget,
+			CurrentFeeRate:      currentFeeRate,
+			NextFeeRate:         nextFeeRate,
 		}
 	}
 
@@ -1079,6 +1502,10 @@ func (s *UtxoSweeper) handleBumpFeeReq(req *bumpFeeReq,
 
 	pendingInput.feePreference = req.feePreference
 
+	// If the new fee preference has a deadline, its fee rate is raised
+	// starting from now.
+	pendingInput.startHeight = bestHeight
+
 	// We'll reset the input's publish height to the current so that a new
 	// transaction can be created that replaces the transaction currently
 	// spending the input. We only do this for inputs that have been
@@ -1090,6 +1517,7 @@ func (s *UtxoSweeper) handleBumpFeeReq(req *bumpFeeReq,
 	if pendingInput.publishAttempts > 0 {
 		pendingInput.minPublishHeight = bestHeight
 	}
+	s.persistInput(pendingInput)
 
 	if err := s.scheduleSweep(bestHeight); err != nil {
 		log.Errorf("Unable to schedule sweep: %v", err)
@@ -1,9 +1,11 @@
 package sweep
 
 import (
+	"math"
 	"os"
 	"runtime/debug"
 	"runtime/pprof"
+	"sort"
 	"testing"
 	"time"
 
@@ -35,6 +37,7 @@ type sweeperTestContext struct {
 	estimator *mockFeeEstimator
 	backend   *mockBackend
 	store     *MockSweeperStore
+	leaser    *mockOutputLeaser
 
 	timeoutChan chan chan time.Time
 	publishChan chan wire.MsgTx
@@ -109,13 +112,14 @@ func createSweeperTestContext(t *testing.T) *sweeperTestContext {
 		estimator:   estimator,
 		backend:     backend,
 		store:       store,
+		leaser:      newMockOutputLeaser(nil),
 		timeoutChan: make(chan chan time.Time, 1),
 	}
 
 	var outputScriptCount byte
 	ctx.sweeper = New(&UtxoSweeperConfig{
 		Notifier: notifier,
-		PublishTransaction: func(tx *wire.MsgTx) error {
+		PublishTransaction: func(tx *wire.MsgTx, _ string) error {
 			log.Tracef("Publishing tx %v", tx.TxHash())
 			err := backend.publishTransaction(tx)
 			select {
@@ -130,8 +134,9 @@ func createSweeperTestContext(t *testing.T) *sweeperTestContext {
 			ctx.timeoutChan <- c
 			return c
 		},
-		Store:  store,
-		Signer: &mockSigner{},
+		Store:        store,
+		Signer:       &mockSigner{},
+		OutputLeaser: ctx.leaser,
 		GenSweepScript: func() ([]byte, error) {
 			script := []byte{outputScriptCount}
 			outputScriptCount++
@@ -144,8 +149,10 @@ func createSweeperTestContext(t *testing.T) *sweeperTestContext {
 			// Use delta func without random factor.
 			return 1 << uint(attempts-1)
 		},
-		MaxFeeRate:        DefaultMaxFeeRate,
-		FeeRateBucketSize: DefaultFeeRateBucketSize,
+		MaxFeeRate:         DefaultMaxFeeRate,
+		FeeRateBucketSize:  DefaultFeeRateBucketSize,
+		FeeFunction:        LinearFeeFunction,
+		DeadlineBucketSize: DefaultDeadlineBucketSize,
 	})
 
 	ctx.sweeper.Start()
@@ -285,6 +292,42 @@ func (ctx *sweeperTestContext) assertPendingInputs(inputs ...input.Input) {
 	}
 }
 
+// waitForPendingInputs waits until the sweeper's set of pending inputs matches
+// the given inputs.
+func (ctx *sweeperTestContext) waitForPendingInputs(inputs ...input.Input) {
+	ctx.t.Helper()
+
+	inputSet := make(map[wire.OutPoint]struct{}, len(inputs))
+	for _, input := range inputs {
+		inputSet[*input.OutPoint()] = struct{}{}
+	}
+
+	timeout := time.After(defaultTestTimeout)
+	for {
+		pendingInputs, err := ctx.sweeper.PendingInputs()
+		if err != nil {
+			ctx.t.Fatal(err)
+		}
+
+		match := len(pendingInputs) == len(inputSet)
+		for input := range pendingInputs {
+			if _, ok := inputSet[input]; !ok {
+				match = false
+			}
+		}
+		if match {
+			return
+		}
+
+		select {
+		case <-timeout:
+			ctx.t.Fatalf("expected %d pending inputs, got %d",
+				len(inputSet), len(pendingInputs))
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
 // assertTxSweepsInputs ensures that the transaction returned within the value
 // received from resultChan spends the given inputs.
 func assertTxSweepsInputs(t *testing.T, sweepTx *wire.MsgTx,
@@ -814,6 +857,10 @@ func TestRestartRemoteSpend(t *testing.T) {
 	// Mine remote spending tx.
 	ctx.backend.mine()
 
+	// Input 1 was restored from the store, so it remains pending once the
+	// remote spend of input 2 has been processed.
+	ctx.waitForPendingInputs(input1)
+
 	// Simulate other subsystem (eg contract resolver) re-offering input 0.
 	spendChan, err := ctx.sweeper.SweepInput(input1, defaultFeePref)
 	if err != nil {
@@ -821,7 +868,9 @@ func TestRestartRemoteSpend(t *testing.T) {
 	}
 
 	// Expect sweeper to construct a new tx, because input 1 was spend
-	// remotely.
+	// remotely. The restored input keeps its backoff, so it is only retried
+	// at the next block.
+	ctx.notifier.NotifyEpoch(mockChainHeight + 1)
 	ctx.tick()
 
 	ctx.receiveTx()
@@ -857,6 +906,10 @@ func TestRestartConfirmed(t *testing.T) {
 	// Mine the sweep tx.
 	ctx.backend.mine()
 
+	// The input was restored from the store, so wait for the spend of it to
+	// be processed.
+	ctx.waitForPendingInputs()
+
 	// Simulate other subsystem (eg contract resolver) re-offering input 0.
 	spendChan, err := ctx.sweeper.SweepInput(input, defaultFeePref)
 	if err != nil {
@@ -901,6 +954,158 @@ func TestRestartRepublish(t *testing.T) {
 	ctx.finish(1)
 }
 
+// TestRestartRestorePendingInputs asserts that the sweeper resumes sweeping the
+// inputs that were pending when it was shut down, without them being offered
+// again, and records the outcome of their sweep.
+func TestRestartRestorePendingInputs(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	input0 := spendableInputs[0]
+	input1 := spendableInputs[1]
+	for _, inp := range []input.Input{input0, input1} {
+		_, err := ctx.sweeper.SweepInput(inp, defaultFeePref)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx.tick()
+
+	sweepTx := ctx.receiveTx()
+
+	// Restart the sweeper. Both inputs are expected to be restored and
+	// the last tx to be republished.
+	ctx.restartSweeper()
+
+	ctx.receiveTx()
+
+	ctx.assertPendingInputs(input0, input1)
+
+	// Mine the sweep tx. The restored inputs are expected to be removed
+	// from the set of pending inputs.
+	ctx.backend.mine()
+
+	ctx.waitForPendingInputs()
+
+	// The sweep history should record the successful sweep of both inputs
+	// by our tx, including the fee it paid.
+	var inputAmt int64
+	for _, txIn := range sweepTx.TxIn {
+		for _, inp := range []input.Input{input0, input1} {
+			if txIn.PreviousOutPoint == *inp.OutPoint() {
+				inputAmt += inp.SignDesc().Output.Value
+			}
+		}
+	}
+	expectedFee := btcutil.Amount(inputAmt - sweepTx.TxOut[0].Value)
+
+	records, err := ctx.sweeper.SweepHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %v", len(records))
+	}
+	for _, record := range records {
+		if record.Outcome != SweepSucceeded {
+			t.Fatalf("expected outcome %v, got %v",
+				SweepSucceeded, record.Outcome)
+		}
+		if record.SpendTxid != sweepTx.TxHash() {
+			t.Fatalf("expected spend by %v, got %v",
+				sweepTx.TxHash(), record.SpendTxid)
+		}
+		if record.Fee != expectedFee {
+			t.Fatalf("expected fee %v, got %v", expectedFee,
+				record.Fee)
+		}
+	}
+
+	ctx.finish(1)
+}
+
+// TestRestartWalletInputs asserts that wallet UTXOs remain leased while the
+// sweeper resumes sweeping them after a restart, even if their lease expired in
+// the meantime, and that their leases are released once they've been swept.
+func TestRestartWalletInputs(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	utxos := makeConsolidationUtxos(10000, 12000)
+	ctx.leaser.utxos = utxos
+
+	inputs, err := makeWalletInputs(utxos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, inp := range inputs {
+		_, err := ctx.leaser.LeaseOutput(
+			WalletInputLockID, utxos[i].OutPoint, time.Hour,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = ctx.sweeper.SweepInput(inp, defaultFeePref)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx.tick()
+
+	ctx.receiveTx()
+
+	assertUnspent := func(expected int) {
+		t.Helper()
+
+		unspent, err := ctx.leaser.ListUnspentWitnessFromDefaultAccount(
+			1, math.MaxInt32,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(unspent) != expected {
+			t.Fatalf("expected %v unspent utxos, got %v", expected,
+				len(unspent))
+		}
+	}
+
+	// Let the leases expire while the sweeper is restarted. The restored
+	// inputs are expected to be leased again, such that they can't be
+	// selected for any other transaction.
+	ctx.sweeper.Stop()
+	ctx.leaser.expireLeases()
+	assertUnspent(len(utxos))
+
+	ctx.sweeper = New(ctx.sweeper.cfg)
+	ctx.sweeper.Start()
+
+	ctx.receiveTx()
+
+	ctx.assertPendingInputs(inputs...)
+	assertUnspent(0)
+	for _, utxo := range utxos {
+		if !ctx.leaser.isLeased(utxo.OutPoint) {
+			t.Fatalf("expected utxo %v to be leased",
+				utxo.OutPoint)
+		}
+	}
+
+	// Once the sweep tx confirms, the leases should be released.
+	ctx.backend.mine()
+
+	ctx.waitForPendingInputs()
+
+	for _, utxo := range utxos {
+		if ctx.leaser.isLeased(utxo.OutPoint) {
+			t.Fatalf("expected utxo %v to be released",
+				utxo.OutPoint)
+		}
+	}
+
+	ctx.finish(1)
+}
+
 // TestRetry tests the sweeper retry flow.
 func TestRetry(t *testing.T) {
 	ctx := createSweeperTestContext(t)
@@ -1169,3 +1374,204 @@ func TestBumpFeeRBF(t *testing.T) {
 
 	ctx.finish(1)
 }
+
+// TestDeadlineFeeRate ensures that the fee rate of an input with a deadline is
+// raised every block along the fee function until it reaches the rate allowed
+// by its budget at the deadline, replacing the previous sweep transaction each
+// time.
+func TestDeadlineFeeRate(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	feePref := FeePreference{ConfTarget: 6}
+	ctx.estimator.blocksToFee[feePref.ConfTarget] = 1000
+
+	inp := createTestInput(
+		btcutil.SatoshiPerBitcoin, input.CommitmentTimeLock,
+	)
+
+	// We'll give the input a budget that allows for a fee rate of 5000
+	// sat/kw at its deadline, four blocks from now. The fee rate should
+	// thus be raised by 1000 sat/kw every block.
+	_, weight, _, _ := getWeightEstimate([]input.Input{&inp})
+	feePref.DeadlineHeight = mockChainHeight + 4
+	feePref.Budget = lnwallet.SatPerKWeight(5000).FeeForWeight(weight)
+
+	resultChan, err := ctx.sweeper.SweepInput(&inp, feePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	assertTxFeeRate(t, &sweepTx, 1000, &inp)
+
+	// The pending input should report both its current fee rate and the
+	// one it'll be swept with in the next block.
+	pendingInputs, err := ctx.sweeper.PendingInputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pendingInput := pendingInputs[*inp.OutPoint()]
+	if pendingInput.CurrentFeeRate != 1000 ||
+		pendingInput.NextFeeRate != 2000 {
+
+		t.Fatalf("expected fee rates of 1000 and 2000 sat/kw, got "+
+			"%v and %v", pendingInput.CurrentFeeRate,
+			pendingInput.NextFeeRate)
+	}
+	if pendingInput.DeadlineHeight != feePref.DeadlineHeight {
+		t.Fatalf("expected deadline %v, got %v",
+			feePref.DeadlineHeight, pendingInput.DeadlineHeight)
+	}
+
+	// Unlike inputs without a deadline, the input should be resweeped in
+	// the next block with a higher fee rate, replacing the previous sweep
+	// transaction.
+	ctx.notifier.NotifyEpoch(mockChainHeight + 1)
+	ctx.tick()
+	sweepTx = ctx.receiveTx()
+	assertTxFeeRate(t, &sweepTx, 2000, &inp)
+
+	// Once the deadline is reached, the fee rate should be the one allowed
+	// by the budget, and shouldn't be raised any further.
+	ctx.notifier.NotifyEpoch(mockChainHeight + 4)
+	ctx.tick()
+	sweepTx = ctx.receiveTx()
+	assertTxFeeRate(t, &sweepTx, 5000, &inp)
+
+	ctx.notifier.NotifyEpoch(mockChainHeight + 5)
+	ctx.tick()
+	sweepTx = ctx.receiveTx()
+	assertTxFeeRate(t, &sweepTx, 5000, &inp)
+
+	ctx.backend.mine()
+	ctx.expectResult(resultChan, nil)
+
+	ctx.finish(1)
+}
+
+// TestClusterByDeadline ensures that inputs with similar fee rates are only
+// clustered together if they have compatible deadlines, and that clusters of
+// inputs with a deadline use the highest fee rate among them.
+func TestClusterByDeadline(t *testing.T) {
+	t.Parallel()
+
+	estimator := newMockFeeEstimator(1000, lnwallet.FeePerKwFloor)
+	sweeper := New(&UtxoSweeperConfig{
+		FeeEstimator:       estimator,
+		MaxFeeRate:         DefaultMaxFeeRate,
+		FeeRateBucketSize:  DefaultFeeRateBucketSize,
+		FeeFunction:        LinearFeeFunction,
+		DeadlineBucketSize: DefaultDeadlineBucketSize,
+	})
+	sweeper.relayFeeRate = lnwallet.FeePerKwFloor
+
+	const height = 100
+	addInput := func(rate lnwallet.SatPerKWeight, deadline int32) {
+		inp := createTestInput(
+			btcutil.SatoshiPerBitcoin, input.CommitmentTimeLock,
+		)
+		sweeper.pendingInputs[*inp.OutPoint()] = &pendingInput{
+			input: &inp,
+			feePreference: FeePreference{
+				FeeRate:        rate,
+				DeadlineHeight: deadline,
+			},
+			startHeight: height,
+		}
+	}
+
+	// Two inputs without a deadline, two with deadlines within the same
+	// bucket, and one with a deadline far in the future. All of them start
+	// out within the same fee rate bucket.
+	addInput(1000, 0)
+	addInput(1010, 0)
+	addInput(1000, 1000)
+	addInput(1020, 1001)
+	addInput(1000, 5000)
+
+	clusters := sweeper.clusterBySweepFeeRate(height)
+	if len(clusters) != 3 {
+		t.Fatalf("expected 3 clusters, got %d", len(clusters))
+	}
+
+	// We'll index the clusters by their size and sweep fee rate, as the
+	// latter only differs among clusters of the same size.
+	clusterRates := make(map[int][]lnwallet.SatPerKWeight)
+	for _, cluster := range clusters {
+		size := len(cluster.inputs)
+		clusterRates[size] = append(
+			clusterRates[size], cluster.sweepFeeRate,
+		)
+	}
+
+	pairRates := clusterRates[2]
+	if len(pairRates) != 2 {
+		t.Fatalf("expected two clusters of two inputs, got %d",
+			len(pairRates))
+	}
+	sort.Slice(pairRates, func(i, j int) bool {
+		return pairRates[i] < pairRates[j]
+	})
+
+	// The inputs without a deadline are swept at their average fee rate,
+	// the ones with a deadline at their highest.
+	if pairRates[0] != 1005 || pairRates[1] != 1020 {
+		t.Fatalf("expected sweep fee rates of 1005 and 1020, got %v",
+			pairRates)
+	}
+	if len(clusterRates[1]) != 1 || clusterRates[1][0] != 1000 {
+		t.Fatalf("expected a single input cluster at 1000, got %v",
+			clusterRates[1])
+	}
+}
+
+// TestSweepGroups asserts that inputs of different sweep groups are swept in
+// separate transactions, even if they share the same fee preference.
+func TestSweepGroups(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	feePref := FeePreference{ConfTarget: 6}
+
+	input1 := spendableInputs[0]
+	resultChan1, err := ctx.sweeper.SweepInput(input1, feePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input2 := spendableInputs[1]
+	resultChan2, err := ctx.sweeper.SweepInputInGroup(input2, feePref, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input3 := spendableInputs[2]
+	resultChan3, err := ctx.sweeper.SweepInputInGroup(input3, feePref, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+
+	// We expect one transaction for the input of the default group, and
+	// one for the two inputs of the other group, in any order.
+	sweepTx1 := ctx.receiveTx()
+	sweepTx2 := ctx.receiveTx()
+	if len(sweepTx1.TxIn) > len(sweepTx2.TxIn) {
+		sweepTx1, sweepTx2 = sweepTx2, sweepTx1
+	}
+	if len(sweepTx1.TxIn) != 1 || len(sweepTx2.TxIn) != 2 {
+		t.Fatalf("expected sweep txes with 1 and 2 inputs, got %d "+
+			"and %d", len(sweepTx1.TxIn), len(sweepTx2.TxIn))
+	}
+	if sweepTx1.TxIn[0].PreviousOutPoint != *input1.OutPoint() {
+		t.Fatalf("expected input %v to be swept alone",
+			input1.OutPoint())
+	}
+
+	ctx.backend.mine()
+	resultChans := []chan Result{resultChan1, resultChan2, resultChan3}
+	for _, resultChan := range resultChans {
+		ctx.expectResult(resultChan, nil)
+	}
+
+	ctx.finish(1)
+}
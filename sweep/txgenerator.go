@@ -219,22 +219,35 @@ func createSweepTx(inputs []input.Input, outputPkScript []byte,
 		return nil, err
 	}
 
-	hashCache := txscript.NewTxSigHashes(sweepTx)
+	if err := addInputScripts(sweepTx, inputs, signer); err != nil {
+		return nil, err
+	}
+
+	return sweepTx, nil
+}
+
+// addInputScripts attaches a valid input script to each of the inputs of the
+// passed transaction. The inputs must be given in the same order as they
+// appear within the transaction.
+func addInputScripts(tx *wire.MsgTx, inputs []input.Input,
+	signer input.Signer) error {
+
+	hashCache := txscript.NewTxSigHashes(tx)
 
 	// With all the inputs in place, use each output's unique input script
 	// function to generate the final witness required for spending.
 	addInputScript := func(idx int, tso input.Input) error {
 		inputScript, err := tso.CraftInputScript(
-			signer, sweepTx, hashCache, idx,
+			signer, tx, hashCache, idx,
 		)
 		if err != nil {
 			return err
 		}
 
-		sweepTx.TxIn[idx].Witness = inputScript.Witness
+		tx.TxIn[idx].Witness = inputScript.Witness
 
 		if len(inputScript.SigScript) != 0 {
-			sweepTx.TxIn[idx].SignatureScript = inputScript.SigScript
+			tx.TxIn[idx].SignatureScript = inputScript.SigScript
 		}
 
 		return nil
@@ -244,11 +257,11 @@ func createSweepTx(inputs []input.Input, outputPkScript []byte,
 	// within the sweeping transaction.
 	for i, input := range inputs {
 		if err := addInputScript(i, input); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return sweepTx, nil
+	return nil
 }
 
 // getWeightEstimate returns a weight estimate for the given inputs.
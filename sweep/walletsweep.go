@@ -3,7 +3,9 @@ package sweep
 import (
 	"fmt"
 	"math"
+	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -28,14 +30,35 @@ type FeePreference struct {
 	// FeeRate if non-zero, signals a fee pre fence expressed in the fee
 	// rate expressed in sat/kw for a particular transaction.
 	FeeRate lnwallet.SatPerKWeight
+
+	// DeadlineHeight if non-zero, is the height by which an input swept by
+	// the UtxoSweeper should be confirmed. The fee rate determined by
+	// ConfTarget or FeeRate is then only the starting point, and is raised
+	// every block until it reaches the rate allowed by Budget at this
+	// height.
+	DeadlineHeight int32
+
+	// Budget is the maximum fee an input with a deadline is willing to pay
+	// if it were swept on its own. If zero, the fee rate is raised up to
+	// the maximum fee rate of the UtxoSweeper instead.
+	Budget btcutil.Amount
 }
 
 // String returns a human-readable string of the fee preference.
 func (p FeePreference) String() string {
+	var pref string
 	if p.ConfTarget != 0 {
-		return fmt.Sprintf("%v blocks", p.ConfTarget)
+		pref = fmt.Sprintf("%v blocks", p.ConfTarget)
+	} else {
+		pref = p.FeeRate.String()
+	}
+
+	if p.DeadlineHeight != 0 {
+		pref += fmt.Sprintf(" (deadline=%v, budget=%v)",
+			p.DeadlineHeight, p.Budget)
 	}
-	return p.FeeRate.String()
+
+	return pref
 }
 
 // DetermineFeePerKw will determine the fee in sat/kw that should be paid given
@@ -98,9 +121,11 @@ func DetermineFeePerKw(feeEstimator lnwallet.FeeEstimator,
 // UtxoSource is an interface that allows a caller to access a source of UTXOs
 // to use when crafting sweep transactions.
 type UtxoSource interface {
-	// ListUnspentWitness returns all UTXOs from the source that have
-	// between minConfs and maxConfs number of confirmations.
-	ListUnspentWitness(minConfs, maxConfs int32) ([]*lnwallet.Utxo, error)
+	// ListUnspentWitnessFromDefaultAccount returns all UTXOs of the
+	// default account of the source that have between minConfs and
+	// maxConfs number of confirmations.
+	ListUnspentWitnessFromDefaultAccount(minConfs, maxConfs int32) (
+		[]*lnwallet.Utxo, error)
 }
 
 // CoinSelectionLocker is an interface that allows the caller to perform an
@@ -130,6 +155,21 @@ type OutpointLocker interface {
 	UnlockOutpoint(o wire.OutPoint)
 }
 
+// OutputLeaser allows a caller to lease outputs of the wallet. Unlike locked
+// outpoints, leases are persisted, so leased outputs remain unusable for coin
+// selection across restarts until they're released or their lease expires.
+type OutputLeaser interface {
+	// LeaseOutput leases the output under the given lock ID for the given
+	// duration. Leasing an output again under the same lock ID extends
+	// its lease.
+	LeaseOutput(id lnwallet.LockID, op wire.OutPoint,
+		duration time.Duration) (time.Time, error)
+
+	// ReleaseOutput releases the lease on an output, allowing it to be
+	// used for coin selection once again.
+	ReleaseOutput(id lnwallet.LockID, op wire.OutPoint) error
+}
+
 // WalletSweepPackage is a package that gives the caller the ability to sweep
 // ALL funds from a wallet in a single transaction. We also package a function
 // closure that allows one to abort the operation.
@@ -147,18 +187,14 @@ type WalletSweepPackage struct {
 	CancelSweepAttempt func()
 }
 
-// CraftSweepAllTx attempts to craft a WalletSweepPackage which will allow the
-// caller to sweep ALL outputs within the wallet to a single UTXO, as specified
-// by the delivery address. The sweep transaction will be crafted with the
-// target fee rate, and will use the utxoSource and outpointLocker as sources
-// for wallet funds.
-func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
-	deliveryAddr btcutil.Address, coinSelectLocker CoinSelectionLocker,
+// lockWalletUtxos fetches and locks the UTXOs of the wallet that should be
+// spent. If no outpoints are given, all confirmed UTXOs are returned.
+// Otherwise, exactly the UTXOs matching the given outpoints are returned, and
+// an error is returned if any of them isn't an unspent, unlocked output of the
+// wallet. A closure that unlocks all returned UTXOs is returned as well.
+func lockWalletUtxos(coinSelectLocker CoinSelectionLocker,
 	utxoSource UtxoSource, outpointLocker OutpointLocker,
-	feeEstimator lnwallet.FeeEstimator,
-	signer input.Signer) (*WalletSweepPackage, error) {
-
-	// TODO(roasbeef): turn off ATPL as well when available?
+	outpoints []wire.OutPoint) ([]*lnwallet.Utxo, func(), error) {
 
 	var allOutputs []*lnwallet.Utxo
 
@@ -177,16 +213,46 @@ func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
 	// knows of.  Otherwise, it may be possible for a new funding flow to
 	// lock an output while we fetch the set of unspent witnesses.
 	err := coinSelectLocker.WithCoinSelectLock(func() error {
+		// If the caller selected the outputs to spend, we'll also
+		// consider unconfirmed outputs.
+		minConfs := int32(1)
+		if len(outpoints) != 0 {
+			minConfs = 0
+		}
+
 		// Now that we can be sure that no other coin selection
 		// operations are going on, we can grab a clean snapshot of the
 		// current UTXO state of the wallet.
-		utxos, err := utxoSource.ListUnspentWitness(
-			1, math.MaxInt32,
+		utxos, err := utxoSource.ListUnspentWitnessFromDefaultAccount(
+			minConfs, math.MaxInt32,
 		)
 		if err != nil {
 			return err
 		}
 
+		// Filter the UTXOs down to the selected outpoints, if any.
+		// Leased or otherwise locked outputs aren't returned by the
+		// wallet, so they can't be selected.
+		if len(outpoints) != 0 {
+			unspent := make(map[wire.OutPoint]*lnwallet.Utxo)
+			for _, utxo := range utxos {
+				unspent[utxo.OutPoint] = utxo
+			}
+
+			utxos = make([]*lnwallet.Utxo, 0, len(outpoints))
+			for _, op := range outpoints {
+				utxo, ok := unspent[op]
+				if !ok {
+					return fmt.Errorf("outpoint %v is not "+
+						"an unspent, unlocked output "+
+						"of the wallet", op)
+				}
+
+				delete(unspent, op)
+				utxos = append(utxos, utxo)
+			}
+		}
+
 		// We'll now lock each UTXO to ensure that other callers don't
 		// attempt to use these UTXOs in transactions while we're
 		// crafting out sweep all transaction.
@@ -203,15 +269,18 @@ func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
 		// in case we had any lingering outputs.
 		unlockOutputs()
 
-		return nil, fmt.Errorf("unable to fetch+lock wallet "+
+		return nil, nil, fmt.Errorf("unable to fetch+lock wallet "+
 			"utxos: %v", err)
 	}
 
-	// Now that we've locked all the potential outputs to sweep, we'll
-	// assemble an input for each of them, so we can hand it off to the
-	// sweeper to generate and sign a transaction for us.
-	var inputsToSweep []input.Input
-	for _, output := range allOutputs {
+	return allOutputs, unlockOutputs, nil
+}
+
+// makeWalletInputs assembles an input for each of the passed wallet UTXOs,
+// such that they can be signed for by the sweeper.
+func makeWalletInputs(utxos []*lnwallet.Utxo) ([]input.Input, error) {
+	var inputs []input.Input
+	for _, output := range utxos {
 		// As we'll be signing for outputs under control of the wallet,
 		// we only need to populate the output value and output script.
 		// The rest of the items will be populated internally within
@@ -245,8 +314,6 @@ func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
 		// All other output types we count as unknown and will fail to
 		// sweep.
 		default:
-			unlockOutputs()
-
 			return nil, fmt.Errorf("unable to sweep coins, "+
 				"unknown script: %x", pkScript[:])
 		}
@@ -255,7 +322,49 @@ func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
 		// input which can be passed to the sweeper for ultimate
 		// sweeping.
 		input := input.MakeBaseInput(&output.OutPoint, witnessType, signDesc, 0)
-		inputsToSweep = append(inputsToSweep, &input)
+		inputs = append(inputs, &walletInput{BaseInput: input})
+	}
+
+	return inputs, nil
+}
+
+// walletInput is an input spending a UTXO of the wallet. When offered to the
+// UtxoSweeper, the UTXO is expected to be leased under WalletInputLockID. The
+// UtxoSweeper renews the lease when it resumes sweeping the input after a
+// restart, and releases it once it's done with the input.
+type walletInput struct {
+	input.BaseInput
+}
+
+// CraftSweepAllTx attempts to craft a WalletSweepPackage which will allow the
+// caller to sweep ALL outputs within the wallet to a single UTXO, as specified
+// by the delivery address. If a set of outpoints is given, only those outputs
+// are swept instead. The sweep transaction will be crafted with the target fee
+// rate, and will use the utxoSource and outpointLocker as sources for wallet
+// funds.
+func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
+	deliveryAddr btcutil.Address, outpoints []wire.OutPoint,
+	coinSelectLocker CoinSelectionLocker, utxoSource UtxoSource,
+	outpointLocker OutpointLocker, feeEstimator lnwallet.FeeEstimator,
+	signer input.Signer) (*WalletSweepPackage, error) {
+
+	// TODO(roasbeef): turn off ATPL as well when available?
+
+	allOutputs, unlockOutputs, err := lockWalletUtxos(
+		coinSelectLocker, utxoSource, outpointLocker, outpoints,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Now that we've locked all the potential outputs to sweep, we'll
+	// assemble an input for each of them, so we can hand it off to the
+	// sweeper to generate and sign a transaction for us.
+	inputsToSweep, err := makeWalletInputs(allOutputs)
+	if err != nil {
+		unlockOutputs()
+
+		return nil, err
 	}
 
 	// Next, we'll convert the delivery addr to a pkScript that we can use
@@ -283,3 +392,136 @@ func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
 		CancelSweepAttempt: unlockOutputs,
 	}, nil
 }
+
+// CraftSpendTx attempts to craft a WalletSweepPackage which spends exactly the
+// given outpoints of the wallet to the passed outputs. Any value that remains
+// after paying for the outputs and the transaction fee is sent to a P2WKH
+// change address, unless it would be dust. The change address is only
+// requested once the selected outpoints are known to cover the outputs and
+// the fee. The transaction will be crafted with the target fee rate, and will
+// use the utxoSource and outpointLocker as sources for wallet funds.
+func CraftSpendTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
+	outputs []*wire.TxOut, changeAddr func() (btcutil.Address, error),
+	outpoints []wire.OutPoint, coinSelectLocker CoinSelectionLocker,
+	utxoSource UtxoSource, outpointLocker OutpointLocker,
+	signer input.Signer) (*WalletSweepPackage, error) {
+
+	if len(outputs) == 0 {
+		return nil, lnwallet.ErrNoOutputs
+	}
+	if len(outpoints) == 0 {
+		return nil, fmt.Errorf("no outpoints to spend")
+	}
+
+	selectedOutputs, unlockOutputs, err := lockWalletUtxos(
+		coinSelectLocker, utxoSource, outpointLocker, outpoints,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := makeWalletInputs(selectedOutputs)
+	if err != nil {
+		unlockOutputs()
+
+		return nil, err
+	}
+
+	changePkScript := func() ([]byte, error) {
+		addr, err := changeAddr()
+		if err != nil {
+			return nil, err
+		}
+
+		return txscript.PayToAddrScript(addr)
+	}
+
+	spendTx, err := createSpendTx(
+		inputs, outputs, changePkScript, blockHeight, feeRate, signer,
+	)
+	if err != nil {
+		unlockOutputs()
+
+		return nil, err
+	}
+
+	return &WalletSweepPackage{
+		SweepTx:            spendTx,
+		CancelSweepAttempt: unlockOutputs,
+	}, nil
+}
+
+// createSpendTx builds a signed transaction that spends all of the passed
+// inputs to the given outputs, sending any remaining value after fees to a
+// P2WKH change script, unless it would be dust. The change script is only
+// fetched if a change output is needed.
+func createSpendTx(inputs []input.Input, outputs []*wire.TxOut,
+	changePkScript func() ([]byte, error), blockHeight uint32,
+	feeRate lnwallet.SatPerKWeight, signer input.Signer) (*wire.MsgTx,
+	error) {
+
+	// We'll always account for a change output in our weight estimate,
+	// even if it ends up being omitted.
+	var weightEstimate input.TxWeightEstimator
+	weightEstimate.AddP2WKHOutput()
+
+	var totalOut btcutil.Amount
+	for _, output := range outputs {
+		weightEstimate.AddTxOutput(output)
+		totalOut += btcutil.Amount(output.Value)
+	}
+
+	var totalIn btcutil.Amount
+	for _, inp := range inputs {
+		_, _, err := inp.WitnessType().AddWeightEstimation(
+			&weightEstimate,
+		)
+		if err != nil {
+			return nil, err
+		}
+		totalIn += btcutil.Amount(inp.SignDesc().Output.Value)
+	}
+
+	txFee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+	if totalIn < totalOut+txFee {
+		return nil, fmt.Errorf("insufficient funds in selected "+
+			"outpoints: have %v, need %v", totalIn, totalOut+txFee)
+	}
+
+	spendTx := wire.NewMsgTx(2)
+	for _, output := range outputs {
+		spendTx.AddTxOut(output)
+	}
+
+	changeAmt := totalIn - totalOut - txFee
+	if changeAmt >= lnwallet.DefaultDustLimit() {
+		pkScript, err := changePkScript()
+		if err != nil {
+			return nil, err
+		}
+
+		spendTx.AddTxOut(&wire.TxOut{
+			PkScript: pkScript,
+			Value:    int64(changeAmt),
+		})
+	}
+
+	spendTx.LockTime = blockHeight
+	for _, inp := range inputs {
+		spendTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: *inp.OutPoint(),
+			Sequence:         inp.BlocksToMaturity(),
+		})
+	}
+
+	btx := btcutil.NewTx(spendTx)
+	if err := blockchain.CheckTransactionSanity(btx); err != nil {
+		return nil, err
+	}
+
+	if err := addInputScripts(spendTx, inputs, signer); err != nil {
+		return nil, err
+	}
+
+	return spendTx, nil
+}
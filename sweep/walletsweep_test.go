@@ -117,7 +117,7 @@ func newMockUtxoSource(utxos []*lnwallet.Utxo) *mockUtxoSource {
 	}
 }
 
-func (m *mockUtxoSource) ListUnspentWitness(minConfs int32,
+func (m *mockUtxoSource) ListUnspentWitnessFromDefaultAccount(minConfs int32,
 	maxConfs int32) ([]*lnwallet.Utxo, error) {
 
 	return m.outputs, nil
@@ -286,7 +286,8 @@ func TestCraftSweepAllTxCoinSelectFail(t *testing.T) {
 	utxoLocker := newMockOutpointLocker()
 
 	_, err := CraftSweepAllTx(
-		0, 100, nil, coinSelectLocker, utxoSource, utxoLocker, nil, nil,
+		0, 100, nil, nil, coinSelectLocker, utxoSource, utxoLocker, nil,
+		nil,
 	)
 
 	// Since we instructed the coin select locker to fail above, we should
@@ -311,7 +312,8 @@ func TestCraftSweepAllTxUnknownWitnessType(t *testing.T) {
 	utxoLocker := newMockOutpointLocker()
 
 	_, err := CraftSweepAllTx(
-		0, 100, nil, coinSelectLocker, utxoSource, utxoLocker, nil, nil,
+		0, 100, nil, nil, coinSelectLocker, utxoSource, utxoLocker, nil,
+		nil,
 	)
 
 	// Since passed in a p2wsh output, which is unknown, we should fail to
@@ -345,8 +347,8 @@ func TestCraftSweepAllTx(t *testing.T) {
 	utxoLocker := newMockOutpointLocker()
 
 	sweepPkg, err := CraftSweepAllTx(
-		0, 100, deliveryAddr, coinSelectLocker, utxoSource, utxoLocker,
-		feeEstimator, signer,
+		0, 100, deliveryAddr, nil, coinSelectLocker, utxoSource,
+		utxoLocker, feeEstimator, signer,
 	)
 	if err != nil {
 		t.Fatalf("unable to make sweep tx: %v", err)
@@ -389,3 +391,97 @@ func TestCraftSweepAllTx(t *testing.T) {
 	sweepPkg.CancelSweepAttempt()
 	assertUtxosUnlocked(t, utxoLocker, testUtxos[:2])
 }
+
+// TestCraftSpendTx tests that we'll only lock and spend the selected outputs
+// of the wallet, and return any remaining value to the change address.
+func TestCraftSpendTx(t *testing.T) {
+	t.Parallel()
+
+	signer := &mockSigner{}
+	utxoSource := newMockUtxoSource(testUtxos)
+	coinSelectLocker := &mockCoinSelectionLocker{}
+	utxoLocker := newMockOutpointLocker()
+
+	outputs := []*wire.TxOut{{
+		PkScript: sweepScript,
+		Value:    500,
+	}}
+
+	// We'll keep track of the number of change addresses requested, as
+	// one should only be created if the spend succeeds.
+	var numChangeAddrs int
+	changeAddr := func() (btcutil.Address, error) {
+		numChangeAddrs++
+		return deliveryAddr, nil
+	}
+
+	// Selecting an outpoint that isn't known to the wallet should fail.
+	_, err := CraftSpendTx(
+		0, 100, outputs, changeAddr, []wire.OutPoint{{Index: 4}},
+		coinSelectLocker, utxoSource, utxoLocker, signer,
+	)
+	if err == nil {
+		t.Fatalf("spend tx should have failed")
+	}
+
+	// Paying more than the selected output holds should fail as well, and
+	// unlock the selected output.
+	selected := []wire.OutPoint{testUtxos[1].OutPoint}
+	_, err = CraftSpendTx(
+		0, 100, []*wire.TxOut{{PkScript: sweepScript, Value: 2500}},
+		changeAddr, selected, coinSelectLocker, utxoSource,
+		utxoLocker, signer,
+	)
+	if err == nil {
+		t.Fatalf("spend tx should have failed")
+	}
+	assertUtxosLockedAndUnlocked(t, utxoLocker, testUtxos[1:2])
+
+	if numChangeAddrs != 0 {
+		t.Fatalf("expected no change address to be created, got %v",
+			numChangeAddrs)
+	}
+
+	utxoLocker = newMockOutpointLocker()
+	spendPkg, err := CraftSpendTx(
+		0, 100, outputs, changeAddr, selected, coinSelectLocker,
+		utxoSource, utxoLocker, signer,
+	)
+	if err != nil {
+		t.Fatalf("unable to make spend tx: %v", err)
+	}
+	if numChangeAddrs != 1 {
+		t.Fatalf("expected 1 change address to be created, got %v",
+			numChangeAddrs)
+	}
+
+	// Only the selected output should be locked.
+	assertUtxosLocked(t, utxoLocker, testUtxos[1:2])
+	if _, ok := utxoLocker.lockedOutpoints[testUtxos[0].OutPoint]; ok {
+		t.Fatalf("utxo %v should not be locked",
+			testUtxos[0].OutPoint)
+	}
+
+	// The transaction should spend the selected output, and pay the
+	// remaining value to the change address as we use a zero fee rate.
+	spendTx := spendPkg.SweepTx
+	if len(spendTx.TxIn) != 1 ||
+		spendTx.TxIn[0].PreviousOutPoint != testUtxos[1].OutPoint {
+
+		t.Fatalf("unexpected inputs: %v", spendTx.TxIn)
+	}
+	if len(spendTx.TxOut) != 2 {
+		t.Fatalf("expected 2 outputs, got %v", len(spendTx.TxOut))
+	}
+	if spendTx.TxOut[0].Value != 500 {
+		t.Fatalf("expected output value 500, got %v",
+			spendTx.TxOut[0].Value)
+	}
+	if spendTx.TxOut[1].Value != 1500 {
+		t.Fatalf("expected change value 1500, got %v",
+			spendTx.TxOut[1].Value)
+	}
+
+	spendPkg.CancelSweepAttempt()
+	assertUtxosUnlocked(t, utxoLocker, testUtxos[1:2])
+}
@@ -389,12 +389,18 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 
 	const chanActiveTimeout = time.Minute
 
+	chanStatusStore, err := netann.NewChanStatusStore(dbAlice.DB)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	chanStatusMgr, err := netann.NewChanStatusManager(&netann.ChanStatusConfig{
 		ChanStatusSampleInterval: 30 * time.Second,
 		ChanEnableTimeout:        chanActiveTimeout,
 		ChanDisableTimeout:       2 * time.Minute,
 		DB:                       dbAlice,
 		Graph:                    dbAlice.ChannelGraph(),
+		Store:                    chanStatusStore,
 		MessageSigner:            nodeSignerAlice,
 		OurPubKey:                aliceKeyPub,
 		IsChannelActive:          s.htlcSwitch.HasActiveLink,
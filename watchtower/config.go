@@ -68,11 +68,11 @@ type Config struct {
 	NodePrivKey *btcec.PrivateKey
 
 	// PublishTx provides the ability to send a signed transaction to the
-	// network.
+	// network, storing the given label for it.
 	//
 	// TODO(conner): replace with lnwallet.WalletController interface to
 	// have stronger guarantees wrt. returned error types.
-	PublishTx func(*wire.MsgTx) error
+	PublishTx func(*wire.MsgTx, string) error
 
 	// ListenAddrs specifies the listening addresses of the tower.
 	ListenAddrs []net.Addr
@@ -171,6 +171,7 @@ func genTaskTest(
 	// its txid and inputs spending from it. We also generate the
 	// input.Inputs that should be derived by the backup task.
 	txid := breachTxn.TxHash()
+	breachInfo.BreachTxHash = txid
 	var index uint32
 	if toLocalAmt > 0 {
 		breachInfo.RemoteOutpoint = wire.OutPoint{
@@ -297,6 +297,7 @@ func (c *mockChannel) createRemoteCommitTx(t *testing.T) {
 
 	retribution := &lnwallet.BreachRetribution{
 		BreachTransaction:    commitTxn,
+		BreachTxHash:         commitTxn.TxHash(),
 		RevokedStateNum:      c.commitHeight,
 		KeyRing:              commitKeyRing,
 		RemoteDelay:          c.csvDelay,